@@ -482,6 +482,7 @@ type SecretGeneratorSettingsAttributes struct {
 	OTPSMS                   *OTPSMSAttributes                   `json:"otpSms,omitempty"`
 	OTPEmail                 *OTPEmailAttributes                 `json:"otpEmail,omitempty"`
 	InviteCode               *InviteCodeAttributes               `json:"inviteCode,omitempty"`
+	MagicLink                *MagicLinkAttributes                `json:"magicLink,omitempty"`
 }
 
 type ClientSecretAttributes struct {
@@ -524,6 +525,10 @@ type InviteCodeAttributes struct {
 	SecretGeneratorAttrsWithExpiry
 }
 
+type MagicLinkAttributes struct {
+	SecretGeneratorAttrsWithExpiry
+}
+
 type SecretGeneratorAttrs struct {
 	Length              *uint `json:"length,omitempty"`
 	IncludeLowerLetters *bool `json:"includeLowerLetters,omitempty"`
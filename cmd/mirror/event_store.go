@@ -67,6 +67,7 @@ func copyEventstore(ctx context.Context, config *Migration) {
 
 	copyEvents(ctx, sourceClient, destClient, config.EventBulkSize)
 	copyUniqueConstraints(ctx, sourceClient, destClient)
+	copyPersonalDataKeys(ctx, sourceClient, destClient)
 }
 
 func positionQuery(db *db.DB) (string, error) {
@@ -277,3 +278,55 @@ func copyUniqueConstraints(ctx context.Context, source, dest *db.DB) {
 	logging.OnError(ctx, <-errs).Fatal("unable to copy unique constraints from source")
 	logging.Info(ctx, "unique constraints migrated", "took", time.Since(start), "count", eventCount)
 }
+
+func copyPersonalDataKeys(ctx context.Context, source, dest *db.DB) {
+	logging.Info(ctx, "starting to copy personal data keys")
+	start := time.Now()
+	reader, writer := io.Pipe()
+	errs := make(chan error, 1)
+
+	sourceConn, err := source.Conn(ctx)
+	logging.OnError(ctx, err).Fatal("unable to acquire source connection")
+
+	go func() {
+		err := sourceConn.Raw(func(driverConn interface{}) error {
+			conn := driverConn.(*stdlib.Conn).Conn()
+			var stmt database.Statement
+			stmt.WriteString("COPY (SELECT instance_id, aggregate_type, aggregate_id, key_id, key, created_at FROM eventstore.personal_data_keys ")
+			stmt.WriteString(instanceClause())
+			stmt.WriteString(") TO stdout")
+
+			_, err := conn.PgConn().CopyTo(ctx, writer, stmt.String())
+			writer.Close()
+			return err
+		})
+		errs <- err
+	}()
+
+	destConn, err := dest.Conn(ctx)
+	logging.OnError(ctx, err).Fatal("unable to acquire dest connection")
+
+	var keyCount int64
+	err = destConn.Raw(func(driverConn interface{}) error {
+		conn := driverConn.(*stdlib.Conn).Conn()
+
+		if shouldReplace {
+			var stmt database.Statement
+			stmt.WriteString("DELETE FROM eventstore.personal_data_keys ")
+			stmt.WriteString(instanceClause())
+
+			_, err := conn.Exec(ctx, stmt.String())
+			if err != nil {
+				return err
+			}
+		}
+
+		tag, err := conn.PgConn().CopyFrom(ctx, reader, "COPY eventstore.personal_data_keys (instance_id, aggregate_type, aggregate_id, key_id, key, created_at) FROM stdin")
+		keyCount = tag.RowsAffected()
+
+		return err
+	})
+	logging.OnError(ctx, err).Fatal("unable to copy personal data keys to destination")
+	logging.OnError(ctx, <-errs).Fatal("unable to copy personal data keys from source")
+	logging.Info(ctx, "personal data keys migrated", "took", time.Since(start), "count", keyCount)
+}
@@ -42,6 +42,7 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/notification"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
+	"github.com/zitadel/zitadel/internal/personaldata"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	static_config "github.com/zitadel/zitadel/internal/static/config"
@@ -96,19 +97,20 @@ type ProjectionsConfig struct {
 	Log     *old_logging.Config
 	Machine *id.Config
 
-	ExternalPort    uint16
-	ExternalDomain  string
-	ExternalSecure  bool
-	InternalAuthZ   internal_authz.Config
-	SystemAuthZ     internal_authz.Config
-	SystemDefaults  systemdefaults.SystemDefaults
-	Telemetry       *handlers.TelemetryPusherConfig
-	Login           login.Config
-	OIDC            oidc.Config
-	WebAuthNName    string
-	DefaultInstance command.InstanceSetup
-	AssetStorage    static_config.AssetStorageConfig
-	HTTPClient      http.ClientConfig
+	ExternalPort      uint16
+	ExternalDomain    string
+	ExternalSecure    bool
+	InternalAuthZ     internal_authz.Config
+	SystemAuthZ       internal_authz.Config
+	SystemDefaults    systemdefaults.SystemDefaults
+	Telemetry         *handlers.TelemetryPusherConfig
+	PushNotifications handlers.PushNotifierConfig
+	Login             login.Config
+	OIDC              oidc.Config
+	WebAuthNName      string
+	DefaultInstance   command.InstanceSetup
+	AssetStorage      static_config.AssetStorageConfig
+	HTTPClient        http.ClientConfig
 }
 
 func migrateProjectionsFlags(cmd *cobra.Command) {
@@ -140,6 +142,7 @@ func projections(
 	config.Eventstore.Querier = old_es.NewPostgres(client)
 	config.Eventstore.Pusher = newEventstore
 	config.Eventstore.Searcher = newEventstore
+	config.Eventstore.PersonalData = personaldata.NewCrypter(client, keys.User)
 
 	es := eventstore.NewEventstore(config.Eventstore)
 	esV4 := es_v4.NewEventstoreFromOne(es_v4_pg.New(client, &es_v4_pg.Config{
@@ -235,9 +238,11 @@ func projections(
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["backchannel"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["notificationspush"],
 		config.Notifications,
 		config.OIDC.BackChannelLogoutConfig(),
 		*config.Telemetry,
+		config.PushNotifications,
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 76.sql
+	addAuthorizationDetails string
+)
+
+type AddAuthorizationDetails struct {
+	dbClient *database.DB
+}
+
+func (mig *AddAuthorizationDetails) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addAuthorizationDetails)
+	return err
+}
+
+func (mig *AddAuthorizationDetails) String() string {
+	return "76_add_authorization_details"
+}
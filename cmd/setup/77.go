@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 77.sql
+	addRequireConsent string
+)
+
+type AddRequireConsent struct {
+	dbClient *database.DB
+}
+
+func (mig *AddRequireConsent) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addRequireConsent)
+	return err
+}
+
+func (mig *AddRequireConsent) String() string {
+	return "77_add_require_consent"
+}
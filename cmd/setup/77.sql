@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS require_consent BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.auth_requests ADD COLUMN IF NOT EXISTS require_consent BOOLEAN NOT NULL DEFAULT FALSE;
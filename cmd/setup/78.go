@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 78.sql
+	addTokenExchangePolicy string
+)
+
+type AddTokenExchangePolicy struct {
+	dbClient *database.DB
+}
+
+func (mig *AddTokenExchangePolicy) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTokenExchangePolicy)
+	return err
+}
+
+func (mig *AddTokenExchangePolicy) String() string {
+	return "78_add_token_exchange_policy"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 79.sql
+	addProjectResourceURIs string
+)
+
+type AddProjectResourceURIs struct {
+	dbClient *database.DB
+}
+
+func (mig *AddProjectResourceURIs) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addProjectResourceURIs)
+	return err
+}
+
+func (mig *AddProjectResourceURIs) String() string {
+	return "79_add_project_resource_uris"
+}
@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.projects4 ADD COLUMN IF NOT EXISTS resource_uris TEXT[];
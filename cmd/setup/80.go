@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 80.sql
+	addSoftwareStatementIssuers string
+)
+
+type AddSoftwareStatementIssuers struct {
+	dbClient *database.DB
+}
+
+func (mig *AddSoftwareStatementIssuers) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSoftwareStatementIssuers)
+	return err
+}
+
+func (mig *AddSoftwareStatementIssuers) String() string {
+	return "80_add_software_statement_issuers"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.security_policies3 ADD COLUMN IF NOT EXISTS require_software_statement BOOLEAN DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.security_policies3 ADD COLUMN IF NOT EXISTS software_statement_issuers JSONB;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 81.sql
+	addACR string
+)
+
+type AddACR struct {
+	dbClient *database.DB
+}
+
+func (mig *AddACR) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addACR)
+	return err
+}
+
+func (mig *AddACR) String() string {
+	return "81_add_acr"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.security_policies3 ADD COLUMN IF NOT EXISTS acr_definitions JSONB;
+ALTER TABLE IF EXISTS projections.auth_requests ADD COLUMN IF NOT EXISTS acr_values TEXT[];
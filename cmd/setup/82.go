@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 82.sql
+	addMagicLink string
+)
+
+type AddMagicLink struct {
+	dbClient *database.DB
+}
+
+func (mig *AddMagicLink) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addMagicLink)
+	return err
+}
+
+func (mig *AddMagicLink) String() string {
+	return "82_add_magic_link"
+}
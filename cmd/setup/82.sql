@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS magic_link_checked_at TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS allow_magic_link BOOLEAN DEFAULT FALSE;
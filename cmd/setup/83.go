@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 83.sql
+	addTrustedDevices string
+)
+
+type AddTrustedDevices struct {
+	dbClient *database.DB
+}
+
+func (mig *AddTrustedDevices) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTrustedDevices)
+	return err
+}
+
+func (mig *AddTrustedDevices) String() string {
+	return "83_add_trusted_devices"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 84.sql
+	addSessionRisk string
+)
+
+type AddSessionRisk struct {
+	dbClient *database.DB
+}
+
+func (mig *AddSessionRisk) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSessionRisk)
+	return err
+}
+
+func (mig *AddSessionRisk) String() string {
+	return "84_add_session_risk"
+}
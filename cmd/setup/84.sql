@@ -0,0 +1,5 @@
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS risk_score BIGINT;
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS risk_signals TEXT[];
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS risk_outcome SMALLINT;
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS risk_location JSONB;
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS risk_evaluated_at TIMESTAMPTZ;
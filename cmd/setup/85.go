@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 85.sql
+	addPasswordComplexityCheckBreached string
+)
+
+type AddPasswordComplexityCheckBreached struct {
+	dbClient *database.DB
+}
+
+func (mig *AddPasswordComplexityCheckBreached) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPasswordComplexityCheckBreached)
+	return err
+}
+
+func (mig *AddPasswordComplexityCheckBreached) String() string {
+	return "85_add_password_complexity_check_breached"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 86.sql
+	addPasswordAgeHistoryDepth string
+)
+
+type AddPasswordAgeHistoryDepth struct {
+	dbClient *database.DB
+}
+
+func (mig *AddPasswordAgeHistoryDepth) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPasswordAgeHistoryDepth)
+	return err
+}
+
+func (mig *AddPasswordAgeHistoryDepth) String() string {
+	return "86_add_password_age_history_depth"
+}
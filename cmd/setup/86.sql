@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.password_age_policies2 ADD COLUMN IF NOT EXISTS history_depth BIGINT DEFAULT 0;
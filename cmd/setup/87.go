@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 87.sql
+	addSecurityPolicyPasswordHash string
+)
+
+type AddSecurityPolicyPasswordHash struct {
+	dbClient *database.DB
+}
+
+func (mig *AddSecurityPolicyPasswordHash) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSecurityPolicyPasswordHash)
+	return err
+}
+
+func (mig *AddSecurityPolicyPasswordHash) String() string {
+	return "87_add_security_policy_password_hash"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.security_policies3 ADD COLUMN IF NOT EXISTS password_rehash_before TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.security_policies3 ADD COLUMN IF NOT EXISTS insecure_password_hash_deadline TIMESTAMPTZ;
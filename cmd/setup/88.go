@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 88.sql
+	addLoginPolicySessionLimits string
+)
+
+type AddLoginPolicySessionLimits struct {
+	dbClient *database.DB
+}
+
+func (mig *AddLoginPolicySessionLimits) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLoginPolicySessionLimits)
+	return err
+}
+
+func (mig *AddLoginPolicySessionLimits) String() string {
+	return "88_add_login_policy_session_limits"
+}
@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS session_idle_timeout BIGINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS session_max_lifetime BIGINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS max_concurrent_sessions BIGINT DEFAULT 0;
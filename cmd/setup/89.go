@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 89.sql
+	addLoginPolicyWebAuthN string
+)
+
+type AddLoginPolicyWebAuthN struct {
+	dbClient *database.DB
+}
+
+func (mig *AddLoginPolicyWebAuthN) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLoginPolicyWebAuthN)
+	return err
+}
+
+func (mig *AddLoginPolicyWebAuthN) String() string {
+	return "89_add_login_policy_webauthn"
+}
@@ -0,0 +1,5 @@
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS webauthn_attestation SMALLINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS webauthn_user_verification SMALLINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS webauthn_resident_key SMALLINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS webauthn_allowed_aaguids TEXT[];
+ALTER TABLE IF EXISTS projections.login_policies5 ADD COLUMN IF NOT EXISTS webauthn_denied_aaguids TEXT[];
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 90.sql
+	addSessionPush string
+)
+
+type AddSessionPush struct {
+	dbClient *database.DB
+}
+
+func (mig *AddSessionPush) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSessionPush)
+	return err
+}
+
+func (mig *AddSessionPush) String() string {
+	return "90_add_session_push"
+}
@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS push_checked_at TIMESTAMPTZ;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 91.sql
+	addNotificationPolicySecurityNotifications string
+)
+
+type AddNotificationPolicySecurityNotifications struct {
+	dbClient *database.DB
+}
+
+func (mig *AddNotificationPolicySecurityNotifications) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addNotificationPolicySecurityNotifications)
+	return err
+}
+
+func (mig *AddNotificationPolicySecurityNotifications) String() string {
+	return "91_add_notification_policy_security_notifications"
+}
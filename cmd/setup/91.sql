@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.notification_policies ADD COLUMN IF NOT EXISTS security_notifications BOOLEAN DEFAULT false;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 92.sql
+	addPhoneChannels string
+)
+
+type AddPhoneChannels struct {
+	dbClient *database.DB
+}
+
+func (mig *AddPhoneChannels) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPhoneChannels)
+	return err
+}
+
+func (mig *AddPhoneChannels) String() string {
+	return "92_add_phone_channels"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.notification_policies ADD COLUMN IF NOT EXISTS phone_channels SMALLINT[];
+ALTER TABLE IF EXISTS projections.users14_humans ADD COLUMN IF NOT EXISTS phone_channel SMALLINT DEFAULT 0;
@@ -0,0 +1,30 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 93.sql
+	addPersonalDataKeys string
+)
+
+// AddPersonalDataKeys creates the table of the keys used to encrypt the personal data of the users
+// if Eventstore.EncryptPersonalData is enabled.
+// Existing events are not encrypted, so their personal data is not shredded if the user is removed.
+type AddPersonalDataKeys struct {
+	dbClient *database.DB
+}
+
+func (mig *AddPersonalDataKeys) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPersonalDataKeys)
+	return err
+}
+
+func (mig *AddPersonalDataKeys) String() string {
+	return "93_add_personal_data_keys"
+}
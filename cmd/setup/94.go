@@ -0,0 +1,59 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	"github.com/zitadel/zitadel/backend/v3/instrumentation/logging"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+var (
+	//go:embed 94/94_create_table.sql
+	addUserInactivity string
+	//go:embed 94/94_fill_activities.sql
+	fillUserActivities string
+)
+
+// AddUserInactivity creates the table of the last activities of the users.
+// The last activity of the existing users is filled from the events of their sign-ins and tokens,
+// one instance at a time, so each query can use the index on the instance and event type.
+type AddUserInactivity struct {
+	dbClient   *database.DB
+	eventstore *eventstore.Eventstore
+}
+
+func (mig *AddUserInactivity) Execute(ctx context.Context, _ eventstore.Event) error {
+	if _, err := mig.dbClient.ExecContext(ctx, addUserInactivity); err != nil {
+		return err
+	}
+	instances, err := mig.eventstore.InstanceIDs(
+		ctx,
+		eventstore.NewSearchQueryBuilder(eventstore.ColumnsInstanceIDs).
+			OrderDesc().
+			AddQuery().
+			AggregateTypes(instance.AggregateType).
+			EventTypes(instance.InstanceAddedEventType).
+			Builder().ExcludeAggregateIDs().
+			AggregateTypes(instance.AggregateType).
+			EventTypes(instance.InstanceRemovedEventType).
+			Builder(),
+	)
+	if err != nil {
+		return err
+	}
+	for i, instanceID := range instances {
+		logging.Info(ctx, "fill user activities", "instance", instanceID, "migration", mig.String(), "progress", fmt.Sprintf("%d/%d", i+1, len(instances)))
+		if _, err = mig.dbClient.ExecContext(ctx, fillUserActivities, instanceID); err != nil {
+			return fmt.Errorf("%s %s: %w", mig.String(), instanceID, err)
+		}
+	}
+	return nil
+}
+
+func (mig *AddUserInactivity) String() string {
+	return "94_add_user_inactivity"
+}
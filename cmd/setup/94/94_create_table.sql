@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS auth.user_activities (
+    instance_id TEXT NOT NULL
+    , user_id TEXT NOT NULL
+    , last_activity TIMESTAMPTZ NOT NULL
+
+    , PRIMARY KEY (instance_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_activities_last_activity_idx ON auth.user_activities (instance_id, last_activity);
@@ -0,0 +1,42 @@
+-- the activity is only recorded from now on, the last activity of the existing users is taken from their sign-ins and tokens
+-- the filter on the instance, aggregate type and event type uses the es_projection index
+INSERT INTO auth.user_activities (instance_id, user_id, last_activity)
+SELECT instance_id, user_id, MAX(created_at)
+FROM (
+    SELECT instance_id, aggregate_id AS user_id, created_at
+    FROM eventstore.events2
+    WHERE instance_id = $1
+        AND aggregate_type = 'user'
+        AND event_type = ANY(ARRAY[
+            'user.token.added'
+            , 'user.human.refresh.token.added'
+            , 'user.human.refresh.token.renewed'
+            , 'user.human.password.check.succeeded'
+            , 'user.human.externallogin.check.succeeded'
+            , 'user.human.passwordless.token.check.succeeded'
+            , 'user.human.mfa.u2f.token.check.succeeded'
+            , 'user.human.mfa.otp.check.succeeded'
+        ])
+    UNION ALL
+    SELECT instance_id, payload->>'userID' AS user_id, created_at
+    FROM eventstore.events2
+    WHERE instance_id = $1
+        AND aggregate_type = 'session'
+        AND event_type = 'session.user.checked'
+    UNION ALL
+    SELECT instance_id, payload->>'userID' AS user_id, created_at
+    FROM eventstore.events2
+    WHERE instance_id = $1
+        AND aggregate_type = 'oidc_session'
+        AND event_type = 'oidc_session.added'
+    UNION ALL
+    SELECT instance_id, payload->>'userID' AS user_id, created_at
+    FROM eventstore.events2
+    WHERE instance_id = $1
+        AND aggregate_type = 'saml_session'
+        AND event_type = 'saml_session.added'
+) activities
+WHERE user_id IS NOT NULL
+GROUP BY instance_id, user_id
+ON CONFLICT (instance_id, user_id) DO UPDATE SET
+    last_activity = GREATEST(auth.user_activities.last_activity, EXCLUDED.last_activity);
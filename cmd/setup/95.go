@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 95.sql
+	addOrgHierarchy string
+)
+
+type AddOrgHierarchy struct {
+	dbClient *database.DB
+}
+
+func (mig *AddOrgHierarchy) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOrgHierarchy)
+	return err
+}
+
+func (mig *AddOrgHierarchy) String() string {
+	return "95_add_org_hierarchy"
+}
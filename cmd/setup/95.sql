@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS projections.orgs1 ADD COLUMN IF NOT EXISTS parent_id TEXT DEFAULT '';
+CREATE INDEX IF NOT EXISTS orgs1_parent_idx ON projections.orgs1 (parent_id);
+
+ALTER TABLE IF EXISTS projections.project_grants4 ADD COLUMN IF NOT EXISTS include_sub_orgs BOOLEAN DEFAULT FALSE;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 96.sql
+	addAccessValidity string
+)
+
+type AddAccessValidity struct {
+	dbClient *database.DB
+}
+
+func (mig *AddAccessValidity) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addAccessValidity)
+	return err
+}
+
+func (mig *AddAccessValidity) String() string {
+	return "96_add_access_validity"
+}
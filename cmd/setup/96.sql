@@ -0,0 +1,77 @@
+ALTER TABLE IF EXISTS projections.user_grants5 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.user_grants5 ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.user_grants5 ADD COLUMN IF NOT EXISTS expired BOOLEAN DEFAULT FALSE;
+
+ALTER TABLE IF EXISTS projections.instance_members4 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.instance_members4 ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.instance_members4 ADD COLUMN IF NOT EXISTS expired BOOLEAN DEFAULT FALSE;
+
+ALTER TABLE IF EXISTS projections.org_members4 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.org_members4 ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.org_members4 ADD COLUMN IF NOT EXISTS expired BOOLEAN DEFAULT FALSE;
+
+ALTER TABLE IF EXISTS projections.project_members4 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.project_members4 ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.project_members4 ADD COLUMN IF NOT EXISTS expired BOOLEAN DEFAULT FALSE;
+
+ALTER TABLE IF EXISTS projections.project_grant_members4 ADD COLUMN IF NOT EXISTS valid_from TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.project_grant_members4 ADD COLUMN IF NOT EXISTS valid_until TIMESTAMPTZ;
+ALTER TABLE IF EXISTS projections.project_grant_members4 ADD COLUMN IF NOT EXISTS expired BOOLEAN DEFAULT FALSE;
+
+-- recreate the member views to ignore memberships outside of their validity
+CREATE OR REPLACE VIEW eventstore.instance_members AS
+SELECT r.instance_id, r.object_id as user_id, r.text_value as role
+FROM eventstore.fields r
+WHERE r.aggregate_type = 'instance'
+AND r.object_type = 'instance_member_role'
+AND r.field_name = 'instance_role'
+AND NOT EXISTS (
+    SELECT 1 FROM eventstore.fields v
+    WHERE v.instance_id = r.instance_id
+    AND v.aggregate_type = r.aggregate_type
+    AND v.aggregate_id = r.aggregate_id
+    AND v.object_type = 'instance_member_validity'
+    AND v.object_id = r.object_id
+    AND (
+        (v.field_name = 'instance_valid_from' AND v.text_value::TIMESTAMPTZ > now())
+        OR (v.field_name = 'instance_valid_until' AND v.text_value::TIMESTAMPTZ <= now())
+    )
+);
+
+CREATE OR REPLACE VIEW eventstore.org_members AS
+SELECT r.instance_id, r.aggregate_id as org_id, r.object_id as user_id, r.text_value as role
+FROM eventstore.fields r
+WHERE r.aggregate_type = 'org'
+AND r.object_type = 'org_member_role'
+AND r.field_name = 'org_role'
+AND NOT EXISTS (
+    SELECT 1 FROM eventstore.fields v
+    WHERE v.instance_id = r.instance_id
+    AND v.aggregate_type = r.aggregate_type
+    AND v.aggregate_id = r.aggregate_id
+    AND v.object_type = 'org_member_validity'
+    AND v.object_id = r.object_id
+    AND (
+        (v.field_name = 'org_valid_from' AND v.text_value::TIMESTAMPTZ > now())
+        OR (v.field_name = 'org_valid_until' AND v.text_value::TIMESTAMPTZ <= now())
+    )
+);
+
+CREATE OR REPLACE VIEW eventstore.project_members AS
+SELECT r.instance_id, r.aggregate_id as project_id, r.object_id as user_id, r.text_value as role, r.resource_owner as org_id
+FROM eventstore.fields r
+WHERE r.aggregate_type = 'project'
+AND r.object_type = 'project_member_role'
+AND r.field_name = 'project_role'
+AND NOT EXISTS (
+    SELECT 1 FROM eventstore.fields v
+    WHERE v.instance_id = r.instance_id
+    AND v.aggregate_type = r.aggregate_type
+    AND v.aggregate_id = r.aggregate_id
+    AND v.object_type = 'project_member_validity'
+    AND v.object_id = r.object_id
+    AND (
+        (v.field_name = 'project_valid_from' AND v.text_value::TIMESTAMPTZ > now())
+        OR (v.field_name = 'project_valid_until' AND v.text_value::TIMESTAMPTZ <= now())
+    )
+);
@@ -0,0 +1,36 @@
+package setup
+
+import (
+	"context"
+	"embed"
+	"fmt"
+
+	"github.com/zitadel/zitadel/backend/v3/instrumentation/logging"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+type PermittedOrgsOrgHierarchy struct {
+	dbClient *database.DB
+}
+
+//go:embed 98/*.sql
+var permittedOrgsOrgHierarchy embed.FS
+
+func (mig *PermittedOrgsOrgHierarchy) Execute(ctx context.Context, _ eventstore.Event) error {
+	statements, err := readStatements(permittedOrgsOrgHierarchy, "98")
+	if err != nil {
+		return err
+	}
+	for _, stmt := range statements {
+		logging.Info(ctx, "execute statement", "file", stmt.file, "migration", mig.String())
+		if _, err := mig.dbClient.ExecContext(ctx, stmt.query); err != nil {
+			return fmt.Errorf("%s %s: %w", mig.String(), stmt.file, err)
+		}
+	}
+	return nil
+}
+
+func (*PermittedOrgsOrgHierarchy) String() string {
+	return "98_permitted_orgs_org_hierarchy"
+}
@@ -0,0 +1,8 @@
+-- org_parents contains the parent of each sub organization.
+-- It is read from the fields instead of projections.orgs1 so the hierarchy is up to date with the events.
+CREATE OR REPLACE VIEW eventstore.org_parents AS
+SELECT instance_id, object_id as org_id, text_value as parent_id
+FROM eventstore.fields
+WHERE aggregate_type = 'org'
+AND object_type = 'org'
+AND field_name = 'parent_id';
@@ -0,0 +1,91 @@
+-- permitted_projects calls permitted_orgs and is recreated with the new signature afterwards.
+DROP FUNCTION IF EXISTS eventstore.permitted_projects;
+DROP FUNCTION IF EXISTS eventstore.permitted_orgs;
+
+-- permitted_orgs includes the sub organizations of the organizations where the permission was granted,
+-- as the members of a parent organization manage its sub organizations as well.
+-- The depth of the hierarchy is limited by max_depth.
+CREATE OR REPLACE FUNCTION eventstore.permitted_orgs(
+    req_instance_id TEXT
+    , auth_user_id TEXT
+    , system_user_perms JSONB
+    , perm TEXT
+    , filter_org TEXT
+    , max_depth INT
+
+    , instance_permitted OUT BOOLEAN
+    , org_ids OUT TEXT[]
+)
+	LANGUAGE 'plpgsql' STABLE
+AS $$
+BEGIN
+    -- if system user
+    IF system_user_perms IS NOT NULL THEN
+        SELECT p.instance_permitted, p.org_ids INTO instance_permitted, org_ids
+        FROM eventstore.check_system_user_perms(system_user_perms, req_instance_id, perm) p;
+        RETURN;
+    END IF;
+
+    -- if human/service account
+    DECLARE
+    	matched_roles TEXT[] := eventstore.find_roles(req_instance_id, perm);
+	BEGIN
+        -- First try if the permission was granted thru an instance-level role
+        SELECT true INTO instance_permitted
+            FROM eventstore.instance_members im
+            WHERE im.role = ANY(matched_roles)
+            AND im.instance_id = req_instance_id
+            AND im.user_id = auth_user_id
+            LIMIT 1;
+
+        org_ids := ARRAY[]::TEXT[];
+        IF instance_permitted THEN
+            RETURN;
+        END IF;
+        instance_permitted := FALSE;
+
+        -- The filtered organization is permitted if the permission was granted on it or on one of its parents
+        IF filter_org IS NOT NULL THEN
+            WITH RECURSIVE hierarchy (org_id, depth) AS (
+                SELECT filter_org, 0
+                UNION ALL
+                SELECT op.parent_id, h.depth + 1
+                FROM hierarchy h
+                JOIN eventstore.org_parents op
+                    ON op.instance_id = req_instance_id
+                    AND op.org_id = h.org_id
+                WHERE h.depth < max_depth
+            )
+            SELECT ARRAY[filter_org] INTO org_ids
+            WHERE EXISTS (
+                SELECT 1
+                FROM eventstore.org_members om
+                JOIN hierarchy h
+                    ON om.org_id = h.org_id
+                WHERE om.role = ANY(matched_roles)
+                AND om.instance_id = req_instance_id
+                AND om.user_id = auth_user_id
+            );
+            RETURN;
+        END IF;
+
+        -- Return the organizations where permission were granted thru org-level roles and their sub organizations
+        WITH RECURSIVE permitted (org_id, depth) AS (
+            SELECT DISTINCT om.org_id, 0
+            FROM eventstore.org_members om
+            WHERE om.role = ANY(matched_roles)
+            AND om.instance_id = req_instance_id
+            AND om.user_id = auth_user_id
+            UNION
+            SELECT op.org_id, p.depth + 1
+            FROM permitted p
+            JOIN eventstore.org_parents op
+                ON op.instance_id = req_instance_id
+                AND op.parent_id = p.org_id
+            WHERE p.depth < max_depth
+        )
+        SELECT array_agg(DISTINCT p.org_id) INTO org_ids
+        FROM permitted p;
+    END;
+END;
+$$;
@@ -58,14 +58,15 @@ type Config struct {
 	Executions      execution.WorkerConfig
 	Eventstore      *eventstore.Config
 
-	InitProjections InitProjections
-	AssetStorage    static_config.AssetStorageConfig
-	OIDC            oidc.Config
-	Login           login.Config
-	WebAuthNName    string
-	Telemetry       *handlers.TelemetryPusherConfig
-	SystemAPIUsers  map[string]*authz.SystemAPIUser
-	HTTPClient      http.ClientConfig
+	InitProjections   InitProjections
+	AssetStorage      static_config.AssetStorageConfig
+	OIDC              oidc.Config
+	Login             login.Config
+	WebAuthNName      string
+	Telemetry         *handlers.TelemetryPusherConfig
+	PushNotifications handlers.PushNotifierConfig
+	SystemAPIUsers    map[string]*authz.SystemAPIUser
+	HTTPClient        http.ClientConfig
 }
 
 type InitProjections struct {
@@ -125,77 +126,101 @@ func NewConfig(cmd *cobra.Command, v *viper.Viper) (*Config, instrumentation.Shu
 }
 
 type Steps struct {
-	s1ProjectionTable                       *ProjectionTable
-	s2AssetsTable                           *AssetTable
-	FirstInstance                           *FirstInstance
-	s5LastFailed                            *LastFailed
-	s6OwnerRemoveColumns                    *OwnerRemoveColumns
-	s7LogstoreTables                        *LogstoreTables
-	s8AuthTokens                            *AuthTokenIndexes
-	CorrectCreationDate                     *CorrectCreationDate
-	s12AddOTPColumns                        *AddOTPColumns
-	s13FixQuotaProjection                   *FixQuotaConstraints
-	s14NewEventsTable                       *NewEventsTable
-	s15CurrentStates                        *CurrentProjectionState
-	s16UniqueConstraintsLower               *UniqueConstraintToLower
-	s17AddOffsetToUniqueConstraints         *AddOffsetToCurrentStates
-	s18AddLowerFieldsToLoginNames           *AddLowerFieldsToLoginNames
-	s19AddCurrentStatesIndex                *AddCurrentSequencesIndex
-	s20AddByUserSessionIndex                *AddByUserIndexToSession
-	s21AddBlockFieldToLimits                *AddBlockFieldToLimits
-	s22ActiveInstancesIndex                 *ActiveInstanceEvents
-	s23CorrectGlobalUniqueConstraints       *CorrectGlobalUniqueConstraints
-	s24AddActorToAuthTokens                 *AddActorToAuthTokens
-	s25User11AddLowerFieldsToVerifiedEmail  *User11AddLowerFieldsToVerifiedEmail
-	s26AuthUsers3                           *AuthUsers3
-	s27IDPTemplate6SAMLNameIDFormat         *IDPTemplate6SAMLNameIDFormat
-	s28AddFieldTable                        *AddFieldTable
-	s29FillFieldsForProjectGrant            *FillFieldsForProjectGrant
-	s30FillFieldsForOrgDomainVerified       *FillFieldsForOrgDomainVerified
-	s31AddAggregateIndexToFields            *AddAggregateIndexToFields
-	s32AddAuthSessionID                     *AddAuthSessionID
-	s33SMSConfigs3TwilioAddVerifyServiceSid *SMSConfigs3TwilioAddVerifyServiceSid
-	s34AddCacheSchema                       *AddCacheSchema
-	s35AddPositionToIndexEsWm               *AddPositionToIndexEsWm
-	s36FillV2Milestones                     *FillV3Milestones
-	s37Apps7OIDConfigsBackChannelLogoutURI  *Apps7OIDConfigsBackChannelLogoutURI
-	s38BackChannelLogoutNotificationStart   *BackChannelLogoutNotificationStart
-	s40InitPushFunc                         *InitPushFunc
-	s42Apps7OIDCConfigsLoginVersion         *Apps7OIDCConfigsLoginVersion
-	s43CreateFieldsDomainIndex              *CreateFieldsDomainIndex
-	s44ReplaceCurrentSequencesIndex         *ReplaceCurrentSequencesIndex
-	s45CorrectProjectOwners                 *CorrectProjectOwners
-	s46InitPermissionFunctions              *InitPermissionFunctions
-	s47FillMembershipFields                 *FillMembershipFields
-	s48Apps7SAMLConfigsLoginVersion         *Apps7SAMLConfigsLoginVersion
-	s49InitPermittedOrgsFunction            *InitPermittedOrgsFunction
-	s50IDPTemplate6UsePKCE                  *IDPTemplate6UsePKCE
-	s51IDPTemplate6RootCA                   *IDPTemplate6RootCA
-	s52IDPTemplate6LDAP2                    *IDPTemplate6LDAP2
-	s53InitPermittedOrgsFunction            *InitPermittedOrgsFunction53
-	s54InstancePositionIndex                *InstancePositionIndex
-	s55ExecutionHandlerStart                *ExecutionHandlerStart
-	s56IDPTemplate6SAMLFederatedLogout      *IDPTemplate6SAMLFederatedLogout
-	s57CreateResourceCounts                 *CreateResourceCounts
-	s58ReplaceLoginNames3View               *ReplaceLoginNames3View
-	s59SetupWebkeys                         *SetupWebkeys
-	s60GenerateSystemID                     *GenerateSystemID
-	s61IDPTemplate6SAMLSignatureAlgorithm   *IDPTemplate6SAMLSignatureAlgorithm
-	s62HTTPProviderAddSigningKey            *HTTPProviderAddSigningKey
-	s63AlterResourceCounts                  *AlterResourceCounts
-	s64ChangePushPosition                   *ChangePushPosition
-	s65FixUserMetadata5Index                *FixUserMetadata5Index
-	s66SessionRecoveryCodeCheckedAt         *SessionRecoveryCodeCheckedAt
-	s67SyncMemberRoleFields                 *SyncMemberRoleFields
-	s68TargetAddPayloadTypeColumn           *TargetAddPayloadTypeColumn
-	s69CacheTablesLogged                    *CacheTablesLogged
-	s70AddEventStoreCommandEnforceOwner     *AddEventStoreCommandEnforceOwnerColumn
-	s71JWTProvideAddAudienceColumn          *JWTProvideAddAudienceColumn
-	s72AddColumnsToLoginNamesView           *AddColumnsToLoginNamesView
-	s73FixUserGrantRoles                    *FixUserGrantRoles
-	s74Apps7OIDCConfigsAddRegistrationToken *Apps7OIDCConfigsAddRegistrationToken
-	s75Apps7OIDCConfigsAddAppLinkConfig     *Apps7OIDCConfigsAddAppLinkConfig
-	RelationalTables                        *TransactionalTables
+	s1ProjectionTable                             *ProjectionTable
+	s2AssetsTable                                 *AssetTable
+	FirstInstance                                 *FirstInstance
+	s5LastFailed                                  *LastFailed
+	s6OwnerRemoveColumns                          *OwnerRemoveColumns
+	s7LogstoreTables                              *LogstoreTables
+	s8AuthTokens                                  *AuthTokenIndexes
+	CorrectCreationDate                           *CorrectCreationDate
+	s12AddOTPColumns                              *AddOTPColumns
+	s13FixQuotaProjection                         *FixQuotaConstraints
+	s14NewEventsTable                             *NewEventsTable
+	s15CurrentStates                              *CurrentProjectionState
+	s16UniqueConstraintsLower                     *UniqueConstraintToLower
+	s17AddOffsetToUniqueConstraints               *AddOffsetToCurrentStates
+	s18AddLowerFieldsToLoginNames                 *AddLowerFieldsToLoginNames
+	s19AddCurrentStatesIndex                      *AddCurrentSequencesIndex
+	s20AddByUserSessionIndex                      *AddByUserIndexToSession
+	s21AddBlockFieldToLimits                      *AddBlockFieldToLimits
+	s22ActiveInstancesIndex                       *ActiveInstanceEvents
+	s23CorrectGlobalUniqueConstraints             *CorrectGlobalUniqueConstraints
+	s24AddActorToAuthTokens                       *AddActorToAuthTokens
+	s25User11AddLowerFieldsToVerifiedEmail        *User11AddLowerFieldsToVerifiedEmail
+	s26AuthUsers3                                 *AuthUsers3
+	s27IDPTemplate6SAMLNameIDFormat               *IDPTemplate6SAMLNameIDFormat
+	s28AddFieldTable                              *AddFieldTable
+	s29FillFieldsForProjectGrant                  *FillFieldsForProjectGrant
+	s30FillFieldsForOrgDomainVerified             *FillFieldsForOrgDomainVerified
+	s31AddAggregateIndexToFields                  *AddAggregateIndexToFields
+	s32AddAuthSessionID                           *AddAuthSessionID
+	s33SMSConfigs3TwilioAddVerifyServiceSid       *SMSConfigs3TwilioAddVerifyServiceSid
+	s34AddCacheSchema                             *AddCacheSchema
+	s35AddPositionToIndexEsWm                     *AddPositionToIndexEsWm
+	s36FillV2Milestones                           *FillV3Milestones
+	s37Apps7OIDConfigsBackChannelLogoutURI        *Apps7OIDConfigsBackChannelLogoutURI
+	s38BackChannelLogoutNotificationStart         *BackChannelLogoutNotificationStart
+	s40InitPushFunc                               *InitPushFunc
+	s42Apps7OIDCConfigsLoginVersion               *Apps7OIDCConfigsLoginVersion
+	s43CreateFieldsDomainIndex                    *CreateFieldsDomainIndex
+	s44ReplaceCurrentSequencesIndex               *ReplaceCurrentSequencesIndex
+	s45CorrectProjectOwners                       *CorrectProjectOwners
+	s46InitPermissionFunctions                    *InitPermissionFunctions
+	s47FillMembershipFields                       *FillMembershipFields
+	s48Apps7SAMLConfigsLoginVersion               *Apps7SAMLConfigsLoginVersion
+	s49InitPermittedOrgsFunction                  *InitPermittedOrgsFunction
+	s50IDPTemplate6UsePKCE                        *IDPTemplate6UsePKCE
+	s51IDPTemplate6RootCA                         *IDPTemplate6RootCA
+	s52IDPTemplate6LDAP2                          *IDPTemplate6LDAP2
+	s53InitPermittedOrgsFunction                  *InitPermittedOrgsFunction53
+	s54InstancePositionIndex                      *InstancePositionIndex
+	s55ExecutionHandlerStart                      *ExecutionHandlerStart
+	s56IDPTemplate6SAMLFederatedLogout            *IDPTemplate6SAMLFederatedLogout
+	s57CreateResourceCounts                       *CreateResourceCounts
+	s58ReplaceLoginNames3View                     *ReplaceLoginNames3View
+	s59SetupWebkeys                               *SetupWebkeys
+	s60GenerateSystemID                           *GenerateSystemID
+	s61IDPTemplate6SAMLSignatureAlgorithm         *IDPTemplate6SAMLSignatureAlgorithm
+	s62HTTPProviderAddSigningKey                  *HTTPProviderAddSigningKey
+	s63AlterResourceCounts                        *AlterResourceCounts
+	s64ChangePushPosition                         *ChangePushPosition
+	s65FixUserMetadata5Index                      *FixUserMetadata5Index
+	s66SessionRecoveryCodeCheckedAt               *SessionRecoveryCodeCheckedAt
+	s67SyncMemberRoleFields                       *SyncMemberRoleFields
+	s68TargetAddPayloadTypeColumn                 *TargetAddPayloadTypeColumn
+	s69CacheTablesLogged                          *CacheTablesLogged
+	s70AddEventStoreCommandEnforceOwner           *AddEventStoreCommandEnforceOwnerColumn
+	s71JWTProvideAddAudienceColumn                *JWTProvideAddAudienceColumn
+	s72AddColumnsToLoginNamesView                 *AddColumnsToLoginNamesView
+	s73FixUserGrantRoles                          *FixUserGrantRoles
+	s74Apps7OIDCConfigsAddRegistrationToken       *Apps7OIDCConfigsAddRegistrationToken
+	s75Apps7OIDCConfigsAddAppLinkConfig           *Apps7OIDCConfigsAddAppLinkConfig
+	s76AddAuthorizationDetails                    *AddAuthorizationDetails
+	s77AddRequireConsent                          *AddRequireConsent
+	s78AddTokenExchangePolicy                     *AddTokenExchangePolicy
+	s79AddProjectResourceURIs                     *AddProjectResourceURIs
+	s80AddSoftwareStatementIssuers                *AddSoftwareStatementIssuers
+	s81AddACR                                     *AddACR
+	s82AddMagicLink                               *AddMagicLink
+	s83AddTrustedDevices                          *AddTrustedDevices
+	s84AddSessionRisk                             *AddSessionRisk
+	s85AddPasswordComplexityCheckBreached         *AddPasswordComplexityCheckBreached
+	s86AddPasswordAgeHistoryDepth                 *AddPasswordAgeHistoryDepth
+	s87AddSecurityPolicyPasswordHash              *AddSecurityPolicyPasswordHash
+	s88AddLoginPolicySessionLimits                *AddLoginPolicySessionLimits
+	s89AddLoginPolicyWebAuthN                     *AddLoginPolicyWebAuthN
+	s90AddSessionPush                             *AddSessionPush
+	s91AddNotificationPolicySecurityNotifications *AddNotificationPolicySecurityNotifications
+	s92AddPhoneChannels                           *AddPhoneChannels
+	s93AddPersonalDataKeys                        *AddPersonalDataKeys
+	s94AddUserInactivity                          *AddUserInactivity
+	s95AddOrgHierarchy                            *AddOrgHierarchy
+	s96AddAccessValidity                          *AddAccessValidity
+	s97FillFieldsForSessionUsers                  *FillFieldsForSessionUsers
+	s98PermittedOrgsOrgHierarchy                  *PermittedOrgsOrgHierarchy
+	s99FillFieldsForOrgParents                    *FillFieldsForOrgParents
+	RelationalTables                              *TransactionalTables
 }
 
 func NewSteps(ctx context.Context, v *viper.Viper) (*Steps, error) {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/org"
@@ -544,7 +545,7 @@ func TestPermittedOrgs(t *testing.T) {
 	createMember(t, tx, instance.AggregateType, "instance_user")
 	createMember(t, tx, org.AggregateType, "org_user")
 
-	const query = "SELECT instance_permitted, org_ids FROM eventstore.permitted_orgs($1,$2,$3,$4,$5);"
+	const query = "SELECT instance_permitted, org_ids FROM eventstore.permitted_orgs($1,$2,$3,$4,$5,$6);"
 	type args struct {
 		reqInstanceID   string
 		authUserID      string
@@ -647,7 +648,7 @@ func TestPermittedOrgs(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rows, err := tx.Query(CTX, query, tt.args.reqInstanceID, tt.args.authUserID, database.NewJSONArray(tt.args.systemUserPerms), tt.args.perm, tt.args.filterOrg)
+			rows, err := tx.Query(CTX, query, tt.args.reqInstanceID, tt.args.authUserID, database.NewJSONArray(tt.args.systemUserPerms), tt.args.perm, tt.args.filterOrg, domain.OrgHierarchyMaxDepth)
 			require.NoError(t, err)
 			got, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByPos[result])
 			require.NoError(t, err)
@@ -673,7 +674,7 @@ func TestPermittedProjects(t *testing.T) {
 	createMember(t, tx, org.AggregateType, "org_user")
 	createMember(t, tx, project.AggregateType, "project_user")
 
-	const query = "SELECT instance_permitted, org_ids, project_ids FROM eventstore.permitted_projects($1,$2,$3,$4,$5);"
+	const query = "SELECT instance_permitted, org_ids, project_ids FROM eventstore.permitted_projects($1,$2,$3,$4,$5,$6);"
 	type args struct {
 		reqInstanceID   string
 		authUserID      string
@@ -828,7 +829,7 @@ func TestPermittedProjects(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			rows, err := tx.Query(CTX, query, tt.args.reqInstanceID, tt.args.authUserID, database.NewJSONArray(tt.args.systemUserPerms), tt.args.perm, tt.args.filterOrg)
+			rows, err := tx.Query(CTX, query, tt.args.reqInstanceID, tt.args.authUserID, database.NewJSONArray(tt.args.systemUserPerms), tt.args.perm, tt.args.filterOrg, domain.OrgHierarchyMaxDepth)
 			require.NoError(t, err)
 			got, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByPos[result])
 			require.NoError(t, err)
@@ -31,6 +31,7 @@ import (
 	authz_es "github.com/zitadel/zitadel/internal/authz/repository/eventsourcing/eventstore"
 	"github.com/zitadel/zitadel/internal/cache/connector"
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
 	cryptoDB "github.com/zitadel/zitadel/internal/crypto/database"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -40,6 +41,7 @@ import (
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/migration"
 	notify_handler "github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/personaldata"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/queue"
@@ -163,6 +165,8 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	esV3 := new_es.NewEventstore(dbClient)
 	config.Eventstore.Pusher = esV3
 	config.Eventstore.Searcher = esV3
+	config.Eventstore.PersonalData, err = personalDataCrypter(ctx, dbClient, masterKey, config.EncryptionKeys.User)
+	logging.OnError(ctx, err).Fatal("unable to start personal data encryption")
 	eventstoreClient := eventstore.NewEventstore(config.Eventstore)
 
 	logging.OnError(ctx, err).Fatal("unable to start eventstore")
@@ -258,6 +262,30 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s73FixUserGrantRoles = &FixUserGrantRoles{eventstore: eventstoreClient}
 	steps.s74Apps7OIDCConfigsAddRegistrationToken = &Apps7OIDCConfigsAddRegistrationToken{dbClient: dbClient}
 	steps.s75Apps7OIDCConfigsAddAppLinkConfig = &Apps7OIDCConfigsAddAppLinkConfig{dbClient: dbClient}
+	steps.s76AddAuthorizationDetails = &AddAuthorizationDetails{dbClient: dbClient}
+	steps.s77AddRequireConsent = &AddRequireConsent{dbClient: dbClient}
+	steps.s78AddTokenExchangePolicy = &AddTokenExchangePolicy{dbClient: dbClient}
+	steps.s79AddProjectResourceURIs = &AddProjectResourceURIs{dbClient: dbClient}
+	steps.s80AddSoftwareStatementIssuers = &AddSoftwareStatementIssuers{dbClient: dbClient}
+	steps.s81AddACR = &AddACR{dbClient: dbClient}
+	steps.s82AddMagicLink = &AddMagicLink{dbClient: dbClient}
+	steps.s83AddTrustedDevices = &AddTrustedDevices{dbClient: dbClient}
+	steps.s84AddSessionRisk = &AddSessionRisk{dbClient: dbClient}
+	steps.s85AddPasswordComplexityCheckBreached = &AddPasswordComplexityCheckBreached{dbClient: dbClient}
+	steps.s86AddPasswordAgeHistoryDepth = &AddPasswordAgeHistoryDepth{dbClient: dbClient}
+	steps.s87AddSecurityPolicyPasswordHash = &AddSecurityPolicyPasswordHash{dbClient: dbClient}
+	steps.s88AddLoginPolicySessionLimits = &AddLoginPolicySessionLimits{dbClient: dbClient}
+	steps.s89AddLoginPolicyWebAuthN = &AddLoginPolicyWebAuthN{dbClient: dbClient}
+	steps.s90AddSessionPush = &AddSessionPush{dbClient: dbClient}
+	steps.s91AddNotificationPolicySecurityNotifications = &AddNotificationPolicySecurityNotifications{dbClient: dbClient}
+	steps.s92AddPhoneChannels = &AddPhoneChannels{dbClient: dbClient}
+	steps.s93AddPersonalDataKeys = &AddPersonalDataKeys{dbClient: dbClient}
+	steps.s94AddUserInactivity = &AddUserInactivity{dbClient: dbClient, eventstore: eventstoreClient}
+	steps.s95AddOrgHierarchy = &AddOrgHierarchy{dbClient: dbClient}
+	steps.s96AddAccessValidity = &AddAccessValidity{dbClient: dbClient}
+	steps.s97FillFieldsForSessionUsers = &FillFieldsForSessionUsers{eventstore: eventstoreClient}
+	steps.s98PermittedOrgsOrgHierarchy = &PermittedOrgsOrgHierarchy{dbClient: dbClient}
+	steps.s99FillFieldsForOrgParents = &FillFieldsForOrgParents{eventstore: eventstoreClient}
 
 	err = projection.Create(ctx, dbClient, eventstoreClient, config.Projections, nil, nil, nil)
 	if err != nil {
@@ -272,6 +300,8 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s28AddFieldTable,
 		steps.s31AddAggregateIndexToFields,
 		steps.s46InitPermissionFunctions,
+		// the keys are required to push events containing personal data
+		steps.s93AddPersonalDataKeys,
 		steps.FirstInstance,
 		steps.s5LastFailed,
 		steps.s6OwnerRemoveColumns,
@@ -316,6 +346,8 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s67SyncMemberRoleFields,
 		steps.s69CacheTablesLogged,
 		steps.s70AddEventStoreCommandEnforceOwner,
+		steps.s97FillFieldsForSessionUsers,
+		steps.s99FillFieldsForOrgParents,
 	} {
 		setupErr = executeMigration(ctx, eventstoreClient, step, "migration failed")
 		if setupErr != nil {
@@ -392,6 +424,27 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s73FixUserGrantRoles,
 		steps.s74Apps7OIDCConfigsAddRegistrationToken,
 		steps.s75Apps7OIDCConfigsAddAppLinkConfig,
+		steps.s76AddAuthorizationDetails,
+		steps.s77AddRequireConsent,
+		steps.s78AddTokenExchangePolicy,
+		steps.s79AddProjectResourceURIs,
+		steps.s80AddSoftwareStatementIssuers,
+		steps.s81AddACR,
+		steps.s82AddMagicLink,
+		steps.s83AddTrustedDevices,
+		steps.s84AddSessionRisk,
+		steps.s85AddPasswordComplexityCheckBreached,
+		steps.s86AddPasswordAgeHistoryDepth,
+		steps.s87AddSecurityPolicyPasswordHash,
+		steps.s88AddLoginPolicySessionLimits,
+		steps.s89AddLoginPolicyWebAuthN,
+		steps.s90AddSessionPush,
+		steps.s91AddNotificationPolicySecurityNotifications,
+		steps.s92AddPhoneChannels,
+		steps.s94AddUserInactivity,
+		steps.s95AddOrgHierarchy,
+		steps.s96AddAccessValidity,
+		steps.s98PermittedOrgsOrgHierarchy,
 	} {
 		setupErr = executeMigration(ctx, eventstoreClient, step, "migration failed")
 		if setupErr != nil {
@@ -465,6 +518,23 @@ func readStatements(fs embed.FS, folder string) ([]statement, error) {
 	return statements, nil
 }
 
+// personalDataCrypter ensures the user encryption key exists,
+// because the first instance pushes events containing personal data.
+func personalDataCrypter(ctx context.Context, dbClient *database.DB, masterKey string, keyConfig *crypto.KeyConfig) (*personaldata.Crypter, error) {
+	keyStorage, err := cryptoDB.NewKeyStorage(dbClient, masterKey)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifyKey(ctx, keyConfig, keyStorage); err != nil {
+		return nil, err
+	}
+	userAlg, err := crypto.NewAESCrypto(keyConfig, keyStorage)
+	if err != nil {
+		return nil, err
+	}
+	return personaldata.NewCrypter(dbClient, userAlg), nil
+}
+
 func startCommandsQueries(
 	ctx context.Context,
 	eventstoreClient *eventstore.Eventstore,
@@ -615,9 +685,11 @@ func startCommandsQueries(
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["backchannel"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["notificationspush"],
 		config.Notifications,
 		config.OIDC.BackChannelLogoutConfig(),
 		*config.Telemetry,
+		config.PushNotifications,
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
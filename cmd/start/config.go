@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/backend/v3/instrumentation/logging"
 	"github.com/zitadel/zitadel/cmd/encryption"
 	"github.com/zitadel/zitadel/cmd/hooks"
+	"github.com/zitadel/zitadel/internal/accessexpiry"
 	"github.com/zitadel/zitadel/internal/actions"
 	admin_es "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing"
 	"github.com/zitadel/zitadel/internal/api/authz"
@@ -26,6 +27,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/api/well_known"
 	auth_es "github.com/zitadel/zitadel/internal/auth/repository/eventsourcing"
+	"github.com/zitadel/zitadel/internal/breach"
 	"github.com/zitadel/zitadel/internal/cache/connector"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/config/hook"
@@ -40,8 +42,12 @@ import (
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/serviceping"
 	static_config "github.com/zitadel/zitadel/internal/static/config"
+	"github.com/zitadel/zitadel/internal/userexport"
+	"github.com/zitadel/zitadel/internal/userinactivity"
+	"github.com/zitadel/zitadel/internal/webauthn"
 )
 
 type Config struct {
@@ -57,6 +63,7 @@ type Config struct {
 	HTTP2HostHeader     string
 	HTTP1HostHeader     string
 	WebAuthNName        string
+	WebAuthNAttestation webauthn.TrustConfig
 	Database            database.Config
 	Caches              *connector.CachesConfig
 	Tracing             *instrumentation.LegacyTraceConfig
@@ -65,6 +72,9 @@ type Config struct {
 	Projections         projection.Config
 	Notifications       handlers.WorkerConfig
 	Executions          execution.WorkerConfig
+	UserDataExports     userexport.WorkerConfig
+	Risk                risk.Config
+	PasswordBreach      breach.Config
 	Auth                auth_es.Config
 	Admin               admin_es.Config
 	UserAgentCookie     *middleware.UserAgentCookieConfig
@@ -89,7 +99,10 @@ type Config struct {
 	LogStore            *logstore.Configs
 	Quotas              *QuotasConfig
 	Telemetry           *handlers.TelemetryPusherConfig
+	PushNotifications   handlers.PushNotifierConfig
 	ServicePing         *serviceping.Config
+	UserInactivity      *userinactivity.Config
+	AccessExpiry        *accessexpiry.Config
 	HTTPClient          *http.ClientConfig
 }
 
@@ -33,7 +33,9 @@ import (
 	"github.com/zitadel/zitadel/cmd/encryption"
 	"github.com/zitadel/zitadel/cmd/key"
 	cmd_tls "github.com/zitadel/zitadel/cmd/tls"
+	"github.com/zitadel/zitadel/internal/accessexpiry"
 	"github.com/zitadel/zitadel/internal/actions"
+	"github.com/zitadel/zitadel/internal/activity"
 	admin_es "github.com/zitadel/zitadel/internal/admin/repository/eventsourcing"
 	"github.com/zitadel/zitadel/internal/api"
 	"github.com/zitadel/zitadel/internal/api/assets"
@@ -90,6 +92,7 @@ import (
 	"github.com/zitadel/zitadel/internal/authz"
 	authz_repo "github.com/zitadel/zitadel/internal/authz/repository"
 	authz_es "github.com/zitadel/zitadel/internal/authz/repository/eventsourcing/eventstore"
+	"github.com/zitadel/zitadel/internal/breach"
 	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/cache/connector"
 	"github.com/zitadel/zitadel/internal/command"
@@ -112,10 +115,14 @@ import (
 	"github.com/zitadel/zitadel/internal/logstore/record"
 	"github.com/zitadel/zitadel/internal/net"
 	"github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/personaldata"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/serviceping"
 	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/userexport"
+	"github.com/zitadel/zitadel/internal/userinactivity"
 	es_v4 "github.com/zitadel/zitadel/internal/v2/eventstore"
 	es_v4_pg "github.com/zitadel/zitadel/internal/v2/eventstore/postgres"
 	"github.com/zitadel/zitadel/internal/webauthn"
@@ -203,6 +210,10 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	config.Eventstore.Pusher = new_es.NewEventstore(dbClient, new_es.WithExecutionQueueOption(q))
 	config.Eventstore.Searcher = new_es.NewEventstore(dbClient, new_es.WithExecutionQueueOption(q))
 	config.Eventstore.Querier = old_es.NewPostgres(dbClient)
+	config.Eventstore.PersonalData = personaldata.NewCrypter(dbClient, keys.User)
+	if config.UserInactivity != nil && config.UserInactivity.Enabled {
+		activity.SetRecorder(activity.NewDBRecorder(dbClient))
+	}
 	eventstoreClient := eventstore.NewEventstore(config.Eventstore)
 	eventstoreV4 := es_v4.NewEventstoreFromOne(es_v4_pg.New(dbClient, &es_v4_pg.Config{
 		MaxRetries: config.Eventstore.MaxRetries,
@@ -267,9 +278,14 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	if err != nil {
 		return fmt.Errorf("cannot start asset storage client: %w", err)
 	}
+	webAuthNTrust, err := webauthn.NewTrustStore(&config.WebAuthNAttestation)
+	if err != nil {
+		return fmt.Errorf("cannot load webauthn attestation trust: %w", err)
+	}
 	webAuthNConfig := &webauthn.Config{
 		DisplayName:    config.WebAuthNName,
 		ExternalSecure: config.ExternalSecure,
+		Trust:          webAuthNTrust,
 	}
 
 	new_domain.SetWebAuthNConfig(webAuthNConfig)
@@ -309,6 +325,17 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		return fmt.Errorf("cannot start commands: %w", err)
 	}
 	defer commands.Close(ctx) // wait for background jobs
+	commands.RiskEvaluator, err = risk.NewEvaluator(&config.Risk, queries, keys.Target, queries.GetActiveSigningWebKey, httpClient)
+	if err != nil {
+		return fmt.Errorf("cannot start risk evaluation: %w", err)
+	}
+	if commands.RiskEvaluator == nil {
+		commands.DeviceEvaluator = risk.NewDeviceEvaluator(queries)
+	}
+	commands.PasswordBreachChecker, err = breach.NewChecker(&config.PasswordBreach, httpClient)
+	if err != nil {
+		return fmt.Errorf("cannot load breached password corpus: %w", err)
+	}
 
 	// sink Server is stubbed out in production builds, see function's godoc.
 	closeSink := sink.StartServer(commands)
@@ -334,9 +361,11 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["backchannel"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["notificationspush"],
 		config.Notifications,
 		config.OIDC.BackChannelLogoutConfig(),
 		*config.Telemetry,
+		config.PushNotifications,
 		config.ExternalDomain,
 		config.ExternalPort,
 		config.ExternalSecure,
@@ -363,10 +392,24 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	)
 	execution.Start(ctx)
 
+	userexport.Register(
+		ctx,
+		config.Projections.Customizations["user_data_exports"],
+		config.UserDataExports,
+		commands,
+		queries,
+		eventstoreClient,
+		storage,
+		q,
+	)
+	userexport.Start(ctx)
+
 	// the service ping and it's workers need to be registered before starting the queue
 	if err := serviceping.Register(ctx, q, queries, eventstoreClient, config.ServicePing); err != nil {
 		return err
 	}
+	userinactivity.Register(ctx, q, commands, queries, config.UserInactivity)
+	accessexpiry.Register(ctx, q, commands, queries, config.AccessExpiry)
 
 	if err = q.Start(ctx); err != nil {
 		return err
@@ -376,6 +419,12 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	if err = serviceping.Start(ctx, config.ServicePing, q); err != nil {
 		return err
 	}
+	if err = userinactivity.Start(ctx, config.UserInactivity, q); err != nil {
+		return err
+	}
+	if err = accessexpiry.Start(ctx, config.AccessExpiry, q); err != nil {
+		return err
+	}
 
 	router := mux.NewRouter()
 	tlsConfig, err := config.TLS.Config()
@@ -543,7 +592,7 @@ func startAPIs(
 	if err := apis.RegisterService(ctx, user_v2beta.CreateServer(commands, queries, keys.User, keys.IDPConfig, idp.CallbackURL(), idp.SAMLRootURL(), assets.AssetAPI(), permissionCheck)); err != nil {
 		return nil, err
 	}
-	if err := apis.RegisterService(ctx, user_v2.CreateServer(commands, queries, config.SystemDefaults, keys.User, keys.IDPConfig, idp.CallbackURL(), idp.SAMLRootURL(), assets.AssetAPI(), permissionCheck)); err != nil {
+	if err := apis.RegisterService(ctx, user_v2.CreateServer(commands, queries, config.SystemDefaults, keys.User, keys.IDPConfig, idp.CallbackURL(), idp.SAMLRootURL(), assets.AssetAPI(), store, permissionCheck)); err != nil {
 		return nil, err
 	}
 	if err := apis.RegisterService(ctx, session_v2beta.CreateServer(commands, queries, permissionCheck)); err != nil {
@@ -0,0 +1,12 @@
+package accessexpiry
+
+type Config struct {
+	// Enabled starts the periodic check of the user grants and memberships whose validity ended
+	Enabled bool
+	// Interval of the check as cron expression
+	Interval string
+	// MaxAttempts of each check
+	MaxAttempts uint8
+	// BulkSize is the amount of user grants and memberships loaded at once
+	BulkSize uint64
+}
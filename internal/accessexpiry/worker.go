@@ -0,0 +1,213 @@
+// Package accessexpiry periodically pushes the expiry of the user grants and memberships
+// whose validity ended, so Actions and webhooks can react on it.
+// The access itself already ends with the validity.
+package accessexpiry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	QueueName = "access_expiry"
+	// ExpiryUserID is the creator of the events pushed by the worker.
+	ExpiryUserID = "ACCESS_EXPIRY"
+)
+
+var _ river.Worker[*Check] = (*Worker)(nil)
+
+// Check is the periodic job checking the user grants and memberships of all instances.
+type Check struct{}
+
+func (*Check) Kind() string {
+	return "access_expiry_check"
+}
+
+type Worker struct {
+	river.WorkerDefaults[*Check]
+
+	config   *Config
+	commands Commands
+	queries  Queries
+	now      func() time.Time
+}
+
+type Commands interface {
+	ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (*domain.ObjectDetails, error)
+	ExpireInstanceMember(ctx context.Context, instanceID, userID string) (*domain.ObjectDetails, error)
+	ExpireOrgMember(ctx context.Context, orgID, userID string) (*domain.ObjectDetails, error)
+	ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) (*domain.ObjectDetails, error)
+}
+
+type Queries interface {
+	SearchInstances(ctx context.Context, queries *query.InstanceSearchQueries) (*query.Instances, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool, permissionCheck domain.PermissionCheck) (*query.UserGrants, error)
+	Memberships(ctx context.Context, queries *query.MembershipSearchQuery, shouldTrigger bool) (*query.Memberships, error)
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker[*Check](workers, w)
+	queues[QueueName] = river.QueueConfig{
+		MaxWorkers: 1,
+	}
+}
+
+// Work implements the [river.Worker] interface.
+func (w *Worker) Work(ctx context.Context, _ *river.Job[*Check]) error {
+	instances, err := w.queries.SearchInstances(ctx, &query.InstanceSearchQueries{})
+	if err != nil {
+		return err
+	}
+	errs := make([]error, 0)
+	for _, instance := range instances.Instances {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		instanceCtx := authz.WithInstanceID(authz.SetCtxData(ctx, authz.CtxData{UserID: ExpiryUserID}), instance.ID)
+		if err := w.checkInstance(instanceCtx); err != nil {
+			logging.WithFields("instance", instance.ID).WithError(err).Warn("unable to check expired access")
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (w *Worker) checkInstance(ctx context.Context) error {
+	now := w.now()
+	return errors.Join(
+		w.checkUserGrants(ctx, now),
+		w.checkMemberships(ctx, now),
+	)
+}
+
+// checkUserGrants expires the user grants in bulks.
+// As the expired grants aren't returned anymore, the offset is only increased by the grants which couldn't be expired.
+func (w *Worker) checkUserGrants(ctx context.Context, now time.Time) error {
+	pendingQuery, err := query.NewUserGrantExpiryPendingQuery(now)
+	if err != nil {
+		return err
+	}
+	search := &query.UserGrantsQueries{
+		SearchRequest: query.SearchRequest{Limit: w.config.BulkSize},
+		Queries:       []query.SearchQuery{pendingQuery},
+	}
+	for {
+		grants, err := w.queries.UserGrants(ctx, search, true, nil)
+		if err != nil {
+			return err
+		}
+		for _, grant := range grants.UserGrants {
+			if !w.expireUserGrant(ctx, grant) {
+				search.Offset++
+			}
+		}
+		if search.Limit == 0 || uint64(len(grants.UserGrants)) < search.Limit {
+			return nil
+		}
+	}
+}
+
+func (w *Worker) expireUserGrant(ctx context.Context, grant *query.UserGrant) bool {
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: ExpiryUserID, OrgID: grant.ResourceOwner})
+	if _, err := w.commands.ExpireUserGrant(ctx, grant.ID, grant.ResourceOwner); err != nil {
+		logging.WithFields("grant", grant.ID).WithError(err).Warn("unable to expire user grant")
+		return false
+	}
+	return true
+}
+
+// checkMemberships expires the memberships in bulks, see [Worker.checkUserGrants].
+func (w *Worker) checkMemberships(ctx context.Context, now time.Time) error {
+	pendingQuery, err := query.NewMembershipExpiryPendingQuery(now)
+	if err != nil {
+		return err
+	}
+	search := &query.MembershipSearchQuery{
+		SearchRequest: query.SearchRequest{Limit: w.config.BulkSize},
+		Queries:       []query.SearchQuery{pendingQuery},
+	}
+	for {
+		memberships, err := w.queries.Memberships(ctx, search, true)
+		if err != nil {
+			return err
+		}
+		for _, membership := range memberships.Memberships {
+			if !w.expireMembership(ctx, membership) {
+				search.Offset++
+			}
+		}
+		if search.Limit == 0 || uint64(len(memberships.Memberships)) < search.Limit {
+			return nil
+		}
+	}
+}
+
+func (w *Worker) expireMembership(ctx context.Context, membership *query.Membership) bool {
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: ExpiryUserID, OrgID: membership.ResourceOwner})
+	var err error
+	switch {
+	case membership.IAM != nil:
+		_, err = w.commands.ExpireInstanceMember(ctx, membership.IAM.IAMID, membership.UserID)
+	case membership.Org != nil:
+		_, err = w.commands.ExpireOrgMember(ctx, membership.Org.OrgID, membership.UserID)
+	case membership.Project != nil:
+		_, err = w.commands.ExpireProjectMember(ctx, membership.Project.ProjectID, membership.UserID, membership.ResourceOwner)
+	default:
+		// the validity of project grant memberships can't be limited
+		return false
+	}
+	if err != nil {
+		logging.WithFields("user", membership.UserID, "resource_owner", membership.ResourceOwner).WithError(err).Warn("unable to expire membership")
+		return false
+	}
+	return true
+}
+
+func Register(
+	ctx context.Context,
+	q *queue.Queue,
+	commands *command.Commands,
+	queries *query.Queries,
+	config *Config,
+) {
+	if !config.Enabled {
+		return
+	}
+	q.AddWorkers(ctx, &Worker{
+		config:   config,
+		commands: commands,
+		queries:  queries,
+		now:      time.Now,
+	})
+}
+
+func Start(ctx context.Context, config *Config, q *queue.Queue) error {
+	if !config.Enabled {
+		return nil
+	}
+	schedule, err := cron.ParseStandard(config.Interval)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "ACCEX-Ohh5a", "invalid interval")
+	}
+	q.AddPeriodicJob(
+		ctx,
+		schedule,
+		&Check{},
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(config.MaxAttempts),
+	)
+	return nil
+}
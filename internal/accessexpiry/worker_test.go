@@ -0,0 +1,136 @@
+package accessexpiry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type fakeQueries struct {
+	grants             []*query.UserGrant
+	memberships        []*query.Membership
+	grantSearches      []query.SearchRequest
+	membershipSearches []query.SearchRequest
+}
+
+func (q *fakeQueries) SearchInstances(context.Context, *query.InstanceSearchQueries) (*query.Instances, error) {
+	return &query.Instances{Instances: []*query.Instance{{ID: "instance1"}}}, nil
+}
+
+func (q *fakeQueries) UserGrants(_ context.Context, search *query.UserGrantsQueries, _ bool, _ domain.PermissionCheck) (*query.UserGrants, error) {
+	q.grantSearches = append(q.grantSearches, search.SearchRequest)
+	return &query.UserGrants{UserGrants: page(q.grants, search.SearchRequest)}, nil
+}
+
+func (q *fakeQueries) Memberships(_ context.Context, search *query.MembershipSearchQuery, _ bool) (*query.Memberships, error) {
+	q.membershipSearches = append(q.membershipSearches, search.SearchRequest)
+	return &query.Memberships{Memberships: page(q.memberships, search.SearchRequest)}, nil
+}
+
+func page[T any](results []T, search query.SearchRequest) []T {
+	if search.Offset >= uint64(len(results)) {
+		return nil
+	}
+	results = results[search.Offset:]
+	if search.Limit > 0 && search.Limit < uint64(len(results)) {
+		return results[:search.Limit]
+	}
+	return results
+}
+
+type expiry struct {
+	kind       string
+	id         string
+	userID     string
+	instanceID string
+}
+
+type fakeCommands struct {
+	expired []expiry
+	err     error
+}
+
+func (c *fakeCommands) ExpireUserGrant(ctx context.Context, grantID, _ string) (*domain.ObjectDetails, error) {
+	c.expired = append(c.expired, expiry{kind: "grant", id: grantID, instanceID: authz.GetInstance(ctx).InstanceID()})
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *fakeCommands) ExpireInstanceMember(ctx context.Context, instanceID, userID string) (*domain.ObjectDetails, error) {
+	c.expired = append(c.expired, expiry{kind: "instance", id: instanceID, userID: userID, instanceID: authz.GetInstance(ctx).InstanceID()})
+	return &domain.ObjectDetails{}, nil
+}
+
+func (c *fakeCommands) ExpireOrgMember(ctx context.Context, orgID, userID string) (*domain.ObjectDetails, error) {
+	c.expired = append(c.expired, expiry{kind: "org", id: orgID, userID: userID, instanceID: authz.GetInstance(ctx).InstanceID()})
+	return &domain.ObjectDetails{}, c.err
+}
+
+func (c *fakeCommands) ExpireProjectMember(ctx context.Context, projectID, userID, _ string) (*domain.ObjectDetails, error) {
+	c.expired = append(c.expired, expiry{kind: "project", id: projectID, userID: userID, instanceID: authz.GetInstance(ctx).InstanceID()})
+	return &domain.ObjectDetails{}, nil
+}
+
+func TestWorker_Work(t *testing.T) {
+	queries := &fakeQueries{
+		grants: []*query.UserGrant{
+			{ID: "grant1", ResourceOwner: "org1"},
+		},
+		memberships: []*query.Membership{
+			{UserID: "user1", ResourceOwner: "instance1", IAM: &query.IAMMembership{IAMID: "instance1"}},
+			{UserID: "user2", ResourceOwner: "org1", Org: &query.OrgMembership{OrgID: "org1"}},
+			{UserID: "user3", ResourceOwner: "org1", Project: &query.ProjectMembership{ProjectID: "project1"}},
+			{UserID: "user4", ResourceOwner: "org1", ProjectGrant: &query.ProjectGrantMembership{ProjectID: "project1", GrantID: "grant1"}},
+		},
+	}
+	commands := new(fakeCommands)
+	w := &Worker{
+		config:   &Config{BulkSize: 10},
+		commands: commands,
+		queries:  queries,
+		now:      time.Now,
+	}
+
+	err := w.Work(context.Background(), &river.Job[*Check]{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []expiry{
+		{kind: "grant", id: "grant1", instanceID: "instance1"},
+		{kind: "instance", id: "instance1", userID: "user1", instanceID: "instance1"},
+		{kind: "org", id: "org1", userID: "user2", instanceID: "instance1"},
+		{kind: "project", id: "project1", userID: "user3", instanceID: "instance1"},
+	}, commands.expired)
+	assert.Equal(t, []query.SearchRequest{{Limit: 10}}, queries.grantSearches)
+	assert.Equal(t, []query.SearchRequest{{Limit: 10}}, queries.membershipSearches)
+}
+
+func TestWorker_Work_bulks(t *testing.T) {
+	queries := &fakeQueries{
+		memberships: []*query.Membership{
+			{UserID: "user1", ResourceOwner: "org1", Org: &query.OrgMembership{OrgID: "org1"}},
+			{UserID: "user2", ResourceOwner: "org1", Org: &query.OrgMembership{OrgID: "org1"}},
+		},
+	}
+	commands := &fakeCommands{err: errors.New("push failed")}
+	w := &Worker{
+		config:   &Config{BulkSize: 2},
+		commands: commands,
+		queries:  queries,
+		now:      time.Now,
+	}
+
+	err := w.Work(context.Background(), &river.Job[*Check]{})
+	require.NoError(t, err)
+
+	// the memberships which couldn't be expired are skipped on the next bulk
+	assert.Len(t, queries.membershipSearches, 2)
+	assert.Equal(t, uint64(2), queries.membershipSearches[1].Offset)
+}
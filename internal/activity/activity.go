@@ -57,6 +57,7 @@ func Trigger(ctx context.Context, orgID, userID string, trigger TriggerMethod, r
 		orgID = getOrgOfUser(ctx, userID, reducer)
 	}
 	ai := info.ActivityInfoFromContext(ctx)
+	record(ctx, authz.GetInstance(ctx).InstanceID(), userID)
 	triggerLog(
 		authz.GetInstance(ctx).InstanceID(),
 		orgID,
@@ -74,6 +75,7 @@ func Trigger(ctx context.Context, orgID, userID string, trigger TriggerMethod, r
 
 func TriggerGRPCWithContext(ctx context.Context, trigger TriggerMethod) {
 	ai := info.ActivityInfoFromContext(ctx)
+	record(ctx, authz.GetInstance(ctx).InstanceID(), authz.GetCtxData(ctx).UserID)
 	triggerLog(
 		authz.GetInstance(ctx).InstanceID(),
 		authz.GetCtxData(ctx).OrgID,
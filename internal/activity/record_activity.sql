@@ -0,0 +1,10 @@
+INSERT INTO auth.user_activities (
+    instance_id
+    , user_id
+    , last_activity
+) VALUES (
+    $1
+    , $2
+    , $3
+) ON CONFLICT (instance_id, user_id) DO UPDATE SET
+    last_activity = GREATEST(auth.user_activities.last_activity, EXCLUDED.last_activity)
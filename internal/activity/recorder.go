@@ -0,0 +1,98 @@
+package activity
+
+import (
+	"context"
+	_ "embed"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+//go:embed record_activity.sql
+var recordActivityStmt string
+
+const (
+	// recordInterval is the minimal duration between two records of the same user
+	recordInterval = time.Hour
+	// maxRecordedUsers limits the amount of users whose last record is kept in memory
+	maxRecordedUsers = 100000
+)
+
+// Recorder persists the last activity of a user, e.g. to detect inactive users.
+type Recorder interface {
+	Record(ctx context.Context, instanceID, userID string, at time.Time)
+}
+
+var recorder Recorder
+
+// SetRecorder sets the recorder which is called on every [Trigger] of a user.
+// It must be called before the APIs are started.
+func SetRecorder(r Recorder) {
+	recorder = r
+}
+
+func record(ctx context.Context, instanceID, userID string) {
+	if recorder == nil || instanceID == "" || userID == "" {
+		return
+	}
+	recorder.Record(ctx, instanceID, userID, time.Now())
+}
+
+type recordKey struct {
+	instanceID string
+	userID     string
+}
+
+// DBRecorder implements [Recorder] by storing the last activity in the database.
+// To reduce the writes, the activity of a user is stored at most once per [recordInterval] by each process.
+type DBRecorder struct {
+	client *database.DB
+
+	mu       sync.Mutex
+	recorded map[recordKey]time.Time
+}
+
+func NewDBRecorder(client *database.DB) *DBRecorder {
+	return &DBRecorder{
+		client:   client,
+		recorded: make(map[recordKey]time.Time),
+	}
+}
+
+// Record implements [Recorder]
+func (r *DBRecorder) Record(ctx context.Context, instanceID, userID string, at time.Time) {
+	if !r.shouldRecord(recordKey{instanceID: instanceID, userID: userID}, at) {
+		return
+	}
+	_, err := r.client.ExecContext(context.WithoutCancel(ctx), recordActivityStmt, instanceID, userID, at)
+	logging.OnError(err).WithField("instance", instanceID).WithField("user", userID).Warn("unable to record user activity")
+}
+
+func (r *DBRecorder) shouldRecord(key recordKey, at time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if last, ok := r.recorded[key]; ok && at.Sub(last) < recordInterval {
+		return false
+	}
+	if len(r.recorded) >= maxRecordedUsers {
+		r.prune(at)
+	}
+	r.recorded[key] = at
+	return true
+}
+
+// prune removes the outdated records, if it is still full all records are removed.
+// The caller must hold the lock.
+func (r *DBRecorder) prune(now time.Time) {
+	for key, last := range r.recorded {
+		if now.Sub(last) >= recordInterval {
+			delete(r.recorded, key)
+		}
+	}
+	if len(r.recorded) >= maxRecordedUsers {
+		clear(r.recorded)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/userexport"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -129,6 +130,10 @@ func (h *Handler) GetFile() Downloader {
 type publicFileDownloader struct{}
 
 func (l *publicFileDownloader) ObjectName(_ context.Context, path string) (string, error) {
+	// user data exports contain personal data and can only be retrieved through the authenticated API
+	if strings.HasPrefix(path, userexport.ObjectNamePrefix) {
+		return "", nil
+	}
 	return path, nil
 }
 
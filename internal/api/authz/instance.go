@@ -32,6 +32,12 @@ type Instance interface {
 	// AllowUnauthenticatedDynamicClientRegistration states if clients may register without
 	// an access token. It implies EnableDynamicClientRegistration.
 	AllowUnauthenticatedDynamicClientRegistration() bool
+	// PasswordRehashBefore forces password hashes set before the returned time
+	// to be re-hashed on the next successful password check.
+	PasswordRehashBefore() time.Time
+	// InsecurePasswordHashDeadline returns the time after which passwords
+	// still hashed with an insecure algorithm are expired.
+	InsecurePasswordHashDeadline() time.Time
 	Block() *bool
 	AuditLogRetention() *time.Duration
 	Features() feature.Features
@@ -112,6 +118,14 @@ func (i *instance) AllowUnauthenticatedDynamicClientRegistration() bool {
 	return i.enableDCR && i.allowUnauthenticatedDCR
 }
 
+func (i *instance) PasswordRehashBefore() time.Time {
+	return time.Time{}
+}
+
+func (i *instance) InsecurePasswordHashDeadline() time.Time {
+	return time.Time{}
+}
+
 func (i *instance) Features() feature.Features {
 	return i.features
 }
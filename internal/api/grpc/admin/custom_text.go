@@ -492,6 +492,44 @@ func (s *Server) ResetCustomPasswordlessRegistrationMessageTextToDefault(ctx con
 	}, nil
 }
 
+func (s *Server) GetDefaultMessageTemplate(ctx context.Context, req *admin_pb.GetDefaultMessageTemplateRequest) (*admin_pb.GetDefaultMessageTemplateResponse, error) {
+	template, err := s.query.DefaultMailMessageTemplate(ctx, req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetDefaultMessageTemplateResponse{
+		Template: text_grpc.MailMessageTemplateToPb(template),
+	}, nil
+}
+
+func (s *Server) SetDefaultMessageTemplate(ctx context.Context, req *admin_pb.SetDefaultMessageTemplateRequest) (*admin_pb.SetDefaultMessageTemplateResponse, error) {
+	result, err := s.command.SetDefaultMailMessageTemplate(ctx, SetMessageTemplateToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetDefaultMessageTemplateResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomMessageTemplateToDefault(ctx context.Context, req *admin_pb.ResetCustomMessageTemplateToDefaultRequest) (*admin_pb.ResetCustomMessageTemplateToDefaultResponse, error) {
+	result, err := s.command.RemoveDefaultMailMessageTemplate(ctx, req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResetCustomMessageTemplateToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
 func (s *Server) GetDefaultLoginTexts(ctx context.Context, req *admin_pb.GetDefaultLoginTextsRequest) (*admin_pb.GetDefaultLoginTextsResponse, error) {
 	msg, err := s.query.GetDefaultLoginTexts(ctx, req.Language)
 	if err != nil {
@@ -8,6 +8,15 @@ import (
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
+func SetMessageTemplateToDomain(msg *admin_pb.SetDefaultMessageTemplateRequest) *domain.MailMessageTemplate {
+	return &domain.MailMessageTemplate{
+		MessageType: msg.MessageType,
+		Language:    language.Make(msg.Language),
+		HTML:        msg.Html,
+		PlainText:   msg.PlainText,
+	}
+}
+
 func SetInitCustomTextToDomain(msg *admin_pb.SetDefaultInitMessageTextRequest) *domain.CustomMessageText {
 	langTag := language.Make(msg.Language)
 	return &domain.CustomMessageText{
@@ -9,6 +9,7 @@ import (
 
 	authn_grpc "github.com/zitadel/zitadel/internal/api/grpc/authn"
 	"github.com/zitadel/zitadel/internal/api/grpc/org"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
 	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
 	user_converter "github.com/zitadel/zitadel/internal/api/grpc/user"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -402,6 +403,9 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string, orgIDPs []str
 		mfaInitSkip := durationpb.New(time.Duration(queriedLogin.MFAInitSkipLifetime))
 		secondFactor := durationpb.New(time.Duration(queriedLogin.SecondFactorCheckLifetime))
 		multiFactor := durationpb.New(time.Duration(queriedLogin.MultiFactorCheckLifetime))
+		trustedDevice := durationpb.New(time.Duration(queriedLogin.TrustedDeviceLifetime))
+		sessionIdleTimeout := durationpb.New(time.Duration(queriedLogin.SessionIdleTimeout))
+		sessionMaxLifetime := durationpb.New(time.Duration(queriedLogin.SessionMaxLifetime))
 
 		secondFactors := []policy_pb.SecondFactorType{}
 		for _, factor := range queriedLogin.SecondFactors {
@@ -453,6 +457,15 @@ func (s *Server) getLoginPolicy(ctx context.Context, orgID string, orgIDPs []str
 			MfaInitSkipLifetime:        mfaInitSkip,
 			SecondFactorCheckLifetime:  secondFactor,
 			MultiFactorCheckLifetime:   multiFactor,
+			TrustedDeviceLifetime:      trustedDevice,
+			SessionIdleTimeout:         sessionIdleTimeout,
+			SessionMaxLifetime:         sessionMaxLifetime,
+			MaxConcurrentSessions:      queriedLogin.MaxConcurrentSessions,
+			WebauthnAttestation:        policy_grpc.ModelWebAuthNAttestationToPb(queriedLogin.WebAuthNAttestation),
+			WebauthnUserVerification:   policy_grpc.ModelWebAuthNUserVerificationToPb(queriedLogin.WebAuthNUserVerification),
+			WebauthnResidentKey:        policy_grpc.ModelWebAuthNResidentKeyToPb(queriedLogin.WebAuthNResidentKey),
+			WebauthnAllowedAaguids:     queriedLogin.WebAuthNAllowedAAGUIDs,
+			WebauthnDeniedAaguids:      queriedLogin.WebAuthNDeniedAAGUIDs,
 			SecondFactors:              secondFactors,
 			MultiFactors:               multiFactors,
 			Idps:                       idpLinks,
@@ -516,11 +529,12 @@ func (s *Server) getPasswordComplexityPolicy(ctx context.Context, orgID string)
 	}
 	if !queriedPasswordComplexity.IsDefault {
 		return &management_pb.AddCustomPasswordComplexityPolicyRequest{
-			MinLength:    queriedPasswordComplexity.MinLength,
-			HasUppercase: queriedPasswordComplexity.HasUppercase,
-			HasLowercase: queriedPasswordComplexity.HasLowercase,
-			HasNumber:    queriedPasswordComplexity.HasNumber,
-			HasSymbol:    queriedPasswordComplexity.HasSymbol,
+			MinLength:     queriedPasswordComplexity.MinLength,
+			HasUppercase:  queriedPasswordComplexity.HasUppercase,
+			HasLowercase:  queriedPasswordComplexity.HasLowercase,
+			HasNumber:     queriedPasswordComplexity.HasNumber,
+			HasSymbol:     queriedPasswordComplexity.HasSymbol,
+			CheckBreached: queriedPasswordComplexity.CheckBreached,
 		}, nil
 	}
 	return nil, nil
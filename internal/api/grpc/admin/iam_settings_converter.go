@@ -107,6 +107,8 @@ func SecretGeneratorTypeToPb(generatorType domain.SecretGeneratorType) settings_
 		return settings_pb.SecretGeneratorType_SECRET_GENERATOR_TYPE_OTP_EMAIL
 	case domain.SecretGeneratorTypeInviteCode:
 		return settings_pb.SecretGeneratorType_SECRET_GENERATOR_TYPE_INVITE_CODE
+	case domain.SecretGeneratorTypeMagicLink:
+		return settings_pb.SecretGeneratorType_SECRET_GENERATOR_TYPE_MAGIC_LINK
 	default:
 		return settings_pb.SecretGeneratorType_SECRET_GENERATOR_TYPE_UNSPECIFIED
 	}
@@ -132,6 +134,8 @@ func SecretGeneratorTypeToDomain(generatorType settings_pb.SecretGeneratorType)
 		return domain.SecretGeneratorTypeOTPEmail
 	case settings_pb.SecretGeneratorType_SECRET_GENERATOR_TYPE_INVITE_CODE:
 		return domain.SecretGeneratorTypeInviteCode
+	case settings_pb.SecretGeneratorType_SECRET_GENERATOR_TYPE_MAGIC_LINK:
+		return domain.SecretGeneratorTypeMagicLink
 	default:
 		return domain.SecretGeneratorTypeUnspecified
 	}
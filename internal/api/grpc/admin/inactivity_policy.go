@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	policy_grpc "github.com/zitadel/zitadel/internal/api/grpc/policy"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) AddInactivityPolicy(ctx context.Context, req *admin_pb.AddInactivityPolicyRequest) (*admin_pb.AddInactivityPolicyResponse, error) {
+	result, err := s.command.AddDefaultInactivityPolicy(ctx, authz.GetInstance(ctx).InstanceID(), AddInactivityPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddInactivityPolicyResponse{
+		Details: object.AddToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) GetInactivityPolicy(ctx context.Context, _ *admin_pb.GetInactivityPolicyRequest) (*admin_pb.GetInactivityPolicyResponse, error) {
+	policy, err := s.query.DefaultInactivityPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetInactivityPolicyResponse{Policy: policy_grpc.ModelInactivityPolicyToPb(policy)}, nil
+}
+
+func (s *Server) UpdateInactivityPolicy(ctx context.Context, req *admin_pb.UpdateInactivityPolicyRequest) (*admin_pb.UpdateInactivityPolicyResponse, error) {
+	result, err := s.command.ChangeDefaultInactivityPolicy(ctx, authz.GetInstance(ctx).InstanceID(), UpdateInactivityPolicyToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateInactivityPolicyResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
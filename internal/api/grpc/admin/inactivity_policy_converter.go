@@ -0,0 +1,26 @@
+package admin
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func AddInactivityPolicyToDomain(p *admin.AddInactivityPolicyRequest) *domain.InactivityPolicy {
+	return &domain.InactivityPolicy{
+		WarnDays:           uint64(p.GetWarnDays()),
+		DeactivateDays:     uint64(p.GetDeactivateDays()),
+		DeleteDays:         uint64(p.GetDeleteDays()),
+		ExemptMachineUsers: p.GetExemptMachineUsers(),
+		ExemptRoles:        p.GetExemptRoles(),
+	}
+}
+
+func UpdateInactivityPolicyToDomain(p *admin.UpdateInactivityPolicyRequest) *domain.InactivityPolicy {
+	return &domain.InactivityPolicy{
+		WarnDays:           uint64(p.GetWarnDays()),
+		DeactivateDays:     uint64(p.GetDeactivateDays()),
+		DeleteDays:         uint64(p.GetDeleteDays()),
+		ExemptMachineUsers: p.GetExemptMachineUsers(),
+		ExemptRoles:        p.GetExemptRoles(),
+	}
+}
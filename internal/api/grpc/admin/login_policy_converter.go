@@ -21,12 +21,22 @@ func updateLoginPolicyToCommand(p *admin_pb.UpdateLoginPolicyRequest) *command.C
 		AllowDomainDiscovery:       p.AllowDomainDiscovery,
 		DisableLoginWithEmail:      p.DisableLoginWithEmail,
 		DisableLoginWithPhone:      p.DisableLoginWithPhone,
+		AllowMagicLink:             p.AllowMagicLink,
 		DefaultRedirectURI:         p.DefaultRedirectUri,
 		PasswordCheckLifetime:      p.PasswordCheckLifetime.AsDuration(),
 		ExternalLoginCheckLifetime: p.ExternalLoginCheckLifetime.AsDuration(),
 		MFAInitSkipLifetime:        p.MfaInitSkipLifetime.AsDuration(),
 		SecondFactorCheckLifetime:  p.SecondFactorCheckLifetime.AsDuration(),
 		MultiFactorCheckLifetime:   p.MultiFactorCheckLifetime.AsDuration(),
+		TrustedDeviceLifetime:      p.TrustedDeviceLifetime.AsDuration(),
+		SessionIdleTimeout:         p.SessionIdleTimeout.AsDuration(),
+		SessionMaxLifetime:         p.SessionMaxLifetime.AsDuration(),
+		MaxConcurrentSessions:      p.MaxConcurrentSessions,
+		WebAuthNAttestation:        policy_grpc.WebAuthNAttestationToDomain(p.WebauthnAttestation),
+		WebAuthNUserVerification:   policy_grpc.WebAuthNUserVerificationToDomain(p.WebauthnUserVerification),
+		WebAuthNResidentKey:        policy_grpc.WebAuthNResidentKeyToDomain(p.WebauthnResidentKey),
+		WebAuthNAllowedAAGUIDs:     p.WebauthnAllowedAaguids,
+		WebAuthNDeniedAAGUIDs:      p.WebauthnDeniedAaguids,
 	}
 }
 
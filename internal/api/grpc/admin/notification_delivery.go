@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListNotifications(ctx context.Context, req *admin_pb.ListNotificationsRequest) (*admin_pb.ListNotificationsResponse, error) {
+	queries, err := listNotificationsToQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchNotificationDeliveries(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListNotificationsResponse{
+		Result:  user_grpc.NotificationDeliveriesToPb(res.Deliveries),
+		Details: object.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) ResendNotification(ctx context.Context, req *admin_pb.ResendNotificationRequest) (*admin_pb.ResendNotificationResponse, error) {
+	details, err := s.command.ResendNotification(ctx, req.Id, "", "")
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ResendNotificationResponse{
+		Details: object.ChangeToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
+	}, nil
+}
@@ -9,5 +9,6 @@ func UpdatePasswordAgePolicyToDomain(policy *admin_pb.UpdatePasswordAgePolicyReq
 	return &domain.PasswordAgePolicy{
 		MaxAgeDays:     uint64(policy.MaxAgeDays),
 		ExpireWarnDays: uint64(policy.ExpireWarnDays),
+		HistoryDepth:   uint64(policy.HistoryDepth),
 	}
 }
@@ -0,0 +1,17 @@
+package admin
+
+import (
+	"context"
+
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) ListPasswordHashStatistics(ctx context.Context, req *admin_pb.ListPasswordHashStatisticsRequest) (*admin_pb.ListPasswordHashStatisticsResponse, error) {
+	statistics, err := s.query.PasswordHashStatisticsByOrg(ctx, req.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListPasswordHashStatisticsResponse{
+		Result: PasswordHashStatisticsToPb(statistics.Statistics),
+	}, nil
+}
@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"github.com/zitadel/zitadel/internal/query"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func PasswordHashStatisticsToPb(statistics []*query.PasswordHashStatistic) []*admin_pb.PasswordHashStatistic {
+	result := make([]*admin_pb.PasswordHashStatistic, len(statistics))
+	for i, statistic := range statistics {
+		result[i] = &admin_pb.PasswordHashStatistic{
+			OrgId:     statistic.ResourceOwner,
+			Algorithm: statistic.Algorithm,
+			Cost:      statistic.Cost,
+			UserCount: statistic.UserCount,
+			Insecure:  statistic.Insecure,
+		}
+	}
+	return result
+}
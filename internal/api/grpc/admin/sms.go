@@ -88,6 +88,48 @@ func (s *Server) UpdateSMSProviderHTTP(ctx context.Context, req *admin_pb.Update
 	}, nil
 }
 
+func (s *Server) AddSMSProviderWhatsApp(ctx context.Context, req *admin_pb.AddSMSProviderWhatsAppRequest) (*admin_pb.AddSMSProviderWhatsAppResponse, error) {
+	smsConfig := addSMSConfigWhatsAppToConfig(ctx, req)
+	if err := s.command.AddSMSConfigWhatsApp(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderWhatsAppResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderWhatsApp(ctx context.Context, req *admin_pb.UpdateSMSProviderWhatsAppRequest) (*admin_pb.UpdateSMSProviderWhatsAppResponse, error) {
+	smsConfig := updateSMSConfigWhatsAppToConfig(ctx, req)
+	if err := s.command.ChangeSMSConfigWhatsApp(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderWhatsAppResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderVoice(ctx context.Context, req *admin_pb.AddSMSProviderVoiceRequest) (*admin_pb.AddSMSProviderVoiceResponse, error) {
+	smsConfig := addSMSConfigVoiceToConfig(ctx, req)
+	if err := s.command.AddSMSConfigVoice(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderVoiceResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderVoice(ctx context.Context, req *admin_pb.UpdateSMSProviderVoiceRequest) (*admin_pb.UpdateSMSProviderVoiceResponse, error) {
+	smsConfig := updateSMSConfigVoiceToConfig(ctx, req)
+	if err := s.command.ChangeSMSConfigVoice(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderVoiceResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
 func (s *Server) ActivateSMSProvider(ctx context.Context, req *admin_pb.ActivateSMSProviderRequest) (*admin_pb.ActivateSMSProviderResponse, error) {
 	result, err := s.command.ActivateSMSConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
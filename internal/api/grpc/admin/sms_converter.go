@@ -7,6 +7,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
@@ -40,6 +41,7 @@ func SMSConfigToProviderPb(config *query.SMSConfig) *settings_pb.SMSProvider {
 		Description: config.Description,
 		State:       smsStateToPb(config.State),
 		Config:      SMSConfigToPb(config),
+		Channel:     user_grpc.PhoneChannelToPb(config.Channel.OrSMS()),
 	}
 }
 
@@ -50,6 +52,12 @@ func SMSConfigToPb(config *query.SMSConfig) settings_pb.SMSConfig {
 	if config.HTTPConfig != nil {
 		return HTTPConfigToPb(config.HTTPConfig)
 	}
+	if config.WhatsAppConfig != nil {
+		return WhatsAppConfigToPb(config.WhatsAppConfig)
+	}
+	if config.VoiceConfig != nil {
+		return VoiceConfigToPb(config.VoiceConfig)
+	}
 	return nil
 }
 
@@ -72,6 +80,25 @@ func TwilioConfigToPb(twilio *query.Twilio) *settings_pb.SMSProvider_Twilio {
 	}
 }
 
+func WhatsAppConfigToPb(whatsApp *query.WhatsApp) *settings_pb.SMSProvider_Whatsapp {
+	return &settings_pb.SMSProvider_Whatsapp{
+		Whatsapp: &settings_pb.WhatsAppConfig{
+			PhoneNumberId:    whatsApp.PhoneNumberID,
+			TemplateName:     whatsApp.TemplateName,
+			TemplateLanguage: whatsApp.TemplateLanguage,
+		},
+	}
+}
+
+func VoiceConfigToPb(voice *query.Voice) *settings_pb.SMSProvider_Voice {
+	return &settings_pb.SMSProvider_Voice{
+		Voice: &settings_pb.VoiceConfig{
+			Sid:          voice.SID,
+			SenderNumber: voice.SenderNumber,
+		},
+	}
+}
+
 func smsStateToPb(state domain.SMSConfigState) settings_pb.SMSProviderConfigState {
 	switch state {
 	case domain.SMSConfigStateUnspecified, domain.SMSConfigStateRemoved:
@@ -126,3 +153,47 @@ func updateSMSConfigHTTPToConfig(ctx context.Context, req *admin_pb.UpdateSMSPro
 		ExpirationSigningKey: expirationSigningKey,
 	}
 }
+
+func addSMSConfigWhatsAppToConfig(ctx context.Context, req *admin_pb.AddSMSProviderWhatsAppRequest) *command.AddSMSWhatsApp {
+	return &command.AddSMSWhatsApp{
+		ResourceOwner:    authz.GetInstance(ctx).InstanceID(),
+		Description:      req.GetDescription(),
+		PhoneNumberID:    req.GetPhoneNumberId(),
+		AccessToken:      req.GetAccessToken(),
+		TemplateName:     req.GetTemplateName(),
+		TemplateLanguage: req.GetTemplateLanguage(),
+	}
+}
+
+func updateSMSConfigWhatsAppToConfig(ctx context.Context, req *admin_pb.UpdateSMSProviderWhatsAppRequest) *command.ChangeSMSWhatsApp {
+	return &command.ChangeSMSWhatsApp{
+		ResourceOwner:    authz.GetInstance(ctx).InstanceID(),
+		ID:               req.Id,
+		Description:      gu.Ptr(req.Description),
+		PhoneNumberID:    gu.Ptr(req.PhoneNumberId),
+		AccessToken:      gu.Ptr(req.AccessToken),
+		TemplateName:     gu.Ptr(req.TemplateName),
+		TemplateLanguage: gu.Ptr(req.TemplateLanguage),
+	}
+}
+
+func addSMSConfigVoiceToConfig(ctx context.Context, req *admin_pb.AddSMSProviderVoiceRequest) *command.AddSMSVoice {
+	return &command.AddSMSVoice{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		Description:   req.GetDescription(),
+		SID:           req.GetSid(),
+		Token:         req.GetToken(),
+		SenderNumber:  req.GetSenderNumber(),
+	}
+}
+
+func updateSMSConfigVoiceToConfig(ctx context.Context, req *admin_pb.UpdateSMSProviderVoiceRequest) *command.ChangeSMSVoice {
+	return &command.ChangeSMSVoice{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		ID:            req.Id,
+		Description:   gu.Ptr(req.Description),
+		SID:           gu.Ptr(req.Sid),
+		Token:         gu.Ptr(req.Token),
+		SenderNumber:  gu.Ptr(req.SenderNumber),
+	}
+}
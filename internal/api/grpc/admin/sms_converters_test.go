@@ -18,6 +18,7 @@ import (
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 	object_pb "github.com/zitadel/zitadel/pkg/grpc/object"
 	settings_pb "github.com/zitadel/zitadel/pkg/grpc/settings"
+	user_pb "github.com/zitadel/zitadel/pkg/grpc/user"
 )
 
 func Test_listSMSConfigsToModel(t *testing.T) {
@@ -114,6 +115,7 @@ func Test_SMSConfigsToPb(t *testing.T) {
 					Id:          "id",
 					State:       1,
 					Description: "description",
+					Channel:     user_pb.PhoneChannel_PHONE_CHANNEL_SMS,
 					Config: &settings_pb.SMSProvider_Twilio{
 						Twilio: &settings_pb.TwilioConfig{
 							Sid:              "sid",
@@ -132,6 +134,7 @@ func Test_SMSConfigsToPb(t *testing.T) {
 					Id:          "id",
 					State:       1,
 					Description: "description",
+					Channel:     user_pb.PhoneChannel_PHONE_CHANNEL_SMS,
 					Config: &settings_pb.SMSProvider_Http{
 						Http: &settings_pb.HTTPConfig{
 							Endpoint:   "endpoint",
@@ -190,6 +193,7 @@ func Test_SMSConfigToProviderPb(t *testing.T) {
 				Id:          "id",
 				State:       1,
 				Description: "description",
+				Channel:     user_pb.PhoneChannel_PHONE_CHANNEL_SMS,
 				Config: &settings_pb.SMSProvider_Twilio{
 					Twilio: &settings_pb.TwilioConfig{
 						Sid:              "sid",
@@ -227,6 +231,7 @@ func Test_SMSConfigToProviderPb(t *testing.T) {
 				Id:          "id",
 				State:       1,
 				Description: "description",
+				Channel:     user_pb.PhoneChannel_PHONE_CHANNEL_SMS,
 				Config: &settings_pb.SMSProvider_Http{
 					Http: &settings_pb.HTTPConfig{
 						Endpoint:   "endpoint",
@@ -235,6 +240,46 @@ func Test_SMSConfigToProviderPb(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "all fields filled, whatsapp",
+			args: args{
+				req: &query.SMSConfig{
+					CreationDate:  time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+					ChangeDate:    time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+					ResourceOwner: "resourceowner",
+					AggregateID:   "agg",
+					ID:            "id",
+					Sequence:      1,
+					Description:   "description",
+					Channel:       domain.PhoneChannelWhatsApp,
+					WhatsAppConfig: &query.WhatsApp{
+						PhoneNumberID:    "phoneNumberID",
+						TemplateName:     "otp_code",
+						TemplateLanguage: "en",
+					},
+					State: 1,
+				},
+			},
+			res: &settings_pb.SMSProvider{
+				Details: &object_pb.ObjectDetails{
+					Sequence:      1,
+					CreationDate:  timestamppb.New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+					ChangeDate:    timestamppb.New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+					ResourceOwner: "resourceowner",
+				},
+				Id:          "id",
+				State:       1,
+				Description: "description",
+				Channel:     user_pb.PhoneChannel_PHONE_CHANNEL_WHATSAPP,
+				Config: &settings_pb.SMSProvider_Whatsapp{
+					Whatsapp: &settings_pb.WhatsAppConfig{
+						PhoneNumberId:    "phoneNumberID",
+						TemplateName:     "otp_code",
+						TemplateLanguage: "en",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -45,6 +45,9 @@ func CreateOIDCAppRequestToDomain(name, appID, projectID string, req *applicatio
 		IOSBundleID:                   iosBundleID,
 		AndroidPackageName:            androidPackageName,
 		AndroidSHA256CertFingerprints: androidFingerprints,
+		AuthorizationDetailsTypes:     req.GetAuthorizationDetailsTypes(),
+		RequireConsent:                gu.Ptr(req.GetRequireConsent()),
+		TokenExchangePolicy:           tokenExchangePolicyToDomain(req.GetTokenExchangePolicy()),
 	}, nil
 }
 
@@ -82,6 +85,9 @@ func UpdateOIDCAppConfigRequestToDomain(appID, projectID string, app *applicatio
 		IOSBundleID:                   iosBundleID,
 		AndroidPackageName:            androidPackageName,
 		AndroidSHA256CertFingerprints: androidFingerprints,
+		AuthorizationDetailsTypes:     app.AuthorizationDetailsTypes,
+		RequireConsent:                app.RequireConsent,
+		TokenExchangePolicy:           tokenExchangePolicyToDomain(app.GetTokenExchangePolicy()),
 	}, nil
 }
 
@@ -205,29 +211,32 @@ func ComplianceProblemsToLocalizedMessages(complianceProblems []string) []*appli
 func appOIDCConfigToPb(oidcApp *query.OIDCApp) *application.Application_OidcConfiguration {
 	return &application.Application_OidcConfiguration{
 		OidcConfiguration: &application.OIDCConfiguration{
-			RedirectUris:             oidcApp.RedirectURIs,
-			ResponseTypes:            oidcResponseTypesFromModel(oidcApp.ResponseTypes),
-			GrantTypes:               oidcGrantTypesFromModel(oidcApp.GrantTypes),
-			ApplicationType:          oidcApplicationTypeToPb(oidcApp.AppType),
-			ClientId:                 oidcApp.ClientID,
-			AuthMethodType:           oidcAuthMethodTypeToPb(oidcApp.AuthMethodType),
-			PostLogoutRedirectUris:   oidcApp.PostLogoutRedirectURIs,
-			Version:                  application.OIDCVersion_OIDC_VERSION_1_0,
-			NonCompliant:             len(oidcApp.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(oidcApp.ComplianceProblems),
-			DevelopmentMode:          oidcApp.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(oidcApp.AccessTokenType),
-			AccessTokenRoleAssertion: oidcApp.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     oidcApp.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: oidcApp.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(oidcApp.ClockSkew),
-			AdditionalOrigins:        oidcApp.AdditionalOrigins,
-			AllowedOrigins:           oidcApp.AllowedOrigins,
-			SkipNativeAppSuccessPage: oidcApp.SkipNativeAppSuccessPage,
-			BackChannelLogoutUri:     oidcApp.BackChannelLogoutURI,
-			LoginVersion:             loginVersionToPb(oidcApp.LoginVersion, oidcApp.LoginBaseURI),
-			Ios:                      iosAppLinkConfigToPb(oidcApp.IOSTeamID, oidcApp.IOSBundleID),
-			Android:                  androidAppLinkConfigToPb(oidcApp.AndroidPackageName, oidcApp.AndroidSHA256CertFingerprints),
+			RedirectUris:              oidcApp.RedirectURIs,
+			ResponseTypes:             oidcResponseTypesFromModel(oidcApp.ResponseTypes),
+			GrantTypes:                oidcGrantTypesFromModel(oidcApp.GrantTypes),
+			ApplicationType:           oidcApplicationTypeToPb(oidcApp.AppType),
+			ClientId:                  oidcApp.ClientID,
+			AuthMethodType:            oidcAuthMethodTypeToPb(oidcApp.AuthMethodType),
+			PostLogoutRedirectUris:    oidcApp.PostLogoutRedirectURIs,
+			Version:                   application.OIDCVersion_OIDC_VERSION_1_0,
+			NonCompliant:              len(oidcApp.ComplianceProblems) != 0,
+			ComplianceProblems:        ComplianceProblemsToLocalizedMessages(oidcApp.ComplianceProblems),
+			DevelopmentMode:           oidcApp.IsDevMode,
+			AccessTokenType:           oidcTokenTypeToPb(oidcApp.AccessTokenType),
+			AccessTokenRoleAssertion:  oidcApp.AssertAccessTokenRole,
+			IdTokenRoleAssertion:      oidcApp.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:  oidcApp.AssertIDTokenUserinfo,
+			ClockSkew:                 durationpb.New(oidcApp.ClockSkew),
+			AdditionalOrigins:         oidcApp.AdditionalOrigins,
+			AllowedOrigins:            oidcApp.AllowedOrigins,
+			SkipNativeAppSuccessPage:  oidcApp.SkipNativeAppSuccessPage,
+			BackChannelLogoutUri:      oidcApp.BackChannelLogoutURI,
+			LoginVersion:              loginVersionToPb(oidcApp.LoginVersion, oidcApp.LoginBaseURI),
+			Ios:                       iosAppLinkConfigToPb(oidcApp.IOSTeamID, oidcApp.IOSBundleID),
+			Android:                   androidAppLinkConfigToPb(oidcApp.AndroidPackageName, oidcApp.AndroidSHA256CertFingerprints),
+			AuthorizationDetailsTypes: oidcApp.AuthorizationDetailsTypes,
+			RequireConsent:            oidcApp.RequireConsent,
+			TokenExchangePolicy:       tokenExchangePolicyToPb(oidcApp.TokenExchangePolicy),
 		},
 	}
 }
@@ -270,6 +279,30 @@ func androidAppLinkConfigToPb(packageName string, fingerprints []string) *applic
 	}
 }
 
+func tokenExchangePolicyToDomain(policy *application.TokenExchangePolicy) *domain.TokenExchangePolicy {
+	if policy == nil {
+		return nil
+	}
+	return &domain.TokenExchangePolicy{
+		SubjectTokenTypes: policy.GetSubjectTokenTypes(),
+		Audiences:         policy.GetAudiences(),
+		Scopes:            policy.GetScopes(),
+		DenyNestedActors:  policy.GetDenyNestedActors(),
+	}
+}
+
+func tokenExchangePolicyToPb(policy *domain.TokenExchangePolicy) *application.TokenExchangePolicy {
+	if policy.IsZero() {
+		return nil
+	}
+	return &application.TokenExchangePolicy{
+		SubjectTokenTypes: policy.SubjectTokenTypes,
+		Audiences:         policy.Audiences,
+		Scopes:            policy.Scopes,
+		DenyNestedActors:  policy.DenyNestedActors,
+	}
+}
+
 func oidcResponseTypesFromModel(responseTypes []domain.OIDCResponseType) []application.OIDCResponseType {
 	oidcResponseTypes := make([]application.OIDCResponseType, len(responseTypes))
 	for i, responseType := range responseTypes {
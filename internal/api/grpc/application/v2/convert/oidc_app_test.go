@@ -74,6 +74,12 @@ func TestCreateOIDCAppRequestToDomain(t *testing.T) {
 					PackageName:            "com.example.app",
 					Sha256CertFingerprints: []string{"AA:BB:CC"},
 				},
+				AuthorizationDetailsTypes: []string{"payment_initiation"},
+				RequireConsent:            true,
+				TokenExchangePolicy: &application.TokenExchangePolicy{
+					Audiences:        []string{"api"},
+					DenyNestedActors: true,
+				},
 			},
 			expectedModel: &domain.OIDCApp{
 				ObjectRoot:                    models.ObjectRoot{AggregateID: "project1"},
@@ -101,6 +107,12 @@ func TestCreateOIDCAppRequestToDomain(t *testing.T) {
 				IOSBundleID:                   gu.Ptr("com.example.app"),
 				AndroidPackageName:            gu.Ptr("com.example.app"),
 				AndroidSHA256CertFingerprints: []string{"AA:BB:CC"},
+				AuthorizationDetailsTypes:     []string{"payment_initiation"},
+				RequireConsent:                gu.Ptr(true),
+				TokenExchangePolicy: &domain.TokenExchangePolicy{
+					Audiences:        []string{"api"},
+					DenyNestedActors: true,
+				},
 			},
 		},
 	}
@@ -554,6 +566,9 @@ func TestAppOIDCConfigToPb(t *testing.T) {
 				IOSBundleID:                   "com.example.app",
 				AndroidPackageName:            "com.example.app",
 				AndroidSHA256CertFingerprints: []string{"AA:BB:CC"},
+				AuthorizationDetailsTypes:     []string{"payment_initiation"},
+				RequireConsent:                true,
+				TokenExchangePolicy:           &domain.TokenExchangePolicy{Scopes: []string{"openid"}},
 			},
 			expected: &application.Application_OidcConfiguration{
 				OidcConfiguration: &application.OIDCConfiguration{
@@ -595,6 +610,9 @@ func TestAppOIDCConfigToPb(t *testing.T) {
 						PackageName:            "com.example.app",
 						Sha256CertFingerprints: []string{"AA:BB:CC"},
 					},
+					AuthorizationDetailsTypes: []string{"payment_initiation"},
+					RequireConsent:            true,
+					TokenExchangePolicy:       &application.TokenExchangePolicy{Scopes: []string{"openid"}},
 				},
 			},
 		},
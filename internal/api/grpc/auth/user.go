@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/change"
@@ -238,8 +239,12 @@ func (s *Server) myOrgsQuery(ctx context.Context, ctxData authz.CtxData) (*query
 	if err != nil {
 		return nil, err
 	}
+	validQuery, err := query.NewMembershipValidAtQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
 	return s.query.Memberships(ctx, &query.MembershipSearchQuery{
-		Queries: []query.SearchQuery{userQuery},
+		Queries: []query.SearchQuery{userQuery, validQuery},
 	}, false)
 }
 
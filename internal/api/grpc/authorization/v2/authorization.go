@@ -2,6 +2,7 @@ package authorization
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -19,6 +20,7 @@ func (s *Server) CreateAuthorization(ctx context.Context, req *connect.Request[a
 		ObjectRoot: models.ObjectRoot{
 			ResourceOwner: req.Msg.GetOrganizationId(),
 		},
+		Validity: validityToDomain(req.Msg.GetValidity()),
 	}
 	grant, err := s.command.AddUserGrant(ctx, grant, s.command.NewPermissionCheckUserGrantWrite(ctx))
 	if err != nil {
@@ -36,6 +38,7 @@ func (s *Server) UpdateAuthorization(ctx context.Context, request *connect.Reque
 			AggregateID: request.Msg.Id,
 		},
 		RoleKeys: request.Msg.RoleKeys,
+		Validity: validityToDomain(request.Msg.GetValidity()),
 	}, true, true, s.command.NewPermissionCheckUserGrantWrite(ctx))
 	if err != nil {
 		return nil, err
@@ -74,3 +77,20 @@ func (s *Server) DeactivateAuthorization(ctx context.Context, request *connect.R
 		ChangeDate: timestamppb.New(details.EventDate),
 	}), nil
 }
+
+func validityToDomain(validity *authorization.Validity) *domain.Validity {
+	if validity == nil {
+		return nil
+	}
+	return &domain.Validity{
+		ValidFrom:  pbToTime(validity.GetValidFrom()),
+		ValidUntil: pbToTime(validity.GetValidUntil()),
+	}
+}
+
+func pbToTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
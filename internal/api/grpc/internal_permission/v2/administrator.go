@@ -2,12 +2,14 @@ package internal_permission
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 	"github.com/zitadel/zitadel/pkg/grpc/internal_permission/v2"
 )
@@ -18,7 +20,7 @@ func (s *Server) CreateAdministrator(ctx context.Context, req *connect.Request[i
 	switch resource := req.Msg.GetResource().GetResource().(type) {
 	case *internal_permission.ResourceType_Instance:
 		if resource.Instance {
-			member, err := s.command.AddInstanceMember(ctx, createAdministratorInstanceToCommand(authz.GetInstance(ctx).InstanceID(), req.Msg.UserId, req.Msg.Roles))
+			member, err := s.command.AddInstanceMember(ctx, createAdministratorInstanceToCommand(authz.GetInstance(ctx).InstanceID(), req.Msg.UserId, req.Msg.Roles, validityToDomain(req.Msg.GetValidity())))
 			if err != nil {
 				return nil, err
 			}
@@ -27,7 +29,7 @@ func (s *Server) CreateAdministrator(ctx context.Context, req *connect.Request[i
 			}
 		}
 	case *internal_permission.ResourceType_OrganizationId:
-		member, err := s.command.AddOrgMember(ctx, createAdministratorOrganizationToCommand(resource, req.Msg.UserId, req.Msg.Roles))
+		member, err := s.command.AddOrgMember(ctx, createAdministratorOrganizationToCommand(resource, req.Msg.UserId, req.Msg.Roles, validityToDomain(req.Msg.GetValidity())))
 		if err != nil {
 			return nil, err
 		}
@@ -35,7 +37,7 @@ func (s *Server) CreateAdministrator(ctx context.Context, req *connect.Request[i
 			creationDate = timestamppb.New(member.EventDate)
 		}
 	case *internal_permission.ResourceType_ProjectId:
-		member, err := s.command.AddProjectMember(ctx, createAdministratorProjectToCommand(resource, req.Msg.UserId, req.Msg.Roles))
+		member, err := s.command.AddProjectMember(ctx, createAdministratorProjectToCommand(resource, req.Msg.UserId, req.Msg.Roles, validityToDomain(req.Msg.GetValidity())))
 		if err != nil {
 			return nil, err
 		}
@@ -43,6 +45,9 @@ func (s *Server) CreateAdministrator(ctx context.Context, req *connect.Request[i
 			creationDate = timestamppb.New(member.EventDate)
 		}
 	case *internal_permission.ResourceType_ProjectGrant_:
+		if req.Msg.GetValidity() != nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-Ohm2a", "Errors.Member.ValidityNotSupported")
+		}
 		member, err := s.command.AddProjectGrantMember(ctx, createAdministratorProjectGrantToCommand(resource, req.Msg.UserId, req.Msg.Roles))
 		if err != nil {
 			return nil, err
@@ -59,27 +64,30 @@ func (s *Server) CreateAdministrator(ctx context.Context, req *connect.Request[i
 	}), nil
 }
 
-func createAdministratorInstanceToCommand(instanceID, userID string, roles []string) *command.AddInstanceMember {
+func createAdministratorInstanceToCommand(instanceID, userID string, roles []string, validity *domain.Validity) *command.AddInstanceMember {
 	return &command.AddInstanceMember{
 		InstanceID: instanceID,
 		UserID:     userID,
 		Roles:      roles,
+		Validity:   validity,
 	}
 }
 
-func createAdministratorOrganizationToCommand(req *internal_permission.ResourceType_OrganizationId, userID string, roles []string) *command.AddOrgMember {
+func createAdministratorOrganizationToCommand(req *internal_permission.ResourceType_OrganizationId, userID string, roles []string, validity *domain.Validity) *command.AddOrgMember {
 	return &command.AddOrgMember{
-		OrgID:  req.OrganizationId,
-		UserID: userID,
-		Roles:  roles,
+		OrgID:    req.OrganizationId,
+		UserID:   userID,
+		Roles:    roles,
+		Validity: validity,
 	}
 }
 
-func createAdministratorProjectToCommand(req *internal_permission.ResourceType_ProjectId, userID string, roles []string) *command.AddProjectMember {
+func createAdministratorProjectToCommand(req *internal_permission.ResourceType_ProjectId, userID string, roles []string, validity *domain.Validity) *command.AddProjectMember {
 	return &command.AddProjectMember{
 		ProjectID: req.ProjectId,
 		UserID:    userID,
 		Roles:     roles,
+		Validity:  validity,
 	}
 }
 
@@ -98,7 +106,7 @@ func (s *Server) UpdateAdministrator(ctx context.Context, req *connect.Request[i
 	switch resource := req.Msg.GetResource().GetResource().(type) {
 	case *internal_permission.ResourceType_Instance:
 		if resource.Instance {
-			member, err := s.command.ChangeInstanceMember(ctx, updateAdministratorInstanceToCommand(authz.GetInstance(ctx).InstanceID(), req.Msg.UserId, req.Msg.Roles))
+			member, err := s.command.ChangeInstanceMember(ctx, updateAdministratorInstanceToCommand(authz.GetInstance(ctx).InstanceID(), req.Msg.UserId, req.Msg.Roles, validityToDomain(req.Msg.GetValidity())))
 			if err != nil {
 				return nil, err
 			}
@@ -107,7 +115,7 @@ func (s *Server) UpdateAdministrator(ctx context.Context, req *connect.Request[i
 			}
 		}
 	case *internal_permission.ResourceType_OrganizationId:
-		member, err := s.command.ChangeOrgMember(ctx, updateAdministratorOrganizationToCommand(resource, req.Msg.UserId, req.Msg.Roles))
+		member, err := s.command.ChangeOrgMember(ctx, updateAdministratorOrganizationToCommand(resource, req.Msg.UserId, req.Msg.Roles, validityToDomain(req.Msg.GetValidity())))
 		if err != nil {
 			return nil, err
 		}
@@ -115,7 +123,7 @@ func (s *Server) UpdateAdministrator(ctx context.Context, req *connect.Request[i
 			changeDate = timestamppb.New(member.EventDate)
 		}
 	case *internal_permission.ResourceType_ProjectId:
-		member, err := s.command.ChangeProjectMember(ctx, updateAdministratorProjectToCommand(resource, req.Msg.UserId, req.Msg.Roles))
+		member, err := s.command.ChangeProjectMember(ctx, updateAdministratorProjectToCommand(resource, req.Msg.UserId, req.Msg.Roles, validityToDomain(req.Msg.GetValidity())))
 		if err != nil {
 			return nil, err
 		}
@@ -123,6 +131,9 @@ func (s *Server) UpdateAdministrator(ctx context.Context, req *connect.Request[i
 			changeDate = timestamppb.New(member.EventDate)
 		}
 	case *internal_permission.ResourceType_ProjectGrant_:
+		if req.Msg.GetValidity() != nil {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-ieW7u", "Errors.Member.ValidityNotSupported")
+		}
 		member, err := s.command.ChangeProjectGrantMember(ctx, updateAdministratorProjectGrantToCommand(resource, req.Msg.UserId, req.Msg.Roles))
 		if err != nil {
 			return nil, err
@@ -139,27 +150,30 @@ func (s *Server) UpdateAdministrator(ctx context.Context, req *connect.Request[i
 	}), nil
 }
 
-func updateAdministratorInstanceToCommand(instanceID, userID string, roles []string) *command.ChangeInstanceMember {
+func updateAdministratorInstanceToCommand(instanceID, userID string, roles []string, validity *domain.Validity) *command.ChangeInstanceMember {
 	return &command.ChangeInstanceMember{
 		InstanceID: instanceID,
 		UserID:     userID,
 		Roles:      roles,
+		Validity:   validity,
 	}
 }
 
-func updateAdministratorOrganizationToCommand(req *internal_permission.ResourceType_OrganizationId, userID string, roles []string) *command.ChangeOrgMember {
+func updateAdministratorOrganizationToCommand(req *internal_permission.ResourceType_OrganizationId, userID string, roles []string, validity *domain.Validity) *command.ChangeOrgMember {
 	return &command.ChangeOrgMember{
-		OrgID:  req.OrganizationId,
-		UserID: userID,
-		Roles:  roles,
+		OrgID:    req.OrganizationId,
+		UserID:   userID,
+		Roles:    roles,
+		Validity: validity,
 	}
 }
 
-func updateAdministratorProjectToCommand(req *internal_permission.ResourceType_ProjectId, userID string, roles []string) *command.ChangeProjectMember {
+func updateAdministratorProjectToCommand(req *internal_permission.ResourceType_ProjectId, userID string, roles []string, validity *domain.Validity) *command.ChangeProjectMember {
 	return &command.ChangeProjectMember{
 		ProjectID: req.ProjectId,
 		UserID:    userID,
 		Roles:     roles,
+		Validity:  validity,
 	}
 }
 
@@ -172,6 +186,23 @@ func updateAdministratorProjectGrantToCommand(req *internal_permission.ResourceT
 	}
 }
 
+func validityToDomain(validity *internal_permission.Validity) *domain.Validity {
+	if validity == nil {
+		return nil
+	}
+	return &domain.Validity{
+		ValidFrom:  pbToTime(validity.GetValidFrom()),
+		ValidUntil: pbToTime(validity.GetValidUntil()),
+	}
+}
+
+func pbToTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
 func (s *Server) DeleteAdministrator(ctx context.Context, req *connect.Request[internal_permission.DeleteAdministratorRequest]) (*connect.Response[internal_permission.DeleteAdministratorResponse], error) {
 	var deletionDate *timestamppb.Timestamp
 
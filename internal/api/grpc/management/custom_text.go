@@ -9,6 +9,8 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	text_grpc "github.com/zitadel/zitadel/internal/api/grpc/text"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
 )
 
@@ -502,6 +504,72 @@ func (s *Server) GetCustomLoginTexts(ctx context.Context, req *mgmt_pb.GetCustom
 	}, nil
 }
 
+func (s *Server) GetMessageTemplate(ctx context.Context, req *mgmt_pb.GetMessageTemplateRequest) (*mgmt_pb.GetMessageTemplateResponse, error) {
+	template, err := s.query.MailMessageTemplateByOrg(ctx, authz.GetCtxData(ctx).OrgID, req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetMessageTemplateResponse{
+		Template: text_grpc.MailMessageTemplateToPb(template),
+	}, nil
+}
+
+func (s *Server) SetCustomMessageTemplate(ctx context.Context, req *mgmt_pb.SetCustomMessageTemplateRequest) (*mgmt_pb.SetCustomMessageTemplateResponse, error) {
+	result, err := s.command.SetOrgMailMessageTemplate(ctx, authz.GetCtxData(ctx).OrgID, SetMessageTemplateToDomain(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetCustomMessageTemplateResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) ResetCustomMessageTemplateToDefault(ctx context.Context, req *mgmt_pb.ResetCustomMessageTemplateToDefaultRequest) (*mgmt_pb.ResetCustomMessageTemplateToDefaultResponse, error) {
+	result, err := s.command.RemoveOrgMailMessageTemplate(ctx, authz.GetCtxData(ctx).OrgID, req.MessageType, language.Make(req.Language))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResetCustomMessageTemplateToDefaultResponse{
+		Details: object.ChangeToDetailsPb(
+			result.Sequence,
+			result.EventDate,
+			result.ResourceOwner,
+		),
+	}, nil
+}
+
+func (s *Server) PreviewMessage(ctx context.Context, req *mgmt_pb.PreviewMessageRequest) (*mgmt_pb.PreviewMessageResponse, error) {
+	orgID := authz.GetCtxData(ctx).OrgID
+	draft, err := PreviewMessageDraftToQuery(req)
+	if err != nil {
+		return nil, err
+	}
+	user := types.PreviewUser(orgID, language.Make(req.Language))
+	if req.UserId != "" {
+		user, err = s.query.GetNotifyUserByID(ctx, true, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if user.ResourceOwner != orgID {
+			return nil, zerrors.ThrowNotFound(nil, "MANAG-oo7Ae", "Errors.User.NotFound")
+		}
+		user.PreferredLanguage = language.Make(req.Language)
+	}
+	preview, err := types.PreviewEmail(ctx, s.query, user, req.MessageType, draft)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.PreviewMessageResponse{
+		Subject:   preview.Subject,
+		Html:      preview.HTML,
+		PlainText: preview.PlainText,
+	}, nil
+}
+
 func (s *Server) GetDefaultLoginTexts(ctx context.Context, req *mgmt_pb.GetDefaultLoginTextsRequest) (*mgmt_pb.GetDefaultLoginTextsResponse, error) {
 	msg, err := s.query.IAMLoginTexts(ctx, req.Language)
 	if err != nil {
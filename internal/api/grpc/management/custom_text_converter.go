@@ -5,9 +5,47 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/grpc/text"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
 )
 
+func SetMessageTemplateToDomain(msg *mgmt_pb.SetCustomMessageTemplateRequest) *domain.MailMessageTemplate {
+	return &domain.MailMessageTemplate{
+		MessageType: msg.MessageType,
+		Language:    language.Make(msg.Language),
+		HTML:        msg.Html,
+		PlainText:   msg.PlainText,
+	}
+}
+
+// PreviewMessageDraftToQuery returns the draft template of the preview request, if any is set.
+// The draft is validated as it would be on setting it, so errors in the template are shown before saving it.
+func PreviewMessageDraftToQuery(msg *mgmt_pb.PreviewMessageRequest) (*query.MailMessageTemplate, error) {
+	if !domain.IsMessageTextType(msg.MessageType) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "MANAG-Ea4ph", "Errors.MailMessageTemplate.Invalid")
+	}
+	if msg.Html == "" && msg.PlainText == "" {
+		return nil, nil
+	}
+	draft := &domain.MailMessageTemplate{
+		MessageType: msg.MessageType,
+		Language:    language.Make(msg.Language),
+		HTML:        msg.Html,
+		PlainText:   msg.PlainText,
+	}
+	if err := draft.IsValid(i18n.SupportedLanguages()); err != nil {
+		return nil, err
+	}
+	return &query.MailMessageTemplate{
+		MessageType: draft.MessageType,
+		Language:    draft.Language,
+		HTML:        draft.HTML,
+		PlainText:   draft.PlainText,
+	}, nil
+}
+
 func SetInitCustomTextToDomain(msg *mgmt_pb.SetCustomInitMessageTextRequest) *domain.CustomMessageText {
 	langTag := language.Make(msg.Language)
 	return &domain.CustomMessageText{
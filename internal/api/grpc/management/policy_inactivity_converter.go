@@ -0,0 +1,26 @@
+package management
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	mgmt "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func AddInactivityPolicyToDomain(p *mgmt.AddCustomInactivityPolicyRequest) *domain.InactivityPolicy {
+	return &domain.InactivityPolicy{
+		WarnDays:           uint64(p.GetWarnDays()),
+		DeactivateDays:     uint64(p.GetDeactivateDays()),
+		DeleteDays:         uint64(p.GetDeleteDays()),
+		ExemptMachineUsers: p.GetExemptMachineUsers(),
+		ExemptRoles:        p.GetExemptRoles(),
+	}
+}
+
+func UpdateInactivityPolicyToDomain(p *mgmt.UpdateCustomInactivityPolicyRequest) *domain.InactivityPolicy {
+	return &domain.InactivityPolicy{
+		WarnDays:           uint64(p.GetWarnDays()),
+		DeactivateDays:     uint64(p.GetDeactivateDays()),
+		DeleteDays:         uint64(p.GetDeleteDays()),
+		ExemptMachineUsers: p.GetExemptMachineUsers(),
+		ExemptRoles:        p.GetExemptRoles(),
+	}
+}
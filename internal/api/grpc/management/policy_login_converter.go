@@ -26,11 +26,21 @@ func AddLoginPolicyToCommand(p *mgmt_pb.AddCustomLoginPolicyRequest) *command.Ad
 		MFAInitSkipLifetime:        p.MfaInitSkipLifetime.AsDuration(),
 		SecondFactorCheckLifetime:  p.SecondFactorCheckLifetime.AsDuration(),
 		MultiFactorCheckLifetime:   p.MultiFactorCheckLifetime.AsDuration(),
+		TrustedDeviceLifetime:      p.TrustedDeviceLifetime.AsDuration(),
+		SessionIdleTimeout:         p.SessionIdleTimeout.AsDuration(),
+		SessionMaxLifetime:         p.SessionMaxLifetime.AsDuration(),
+		MaxConcurrentSessions:      p.MaxConcurrentSessions,
+		WebAuthNAttestation:        policy_grpc.WebAuthNAttestationToDomain(p.WebauthnAttestation),
+		WebAuthNUserVerification:   policy_grpc.WebAuthNUserVerificationToDomain(p.WebauthnUserVerification),
+		WebAuthNResidentKey:        policy_grpc.WebAuthNResidentKeyToDomain(p.WebauthnResidentKey),
+		WebAuthNAllowedAAGUIDs:     p.WebauthnAllowedAaguids,
+		WebAuthNDeniedAAGUIDs:      p.WebauthnDeniedAaguids,
 		SecondFactors:              policy_grpc.SecondFactorsTypesToDomain(p.SecondFactors),
 		MultiFactors:               policy_grpc.MultiFactorsTypesToDomain(p.MultiFactors),
 		IDPProviders:               addLoginPolicyIDPsToCommand(p.Idps),
 		DisableLoginWithEmail:      p.DisableLoginWithEmail,
 		DisableLoginWithPhone:      p.DisableLoginWithPhone,
+		AllowMagicLink:             p.AllowMagicLink,
 	}
 }
 func addLoginPolicyIDPsToCommand(idps []*mgmt_pb.AddCustomLoginPolicyRequest_IDP) []*command.AddLoginPolicyIDP {
@@ -57,12 +67,22 @@ func updateLoginPolicyToCommand(p *mgmt_pb.UpdateCustomLoginPolicyRequest) *comm
 		AllowDomainDiscovery:       p.AllowDomainDiscovery,
 		DisableLoginWithEmail:      p.DisableLoginWithEmail,
 		DisableLoginWithPhone:      p.DisableLoginWithPhone,
+		AllowMagicLink:             p.AllowMagicLink,
 		DefaultRedirectURI:         p.DefaultRedirectUri,
 		PasswordCheckLifetime:      p.PasswordCheckLifetime.AsDuration(),
 		ExternalLoginCheckLifetime: p.ExternalLoginCheckLifetime.AsDuration(),
 		MFAInitSkipLifetime:        p.MfaInitSkipLifetime.AsDuration(),
 		SecondFactorCheckLifetime:  p.SecondFactorCheckLifetime.AsDuration(),
 		MultiFactorCheckLifetime:   p.MultiFactorCheckLifetime.AsDuration(),
+		TrustedDeviceLifetime:      p.TrustedDeviceLifetime.AsDuration(),
+		SessionIdleTimeout:         p.SessionIdleTimeout.AsDuration(),
+		SessionMaxLifetime:         p.SessionMaxLifetime.AsDuration(),
+		MaxConcurrentSessions:      p.MaxConcurrentSessions,
+		WebAuthNAttestation:        policy_grpc.WebAuthNAttestationToDomain(p.WebauthnAttestation),
+		WebAuthNUserVerification:   policy_grpc.WebAuthNUserVerificationToDomain(p.WebauthnUserVerification),
+		WebAuthNResidentKey:        policy_grpc.WebAuthNResidentKeyToDomain(p.WebauthnResidentKey),
+		WebAuthNAllowedAAGUIDs:     p.WebauthnAllowedAaguids,
+		WebAuthNDeniedAAGUIDs:      p.WebauthnDeniedAaguids,
 	}
 }
 
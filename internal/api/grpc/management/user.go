@@ -115,6 +115,31 @@ func (s *Server) ListUserChanges(ctx context.Context, req *mgmt_pb.ListUserChang
 	}, nil
 }
 
+func (s *Server) ListUserNotifications(ctx context.Context, req *mgmt_pb.ListUserNotificationsRequest) (*mgmt_pb.ListUserNotificationsResponse, error) {
+	queries, err := ListUserNotificationsRequestToQuery(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchNotificationDeliveries(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ListUserNotificationsResponse{
+		Result:  user_grpc.NotificationDeliveriesToPb(res.Deliveries),
+		Details: obj_grpc.ToListDetails(res.Count, res.Sequence, res.LastRun),
+	}, nil
+}
+
+func (s *Server) ResendUserNotification(ctx context.Context, req *mgmt_pb.ResendUserNotificationRequest) (*mgmt_pb.ResendUserNotificationResponse, error) {
+	details, err := s.command.ResendNotification(ctx, req.NotificationId, authz.GetCtxData(ctx).OrgID, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.ResendUserNotificationResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) IsUserUnique(ctx context.Context, req *mgmt_pb.IsUserUniqueRequest) (*mgmt_pb.IsUserUniqueResponse, error) {
 	orgID := authz.GetCtxData(ctx).OrgID
 	policy, err := s.query.DomainPolicyByOrg(ctx, true, orgID, false)
@@ -548,6 +573,16 @@ func (s *Server) RemoveHumanPhone(ctx context.Context, req *mgmt_pb.RemoveHumanP
 	}, nil
 }
 
+func (s *Server) SetHumanPhoneChannel(ctx context.Context, req *mgmt_pb.SetHumanPhoneChannelRequest) (*mgmt_pb.SetHumanPhoneChannelResponse, error) {
+	objectDetails, err := s.command.SetHumanPhoneChannel(ctx, req.UserId, authz.GetCtxData(ctx).OrgID, user_grpc.PhoneChannelToDomain(req.Channel))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.SetHumanPhoneChannelResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(objectDetails),
+	}, nil
+}
+
 func (s *Server) ResendHumanPhoneVerification(ctx context.Context, req *mgmt_pb.ResendHumanPhoneVerificationRequest) (*mgmt_pb.ResendHumanPhoneVerificationResponse, error) {
 	objectDetails, err := s.command.CreateHumanPhoneVerificationCode(ctx, req.UserId, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
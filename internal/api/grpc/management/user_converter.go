@@ -73,6 +73,30 @@ func BulkSetUserMetadataToDomain(req *mgmt_pb.BulkSetUserMetadataRequest) []*dom
 	return metadata
 }
 
+func ListUserNotificationsRequestToQuery(ctx context.Context, req *mgmt_pb.ListUserNotificationsRequest) (*query.NotificationDeliverySearchQueries, error) {
+	offset, limit, asc := object.ListQueryToModel(req.Query)
+	queries, err := user_grpc.NotificationDeliveryQueriesToQuery(req.Queries)
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := query.NewNotificationDeliveryUserIDSearchQuery(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	resourceOwnerQuery, err := query.NewNotificationDeliveryResourceOwnerSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &query.NotificationDeliverySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: append(queries, userIDQuery, resourceOwnerQuery),
+	}, nil
+}
+
 func ListUserMetadataToDomain(req *mgmt_pb.ListUserMetadataRequest) (*query.UserMetadataSearchQueries, error) {
 	offset, limit, asc := object.ListQueryToModel(req.Query)
 	queries, err := metadata.UserMetadataQueriesToQuery(req.Queries)
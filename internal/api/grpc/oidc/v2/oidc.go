@@ -2,11 +2,14 @@ package oidc
 
 import (
 	"context"
+	"encoding/json"
 
 	"connectrpc.com/connect"
 	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v3/pkg/op"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
@@ -24,8 +27,12 @@ func (s *Server) GetAuthRequest(ctx context.Context, req *connect.Request[oidc_p
 		logging.WithError(err).Error("query authRequest by ID")
 		return nil, err
 	}
+	pbAuthRequest, err := authRequestToPb(authRequest)
+	if err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&oidc_pb.GetAuthRequestResponse{
-		AuthRequest: authRequestToPb(authRequest),
+		AuthRequest: pbAuthRequest,
 	}), nil
 }
 
@@ -77,22 +84,47 @@ func (s *Server) AuthorizeOrDenyDeviceAuthorization(ctx context.Context, req *co
 	return connect.NewResponse(&oidc_pb.AuthorizeOrDenyDeviceAuthorizationResponse{}), nil
 }
 
-func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
+func authRequestToPb(a *query.AuthRequest) (*oidc_pb.AuthRequest, error) {
 	pba := &oidc_pb.AuthRequest{
-		Id:           a.ID,
-		CreationDate: timestamppb.New(a.CreationDate),
-		ClientId:     a.ClientID,
-		Scope:        a.Scope,
-		RedirectUri:  a.RedirectURI,
-		Prompt:       promptsToPb(a.Prompt),
-		UiLocales:    a.UiLocales,
-		LoginHint:    a.LoginHint,
-		HintUserId:   a.HintUserID,
+		Id:             a.ID,
+		CreationDate:   timestamppb.New(a.CreationDate),
+		ClientId:       a.ClientID,
+		Scope:          a.Scope,
+		RedirectUri:    a.RedirectURI,
+		Prompt:         promptsToPb(a.Prompt),
+		UiLocales:      a.UiLocales,
+		LoginHint:      a.LoginHint,
+		HintUserId:     a.HintUserID,
+		RequireConsent: a.RequireConsent,
+		AcrValues:      a.ACRValues,
 	}
 	if a.MaxAge != nil {
 		pba.MaxAge = durationpb.New(*a.MaxAge)
 	}
-	return pba
+	var err error
+	pba.AuthorizationDetails, err = authorizationDetailsToPb(a.AuthorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+	return pba, nil
+}
+
+func authorizationDetailsToPb(details domain.AuthorizationDetails) ([]*structpb.Struct, error) {
+	if len(details) == 0 {
+		return nil, nil
+	}
+	out := make([]*structpb.Struct, len(details))
+	for i, detail := range details {
+		data, err := json.Marshal(detail)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "OIDCv2-Iech6", "Errors.Internal")
+		}
+		out[i] = new(structpb.Struct)
+		if err = protojson.Unmarshal(data, out[i]); err != nil {
+			return nil, zerrors.ThrowInternal(err, "OIDCv2-aeD3k", "Errors.Internal")
+		}
+	}
+	return out, nil
 }
 
 func promptsToPb(promps []domain.Prompt) []oidc_pb.Prompt {
@@ -153,7 +185,7 @@ func (s *Server) failAuthRequest(ctx context.Context, authRequestID string, ae *
 }
 
 func (s *Server) linkSessionToAuthRequest(ctx context.Context, authRequestID string, session *oidc_pb.Session) (*connect.Response[oidc_pb.CreateCallbackResponse], error) {
-	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true, s.checkPermission)
+	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true, s.checkPermission, session.GetConsentGranted())
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +88,26 @@ func (s *Server) ActivateOrganization(ctx context.Context, request *connect.Requ
 	}), err
 }
 
+func (s *Server) SetOrganizationParent(ctx context.Context, request *connect.Request[org.SetOrganizationParentRequest]) (*connect.Response[org.SetOrganizationParentResponse], error) {
+	objectDetails, err := s.command.SetOrgParent(ctx, request.Msg.GetOrganizationId(), request.Msg.GetParentId(), s.command.CheckPermissionOrganizationWrite)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&org.SetOrganizationParentResponse{
+		ChangeDate: timestamppb.New(objectDetails.EventDate),
+	}), nil
+}
+
+func (s *Server) RemoveOrganizationParent(ctx context.Context, request *connect.Request[org.RemoveOrganizationParentRequest]) (*connect.Response[org.RemoveOrganizationParentResponse], error) {
+	objectDetails, err := s.command.RemoveOrgParent(ctx, request.Msg.GetOrganizationId(), s.command.CheckPermissionOrganizationWrite)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&org.RemoveOrganizationParentResponse{
+		ChangeDate: timestamppb.New(objectDetails.EventDate),
+	}), nil
+}
+
 func (s *Server) AddOrganizationDomain(ctx context.Context, request *connect.Request[org.AddOrganizationDomainRequest]) (*connect.Response[org.AddOrganizationDomainResponse], error) {
 	userIDs, err := s.getClaimedUserIDsOfOrgDomain(ctx, request.Msg.GetDomain(), request.Msg.GetOrganizationId())
 	if err != nil {
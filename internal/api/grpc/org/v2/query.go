@@ -219,7 +219,8 @@ func organizationToPb(organization *query.Org) *org.Organization {
 			ResourceOwner: organization.ResourceOwner,
 			CreationDate:  organization.CreationDate,
 		}),
-		State: orgStateToPb(organization.State),
+		State:    orgStateToPb(organization.State),
+		ParentId: organization.ParentID,
 	}
 }
 
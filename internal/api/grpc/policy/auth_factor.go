@@ -25,6 +25,8 @@ func SecondFactorTypeToDomain(secondFactorType policy_pb.SecondFactorType) domai
 		return domain.SecondFactorTypeOTPSMS
 	case policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES:
 		return domain.SecondFactorTypeRecoveryCodes
+	case policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_PUSH:
+		return domain.SecondFactorTypePush
 	default:
 		return domain.SecondFactorTypeUnspecified
 	}
@@ -50,6 +52,8 @@ func ModelSecondFactorTypeToPb(secondFactorType domain.SecondFactorType) policy_
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS
 	case domain.SecondFactorTypeRecoveryCodes:
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES
+	case domain.SecondFactorTypePush:
+		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_PUSH
 	default:
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_UNSPECIFIED
 	}
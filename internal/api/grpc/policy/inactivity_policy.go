@@ -0,0 +1,24 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/query"
+	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
+)
+
+func ModelInactivityPolicyToPb(policy *query.InactivityPolicy) *policy_pb.InactivityPolicy {
+	return &policy_pb.InactivityPolicy{
+		IsDefault:          policy.IsDefault,
+		WarnDays:           policy.WarnDays,
+		DeactivateDays:     policy.DeactivateDays,
+		DeleteDays:         policy.DeleteDays,
+		ExemptMachineUsers: policy.ExemptMachineUsers,
+		ExemptRoles:        policy.ExemptRoles,
+		Details: object.ToViewDetailsPb(
+			policy.Sequence,
+			policy.CreationDate,
+			policy.ChangeDate,
+			policy.ResourceOwner,
+		),
+	}
+}
@@ -27,12 +27,22 @@ func ModelLoginPolicyToPb(policy *query.LoginPolicy) *policy_pb.LoginPolicy {
 		AllowDomainDiscovery:       policy.AllowDomainDiscovery,
 		DisableLoginWithEmail:      policy.DisableLoginWithEmail,
 		DisableLoginWithPhone:      policy.DisableLoginWithPhone,
+		AllowMagicLink:             policy.AllowMagicLink,
 		DefaultRedirectUri:         policy.DefaultRedirectURI,
 		PasswordCheckLifetime:      durationpb.New(time.Duration(policy.PasswordCheckLifetime)),
 		ExternalLoginCheckLifetime: durationpb.New(time.Duration(policy.ExternalLoginCheckLifetime)),
 		MfaInitSkipLifetime:        durationpb.New(time.Duration(policy.MFAInitSkipLifetime)),
 		SecondFactorCheckLifetime:  durationpb.New(time.Duration(policy.SecondFactorCheckLifetime)),
 		MultiFactorCheckLifetime:   durationpb.New(time.Duration(policy.MultiFactorCheckLifetime)),
+		TrustedDeviceLifetime:      durationpb.New(time.Duration(policy.TrustedDeviceLifetime)),
+		SessionIdleTimeout:         durationpb.New(time.Duration(policy.SessionIdleTimeout)),
+		SessionMaxLifetime:         durationpb.New(time.Duration(policy.SessionMaxLifetime)),
+		MaxConcurrentSessions:      policy.MaxConcurrentSessions,
+		WebauthnAttestation:        ModelWebAuthNAttestationToPb(policy.WebAuthNAttestation),
+		WebauthnUserVerification:   ModelWebAuthNUserVerificationToPb(policy.WebAuthNUserVerification),
+		WebauthnResidentKey:        ModelWebAuthNResidentKeyToPb(policy.WebAuthNResidentKey),
+		WebauthnAllowedAaguids:     policy.WebAuthNAllowedAAGUIDs,
+		WebauthnDeniedAaguids:      policy.WebAuthNDeniedAAGUIDs,
 		SecondFactors:              ModelSecondFactorTypesToPb(policy.SecondFactors),
 		MultiFactors:               ModelMultiFactorTypesToPb(policy.MultiFactors),
 		Idps:                       idp_grpc.IDPLoginPolicyLinksToPb(policy.IDPLinks),
@@ -66,3 +76,97 @@ func ModelPasswordlessTypeToPb(passwordlessType domain.PasswordlessType) policy_
 		return policy_pb.PasswordlessType_PASSWORDLESS_TYPE_NOT_ALLOWED
 	}
 }
+
+func WebAuthNAttestationToDomain(attestation policy_pb.WebAuthNAttestation) domain.AttestationConveyance {
+	switch attestation {
+	case policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_NONE:
+		return domain.AttestationConveyanceNone
+	case policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_INDIRECT:
+		return domain.AttestationConveyanceIndirect
+	case policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_DIRECT:
+		return domain.AttestationConveyanceDirect
+	case policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_ENTERPRISE:
+		return domain.AttestationConveyanceEnterprise
+	case policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_UNSPECIFIED:
+		return domain.AttestationConveyanceUnspecified
+	default:
+		return domain.AttestationConveyanceUnspecified
+	}
+}
+
+func ModelWebAuthNAttestationToPb(attestation domain.AttestationConveyance) policy_pb.WebAuthNAttestation {
+	switch attestation {
+	case domain.AttestationConveyanceNone:
+		return policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_NONE
+	case domain.AttestationConveyanceIndirect:
+		return policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_INDIRECT
+	case domain.AttestationConveyanceDirect:
+		return policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_DIRECT
+	case domain.AttestationConveyanceEnterprise:
+		return policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_ENTERPRISE
+	case domain.AttestationConveyanceUnspecified:
+		return policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_UNSPECIFIED
+	default:
+		return policy_pb.WebAuthNAttestation_WEBAUTHN_ATTESTATION_UNSPECIFIED
+	}
+}
+
+func WebAuthNUserVerificationToDomain(userVerification policy_pb.WebAuthNUserVerification) domain.UserVerificationRequirement {
+	switch userVerification {
+	case policy_pb.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_DISCOURAGED:
+		return domain.UserVerificationRequirementDiscouraged
+	case policy_pb.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_PREFERRED:
+		return domain.UserVerificationRequirementPreferred
+	case policy_pb.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_REQUIRED:
+		return domain.UserVerificationRequirementRequired
+	case policy_pb.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_UNSPECIFIED:
+		return domain.UserVerificationRequirementUnspecified
+	default:
+		return domain.UserVerificationRequirementUnspecified
+	}
+}
+
+func ModelWebAuthNUserVerificationToPb(userVerification domain.UserVerificationRequirement) policy_pb.WebAuthNUserVerification {
+	switch userVerification {
+	case domain.UserVerificationRequirementDiscouraged:
+		return policy_pb.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_DISCOURAGED
+	case domain.UserVerificationRequirementPreferred:
+		return policy_pb.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_PREFERRED
+	case domain.UserVerificationRequirementRequired:
+		return policy_pb.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_REQUIRED
+	case domain.UserVerificationRequirementUnspecified:
+		return policy_pb.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_UNSPECIFIED
+	default:
+		return policy_pb.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_UNSPECIFIED
+	}
+}
+
+func WebAuthNResidentKeyToDomain(residentKey policy_pb.WebAuthNResidentKey) domain.ResidentKeyRequirement {
+	switch residentKey {
+	case policy_pb.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_DISCOURAGED:
+		return domain.ResidentKeyRequirementDiscouraged
+	case policy_pb.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_PREFERRED:
+		return domain.ResidentKeyRequirementPreferred
+	case policy_pb.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_REQUIRED:
+		return domain.ResidentKeyRequirementRequired
+	case policy_pb.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_UNSPECIFIED:
+		return domain.ResidentKeyRequirementUnspecified
+	default:
+		return domain.ResidentKeyRequirementUnspecified
+	}
+}
+
+func ModelWebAuthNResidentKeyToPb(residentKey domain.ResidentKeyRequirement) policy_pb.WebAuthNResidentKey {
+	switch residentKey {
+	case domain.ResidentKeyRequirementDiscouraged:
+		return policy_pb.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_DISCOURAGED
+	case domain.ResidentKeyRequirementPreferred:
+		return policy_pb.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_PREFERRED
+	case domain.ResidentKeyRequirementRequired:
+		return policy_pb.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_REQUIRED
+	case domain.ResidentKeyRequirementUnspecified:
+		return policy_pb.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_UNSPECIFIED
+	default:
+		return policy_pb.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_UNSPECIFIED
+	}
+}
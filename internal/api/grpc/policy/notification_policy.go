@@ -2,14 +2,17 @@ package policy
 
 import (
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	user_grpc "github.com/zitadel/zitadel/internal/api/grpc/user"
 	"github.com/zitadel/zitadel/internal/query"
 	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
 )
 
 func ModelNotificationPolicyToPb(policy *query.NotificationPolicy) *policy_pb.NotificationPolicy {
 	return &policy_pb.NotificationPolicy{
-		IsDefault:      policy.IsDefault,
-		PasswordChange: policy.PasswordChange,
+		IsDefault:             policy.IsDefault,
+		PasswordChange:        policy.PasswordChange,
+		SecurityNotifications: policy.SecurityNotifications,
+		PhoneChannels:         user_grpc.PhoneChannelsToPb(policy.PhoneChannels),
 		Details: object.ToViewDetailsPb(
 			policy.Sequence,
 			policy.CreationDate,
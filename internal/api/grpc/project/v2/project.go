@@ -45,6 +45,7 @@ func projectCreateToCommand(req *project_pb.CreateProjectRequest) *command.AddPr
 		ProjectRoleCheck:       req.AuthorizationRequired,
 		HasProjectCheck:        req.ProjectAccessRequired,
 		PrivateLabelingSetting: privateLabelingSettingToDomain(req.PrivateLabelingSetting),
+		ResourceURIs:           req.ResourceUris,
 	}
 }
 
@@ -80,6 +81,10 @@ func projectUpdateToCommand(req *project_pb.UpdateProjectRequest) *command.Chang
 	if req.PrivateLabelingSetting != nil {
 		labeling = gu.Ptr(privateLabelingSettingToDomain(*req.PrivateLabelingSetting))
 	}
+	var resourceURIs *[]string
+	if req.ResourceUris != nil {
+		resourceURIs = gu.Ptr(req.ResourceUris.GetUris())
+	}
 	return &command.ChangeProject{
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.ProjectId,
@@ -89,6 +94,7 @@ func projectUpdateToCommand(req *project_pb.UpdateProjectRequest) *command.Chang
 		ProjectRoleCheck:       req.AuthorizationRequired,
 		HasProjectCheck:        req.ProjectAccessRequired,
 		PrivateLabelingSetting: labeling,
+		ResourceURIs:           resourceURIs,
 	}
 }
 
@@ -32,8 +32,9 @@ func projectGrantCreateToCommand(req *project_pb.CreateProjectGrantRequest) *com
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.ProjectId,
 		},
-		GrantedOrgID: req.GrantedOrganizationId,
-		RoleKeys:     req.RoleKeys,
+		GrantedOrgID:   req.GrantedOrganizationId,
+		RoleKeys:       req.RoleKeys,
+		IncludeSubOrgs: req.IncludeSubOrganizations,
 	}
 }
 
@@ -42,6 +43,12 @@ func (s *Server) UpdateProjectGrant(ctx context.Context, req *connect.Request[pr
 	if err != nil {
 		return nil, err
 	}
+	if req.Msg.IncludeSubOrganizations != nil {
+		project, err = s.command.SetProjectGrantSubOrgs(ctx, req.Msg.GetProjectId(), "", req.Msg.GetGrantedOrganizationId(), "", req.Msg.GetIncludeSubOrganizations())
+		if err != nil {
+			return nil, err
+		}
+	}
 	var changeDate *timestamppb.Timestamp
 	if !project.EventDate.IsZero() {
 		changeDate = timestamppb.New(project.EventDate)
@@ -146,6 +146,7 @@ func projectToPb(project *query.Project) *project_pb.Project {
 		ProjectAccessRequired:  project.HasProjectCheck,
 		ProjectRoleAssertion:   project.ProjectRoleAssertion,
 		AuthorizationRequired:  project.ProjectRoleCheck,
+		ResourceUris:           project.ResourceURIs,
 	}
 }
 
@@ -172,6 +173,7 @@ func grantedProjectToPb(project *query.GrantedProject) *project_pb.Project {
 		GrantedOrganizationId:   grantedOrganizationID,
 		GrantedOrganizationName: grantedOrganizationName,
 		GrantedState:            grantedProjectStateToPb(project.ProjectGrantState),
+		ResourceUris:            project.ResourceURIs,
 	}
 }
 
@@ -317,6 +317,14 @@ func (m *mockInstance) AllowUnauthenticatedDynamicClientRegistration() bool {
 	return false
 }
 
+func (m *mockInstance) PasswordRehashBefore() time.Time {
+	return time.Time{}
+}
+
+func (m *mockInstance) InsecurePasswordHashDeadline() time.Time {
+	return time.Time{}
+}
+
 func (m *mockInstance) Features() feature.Features {
 	return feature.Features{}
 }
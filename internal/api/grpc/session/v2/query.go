@@ -183,6 +183,42 @@ func sessionToPb(s *query.Session) *session.Session {
 		Metadata:       s.Metadata,
 		UserAgent:      userAgentToPb(s.UserAgent),
 		ExpirationDate: expirationToPb(s.Expiration),
+		Risk:           riskToPb(s.Risk),
+	}
+}
+
+func riskToPb(risk query.SessionRisk) *session.Risk {
+	if risk.EvaluatedAt.IsZero() {
+		return nil
+	}
+	out := &session.Risk{
+		Score:       int32(risk.Score),
+		Signals:     make([]string, len(risk.Signals)),
+		Outcome:     riskOutcomeToPb(risk.Outcome),
+		EvaluatedAt: timestamppb.New(risk.EvaluatedAt),
+	}
+	for i, signal := range risk.Signals {
+		out.Signals[i] = string(signal)
+	}
+	if risk.Location != nil {
+		out.Country = risk.Location.Country
+		out.Asn = risk.Location.ASN
+	}
+	return out
+}
+
+func riskOutcomeToPb(outcome domain.RiskOutcome) session.RiskOutcome {
+	switch outcome {
+	case domain.RiskOutcomeAllow:
+		return session.RiskOutcome_RISK_OUTCOME_ALLOW
+	case domain.RiskOutcomeRequireMFA:
+		return session.RiskOutcome_RISK_OUTCOME_REQUIRE_MFA
+	case domain.RiskOutcomeDeny:
+		return session.RiskOutcome_RISK_OUTCOME_DENY
+	case domain.RiskOutcomeUnspecified:
+		fallthrough
+	default:
+		return session.RiskOutcome_RISK_OUTCOME_UNSPECIFIED
 	}
 }
 
@@ -223,14 +259,17 @@ func factorsToPb(s *query.Session) *session.Factors {
 		return nil
 	}
 	return &session.Factors{
-		User:         user,
-		Password:     passwordFactorToPb(s.PasswordFactor),
-		WebAuthN:     webAuthNFactorToPb(s.WebAuthNFactor),
-		Intent:       intentFactorToPb(s.IntentFactor),
-		Totp:         totpFactorToPb(s.TOTPFactor),
-		OtpSms:       otpFactorToPb(s.OTPSMSFactor),
-		OtpEmail:     otpFactorToPb(s.OTPEmailFactor),
-		RecoveryCode: recoveryCodeFactorToPb(s.RecoveryCodeFactor),
+		User:          user,
+		Password:      passwordFactorToPb(s.PasswordFactor),
+		WebAuthN:      webAuthNFactorToPb(s.WebAuthNFactor),
+		Intent:        intentFactorToPb(s.IntentFactor),
+		Totp:          totpFactorToPb(s.TOTPFactor),
+		OtpSms:        otpFactorToPb(s.OTPSMSFactor),
+		OtpEmail:      otpFactorToPb(s.OTPEmailFactor),
+		RecoveryCode:  recoveryCodeFactorToPb(s.RecoveryCodeFactor),
+		MagicLink:     magicLinkFactorToPb(s.MagicLinkFactor),
+		TrustedDevice: trustedDeviceFactorToPb(s.TrustedDeviceFactor),
+		Push:          pushFactorToPb(s.PushFactor),
 	}
 }
 
@@ -289,6 +328,33 @@ func recoveryCodeFactorToPb(factor query.SessionRecoveryCodeFactor) *session.Rec
 	}
 }
 
+func magicLinkFactorToPb(factor query.SessionMagicLinkFactor) *session.MagicLinkFactor {
+	if factor.MagicLinkCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.MagicLinkFactor{
+		VerifiedAt: timestamppb.New(factor.MagicLinkCheckedAt),
+	}
+}
+
+func trustedDeviceFactorToPb(factor query.SessionTrustedDeviceFactor) *session.TrustedDeviceFactor {
+	if factor.TrustedDeviceCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.TrustedDeviceFactor{
+		VerifiedAt: timestamppb.New(factor.TrustedDeviceCheckedAt),
+	}
+}
+
+func pushFactorToPb(factor query.SessionPushFactor) *session.PushFactor {
+	if factor.PushCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.PushFactor{
+		VerifiedAt: timestamppb.New(factor.PushCheckedAt),
+	}
+}
+
 func userFactorToPb(factor query.SessionUserFactor) *session.UserFactor {
 	if factor.UserID == "" || factor.UserCheckedAt.IsZero() {
 		return nil
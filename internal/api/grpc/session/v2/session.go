@@ -83,6 +83,9 @@ func (s *Server) createSessionRequestToCommand(ctx context.Context, req *session
 	if err != nil {
 		return nil, nil, nil, 0, err
 	}
+	if req.GetTrustDevice() {
+		checks = append(checks, command.TrustDevice())
+	}
 	return checks, req.GetMetadata(), userAgentToCommand(req.GetUserAgent()), req.GetLifetime().AsDuration(), nil
 }
 
@@ -109,6 +112,9 @@ func (s *Server) setSessionRequestToCommand(ctx context.Context, req *session.Se
 	if err != nil {
 		return nil, err
 	}
+	if req.GetTrustDevice() {
+		checks = append(checks, command.TrustDevice())
+	}
 	return checks, nil
 }
 
@@ -154,6 +160,15 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if recoveryCode := checks.GetRecoveryCode(); recoveryCode != nil {
 		sessionChecks = append(sessionChecks, command.CheckRecoveryCode(recoveryCode.GetCode()))
 	}
+	if magicLink := checks.GetMagicLink(); magicLink != nil {
+		sessionChecks = append(sessionChecks, command.CheckMagicLink(magicLink.GetCode()))
+	}
+	if checks.GetTrustedDevice() != nil {
+		sessionChecks = append(sessionChecks, command.CheckTrustedDevice())
+	}
+	if push := checks.GetPush(); push != nil {
+		sessionChecks = append(sessionChecks, command.CheckPush(push.GetApproval()))
+	}
 	return sessionChecks, nil
 }
 
@@ -180,6 +195,19 @@ func (s *Server) challengesToCommand(challenges *session.RequestChallenges, cmds
 		resp.OtpEmail = challenge
 		cmds = append(cmds, cmd)
 	}
+	if req := challenges.GetMagicLink(); req != nil {
+		challenge, cmd, err := s.createMagicLinkChallengeCommand(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		resp.MagicLink = challenge
+		cmds = append(cmds, cmd)
+	}
+	if req := challenges.GetPush(); req != nil {
+		// the number is set on the challenge, when the command is executed
+		resp.Push = new(session.Challenges_Push)
+		cmds = append(cmds, s.command.CreatePushChallenge(&resp.Push.Number))
+	}
 	return resp, cmds, nil
 }
 
@@ -234,6 +262,22 @@ func (s *Server) createOTPEmailChallengeCommand(req *session.RequestChallenges_O
 	}
 }
 
+func (s *Server) createMagicLinkChallengeCommand(req *session.RequestChallenges_MagicLink) (*string, command.SessionCommand, error) {
+	switch t := req.GetDeliveryType().(type) {
+	case *session.RequestChallenges_MagicLink_SendLink_:
+		cmd, err := s.command.CreateMagicLinkChallengeURLTemplate(t.SendLink.GetUrlTemplate())
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, cmd, nil
+	case *session.RequestChallenges_MagicLink_ReturnCode_:
+		challenge := new(string)
+		return challenge, s.command.CreateMagicLinkChallengeReturnCode(challenge), nil
+	default:
+		return nil, nil, zerrors.ThrowUnimplementedf(nil, "SESSION-Moh3i", "delivery_type oneOf %T in MagicLinkChallenge not implemented", t)
+	}
+}
+
 func userCheck(user *session.CheckUser) (userSearch, error) {
 	if user == nil {
 		return nil, nil
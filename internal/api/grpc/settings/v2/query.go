@@ -130,6 +130,22 @@ func (s *Server) GetLockoutSettings(ctx context.Context, req *connect.Request[se
 	}), nil
 }
 
+func (s *Server) GetInactivitySettings(ctx context.Context, req *connect.Request[settings.GetInactivitySettingsRequest]) (*connect.Response[settings.GetInactivitySettingsResponse], error) {
+	current, err := s.query.InactivityPolicyByOrg(ctx, true, object.ResourceOwnerFromReq(ctx, req.Msg.GetCtx()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&settings.GetInactivitySettingsResponse{
+		Settings: inactivitySettingsToPb(current),
+		Details: &object_pb.Details{
+			Sequence:      current.Sequence,
+			CreationDate:  timestamppb.New(current.CreationDate),
+			ChangeDate:    timestamppb.New(current.ChangeDate),
+			ResourceOwner: current.ResourceOwner,
+		},
+	}), nil
+}
+
 func (s *Server) GetActiveIdentityProviders(ctx context.Context, req *connect.Request[settings.GetActiveIdentityProvidersRequest]) (*connect.Response[settings.GetActiveIdentityProvidersResponse], error) {
 	queries, err := activeIdentityProvidersToQuery(req.Msg)
 	if err != nil {
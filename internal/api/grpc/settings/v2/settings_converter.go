@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	idp_api "github.com/zitadel/zitadel/internal/api/grpc/idp/v2"
 	"github.com/zitadel/zitadel/internal/command"
@@ -36,12 +37,22 @@ func loginSettingsToPb(current *query.LoginPolicy) *settings.LoginSettings {
 		AllowDomainDiscovery:       current.AllowDomainDiscovery,
 		DisableLoginWithEmail:      current.DisableLoginWithEmail,
 		DisableLoginWithPhone:      current.DisableLoginWithPhone,
+		AllowMagicLink:             current.AllowMagicLink,
 		DefaultRedirectUri:         current.DefaultRedirectURI,
 		PasswordCheckLifetime:      durationpb.New(time.Duration(current.PasswordCheckLifetime)),
 		ExternalLoginCheckLifetime: durationpb.New(time.Duration(current.ExternalLoginCheckLifetime)),
 		MfaInitSkipLifetime:        durationpb.New(time.Duration(current.MFAInitSkipLifetime)),
 		SecondFactorCheckLifetime:  durationpb.New(time.Duration(current.SecondFactorCheckLifetime)),
 		MultiFactorCheckLifetime:   durationpb.New(time.Duration(current.MultiFactorCheckLifetime)),
+		TrustedDeviceLifetime:      durationpb.New(time.Duration(current.TrustedDeviceLifetime)),
+		SessionIdleTimeout:         durationpb.New(time.Duration(current.SessionIdleTimeout)),
+		SessionMaxLifetime:         durationpb.New(time.Duration(current.SessionMaxLifetime)),
+		MaxConcurrentSessions:      current.MaxConcurrentSessions,
+		WebauthnAttestation:        webAuthNAttestationToPb(current.WebAuthNAttestation),
+		WebauthnUserVerification:   webAuthNUserVerificationToPb(current.WebAuthNUserVerification),
+		WebauthnResidentKey:        webAuthNResidentKeyToPb(current.WebAuthNResidentKey),
+		WebauthnAllowedAaguids:     current.WebAuthNAllowedAAGUIDs,
+		WebauthnDeniedAaguids:      current.WebAuthNDeniedAAGUIDs,
 		SecondFactors:              second,
 		MultiFactors:               multi,
 		ResourceOwnerType:          isDefaultToResourceOwnerTypePb(current.IsDefault),
@@ -66,6 +77,53 @@ func passkeysTypeToPb(passwordlessType domain.PasswordlessType) settings.Passkey
 	}
 }
 
+func webAuthNAttestationToPb(attestation domain.AttestationConveyance) settings.WebAuthNAttestation {
+	switch attestation {
+	case domain.AttestationConveyanceNone:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_NONE
+	case domain.AttestationConveyanceIndirect:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_INDIRECT
+	case domain.AttestationConveyanceDirect:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_DIRECT
+	case domain.AttestationConveyanceEnterprise:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_ENTERPRISE
+	case domain.AttestationConveyanceUnspecified:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_UNSPECIFIED
+	default:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_UNSPECIFIED
+	}
+}
+
+func webAuthNUserVerificationToPb(userVerification domain.UserVerificationRequirement) settings.WebAuthNUserVerification {
+	switch userVerification {
+	case domain.UserVerificationRequirementDiscouraged:
+		return settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_DISCOURAGED
+	case domain.UserVerificationRequirementPreferred:
+		return settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_PREFERRED
+	case domain.UserVerificationRequirementRequired:
+		return settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_REQUIRED
+	case domain.UserVerificationRequirementUnspecified:
+		return settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_UNSPECIFIED
+	default:
+		return settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_UNSPECIFIED
+	}
+}
+
+func webAuthNResidentKeyToPb(residentKey domain.ResidentKeyRequirement) settings.WebAuthNResidentKey {
+	switch residentKey {
+	case domain.ResidentKeyRequirementDiscouraged:
+		return settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_DISCOURAGED
+	case domain.ResidentKeyRequirementPreferred:
+		return settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_PREFERRED
+	case domain.ResidentKeyRequirementRequired:
+		return settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_REQUIRED
+	case domain.ResidentKeyRequirementUnspecified:
+		return settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_UNSPECIFIED
+	default:
+		return settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_UNSPECIFIED
+	}
+}
+
 func secondFactorTypeToPb(secondFactorType domain.SecondFactorType) settings.SecondFactorType {
 	switch secondFactorType {
 	case domain.SecondFactorTypeTOTP:
@@ -78,6 +136,8 @@ func secondFactorTypeToPb(secondFactorType domain.SecondFactorType) settings.Sec
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS
 	case domain.SecondFactorTypeRecoveryCodes:
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES
+	case domain.SecondFactorTypePush:
+		return settings.SecondFactorType_SECOND_FACTOR_TYPE_PUSH
 	case domain.SecondFactorTypeUnspecified:
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_UNSPECIFIED
 	default:
@@ -103,6 +163,7 @@ func passwordComplexitySettingsToPb(current *query.PasswordComplexityPolicy) *se
 		RequiresLowercase: current.HasLowercase,
 		RequiresNumber:    current.HasNumber,
 		RequiresSymbol:    current.HasSymbol,
+		RejectsBreached:   current.CheckBreached,
 		ResourceOwnerType: isDefaultToResourceOwnerTypePb(current.IsDefault),
 	}
 }
@@ -112,6 +173,7 @@ func passwordExpirySettingsToPb(current *query.PasswordAgePolicy) *settings.Pass
 		MaxAgeDays:        current.MaxAgeDays,
 		ExpireWarnDays:    current.ExpireWarnDays,
 		ResourceOwnerType: isDefaultToResourceOwnerTypePb(current.IsDefault),
+		HistoryDepth:      current.HistoryDepth,
 	}
 }
 
@@ -181,6 +243,17 @@ func lockoutSettingsToPb(current *query.LockoutPolicy) *settings.LockoutSettings
 	}
 }
 
+func inactivitySettingsToPb(current *query.InactivityPolicy) *settings.InactivitySettings {
+	return &settings.InactivitySettings{
+		WarnDays:           current.WarnDays,
+		DeactivateDays:     current.DeactivateDays,
+		DeleteDays:         current.DeleteDays,
+		ExemptMachineUsers: current.ExemptMachineUsers,
+		ExemptRoles:        current.ExemptRoles,
+		ResourceOwnerType:  isDefaultToResourceOwnerTypePb(current.IsDefault),
+	}
+}
+
 func identityProvidersToPb(idps []*query.IDPLoginPolicyLink) []*settings.IdentityProvider {
 	providers := make([]*settings.IdentityProvider, len(idps))
 	for i, idp := range idps {
@@ -247,10 +320,87 @@ func securityPolicyToSettingsPb(policy *query.SecurityPolicy) *settings.Security
 		},
 		EnableImpersonation: policy.EnableImpersonation,
 		DynamicClientRegistration: &settings.DynamicClientRegistrationSettings{
-			Enabled:              policy.EnableDynamicClientRegistration,
-			AllowUnauthenticated: policy.AllowUnauthenticatedDynamicClientRegistration,
+			Enabled:                         policy.EnableDynamicClientRegistration,
+			AllowUnauthenticated:            policy.AllowUnauthenticatedDynamicClientRegistration,
+			RequireSoftwareStatement:        policy.RequireSoftwareStatement,
+			TrustedSoftwareStatementIssuers: softwareStatementIssuersToPb(policy.SoftwareStatementIssuers),
 		},
+		AcrDefinitions: acrDefinitionsToPb(policy.ACRDefinitions),
+		PasswordHash: &settings.PasswordHashSettings{
+			RehashBefore:         timeToPb(policy.PasswordRehashBefore),
+			InsecureHashDeadline: timeToPb(policy.InsecurePasswordHashDeadline),
+		},
+	}
+}
+
+func timeToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func pbToTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+func acrDefinitionsToPb(definitions domain.ACRDefinitions) []*settings.ACRDefinition {
+	if len(definitions) == 0 {
+		return nil
+	}
+	pb := make([]*settings.ACRDefinition, len(definitions))
+	for i, definition := range definitions {
+		pb[i] = &settings.ACRDefinition{
+			Value: definition.Value,
+			Level: settings.AuthenticationLevel(definition.Level),
+		}
+	}
+	return pb
+}
+
+func acrDefinitionsToDomain(definitions []*settings.ACRDefinition) domain.ACRDefinitions {
+	if len(definitions) == 0 {
+		return nil
+	}
+	result := make(domain.ACRDefinitions, len(definitions))
+	for i, definition := range definitions {
+		result[i] = domain.ACRDefinition{
+			Value: definition.GetValue(),
+			Level: domain.AuthenticationLevel(definition.GetLevel()),
+		}
 	}
+	return result
+}
+
+func softwareStatementIssuersToPb(issuers domain.SoftwareStatementIssuers) []*settings.SoftwareStatementIssuer {
+	if len(issuers) == 0 {
+		return nil
+	}
+	pb := make([]*settings.SoftwareStatementIssuer, len(issuers))
+	for i, issuer := range issuers {
+		pb[i] = &settings.SoftwareStatementIssuer{
+			Issuer: issuer.Issuer,
+			Jwks:   issuer.KeySet,
+		}
+	}
+	return pb
+}
+
+func softwareStatementIssuersToDomain(issuers []*settings.SoftwareStatementIssuer) domain.SoftwareStatementIssuers {
+	if len(issuers) == 0 {
+		return nil
+	}
+	result := make(domain.SoftwareStatementIssuers, len(issuers))
+	for i, issuer := range issuers {
+		result[i] = domain.SoftwareStatementIssuer{
+			Issuer: issuer.GetIssuer(),
+			KeySet: issuer.GetJwks(),
+		}
+	}
+	return result
 }
 
 func securitySettingsToCommand(req *settings.SetSecuritySettingsRequest) *command.SecurityPolicy {
@@ -261,6 +411,13 @@ func securitySettingsToCommand(req *settings.SetSecuritySettingsRequest) *comman
 
 		EnableDynamicClientRegistration:               req.GetDynamicClientRegistration().GetEnabled(),
 		AllowUnauthenticatedDynamicClientRegistration: req.GetDynamicClientRegistration().GetAllowUnauthenticated(),
+		RequireSoftwareStatement:                      req.GetDynamicClientRegistration().GetRequireSoftwareStatement(),
+		SoftwareStatementIssuers:                      softwareStatementIssuersToDomain(req.GetDynamicClientRegistration().GetTrustedSoftwareStatementIssuers()),
+
+		ACRDefinitions: acrDefinitionsToDomain(req.GetAcrDefinitions()),
+
+		PasswordRehashBefore:         pbToTime(req.GetPasswordHash().GetRehashBefore()),
+		InsecurePasswordHashDeadline: pbToTime(req.GetPasswordHash().GetInsecureHashDeadline()),
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/grpc"
 	"github.com/zitadel/zitadel/internal/command"
@@ -35,12 +36,22 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		AllowMagicLink:             true,
 		DefaultRedirectURI:         "example.com",
 		PasswordCheckLifetime:      database.Duration(time.Hour),
 		ExternalLoginCheckLifetime: database.Duration(time.Minute),
 		MFAInitSkipLifetime:        database.Duration(time.Millisecond),
 		SecondFactorCheckLifetime:  database.Duration(time.Microsecond),
 		MultiFactorCheckLifetime:   database.Duration(time.Nanosecond),
+		TrustedDeviceLifetime:      database.Duration(24 * time.Hour),
+		SessionIdleTimeout:         database.Duration(time.Hour),
+		SessionMaxLifetime:         database.Duration(12 * time.Hour),
+		MaxConcurrentSessions:      5,
+		WebAuthNAttestation:        domain.AttestationConveyanceDirect,
+		WebAuthNUserVerification:   domain.UserVerificationRequirementRequired,
+		WebAuthNResidentKey:        domain.ResidentKeyRequirementRequired,
+		WebAuthNAllowedAAGUIDs:     database.TextArray[string]{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+		WebAuthNDeniedAAGUIDs:      database.TextArray[string]{"08987058-cadc-4b81-b6e1-30de50dcbe96"},
 		SecondFactors: []domain.SecondFactorType{
 			domain.SecondFactorTypeTOTP,
 			domain.SecondFactorTypeU2F,
@@ -67,12 +78,22 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		AllowMagicLink:             true,
 		DefaultRedirectUri:         "example.com",
 		PasswordCheckLifetime:      durationpb.New(time.Hour),
 		ExternalLoginCheckLifetime: durationpb.New(time.Minute),
 		MfaInitSkipLifetime:        durationpb.New(time.Millisecond),
 		SecondFactorCheckLifetime:  durationpb.New(time.Microsecond),
 		MultiFactorCheckLifetime:   durationpb.New(time.Nanosecond),
+		TrustedDeviceLifetime:      durationpb.New(24 * time.Hour),
+		SessionIdleTimeout:         durationpb.New(time.Hour),
+		SessionMaxLifetime:         durationpb.New(12 * time.Hour),
+		MaxConcurrentSessions:      5,
+		WebauthnAttestation:        settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_DIRECT,
+		WebauthnUserVerification:   settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_REQUIRED,
+		WebauthnResidentKey:        settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_REQUIRED,
+		WebauthnAllowedAaguids:     []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+		WebauthnDeniedAaguids:      []string{"08987058-cadc-4b81-b6e1-30de50dcbe96"},
 		SecondFactors: []settings.SecondFactorType{
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP,
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_U2F,
@@ -147,6 +168,43 @@ func Test_passkeysTypeToPb(t *testing.T) {
 	}
 }
 
+func Test_webAuthNUserVerificationToPb(t *testing.T) {
+	type args struct {
+		userVerification domain.UserVerificationRequirement
+	}
+	tests := []struct {
+		args args
+		want settings.WebAuthNUserVerification
+	}{
+		{
+			args: args{domain.UserVerificationRequirementUnspecified},
+			want: settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_UNSPECIFIED,
+		},
+		{
+			args: args{domain.UserVerificationRequirementDiscouraged},
+			want: settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_DISCOURAGED,
+		},
+		{
+			args: args{domain.UserVerificationRequirementPreferred},
+			want: settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_PREFERRED,
+		},
+		{
+			args: args{domain.UserVerificationRequirementRequired},
+			want: settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_REQUIRED,
+		},
+		{
+			args: args{99},
+			want: settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_UNSPECIFIED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want.String(), func(t *testing.T) {
+			got := webAuthNUserVerificationToPb(tt.args.userVerification)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_secondFactorTypeToPb(t *testing.T) {
 	type args struct {
 		secondFactorType domain.SecondFactorType
@@ -188,6 +246,10 @@ func Test_secondFactorTypeToPb(t *testing.T) {
 			args: args{domain.SecondFactorTypeRecoveryCodes},
 			want: settings.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES,
 		},
+		{
+			args: args{domain.SecondFactorTypePush},
+			want: settings.SecondFactorType_SECOND_FACTOR_TYPE_PUSH,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want.String(), func(t *testing.T) {
@@ -228,12 +290,13 @@ func Test_multiFactorTypeToPb(t *testing.T) {
 
 func Test_passwordComplexitySettingsToPb(t *testing.T) {
 	arg := &query.PasswordComplexityPolicy{
-		MinLength:    12,
-		HasUppercase: true,
-		HasLowercase: true,
-		HasNumber:    true,
-		HasSymbol:    true,
-		IsDefault:    true,
+		MinLength:     12,
+		HasUppercase:  true,
+		HasLowercase:  true,
+		HasNumber:     true,
+		HasSymbol:     true,
+		CheckBreached: true,
+		IsDefault:     true,
 	}
 	want := &settings.PasswordComplexitySettings{
 		MinLength:         12,
@@ -241,6 +304,7 @@ func Test_passwordComplexitySettingsToPb(t *testing.T) {
 		RequiresLowercase: true,
 		RequiresNumber:    true,
 		RequiresSymbol:    true,
+		RejectsBreached:   true,
 		ResourceOwnerType: settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
 	}
 
@@ -255,12 +319,14 @@ func Test_passwordExpirySettingsToPb(t *testing.T) {
 	arg := &query.PasswordAgePolicy{
 		ExpireWarnDays: 80,
 		MaxAgeDays:     90,
+		HistoryDepth:   5,
 		IsDefault:      true,
 	}
 	want := &settings.PasswordExpirySettings{
 		ExpireWarnDays:    80,
 		MaxAgeDays:        90,
 		ResourceOwnerType: settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+		HistoryDepth:      5,
 	}
 
 	got := passwordExpirySettingsToPb(arg)
@@ -392,6 +458,30 @@ func Test_lockoutSettingsToPb(t *testing.T) {
 	}
 }
 
+func Test_inactivitySettingsToPb(t *testing.T) {
+	arg := &query.InactivityPolicy{
+		WarnDays:           30,
+		DeactivateDays:     60,
+		DeleteDays:         90,
+		ExemptMachineUsers: true,
+		ExemptRoles:        []string{"ORG_OWNER"},
+		IsDefault:          true,
+	}
+	want := &settings.InactivitySettings{
+		WarnDays:           30,
+		DeactivateDays:     60,
+		DeleteDays:         90,
+		ExemptMachineUsers: true,
+		ExemptRoles:        []string{"ORG_OWNER"},
+		ResourceOwnerType:  settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+	}
+	got := inactivitySettingsToPb(arg)
+	grpc.AllFieldsSet(t, got.ProtoReflect(), ignoreTypes...)
+	if !proto.Equal(got, want) {
+		t.Errorf("inactivitySettingsToPb() =\n%v\nwant\n%v", got, want)
+	}
+}
+
 func Test_identityProvidersToPb(t *testing.T) {
 	arg := []*query.IDPLoginPolicyLink{
 		{
@@ -537,8 +627,19 @@ func Test_securityPolicyToSettingsPb(t *testing.T) {
 		},
 		EnableImpersonation: true,
 		DynamicClientRegistration: &settings.DynamicClientRegistrationSettings{
-			Enabled:              true,
-			AllowUnauthenticated: true,
+			Enabled:                  true,
+			AllowUnauthenticated:     true,
+			RequireSoftwareStatement: true,
+			TrustedSoftwareStatementIssuers: []*settings.SoftwareStatementIssuer{
+				{Issuer: "https://certification.example.com", Jwks: `{"keys":[]}`},
+			},
+		},
+		AcrDefinitions: []*settings.ACRDefinition{
+			{Value: "urn:zitadel:acr:mfa", Level: settings.AuthenticationLevel_AUTHENTICATION_LEVEL_MULTI_FACTOR},
+		},
+		PasswordHash: &settings.PasswordHashSettings{
+			RehashBefore:         timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			InsecureHashDeadline: timestamppb.New(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)),
 		},
 	}
 	got := securityPolicyToSettingsPb(&query.SecurityPolicy{
@@ -548,6 +649,17 @@ func Test_securityPolicyToSettingsPb(t *testing.T) {
 
 		EnableDynamicClientRegistration:               true,
 		AllowUnauthenticatedDynamicClientRegistration: true,
+		RequireSoftwareStatement:                      true,
+		SoftwareStatementIssuers: domain.SoftwareStatementIssuers{
+			{Issuer: "https://certification.example.com", KeySet: `{"keys":[]}`},
+		},
+
+		ACRDefinitions: domain.ACRDefinitions{
+			{Value: "urn:zitadel:acr:mfa", Level: domain.AuthenticationLevelMultiFactor},
+		},
+
+		PasswordRehashBefore:         time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		InsecurePasswordHashDeadline: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
 	})
 	assert.Equal(t, want, got)
 }
@@ -565,6 +677,17 @@ func Test_securitySettingsToCommand(t *testing.T) {
 
 		EnableDynamicClientRegistration:               true,
 		AllowUnauthenticatedDynamicClientRegistration: true,
+		RequireSoftwareStatement:                      true,
+		SoftwareStatementIssuers: domain.SoftwareStatementIssuers{
+			{Issuer: "https://certification.example.com", KeySet: `{"keys":[]}`},
+		},
+
+		ACRDefinitions: domain.ACRDefinitions{
+			{Value: "urn:zitadel:acr:mfa", Level: domain.AuthenticationLevelMultiFactor},
+		},
+
+		PasswordRehashBefore:         time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		InsecurePasswordHashDeadline: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
 	}
 	got := securitySettingsToCommand(&settings.SetSecuritySettingsRequest{
 		EmbeddedIframe: &settings.EmbeddedIframeSettings{
@@ -573,8 +696,19 @@ func Test_securitySettingsToCommand(t *testing.T) {
 		},
 		EnableImpersonation: true,
 		DynamicClientRegistration: &settings.DynamicClientRegistrationSettings{
-			Enabled:              true,
-			AllowUnauthenticated: true,
+			Enabled:                  true,
+			AllowUnauthenticated:     true,
+			RequireSoftwareStatement: true,
+			TrustedSoftwareStatementIssuers: []*settings.SoftwareStatementIssuer{
+				{Issuer: "https://certification.example.com", Jwks: `{"keys":[]}`},
+			},
+		},
+		AcrDefinitions: []*settings.ACRDefinition{
+			{Value: "urn:zitadel:acr:mfa", Level: settings.AuthenticationLevel_AUTHENTICATION_LEVEL_MULTI_FACTOR},
+		},
+		PasswordHash: &settings.PasswordHashSettings{
+			RehashBefore:         timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+			InsecureHashDeadline: timestamppb.New(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)),
 		},
 	})
 	assert.Equal(t, want, got)
@@ -586,4 +720,6 @@ func Test_securitySettingsToCommand_dynamicClientRegistrationOmitted(t *testing.
 	got := securitySettingsToCommand(&settings.SetSecuritySettingsRequest{})
 	assert.False(t, got.EnableDynamicClientRegistration)
 	assert.False(t, got.AllowUnauthenticatedDynamicClientRegistration)
+	assert.True(t, got.PasswordRehashBefore.IsZero())
+	assert.True(t, got.InsecurePasswordHashDeadline.IsZero())
 }
@@ -33,12 +33,22 @@ func loginSettingsToPb(current *query.LoginPolicy) *settings.LoginSettings {
 		AllowDomainDiscovery:       current.AllowDomainDiscovery,
 		DisableLoginWithEmail:      current.DisableLoginWithEmail,
 		DisableLoginWithPhone:      current.DisableLoginWithPhone,
+		AllowMagicLink:             current.AllowMagicLink,
 		DefaultRedirectUri:         current.DefaultRedirectURI,
 		PasswordCheckLifetime:      durationpb.New(time.Duration(current.PasswordCheckLifetime)),
 		ExternalLoginCheckLifetime: durationpb.New(time.Duration(current.ExternalLoginCheckLifetime)),
 		MfaInitSkipLifetime:        durationpb.New(time.Duration(current.MFAInitSkipLifetime)),
 		SecondFactorCheckLifetime:  durationpb.New(time.Duration(current.SecondFactorCheckLifetime)),
 		MultiFactorCheckLifetime:   durationpb.New(time.Duration(current.MultiFactorCheckLifetime)),
+		TrustedDeviceLifetime:      durationpb.New(time.Duration(current.TrustedDeviceLifetime)),
+		SessionIdleTimeout:         durationpb.New(time.Duration(current.SessionIdleTimeout)),
+		SessionMaxLifetime:         durationpb.New(time.Duration(current.SessionMaxLifetime)),
+		MaxConcurrentSessions:      current.MaxConcurrentSessions,
+		WebauthnAttestation:        webAuthNAttestationToPb(current.WebAuthNAttestation),
+		WebauthnUserVerification:   webAuthNUserVerificationToPb(current.WebAuthNUserVerification),
+		WebauthnResidentKey:        webAuthNResidentKeyToPb(current.WebAuthNResidentKey),
+		WebauthnAllowedAaguids:     current.WebAuthNAllowedAAGUIDs,
+		WebauthnDeniedAaguids:      current.WebAuthNDeniedAAGUIDs,
 		SecondFactors:              second,
 		MultiFactors:               multi,
 		ResourceOwnerType:          isDefaultToResourceOwnerTypePb(current.IsDefault),
@@ -63,6 +73,53 @@ func passkeysTypeToPb(passwordlessType domain.PasswordlessType) settings.Passkey
 	}
 }
 
+func webAuthNAttestationToPb(attestation domain.AttestationConveyance) settings.WebAuthNAttestation {
+	switch attestation {
+	case domain.AttestationConveyanceNone:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_NONE
+	case domain.AttestationConveyanceIndirect:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_INDIRECT
+	case domain.AttestationConveyanceDirect:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_DIRECT
+	case domain.AttestationConveyanceEnterprise:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_ENTERPRISE
+	case domain.AttestationConveyanceUnspecified:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_UNSPECIFIED
+	default:
+		return settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_UNSPECIFIED
+	}
+}
+
+func webAuthNUserVerificationToPb(userVerification domain.UserVerificationRequirement) settings.WebAuthNUserVerification {
+	switch userVerification {
+	case domain.UserVerificationRequirementDiscouraged:
+		return settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_DISCOURAGED
+	case domain.UserVerificationRequirementPreferred:
+		return settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_PREFERRED
+	case domain.UserVerificationRequirementRequired:
+		return settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_REQUIRED
+	case domain.UserVerificationRequirementUnspecified:
+		return settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_UNSPECIFIED
+	default:
+		return settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_UNSPECIFIED
+	}
+}
+
+func webAuthNResidentKeyToPb(residentKey domain.ResidentKeyRequirement) settings.WebAuthNResidentKey {
+	switch residentKey {
+	case domain.ResidentKeyRequirementDiscouraged:
+		return settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_DISCOURAGED
+	case domain.ResidentKeyRequirementPreferred:
+		return settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_PREFERRED
+	case domain.ResidentKeyRequirementRequired:
+		return settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_REQUIRED
+	case domain.ResidentKeyRequirementUnspecified:
+		return settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_UNSPECIFIED
+	default:
+		return settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_UNSPECIFIED
+	}
+}
+
 func secondFactorTypeToPb(secondFactorType domain.SecondFactorType) settings.SecondFactorType {
 	switch secondFactorType {
 	case domain.SecondFactorTypeTOTP:
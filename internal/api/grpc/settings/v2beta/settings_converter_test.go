@@ -34,12 +34,22 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		AllowMagicLink:             true,
 		DefaultRedirectURI:         "example.com",
 		PasswordCheckLifetime:      database.Duration(time.Hour),
 		ExternalLoginCheckLifetime: database.Duration(time.Minute),
 		MFAInitSkipLifetime:        database.Duration(time.Millisecond),
 		SecondFactorCheckLifetime:  database.Duration(time.Microsecond),
 		MultiFactorCheckLifetime:   database.Duration(time.Nanosecond),
+		TrustedDeviceLifetime:      database.Duration(24 * time.Hour),
+		SessionIdleTimeout:         database.Duration(time.Hour),
+		SessionMaxLifetime:         database.Duration(12 * time.Hour),
+		MaxConcurrentSessions:      5,
+		WebAuthNAttestation:        domain.AttestationConveyanceDirect,
+		WebAuthNUserVerification:   domain.UserVerificationRequirementRequired,
+		WebAuthNResidentKey:        domain.ResidentKeyRequirementRequired,
+		WebAuthNAllowedAAGUIDs:     database.TextArray[string]{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+		WebAuthNDeniedAAGUIDs:      database.TextArray[string]{"08987058-cadc-4b81-b6e1-30de50dcbe96"},
 		SecondFactors: []domain.SecondFactorType{
 			domain.SecondFactorTypeTOTP,
 			domain.SecondFactorTypeU2F,
@@ -64,12 +74,22 @@ func Test_loginSettingsToPb(t *testing.T) {
 		AllowDomainDiscovery:       true,
 		DisableLoginWithEmail:      true,
 		DisableLoginWithPhone:      true,
+		AllowMagicLink:             true,
 		DefaultRedirectUri:         "example.com",
 		PasswordCheckLifetime:      durationpb.New(time.Hour),
 		ExternalLoginCheckLifetime: durationpb.New(time.Minute),
 		MfaInitSkipLifetime:        durationpb.New(time.Millisecond),
 		SecondFactorCheckLifetime:  durationpb.New(time.Microsecond),
 		MultiFactorCheckLifetime:   durationpb.New(time.Nanosecond),
+		TrustedDeviceLifetime:      durationpb.New(24 * time.Hour),
+		SessionIdleTimeout:         durationpb.New(time.Hour),
+		SessionMaxLifetime:         durationpb.New(12 * time.Hour),
+		MaxConcurrentSessions:      5,
+		WebauthnAttestation:        settings.WebAuthNAttestation_WEBAUTHN_ATTESTATION_DIRECT,
+		WebauthnUserVerification:   settings.WebAuthNUserVerification_WEBAUTHN_USER_VERIFICATION_REQUIRED,
+		WebauthnResidentKey:        settings.WebAuthNResidentKey_WEBAUTHN_RESIDENT_KEY_REQUIRED,
+		WebauthnAllowedAaguids:     []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+		WebauthnDeniedAaguids:      []string{"08987058-cadc-4b81-b6e1-30de50dcbe96"},
 		SecondFactors: []settings.SecondFactorType{
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP,
 			settings.SecondFactorType_SECOND_FACTOR_TYPE_U2F,
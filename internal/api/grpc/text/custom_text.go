@@ -26,6 +26,22 @@ func ModelCustomMessageTextToPb(msg *query.MessageText) *text_pb.MessageCustomTe
 	}
 }
 
+func MailMessageTemplateToPb(template *query.MailMessageTemplate) *text_pb.MessageTemplate {
+	return &text_pb.MessageTemplate{
+		MessageType: template.MessageType,
+		Language:    template.Language.String(),
+		Html:        template.HTML,
+		PlainText:   template.PlainText,
+		Details: object.ToViewDetailsPb(
+			template.Sequence,
+			template.CreationDate,
+			template.ChangeDate,
+			template.AggregateID,
+		),
+		IsDefault: template.IsDefault,
+	}
+}
+
 func CustomLoginTextToPb(text *domain.CustomLoginText) *text_pb.LoginCustomText {
 	return &text_pb.LoginCustomText{
 		Details: object.ToViewDetailsPb(
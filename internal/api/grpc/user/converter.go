@@ -107,6 +107,7 @@ func PhoneToPb(phone *query.Phone) *user_pb.Phone {
 	return &user_pb.Phone{
 		Phone:           phone.Phone,
 		IsPhoneVerified: phone.IsVerified,
+		Channel:         PhoneChannelToPb(phone.Channel),
 	}
 }
 
@@ -121,6 +122,7 @@ func ModelPhoneToPb(phone *query.Phone) *user_pb.Phone {
 	return &user_pb.Phone{
 		Phone:           phone.Phone,
 		IsPhoneVerified: phone.IsVerified,
+		Channel:         PhoneChannelToPb(phone.Channel),
 	}
 }
 
@@ -137,6 +139,30 @@ func GenderToDomain(gender user_pb.Gender) domain.Gender {
 	}
 }
 
+func PhoneChannelToDomain(channel user_pb.PhoneChannel) domain.PhoneChannel {
+	switch channel {
+	case user_pb.PhoneChannel_PHONE_CHANNEL_SMS:
+		return domain.PhoneChannelSMS
+	case user_pb.PhoneChannel_PHONE_CHANNEL_WHATSAPP:
+		return domain.PhoneChannelWhatsApp
+	case user_pb.PhoneChannel_PHONE_CHANNEL_VOICE:
+		return domain.PhoneChannelVoice
+	default:
+		return domain.PhoneChannelUnspecified
+	}
+}
+
+func PhoneChannelsToDomain(channels []user_pb.PhoneChannel) []domain.PhoneChannel {
+	if len(channels) == 0 {
+		return nil
+	}
+	c := make([]domain.PhoneChannel, len(channels))
+	for i, channel := range channels {
+		c[i] = PhoneChannelToDomain(channel)
+	}
+	return c
+}
+
 func AccessTokenTypeToDomain(accessTokenType user_pb.AccessTokenType) domain.OIDCTokenType {
 	switch accessTokenType {
 	case user_pb.AccessTokenType_ACCESS_TOKEN_TYPE_BEARER:
@@ -180,6 +206,27 @@ func GenderToPb(gender domain.Gender) user_pb.Gender {
 	}
 }
 
+func PhoneChannelToPb(channel domain.PhoneChannel) user_pb.PhoneChannel {
+	switch channel {
+	case domain.PhoneChannelSMS:
+		return user_pb.PhoneChannel_PHONE_CHANNEL_SMS
+	case domain.PhoneChannelWhatsApp:
+		return user_pb.PhoneChannel_PHONE_CHANNEL_WHATSAPP
+	case domain.PhoneChannelVoice:
+		return user_pb.PhoneChannel_PHONE_CHANNEL_VOICE
+	default:
+		return user_pb.PhoneChannel_PHONE_CHANNEL_UNSPECIFIED
+	}
+}
+
+func PhoneChannelsToPb(channels []domain.PhoneChannel) []user_pb.PhoneChannel {
+	c := make([]user_pb.PhoneChannel, len(channels))
+	for i, channel := range channels {
+		c[i] = PhoneChannelToPb(channel)
+	}
+	return c
+}
+
 func AccessTokenTypeToPb(accessTokenType domain.OIDCTokenType) user_pb.AccessTokenType {
 	switch accessTokenType {
 	case domain.OIDCTokenTypeBearer:
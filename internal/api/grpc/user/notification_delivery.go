@@ -0,0 +1,115 @@
+package user
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	user_pb "github.com/zitadel/zitadel/pkg/grpc/user"
+)
+
+func NotificationDeliveriesToPb(deliveries []*query.NotificationDelivery) []*user_pb.NotificationDelivery {
+	d := make([]*user_pb.NotificationDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		d[i] = NotificationDeliveryToPb(delivery)
+	}
+	return d
+}
+
+func NotificationDeliveryToPb(delivery *query.NotificationDelivery) *user_pb.NotificationDelivery {
+	return &user_pb.NotificationDelivery{
+		Id:           delivery.ID,
+		Details:      object.ToViewDetailsPb(delivery.Sequence, delivery.CreationDate, delivery.ChangeDate, delivery.ResourceOwner),
+		UserId:       delivery.UserID,
+		MessageType:  delivery.MessageType,
+		Channel:      NotificationChannelToPb(delivery.NotificationType),
+		ProviderId:   delivery.ProviderID,
+		Attempts:     uint32(delivery.Attempts),
+		State:        NotificationDeliveryStateToPb(delivery.State),
+		LastError:    delivery.LastError,
+		EventType:    delivery.EventType,
+		CreationDate: timestamppb.New(delivery.CreationDate),
+	}
+}
+
+func NotificationChannelToPb(notificationType domain.NotificationType) user_pb.NotificationChannel {
+	switch notificationType {
+	case domain.NotificationTypeEmail:
+		return user_pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	case domain.NotificationTypeSms:
+		return user_pb.NotificationChannel_NOTIFICATION_CHANNEL_SMS
+	default:
+		return user_pb.NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+	}
+}
+
+func NotificationDeliveryStateToPb(state domain.NotificationDeliveryState) user_pb.NotificationDeliveryState {
+	switch state {
+	case domain.NotificationDeliveryStateSent:
+		return user_pb.NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_SENT
+	case domain.NotificationDeliveryStateRetrying:
+		return user_pb.NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_RETRYING
+	case domain.NotificationDeliveryStateFailed:
+		return user_pb.NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_FAILED
+	case domain.NotificationDeliveryStateResendRequested:
+		return user_pb.NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_RESEND_REQUESTED
+	default:
+		return user_pb.NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_UNSPECIFIED
+	}
+}
+
+func NotificationDeliveryStateToDomain(state user_pb.NotificationDeliveryState) domain.NotificationDeliveryState {
+	switch state {
+	case user_pb.NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_SENT:
+		return domain.NotificationDeliveryStateSent
+	case user_pb.NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_RETRYING:
+		return domain.NotificationDeliveryStateRetrying
+	case user_pb.NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_FAILED:
+		return domain.NotificationDeliveryStateFailed
+	case user_pb.NotificationDeliveryState_NOTIFICATION_DELIVERY_STATE_RESEND_REQUESTED:
+		return domain.NotificationDeliveryStateResendRequested
+	default:
+		return domain.NotificationDeliveryStateUnspecified
+	}
+}
+
+func NotificationDeliveryQueriesToQuery(queries []*user_pb.NotificationDeliveryQuery) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(queries))
+	for i, query := range queries {
+		q[i], err = NotificationDeliveryQueryToQuery(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func NotificationDeliveryQueryToQuery(q *user_pb.NotificationDeliveryQuery) (query.SearchQuery, error) {
+	switch q := q.Query.(type) {
+	case *user_pb.NotificationDeliveryQuery_MessageTypeQuery:
+		return query.NewNotificationDeliveryMessageTypeSearchQuery(q.MessageTypeQuery.MessageType)
+	case *user_pb.NotificationDeliveryQuery_StateQuery:
+		return query.NewNotificationDeliveryStateSearchQuery(NotificationDeliveryStateToDomain(q.StateQuery.State))
+	case *user_pb.NotificationDeliveryQuery_ChannelQuery:
+		return notificationChannelQueryToQuery(q.ChannelQuery.Channel)
+	case *user_pb.NotificationDeliveryQuery_UserIdQuery:
+		return query.NewNotificationDeliveryUserIDSearchQuery(q.UserIdQuery.UserId)
+	case *user_pb.NotificationDeliveryQuery_OrgIdQuery:
+		return query.NewNotificationDeliveryResourceOwnerSearchQuery(q.OrgIdQuery.OrgId)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "USER-Ooz4a", "List.Query.Invalid")
+	}
+}
+
+func notificationChannelQueryToQuery(channel user_pb.NotificationChannel) (query.SearchQuery, error) {
+	switch channel {
+	case user_pb.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL:
+		return query.NewNotificationDeliveryNotificationTypeSearchQuery(domain.NotificationTypeEmail)
+	case user_pb.NotificationChannel_NOTIFICATION_CHANNEL_SMS:
+		return query.NewNotificationDeliveryNotificationTypeSearchQuery(domain.NotificationTypeSms)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "USER-eiW7u", "List.Query.Invalid")
+	}
+}
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) ListConsents(ctx context.Context, req *connect.Request[user.ListConsentsRequest]) (*connect.Response[user.ListConsentsResponse], error) {
+	consents, err := s.query.UserConsentsByUserID(ctx, true, req.Msg.GetUserId(), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.ListConsentsResponse{
+		Details: object.ToListDetails(consents.SearchResponse),
+		Result:  consentsToPb(consents.Consents),
+	}), nil
+}
+
+func (s *Server) RevokeConsent(ctx context.Context, req *connect.Request[user.RevokeConsentRequest]) (*connect.Response[user.RevokeConsentResponse], error) {
+	objectDetails, err := s.command.RevokeHumanConsent(ctx, req.Msg.GetUserId(), req.Msg.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.RevokeConsentResponse{
+		Details: object.DomainToDetailsPb(objectDetails),
+	}), nil
+}
+
+func consentsToPb(consents []*query.UserConsent) []*user.Consent {
+	c := make([]*user.Consent, len(consents))
+	for i, consent := range consents {
+		c[i] = &user.Consent{
+			CreationDate: timestamppb.New(consent.CreationDate),
+			ChangeDate:   timestamppb.New(consent.ChangeDate),
+			ClientId:     consent.ClientID,
+			Scopes:       consent.Scopes,
+		}
+	}
+	return c
+}
@@ -0,0 +1,54 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func Test_consentsToPb(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		consents []*query.UserConsent
+		want     []*user.Consent
+	}{
+		{
+			name:     "empty",
+			consents: []*query.UserConsent{},
+			want:     []*user.Consent{},
+		},
+		{
+			name: "consents",
+			consents: []*query.UserConsent{
+				{
+					UserID:        "userID",
+					ClientID:      "clientID",
+					CreationDate:  now,
+					ChangeDate:    now,
+					ResourceOwner: "org1",
+					Scopes:        database.TextArray[string]{"openid", "profile"},
+				},
+			},
+			want: []*user.Consent{
+				{
+					CreationDate: timestamppb.New(now),
+					ChangeDate:   timestamppb.New(now),
+					ClientId:     "clientID",
+					Scopes:       []string{"openid", "profile"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, consentsToPb(tt.consents))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package convert
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+// StaleUsersToPb converts the stale users, the action is the one the policy currently takes on the user.
+func StaleUsersToPb(users []*query.StaleUser, policy *domain.InactivityPolicy, now time.Time) []*user.StaleUser {
+	result := make([]*user.StaleUser, len(users))
+	for i, staleUser := range users {
+		result[i] = &user.StaleUser{
+			UserId:         staleUser.ID,
+			Username:       staleUser.Username,
+			OrganizationId: staleUser.ResourceOwner,
+			Type:           staleUserTypeToPb(staleUser.Type),
+			State:          userStateToPb(staleUser.State),
+			LastActivity:   timestamppb.New(staleUser.LastActivity),
+			Action:         inactivityActionToPb(policy.Action(staleUser.LastActivity, now)),
+		}
+	}
+	return result
+}
+
+func staleUserTypeToPb(userType domain.UserType) user.Type {
+	switch userType {
+	case domain.UserTypeHuman:
+		return user.Type_TYPE_HUMAN
+	case domain.UserTypeMachine:
+		return user.Type_TYPE_MACHINE
+	case domain.UserTypeUnspecified:
+		return user.Type_TYPE_UNSPECIFIED
+	default:
+		return user.Type_TYPE_UNSPECIFIED
+	}
+}
+
+func inactivityActionToPb(action domain.InactivityAction) user.InactivityAction {
+	switch action {
+	case domain.InactivityActionWarn:
+		return user.InactivityAction_INACTIVITY_ACTION_WARN
+	case domain.InactivityActionDeactivate:
+		return user.InactivityAction_INACTIVITY_ACTION_DEACTIVATE
+	case domain.InactivityActionDelete:
+		return user.InactivityAction_INACTIVITY_ACTION_DELETE
+	case domain.InactivityActionNone:
+		return user.InactivityAction_INACTIVITY_ACTION_UNSPECIFIED
+	default:
+		return user.InactivityAction_INACTIVITY_ACTION_UNSPECIFIED
+	}
+}
@@ -0,0 +1,121 @@
+package convert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func Test_StaleUsersToPb(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	policy := &domain.InactivityPolicy{
+		WarnDays:       30,
+		DeactivateDays: 60,
+		DeleteDays:     90,
+	}
+
+	tt := []struct {
+		testName  string
+		inputUser *query.StaleUser
+		expected  *user.StaleUser
+	}{
+		{
+			testName: "human user to warn",
+			inputUser: &query.StaleUser{
+				ID:            "user1",
+				ResourceOwner: "org1",
+				Username:      "username",
+				Type:          domain.UserTypeHuman,
+				State:         domain.UserStateActive,
+				LastActivity:  now.AddDate(0, 0, -40),
+			},
+			expected: &user.StaleUser{
+				UserId:         "user1",
+				Username:       "username",
+				OrganizationId: "org1",
+				Type:           user.Type_TYPE_HUMAN,
+				State:          user.UserState_USER_STATE_ACTIVE,
+				LastActivity:   timestamppb.New(now.AddDate(0, 0, -40)),
+				Action:         user.InactivityAction_INACTIVITY_ACTION_WARN,
+			},
+		},
+		{
+			testName: "machine user to deactivate",
+			inputUser: &query.StaleUser{
+				ID:            "user2",
+				ResourceOwner: "org1",
+				Username:      "machine",
+				Type:          domain.UserTypeMachine,
+				State:         domain.UserStateActive,
+				LastActivity:  now.AddDate(0, 0, -70),
+			},
+			expected: &user.StaleUser{
+				UserId:         "user2",
+				Username:       "machine",
+				OrganizationId: "org1",
+				Type:           user.Type_TYPE_MACHINE,
+				State:          user.UserState_USER_STATE_ACTIVE,
+				LastActivity:   timestamppb.New(now.AddDate(0, 0, -70)),
+				Action:         user.InactivityAction_INACTIVITY_ACTION_DEACTIVATE,
+			},
+		},
+		{
+			testName: "inactive user to delete",
+			inputUser: &query.StaleUser{
+				ID:            "user3",
+				ResourceOwner: "org1",
+				Username:      "inactive",
+				Type:          domain.UserTypeHuman,
+				State:         domain.UserStateInactive,
+				LastActivity:  now.AddDate(0, 0, -100),
+			},
+			expected: &user.StaleUser{
+				UserId:         "user3",
+				Username:       "inactive",
+				OrganizationId: "org1",
+				Type:           user.Type_TYPE_HUMAN,
+				State:          user.UserState_USER_STATE_INACTIVE,
+				LastActivity:   timestamppb.New(now.AddDate(0, 0, -100)),
+				Action:         user.InactivityAction_INACTIVITY_ACTION_DELETE,
+			},
+		},
+		{
+			testName: "user without action",
+			inputUser: &query.StaleUser{
+				ID:            "user4",
+				ResourceOwner: "org1",
+				Username:      "active",
+				Type:          domain.UserTypeHuman,
+				State:         domain.UserStateActive,
+				LastActivity:  now.AddDate(0, 0, -10),
+			},
+			expected: &user.StaleUser{
+				UserId:         "user4",
+				Username:       "active",
+				OrganizationId: "org1",
+				Type:           user.Type_TYPE_HUMAN,
+				State:          user.UserState_USER_STATE_ACTIVE,
+				LastActivity:   timestamppb.New(now.AddDate(0, 0, -10)),
+				Action:         user.InactivityAction_INACTIVITY_ACTION_UNSPECIFIED,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+
+			res := StaleUsersToPb([]*query.StaleUser{tc.inputUser}, policy, now)
+
+			assert.Equal(t, []*user.StaleUser{tc.expected}, res)
+		})
+	}
+}
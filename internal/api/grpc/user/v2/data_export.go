@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/userexport"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	object_pb "github.com/zitadel/zitadel/pkg/grpc/object/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) ExportUserData(ctx context.Context, req *connect.Request[user.ExportUserDataRequest]) (*connect.Response[user.ExportUserDataResponse], error) {
+	exportID, details, err := s.command.RequestUserDataExport(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.ExportUserDataResponse{
+		Details:  object.DomainToDetailsPb(details),
+		ExportId: exportID,
+	}), nil
+}
+
+func (s *Server) GetUserDataExport(ctx context.Context, req *connect.Request[user.GetUserDataExportRequest]) (*connect.Response[user.GetUserDataExportResponse], error) {
+	export, err := s.query.UserDataExportByID(ctx, req.Msg.GetUserId(), req.Msg.GetExportId(), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	resp := &user.GetUserDataExportResponse{
+		Details: userDataExportToDetailsPb(export),
+		State:   userDataExportStateToPb(export.State),
+	}
+	if export.State != domain.UserDataExportStateSucceeded {
+		return connect.NewResponse(resp), nil
+	}
+	if s.storage == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "USERv2-ahT0e", "Errors.Assets.Store.NotConfigured")
+	}
+	resp.Data, _, err = s.storage.GetObject(ctx, authz.GetInstance(ctx).InstanceID(), export.ResourceOwner, userexport.ObjectName(export.UserID, export.ID))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func userDataExportToDetailsPb(export *query.UserDataExport) *object_pb.Details {
+	return &object_pb.Details{
+		ResourceOwner: export.ResourceOwner,
+		CreationDate:  timestamppb.New(export.CreationDate),
+		ChangeDate:    timestamppb.New(export.ChangeDate),
+	}
+}
+
+func userDataExportStateToPb(state domain.UserDataExportState) user.UserDataExportState {
+	switch state {
+	case domain.UserDataExportStateRequested:
+		return user.UserDataExportState_USER_DATA_EXPORT_STATE_REQUESTED
+	case domain.UserDataExportStateSucceeded:
+		return user.UserDataExportState_USER_DATA_EXPORT_STATE_SUCCEEDED
+	case domain.UserDataExportStateFailed:
+		return user.UserDataExportState_USER_DATA_EXPORT_STATE_FAILED
+	case domain.UserDataExportStateExpired:
+		return user.UserDataExportState_USER_DATA_EXPORT_STATE_EXPIRED
+	case domain.UserDataExportStateUnspecified:
+		return user.UserDataExportState_USER_DATA_EXPORT_STATE_UNSPECIFIED
+	default:
+		return user.UserDataExportState_USER_DATA_EXPORT_STATE_UNSPECIFIED
+	}
+}
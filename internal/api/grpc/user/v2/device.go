@@ -0,0 +1,135 @@
+package user
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) ListDevices(ctx context.Context, req *connect.Request[user.ListDevicesRequest]) (*connect.Response[user.ListDevicesResponse], error) {
+	devices, err := s.query.UserDevicesByUserID(ctx, true, req.Msg.GetUserId(), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.ListDevicesResponse{
+		Details: object.ToListDetails(devices.SearchResponse),
+		Result:  devicesToPb(devices.Devices),
+	}), nil
+}
+
+func (s *Server) RevokeDevice(ctx context.Context, req *connect.Request[user.RevokeDeviceRequest]) (*connect.Response[user.RevokeDeviceResponse], error) {
+	devices, err := s.query.UserDevicesByUserID(ctx, true, req.Msg.GetUserId(), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	device := deviceByFingerprintID(devices.Devices, req.Msg.GetFingerprintId())
+	if device == nil {
+		return nil, zerrors.ThrowNotFound(nil, "USERv2-ohk3I", "Errors.User.Device.NotFound")
+	}
+	objectDetails, err := s.command.RevokeUserDevice(ctx, req.Msg.GetUserId(), deviceToCommand(device))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.RevokeDeviceResponse{
+		Details: object.DomainToDetailsPb(objectDetails),
+	}), nil
+}
+
+func deviceByFingerprintID(devices []*query.UserDevice, fingerprintID string) *query.UserDevice {
+	for _, device := range devices {
+		if device.FingerprintID == fingerprintID {
+			return device
+		}
+	}
+	return nil
+}
+
+// deviceToCommand collects the sessions and tokens of the device.
+// The sessions OIDC and SAML sessions were created from are terminated as well,
+// so the applications are notified through the back-channel logout.
+func deviceToCommand(device *query.UserDevice) *command.UserDevice {
+	cmd := &command.UserDevice{
+		FingerprintID: device.FingerprintID,
+	}
+	sessionIDs := make(map[string]struct{})
+	addSessionID := func(id string) {
+		if _, ok := sessionIDs[id]; ok || id == "" {
+			return
+		}
+		sessionIDs[id] = struct{}{}
+		cmd.SessionIDs = append(cmd.SessionIDs, id)
+	}
+	for _, session := range device.Sessions {
+		switch session.Type {
+		case domain.DeviceSessionTypeSession:
+			addSessionID(session.ID)
+		case domain.DeviceSessionTypeOIDC:
+			cmd.OIDCSessionIDs = append(cmd.OIDCSessionIDs, session.ID)
+			addSessionID(session.SessionID)
+		case domain.DeviceSessionTypeSAML:
+			cmd.SAMLSessionIDs = append(cmd.SAMLSessionIDs, session.ID)
+			addSessionID(session.SessionID)
+		case domain.DeviceSessionTypeRefreshToken:
+			cmd.RefreshTokenIDs = append(cmd.RefreshTokenIDs, session.ID)
+		case domain.DeviceSessionTypeUnspecified:
+		}
+	}
+	return cmd
+}
+
+func devicesToPb(devices []*query.UserDevice) []*user.Device {
+	d := make([]*user.Device, len(devices))
+	for i, device := range devices {
+		d[i] = &user.Device{
+			FingerprintId: device.FingerprintID,
+			Description:   device.Description,
+			Ip:            device.IP,
+			LastUsed:      timestamppb.New(device.LastUsed),
+			Sessions:      deviceSessionsToPb(device.Sessions),
+		}
+	}
+	return d
+}
+
+func deviceSessionsToPb(sessions []*query.DeviceSession) []*user.DeviceSession {
+	s := make([]*user.DeviceSession, len(sessions))
+	for i, session := range sessions {
+		s[i] = &user.DeviceSession{
+			Id:           session.ID,
+			Type:         deviceSessionTypeToPb(session.Type),
+			SessionId:    session.SessionID,
+			ClientId:     session.ClientID,
+			CreationDate: timestamppb.New(session.CreationDate),
+			LastUsed:     timestamppb.New(session.LastUsed),
+		}
+		if !session.Expiration.IsZero() {
+			s[i].ExpirationDate = timestamppb.New(session.Expiration)
+		}
+	}
+	return s
+}
+
+func deviceSessionTypeToPb(sessionType domain.DeviceSessionType) user.DeviceSessionType {
+	switch sessionType {
+	case domain.DeviceSessionTypeSession:
+		return user.DeviceSessionType_DEVICE_SESSION_TYPE_SESSION
+	case domain.DeviceSessionTypeOIDC:
+		return user.DeviceSessionType_DEVICE_SESSION_TYPE_OIDC
+	case domain.DeviceSessionTypeSAML:
+		return user.DeviceSessionType_DEVICE_SESSION_TYPE_SAML
+	case domain.DeviceSessionTypeRefreshToken:
+		return user.DeviceSessionType_DEVICE_SESSION_TYPE_REFRESH_TOKEN
+	case domain.DeviceSessionTypeUnspecified:
+		return user.DeviceSessionType_DEVICE_SESSION_TYPE_UNSPECIFIED
+	default:
+		return user.DeviceSessionType_DEVICE_SESSION_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,111 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func Test_devicesToPb(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		devices []*query.UserDevice
+		want    []*user.Device
+	}{
+		{
+			name:    "empty",
+			devices: []*query.UserDevice{},
+			want:    []*user.Device{},
+		},
+		{
+			name: "devices",
+			devices: []*query.UserDevice{
+				{
+					FingerprintID: "fingerprintID",
+					Description:   "firefox",
+					IP:            "1.2.3.4",
+					LastUsed:      now,
+					Sessions: []*query.DeviceSession{
+						{
+							ID:            "oidcSessionID",
+							Type:          domain.DeviceSessionTypeOIDC,
+							ResourceOwner: "org1",
+							SessionID:     "sessionID",
+							ClientID:      "clientID",
+							FingerprintID: "fingerprintID",
+							CreationDate:  now,
+							LastUsed:      now,
+							Expiration:    now.Add(time.Hour),
+						},
+						{
+							ID:            "sessionID",
+							Type:          domain.DeviceSessionTypeSession,
+							ResourceOwner: "org1",
+							FingerprintID: "fingerprintID",
+							CreationDate:  now,
+							LastUsed:      now,
+						},
+					},
+				},
+			},
+			want: []*user.Device{
+				{
+					FingerprintId: "fingerprintID",
+					Description:   "firefox",
+					Ip:            "1.2.3.4",
+					LastUsed:      timestamppb.New(now),
+					Sessions: []*user.DeviceSession{
+						{
+							Id:             "oidcSessionID",
+							Type:           user.DeviceSessionType_DEVICE_SESSION_TYPE_OIDC,
+							SessionId:      "sessionID",
+							ClientId:       "clientID",
+							CreationDate:   timestamppb.New(now),
+							LastUsed:       timestamppb.New(now),
+							ExpirationDate: timestamppb.New(now.Add(time.Hour)),
+						},
+						{
+							Id:           "sessionID",
+							Type:         user.DeviceSessionType_DEVICE_SESSION_TYPE_SESSION,
+							CreationDate: timestamppb.New(now),
+							LastUsed:     timestamppb.New(now),
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, devicesToPb(tt.devices))
+		})
+	}
+}
+
+func Test_deviceToCommand(t *testing.T) {
+	device := &query.UserDevice{
+		FingerprintID: "fingerprintID",
+		Sessions: []*query.DeviceSession{
+			{ID: "oidcSessionID", Type: domain.DeviceSessionTypeOIDC, SessionID: "sessionID"},
+			{ID: "samlSessionID", Type: domain.DeviceSessionTypeSAML, SessionID: "sessionID"},
+			{ID: "sessionID", Type: domain.DeviceSessionTypeSession},
+			{ID: "otherSessionID", Type: domain.DeviceSessionTypeSession},
+			{ID: "tokenID", Type: domain.DeviceSessionTypeRefreshToken},
+		},
+	}
+	assert.Equal(t, &command.UserDevice{
+		FingerprintID:   "fingerprintID",
+		SessionIDs:      []string{"sessionID", "otherSessionID"},
+		OIDCSessionIDs:  []string{"oidcSessionID"},
+		SAMLSessionIDs:  []string{"samlSessionID"},
+		RefreshTokenIDs: []string{"tokenID"},
+	}, deviceToCommand(device))
+}
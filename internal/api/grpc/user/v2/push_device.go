@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) RegisterPushDevice(ctx context.Context, req *connect.Request[user.RegisterPushDeviceRequest]) (*connect.Response[user.RegisterPushDeviceResponse], error) {
+	details, err := s.command.AddUserPushDevice(ctx, req.Msg.GetUserId(), "", req.Msg.GetName(), req.Msg.GetPublicKey(), req.Msg.GetPushToken())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.RegisterPushDeviceResponse{
+		Details:   object.DomainToDetailsPb(details.ObjectDetails),
+		DeviceId:  details.ID,
+		Challenge: details.Challenge,
+	}), nil
+}
+
+func (s *Server) VerifyPushDeviceRegistration(ctx context.Context, req *connect.Request[user.VerifyPushDeviceRegistrationRequest]) (*connect.Response[user.VerifyPushDeviceRegistrationResponse], error) {
+	objectDetails, err := s.command.VerifyUserPushDevice(ctx, req.Msg.GetUserId(), "", req.Msg.GetDeviceId(), req.Msg.GetSignedChallenge())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.VerifyPushDeviceRegistrationResponse{
+		Details: object.DomainToDetailsPb(objectDetails),
+	}), nil
+}
+
+func (s *Server) RemovePushDevice(ctx context.Context, req *connect.Request[user.RemovePushDeviceRequest]) (*connect.Response[user.RemovePushDeviceResponse], error) {
+	objectDetails, err := s.command.RemoveUserPushDevice(ctx, req.Msg.GetUserId(), "", req.Msg.GetDeviceId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.RemovePushDeviceResponse{
+		Details: object.DomainToDetailsPb(objectDetails),
+	}), nil
+}
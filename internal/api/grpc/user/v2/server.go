@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
 	"github.com/zitadel/zitadel/pkg/grpc/user/v2/userconnect"
 )
@@ -30,6 +31,7 @@ type Server struct {
 	samlRootURL    func(ctx context.Context, idpID string) string
 
 	assetAPIPrefix func(context.Context) string
+	storage        static.Storage
 
 	checkPermission domain.PermissionCheck
 }
@@ -45,6 +47,7 @@ func CreateServer(
 	idpCallback func(ctx context.Context) string,
 	samlRootURL func(ctx context.Context, idpID string) string,
 	assetAPIPrefix func(ctx context.Context) string,
+	storage static.Storage,
 	checkPermission domain.PermissionCheck,
 ) *Server {
 	return &Server{
@@ -55,6 +58,7 @@ func CreateServer(
 		idpCallback:     idpCallback,
 		samlRootURL:     samlRootURL,
 		assetAPIPrefix:  assetAPIPrefix,
+		storage:         storage,
 		checkPermission: checkPermission,
 		systemDefaults:  systemDefaults,
 	}
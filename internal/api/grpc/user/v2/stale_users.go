@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/api/grpc/user/v2/convert"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) ListStaleUsers(ctx context.Context, req *connect.Request[user.ListStaleUsersRequest]) (*connect.Response[user.ListStaleUsersResponse], error) {
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.checkPermission(ctx, domain.PermissionUserRead, orgID, ""); err != nil {
+		return nil, err
+	}
+	policy, err := s.query.InactivityPolicyByOrg(ctx, true, orgID)
+	if err != nil {
+		return nil, err
+	}
+	inactivityPolicy := policy.ToDomain()
+	inactiveDays := uint64(req.Msg.GetInactiveDays())
+	if req.Msg.InactiveDays == nil {
+		inactiveDays = inactivityPolicy.FirstStepDays()
+	}
+	if inactiveDays == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "USERv2-ieW4a", "Errors.Policy.Inactivity.Disabled")
+	}
+	now := time.Now()
+	offset, limit, _ := object.ListQueryToQuery(req.Msg.GetQuery())
+	res, err := s.query.SearchStaleUsers(ctx, &query.StaleUsersSearchQueries{
+		Offset:             offset,
+		Limit:              limit,
+		InactiveSince:      now.AddDate(0, 0, -int(inactiveDays)),
+		ResourceOwners:     []string{orgID},
+		ExemptMachineUsers: inactivityPolicy.ExemptMachineUsers,
+		ExemptRoles:        inactivityPolicy.ExemptRoles,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.ListStaleUsersResponse{
+		Details: object.ToListDetails(res.SearchResponse),
+		Result:  convert.StaleUsersToPb(res.Users, inactivityPolicy, now),
+	}), nil
+}
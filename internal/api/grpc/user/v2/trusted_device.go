@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) ListTrustedDevices(ctx context.Context, req *connect.Request[user.ListTrustedDevicesRequest]) (*connect.Response[user.ListTrustedDevicesResponse], error) {
+	devices, err := s.query.UserTrustedDevicesByUserID(ctx, true, req.Msg.GetUserId(), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.ListTrustedDevicesResponse{
+		Details: object.ToListDetails(devices.SearchResponse),
+		Result:  trustedDevicesToPb(devices.TrustedDevices),
+	}), nil
+}
+
+func (s *Server) RemoveTrustedDevice(ctx context.Context, req *connect.Request[user.RemoveTrustedDeviceRequest]) (*connect.Response[user.RemoveTrustedDeviceResponse], error) {
+	objectDetails, err := s.command.RemoveHumanTrustedDevice(ctx, req.Msg.GetUserId(), req.Msg.GetFingerprintId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.RemoveTrustedDeviceResponse{
+		Details: object.DomainToDetailsPb(objectDetails),
+	}), nil
+}
+
+func trustedDevicesToPb(devices []*query.UserTrustedDevice) []*user.TrustedDevice {
+	d := make([]*user.TrustedDevice, len(devices))
+	for i, device := range devices {
+		d[i] = &user.TrustedDevice{
+			CreationDate:   timestamppb.New(device.CreationDate),
+			ChangeDate:     timestamppb.New(device.ChangeDate),
+			FingerprintId:  device.FingerprintID,
+			Description:    device.Description,
+			ExpirationDate: timestamppb.New(device.ExpirationDate),
+		}
+	}
+	return d
+}
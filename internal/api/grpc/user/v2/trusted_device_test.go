@@ -0,0 +1,55 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func Test_trustedDevicesToPb(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		devices []*query.UserTrustedDevice
+		want    []*user.TrustedDevice
+	}{
+		{
+			name:    "empty",
+			devices: []*query.UserTrustedDevice{},
+			want:    []*user.TrustedDevice{},
+		},
+		{
+			name: "trusted devices",
+			devices: []*query.UserTrustedDevice{
+				{
+					UserID:         "userID",
+					FingerprintID:  "fingerprintID",
+					CreationDate:   now,
+					ChangeDate:     now,
+					ResourceOwner:  "org1",
+					Description:    "firefox",
+					ExpirationDate: now.Add(time.Hour),
+				},
+			},
+			want: []*user.TrustedDevice{
+				{
+					CreationDate:   timestamppb.New(now),
+					ChangeDate:     timestamppb.New(now),
+					FingerprintId:  "fingerprintID",
+					Description:    "firefox",
+					ExpirationDate: timestamppb.New(now.Add(time.Hour)),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, trustedDevicesToPb(tt.devices))
+		})
+	}
+}
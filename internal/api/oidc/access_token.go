@@ -34,6 +34,10 @@ type accessToken struct {
 	tokenExpiration   time.Time
 	isPAT             bool
 	actor             *domain.TokenActor
+	// authorizationDetails granted for the token (RFC 9396)
+	authorizationDetails domain.AuthorizationDetails
+	// acr satisfied by the authentication of the token
+	acr string
 }
 
 var ErrInvalidTokenFormat = errors.New("invalid token format")
@@ -98,20 +102,27 @@ func accessTokenV1(tokenID, subject string, token *model.TokenView) *accessToken
 }
 
 func accessTokenV2(tokenID, subject string, token *query.OIDCSessionAccessTokenReadModel) *accessToken {
+	audience := token.Audience
+	// a token restricted to resources (RFC 8707) is only valid for them
+	if len(token.Resources) > 0 {
+		audience = token.Resources
+	}
 	return &accessToken{
-		tokenID:           tokenID,
-		userID:            token.UserID,
-		resourceOwner:     token.ResourceOwner,
-		subject:           subject,
-		preferredLanguage: token.PreferredLanguage,
-		clientID:          token.ClientID,
-		audience:          token.Audience,
-		scope:             token.Scope,
-		authMethods:       token.AuthMethods,
-		authTime:          token.AuthTime,
-		tokenCreation:     token.AccessTokenCreation,
-		tokenExpiration:   token.AccessTokenExpiration,
-		actor:             token.Actor,
+		tokenID:              tokenID,
+		userID:               token.UserID,
+		resourceOwner:        token.ResourceOwner,
+		subject:              subject,
+		preferredLanguage:    token.PreferredLanguage,
+		clientID:             token.ClientID,
+		audience:             audience,
+		scope:                token.Scope,
+		authMethods:          token.AuthMethods,
+		authTime:             token.AuthTime,
+		tokenCreation:        token.AccessTokenCreation,
+		tokenExpiration:      token.AccessTokenExpiration,
+		actor:                token.Actor,
+		authorizationDetails: token.AuthorizationDetails,
+		acr:                  token.ACR,
 	}
 }
 
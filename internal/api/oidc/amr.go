@@ -18,6 +18,8 @@ const (
 	OTP = "otp"
 	// UserPresence states that the end users presence has been verified (e.g. passkey and u2f)
 	UserPresence = "user"
+	// SoftwareKey states that the possession of a software-secured key has been proven (e.g. push approval on a device)
+	SoftwareKey = "swk"
 )
 
 // AuthMethodTypesToAMR maps zitadel auth method types to Authentication Method Reference Values
@@ -41,15 +43,20 @@ func AuthMethodTypesToAMR(methodTypes []domain.UserAuthMethodType) []string {
 		case domain.UserAuthMethodTypeU2F:
 			amr = append(amr, UserPresence)
 			factors++
+		case domain.UserAuthMethodTypePush:
+			amr = append(amr, SoftwareKey)
+			factors++
 		case domain.UserAuthMethodTypeOTP,
 			domain.UserAuthMethodTypeTOTP,
 			domain.UserAuthMethodTypeOTPSMS,
 			domain.UserAuthMethodTypeOTPEmail,
-			domain.UserAuthMethodTypeRecoveryCode:
+			domain.UserAuthMethodTypeRecoveryCode,
+			domain.UserAuthMethodTypeMagicLink:
 			// a user could use multiple (t)otp, which is a factor, but still will be returned as a single `otp` entry
 			otp++
 			factors++
-		case domain.UserAuthMethodTypeIDP:
+		case domain.UserAuthMethodTypeIDP,
+			domain.UserAuthMethodTypeTrustedDevice:
 			// no AMR value according to specification
 			factors++
 		case domain.UserAuthMethodTypeUnspecified:
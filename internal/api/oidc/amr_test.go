@@ -80,6 +80,20 @@ func TestAMR(t *testing.T) {
 			},
 			[]string{PWD, UserPresence, MFA},
 		},
+		{
+			"password on trusted device",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeTrustedDevice},
+			},
+			[]string{PWD, MFA},
+		},
+		{
+			"password and push",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypePush},
+			},
+			[]string{PWD, SoftwareKey, MFA},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -110,22 +110,26 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 		return nil, err
 	}
 	authRequest := &command.AuthRequest{
-		LoginClient:      loginClient,
-		ClientID:         req.ClientID,
-		RedirectURI:      req.RedirectURI,
-		State:            req.State,
-		Nonce:            req.Nonce,
-		Scope:            scope,
-		Audience:         audience,
-		NeedRefreshToken: slices.Contains(scope, oidc.ScopeOfflineAccess),
-		ResponseType:     ResponseTypeToBusiness(req.ResponseType),
-		ResponseMode:     ResponseModeToBusiness(req.ResponseMode),
-		CodeChallenge:    CodeChallengeToBusiness(req.CodeChallenge, req.CodeChallengeMethod),
-		Prompt:           PromptToBusiness(req.Prompt),
-		UILocales:        UILocalesToBusiness(req.UILocales),
-		MaxAge:           MaxAgeToBusiness(req.MaxAge),
-		Issuer:           o.contextToIssuer(ctx),
-		OrganizationID:   orgID,
+		LoginClient:          loginClient,
+		ClientID:             req.ClientID,
+		RedirectURI:          req.RedirectURI,
+		State:                req.State,
+		Nonce:                req.Nonce,
+		Scope:                scope,
+		Audience:             audience,
+		NeedRefreshToken:     slices.Contains(scope, oidc.ScopeOfflineAccess),
+		ResponseType:         ResponseTypeToBusiness(req.ResponseType),
+		ResponseMode:         ResponseModeToBusiness(req.ResponseMode),
+		CodeChallenge:        CodeChallengeToBusiness(req.CodeChallenge, req.CodeChallengeMethod),
+		Prompt:               PromptToBusiness(req.Prompt),
+		UILocales:            UILocalesToBusiness(req.UILocales),
+		MaxAge:               MaxAgeToBusiness(req.MaxAge),
+		Issuer:               o.contextToIssuer(ctx),
+		OrganizationID:       orgID,
+		AuthorizationDetails: authorizationDetailsFromContext(ctx),
+		RequireConsent:       requireConsentFromContext(ctx),
+		Resources:            resourcesFromContext(ctx),
+		ACRValues:            req.ACRValues,
 	}
 	if req.LoginHint != "" {
 		authRequest.LoginHint = &req.LoginHint
@@ -146,6 +150,18 @@ func (o *OPStorage) createAuthRequest(ctx context.Context, req *oidc.AuthRequest
 	if !ok {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-sd436", "no user agent id")
 	}
+	// rich authorization requests are only supported by the login v2
+	if len(authorizationDetailsFromContext(ctx)) > 0 {
+		return nil, invalidAuthorizationDetailsError(zerrors.ThrowInvalidArgument(nil, "OIDC-eeY3o", "Errors.AuthRequest.AuthorizationDetails.LoginV1NotSupported"))
+	}
+	// resource indicators are only supported by the login v2
+	if len(resourcesFromContext(ctx)) > 0 {
+		return nil, invalidTargetError(zerrors.ThrowInvalidArgument(nil, "OIDC-Ohb0r", "Errors.AuthRequest.Resource.LoginV1NotSupported"))
+	}
+	// asking the user for consent is only supported by the login v2
+	if requireConsentFromContext(ctx) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-ohN4e", "Errors.AuthRequest.ConsentLoginV1NotSupported")
+	}
 	// we do not need to handle the orgID for the v1 login, since it handles it already
 	scope, audience, _, err := o.createAuthRequestScopeAndAudience(ctx, req.ClientID, req.Scopes)
 	if err != nil {
@@ -654,6 +670,7 @@ func (s *Server) CreateTokenCallbackURL(ctx context.Context, req op.AuthRequest)
 		implicitFlowComplianceChecker(),
 		slices.Contains(client.GrantTypes(), oidc.GrantTypeRefreshToken),
 		client.client.BackChannelLogoutURI,
+		nil,
 	)
 	if err != nil {
 		return "", err
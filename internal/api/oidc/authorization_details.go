@@ -0,0 +1,55 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// errorInvalidAuthorizationDetails is the error code defined in
+// [RFC 9396, Section 5](https://www.rfc-editor.org/rfc/rfc9396#section-5).
+const errorInvalidAuthorizationDetails = "invalid_authorization_details"
+
+type authorizationDetailsKey struct{}
+
+func contextWithAuthorizationDetails(ctx context.Context, details domain.AuthorizationDetails) context.Context {
+	if len(details) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, authorizationDetailsKey{}, details)
+}
+
+func authorizationDetailsFromContext(ctx context.Context) domain.AuthorizationDetails {
+	details, _ := ctx.Value(authorizationDetailsKey{}).(domain.AuthorizationDetails)
+	return details
+}
+
+// parseAuthorizationDetails parses the authorization_details parameter of an authorization request
+// and checks that all types are allowed for the client.
+func parseAuthorizationDetails(param string, client *Client) (domain.AuthorizationDetails, error) {
+	details, err := domain.ParseAuthorizationDetails(param)
+	if err != nil {
+		return nil, invalidAuthorizationDetailsError(err)
+	}
+	var allowedTypes []string
+	if client != nil {
+		allowedTypes = client.client.AuthorizationDetailsTypes
+	}
+	if err = details.ValidateTypes(allowedTypes); err != nil {
+		return nil, invalidAuthorizationDetailsError(err)
+	}
+	return details, nil
+}
+
+func invalidAuthorizationDetailsError(err error) *oidc.Error {
+	oidcErr := (&oidc.Error{ErrorType: errorInvalidAuthorizationDetails}).WithParent(err)
+	var zErr *zerrors.ZitadelError
+	if errors.As(err, &zErr) {
+		oidcErr.Description = zErr.GetMessage()
+	}
+	return oidcErr
+}
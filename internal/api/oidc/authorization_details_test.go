@@ -0,0 +1,74 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_parseAuthorizationDetails(t *testing.T) {
+	client := &Client{
+		client: &query.OIDCClient{
+			AuthorizationDetailsTypes: []string{"payment_initiation"},
+		},
+	}
+	tests := []struct {
+		name        string
+		param       string
+		client      *Client
+		want        domain.AuthorizationDetails
+		wantErrDesc string
+	}{
+		{
+			name:   "no details",
+			param:  "",
+			client: client,
+			want:   nil,
+		},
+		{
+			name:        "invalid",
+			param:       "payment_initiation",
+			client:      client,
+			wantErrDesc: "Errors.AuthRequest.AuthorizationDetails.Invalid",
+		},
+		{
+			name:        "type not allowed",
+			param:       `[{"type":"account_information"}]`,
+			client:      client,
+			wantErrDesc: "Errors.AuthRequest.AuthorizationDetails.TypeNotAllowed",
+		},
+		{
+			name:        "no client",
+			param:       `[{"type":"payment_initiation"}]`,
+			client:      nil,
+			wantErrDesc: "Errors.AuthRequest.AuthorizationDetails.TypeNotAllowed",
+		},
+		{
+			name:   "allowed",
+			param:  `[{"type":"payment_initiation","actions":["initiate"]}]`,
+			client: client,
+			want: domain.AuthorizationDetails{
+				{Type: "payment_initiation", Actions: []string{"initiate"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAuthorizationDetails(tt.param, tt.client)
+			if tt.wantErrDesc != "" {
+				var oidcErr *oidc.Error
+				require.ErrorAs(t, err, &oidcErr)
+				assert.EqualValues(t, errorInvalidAuthorizationDetails, oidcErr.ErrorType)
+				assert.Equal(t, tt.wantErrDesc, oidcErr.Description)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
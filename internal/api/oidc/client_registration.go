@@ -60,6 +60,15 @@ func (s *Server) dynamicClientRegistration(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	regErr, err := s.checkSoftwareStatement(ctx, &req)
+	if err != nil {
+		s.writeRegistrationServerError(ctx, w, err)
+		return
+	}
+	if regErr != nil {
+		s.writeRegistrationError(ctx, w, regErr)
+		return
+	}
 	app, regErr := req.toOIDCApp()
 	if regErr != nil {
 		s.writeRegistrationError(ctx, w, regErr)
@@ -79,6 +88,7 @@ func (s *Server) dynamicClientRegistration(w http.ResponseWriter, r *http.Reques
 	}
 
 	resp := newClientRegistrationResponse(registered, req.ClientName, time.Now().Unix())
+	resp.setSoftware(&req)
 	resp.RegistrationAccessToken, err = s.registrationAccessToken(registered.ClientID, resourceOwner, registered.RegistrationAccessToken)
 	if err != nil {
 		s.writeRegistrationServerError(ctx, w, err)
@@ -263,6 +273,11 @@ type clientRegistrationRequest struct {
 	PostLogoutRedirectURIs  []string        `json:"post_logout_redirect_uris,omitempty"`
 	JWKsURI                 string          `json:"jwks_uri,omitempty"`
 	JWKs                    json.RawMessage `json:"jwks,omitempty"`
+	SoftwareID              string          `json:"software_id,omitempty"`
+	SoftwareVersion         string          `json:"software_version,omitempty"`
+	// SoftwareStatement is a JWT signed by a trusted issuer (RFC 7591 §2.3),
+	// its client metadata takes precedence over the plain members of the request.
+	SoftwareStatement string `json:"software_statement,omitempty"`
 }
 
 // clientRegistrationResponse is the client information response of a successful
@@ -286,6 +301,9 @@ type clientRegistrationResponse struct {
 	ClientName              string   `json:"client_name,omitempty"`
 	TokenEndpointAuthMethod string   `json:"token_endpoint_auth_method,omitempty"`
 	PostLogoutRedirectURIs  []string `json:"post_logout_redirect_uris,omitempty"`
+	SoftwareID              string   `json:"software_id,omitempty"`
+	SoftwareVersion         string   `json:"software_version,omitempty"`
+	SoftwareStatement       string   `json:"software_statement,omitempty"`
 }
 
 // toOIDCApp maps the registration request to a domain OIDC application, applying the RFC
@@ -496,6 +514,13 @@ func newClientRegistrationResponse(app *domain.OIDCApp, clientName string, issue
 	return resp
 }
 
+// setSoftware echoes the software members of the request, as they are not persisted in this version.
+func (resp *clientRegistrationResponse) setSoftware(req *clientRegistrationRequest) {
+	resp.SoftwareID = req.SoftwareID
+	resp.SoftwareVersion = req.SoftwareVersion
+	resp.SoftwareStatement = req.SoftwareStatement
+}
+
 func authMethodToRegistration(authMethod domain.OIDCAuthMethodType) string {
 	switch authMethod {
 	case domain.OIDCAuthMethodTypeBasic:
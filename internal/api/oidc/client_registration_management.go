@@ -52,6 +52,15 @@ func (s *Server) updateDynamicClientRegistration(w http.ResponseWriter, r *http.
 		return
 	}
 
+	regErr, err := s.checkSoftwareStatement(ctx, &req)
+	if err != nil {
+		s.writeRegistrationServerError(ctx, w, err)
+		return
+	}
+	if regErr != nil {
+		s.writeRegistrationError(ctx, w, regErr)
+		return
+	}
 	app, regErr := req.toOIDCApp()
 	if regErr != nil {
 		s.writeRegistrationError(ctx, w, regErr)
@@ -69,6 +78,7 @@ func (s *Server) updateDynamicClientRegistration(w http.ResponseWriter, r *http.
 	// The update response is a client information response (RFC 7592 §3); client_id_issued_at
 	// reflects the original registration, which is not echoed back here.
 	resp := newClientRegistrationResponse(updated, req.ClientName, 0)
+	resp.setSoftware(&req)
 	resp.RegistrationAccessToken, err = s.registrationAccessToken(client.ClientID, binding.orgID, updated.RegistrationAccessToken)
 	if err != nil {
 		s.writeRegistrationServerError(ctx, w, err)
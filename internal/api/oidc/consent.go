@@ -0,0 +1,21 @@
+package oidc
+
+import (
+	"context"
+)
+
+type requireConsentKey struct{}
+
+// contextWithRequireConsent marks the context of an authorization request
+// for a client which requires the user to consent to the requested scopes.
+func contextWithRequireConsent(ctx context.Context, client *Client) context.Context {
+	if client == nil || client.client == nil || !client.client.RequireConsent {
+		return ctx
+	}
+	return context.WithValue(ctx, requireConsentKey{}, true)
+}
+
+func requireConsentFromContext(ctx context.Context) bool {
+	requireConsent, _ := ctx.Value(requireConsentKey{}).(bool)
+	return requireConsent
+}
@@ -0,0 +1,40 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_requireConsentFromContext(t *testing.T) {
+	tests := []struct {
+		name   string
+		client *Client
+		want   bool
+	}{
+		{
+			name:   "no client",
+			client: nil,
+			want:   false,
+		},
+		{
+			name:   "consent not required",
+			client: &Client{client: &query.OIDCClient{RequireConsent: false}},
+			want:   false,
+		},
+		{
+			name:   "consent required",
+			client: &Client{client: &query.OIDCClient{RequireConsent: true}},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contextWithRequireConsent(context.Background(), tt.client)
+			assert.Equal(t, tt.want, requireConsentFromContext(ctx))
+		})
+	}
+}
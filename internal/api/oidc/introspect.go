@@ -93,7 +93,7 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		}
 	}
 
-	if err = validateIntrospectionAudience(token.audience, client.clientID, client.projectID); err != nil {
+	if err = validateIntrospectionAudience(token.audience, client.clientID, client.projectID, client.resourceURIs); err != nil {
 		return nil, err
 	}
 	userInfo, err := s.userInfo(
@@ -124,6 +124,18 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		Actor:                           actorDomainToClaims(token.actor),
 	}
 	introspectionResp.SetUserInfo(userInfo)
+	if len(token.authorizationDetails) > 0 {
+		if introspectionResp.Claims == nil {
+			introspectionResp.Claims = make(map[string]any, 1)
+		}
+		introspectionResp.Claims[domain.AuthorizationDetailsParam] = token.authorizationDetails
+	}
+	if token.acr != "" {
+		if introspectionResp.Claims == nil {
+			introspectionResp.Claims = make(map[string]any, 1)
+		}
+		introspectionResp.Claims["acr"] = token.acr
+	}
 	return op.NewResponse(introspectionResp), nil
 }
 
@@ -131,6 +143,7 @@ type introspectionClientResult struct {
 	clientID             string
 	projectID            string
 	projectRoleAssertion bool
+	resourceURIs         []string
 	err                  error
 }
 
@@ -139,11 +152,13 @@ var errNoClientSecret = errors.New("client has no configured secret")
 func (s *Server) introspectionClientAuth(ctx context.Context, cc *op.ClientCredentials, rc chan<- *introspectionClientResult) {
 	ctx, span := tracing.NewSpan(ctx)
 
+	var resourceURIs []string
 	clientID, projectID, projectRoleAssertion, err := func() (string, string, bool, error) {
 		client, err := s.clientFromCredentials(ctx, cc)
 		if err != nil {
 			return "", "", false, err
 		}
+		resourceURIs = client.ResourceURIs
 
 		if cc.ClientAssertion != "" {
 			verifier := op.NewJWTProfileVerifierKeySet(keySetMap(client.PublicKeys), op.IssuerFromContext(ctx), time.Hour, time.Second)
@@ -168,6 +183,7 @@ func (s *Server) introspectionClientAuth(ctx context.Context, cc *op.ClientCrede
 		clientID:             clientID,
 		projectID:            projectID,
 		projectRoleAssertion: projectRoleAssertion,
+		resourceURIs:         resourceURIs,
 		err:                  err,
 	}
 }
@@ -229,9 +245,12 @@ func (s *Server) introspectionToken(ctx context.Context, tkn string, rc chan<- *
 	}
 }
 
-func validateIntrospectionAudience(audience []string, clientID, projectID string) error {
+// validateIntrospectionAudience checks that the token is meant for the client.
+// Tokens restricted to resource indicators (RFC 8707) are accepted
+// if one of the resource URIs of the client's project is part of the audience.
+func validateIntrospectionAudience(audience []string, clientID, projectID string, resourceURIs []string) error {
 	if slices.ContainsFunc(audience, func(entry string) bool {
-		return entry == clientID || entry == projectID
+		return entry == clientID || entry == projectID || slices.Contains(resourceURIs, entry)
 	}) {
 		return nil
 	}
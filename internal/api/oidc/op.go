@@ -45,6 +45,7 @@ type Config struct {
 	PublicKeyCacheMaxAge              time.Duration
 	DefaultBackChannelLogoutLifetime  time.Duration
 	BackChannelLogout                 handlers.BackChannelLogoutWorkerConfig
+	PushedAuthRequestLifetime         time.Duration
 }
 
 // BackChannelLogoutConfig returns the BackChannelLogoutWorkerConfig and takes the deprecated TokenLifetime into account.
@@ -57,15 +58,16 @@ func (c *Config) BackChannelLogoutConfig() *handlers.BackChannelLogoutWorkerConf
 }
 
 type EndpointConfig struct {
-	Auth          *Endpoint
-	Token         *Endpoint
-	Introspection *Endpoint
-	Userinfo      *Endpoint
-	Revocation    *Endpoint
-	EndSession    *Endpoint
-	Keys          *Endpoint
-	DeviceAuth    *Endpoint
-	Registration  *Endpoint
+	Auth              *Endpoint
+	Token             *Endpoint
+	Introspection     *Endpoint
+	Userinfo          *Endpoint
+	Revocation        *Endpoint
+	EndSession        *Endpoint
+	Keys              *Endpoint
+	DeviceAuth        *Endpoint
+	Registration      *Endpoint
+	PushedAuthRequest *Endpoint
 }
 
 type Endpoint struct {
@@ -194,6 +196,8 @@ func NewServer(
 		assetAPIPrefix:             assets.AssetAPI(),
 		httpClient:                 httpClient,
 		registrationEndpoint:       registrationEndpoint(config.CustomEndpoints),
+		pushedAuthRequestEndpoint:  pushedAuthRequestEndpoint(config.CustomEndpoints),
+		pushedAuthRequestLifetime:  config.PushedAuthRequestLifetime,
 	}
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
 
@@ -227,6 +231,7 @@ func NewServer(
 			r.Method(http.MethodGet, server.registrationEndpoint.Relative()+"/{client_id}", http.HandlerFunc(server.getDynamicClientRegistration))
 			r.Method(http.MethodPut, server.registrationEndpoint.Relative()+"/{client_id}", http.HandlerFunc(server.updateDynamicClientRegistration))
 			r.Method(http.MethodDelete, server.registrationEndpoint.Relative()+"/{client_id}", http.HandlerFunc(server.deleteDynamicClientRegistration))
+			r.Method(http.MethodPost, server.pushedAuthRequestEndpoint.Relative(), http.HandlerFunc(server.pushedAuthorizationRequest))
 		}),
 	)
 
@@ -253,6 +258,15 @@ func registrationEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
 	return op.NewEndpoint("/oauth/v2/register")
 }
 
+// pushedAuthRequestEndpoint resolves the OAuth 2.0 Pushed Authorization Request endpoint (RFC 9126),
+// optionally overridden through the custom endpoint configuration.
+func pushedAuthRequestEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig != nil && endpointConfig.PushedAuthRequest != nil {
+		return op.NewEndpointWithURL(endpointConfig.PushedAuthRequest.Path, endpointConfig.PushedAuthRequest.URL)
+	}
+	return op.NewEndpoint("/oauth/v2/par")
+}
+
 func ContextToIssuer(ctx context.Context) string {
 	return http_utils.DomainContext(ctx).Origin()
 }
@@ -0,0 +1,212 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// requestURIPrefix is the prefix of the request_uri returned for pushed authorization requests,
+	// as defined in [RFC 9126, Section 2.2](https://www.rfc-editor.org/rfc/rfc9126#section-2.2).
+	requestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+	requestURIParam  = "request_uri"
+
+	// errorInvalidRequestURI is the error code defined in
+	// [RFC 9101, Section 6.2](https://www.rfc-editor.org/rfc/rfc9101#section-6.2).
+	errorInvalidRequestURI = "invalid_request_uri"
+)
+
+// clientAuthenticationParams are removed from the stored parameters of a pushed authorization request,
+// so no credentials of the client are persisted.
+var clientAuthenticationParams = []string{"client_secret", "client_assertion", "client_assertion_type"}
+
+type pushedAuthorizationResponse struct {
+	RequestURI string `json:"request_uri"`
+	ExpiresIn  int64  `json:"expires_in"`
+}
+
+// pushedAuthorizationRequest handles POST requests to the Pushed Authorization Request endpoint (RFC 9126).
+// The client is authenticated the same way as on the token endpoint and the request is validated
+// like on the authorization endpoint, including authorization_details and resource parameters.
+// The parameters are stored and can be used once through the returned request_uri.
+func (s *Server) pushedAuthorizationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.NewSpan(r.Context())
+	var err error
+	defer func() { span.EndWithError(err) }()
+
+	resp, err := s.pushAuthorizationRequest(ctx, r)
+	if err != nil {
+		op.WriteError(w, r, oidcError(ctx, err), s.getLogger(ctx))
+		return
+	}
+	httphelper.MarshalJSONWithStatus(w, resp, http.StatusCreated)
+}
+
+func (s *Server) pushAuthorizationRequest(ctx context.Context, r *http.Request) (_ *pushedAuthorizationResponse, err error) {
+	if err = r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err)
+	}
+	client, err := s.verifyPushedAuthorizationRequestClient(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	form := r.PostForm
+	if form.Has(requestURIParam) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request_uri must not be used in a pushed authorization request")
+	}
+	authReq := new(oidc.AuthRequest)
+	if err = s.Provider().Decoder().Decode(authReq, form); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding form").WithParent(err)
+	}
+	if authReq.ClientID != "" && authReq.ClientID != client.GetID() {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id does not match the authenticated client")
+	}
+	authReq.ClientID = client.GetID()
+	if err = s.validatePushedAuthorizationRequest(ctx, authReq, form, client); err != nil {
+		return nil, err
+	}
+
+	parameters := make(url.Values, len(form))
+	for key, values := range form {
+		parameters[key] = values
+	}
+	for _, param := range clientAuthenticationParams {
+		parameters.Del(param)
+	}
+	parameters.Set("client_id", client.GetID())
+	expiration := time.Now().Add(s.pushedAuthRequestLifetime)
+	id, err := s.command.AddPushedAuthRequest(ctx, client.GetID(), parameters, expiration)
+	if err != nil {
+		return nil, err
+	}
+	return &pushedAuthorizationResponse{
+		RequestURI: requestURIPrefix + id,
+		ExpiresIn:  int64(s.pushedAuthRequestLifetime / time.Second),
+	}, nil
+}
+
+func (s *Server) verifyPushedAuthorizationRequestClient(ctx context.Context, r *http.Request) (*Client, error) {
+	credentials := new(op.ClientCredentials)
+	if err := s.Provider().Decoder().Decode(credentials, r.PostForm); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding form").WithParent(err)
+	}
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		var err error
+		if credentials.ClientID, err = url.QueryUnescape(clientID); err != nil {
+			return nil, oidc.ErrInvalidClient().WithDescription("invalid basic auth header").WithParent(err)
+		}
+		if credentials.ClientSecret, err = url.QueryUnescape(clientSecret); err != nil {
+			return nil, oidc.ErrInvalidClient().WithDescription("invalid basic auth header").WithParent(err)
+		}
+	}
+	if credentials.ClientID == "" && credentials.ClientAssertion == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id or client_assertion must be provided")
+	}
+	if credentials.ClientAssertion != "" && credentials.ClientAssertionType != oidc.ClientAssertionTypeJWTAssertion {
+		return nil, oidc.ErrInvalidRequest().WithDescription("invalid client_assertion_type %s", credentials.ClientAssertionType)
+	}
+	client, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.PostForm,
+		Data:   credentials,
+	})
+	if err != nil {
+		return nil, err
+	}
+	zClient, ok := client.(*Client)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-ahG5u", "Errors.Internal")
+	}
+	return zClient, nil
+}
+
+// validatePushedAuthorizationRequest runs the checks of the authorization endpoint,
+// so errors are returned to the client directly and not only after the redirect of the user.
+func (s *Server) validatePushedAuthorizationRequest(ctx context.Context, authReq *oidc.AuthRequest, form url.Values, client *Client) (err error) {
+	if authReq.RequestParam != "" {
+		if !s.Provider().RequestObjectSupported() {
+			return oidc.ErrRequestNotSupported()
+		}
+		if err = op.ParseRequestObject(ctx, authReq, s.Provider().Storage(), op.IssuerFromContext(ctx)); err != nil {
+			return err
+		}
+	}
+	if authReq.RedirectURI == "" {
+		return oidc.ErrInvalidRequest().WithDescription("redirect_uri must not be empty")
+	}
+	if _, err = op.ValidateAuthReqPrompt(authReq.Prompt, authReq.MaxAge); err != nil {
+		return err
+	}
+	if _, err = op.ValidateAuthReqScopes(client, authReq.Scopes); err != nil {
+		return err
+	}
+	if err = op.ValidateAuthReqRedirectURI(client, authReq.RedirectURI, authReq.ResponseType); err != nil {
+		return err
+	}
+	if err = op.ValidateAuthReqResponseType(client, authReq.ResponseType); err != nil {
+		return err
+	}
+	if _, err = parseAuthorizationDetails(form.Get(domain.AuthorizationDetailsParam), client); err != nil {
+		return err
+	}
+	_, err = s.resolveResources(ctx, form[domain.ResourceParam])
+	return err
+}
+
+// resolvePushedAuthorizationRequest replaces the request with the parameters pushed by the client,
+// if the request references them through a request_uri.
+// The client_id of the request must match the client which pushed the request.
+func (s *Server) resolvePushedAuthorizationRequest(ctx context.Context, r *op.Request[oidc.AuthRequest]) (_ *op.Request[oidc.AuthRequest], err error) {
+	requestURI := r.Form.Get(requestURIParam)
+	if requestURI == "" {
+		return r, nil
+	}
+	id, ok := strings.CutPrefix(requestURI, requestURIPrefix)
+	if !ok || id == "" {
+		return nil, invalidRequestURIError(zerrors.ThrowInvalidArgument(nil, "OIDC-Eiqu5", "Errors.AuthRequest.Pushed.NotExisting"))
+	}
+	if r.Data.ClientID == "" {
+		return nil, oidc.ErrInvalidRequest().WithParent(op.ErrAuthReqMissingClientID).WithDescription("client_id must be provided")
+	}
+	parameters, err := s.command.UsePushedAuthRequest(ctx, id, r.Data.ClientID)
+	if err != nil {
+		return nil, invalidRequestURIError(err)
+	}
+	authReq := new(oidc.AuthRequest)
+	if err = s.Provider().Decoder().Decode(authReq, parameters); err != nil {
+		return nil, oidc.ErrServerError().WithParent(err)
+	}
+	return &op.Request[oidc.AuthRequest]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   parameters,
+		Data:   authReq,
+	}, nil
+}
+
+func invalidRequestURIError(err error) error {
+	if !zerrors.IsNotFound(err) && !zerrors.IsPreconditionFailed(err) && !zerrors.IsErrorInvalidArgument(err) {
+		return err
+	}
+	oidcErr := (&oidc.Error{ErrorType: errorInvalidRequestURI}).WithParent(err)
+	var zErr *zerrors.ZitadelError
+	if errors.As(err, &zErr) {
+		oidcErr.Description = zErr.GetMessage()
+	}
+	return oidcErr
+}
@@ -0,0 +1,97 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestServer_resolvePushedAuthorizationRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		form        url.Values
+		wantSame    bool
+		wantErrType string
+	}{
+		{
+			name:     "no request_uri",
+			form:     url.Values{"client_id": {"clientID"}},
+			wantSame: true,
+		},
+		{
+			name:        "unknown request_uri scheme",
+			form:        url.Values{"client_id": {"clientID"}, "request_uri": {"https://example.com/request"}},
+			wantErrType: errorInvalidRequestURI,
+		},
+		{
+			name:        "missing id",
+			form:        url.Values{"client_id": {"clientID"}, "request_uri": {requestURIPrefix}},
+			wantErrType: errorInvalidRequestURI,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &op.Request[oidc.AuthRequest]{
+				Form: tt.form,
+				Data: &oidc.AuthRequest{ClientID: tt.form.Get("client_id")},
+			}
+			got, err := new(Server).resolvePushedAuthorizationRequest(context.Background(), r)
+			if tt.wantErrType != "" {
+				var oidcErr *oidc.Error
+				require.ErrorAs(t, err, &oidcErr)
+				assert.EqualValues(t, tt.wantErrType, oidcErr.ErrorType)
+				return
+			}
+			require.NoError(t, err)
+			assert.Same(t, r, got)
+		})
+	}
+}
+
+func Test_invalidRequestURIError(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		wantInvalidURI  bool
+		wantDescription string
+	}{
+		{
+			name:            "not found",
+			err:             zerrors.ThrowNotFound(nil, "COMMAND-aeN8u", "Errors.AuthRequest.Pushed.NotExisting"),
+			wantInvalidURI:  true,
+			wantDescription: "Errors.AuthRequest.Pushed.NotExisting",
+		},
+		{
+			name:            "expired",
+			err:             zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ue1ai", "Errors.AuthRequest.Pushed.Expired"),
+			wantInvalidURI:  true,
+			wantDescription: "Errors.AuthRequest.Pushed.Expired",
+		},
+		{
+			name: "internal",
+			err:  zerrors.ThrowInternal(nil, "id", "Errors.Internal"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := invalidRequestURIError(tt.err)
+			var oidcErr *oidc.Error
+			if !tt.wantInvalidURI {
+				assert.False(t, errors.As(err, &oidcErr))
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.ErrorAs(t, err, &oidcErr)
+			assert.EqualValues(t, errorInvalidRequestURI, oidcErr.ErrorType)
+			assert.Equal(t, tt.wantDescription, oidcErr.Description)
+		})
+	}
+}
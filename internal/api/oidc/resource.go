@@ -0,0 +1,66 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type resourcesKey struct{}
+
+func contextWithResources(ctx context.Context, resources []string) context.Context {
+	if len(resources) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, resourcesKey{}, resources)
+}
+
+func resourcesFromContext(ctx context.Context) []string {
+	resources, _ := ctx.Value(resourcesKey{}).([]string)
+	return resources
+}
+
+// resolveResources parses the resource parameters of a request (RFC 8707)
+// and checks that each of them is known as resource URI of a project or client ID of an API application.
+func (s *Server) resolveResources(ctx context.Context, values []string) ([]string, error) {
+	resources, err := domain.ParseResourceIndicators(values)
+	if err != nil {
+		return nil, invalidTargetError(err)
+	}
+	if len(resources) == 0 {
+		return nil, nil
+	}
+	known, err := s.query.KnownResourceIndicators(ctx, resources)
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range resources {
+		if !slices.Contains(known, resource) {
+			return nil, invalidTargetError(zerrors.ThrowInvalidArgument(nil, "OIDC-Ohp3e", "Errors.AuthRequest.Resource.Unknown"))
+		}
+	}
+	return resources, nil
+}
+
+// resourceError returns an invalid_target error
+// if the requested resources were not granted, otherwise err is returned as-is.
+func resourceError(err error) error {
+	if errors.Is(err, zerrors.ThrowInvalidArgument(nil, "DOMAIN-ua9Oh", "")) {
+		return invalidTargetError(err)
+	}
+	return err
+}
+
+func invalidTargetError(err error) *oidc.Error {
+	oidcErr := oidc.ErrInvalidTarget().WithParent(err)
+	var zErr *zerrors.ZitadelError
+	if errors.As(err, &zErr) {
+		oidcErr.Description = zErr.GetMessage()
+	}
+	return oidcErr
+}
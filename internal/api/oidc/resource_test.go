@@ -0,0 +1,88 @@
+package oidc
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_resourceError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantTarget  bool
+		wantErrDesc string
+	}{
+		{
+			name: "no error",
+			err:  nil,
+		},
+		{
+			name: "other error",
+			err:  io.ErrClosedPipe,
+		},
+		{
+			name:        "not granted",
+			err:         zerrors.ThrowInvalidArgument(nil, "DOMAIN-ua9Oh", "Errors.AuthRequest.Resource.NotGranted"),
+			wantTarget:  true,
+			wantErrDesc: "Errors.AuthRequest.Resource.NotGranted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := resourceError(tt.err)
+			if !tt.wantTarget {
+				assert.Equal(t, tt.err, err)
+				return
+			}
+			var oidcErr *oidc.Error
+			require.ErrorAs(t, err, &oidcErr)
+			assert.Equal(t, oidc.InvalidTarget, oidcErr.ErrorType)
+			assert.Equal(t, tt.wantErrDesc, oidcErr.Description)
+		})
+	}
+}
+
+func Test_validateIntrospectionAudience(t *testing.T) {
+	tests := []struct {
+		name         string
+		audience     []string
+		resourceURIs []string
+		wantErr      bool
+	}{
+		{
+			name:     "client id",
+			audience: []string{"clientID"},
+		},
+		{
+			name:     "project id",
+			audience: []string{"projectID"},
+		},
+		{
+			name:         "resource uri",
+			audience:     []string{"https://api.example.com"},
+			resourceURIs: []string{"https://api.example.com"},
+		},
+		{
+			name:         "other resource uri",
+			audience:     []string{"https://other.example.com"},
+			resourceURIs: []string{"https://api.example.com"},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateIntrospectionAudience(tt.audience, "clientID", "projectID", tt.resourceURIs)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -46,7 +47,9 @@ type Server struct {
 	assetAPIPrefix func(ctx context.Context) string
 	httpClient     *http.Client
 
-	registrationEndpoint *op.Endpoint
+	registrationEndpoint      *op.Endpoint
+	pushedAuthRequestEndpoint *op.Endpoint
+	pushedAuthRequestLifetime time.Duration
 }
 
 func endpoints(endpointConfig *EndpointConfig) op.Endpoints {
@@ -127,13 +130,28 @@ func (s *Server) Discovery(ctx context.Context, r *op.Request[struct{}]) (_ *op.
 	if len(allowedLanguages) == 0 {
 		allowedLanguages = i18n.SupportedLanguages()
 	}
-	return op.NewResponse(s.createDiscoveryConfig(ctx, allowedLanguages)), nil
+	return op.NewResponse(&discoveryConfiguration{
+		DiscoveryConfiguration:             s.createDiscoveryConfig(ctx, allowedLanguages),
+		PushedAuthorizationRequestEndpoint: s.pushedAuthRequestEndpoint.Absolute(op.IssuerFromContext(ctx)),
+	}), nil
+}
+
+// discoveryConfiguration extends the [oidc.DiscoveryConfiguration] with metadata
+// which is not supported by the OIDC library.
+type discoveryConfiguration struct {
+	*oidc.DiscoveryConfiguration
+	// PushedAuthorizationRequestEndpoint as defined in [RFC 9126, Section 5](https://www.rfc-editor.org/rfc/rfc9126#section-5).
+	PushedAuthorizationRequestEndpoint string `json:"pushed_authorization_request_endpoint,omitempty"`
 }
 
 func (s *Server) VerifyAuthRequest(ctx context.Context, r *op.Request[oidc.AuthRequest]) (_ *op.ClientRequest[oidc.AuthRequest], err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	r, err = s.resolvePushedAuthorizationRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
 	return s.LegacyServer.VerifyAuthRequest(ctx, r)
 }
 
@@ -147,6 +165,19 @@ func (s *Server) Authorize(ctx context.Context, r *op.ClientRequest[oidc.AuthReq
 	logging.WithFields("instanceID", authz.GetInstance(ctx).InstanceID()).
 		OnError(err).Error("invalid id_token_hint")
 
+	client, _ := r.Client.(*Client)
+	authorizationDetails, err := parseAuthorizationDetails(r.Form.Get(domain.AuthorizationDetailsParam), client)
+	if err != nil {
+		return op.TryErrorRedirect(ctx, r.Data, err, s.Provider().Encoder(), s.Provider().Logger())
+	}
+	ctx = contextWithAuthorizationDetails(ctx, authorizationDetails)
+	resources, err := s.resolveResources(ctx, r.Form[domain.ResourceParam])
+	if err != nil {
+		return op.TryErrorRedirect(ctx, r.Data, err, s.Provider().Encoder(), s.Provider().Logger())
+	}
+	ctx = contextWithResources(ctx, resources)
+	ctx = contextWithRequireConsent(ctx, client)
+
 	req, err := s.Provider().Storage().CreateAuthRequest(ctx, r.Data, userID)
 	if err != nil {
 		return op.TryErrorRedirect(ctx, r.Data, oidc.DefaultToServerError(err, "unable to save auth request"), s.Provider().Encoder(), s.Provider().Logger())
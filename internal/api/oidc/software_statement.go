@@ -0,0 +1,155 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// Software statement error codes as defined in RFC 7591 §3.2.2.
+const (
+	registrationErrorInvalidSoftwareStatement    = "invalid_software_statement"
+	registrationErrorUnapprovedSoftwareStatement = "unapproved_software_statement"
+)
+
+var softwareStatementSigningAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// softwareStatementClaims are the claims of a software statement (RFC 7591 §2.3).
+// Besides the JWT claims, a statement can contain any client metadata.
+type softwareStatementClaims struct {
+	clientRegistrationRequest
+	Issuer     string `json:"iss"`
+	Expiration *int64 `json:"exp,omitempty"`
+}
+
+// checkSoftwareStatement verifies the software statement of a registration request against the
+// trusted issuers of the instance's security settings and applies its client metadata to the
+// request. A request without statement is rejected if the settings require one.
+// The returned error is only set for unexpected failures, problems of the statement itself are
+// reported as registration error.
+func (s *Server) checkSoftwareStatement(ctx context.Context, req *clientRegistrationRequest) (*registrationError, error) {
+	policy, err := s.query.SecurityPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.SoftwareStatement == "" {
+		if policy.RequireSoftwareStatement {
+			return newRegistrationError(registrationErrorUnapprovedSoftwareStatement, "a software_statement of a trusted issuer is required"), nil
+		}
+		return nil, nil
+	}
+	claims, regErr := verifySoftwareStatement(req.SoftwareStatement, policy.SoftwareStatementIssuers, time.Now())
+	if regErr != nil {
+		return regErr, nil
+	}
+	return req.applySoftwareStatement(claims), nil
+}
+
+// verifySoftwareStatement checks that the statement is signed by one of the trusted issuers
+// and has not expired.
+func verifySoftwareStatement(statement string, issuers domain.SoftwareStatementIssuers, now time.Time) (*softwareStatementClaims, *registrationError) {
+	jws, err := jose.ParseSigned(statement, softwareStatementSigningAlgorithms)
+	if err != nil || len(jws.Signatures) != 1 {
+		return nil, newRegistrationError(registrationErrorInvalidSoftwareStatement, "the software_statement could not be parsed")
+	}
+	unverified := new(softwareStatementClaims)
+	if err = json.Unmarshal(jws.UnsafePayloadWithoutVerification(), unverified); err != nil {
+		return nil, newRegistrationError(registrationErrorInvalidSoftwareStatement, "the software_statement could not be parsed")
+	}
+	issuer := issuers.ByIssuer(unverified.Issuer)
+	if issuer == nil {
+		return nil, newRegistrationError(registrationErrorUnapprovedSoftwareStatement, "the issuer of the software_statement is not trusted")
+	}
+	keySet := new(jose.JSONWebKeySet)
+	if err = json.Unmarshal([]byte(issuer.KeySet), keySet); err != nil {
+		return nil, newRegistrationError(registrationErrorUnapprovedSoftwareStatement, "the issuer of the software_statement is not trusted")
+	}
+	payload, ok := verifySoftwareStatementSignature(jws, keySet)
+	if !ok {
+		return nil, newRegistrationError(registrationErrorInvalidSoftwareStatement, "the signature of the software_statement is invalid")
+	}
+	claims := new(softwareStatementClaims)
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, newRegistrationError(registrationErrorInvalidSoftwareStatement, "the software_statement could not be parsed")
+	}
+	if claims.Expiration != nil && now.After(time.Unix(*claims.Expiration, 0)) {
+		return nil, newRegistrationError(registrationErrorInvalidSoftwareStatement, "the software_statement has expired")
+	}
+	return claims, nil
+}
+
+// verifySoftwareStatementSignature verifies the statement with the key referenced by the kid header,
+// or with any key of the set if the statement does not reference one.
+func verifySoftwareStatementSignature(jws *jose.JSONWebSignature, keySet *jose.JSONWebKeySet) ([]byte, bool) {
+	keys := keySet.Keys
+	if keyID := jws.Signatures[0].Header.KeyID; keyID != "" {
+		keys = keySet.Key(keyID)
+	}
+	for _, key := range keys {
+		if payload, err := jws.Verify(key); err == nil {
+			return payload, true
+		}
+	}
+	return nil, false
+}
+
+// applySoftwareStatement merges the client metadata of a verified software statement into the
+// request. As required by RFC 7591 §2.3 the statement takes precedence: single values replace
+// the ones of the request, lists constrain them. A client can register a subset of the
+// certified redirect URIs or grant types, but nothing beyond them.
+func (req *clientRegistrationRequest) applySoftwareStatement(claims *softwareStatementClaims) *registrationError {
+	var regErr *registrationError
+	if req.RedirectURIs, regErr = constrainToSoftwareStatement(req.RedirectURIs, claims.RedirectURIs, registrationErrorInvalidRedirectURI, "redirect_uris"); regErr != nil {
+		return regErr
+	}
+	if req.PostLogoutRedirectURIs, regErr = constrainToSoftwareStatement(req.PostLogoutRedirectURIs, claims.PostLogoutRedirectURIs, registrationErrorInvalidClientMetadata, "post_logout_redirect_uris"); regErr != nil {
+		return regErr
+	}
+	if req.GrantTypes, regErr = constrainToSoftwareStatement(req.GrantTypes, claims.GrantTypes, registrationErrorInvalidClientMetadata, "grant_types"); regErr != nil {
+		return regErr
+	}
+	if req.ResponseTypes, regErr = constrainToSoftwareStatement(req.ResponseTypes, claims.ResponseTypes, registrationErrorInvalidClientMetadata, "response_types"); regErr != nil {
+		return regErr
+	}
+	overrideBySoftwareStatement(&req.ApplicationType, claims.ApplicationType)
+	overrideBySoftwareStatement(&req.ClientName, claims.ClientName)
+	overrideBySoftwareStatement(&req.TokenEndpointAuthMethod, claims.TokenEndpointAuthMethod)
+	overrideBySoftwareStatement(&req.JWKsURI, claims.JWKsURI)
+	overrideBySoftwareStatement(&req.SoftwareID, claims.SoftwareID)
+	overrideBySoftwareStatement(&req.SoftwareVersion, claims.SoftwareVersion)
+	if len(claims.JWKs) > 0 {
+		req.JWKs = claims.JWKs
+	}
+	return nil
+}
+
+func constrainToSoftwareStatement(requested, certified []string, errorType, member string) ([]string, *registrationError) {
+	if len(certified) == 0 {
+		return requested, nil
+	}
+	if len(requested) == 0 {
+		return certified, nil
+	}
+	for _, value := range requested {
+		if !slices.Contains(certified, value) {
+			return nil, newRegistrationError(errorType, member+" must be part of the software_statement")
+		}
+	}
+	return requested, nil
+}
+
+func overrideBySoftwareStatement(requested *string, certified string) {
+	if certified != "" {
+		*requested = certified
+	}
+}
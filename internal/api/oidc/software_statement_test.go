@@ -0,0 +1,185 @@
+package oidc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func Test_verifySoftwareStatement(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keySet, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: key.Public(), KeyID: "key1", Algorithm: string(jose.ES256), Use: "sig"}}})
+	require.NoError(t, err)
+	issuers := domain.SoftwareStatementIssuers{{Issuer: "https://certification.example.com", KeySet: string(keySet)}}
+	now := time.Now()
+
+	sign := func(t *testing.T, signingKey *ecdsa.PrivateKey, claims map[string]any) string {
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: signingKey, KeyID: "key1"}}, nil)
+		require.NoError(t, err)
+		payload, err := json.Marshal(claims)
+		require.NoError(t, err)
+		jws, err := signer.Sign(payload)
+		require.NoError(t, err)
+		statement, err := jws.CompactSerialize()
+		require.NoError(t, err)
+		return statement
+	}
+
+	tests := []struct {
+		name      string
+		statement func(t *testing.T) string
+		want      *softwareStatementClaims
+		wantErr   string // expected registrationError.ErrorType, empty means success
+	}{
+		{
+			name:      "not a jwt",
+			statement: func(*testing.T) string { return "statement" },
+			wantErr:   registrationErrorInvalidSoftwareStatement,
+		},
+		{
+			name: "untrusted issuer",
+			statement: func(t *testing.T) string {
+				return sign(t, key, map[string]any{"iss": "https://other.example.com"})
+			},
+			wantErr: registrationErrorUnapprovedSoftwareStatement,
+		},
+		{
+			name: "invalid signature",
+			statement: func(t *testing.T) string {
+				return sign(t, otherKey, map[string]any{"iss": "https://certification.example.com"})
+			},
+			wantErr: registrationErrorInvalidSoftwareStatement,
+		},
+		{
+			name: "expired",
+			statement: func(t *testing.T) string {
+				return sign(t, key, map[string]any{"iss": "https://certification.example.com", "exp": now.Add(-time.Minute).Unix()})
+			},
+			wantErr: registrationErrorInvalidSoftwareStatement,
+		},
+		{
+			name: "valid",
+			statement: func(t *testing.T) string {
+				return sign(t, key, map[string]any{
+					"iss":           "https://certification.example.com",
+					"exp":           now.Add(time.Hour).Unix(),
+					"software_id":   "4NRB1-0XZABZI9E6-5SM3R",
+					"redirect_uris": []string{"https://app.example.com/callback"},
+				})
+			},
+			want: &softwareStatementClaims{
+				clientRegistrationRequest: clientRegistrationRequest{
+					SoftwareID:   "4NRB1-0XZABZI9E6-5SM3R",
+					RedirectURIs: []string{"https://app.example.com/callback"},
+				},
+				Issuer:     "https://certification.example.com",
+				Expiration: &[]int64{now.Add(time.Hour).Unix()}[0],
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, regErr := verifySoftwareStatement(tt.statement(t), issuers, now)
+			if tt.wantErr != "" {
+				require.NotNil(t, regErr)
+				assert.Equal(t, tt.wantErr, regErr.ErrorType)
+				return
+			}
+			require.Nil(t, regErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_clientRegistrationRequest_applySoftwareStatement(t *testing.T) {
+	t.Parallel()
+
+	claims := &softwareStatementClaims{
+		clientRegistrationRequest: clientRegistrationRequest{
+			RedirectURIs:    []string{"https://app.example.com/callback", "https://app.example.com/other"},
+			GrantTypes:      []string{"authorization_code", "refresh_token"},
+			ClientName:      "Certified Client",
+			SoftwareID:      "4NRB1-0XZABZI9E6-5SM3R",
+			SoftwareVersion: "2.1",
+		},
+		Issuer: "https://certification.example.com",
+	}
+	tests := []struct {
+		name    string
+		req     *clientRegistrationRequest
+		want    *clientRegistrationRequest
+		wantErr string // expected registrationError.ErrorType, empty means success
+	}{
+		{
+			name: "statement values applied",
+			req: &clientRegistrationRequest{
+				ClientName:      "Other Name",
+				ApplicationType: "web",
+			},
+			want: &clientRegistrationRequest{
+				RedirectURIs:    []string{"https://app.example.com/callback", "https://app.example.com/other"},
+				GrantTypes:      []string{"authorization_code", "refresh_token"},
+				ApplicationType: "web",
+				ClientName:      "Certified Client",
+				SoftwareID:      "4NRB1-0XZABZI9E6-5SM3R",
+				SoftwareVersion: "2.1",
+			},
+		},
+		{
+			name: "subset of statement values",
+			req: &clientRegistrationRequest{
+				RedirectURIs: []string{"https://app.example.com/callback"},
+				GrantTypes:   []string{"authorization_code"},
+			},
+			want: &clientRegistrationRequest{
+				RedirectURIs:    []string{"https://app.example.com/callback"},
+				GrantTypes:      []string{"authorization_code"},
+				ClientName:      "Certified Client",
+				SoftwareID:      "4NRB1-0XZABZI9E6-5SM3R",
+				SoftwareVersion: "2.1",
+			},
+		},
+		{
+			name: "redirect uri not in statement",
+			req: &clientRegistrationRequest{
+				RedirectURIs: []string{"https://evil.example.com/callback"},
+			},
+			wantErr: registrationErrorInvalidRedirectURI,
+		},
+		{
+			name: "grant type not in statement",
+			req: &clientRegistrationRequest{
+				GrantTypes: []string{"implicit"},
+			},
+			wantErr: registrationErrorInvalidClientMetadata,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			regErr := tt.req.applySoftwareStatement(claims)
+			if tt.wantErr != "" {
+				require.NotNil(t, regErr)
+				assert.Equal(t, tt.wantErr, regErr.ErrorType)
+				return
+			}
+			require.Nil(t, regErr)
+			assert.Equal(t, tt.want, tt.req)
+		})
+	}
+}
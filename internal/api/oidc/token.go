@@ -2,6 +2,7 @@ package oidc
 
 import (
 	"context"
+	"maps"
 	"slices"
 	"time"
 
@@ -51,11 +52,30 @@ func (s *Server) accessTokenResponseFromSession(ctx context.Context, client op.C
 	}
 
 	if slices.Contains(session.Scope, oidc.ScopeOpenID) {
-		resp.IDToken, _, err = s.createIDToken(ctx, client, getUserInfo, idTokenRoleAssertion, getSigner, session.SessionID, resp.AccessToken, session.Audience, session.AuthMethods, session.AuthTime, session.Nonce, session.Actor)
+		resp.IDToken, _, err = s.createIDToken(ctx, client, getUserInfo, idTokenRoleAssertion, getSigner, session.SessionID, resp.AccessToken, session.Audience, session.AuthMethods, session.AuthTime, session.Nonce, session.ACR, session.Actor)
 	}
 	return resp, err
 }
 
+// accessTokenResponseWithDetails extends the token response
+// with the authorization details granted for the access token,
+// as defined in [RFC 9396, Section 7](https://www.rfc-editor.org/rfc/rfc9396#section-7).
+type accessTokenResponseWithDetails struct {
+	*oidc.AccessTokenResponse
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
+}
+
+// tokenResponse returns the token response, which contains the authorization details of the session if any were granted.
+func tokenResponse(resp *oidc.AccessTokenResponse, session *command.OIDCSession) any {
+	if len(session.AuthorizationDetails) == 0 {
+		return resp
+	}
+	return &accessTokenResponseWithDetails{
+		AccessTokenResponse:  resp,
+		AuthorizationDetails: session.AuthorizationDetails,
+	}
+}
+
 func (s *Server) getSignerOnce() sign.SignerFunc {
 	return sign.GetSignerOnce(s.query.GetActiveSigningWebKey)
 }
@@ -72,7 +92,7 @@ func (s *Server) getUserInfo(userID, projectID, clientID string, projectRoleAsse
 	}
 }
 
-func (*Server) createIDToken(ctx context.Context, client op.Client, getUserInfo userInfoFunc, roleAssertion bool, getSigningKey sign.SignerFunc, sessionID, accessToken string, audience []string, authMethods []domain.UserAuthMethodType, authTime time.Time, nonce string, actor *domain.TokenActor) (idToken string, exp uint64, err error) {
+func (*Server) createIDToken(ctx context.Context, client op.Client, getUserInfo userInfoFunc, roleAssertion bool, getSigningKey sign.SignerFunc, sessionID, accessToken string, audience []string, authMethods []domain.UserAuthMethodType, authTime time.Time, nonce, acr string, actor *domain.TokenActor) (idToken string, exp uint64, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -94,7 +114,7 @@ func (*Server) createIDToken(ctx context.Context, client op.Client, getUserInfo
 		expTime,
 		authTime,
 		nonce,
-		"",
+		acr,
 		AuthMethodTypesToAMR(authMethods),
 		client.GetID(),
 		client.ClockSkew(),
@@ -129,11 +149,15 @@ func (s *Server) createJWT(ctx context.Context, client op.Client, session *comma
 		return "", err
 	}
 
+	audience := session.Audience
+	if len(session.Resources) > 0 {
+		audience = session.Resources
+	}
 	expTime := session.Expiration.Add(client.ClockSkew())
 	claims := oidc.NewAccessTokenClaims(
 		op.IssuerFromContext(ctx),
 		userInfo.Subject,
-		session.Audience,
+		audience,
 		expTime,
 		session.TokenID,
 		client.GetID(),
@@ -141,6 +165,14 @@ func (s *Server) createJWT(ctx context.Context, client op.Client, session *comma
 	)
 	claims.Actor = actorDomainToClaims(session.Actor)
 	claims.Claims = userInfo.Claims
+	if len(session.AuthorizationDetails) > 0 {
+		// don't modify the claims of the (shared) userinfo
+		claims.Claims = maps.Clone(claims.Claims)
+		if claims.Claims == nil {
+			claims.Claims = make(map[string]any, 1)
+		}
+		claims.Claims[domain.AuthorizationDetailsParam] = session.AuthorizationDetails
+	}
 
 	return crypto.Sign(claims, signer)
 }
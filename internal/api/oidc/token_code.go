@@ -33,23 +33,34 @@ func (s *Server) CodeExchange(ctx context.Context, r *op.ClientRequest[oidc.Acce
 	}
 
 	var (
-		session *command.OIDCSession
+		session   *command.OIDCSession
+		resources []string
 	)
 	if strings.HasPrefix(plainCode, command.IDPrefixV2) {
+		resources, err = s.resolveResources(ctx, r.Form[domain.ResourceParam])
+		if err != nil {
+			return nil, err
+		}
 		session, _, err = s.command.CreateOIDCSessionFromAuthRequest(
 			setContextUserSystem(ctx),
 			plainCode,
 			codeExchangeComplianceChecker(client, r.Data),
 			slices.Contains(client.GrantTypes(), oidc.GrantTypeRefreshToken),
 			client.client.BackChannelLogoutURI,
+			resources,
 		)
+		err = resourceError(err)
 	} else {
 		session, err = s.codeExchangeV1(ctx, client, r.Data, r.Data.Code)
 	}
 	if err != nil {
 		return nil, err
 	}
-	return response(s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion))
+	resp, err := s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion)
+	if err != nil {
+		return nil, err
+	}
+	return op.NewResponse(tokenResponse(resp, session)), nil
 }
 
 // codeExchangeV1 creates a v2 token from a v1 auth request.
@@ -18,7 +18,7 @@ import (
 )
 
 const (
-	UserIDTokenType oidc.TokenType = "urn:zitadel:params:oauth:token-type:user_id"
+	UserIDTokenType oidc.TokenType = domain.TokenTypeUserID
 
 	// TokenTypeNA is set when the returned Token Exchange access token value can't be used as an access token.
 	// For example, when it is an ID Token.
@@ -51,6 +51,10 @@ func (s *Server) tokenExchange(ctx context.Context, r *op.ClientRequest[oidc.Tok
 		// not supposed to happen, but just preventing a panic if it does.
 		return nil, zerrors.ThrowInternal(nil, "OIDC-eShi5", "Error.Internal")
 	}
+	policy := client.client.TokenExchangePolicy
+	if !policy.AllowsSubjectTokenType(string(r.Data.SubjectTokenType)) {
+		return nil, oidc.ErrInvalidRequest().WithDescription("subject_token_type %q not allowed for this client", r.Data.SubjectTokenType)
+	}
 
 	subjectToken, err := s.verifyExchangeToken(ctx, client, r.Data.SubjectToken, r.Data.SubjectTokenType, oidc.AllTokenTypes...)
 	if err != nil {
@@ -71,6 +75,10 @@ func (s *Server) tokenExchange(ctx context.Context, r *op.ClientRequest[oidc.Tok
 		if err != nil {
 			return nil, oidc.ErrInvalidRequest().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError).WithDescription("actor_token invalid")
 		}
+		// an actor which acts itself on behalf of another actor would result in a nested act claim
+		if actorToken.actor != nil && !policy.AllowsNestedActors() {
+			return nil, oidc.ErrInvalidRequest().WithDescription("nested actors not allowed for this client")
+		}
 		ctx = authz.SetCtxData(ctx, authz.CtxData{
 			UserID: actorToken.userID,
 			OrgID:  actorToken.resourceOwner,
@@ -81,16 +89,25 @@ func (s *Server) tokenExchange(ctx context.Context, r *op.ClientRequest[oidc.Tok
 	if err != nil {
 		return nil, err
 	}
+	audience, denied, ok := applyTokenExchangePolicy(audience, len(r.Data.Audience) > 0, policy.AllowsAudience)
+	if !ok {
+		return nil, oidc.ErrInvalidTarget().WithDescription("audience %q not allowed for this client", denied)
+	}
 	// scopelessActorPath: actorPath with a subject that cannot carry scopes
 	// (user_id or id_token). Subject-data scopes (email, profile, …) are taken
 	// from the impersonated user at mint time; only authorization scopes must
 	// appear on subject_token ∪ actor_token. Access/JWT subjects always use
 	// union validation even when their scope claim is empty.
 	scopelessActorPath := actorPath && (subjectToken.tokenType == UserIDTokenType || subjectToken.tokenType == oidc.IDTokenType)
+	scopesRequested := len(normalizeRequestedScopes(r.Data.Scopes)) > 0
 	scopes, err := validateTokenExchangeScopes(client, r.Data.Scopes, subjectToken.scopes, actorToken.scopes, scopelessActorPath)
 	if err != nil {
 		return nil, err
 	}
+	scopes, denied, ok = applyTokenExchangePolicy(scopes, scopesRequested, policy.AllowsScope)
+	if !ok {
+		return nil, oidc.ErrInvalidScope().WithDescription("scope %q not allowed for this client", denied)
+	}
 
 	resp, err := s.createExchangeTokens(ctx, r.Data.RequestedTokenType, client, subjectToken, actorToken, audience, scopes)
 	if err != nil {
@@ -120,7 +137,7 @@ func (s *Server) verifyExchangeToken(ctx context.Context, client *Client, token
 			return nil, zerrors.ThrowPermissionDenied(err, "OIDC-Osh3t", "Errors.TokenExchange.Token.Invalid")
 		}
 		if !token.isPAT {
-			if err = validateIntrospectionAudience(token.audience, client.GetID(), client.client.ProjectID); err != nil {
+			if err = validateIntrospectionAudience(token.audience, client.GetID(), client.client.ProjectID, nil); err != nil {
 				return nil, zerrors.ThrowPermissionDenied(err, "OIDC-zi9Y0", "Errors.TokenExchange.Token.Invalid")
 			}
 		}
@@ -267,6 +284,23 @@ func validateImpersonationTokenExchangeScopes(
 	return op.ValidateAuthReqScopes(client, requestedScopes)
 }
 
+// applyTokenExchangePolicy restricts the audience or scopes of an exchanged token to the values allowed by the client's policy.
+// Explicitly requested values must all be allowed, the denied value is returned otherwise.
+// Values inherited from the subject or actor token are narrowed to the allowed ones.
+func applyTokenExchangePolicy(values []string, requested bool, allows func(string) bool) (_ []string, denied string, ok bool) {
+	if requested {
+		for _, value := range values {
+			if !allows(value) {
+				return nil, value, false
+			}
+		}
+		return values, "", true
+	}
+	return slices.DeleteFunc(slices.Clone(values), func(value string) bool {
+		return !allows(value)
+	}), "", true
+}
+
 func normalizeRequestedScopes(scopes []string) []string {
 	// Space-delimited empty scope produces a single "" entry.
 	return slices.DeleteFunc(scopes, func(s string) bool {
@@ -362,7 +396,7 @@ func (s *Server) createExchangeTokens(ctx context.Context, tokenType oidc.TokenT
 		resp.IssuedTokenType = oidc.JWTTokenType
 
 	case oidc.IDTokenType:
-		resp.AccessToken, resp.ExpiresIn, err = s.createIDToken(ctx, client, getUserInfo, client.client.IDTokenRoleAssertion, getSigner, "", resp.AccessToken, audience, actorToken.authMethods, actorToken.authTime, "", actor)
+		resp.AccessToken, resp.ExpiresIn, err = s.createIDToken(ctx, client, getUserInfo, client.client.IDTokenRoleAssertion, getSigner, "", resp.AccessToken, audience, actorToken.authMethods, actorToken.authTime, "", "", actor)
 		resp.TokenType = TokenTypeNA
 		resp.IssuedTokenType = oidc.IDTokenType
 
@@ -376,7 +410,7 @@ func (s *Server) createExchangeTokens(ctx context.Context, tokenType oidc.TokenT
 	}
 
 	if slices.Contains(scopes, oidc.ScopeOpenID) && tokenType != oidc.IDTokenType {
-		resp.IDToken, _, err = s.createIDToken(ctx, client, getUserInfo, client.client.IDTokenRoleAssertion, getSigner, sessionID, resp.AccessToken, audience, actorToken.authMethods, actorToken.authTime, "", actor)
+		resp.IDToken, _, err = s.createIDToken(ctx, client, getUserInfo, client.client.IDTokenRoleAssertion, getSigner, sessionID, resp.AccessToken, audience, actorToken.authMethods, actorToken.authTime, "", "", actor)
 		if err != nil {
 			return nil, err
 		}
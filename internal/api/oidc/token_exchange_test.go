@@ -110,3 +110,59 @@ func Test_isTokenExchangeAuthorizationScope(t *testing.T) {
 		})
 	}
 }
+
+func Test_applyTokenExchangePolicy(t *testing.T) {
+	policy := &domain.TokenExchangePolicy{
+		Audiences: []string{"api", "other"},
+	}
+	tests := []struct {
+		name       string
+		policy     *domain.TokenExchangePolicy
+		values     []string
+		requested  bool
+		want       []string
+		wantDenied string
+		wantOK     bool
+	}{
+		{
+			name:      "no policy",
+			policy:    nil,
+			values:    []string{"api", "project"},
+			requested: true,
+			want:      []string{"api", "project"},
+			wantOK:    true,
+		},
+		{
+			name:      "requested allowed",
+			policy:    policy,
+			values:    []string{"api", "other"},
+			requested: true,
+			want:      []string{"api", "other"},
+			wantOK:    true,
+		},
+		{
+			name:       "requested denied",
+			policy:     policy,
+			values:     []string{"api", "project"},
+			requested:  true,
+			wantDenied: "project",
+			wantOK:     false,
+		},
+		{
+			name:      "inherited narrowed",
+			policy:    policy,
+			values:    []string{"project", "api"},
+			requested: false,
+			want:      []string{"api"},
+			wantOK:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, denied, ok := applyTokenExchangePolicy(tt.values, tt.requested, tt.policy.AllowsAudience)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDenied, denied)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
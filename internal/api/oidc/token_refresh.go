@@ -26,9 +26,22 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 		return nil, zerrors.ThrowInternal(nil, "OIDC-ga0EP", "Error.Internal")
 	}
 
-	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, client.client.ClientID, refreshTokenComplianceChecker())
+	authorizationDetails, err := domain.ParseAuthorizationDetails(r.Form.Get(domain.AuthorizationDetailsParam))
+	if err != nil {
+		return nil, invalidAuthorizationDetailsError(err)
+	}
+	resources, err := s.resolveResources(ctx, r.Form[domain.ResourceParam])
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, authorizationDetails, resources, client.client.ClientID, refreshTokenComplianceChecker())
+	err = resourceError(err)
 	if err == nil {
-		return response(s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion))
+		resp, err := s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion)
+		if err != nil {
+			return nil, err
+		}
+		return op.NewResponse(tokenResponse(resp, session)), nil
 	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-JOI23", "Errors.OIDCSession.RefreshTokenInvalid")) {
 		// We try again for v1 tokens when we encountered specific parsing error
 		return s.refreshTokenV1(ctx, client, r)
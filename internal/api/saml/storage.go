@@ -484,11 +484,16 @@ func (p *Storage) getGrants(ctx context.Context, userID, applicationID string) (
 	if err != nil {
 		return nil, err
 	}
+	validQuery, err := query.NewUserGrantValidAtQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
 	return p.query.UserGrants(ctx, &query.UserGrantsQueries{
 		Queries: []query.SearchQuery{
 			projectQuery,
 			userIDQuery,
 			activeQuery,
+			validQuery,
 		},
 	}, true, nil)
 }
@@ -126,6 +126,7 @@ type userGrantProvider interface {
 type projectProvider interface {
 	ProjectByClientID(context.Context, string) (*query.Project, error)
 	SearchProjectGrants(ctx context.Context, queries *query.ProjectGrantSearchQueries, permissionCheck domain.PermissionCheck) (projects *query.ProjectGrants, err error)
+	OrgHierarchyIDs(ctx context.Context, orgID string) ([]string, error)
 }
 
 type applicationProvider interface {
@@ -1862,12 +1863,16 @@ func projectRequired(ctx context.Context, request *domain.AuthRequest, projectPr
 		return false, nil
 	}
 
-	// else just check if there is a project grant for that org
+	// else just check if there is a project grant for that org or for one of its parents including sub organizations
 	projectID, err := query.NewProjectGrantProjectIDSearchQuery(project.ID)
 	if err != nil {
 		return false, err
 	}
-	grantedOrg, err := query.NewProjectGrantGrantedOrgIDSearchQuery(request.UserOrgID)
+	hierarchy, err := projectProvider.OrgHierarchyIDs(ctx, request.UserOrgID)
+	if err != nil {
+		return false, err
+	}
+	grantedOrg, err := query.NewProjectGrantIncludesOrgSearchQuery(hierarchy)
 	if err != nil {
 		return false, err
 	}
@@ -1875,5 +1880,5 @@ func projectRequired(ctx context.Context, request *domain.AuthRequest, projectPr
 	if err != nil {
 		return false, err
 	}
-	return len(grants.ProjectGrants) != 1, nil
+	return len(grants.ProjectGrants) == 0, nil
 }
@@ -294,6 +294,10 @@ func (m *mockProject) SearchProjectGrants(ctx context.Context, queries *query.Pr
 	return &query.ProjectGrants{}, nil
 }
 
+func (m *mockProject) OrgHierarchyIDs(ctx context.Context, orgID string) ([]string, error) {
+	return []string{orgID}, nil
+}
+
 type mockApp struct {
 	app *query.App
 }
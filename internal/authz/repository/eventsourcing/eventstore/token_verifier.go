@@ -258,6 +258,15 @@ func authMethodsFromSession(session *query.Session) []domain.UserAuthMethodType
 	if !session.RecoveryCodeFactor.RecoveryCodeCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeRecoveryCode)
 	}
+	if !session.MagicLinkFactor.MagicLinkCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeMagicLink)
+	}
+	if !session.TrustedDeviceFactor.TrustedDeviceCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeTrustedDevice)
+	}
+	if !session.PushFactor.PushCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypePush)
+	}
 	return types
 }
 
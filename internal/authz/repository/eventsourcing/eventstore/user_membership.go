@@ -2,6 +2,7 @@ package eventstore
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/query"
@@ -39,8 +40,20 @@ func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID
 	if err != nil {
 		return nil, err
 	}
+	orgQueries := []query.SearchQuery{orgIDsQuery, grantedIDQuery}
+	parentsQuery, err := repo.parentOrgMembershipsQuery(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if parentsQuery != nil {
+		orgQueries = append(orgQueries, parentsQuery)
+	}
+	validQuery, err := query.NewMembershipValidAtQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
 	memberships, err := repo.Queries.Memberships(ctx, &query.MembershipSearchQuery{
-		Queries: []query.SearchQuery{userIDQuery, query.Or(orgIDsQuery, grantedIDQuery)},
+		Queries: []query.SearchQuery{userIDQuery, query.Or(orgQueries...), validQuery},
 	}, shouldTriggerBulk)
 	if err != nil {
 		return nil, err
@@ -48,6 +61,23 @@ func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID
 	return memberships.Memberships, nil
 }
 
+// parentOrgMembershipsQuery returns the query for the organization memberships of the parents of the organization,
+// as the administrators of the parents manage the sub organizations as well.
+// It returns nil if the organization has no parents.
+func (repo *UserMembershipRepo) parentOrgMembershipsQuery(ctx context.Context, orgID string) (query.SearchQuery, error) {
+	if orgID == "" {
+		return nil, nil
+	}
+	hierarchy, err := repo.Queries.OrgHierarchyIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(hierarchy) < 2 {
+		return nil, nil
+	}
+	return query.NewMembershipOrgIDsSearchQuery(hierarchy[1:]...)
+}
+
 func userMembershipToMembership(membership *query.Membership) *authz.Membership {
 	if membership.IAM != nil {
 		return &authz.Membership{
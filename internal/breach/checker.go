@@ -0,0 +1,85 @@
+package breach
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Checker checks passwords against a breached password corpus.
+type Checker interface {
+	// Breached returns true if the password is part of the corpus.
+	Breached(ctx context.Context, password string) (bool, error)
+}
+
+type checker struct {
+	corpus source
+	api    source
+}
+
+// NewChecker returns the checker for the configured corpus and range API.
+// If neither is configured, nil is returned.
+// The client is used to call the range API.
+func NewChecker(config *Config, client *http.Client) (Checker, error) {
+	if !config.enabled() {
+		return nil, nil
+	}
+	c := new(checker)
+	if config.Corpus != "" {
+		corpus, err := loadCorpus(config.Corpus)
+		if err != nil {
+			return nil, err
+		}
+		c.corpus = corpus
+	}
+	if config.RangeAPI != "" {
+		c.api = &rangeAPI{
+			url:    config.RangeAPI,
+			client: client,
+		}
+	}
+	return c, nil
+}
+
+func loadCorpus(path string) (source, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "BREACH-Ie7ai", "Errors.Internal")
+	}
+	if info.IsDir() {
+		return &directoryCorpus{fsys: os.DirFS(path)}, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "BREACH-oo2Sh", "Errors.Internal")
+	}
+	defer file.Close()
+	return parseMemoryCorpus(file)
+}
+
+func (c *checker) Breached(ctx context.Context, password string) (_ bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	h := hashPassword(password)
+	if c.corpus != nil {
+		breached, err := c.corpus.contains(ctx, h)
+		if err != nil || breached {
+			return breached, err
+		}
+	}
+	if c.api != nil {
+		breached, err := c.api.contains(ctx, h)
+		if err != nil {
+			logging.WithError(err).Warn("breached password range api unavailable, password not checked")
+			return false, nil
+		}
+		return breached, nil
+	}
+	return false, nil
+}
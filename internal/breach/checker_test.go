@@ -0,0 +1,93 @@
+package breach
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChecker(t *testing.T) {
+	checker, err := NewChecker(&Config{}, http.DefaultClient)
+	require.NoError(t, err)
+	assert.Nil(t, checker)
+
+	_, err = NewChecker(&Config{Corpus: filepath.Join(t.TempDir(), "missing")}, http.DefaultClient)
+	require.Error(t, err)
+}
+
+func TestChecker_Breached(t *testing.T) {
+	dir := t.TempDir()
+	corpusFile := filepath.Join(dir, "corpus.txt")
+	require.NoError(t, os.WriteFile(corpusFile, []byte("5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:10434004\n"), 0o600))
+
+	var requestedPrefix string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPrefix = filepath.Base(r.URL.Path)
+		assert.Equal(t, "true", r.Header.Get("Add-Padding"))
+		fmt.Fprint(w, "D09CA3762AF61E59520943DC26494F8941B:37359195\r\n0018A45C4D1DEF81644B54AB7F969B88D65:0\r\n")
+	}))
+	defer api.Close()
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	tests := []struct {
+		name       string
+		config     *Config
+		password   string
+		want       bool
+		wantPrefix string
+	}{
+		{
+			name:     "corpus, breached",
+			config:   &Config{Corpus: corpusFile},
+			password: "password",
+			want:     true,
+		},
+		{
+			name:     "corpus, not breached",
+			config:   &Config{Corpus: corpusFile},
+			password: "123456",
+			want:     false,
+		},
+		{
+			name:       "range api, breached",
+			config:     &Config{RangeAPI: api.URL + "/range/"},
+			password:   "123456",
+			want:       true,
+			wantPrefix: "7C4A8",
+		},
+		{
+			name:       "corpus and range api, not breached",
+			config:     &Config{Corpus: corpusFile, RangeAPI: api.URL + "/range/"},
+			password:   "correct horse battery staple",
+			want:       false,
+			wantPrefix: hashPassword("correct horse battery staple").prefix(),
+		},
+		{
+			name:     "range api unavailable, not breached",
+			config:   &Config{RangeAPI: unavailable.URL + "/range/"},
+			password: "123456",
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPrefix = ""
+			checker, err := NewChecker(tt.config, http.DefaultClient)
+			require.NoError(t, err)
+			got, err := checker.Breached(context.Background(), tt.password)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantPrefix, requestedPrefix)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package breach
+
+type Config struct {
+	// Corpus is the path to a local breached password corpus of SHA-1 hashes in the format of Have I Been Pwned.
+	// It's either a directory containing a file per 5 character hash prefix (e.g. `21BD1` or `21BD1.txt`),
+	// each line containing the remaining 35 characters of the hash optionally followed by the count: `SUFFIX:COUNT`,
+	// or a single file containing the full hashes: `HASH:COUNT`, which is loaded into memory.
+	Corpus string
+	// RangeAPI is the URL of a k-anonymity range API, e.g. `https://api.pwnedpasswords.com/range/`.
+	// The 5 character hash prefix is appended to the URL and the response must contain the suffixes in the format of the corpus.
+	// Only the prefix of the hash is sent to the API.
+	// If the API is unavailable, the password is accepted.
+	RangeAPI string
+}
+
+func (c *Config) enabled() bool {
+	return c != nil && (c.Corpus != "" || c.RangeAPI != "")
+}
@@ -0,0 +1,118 @@
+package breach
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec // the corpus is indexed by SHA-1
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// prefixLength is the amount of hex characters of the hash used for the k-anonymity range lookup.
+const prefixLength = 5
+
+// hash is the SHA-1 hash of a password.
+type hash [sha1.Size]byte
+
+func hashPassword(password string) hash {
+	return sha1.Sum([]byte(password)) //nolint:gosec
+}
+
+func (h hash) prefix() string {
+	return strings.ToUpper(hex.EncodeToString(h[:]))[:prefixLength]
+}
+
+func (h hash) suffix() string {
+	return strings.ToUpper(hex.EncodeToString(h[:]))[prefixLength:]
+}
+
+type source interface {
+	contains(ctx context.Context, h hash) (bool, error)
+}
+
+// containsSuffix reads lines in the format `SUFFIX[:COUNT]` and returns if the suffix is part of them.
+// Entries with a count of 0 are padding of the range API and are ignored.
+func containsSuffix(r io.Reader, suffix string) (bool, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		value, count, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !strings.EqualFold(value, suffix) {
+			continue
+		}
+		return strings.TrimSpace(count) != "0", nil
+	}
+	if err := scanner.Err(); err != nil {
+		return false, zerrors.ThrowInternal(err, "BREACH-ooK3a", "Errors.Internal")
+	}
+	return false, nil
+}
+
+// directoryCorpus looks up the hashes in a file per prefix, only the file of the prefix is read.
+type directoryCorpus struct {
+	fsys fs.FS
+}
+
+func (c *directoryCorpus) contains(_ context.Context, h hash) (bool, error) {
+	file, err := c.open(h.prefix())
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "BREACH-Ahm4o", "Errors.Internal")
+	}
+	defer file.Close()
+	return containsSuffix(file, h.suffix())
+}
+
+func (c *directoryCorpus) open(prefix string) (fs.File, error) {
+	file, err := c.fsys.Open(prefix)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c.fsys.Open(prefix + ".txt")
+	}
+	return file, err
+}
+
+// memoryCorpus holds the sorted hashes of a single corpus file.
+type memoryCorpus struct {
+	hashes []hash
+}
+
+func parseMemoryCorpus(r io.Reader) (*memoryCorpus, error) {
+	corpus := new(memoryCorpus)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		value, _, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if value == "" {
+			continue
+		}
+		var h hash
+		if len(value) != hex.EncodedLen(len(h)) {
+			return nil, zerrors.ThrowInvalidArgumentf(nil, "BREACH-Ke3ie", "invalid hash %q in breached password corpus", value)
+		}
+		if _, err := hex.Decode(h[:], []byte(value)); err != nil {
+			return nil, zerrors.ThrowInvalidArgumentf(err, "BREACH-eeJ9u", "invalid hash %q in breached password corpus", value)
+		}
+		corpus.hashes = append(corpus.hashes, h)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "BREACH-uW5ph", "Errors.Internal")
+	}
+	slices.SortFunc(corpus.hashes, compareHashes)
+	return corpus, nil
+}
+
+func (c *memoryCorpus) contains(_ context.Context, h hash) (bool, error) {
+	_, found := slices.BinarySearchFunc(c.hashes, h, compareHashes)
+	return found, nil
+}
+
+func compareHashes(a, b hash) int {
+	return bytes.Compare(a[:], b[:])
+}
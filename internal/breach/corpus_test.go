@@ -0,0 +1,123 @@
+package breach
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_hash(t *testing.T) {
+	h := hashPassword("password")
+	assert.Equal(t, "5BAA6", h.prefix())
+	assert.Equal(t, "1E4C9B93F3F0682250B6CF8331B7EE68FD8", h.suffix())
+}
+
+func Test_containsSuffix(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		suffix string
+		want   bool
+	}{
+		{
+			name:   "found",
+			data:   "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n1E4C9B93F3F0682250B6CF8331B7EE68FD8:10434004\r\n",
+			suffix: "1E4C9B93F3F0682250B6CF8331B7EE68FD8",
+			want:   true,
+		},
+		{
+			name:   "found lowercase without count",
+			data:   "1e4c9b93f3f0682250b6cf8331b7ee68fd8\n",
+			suffix: "1E4C9B93F3F0682250B6CF8331B7EE68FD8",
+			want:   true,
+		},
+		{
+			name:   "padding",
+			data:   "1E4C9B93F3F0682250B6CF8331B7EE68FD8:0\n",
+			suffix: "1E4C9B93F3F0682250B6CF8331B7EE68FD8",
+			want:   false,
+		},
+		{
+			name:   "not found",
+			data:   "0018A45C4D1DEF81644B54AB7F969B88D65:1\n",
+			suffix: "1E4C9B93F3F0682250B6CF8331B7EE68FD8",
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := containsSuffix(strings.NewReader(tt.data), tt.suffix)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_directoryCorpus(t *testing.T) {
+	corpus := &directoryCorpus{
+		fsys: fstest.MapFS{
+			"5BAA6":     {Data: []byte("1E4C9B93F3F0682250B6CF8331B7EE68FD8:10434004\n")},
+			"7C4A8.txt": {Data: []byte("D09CA3762AF61E59520943DC26494F8941B:37359195\n")},
+		},
+	}
+	tests := []struct {
+		password string
+		want     bool
+	}{
+		{"password", true},
+		{"123456", true},
+		{"correct horse battery staple", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			got, err := corpus.contains(context.Background(), hashPassword(tt.password))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseMemoryCorpus(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: "7C4A8D09CA3762AF61E59520943DC26494F8941B:37359195\n\n5baa61e4c9b93f3f0682250b6cf8331b7ee68fd8\n",
+		},
+		{
+			name:    "invalid length",
+			data:    "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8FF:1\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid hex",
+			data:    "ZBAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:1\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			corpus, err := parseMemoryCorpus(strings.NewReader(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for _, password := range []string{"password", "123456"} {
+				got, err := corpus.contains(context.Background(), hashPassword(password))
+				require.NoError(t, err)
+				assert.True(t, got, password)
+			}
+			got, err := corpus.contains(context.Background(), hashPassword("correct horse battery staple"))
+			require.NoError(t, err)
+			assert.False(t, got)
+		})
+	}
+}
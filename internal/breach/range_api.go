@@ -0,0 +1,32 @@
+package breach
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// rangeAPI looks up the hashes using a k-anonymity range API, only the prefix of the hash is sent.
+type rangeAPI struct {
+	url    string
+	client *http.Client
+}
+
+func (a *rangeAPI) contains(ctx context.Context, h hash) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.url+h.prefix(), nil)
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "BREACH-Quo9e", "Errors.Internal")
+	}
+	// pad the response, so the size does not reveal the prefix
+	req.Header.Set("Add-Padding", "true")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return false, zerrors.ThrowUnavailable(err, "BREACH-aeT1o", "Errors.Internal")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, zerrors.ThrowUnavailablef(nil, "BREACH-Xoo5i", "range api responded with status %d", resp.StatusCode)
+	}
+	return containsSuffix(resp.Body, h.suffix())
+}
@@ -0,0 +1,127 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// The Expire commands are used by the scheduled expiry check and therefore don't check any permission.
+// They only push the expiry if the validity ended and the expiry wasn't pushed yet,
+// so Actions and webhooks are notified once per validity.
+// The access itself ends with the validity, independent of the expiry.
+
+// ExpireUserGrant pushes the expiry of the user grant.
+func (c *Commands) ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ek2ie", "Errors.UserGrant.IDMissing")
+	}
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Fah3e", "Errors.UserGrant.NotFound")
+	}
+	if !expiryPending(existingUserGrant.Validity, existingUserGrant.Expired) {
+		return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantExpiredEvent(
+		ctx,
+		UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+		existingUserGrant.UserID,
+		existingUserGrant.ProjectID,
+		existingUserGrant.ProjectGrantID,
+		existingUserGrant.Validity.ValidUntil,
+	))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(existingUserGrant, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
+}
+
+// ExpireInstanceMember pushes the expiry of the instance membership.
+func (c *Commands) ExpireInstanceMember(ctx context.Context, instanceID, userID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if instanceID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-ahV9o", "Errors.Instance.MemberInvalid")
+	}
+	existingMember, err := c.instanceMemberWriteModelByID(ctx, instanceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return c.expireMember(ctx, existingMember, &existingMember.MemberWriteModel, func(validUntil time.Time) eventstore.Command {
+		return instance.NewMemberExpiredEvent(ctx, InstanceAggregateFromWriteModel(&existingMember.WriteModel), userID, validUntil)
+	})
+}
+
+// ExpireOrgMember pushes the expiry of the organization membership.
+func (c *Commands) ExpireOrgMember(ctx context.Context, orgID, userID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-ub8Ee", "Errors.Org.MemberInvalid")
+	}
+	existingMember, err := c.orgMemberWriteModelByID(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return c.expireMember(ctx, existingMember, &existingMember.MemberWriteModel, func(validUntil time.Time) eventstore.Command {
+		return org.NewMemberExpiredEvent(ctx, OrgAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel), userID, validUntil)
+	})
+}
+
+// ExpireProjectMember pushes the expiry of the project membership.
+func (c *Commands) ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Oov8a", "Errors.Project.Member.Invalid")
+	}
+	existingMember, err := c.projectMemberWriteModelByID(ctx, projectID, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return c.expireMember(ctx, existingMember, &existingMember.MemberWriteModel, func(validUntil time.Time) eventstore.Command {
+		return project.NewMemberExpiredEvent(ctx, ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel), userID, validUntil)
+	})
+}
+
+func (c *Commands) expireMember(ctx context.Context, writeModel AppendReducer, member *MemberWriteModel, expiredEvent func(validUntil time.Time) eventstore.Command) (*domain.ObjectDetails, error) {
+	if !member.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Quo9e", "Errors.NotFound")
+	}
+	if !expiryPending(member.Validity, member.Expired) {
+		return writeModelToObjectDetails(&member.WriteModel), nil
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, expiredEvent(member.Validity.ValidUntil))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(writeModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&member.WriteModel), nil
+}
+
+func expiryPending(validity *domain.Validity, expired bool) bool {
+	return !expired && validity.IsExpired(time.Now())
+}
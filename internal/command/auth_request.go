@@ -6,6 +6,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/authrequest"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -31,6 +32,14 @@ type AuthRequest struct {
 	NeedRefreshToken bool
 	Issuer           string
 	OrganizationID   string
+	// AuthorizationDetails requested by the client (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails
+	// RequireConsent is set if the client requires the user to consent to the requested scopes
+	RequireConsent bool
+	// Resources requested by the client (RFC 8707)
+	Resources []string
+	// ACRValues requested by the client in order of preference
+	ACRValues []string
 }
 
 type CurrentAuthRequest struct {
@@ -39,6 +48,8 @@ type CurrentAuthRequest struct {
 	UserID      string
 	AuthMethods []domain.UserAuthMethodType
 	AuthTime    time.Time
+	// ACR is the requested acr value satisfied by the linked session
+	ACR string
 }
 
 const IDPrefixV2 = "V2_"
@@ -77,6 +88,10 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 		authRequest.NeedRefreshToken,
 		authRequest.Issuer,
 		authRequest.OrganizationID,
+		authRequest.AuthorizationDetails,
+		authRequest.RequireConsent,
+		authRequest.Resources,
+		authRequest.ACRValues,
 	))
 	if err != nil {
 		return nil, err
@@ -84,7 +99,12 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 	return authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
-func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID, sessionToken string, checkLoginClient bool, projectPermissionCheck domain.ProjectPermissionCheck) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
+// LinkSessionToAuthRequest links the session to the auth request, finalizing the authentication.
+// If the client requires consent, consentGranted records the consent of the user to the requested scopes.
+// Without a previously granted consent covering the scopes, the link fails until the user consents.
+// The session must also satisfy the max_age and acr_values of the request, otherwise the user
+// needs to (re-)authenticate with further factors before the session can be linked.
+func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID, sessionToken string, checkLoginClient bool, projectPermissionCheck domain.ProjectPermissionCheck, consentGranted bool) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
 		return nil, nil, err
@@ -112,6 +132,9 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
 		return nil, nil, err
 	}
+	if err = sessionWriteModel.CheckRiskAccepted(); err != nil {
+		return nil, nil, err
+	}
 
 	if projectPermissionCheck != nil {
 		if err := projectPermissionCheck(ctx, writeModel.ClientID, sessionWriteModel.UserID); err != nil {
@@ -121,19 +144,54 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 	if writeModel.OrganizationID != "" && writeModel.OrganizationID != sessionWriteModel.UserResourceOwner {
 		return nil, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-59ljd", "Errors.User.NotAllowedOrg")
 	}
+	if err = writeModel.CheckMaxAge(sessionWriteModel.AuthenticationTime()); err != nil {
+		return nil, nil, err
+	}
+	acr, err := c.authRequestACR(ctx, writeModel, sessionWriteModel.AuthMethodTypes())
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if err := c.pushAppendAndReduce(ctx, writeModel, authrequest.NewSessionLinkedEvent(
+	consent, err := c.authRequestConsent(ctx, writeModel, sessionWriteModel.UserID, sessionWriteModel.UserResourceOwner, consentGranted)
+	if err != nil {
+		return nil, nil, err
+	}
+	cmds := make([]eventstore.Command, 0, 2)
+	if consent != nil {
+		cmds = append(cmds, consent)
+	}
+	cmds = append(cmds, authrequest.NewSessionLinkedEvent(
 		ctx, &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
 		sessionID,
 		sessionWriteModel.UserID,
 		sessionWriteModel.AuthenticationTime(),
 		sessionWriteModel.AuthMethodTypes(),
-	)); err != nil {
+		acr,
+	))
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, nil, err
+	}
+	// only the last event belongs to the auth request, the consent is stored on the user
+	if err = AppendAndReduce(writeModel, pushedEvents[len(pushedEvents)-1]); err != nil {
 		return nil, nil, err
 	}
 	return writeModelToObjectDetails(&writeModel.WriteModel), authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
+// authRequestACR returns the first acr value requested by the client, which is satisfied by the auth methods
+// based on the acr definitions of the instance.
+func (c *Commands) authRequestACR(ctx context.Context, writeModel *AuthRequestWriteModel, authMethods []domain.UserAuthMethodType) (string, error) {
+	if len(writeModel.ACRValues) == 0 {
+		return "", nil
+	}
+	policy, err := c.getSecurityPolicyWriteModel(ctx, c.eventstore.Filter) //nolint:staticcheck
+	if err != nil {
+		return "", err
+	}
+	return policy.ACRDefinitions.Satisfy(writeModel.ACRValues, authMethods)
+}
+
 func (c *Commands) FailAuthRequest(ctx context.Context, id string, reason domain.OIDCErrorReason) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
@@ -176,29 +234,34 @@ func (c *Commands) AddAuthRequestCode(ctx context.Context, authRequestID, code s
 func authRequestWriteModelToCurrentAuthRequest(writeModel *AuthRequestWriteModel) (_ *CurrentAuthRequest) {
 	return &CurrentAuthRequest{
 		AuthRequest: &AuthRequest{
-			ID:             writeModel.AggregateID,
-			LoginClient:    writeModel.LoginClient,
-			ClientID:       writeModel.ClientID,
-			RedirectURI:    writeModel.RedirectURI,
-			State:          writeModel.State,
-			Nonce:          writeModel.Nonce,
-			Scope:          writeModel.Scope,
-			Audience:       writeModel.Audience,
-			ResponseType:   writeModel.ResponseType,
-			ResponseMode:   writeModel.ResponseMode,
-			CodeChallenge:  writeModel.CodeChallenge,
-			Prompt:         writeModel.Prompt,
-			UILocales:      writeModel.UILocales,
-			MaxAge:         writeModel.MaxAge,
-			LoginHint:      writeModel.LoginHint,
-			HintUserID:     writeModel.HintUserID,
-			Issuer:         writeModel.Issuer,
-			OrganizationID: writeModel.OrganizationID,
+			ID:                   writeModel.AggregateID,
+			LoginClient:          writeModel.LoginClient,
+			ClientID:             writeModel.ClientID,
+			RedirectURI:          writeModel.RedirectURI,
+			State:                writeModel.State,
+			Nonce:                writeModel.Nonce,
+			Scope:                writeModel.Scope,
+			Audience:             writeModel.Audience,
+			ResponseType:         writeModel.ResponseType,
+			ResponseMode:         writeModel.ResponseMode,
+			CodeChallenge:        writeModel.CodeChallenge,
+			Prompt:               writeModel.Prompt,
+			UILocales:            writeModel.UILocales,
+			MaxAge:               writeModel.MaxAge,
+			LoginHint:            writeModel.LoginHint,
+			HintUserID:           writeModel.HintUserID,
+			Issuer:               writeModel.Issuer,
+			OrganizationID:       writeModel.OrganizationID,
+			AuthorizationDetails: writeModel.AuthorizationDetails,
+			Resources:            writeModel.Resources,
+			RequireConsent:       writeModel.RequireConsent,
+			ACRValues:            writeModel.ACRValues,
 		},
 		SessionID:   writeModel.SessionID,
 		UserID:      writeModel.UserID,
 		AuthMethods: writeModel.AuthMethods,
 		AuthTime:    writeModel.AuthTime,
+		ACR:         writeModel.ACR,
 	}
 }
 
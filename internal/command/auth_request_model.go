@@ -15,29 +15,35 @@ type AuthRequestWriteModel struct {
 	eventstore.WriteModel
 	aggregate *eventstore.Aggregate
 
-	LoginClient      string
-	ClientID         string
-	RedirectURI      string
-	State            string
-	Nonce            string
-	Scope            []string
-	Audience         []string
-	ResponseType     domain.OIDCResponseType
-	ResponseMode     domain.OIDCResponseMode
-	CodeChallenge    *domain.OIDCCodeChallenge
-	Prompt           []domain.Prompt
-	UILocales        []string
-	MaxAge           *time.Duration
-	LoginHint        *string
-	HintUserID       *string
-	SessionID        string
-	UserID           string
-	AuthTime         time.Time
-	AuthMethods      []domain.UserAuthMethodType
-	AuthRequestState domain.AuthRequestState
-	NeedRefreshToken bool
-	Issuer           string
-	OrganizationID   string
+	LoginClient          string
+	ClientID             string
+	RedirectURI          string
+	State                string
+	Nonce                string
+	Scope                []string
+	Audience             []string
+	ResponseType         domain.OIDCResponseType
+	ResponseMode         domain.OIDCResponseMode
+	CodeChallenge        *domain.OIDCCodeChallenge
+	Prompt               []domain.Prompt
+	UILocales            []string
+	MaxAge               *time.Duration
+	LoginHint            *string
+	HintUserID           *string
+	SessionID            string
+	UserID               string
+	AuthTime             time.Time
+	AuthMethods          []domain.UserAuthMethodType
+	AuthRequestState     domain.AuthRequestState
+	NeedRefreshToken     bool
+	Issuer               string
+	OrganizationID       string
+	AuthorizationDetails domain.AuthorizationDetails
+	RequireConsent       bool
+	Resources            []string
+	ACRValues            []string
+	ACR                  string
+	CreationDate         time.Time
 }
 
 func NewAuthRequestWriteModel(ctx context.Context, id string) *AuthRequestWriteModel {
@@ -72,11 +78,17 @@ func (m *AuthRequestWriteModel) Reduce() error {
 			m.NeedRefreshToken = e.NeedRefreshToken
 			m.Issuer = e.Issuer
 			m.OrganizationID = e.OrganizationID
+			m.AuthorizationDetails = e.AuthorizationDetails
+			m.Resources = e.Resources
+			m.RequireConsent = e.RequireConsent
+			m.ACRValues = e.ACRValues
+			m.CreationDate = e.CreatedAt()
 		case *authrequest.SessionLinkedEvent:
 			m.SessionID = e.SessionID
 			m.UserID = e.UserID
 			m.AuthTime = e.AuthTime
 			m.AuthMethods = e.AuthMethods
+			m.ACR = e.ACR
 		case *authrequest.CodeAddedEvent:
 			m.AuthRequestState = domain.AuthRequestStateCodeAdded
 		case *authrequest.FailedEvent:
@@ -99,6 +111,19 @@ func (m *AuthRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
 		Builder()
 }
 
+// CheckMaxAge checks that the authentication happened within the max_age requested by the client.
+// An authentication after the creation of the auth request is always accepted,
+// so a max_age of 0 forces a new authentication, but a slow login does not fail.
+func (m *AuthRequestWriteModel) CheckMaxAge(authTime time.Time) error {
+	if m.MaxAge == nil {
+		return nil
+	}
+	if authTime.Before(time.Now().Add(-*m.MaxAge)) && authTime.Before(m.CreationDate) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Aiv5o", "Errors.AuthRequest.MaxAgeExceeded")
+	}
+	return nil
+}
+
 // CheckAuthenticated checks that the auth request exists, a session must have been linked
 // and in case of a Code Flow the code must have been exchanged
 func (m *AuthRequestWriteModel) CheckAuthenticated() error {
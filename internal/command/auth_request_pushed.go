@@ -0,0 +1,58 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddPushedAuthRequest stores the parameters of a pushed authorization request (RFC 9126)
+// of the client until the expiration.
+// The returned id is used to reference the parameters on the authorization endpoint.
+func (c *Commands) AddPushedAuthRequest(ctx context.Context, clientID string, parameters map[string][]string, expiration time.Time) (_ string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if clientID == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-Oot5e", "Errors.AuthRequest.Pushed.ClientIDMissing")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	writeModel := NewPushedAuthRequestWriteModel(ctx, IDPrefixV2+id)
+	err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedEvent(ctx, writeModel.aggregate, clientID, parameters, expiration))
+	if err != nil {
+		return "", err
+	}
+	return writeModel.AggregateID, nil
+}
+
+// UsePushedAuthRequest returns the parameters of the pushed authorization request.
+// The request can only be used once, by the client which pushed it and before it expires.
+// Concurrent uses are prevented by the unique constraint of the used event.
+func (c *Commands) UsePushedAuthRequest(ctx context.Context, id, clientID string) (_ map[string][]string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewPushedAuthRequestWriteModel(ctx, id)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.Pushed || writeModel.ClientID != clientID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-aeN8u", "Errors.AuthRequest.Pushed.NotExisting")
+	}
+	if writeModel.Used {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ahz3o", "Errors.AuthRequest.Pushed.AlreadyUsed")
+	}
+	if !writeModel.Expiration.After(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ue1ai", "Errors.AuthRequest.Pushed.Expired")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedUsedEvent(ctx, writeModel.aggregate)); err != nil {
+		return nil, err
+	}
+	return writeModel.Parameters, nil
+}
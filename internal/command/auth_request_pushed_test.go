@@ -0,0 +1,248 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddPushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	expiration := time.Now().Add(time.Minute)
+	parameters := map[string][]string{
+		"client_id":     {"clientID"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+	}
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx        context.Context
+		clientID   string
+		parameters map[string][]string
+		expiration time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			"missing client id error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:        mockCtx,
+				parameters: parameters,
+				expiration: expiration,
+			},
+			"",
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Oot5e", "Errors.AuthRequest.Pushed.ClientIDMissing"),
+		},
+		{
+			"pushed",
+			fields{
+				eventstore: expectEventstore(
+					expectPush(
+						authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+							"clientID",
+							parameters,
+							expiration,
+						),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			args{
+				ctx:        mockCtx,
+				clientID:   "clientID",
+				parameters: parameters,
+				expiration: expiration,
+			},
+			"V2_id",
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddPushedAuthRequest(tt.args.ctx, tt.args.clientID, tt.args.parameters, tt.args.expiration)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_UsePushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	parameters := map[string][]string{
+		"client_id":     {"clientID"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+	}
+	pushedEvent := func(expiration time.Time) eventstore.Event {
+		return eventFromEventPusher(
+			authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+				"clientID",
+				parameters,
+				expiration,
+			),
+		)
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		id       string
+		clientID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    map[string][]string
+		wantErr error
+	}{
+		{
+			"not existing error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "V2_id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowNotFound(nil, "COMMAND-aeN8u", "Errors.AuthRequest.Pushed.NotExisting"),
+		},
+		{
+			"other client error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedEvent(time.Now().Add(time.Minute)),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "V2_id",
+				clientID: "otherClientID",
+			},
+			nil,
+			zerrors.ThrowNotFound(nil, "COMMAND-aeN8u", "Errors.AuthRequest.Pushed.NotExisting"),
+		},
+		{
+			"already used error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedEvent(time.Now().Add(time.Minute)),
+						eventFromEventPusher(
+							authrequest.NewPushedUsedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "V2_id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ahz3o", "Errors.AuthRequest.Pushed.AlreadyUsed"),
+		},
+		{
+			"expired error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedEvent(time.Now().Add(-time.Minute)),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "V2_id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ue1ai", "Errors.AuthRequest.Pushed.Expired"),
+		},
+		{
+			"concurrently used error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedEvent(time.Now().Add(time.Minute)),
+					),
+					expectPushFailed(
+						zerrors.ThrowAlreadyExists(nil, "V3-DKcYh", "Errors.AuthRequest.Pushed.AlreadyUsed"),
+						authrequest.NewPushedUsedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "V2_id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowAlreadyExists(nil, "V3-DKcYh", "Errors.AuthRequest.Pushed.AlreadyUsed"),
+		},
+		{
+			"used",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedEvent(time.Now().Add(time.Minute)),
+					),
+					expectPush(
+						authrequest.NewPushedUsedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "V2_id",
+				clientID: "clientID",
+			},
+			parameters,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.UsePushedAuthRequest(tt.args.ctx, tt.args.id, tt.args.clientID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
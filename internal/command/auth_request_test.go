@@ -18,7 +18,9 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/id/mock"
 	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -64,6 +66,10 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 								false,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -105,6 +111,10 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 							false,
 							"issuer",
 							"organizationID",
+							nil,
+							false,
+							nil,
+							nil,
 						),
 					),
 				),
@@ -190,6 +200,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 		sessionToken     string
 		checkLoginClient bool
 		permissionCheck  domain.ProjectPermissionCheck
+		consentGranted   bool
 	}
 	type res struct {
 		details *domain.ObjectDetails
@@ -244,6 +255,10 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -288,6 +303,10 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -331,6 +350,10 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -372,6 +395,10 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -436,6 +463,10 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -489,6 +520,10 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"organizationID",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -554,6 +589,10 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -587,6 +626,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							"",
 						),
 					),
 				),
@@ -621,7 +661,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 			},
 		},
 		{
-			"linked with organization check",
+			"consent required",
 			fields{
 				eventstore: expectEventstore(
 					expectFilter(
@@ -644,7 +684,81 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
-								"org1",
+								"",
+								nil,
+								true,
+								nil,
+								nil,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+					expectFilter(),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+			},
+			res{
+				wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ooy5e", "Errors.AuthRequest.ConsentRequired"),
+			},
+		},
+		{
+			"linked with consent granted",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								nil,
+								true,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -672,22 +786,29 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								2*time.Minute),
 						),
 					),
+					expectFilter(),
 					expectPush(
+						user.NewHumanConsentGrantedEvent(mockCtx, &user.NewAggregate("userID", "org1").Aggregate,
+							"clientID",
+							[]string{"openid"},
+						),
 						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
 							"sessionID",
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							"",
 						),
 					),
 				),
 				tokenVerifier: newMockTokenVerifierValid(),
 			},
 			args{
-				ctx:          mockCtx,
-				id:           "V2_id",
-				sessionID:    "sessionID",
-				sessionToken: "token",
+				ctx:            mockCtx,
+				id:             "V2_id",
+				sessionID:      "sessionID",
+				sessionToken:   "token",
+				consentGranted: true,
 			},
 			res{
 				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
@@ -704,7 +825,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 						ResponseType:   domain.OIDCResponseTypeCode,
 						ResponseMode:   domain.OIDCResponseModeQuery,
 						Issuer:         "issuer",
-						OrganizationID: "org1",
+						RequireConsent: true,
 					},
 					SessionID:   "sessionID",
 					UserID:      "userID",
@@ -713,7 +834,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 			},
 		},
 		{
-			"linked with login client check",
+			"linked with remembered consent",
 			fields{
 				eventstore: expectEventstore(
 					expectFilter(
@@ -737,6 +858,10 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								true,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -764,39 +889,48 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								2*time.Minute),
 						),
 					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(mockCtx, &user.NewAggregate("userID", "org1").Aggregate,
+								"clientID",
+								[]string{"openid", "profile"},
+							),
+						),
+					),
 					expectPush(
 						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
 							"sessionID",
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							"",
 						),
 					),
 				),
 				tokenVerifier: newMockTokenVerifierValid(),
 			},
 			args{
-				ctx:              authz.NewMockContext("instanceID", "orgID", "loginClient"),
-				id:               "V2_id",
-				sessionID:        "sessionID",
-				sessionToken:     "token",
-				checkLoginClient: true,
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
 			},
 			res{
 				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
 				authReq: &CurrentAuthRequest{
 					AuthRequest: &AuthRequest{
-						ID:           "V2_id",
-						LoginClient:  "loginClient",
-						ClientID:     "clientID",
-						RedirectURI:  "redirectURI",
-						State:        "state",
-						Nonce:        "nonce",
-						Scope:        []string{"openid"},
-						Audience:     []string{"audience"},
-						ResponseType: domain.OIDCResponseTypeCode,
-						ResponseMode: domain.OIDCResponseModeQuery,
-						Issuer:       "issuer",
+						ID:             "V2_id",
+						LoginClient:    "loginClient",
+						ClientID:       "clientID",
+						RedirectURI:    "redirectURI",
+						State:          "state",
+						Nonce:          "nonce",
+						Scope:          []string{"openid"},
+						Audience:       []string{"audience"},
+						ResponseType:   domain.OIDCResponseTypeCode,
+						ResponseMode:   domain.OIDCResponseModeQuery,
+						Issuer:         "issuer",
+						RequireConsent: true,
 					},
 					SessionID:   "sessionID",
 					UserID:      "userID",
@@ -805,13 +939,13 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 			},
 		},
 		{
-			"linked with permission",
+			"linked with organization check",
 			fields{
 				eventstore: expectEventstore(
 					expectFilter(
 						eventFromEventPusher(
 							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
-								"otherLoginClient",
+								"loginClient",
 								"clientID",
 								"redirectURI",
 								"state",
@@ -828,7 +962,11 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
-								"",
+								"org1",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -862,34 +1000,34 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							"",
 						),
 					),
 				),
-				tokenVerifier:   newMockTokenVerifierValid(),
-				checkPermission: newMockPermissionCheckAllowed(),
+				tokenVerifier: newMockTokenVerifierValid(),
 			},
 			args{
-				ctx:              authz.NewMockContext("instanceID", "orgID", "loginClient"),
-				id:               "V2_id",
-				sessionID:        "sessionID",
-				sessionToken:     "token",
-				checkLoginClient: true,
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
 			},
 			res{
 				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
 				authReq: &CurrentAuthRequest{
 					AuthRequest: &AuthRequest{
-						ID:           "V2_id",
-						LoginClient:  "otherLoginClient",
-						ClientID:     "clientID",
-						RedirectURI:  "redirectURI",
-						State:        "state",
-						Nonce:        "nonce",
-						Scope:        []string{"openid"},
-						Audience:     []string{"audience"},
-						ResponseType: domain.OIDCResponseTypeCode,
-						ResponseMode: domain.OIDCResponseModeQuery,
-						Issuer:       "issuer",
+						ID:             "V2_id",
+						LoginClient:    "loginClient",
+						ClientID:       "clientID",
+						RedirectURI:    "redirectURI",
+						State:          "state",
+						Nonce:          "nonce",
+						Scope:          []string{"openid"},
+						Audience:       []string{"audience"},
+						ResponseType:   domain.OIDCResponseTypeCode,
+						ResponseMode:   domain.OIDCResponseModeQuery,
+						Issuer:         "issuer",
+						OrganizationID: "org1",
 					},
 					SessionID:   "sessionID",
 					UserID:      "userID",
@@ -898,13 +1036,13 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 			},
 		},
 		{
-			"linked with permission, application permission check",
+			"linked with login client check",
 			fields{
 				eventstore: expectEventstore(
 					expectFilter(
 						eventFromEventPusher(
 							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
-								"otherLoginClient",
+								"loginClient",
 								"clientID",
 								"redirectURI",
 								"state",
@@ -922,6 +1060,10 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -955,11 +1097,11 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							"",
 						),
 					),
 				),
-				tokenVerifier:   newMockTokenVerifierValid(),
-				checkPermission: newMockPermissionCheckAllowed(),
+				tokenVerifier: newMockTokenVerifierValid(),
 			},
 			args{
 				ctx:              authz.NewMockContext("instanceID", "orgID", "loginClient"),
@@ -967,14 +1109,13 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 				sessionID:        "sessionID",
 				sessionToken:     "token",
 				checkLoginClient: true,
-				permissionCheck:  newMockProjectPermissionCheckAllowed(),
 			},
 			res{
 				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
 				authReq: &CurrentAuthRequest{
 					AuthRequest: &AuthRequest{
 						ID:           "V2_id",
-						LoginClient:  "otherLoginClient",
+						LoginClient:  "loginClient",
 						ClientID:     "clientID",
 						RedirectURI:  "redirectURI",
 						State:        "state",
@@ -992,7 +1133,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 			},
 		},
 		{
-			"linked with permission, no application permission",
+			"linked with permission",
 			fields{
 				eventstore: expectEventstore(
 					expectFilter(
@@ -1016,6 +1157,10 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -1043,6 +1188,15 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								2*time.Minute),
 						),
 					),
+					expectPush(
+						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+							"sessionID",
+							"userID",
+							testNow,
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							"",
+						),
+					),
 				),
 				tokenVerifier:   newMockTokenVerifierValid(),
 				checkPermission: newMockPermissionCheckAllowed(),
@@ -1053,21 +1207,464 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 				sessionID:        "sessionID",
 				sessionToken:     "token",
 				checkLoginClient: true,
-				permissionCheck:  newMockProjectPermissionCheckOIDCNotAllowed(),
 			},
 			res{
-				wantErr: zerrors.ThrowPermissionDenied(nil, "OIDC-foSyH49RvL", "Errors.PermissionDenied"),
+				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
+				authReq: &CurrentAuthRequest{
+					AuthRequest: &AuthRequest{
+						ID:           "V2_id",
+						LoginClient:  "otherLoginClient",
+						ClientID:     "clientID",
+						RedirectURI:  "redirectURI",
+						State:        "state",
+						Nonce:        "nonce",
+						Scope:        []string{"openid"},
+						Audience:     []string{"audience"},
+						ResponseType: domain.OIDCResponseTypeCode,
+						ResponseMode: domain.OIDCResponseModeQuery,
+						Issuer:       "issuer",
+					},
+					SessionID:   "sessionID",
+					UserID:      "userID",
+					AuthMethods: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				},
 			},
 		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
+		{
+			"linked with permission, application permission check",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"otherLoginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								nil,
+								false,
+								nil,
+								nil,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+					expectPush(
+						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+							"sessionID",
+							"userID",
+							testNow,
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							"",
+						),
+					),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:              authz.NewMockContext("instanceID", "orgID", "loginClient"),
+				id:               "V2_id",
+				sessionID:        "sessionID",
+				sessionToken:     "token",
+				checkLoginClient: true,
+				permissionCheck:  newMockProjectPermissionCheckAllowed(),
+			},
+			res{
+				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
+				authReq: &CurrentAuthRequest{
+					AuthRequest: &AuthRequest{
+						ID:           "V2_id",
+						LoginClient:  "otherLoginClient",
+						ClientID:     "clientID",
+						RedirectURI:  "redirectURI",
+						State:        "state",
+						Nonce:        "nonce",
+						Scope:        []string{"openid"},
+						Audience:     []string{"audience"},
+						ResponseType: domain.OIDCResponseTypeCode,
+						ResponseMode: domain.OIDCResponseModeQuery,
+						Issuer:       "issuer",
+					},
+					SessionID:   "sessionID",
+					UserID:      "userID",
+					AuthMethods: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				},
+			},
+		},
+		{
+			"linked with permission, no application permission",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"otherLoginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								nil,
+								false,
+								nil,
+								nil,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:              authz.NewMockContext("instanceID", "orgID", "loginClient"),
+				id:               "V2_id",
+				sessionID:        "sessionID",
+				sessionToken:     "token",
+				checkLoginClient: true,
+				permissionCheck:  newMockProjectPermissionCheckOIDCNotAllowed(),
+			},
+			res{
+				wantErr: zerrors.ThrowPermissionDenied(nil, "OIDC-foSyH49RvL", "Errors.PermissionDenied"),
+			},
+		},
+		{
+			"max age exceeded",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								gu.Ptr(time.Minute),
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								nil,
+								false,
+								nil,
+								nil,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow.Add(-time.Hour)),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+			},
+			res{
+				wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Aiv5o", "Errors.AuthRequest.MaxAgeExceeded"),
+			},
+		},
+		{
+			"acr not satisfied",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								nil,
+								false,
+								nil,
+								[]string{"urn:unknown", "urn:zitadel:acr:mfa"},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newACRDefinitionsSetEvent(mockCtx, domain.ACRDefinitions{
+								{Value: "urn:zitadel:acr:mfa", Level: domain.AuthenticationLevelMultiFactor},
+							}),
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+			},
+			res{
+				wantErr: zerrors.ThrowPreconditionFailed(nil, "DOMAIN-Shoo4", "Errors.AuthRequest.ACRNotSatisfied"),
+			},
+		},
+		{
+			"linked with acr",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								gu.Ptr(time.Hour),
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								nil,
+								false,
+								nil,
+								[]string{"urn:unknown", "urn:zitadel:acr:mfa"},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusher(
+							session.NewTOTPCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							newACRDefinitionsSetEvent(mockCtx, domain.ACRDefinitions{
+								{Value: "urn:zitadel:acr:mfa", Level: domain.AuthenticationLevelMultiFactor},
+							}),
+						),
+					),
+					expectPush(
+						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+							"sessionID",
+							"userID",
+							testNow,
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeTOTP},
+							"urn:zitadel:acr:mfa",
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+			},
+			res{
+				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
+				authReq: &CurrentAuthRequest{
+					AuthRequest: &AuthRequest{
+						ID:           "V2_id",
+						LoginClient:  "loginClient",
+						ClientID:     "clientID",
+						RedirectURI:  "redirectURI",
+						State:        "state",
+						Nonce:        "nonce",
+						Scope:        []string{"openid"},
+						Audience:     []string{"audience"},
+						ResponseType: domain.OIDCResponseTypeCode,
+						ResponseMode: domain.OIDCResponseModeQuery,
+						MaxAge:       gu.Ptr(time.Hour),
+						Issuer:       "issuer",
+						ACRValues:    []string{"urn:unknown", "urn:zitadel:acr:mfa"},
+					},
+					SessionID:   "sessionID",
+					UserID:      "userID",
+					AuthMethods: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword, domain.UserAuthMethodTypeTOTP},
+					ACR:         "urn:zitadel:acr:mfa",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
 			c := &Commands{
 				eventstore:           tt.fields.eventstore(t),
 				sessionTokenVerifier: tt.fields.tokenVerifier,
 				checkPermission:      tt.fields.checkPermission,
 			}
-			details, got, err := c.LinkSessionToAuthRequest(tt.args.ctx, tt.args.id, tt.args.sessionID, tt.args.sessionToken, tt.args.checkLoginClient, tt.args.permissionCheck)
+			details, got, err := c.LinkSessionToAuthRequest(tt.args.ctx, tt.args.id, tt.args.sessionID, tt.args.sessionToken, tt.args.checkLoginClient, tt.args.permissionCheck, tt.args.consentGranted)
 			require.ErrorIs(t, err, tt.res.wantErr)
 			assertObjectDetails(t, tt.res.details, details)
 			if err == nil {
@@ -1079,6 +1676,13 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 	}
 }
 
+func newACRDefinitionsSetEvent(ctx context.Context, definitions domain.ACRDefinitions) *instance.SecurityPolicySetEvent {
+	event, _ := instance.NewSecurityPolicySetEvent(ctx, &instance.NewAggregate("instanceID").Aggregate,
+		[]instance.SecurityPolicyChanges{instance.ChangeSecurityPolicyACRDefinitions(definitions)},
+	)
+	return event
+}
+
 func TestCommands_FailAuthRequest(t *testing.T) {
 	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
 	type fields struct {
@@ -1142,6 +1746,10 @@ func TestCommands_FailAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -1182,6 +1790,10 @@ func TestCommands_FailAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -1287,6 +1899,10 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -1327,6 +1943,10 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
+								nil,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -1335,6 +1955,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								"userID",
 								testNow,
 								[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+								"",
 							),
 						),
 					),
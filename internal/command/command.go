@@ -22,6 +22,7 @@ import (
 	new_domain "github.com/zitadel/zitadel/backend/v3/domain"
 	"github.com/zitadel/zitadel/internal/api/authz"
 	api_http "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/breach"
 	"github.com/zitadel/zitadel/internal/cache/connector"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
@@ -32,6 +33,7 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	internal_net "github.com/zitadel/zitadel/internal/net"
 	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	webauthn_helper "github.com/zitadel/zitadel/internal/webauthn"
@@ -74,6 +76,7 @@ type Commands struct {
 	defaultRefreshTokenLifetime     time.Duration
 	defaultRefreshTokenIdleLifetime time.Duration
 	phoneCodeVerifier               func(ctx context.Context, id string) (senders.CodeGenerator, error)
+	pushChallengeGenerator          func() (challenge string, number uint32, err error)
 	tarpit                          func(failedAttempts uint64)
 
 	multifactors            domain.MultifactorConfigs
@@ -98,6 +101,14 @@ type Commands struct {
 	EventExisting          func(event string) bool
 	EventGroupExisting     func(group string) bool
 
+	// RiskEvaluator evaluates the risk of session checks, it's nil if the evaluation is disabled.
+	RiskEvaluator risk.Evaluator
+	// DeviceEvaluator detects sign-ins from new devices if the risk evaluation is disabled.
+	// It's only used for users whose notification policy enables the security notifications.
+	DeviceEvaluator risk.Evaluator
+	// PasswordBreachChecker checks passwords against a breached password corpus, it's nil if none is configured.
+	PasswordBreachChecker breach.Checker
+
 	GenerateDomain func(instanceName, domain string) (string, error)
 
 	caches *Caches
@@ -200,6 +211,7 @@ func StartCommands(
 		newEncryptedCode:                newEncryptedCode,
 		newEncryptedCodeWithDefault:     newEncryptedCodeWithDefaultConfig,
 		sessionTokenCreator:             sessionTokenCreator(idGenerator, sessionAlg),
+		pushChallengeGenerator:          domain.NewPushChallenge,
 		sessionTokenVerifier:            sessionTokenVerifier,
 		defaultAccessTokenLifetime:      defaultAccessTokenLifetime,
 		defaultRefreshTokenLifetime:     defaultRefreshTokenLifetime,
@@ -230,6 +242,9 @@ func StartCommands(
 				Length:     defaults.Multifactors.RecoveryCodes.Length,
 				WithHyphen: defaults.Multifactors.RecoveryCodes.WithHyphen,
 			},
+			Push: domain.PushConfig{
+				ChallengeExpiry: defaults.Multifactors.Push.ChallengeExpiry,
+			},
 		},
 		GenerateDomain:   domain.NewGeneratedInstanceDomain,
 		caches:           caches,
@@ -1248,6 +1248,8 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 						),
 					),
 					expectFilter(), // token lifetime
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
 					expectPush(
 						oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 							"userID", "org1", "sessionID", "clientID", []string{"audience"}, []string{"openid", "offline_access"},
@@ -1257,10 +1259,15 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 								Description:   gu.Ptr("firefox"),
 								Header:        http.Header{"foo": []string{"bar"}},
 							},
+							nil,
+							nil,
+							"",
 						),
 						oidcsession.NewAccessTokenAddedEvent(context.Background(),
 							&oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 							"at_accessTokenID", []string{"openid", "offline_access"}, time.Hour, domain.TokenReasonAuthRequest, nil,
+							nil,
+							nil,
 						),
 						deviceauth.NewDoneEvent(ctx,
 							deviceauth.NewAggregate("123", "instance1"),
@@ -1346,6 +1353,8 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 						),
 					),
 					expectFilter(), // token lifetime
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
 					expectPush(
 						oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 							"userID", "org1", "sessionID", "clientID", []string{"audience"}, []string{"openid", "offline_access"},
@@ -1355,6 +1364,9 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 								Description:   gu.Ptr("firefox"),
 								Header:        http.Header{"foo": []string{"bar"}},
 							},
+							nil,
+							nil,
+							"",
 						),
 						sessionlogout.NewBackChannelLogoutRegisteredEvent(context.Background(),
 							&sessionlogout.NewAggregate("sessionID", "instance1").Aggregate,
@@ -1366,6 +1378,8 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 						oidcsession.NewAccessTokenAddedEvent(context.Background(),
 							&oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 							"at_accessTokenID", []string{"openid", "offline_access"}, time.Hour, domain.TokenReasonAuthRequest, nil,
+							nil,
+							nil,
 						),
 						deviceauth.NewDoneEvent(ctx,
 							deviceauth.NewAggregate("123", "instance1"),
@@ -1451,6 +1465,8 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 						),
 					),
 					expectFilter(), // token lifetime
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
 					expectPush(
 						oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 							"userID", "org1", "sessionID", "clientID", []string{"audience"}, []string{"openid", "offline_access"},
@@ -1460,10 +1476,15 @@ func TestCommands_CreateOIDCSessionFromDeviceAuth(t *testing.T) {
 								Description:   gu.Ptr("firefox"),
 								Header:        http.Header{"foo": []string{"bar"}},
 							},
+							nil,
+							nil,
+							"",
 						),
 						oidcsession.NewAccessTokenAddedEvent(context.Background(),
 							&oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 							"at_accessTokenID", []string{"openid", "offline_access"}, time.Hour, domain.TokenReasonAuthRequest, nil,
+							nil,
+							nil,
 						),
 						oidcsession.NewRefreshTokenAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 							"rt_refreshTokenID", 7*24*time.Hour, 24*time.Hour,
@@ -53,15 +53,17 @@ type InstanceSetup struct {
 		}
 	}
 	PasswordComplexityPolicy struct {
-		MinLength    uint64
-		HasLowercase bool
-		HasUppercase bool
-		HasNumber    bool
-		HasSymbol    bool
+		MinLength     uint64
+		HasLowercase  bool
+		HasUppercase  bool
+		HasNumber     bool
+		HasSymbol     bool
+		CheckBreached bool
 	}
 	PasswordAgePolicy struct {
 		ExpireWarnDays uint64
 		MaxAgeDays     uint64
+		HistoryDepth   uint64
 	}
 	DomainPolicy struct {
 		UserLoginMustBeDomain                  bool
@@ -79,6 +81,7 @@ type InstanceSetup struct {
 		AllowDomainDiscovery       bool
 		DisableLoginWithEmail      bool
 		DisableLoginWithPhone      bool
+		AllowMagicLink             bool
 		PasswordlessType           domain.PasswordlessType
 		DefaultRedirectURI         string
 		PasswordCheckLifetime      time.Duration
@@ -86,9 +89,20 @@ type InstanceSetup struct {
 		MfaInitSkipLifetime        time.Duration
 		SecondFactorCheckLifetime  time.Duration
 		MultiFactorCheckLifetime   time.Duration
+		TrustedDeviceLifetime      time.Duration
+		SessionIdleTimeout         time.Duration
+		SessionMaxLifetime         time.Duration
+		MaxConcurrentSessions      uint64
+		WebAuthNAttestation        domain.AttestationConveyance
+		WebAuthNUserVerification   domain.UserVerificationRequirement
+		WebAuthNResidentKey        domain.ResidentKeyRequirement
+		WebAuthNAllowedAAGUIDs     []string
+		WebAuthNDeniedAAGUIDs      []string
 	}
 	NotificationPolicy struct {
-		PasswordChange bool
+		PasswordChange        bool
+		SecurityNotifications bool
+		PhoneChannels         []domain.PhoneChannel
 	}
 	PrivacyPolicy struct {
 		TOSLink        string
@@ -118,6 +132,13 @@ type InstanceSetup struct {
 		MaxOTPAttempts           uint64
 		ShouldShowLockoutFailure bool
 	}
+	InactivityPolicy struct {
+		WarnDays           uint64
+		DeactivateDays     uint64
+		DeleteDays         uint64
+		ExemptMachineUsers bool
+		ExemptRoles        []string
+	}
 	EmailTemplate          []byte
 	MessageTexts           []*domain.CustomMessageText
 	SMTPConfiguration      *SMTPConfiguration
@@ -161,6 +182,7 @@ type SecretGenerators struct {
 	OTPEmail                 *crypto.GeneratorConfig
 	InviteCode               *crypto.GeneratorConfig
 	SigningKey               *crypto.GeneratorConfig
+	MagicLink                *crypto.GeneratorConfig
 }
 
 func (s *SecretGenerators) ToMap() map[domain.SecretGeneratorType]*crypto.GeneratorConfig {
@@ -176,6 +198,7 @@ func (s *SecretGenerators) ToMap() map[domain.SecretGeneratorType]*crypto.Genera
 		domain.SecretGeneratorTypeOTPEmail:             s.OTPEmail,
 		domain.SecretGeneratorTypeInviteCode:           s.InviteCode,
 		domain.SecretGeneratorTypeSigningKey:           s.SigningKey,
+		domain.SecretGeneratorTypeMagicLink:            s.MagicLink,
 	}
 }
 
@@ -343,6 +366,7 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypeOTPSMS, setup.SecretGenerators.OTPSMS),
 		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypeOTPEmail, setup.SecretGenerators.OTPEmail),
 		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypeInviteCode, setup.SecretGenerators.InviteCode),
+		prepareAddSecretGeneratorConfig(instanceAgg, domain.SecretGeneratorTypeMagicLink, setup.SecretGenerators.MagicLink),
 
 		prepareAddDefaultPasswordComplexityPolicy(
 			instanceAgg,
@@ -351,11 +375,13 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 			setup.PasswordComplexityPolicy.HasUppercase,
 			setup.PasswordComplexityPolicy.HasNumber,
 			setup.PasswordComplexityPolicy.HasSymbol,
+			setup.PasswordComplexityPolicy.CheckBreached,
 		),
 		prepareAddDefaultPasswordAgePolicy(
 			instanceAgg,
 			setup.PasswordAgePolicy.ExpireWarnDays,
 			setup.PasswordAgePolicy.MaxAgeDays,
+			setup.PasswordAgePolicy.HistoryDepth,
 		),
 		prepareAddDefaultDomainPolicy(
 			instanceAgg,
@@ -382,6 +408,16 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 			setup.LoginPolicy.MfaInitSkipLifetime,
 			setup.LoginPolicy.SecondFactorCheckLifetime,
 			setup.LoginPolicy.MultiFactorCheckLifetime,
+			setup.LoginPolicy.AllowMagicLink,
+			setup.LoginPolicy.TrustedDeviceLifetime,
+			setup.LoginPolicy.SessionIdleTimeout,
+			setup.LoginPolicy.SessionMaxLifetime,
+			setup.LoginPolicy.MaxConcurrentSessions,
+			setup.LoginPolicy.WebAuthNAttestation,
+			setup.LoginPolicy.WebAuthNUserVerification,
+			setup.LoginPolicy.WebAuthNResidentKey,
+			setup.LoginPolicy.WebAuthNAllowedAAGUIDs,
+			setup.LoginPolicy.WebAuthNDeniedAAGUIDs,
 		),
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeTOTP),
 		prepareAddSecondFactorToDefaultLoginPolicy(instanceAgg, domain.SecondFactorTypeU2F),
@@ -392,8 +428,15 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 		prepareAddMultiFactorToDefaultLoginPolicy(instanceAgg, domain.MultiFactorTypeU2FWithPIN),
 
 		prepareAddDefaultPrivacyPolicy(instanceAgg, setup.PrivacyPolicy.TOSLink, setup.PrivacyPolicy.PrivacyLink, setup.PrivacyPolicy.HelpLink, setup.PrivacyPolicy.SupportEmail, setup.PrivacyPolicy.DocsLink, setup.PrivacyPolicy.CustomLink, setup.PrivacyPolicy.CustomLinkText),
-		prepareAddDefaultNotificationPolicy(instanceAgg, setup.NotificationPolicy.PasswordChange),
+		prepareAddDefaultNotificationPolicy(instanceAgg, setup.NotificationPolicy.PasswordChange, setup.NotificationPolicy.SecurityNotifications, setup.NotificationPolicy.PhoneChannels),
 		prepareAddDefaultLockoutPolicy(instanceAgg, setup.LockoutPolicy.MaxPasswordAttempts, setup.LockoutPolicy.MaxOTPAttempts, setup.LockoutPolicy.ShouldShowLockoutFailure),
+		prepareAddDefaultInactivityPolicy(instanceAgg, &domain.InactivityPolicy{
+			WarnDays:           setup.InactivityPolicy.WarnDays,
+			DeactivateDays:     setup.InactivityPolicy.DeactivateDays,
+			DeleteDays:         setup.InactivityPolicy.DeleteDays,
+			ExemptMachineUsers: setup.InactivityPolicy.ExemptMachineUsers,
+			ExemptRoles:        setup.InactivityPolicy.ExemptRoles,
+		}),
 
 		prepareAddDefaultLabelPolicy(
 			instanceAgg,
@@ -729,7 +772,7 @@ func setupLoginClient(commands *Commands, validations *[]preparation.Validation,
 
 func setupAdminMembers(commands *Commands, validations *[]preparation.Validation, instanceAgg *instance.Aggregate, orgAgg *org.Aggregate, userID string) {
 	*validations = append(*validations,
-		commands.AddOrgMemberCommand(&AddOrgMember{orgAgg.ID, userID, []string{domain.RoleOrgOwner}}),
+		commands.AddOrgMemberCommand(&AddOrgMember{OrgID: orgAgg.ID, UserID: userID, Roles: []string{domain.RoleOrgOwner}}),
 		commands.AddInstanceMemberCommand(instanceAgg, userID, domain.RoleIAMOwner),
 	)
 }
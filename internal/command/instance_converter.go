@@ -42,6 +42,16 @@ func writeModelToLoginPolicy(wm *LoginPolicyWriteModel) *domain.LoginPolicy {
 		MFAInitSkipLifetime:        wm.MFAInitSkipLifetime,
 		SecondFactorCheckLifetime:  wm.SecondFactorCheckLifetime,
 		MultiFactorCheckLifetime:   wm.MultiFactorCheckLifetime,
+		AllowMagicLink:             wm.AllowMagicLink,
+		TrustedDeviceLifetime:      wm.TrustedDeviceLifetime,
+		SessionIdleTimeout:         wm.SessionIdleTimeout,
+		SessionMaxLifetime:         wm.SessionMaxLifetime,
+		MaxConcurrentSessions:      wm.MaxConcurrentSessions,
+		WebAuthNAttestation:        wm.WebAuthNAttestation,
+		WebAuthNUserVerification:   wm.WebAuthNUserVerification,
+		WebAuthNResidentKey:        wm.WebAuthNResidentKey,
+		WebAuthNAllowedAAGUIDs:     wm.WebAuthNAllowedAAGUIDs,
+		WebAuthNDeniedAAGUIDs:      wm.WebAuthNDeniedAAGUIDs,
 	}
 }
 
@@ -91,17 +101,19 @@ func writeModelToPasswordAgePolicy(wm *PasswordAgePolicyWriteModel) *domain.Pass
 		ObjectRoot:     writeModelToObjectRoot(wm.WriteModel),
 		MaxAgeDays:     wm.MaxAgeDays,
 		ExpireWarnDays: wm.ExpireWarnDays,
+		HistoryDepth:   wm.HistoryDepth,
 	}
 }
 
 func writeModelToPasswordComplexityPolicy(wm *PasswordComplexityPolicyWriteModel) *domain.PasswordComplexityPolicy {
 	return &domain.PasswordComplexityPolicy{
-		ObjectRoot:   writeModelToObjectRoot(wm.WriteModel),
-		MinLength:    wm.MinLength,
-		HasLowercase: wm.HasLowercase,
-		HasUppercase: wm.HasUppercase,
-		HasNumber:    wm.HasNumber,
-		HasSymbol:    wm.HasSymbol,
+		ObjectRoot:    writeModelToObjectRoot(wm.WriteModel),
+		MinLength:     wm.MinLength,
+		HasLowercase:  wm.HasLowercase,
+		HasUppercase:  wm.HasUppercase,
+		HasNumber:     wm.HasNumber,
+		HasSymbol:     wm.HasSymbol,
+		CheckBreached: wm.CheckBreached,
 	}
 }
 
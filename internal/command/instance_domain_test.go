@@ -161,7 +161,7 @@ func TestCommandSide_AddInstanceDomain(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, nil, false, nil),
 						),
 					),
 					expectPush(
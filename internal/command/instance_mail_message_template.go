@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetDefaultMailMessageTemplate overrides the mail layout of the instance for a single message type and language.
+// As for the message texts, only the support of the language is validated, not if it is allowed.
+func (c *Commands) SetDefaultMailMessageTemplate(ctx context.Context, template *domain.MailMessageTemplate) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := template.IsValid(i18n.SupportedLanguages()); err != nil {
+		return nil, err
+	}
+	existing, err := c.defaultMailMessageTemplateWriteModelByID(ctx, template.MessageType, template.Language)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.isChanged(template) {
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&existing.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewMailMessageTemplateSetEvent(ctx, instanceAgg, template.MessageType, template.Language, template.HTML, template.PlainText))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// RemoveDefaultMailMessageTemplate removes the override, the mail template of the instance is used again.
+func (c *Commands) RemoveDefaultMailMessageTemplate(ctx context.Context, messageType string, lang language.Tag) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if messageType == "" || lang == language.Und {
+		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-Yie7a", "Errors.MailMessageTemplate.Invalid")
+	}
+	existing, err := c.defaultMailMessageTemplateWriteModelByID(ctx, messageType, lang)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "INSTANCE-aeL0u", "Errors.MailMessageTemplate.NotFound")
+	}
+	instanceAgg := InstanceAggregateFromWriteModel(&existing.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, instance.NewMailMessageTemplateRemovedEvent(ctx, instanceAgg, messageType, lang))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) defaultMailMessageTemplateWriteModelByID(ctx context.Context, messageType string, lang language.Tag) (*InstanceMailMessageTemplateWriteModel, error) {
+	writeModel := NewInstanceMailMessageTemplateWriteModel(ctx, messageType, lang)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceMailMessageTemplateWriteModel struct {
+	MailMessageTemplateWriteModel
+}
+
+func NewInstanceMailMessageTemplateWriteModel(ctx context.Context, messageType string, lang language.Tag) *InstanceMailMessageTemplateWriteModel {
+	return &InstanceMailMessageTemplateWriteModel{
+		MailMessageTemplateWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+			},
+			MessageType: messageType,
+			Language:    lang,
+		},
+	}
+}
+
+func (wm *InstanceMailMessageTemplateWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.MailMessageTemplateSetEvent:
+			wm.MailMessageTemplateWriteModel.AppendEvents(&e.MailMessageTemplateSetEvent)
+		case *instance.MailMessageTemplateRemovedEvent:
+			wm.MailMessageTemplateWriteModel.AppendEvents(&e.MailMessageTemplateRemovedEvent)
+		}
+	}
+}
+
+func (wm *InstanceMailMessageTemplateWriteModel) Reduce() error {
+	return wm.MailMessageTemplateWriteModel.Reduce()
+}
+
+func (wm *InstanceMailMessageTemplateWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.MailMessageTemplateWriteModel.AggregateID).
+		EventTypes(
+			instance.MailMessageTemplateSetEventType,
+			instance.MailMessageTemplateRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,178 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetDefaultMailMessageTemplate(t *testing.T) {
+	type args struct {
+		template *domain.MailMessageTemplate
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "invalid message type, error",
+			eventstore: expectEventstore(),
+			args: args{
+				template: &domain.MailMessageTemplate{
+					MessageType: "Template",
+					Language:    language.English,
+					HTML:        "<html></html>",
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Thie6", "Errors.MailMessageTemplate.Invalid"),
+		},
+		{
+			name: "unchanged, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						instance.NewMailMessageTemplateSetEvent(context.Background(), &instance.NewAggregate("INSTANCE").Aggregate,
+							domain.InitCodeMessageType, language.English, "<html>{{.Code}}</html>", "{{.Code}}",
+						),
+					),
+				),
+			),
+			args: args{
+				template: &domain.MailMessageTemplate{
+					MessageType: domain.InitCodeMessageType,
+					Language:    language.English,
+					HTML:        "<html>{{.Code}}</html>",
+					PlainText:   "{{.Code}}",
+				},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "INSTANCE",
+			},
+		},
+		{
+			name: "set, ok",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					instance.NewMailMessageTemplateSetEvent(context.Background(), &instance.NewAggregate("INSTANCE").Aggregate,
+						domain.InitCodeMessageType, language.English, "<html>{{.Code}}</html>", "{{.Code}}",
+					),
+				),
+			),
+			args: args{
+				template: &domain.MailMessageTemplate{
+					MessageType: domain.InitCodeMessageType,
+					Language:    language.English,
+					HTML:        "<html>{{.Code}}</html>",
+					PlainText:   "{{.Code}}",
+				},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "INSTANCE",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := r.SetDefaultMailMessageTemplate(authz.WithInstanceID(context.Background(), "INSTANCE"), tt.args.template)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveDefaultMailMessageTemplate(t *testing.T) {
+	type args struct {
+		messageType string
+		language    language.Tag
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "no language, error",
+			eventstore: expectEventstore(),
+			args: args{
+				messageType: domain.InitCodeMessageType,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "INSTANCE-Yie7a", "Errors.MailMessageTemplate.Invalid"),
+		},
+		{
+			name: "not existing, not found error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						instance.NewMailMessageTemplateSetEvent(context.Background(), &instance.NewAggregate("INSTANCE").Aggregate,
+							domain.InitCodeMessageType, language.German, "<html></html>", "",
+						),
+					),
+				),
+			),
+			args: args{
+				messageType: domain.InitCodeMessageType,
+				language:    language.English,
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "INSTANCE-aeL0u", "Errors.MailMessageTemplate.NotFound"),
+		},
+		{
+			name: "remove, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						instance.NewMailMessageTemplateSetEvent(context.Background(), &instance.NewAggregate("INSTANCE").Aggregate,
+							domain.InitCodeMessageType, language.English, "<html></html>", "",
+						),
+					),
+				),
+				expectPush(
+					instance.NewMailMessageTemplateRemovedEvent(context.Background(), &instance.NewAggregate("INSTANCE").Aggregate,
+						domain.InitCodeMessageType, language.English,
+					),
+				),
+			),
+			args: args{
+				messageType: domain.InitCodeMessageType,
+				language:    language.English,
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "INSTANCE",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := r.RemoveDefaultMailMessageTemplate(authz.WithInstanceID(context.Background(), "INSTANCE"), tt.args.messageType, tt.args.language)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
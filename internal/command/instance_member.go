@@ -73,6 +73,8 @@ type AddInstanceMember struct {
 	InstanceID string
 	UserID     string
 	Roles      []string
+	// Validity limits the membership to a period of time, nil if it's not limited.
+	Validity *domain.Validity
 }
 
 // AddInstanceMember adds a user as a member of the instance with the given roles.
@@ -156,12 +158,18 @@ func missingRolesAdded(existing, add []string) []string {
 
 // addInstanceMember performs the write without any permission check.
 func (c *Commands) addInstanceMember(ctx context.Context, member *AddInstanceMember) (*domain.ObjectDetails, error) {
+	if !member.Validity.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-Ue4ho", "Errors.Member.ValidityInvalid")
+	}
 	instanceAgg := instance.NewAggregate(member.InstanceID)
 	//nolint:staticcheck
 	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.AddInstanceMemberCommand(instanceAgg, member.UserID, member.Roles...))
 	if err != nil {
 		return nil, err
 	}
+	if !member.Validity.IsZero() {
+		cmds = append(cmds, instance.NewMemberValiditySetEvent(ctx, &instanceAgg.Aggregate, member.UserID, member.Validity.ValidFrom, member.Validity.ValidUntil))
+	}
 	events, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
 		return nil, err
@@ -178,6 +186,8 @@ type ChangeInstanceMember struct {
 	InstanceID string
 	UserID     string
 	Roles      []string
+	// Validity replaces the period of the membership, nil keeps the current one.
+	Validity *domain.Validity
 }
 
 func (i *ChangeInstanceMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -187,6 +197,9 @@ func (i *ChangeInstanceMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 	if len(domain.CheckForInvalidRoles(i.Roles, domain.IAMRolePrefix, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "INSTANCE-3m9fs", "Errors.Instance.MemberInvalid")
 	}
+	if !i.Validity.IsValid() {
+		return zerrors.ThrowInvalidArgument(nil, "INSTANCE-ooT3e", "Errors.Member.ValidityInvalid")
+	}
 	return nil
 }
 
@@ -206,16 +219,18 @@ func (c *Commands) ChangeInstanceMember(ctx context.Context, member *ChangeInsta
 	if err := c.checkPermissionUpdateInstanceMember(ctx, existingMember.AggregateID); err != nil {
 		return nil, err
 	}
-	if slices.Compare(existingMember.Roles, member.Roles) == 0 {
+	instanceAgg := InstanceAggregateFromWriteModel(&existingMember.WriteModel)
+	cmds := make([]eventstore.Command, 0, 2)
+	if slices.Compare(existingMember.Roles, member.Roles) != 0 {
+		cmds = append(cmds, instance.NewMemberChangedEvent(ctx, instanceAgg, member.UserID, member.Roles...))
+	}
+	if existingMember.validityChanged(member.Validity) {
+		cmds = append(cmds, instance.NewMemberValiditySetEvent(ctx, instanceAgg, member.UserID, member.Validity.ValidFrom, member.Validity.ValidUntil))
+	}
+	if len(cmds) == 0 {
 		return writeModelToObjectDetails(&existingMember.WriteModel), nil
 	}
-	pushedEvents, err := c.eventstore.Push(ctx,
-		instance.NewMemberChangedEvent(ctx,
-			InstanceAggregateFromWriteModel(&existingMember.WriteModel),
-			member.UserID,
-			member.Roles...,
-		),
-	)
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +44,16 @@ func (wm *InstanceMemberWriteModel) AppendEvents(events ...eventstore.Event) {
 				continue
 			}
 			wm.MemberWriteModel.AppendEvents(&e.MemberCascadeRemovedEvent)
+		case *instance.MemberValiditySetEvent:
+			if e.UserID != wm.MemberWriteModel.UserID {
+				continue
+			}
+			wm.MemberWriteModel.AppendEvents(&e.MemberValiditySetEvent)
+		case *instance.MemberExpiredEvent:
+			if e.UserID != wm.MemberWriteModel.UserID {
+				continue
+			}
+			wm.MemberWriteModel.AppendEvents(&e.MemberExpiredEvent)
 		}
 	}
 }
@@ -62,6 +72,8 @@ func (wm *InstanceMemberWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.MemberAddedEventType,
 			instance.MemberChangedEventType,
 			instance.MemberRemovedEventType,
-			instance.MemberCascadeRemovedEventType).
+			instance.MemberCascadeRemovedEventType,
+			instance.MemberValiditySetEventType,
+			instance.MemberExpiredEventType).
 		Builder()
 }
@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (c *Commands) AddDefaultInactivityPolicy(ctx context.Context, resourceOwner string, policy *domain.InactivityPolicy) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(resourceOwner)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultInactivityPolicy(instanceAgg, policy))
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func (c *Commands) ChangeDefaultInactivityPolicy(ctx context.Context, resourceOwner string, policy *domain.InactivityPolicy) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(resourceOwner)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareChangeDefaultInactivityPolicy(instanceAgg, policy))
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+func prepareAddDefaultInactivityPolicy(
+	a *instance.Aggregate,
+	policy *domain.InactivityPolicy,
+) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := policy.IsValid(); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel := NewInstanceInactivityPolicyWriteModel(ctx)
+			events, err := filter(ctx, writeModel.Query())
+			if err != nil {
+				return nil, err
+			}
+			writeModel.AppendEvents(events...)
+			if err = writeModel.Reduce(); err != nil {
+				return nil, err
+			}
+			if writeModel.State == domain.PolicyStateActive {
+				return nil, zerrors.ThrowAlreadyExists(nil, "INSTANCE-Ohg0u", "Errors.Instance.InactivityPolicy.AlreadyExists")
+			}
+			return []eventstore.Command{
+				instance.NewInactivityPolicyAddedEvent(ctx, &a.Aggregate,
+					policy.WarnDays,
+					policy.DeactivateDays,
+					policy.DeleteDays,
+					policy.ExemptMachineUsers,
+					policy.ExemptRoles,
+				),
+			}, nil
+		}, nil
+	}
+}
+
+func prepareChangeDefaultInactivityPolicy(
+	a *instance.Aggregate,
+	policy *domain.InactivityPolicy,
+) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		if err := policy.IsValid(); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel := NewInstanceInactivityPolicyWriteModel(ctx)
+			events, err := filter(ctx, writeModel.Query())
+			if err != nil {
+				return nil, err
+			}
+			writeModel.AppendEvents(events...)
+			if err = writeModel.Reduce(); err != nil {
+				return nil, err
+			}
+
+			if writeModel.State == domain.PolicyStateUnspecified || writeModel.State == domain.PolicyStateRemoved {
+				return nil, zerrors.ThrowNotFound(nil, "INSTANCE-ooY4h", "Errors.Instance.InactivityPolicy.NotFound")
+			}
+			change, hasChanged := writeModel.NewChangedEvent(ctx, &a.Aggregate, policy)
+			if !hasChanged {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-Eiy7u", "Errors.Instance.InactivityPolicy.NotChanged")
+			}
+			return []eventstore.Command{
+				change,
+			}, nil
+		}, nil
+	}
+}
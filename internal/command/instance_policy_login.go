@@ -217,6 +217,9 @@ func prepareChangeDefaultLoginPolicy(a *instance.Aggregate, policy *ChangeLoginP
 		if ok := domain.ValidateDefaultRedirectURI(policy.DefaultRedirectURI); !ok {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INST-SFdqd", "Errors.Instance.LoginPolicy.RedirectURIInvalid")
 		}
+		if !domain.ValidateAAGUIDs(policy.WebAuthNAllowedAAGUIDs) || !domain.ValidateAAGUIDs(policy.WebAuthNDeniedAAGUIDs) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INST-Ohz6u", "Errors.Instance.LoginPolicy.AAGUIDInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			wm := NewInstanceLoginPolicyWriteModel(ctx)
 			if err := queryAndReduce(ctx, filter, wm); err != nil {
@@ -242,7 +245,17 @@ func prepareChangeDefaultLoginPolicy(a *instance.Aggregate, policy *ChangeLoginP
 				policy.ExternalLoginCheckLifetime,
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
-				policy.MultiFactorCheckLifetime)
+				policy.MultiFactorCheckLifetime,
+				policy.AllowMagicLink,
+				policy.TrustedDeviceLifetime,
+				policy.SessionIdleTimeout,
+				policy.SessionMaxLifetime,
+				policy.MaxConcurrentSessions,
+				policy.WebAuthNAttestation,
+				policy.WebAuthNUserVerification,
+				policy.WebAuthNResidentKey,
+				policy.WebAuthNAllowedAAGUIDs,
+				policy.WebAuthNDeniedAAGUIDs)
 			if !hasChanged {
 				return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-5M9vdd", "Errors.Instance.LoginPolicy.NotChanged")
 			}
@@ -270,6 +283,16 @@ func prepareAddDefaultLoginPolicy(
 	mfaInitSkipLifetime time.Duration,
 	secondFactorCheckLifetime time.Duration,
 	multiFactorCheckLifetime time.Duration,
+	allowMagicLink bool,
+	trustedDeviceLifetime,
+	sessionIdleTimeout,
+	sessionMaxLifetime time.Duration,
+	maxConcurrentSessions uint64,
+	webAuthNAttestation domain.AttestationConveyance,
+	webAuthNUserVerification domain.UserVerificationRequirement,
+	webAuthNResidentKey domain.ResidentKeyRequirement,
+	webAuthNAllowedAAGUIDs,
+	webAuthNDeniedAAGUIDs []string,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
@@ -304,6 +327,16 @@ func prepareAddDefaultLoginPolicy(
 					mfaInitSkipLifetime,
 					secondFactorCheckLifetime,
 					multiFactorCheckLifetime,
+					allowMagicLink,
+					trustedDeviceLifetime,
+					sessionIdleTimeout,
+					sessionMaxLifetime,
+					maxConcurrentSessions,
+					webAuthNAttestation,
+					webAuthNUserVerification,
+					webAuthNResidentKey,
+					webAuthNAllowedAAGUIDs,
+					webAuthNDeniedAAGUIDs,
 				),
 			}, nil
 		}, nil
@@ -79,6 +79,9 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -137,6 +140,9 @@ func TestCommandSide_ChangeDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -287,6 +293,9 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -329,6 +338,9 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -391,6 +403,9 @@ func TestCommandSide_AddIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -529,6 +544,9 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -571,6 +589,9 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -626,6 +647,9 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -682,6 +706,9 @@ func TestCommandSide_RemoveIDPProviderDefaultLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
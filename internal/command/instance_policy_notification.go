@@ -10,9 +10,9 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-func (c *Commands) AddDefaultNotificationPolicy(ctx context.Context, resourceOwner string, passwordChange bool) (*domain.ObjectDetails, error) {
+func (c *Commands) AddDefaultNotificationPolicy(ctx context.Context, resourceOwner string, passwordChange, securityNotifications bool, phoneChannels []domain.PhoneChannel) (*domain.ObjectDetails, error) {
 	instanceAgg := instance.NewAggregate(resourceOwner)
-	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultNotificationPolicy(instanceAgg, passwordChange))
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddDefaultNotificationPolicy(instanceAgg, passwordChange, securityNotifications, phoneChannels))
 	if err != nil {
 		return nil, err
 	}
@@ -23,9 +23,9 @@ func (c *Commands) AddDefaultNotificationPolicy(ctx context.Context, resourceOwn
 	return pushedEventsToObjectDetails(pushedEvents), nil
 }
 
-func (c *Commands) ChangeDefaultNotificationPolicy(ctx context.Context, resourceOwner string, passwordChange bool) (*domain.ObjectDetails, error) {
+func (c *Commands) ChangeDefaultNotificationPolicy(ctx context.Context, resourceOwner string, passwordChange, securityNotifications bool, phoneChannels []domain.PhoneChannel) (*domain.ObjectDetails, error) {
 	instanceAgg := instance.NewAggregate(resourceOwner)
-	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareChangeDefaultNotificationPolicy(instanceAgg, passwordChange))
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareChangeDefaultNotificationPolicy(instanceAgg, passwordChange, securityNotifications, phoneChannels))
 	if err != nil {
 		return nil, err
 	}
@@ -38,9 +38,14 @@ func (c *Commands) ChangeDefaultNotificationPolicy(ctx context.Context, resource
 
 func prepareAddDefaultNotificationPolicy(
 	a *instance.Aggregate,
-	passwordChange bool,
+	passwordChange,
+	securityNotifications bool,
+	phoneChannels []domain.PhoneChannel,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
+		if !domain.ValidPhoneChannels(phoneChannels) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-Aih3u", "Errors.Policy.Notification.PhoneChannelsInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			writeModel := NewInstanceNotificationPolicyWriteModel(ctx)
 			events, err := filter(ctx, writeModel.Query())
@@ -55,7 +60,7 @@ func prepareAddDefaultNotificationPolicy(
 				return nil, zerrors.ThrowAlreadyExists(nil, "INSTANCE-xpo1bj", "Errors.Instance.NotificationPolicy.AlreadyExists")
 			}
 			return []eventstore.Command{
-				instance.NewNotificationPolicyAddedEvent(ctx, &a.Aggregate, passwordChange),
+				instance.NewNotificationPolicyAddedEvent(ctx, &a.Aggregate, passwordChange, securityNotifications, phoneChannels),
 			}, nil
 		}, nil
 	}
@@ -63,9 +68,14 @@ func prepareAddDefaultNotificationPolicy(
 
 func prepareChangeDefaultNotificationPolicy(
 	a *instance.Aggregate,
-	passwordChange bool,
+	passwordChange,
+	securityNotifications bool,
+	phoneChannels []domain.PhoneChannel,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
+		if !domain.ValidPhoneChannels(phoneChannels) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-ooj4E", "Errors.Policy.Notification.PhoneChannelsInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			writeModel := NewInstanceNotificationPolicyWriteModel(ctx)
 			events, err := filter(ctx, writeModel.Query())
@@ -80,7 +90,7 @@ func prepareChangeDefaultNotificationPolicy(
 			if writeModel.State == domain.PolicyStateUnspecified || writeModel.State == domain.PolicyStateRemoved {
 				return nil, zerrors.ThrowNotFound(nil, "INSTANCE-x891na", "Errors.Instance.NotificationPolicy.NotFound")
 			}
-			change, hasChanged := writeModel.NewChangedEvent(ctx, &a.Aggregate, passwordChange)
+			change, hasChanged := writeModel.NewChangedEvent(ctx, &a.Aggregate, passwordChange, securityNotifications, phoneChannels)
 			if !hasChanged {
 				return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-29x02n", "Errors.Instance.NotificationPolicy.NotChanged")
 			}
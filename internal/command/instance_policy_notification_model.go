@@ -2,8 +2,10 @@ package command
 
 import (
 	"context"
+	"slices"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/policy"
@@ -54,13 +56,21 @@ func (wm *InstanceNotificationPolicyWriteModel) Query() *eventstore.SearchQueryB
 func (wm *InstanceNotificationPolicyWriteModel) NewChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
-	passwordChange bool,
+	passwordChange,
+	securityNotifications bool,
+	phoneChannels []domain.PhoneChannel,
 ) (*instance.NotificationPolicyChangedEvent, bool) {
 
 	changes := make([]policy.NotificationPolicyChanges, 0)
 	if wm.PasswordChange != passwordChange {
 		changes = append(changes, policy.ChangePasswordChange(passwordChange))
 	}
+	if wm.SecurityNotifications != securityNotifications {
+		changes = append(changes, policy.ChangeSecurityNotifications(securityNotifications))
+	}
+	if !slices.Equal(wm.PhoneChannels, phoneChannels) {
+		changes = append(changes, policy.ChangePhoneChannels(phoneChannels))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -18,9 +18,11 @@ func TestCommandSide_AddDefaultNotificationPolicy(t *testing.T) {
 		eventstore *eventstore.Eventstore
 	}
 	type args struct {
-		ctx            context.Context
-		resourceOwner  string
-		passwordChange bool
+		ctx                   context.Context
+		resourceOwner         string
+		passwordChange        bool
+		securityNotifications bool
+		phoneChannels         []domain.PhoneChannel
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -42,6 +44,8 @@ func TestCommandSide_AddDefaultNotificationPolicy(t *testing.T) {
 							instance.NewNotificationPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								true,
+								false,
+								nil,
 							),
 						),
 					),
@@ -66,6 +70,8 @@ func TestCommandSide_AddDefaultNotificationPolicy(t *testing.T) {
 						instance.NewNotificationPolicyAddedEvent(context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							true,
+							false,
+							nil,
 						),
 					),
 				),
@@ -91,6 +97,8 @@ func TestCommandSide_AddDefaultNotificationPolicy(t *testing.T) {
 						instance.NewNotificationPolicyAddedEvent(context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							true,
+							false,
+							nil,
 						),
 					),
 				),
@@ -112,7 +120,7 @@ func TestCommandSide_AddDefaultNotificationPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultNotificationPolicy(tt.args.ctx, tt.args.resourceOwner, tt.args.passwordChange)
+			got, err := r.AddDefaultNotificationPolicy(tt.args.ctx, tt.args.resourceOwner, tt.args.passwordChange, tt.args.securityNotifications, tt.args.phoneChannels)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -131,9 +139,11 @@ func TestCommandSide_ChangeDefaultNotificationPolicy(t *testing.T) {
 		eventstore *eventstore.Eventstore
 	}
 	type args struct {
-		ctx            context.Context
-		resourceOwner  string
-		passwordChange bool
+		ctx                   context.Context
+		resourceOwner         string
+		passwordChange        bool
+		securityNotifications bool
+		phoneChannels         []domain.PhoneChannel
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -172,6 +182,8 @@ func TestCommandSide_ChangeDefaultNotificationPolicy(t *testing.T) {
 							instance.NewNotificationPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								true,
+								false,
+								nil,
 							),
 						),
 					),
@@ -196,6 +208,8 @@ func TestCommandSide_ChangeDefaultNotificationPolicy(t *testing.T) {
 							instance.NewNotificationPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								false,
+								false,
+								nil,
 							),
 						),
 					),
@@ -223,7 +237,7 @@ func TestCommandSide_ChangeDefaultNotificationPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.ChangeDefaultNotificationPolicy(tt.args.ctx, tt.args.resourceOwner, tt.args.passwordChange)
+			got, err := r.ChangeDefaultNotificationPolicy(tt.args.ctx, tt.args.resourceOwner, tt.args.passwordChange, tt.args.securityNotifications, tt.args.phoneChannels)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -45,6 +45,7 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
 							),
 						),
 					),
@@ -70,6 +71,7 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							365,
 							10,
+							0,
 						),
 					),
 				),
@@ -91,7 +93,7 @@ func TestCommandSide_AddDefaultPasswordAgePolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPasswordAgePolicy(tt.args.ctx, tt.args.expireWarnDays, tt.args.maxAgeDays)
+			got, err := r.AddDefaultPasswordAgePolicy(tt.args.ctx, tt.args.expireWarnDays, tt.args.maxAgeDays, 0)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -153,6 +155,7 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
 							),
 						),
 					),
@@ -180,6 +183,7 @@ func TestCommandSide_ChangeDefaultPasswordAgePolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								365,
 								10,
+								0,
 							),
 						),
 					),
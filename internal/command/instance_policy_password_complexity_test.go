@@ -66,7 +66,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 							instance.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -94,7 +94,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 						instance.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							8,
-							true, true, true, true,
+							true, true, true, true, false,
 						),
 					),
 				),
@@ -119,7 +119,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPasswordComplexityPolicy(tt.args.ctx, tt.args.minLength, tt.args.hasLowercase, tt.args.hasUppercase, tt.args.hasNumber, tt.args.hasSymbol)
+			got, err := r.AddDefaultPasswordComplexityPolicy(tt.args.ctx, tt.args.minLength, tt.args.hasLowercase, tt.args.hasUppercase, tt.args.hasNumber, tt.args.hasSymbol, false)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -204,7 +204,7 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 							instance.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -234,7 +234,7 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 							instance.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
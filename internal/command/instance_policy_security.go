@@ -2,12 +2,17 @@ package command
 
 import (
 	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type SecurityPolicy struct {
@@ -17,11 +22,33 @@ type SecurityPolicy struct {
 
 	EnableDynamicClientRegistration               bool
 	AllowUnauthenticatedDynamicClientRegistration bool
+	RequireSoftwareStatement                      bool
+	SoftwareStatementIssuers                      domain.SoftwareStatementIssuers
+
+	ACRDefinitions domain.ACRDefinitions
+
+	// PasswordRehashBefore forces password hashes set before this time
+	// to be re-hashed with the current hasher configuration on the next successful password check.
+	PasswordRehashBefore time.Time
+	// InsecurePasswordHashDeadline expires passwords still hashed with an insecure algorithm (e.g. md5) after this time,
+	// the affected users need to reset their password.
+	InsecurePasswordHashDeadline time.Time
 }
 
 func (c *Commands) SetSecurityPolicy(ctx context.Context, policy *SecurityPolicy) (*domain.ObjectDetails, error) {
 	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
-	validation := c.prepareSetSecurityPolicy(instanceAgg, policy)
+	return c.pushSecurityPolicy(ctx, c.prepareSetSecurityPolicy(instanceAgg, policy))
+}
+
+// SetSecurityPolicyEmbeddingAndImpersonation only sets the iframe embedding and impersonation
+// settings of the policy and keeps all others (e.g. dynamic client registration), as the admin API
+// and the settings v2beta API are not able to pass them.
+func (c *Commands) SetSecurityPolicyEmbeddingAndImpersonation(ctx context.Context, policy *SecurityPolicy) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	return c.pushSecurityPolicy(ctx, c.prepareSetSecurityPolicyEmbeddingAndImpersonation(instanceAgg, policy))
+}
+
+func (c *Commands) pushSecurityPolicy(ctx context.Context, validation preparation.Validation) (*domain.ObjectDetails, error) {
 	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validation)
 	if err != nil {
 		return nil, err
@@ -39,6 +66,15 @@ func (c *Commands) SetSecurityPolicy(ctx context.Context, policy *SecurityPolicy
 
 func (c *Commands) prepareSetSecurityPolicy(a *instance.Aggregate, policy *SecurityPolicy) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
+		if err := validateSoftwareStatementIssuers(policy.SoftwareStatementIssuers); err != nil {
+			return nil, err
+		}
+		if policy.RequireSoftwareStatement && len(policy.SoftwareStatementIssuers) == 0 {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ooph4", "Errors.Instance.SecurityPolicy.SoftwareStatementIssuerMissing")
+		}
+		if err := policy.ACRDefinitions.Validate(); err != nil {
+			return nil, err
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			writeModel, err := c.getSecurityPolicyWriteModel(ctx, filter)
 			if err != nil {
@@ -53,6 +89,26 @@ func (c *Commands) prepareSetSecurityPolicy(a *instance.Aggregate, policy *Secur
 	}
 }
 
+func (c *Commands) prepareSetSecurityPolicyEmbeddingAndImpersonation(a *instance.Aggregate, policy *SecurityPolicy) preparation.Validation {
+	return func() (preparation.CreateCommands, error) {
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel, err := c.getSecurityPolicyWriteModel(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			changed := writeModel.SecurityPolicy
+			changed.EnableIframeEmbedding = policy.EnableIframeEmbedding
+			changed.AllowedOrigins = policy.AllowedOrigins
+			changed.EnableImpersonation = policy.EnableImpersonation
+			cmd, err := writeModel.NewSetEvent(ctx, &a.Aggregate, &changed)
+			if err != nil {
+				return nil, err
+			}
+			return []eventstore.Command{cmd}, nil
+		}, nil
+	}
+}
+
 func (c *Commands) getSecurityPolicyWriteModel(ctx context.Context, filter preparation.FilterToQueryReducer) (_ *InstanceSecurityPolicyWriteModel, err error) {
 	writeModel := NewInstanceSecurityPolicyWriteModel(ctx)
 	events, err := filter(ctx, writeModel.Query())
@@ -66,3 +122,23 @@ func (c *Commands) getSecurityPolicyWriteModel(ctx context.Context, filter prepa
 	err = writeModel.Reduce()
 	return writeModel, err
 }
+
+// validateSoftwareStatementIssuers checks that every issuer is unique
+// and provides a key set with at least one public key to verify its statements.
+func validateSoftwareStatementIssuers(issuers domain.SoftwareStatementIssuers) error {
+	for i, issuer := range issuers {
+		if issuer.Issuer == "" || issuers[:i].ByIssuer(issuer.Issuer) != nil {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-ieX4u", "Errors.Instance.SecurityPolicy.SoftwareStatementIssuerInvalid")
+		}
+		keySet := new(jose.JSONWebKeySet)
+		if err := json.Unmarshal([]byte(issuer.KeySet), keySet); err != nil || len(keySet.Keys) == 0 {
+			return zerrors.ThrowInvalidArgument(err, "COMMAND-Aeb6i", "Errors.Instance.SecurityPolicy.SoftwareStatementKeySetInvalid")
+		}
+		for _, key := range keySet.Keys {
+			if !key.Valid() || !key.IsPublic() {
+				return zerrors.ThrowInvalidArgument(nil, "COMMAND-Xah7e", "Errors.Instance.SecurityPolicy.SoftwareStatementKeySetInvalid")
+			}
+		}
+	}
+	return nil
+}
@@ -44,6 +44,21 @@ func (wm *InstanceSecurityPolicyWriteModel) Reduce() error {
 			if e.AllowUnauthenticatedDynamicClientRegistration != nil {
 				wm.AllowUnauthenticatedDynamicClientRegistration = *e.AllowUnauthenticatedDynamicClientRegistration
 			}
+			if e.RequireSoftwareStatement != nil {
+				wm.RequireSoftwareStatement = *e.RequireSoftwareStatement
+			}
+			if e.SoftwareStatementIssuers != nil {
+				wm.SoftwareStatementIssuers = *e.SoftwareStatementIssuers
+			}
+			if e.ACRDefinitions != nil {
+				wm.ACRDefinitions = *e.ACRDefinitions
+			}
+			if e.PasswordRehashBefore != nil {
+				wm.PasswordRehashBefore = *e.PasswordRehashBefore
+			}
+			if e.InsecurePasswordHashDeadline != nil {
+				wm.InsecurePasswordHashDeadline = *e.InsecurePasswordHashDeadline
+			}
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -65,7 +80,7 @@ func (wm *InstanceSecurityPolicyWriteModel) NewSetEvent(
 	aggregate *eventstore.Aggregate,
 	policy *SecurityPolicy,
 ) (*instance.SecurityPolicySetEvent, error) {
-	changes := make([]instance.SecurityPolicyChanges, 0, 5)
+	changes := make([]instance.SecurityPolicyChanges, 0, 10)
 	var err error
 
 	if wm.EnableIframeEmbedding != policy.EnableIframeEmbedding {
@@ -83,6 +98,21 @@ func (wm *InstanceSecurityPolicyWriteModel) NewSetEvent(
 	if wm.AllowUnauthenticatedDynamicClientRegistration != policy.AllowUnauthenticatedDynamicClientRegistration {
 		changes = append(changes, instance.ChangeSecurityPolicyAllowUnauthenticatedDynamicClientRegistration(policy.AllowUnauthenticatedDynamicClientRegistration))
 	}
+	if wm.RequireSoftwareStatement != policy.RequireSoftwareStatement {
+		changes = append(changes, instance.ChangeSecurityPolicyRequireSoftwareStatement(policy.RequireSoftwareStatement))
+	}
+	if !slices.Equal(wm.SoftwareStatementIssuers, policy.SoftwareStatementIssuers) {
+		changes = append(changes, instance.ChangeSecurityPolicySoftwareStatementIssuers(policy.SoftwareStatementIssuers))
+	}
+	if !slices.Equal(wm.ACRDefinitions, policy.ACRDefinitions) {
+		changes = append(changes, instance.ChangeSecurityPolicyACRDefinitions(policy.ACRDefinitions))
+	}
+	if !wm.PasswordRehashBefore.Equal(policy.PasswordRehashBefore) {
+		changes = append(changes, instance.ChangeSecurityPolicyPasswordRehashBefore(policy.PasswordRehashBefore))
+	}
+	if !wm.InsecurePasswordHashDeadline.Equal(policy.InsecurePasswordHashDeadline) {
+		changes = append(changes, instance.ChangeSecurityPolicyInsecurePasswordHashDeadline(policy.InsecurePasswordHashDeadline))
+	}
 	changeEvent, err := instance.NewSecurityPolicySetEvent(ctx, aggregate, changes)
 	if err != nil {
 		return nil, err
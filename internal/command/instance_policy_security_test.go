@@ -0,0 +1,181 @@
+package command
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_validateSoftwareStatementIssuers(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKeySet, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: key.Public(), KeyID: "key1"}}})
+	require.NoError(t, err)
+	privateKeySet, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: key, KeyID: "key1"}}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		issuers domain.SoftwareStatementIssuers
+		wantErr error
+	}{
+		{
+			name: "no issuers",
+		},
+		{
+			name: "valid issuers",
+			issuers: domain.SoftwareStatementIssuers{
+				{Issuer: "https://certification.example.com", KeySet: string(publicKeySet)},
+				{Issuer: "https://other.example.com", KeySet: string(publicKeySet)},
+			},
+		},
+		{
+			name: "missing issuer",
+			issuers: domain.SoftwareStatementIssuers{
+				{KeySet: string(publicKeySet)},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieX4u", "Errors.Instance.SecurityPolicy.SoftwareStatementIssuerInvalid"),
+		},
+		{
+			name: "duplicate issuer",
+			issuers: domain.SoftwareStatementIssuers{
+				{Issuer: "https://certification.example.com", KeySet: string(publicKeySet)},
+				{Issuer: "https://certification.example.com", KeySet: string(publicKeySet)},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieX4u", "Errors.Instance.SecurityPolicy.SoftwareStatementIssuerInvalid"),
+		},
+		{
+			name: "invalid key set",
+			issuers: domain.SoftwareStatementIssuers{
+				{Issuer: "https://certification.example.com", KeySet: "keys"},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeb6i", "Errors.Instance.SecurityPolicy.SoftwareStatementKeySetInvalid"),
+		},
+		{
+			name: "empty key set",
+			issuers: domain.SoftwareStatementIssuers{
+				{Issuer: "https://certification.example.com", KeySet: `{"keys":[]}`},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeb6i", "Errors.Instance.SecurityPolicy.SoftwareStatementKeySetInvalid"),
+		},
+		{
+			name: "private key",
+			issuers: domain.SoftwareStatementIssuers{
+				{Issuer: "https://certification.example.com", KeySet: string(privateKeySet)},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Xah7e", "Errors.Instance.SecurityPolicy.SoftwareStatementKeySetInvalid"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSoftwareStatementIssuers(tt.issuers)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCommands_SetSecurityPolicyEmbeddingAndImpersonation(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instanceID")
+	issuers := domain.SoftwareStatementIssuers{
+		{Issuer: "https://certification.example.com", KeySet: `{"keys":[]}`},
+	}
+	newSetEvent := func(changes ...instance.SecurityPolicyChanges) *instance.SecurityPolicySetEvent {
+		event, _ := instance.NewSecurityPolicySetEvent(ctx, &instance.NewAggregate("instanceID").Aggregate, changes)
+		return event
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		policy *SecurityPolicy
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr error
+	}{
+		{
+			"no changes error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newSetEvent(
+							instance.ChangeSecurityPolicyEnableIframeEmbedding(true),
+							instance.ChangeSecurityPolicyAllowedOrigins([]string{"https://example.com"}),
+						)),
+					),
+				),
+			},
+			args{
+				policy: &SecurityPolicy{
+					EnableIframeEmbedding: true,
+					AllowedOrigins:        []string{"https://example.com"},
+				},
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "POLICY-EWsf3", "Errors.NoChangesFound"),
+		},
+		{
+			"dynamic client registration kept",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(newSetEvent(
+							instance.ChangeSecurityPolicyEnableDynamicClientRegistration(true),
+							instance.ChangeSecurityPolicyRequireSoftwareStatement(true),
+							instance.ChangeSecurityPolicySoftwareStatementIssuers(issuers),
+							instance.ChangeSecurityPolicyACRDefinitions(domain.ACRDefinitions{{Value: "mfa"}}),
+						)),
+					),
+					expectPush(
+						newSetEvent(
+							instance.ChangeSecurityPolicyEnableIframeEmbedding(true),
+							instance.ChangeSecurityPolicyAllowedOrigins([]string{"https://example.com"}),
+							instance.ChangeSecurityPolicyEnableImpersonation(true),
+						),
+					),
+				),
+			},
+			args{
+				policy: &SecurityPolicy{
+					EnableIframeEmbedding: true,
+					AllowedOrigins:        []string{"https://example.com"},
+					EnableImpersonation:   true,
+				},
+			},
+			&domain.ObjectDetails{
+				ResourceOwner: "instanceID",
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.SetSecurityPolicyEmbeddingAndImpersonation(ctx, tt.args.policy)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
@@ -49,6 +49,7 @@ func projectAddedEvents(ctx context.Context, instanceID, orgID, id string, exter
 			false,
 			false,
 			domain.PrivateLabelingSettingUnspecified,
+			nil,
 		),
 		instance.NewIAMProjectSetEvent(ctx,
 			&instance.NewAggregate(instanceID).Aggregate,
@@ -128,7 +129,7 @@ func oidcAppEvents(ctx context.Context, orgID, projectID, id, name, clientID str
 			"",
 			"",
 			"",
-			nil),
+			nil, nil, false, nil),
 	}
 }
 
@@ -203,22 +204,24 @@ func instancePoliciesFilters(_ string) []expect {
 		expectFilter(),
 		expectFilter(),
 		expectFilter(),
+		expectFilter(),
 	}
 }
 
 func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore.Command {
 	instanceAgg := instance.NewAggregate(instanceID)
 	return []eventstore.Command{
-		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true),
-		instance.NewPasswordAgePolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0),
+		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true, false),
+		instance.NewPasswordAgePolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, 0),
 		instance.NewDomainPolicyAddedEvent(ctx, &instanceAgg.Aggregate, false, false, false),
-		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour),
+		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour, false, 0, 0, 0, 0, 0, 0, 0, nil, nil),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeTOTP),
 		instance.NewLoginPolicySecondFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.SecondFactorTypeU2F),
 		instance.NewLoginPolicyMultiFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.MultiFactorTypeU2FWithPIN),
 		instance.NewPrivacyPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "", "", "", "", "", "", ""),
-		instance.NewNotificationPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true),
+		instance.NewNotificationPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, nil),
 		instance.NewLockoutPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, true),
+		instance.NewInactivityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, 0, true, nil),
 		instance.NewLabelPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "#5469d4", "#fafafa", "#cd3d56", "#000000", "#2073c4", "#111827", "#ff3b5b", "#ffffff", false, false, false, domain.LabelPolicyThemeAuto),
 		instance.NewLabelPolicyActivatedEvent(ctx, &instanceAgg.Aggregate),
 	}
@@ -227,16 +230,18 @@ func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore
 func instanceSetupPoliciesConfig() *InstanceSetup {
 	return &InstanceSetup{
 		PasswordComplexityPolicy: struct {
-			MinLength    uint64
-			HasLowercase bool
-			HasUppercase bool
-			HasNumber    bool
-			HasSymbol    bool
-		}{8, true, true, true, true},
+			MinLength     uint64
+			HasLowercase  bool
+			HasUppercase  bool
+			HasNumber     bool
+			HasSymbol     bool
+			CheckBreached bool
+		}{8, true, true, true, true, false},
 		PasswordAgePolicy: struct {
 			ExpireWarnDays uint64
 			MaxAgeDays     uint64
-		}{0, 0},
+			HistoryDepth   uint64
+		}{0, 0, 0},
 		DomainPolicy: struct {
 			UserLoginMustBeDomain                  bool
 			ValidateOrgDomains                     bool
@@ -253,6 +258,7 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 			AllowDomainDiscovery       bool
 			DisableLoginWithEmail      bool
 			DisableLoginWithPhone      bool
+			AllowMagicLink             bool
 			PasswordlessType           domain.PasswordlessType
 			DefaultRedirectURI         string
 			PasswordCheckLifetime      time.Duration
@@ -260,10 +266,21 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 			MfaInitSkipLifetime        time.Duration
 			SecondFactorCheckLifetime  time.Duration
 			MultiFactorCheckLifetime   time.Duration
-		}{true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240 * time.Hour, 240 * time.Hour, 720 * time.Hour, 18 * time.Hour, 12 * time.Hour},
+			TrustedDeviceLifetime      time.Duration
+			SessionIdleTimeout         time.Duration
+			SessionMaxLifetime         time.Duration
+			MaxConcurrentSessions      uint64
+			WebAuthNAttestation        domain.AttestationConveyance
+			WebAuthNUserVerification   domain.UserVerificationRequirement
+			WebAuthNResidentKey        domain.ResidentKeyRequirement
+			WebAuthNAllowedAAGUIDs     []string
+			WebAuthNDeniedAAGUIDs      []string
+		}{true, true, true, false, false, false, false, true, false, false, false, domain.PasswordlessTypeAllowed, "", 240 * time.Hour, 240 * time.Hour, 720 * time.Hour, 18 * time.Hour, 12 * time.Hour, 0, 0, 0, 0, 0, 0, 0, nil, nil},
 		NotificationPolicy: struct {
-			PasswordChange bool
-		}{true},
+			PasswordChange        bool
+			SecurityNotifications bool
+			PhoneChannels         []domain.PhoneChannel
+		}{true, true, nil},
 		PrivacyPolicy: struct {
 			TOSLink        string
 			PrivacyLink    string
@@ -292,6 +309,13 @@ func instanceSetupPoliciesConfig() *InstanceSetup {
 			MaxOTPAttempts           uint64
 			ShouldShowLockoutFailure bool
 		}{0, 0, true},
+		InactivityPolicy: struct {
+			WarnDays           uint64
+			DeactivateDays     uint64
+			DeleteDays         uint64
+			ExemptMachineUsers bool
+			ExemptRoles        []string
+		}{0, 0, 0, true, nil},
 	}
 }
 
@@ -474,7 +498,7 @@ func generatedDomainFilters(instanceID, orgID, projectID, appID, generatedDomain
 				"",
 				"",
 				"",
-				nil),
+				nil, nil, false, nil),
 		),
 		expectFilter(
 			func() eventstore.Event {
@@ -520,6 +544,7 @@ func humanFilters(orgID string) []expect {
 				false,
 				false,
 				false,
+				false,
 			),
 		),
 	}
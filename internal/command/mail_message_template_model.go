@@ -0,0 +1,48 @@
+package command
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type MailMessageTemplateWriteModel struct {
+	eventstore.WriteModel
+
+	MessageType string
+	Language    language.Tag
+	HTML        string
+	PlainText   string
+
+	State domain.PolicyState
+}
+
+func (wm *MailMessageTemplateWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.MailMessageTemplateSetEvent:
+			if e.MessageType != wm.MessageType || e.Language != wm.Language {
+				continue
+			}
+			wm.HTML = e.HTML
+			wm.PlainText = e.PlainText
+			wm.State = domain.PolicyStateActive
+		case *policy.MailMessageTemplateRemovedEvent:
+			if e.MessageType != wm.MessageType || e.Language != wm.Language {
+				continue
+			}
+			wm.HTML = ""
+			wm.PlainText = ""
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *MailMessageTemplateWriteModel) isChanged(template *domain.MailMessageTemplate) bool {
+	return wm.State != domain.PolicyStateActive ||
+		wm.HTML != template.HTML ||
+		wm.PlainText != template.PlainText
+}
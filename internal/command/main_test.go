@@ -179,7 +179,10 @@ func GetMockSecretGenerator(t *testing.T) crypto.Generator {
 	return generator
 }
 
-type mockInstance struct{}
+type mockInstance struct {
+	passwordRehashBefore         time.Time
+	insecurePasswordHashDeadline time.Time
+}
 
 func (m *mockInstance) Block() *bool {
 	panic("shouldn't be called here")
@@ -233,6 +236,14 @@ func (m *mockInstance) AllowUnauthenticatedDynamicClientRegistration() bool {
 	return false
 }
 
+func (m *mockInstance) PasswordRehashBefore() time.Time {
+	return m.passwordRehashBefore
+}
+
+func (m *mockInstance) InsecurePasswordHashDeadline() time.Time {
+	return m.insecurePasswordHashDeadline
+}
+
 func (m *mockInstance) Features() feature.Features {
 	return feature.Features{}
 }
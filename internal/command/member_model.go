@@ -11,6 +11,10 @@ type MemberWriteModel struct {
 
 	UserID string
 	Roles  []string
+	// Validity of the membership, nil if it's not limited.
+	Validity *domain.Validity
+	// Expired is set as soon as the expiry of the current validity was pushed.
+	Expired bool
 
 	State domain.MemberState
 }
@@ -27,9 +31,16 @@ func (wm *MemberWriteModel) Reduce() error {
 		case *member.MemberAddedEvent:
 			wm.UserID = e.UserID
 			wm.Roles = e.Roles
+			wm.Validity = nil
+			wm.Expired = false
 			wm.State = domain.MemberStateActive
 		case *member.MemberChangedEvent:
 			wm.Roles = e.Roles
+		case *member.MemberValiditySetEvent:
+			wm.Validity = &domain.Validity{ValidFrom: e.ValidFrom, ValidUntil: e.ValidUntil}
+			wm.Expired = false
+		case *member.MemberExpiredEvent:
+			wm.Expired = true
 		case *member.MemberRemovedEvent:
 			wm.Roles = nil
 			wm.State = domain.MemberStateRemoved
@@ -37,3 +48,8 @@ func (wm *MemberWriteModel) Reduce() error {
 	}
 	return wm.WriteModel.Reduce()
 }
+
+// validityChanged is true if the validity is set and differs from the current one.
+func (wm *MemberWriteModel) validityChanged(validity *domain.Validity) bool {
+	return validity != nil && !wm.Validity.Equal(validity)
+}
@@ -0,0 +1,129 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// NotificationDelivered records a successful delivery attempt of the notification with the id.
+func (c *Commands) NotificationDelivered(ctx context.Context, id, resourceOwner string, delivery notification.Delivery) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, delivery, err := c.notificationDelivery(ctx, id, resourceOwner, delivery)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx,
+		notification.NewDeliverySucceededEvent(ctx, notificationAggregateFromWriteModel(ctx, writeModel), delivery),
+	)
+	return err
+}
+
+// NotificationDeliveryFailed records a failed delivery attempt of the notification with the id.
+// Final must be set if the notification will not be retried.
+func (c *Commands) NotificationDeliveryFailed(ctx context.Context, id, resourceOwner string, delivery notification.Delivery, deliveryErr error, final bool) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, delivery, err := c.notificationDelivery(ctx, id, resourceOwner, delivery)
+	if err != nil {
+		return err
+	}
+	var errMsg string
+	if deliveryErr != nil {
+		errMsg = deliveryErr.Error()
+	}
+	_, err = c.eventstore.Push(ctx,
+		notification.NewDeliveryFailedEvent(ctx, notificationAggregateFromWriteModel(ctx, writeModel), delivery, errMsg, final),
+	)
+	return err
+}
+
+// notificationDelivery counts the attempt.
+func (c *Commands) notificationDelivery(ctx context.Context, id, resourceOwner string, delivery notification.Delivery) (*NotificationDeliveryWriteModel, notification.Delivery, error) {
+	if id == "" || resourceOwner == "" {
+		return nil, delivery, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieN8u", "Errors.IDMissing")
+	}
+	writeModel := NewNotificationDeliveryWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, delivery, err
+	}
+	delivery.Attempt = writeModel.Attempts + 1
+	return writeModel, delivery, nil
+}
+
+// ResendNotification requests a new notification in place of one, which was either sent or finally failed.
+// As the content of a notification (e.g. the code) is never stored, the message is created again
+// by the command which originally triggered it, e.g. a new verification code is generated.
+// If userID is set, the notification must have been sent to that user.
+func (c *Commands) ResendNotification(ctx context.Context, id, resourceOwner, userID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Uu3ae", "Errors.IDMissing")
+	}
+	writeModel := NewNotificationDeliveryWriteModel(id, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.NotificationDeliveryStateUnspecified || (userID != "" && writeModel.UserID != userID) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ohc5e", "Errors.Notification.NotFound")
+	}
+	if !writeModel.State.IsFinal() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-aiT7e", "Errors.Notification.NotFinished")
+	}
+	if err = c.requestNotificationAgain(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		notification.NewResendRequestedEvent(ctx, notificationAggregateFromWriteModel(ctx, writeModel), writeModel.UserID),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// requestNotificationAgain calls the command which originally triggered the notification.
+// Only notifications with a code the user needs to proceed can be requested again,
+// the ones bound to a login (e.g. OTP challenges) must be requested in the login itself.
+func (c *Commands) requestNotificationAgain(ctx context.Context, wm *NotificationDeliveryWriteModel) (err error) {
+	switch wm.MessageType {
+	case domain.InitCodeMessageType:
+		gen, _, err := encryptedCodeGenerator(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeInitCode, c.userEncryption, emptyConfig) //nolint:staticcheck
+		if err != nil {
+			return err
+		}
+		_, err = c.ResendInitialMail(ctx, wm.UserID, "", wm.ResourceOwner, gen, "")
+		return err
+	case domain.VerifyEmailMessageType:
+		gen, _, err := encryptedCodeGenerator(ctx, c.eventstore.Filter, domain.SecretGeneratorTypeVerifyEmailCode, c.userEncryption, emptyConfig) //nolint:staticcheck
+		if err != nil {
+			return err
+		}
+		_, err = c.CreateHumanEmailVerificationCode(ctx, wm.UserID, wm.ResourceOwner, gen, "")
+		return err
+	case domain.VerifyPhoneMessageType:
+		_, err = c.CreateHumanPhoneVerificationCode(ctx, wm.UserID, wm.ResourceOwner)
+		return err
+	case domain.PasswordResetMessageType:
+		_, err = c.RequestSetPassword(ctx, wm.UserID, wm.ResourceOwner, wm.NotificationType, "")
+		return err
+	case domain.InviteUserMessageType:
+		_, err = c.ResendInviteCode(ctx, wm.UserID, wm.ResourceOwner, "")
+		return err
+	default:
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eis4a", "Errors.Notification.NotResendable")
+	}
+}
+
+func notificationAggregateFromWriteModel(ctx context.Context, wm *NotificationDeliveryWriteModel) *eventstore.Aggregate {
+	return &notification.NewAggregate(wm.AggregateID, wm.ResourceOwner, authz.GetInstance(ctx).InstanceID()).Aggregate
+}
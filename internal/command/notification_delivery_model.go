@@ -0,0 +1,66 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+)
+
+type NotificationDeliveryWriteModel struct {
+	eventstore.WriteModel
+
+	UserID           string
+	MessageType      string
+	NotificationType domain.NotificationType
+	Attempts         uint16
+	State            domain.NotificationDeliveryState
+}
+
+func NewNotificationDeliveryWriteModel(id, resourceOwner string) *NotificationDeliveryWriteModel {
+	return &NotificationDeliveryWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *NotificationDeliveryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *notification.DeliverySucceededEvent:
+			wm.reduceDelivery(e.Delivery)
+			wm.State = domain.NotificationDeliveryStateSent
+		case *notification.DeliveryFailedEvent:
+			wm.reduceDelivery(e.Delivery)
+			wm.State = domain.NotificationDeliveryStateRetrying
+			if e.Final {
+				wm.State = domain.NotificationDeliveryStateFailed
+			}
+		case *notification.ResendRequestedEvent:
+			wm.State = domain.NotificationDeliveryStateResendRequested
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *NotificationDeliveryWriteModel) reduceDelivery(delivery notification.Delivery) {
+	wm.UserID = delivery.UserID
+	wm.MessageType = delivery.MessageType
+	wm.NotificationType = delivery.NotificationType
+	wm.Attempts = delivery.Attempt
+}
+
+func (wm *NotificationDeliveryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(notification.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			notification.DeliverySucceededType,
+			notification.DeliveryFailedType,
+			notification.ResendRequestedType,
+		).
+		Builder()
+}
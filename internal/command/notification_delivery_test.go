@@ -0,0 +1,273 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func testNotificationDelivery(attempt uint16) notification.Delivery {
+	return notification.Delivery{
+		UserID:           "user1",
+		MessageType:      domain.PasswordResetMessageType,
+		NotificationType: domain.NotificationTypeEmail,
+		EventType:        user.HumanPasswordCodeAddedType,
+		ProviderID:       "provider1",
+		Attempt:          attempt,
+	}
+}
+
+func TestCommands_NotificationDelivered(t *testing.T) {
+	t.Parallel()
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	agg := &notification.NewAggregate("notification1", "org1", "instance1").Aggregate
+	type args struct {
+		id            string
+		resourceOwner string
+		delivery      notification.Delivery
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		wantErr    error
+	}{
+		{
+			name:       "missing id, error",
+			eventstore: expectEventstore(),
+			args: args{
+				resourceOwner: "org1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieN8u", "Errors.IDMissing"),
+		},
+		{
+			name: "first attempt, ok",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					notification.NewDeliverySucceededEvent(ctx, agg, testNotificationDelivery(1)),
+				),
+			),
+			args: args{
+				id:            "notification1",
+				resourceOwner: "org1",
+				delivery:      testNotificationDelivery(0),
+			},
+		},
+		{
+			name: "retry, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						notification.NewDeliveryFailedEvent(ctx, agg, testNotificationDelivery(1), "timeout", false),
+					),
+				),
+				expectPush(
+					notification.NewDeliverySucceededEvent(ctx, agg, testNotificationDelivery(2)),
+				),
+			),
+			args: args{
+				id:            "notification1",
+				resourceOwner: "org1",
+				delivery:      testNotificationDelivery(0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.NotificationDelivered(ctx, tt.args.id, tt.args.resourceOwner, tt.args.delivery)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_NotificationDeliveryFailed(t *testing.T) {
+	t.Parallel()
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	agg := &notification.NewAggregate("notification1", "org1", "instance1").Aggregate
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(
+					notification.NewDeliveryFailedEvent(ctx, agg, testNotificationDelivery(1), "timeout", false),
+				),
+			),
+			expectPush(
+				notification.NewDeliveryFailedEvent(ctx, agg, testNotificationDelivery(2), "rejected", true),
+			),
+		)(t),
+	}
+	err := c.NotificationDeliveryFailed(ctx, "notification1", "org1", testNotificationDelivery(0), errors.New("rejected"), true)
+	assert.NoError(t, err)
+}
+
+func TestCommands_ResendNotification(t *testing.T) {
+	t.Parallel()
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	agg := &notification.NewAggregate("notification1", "org1", "instance1").Aggregate
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		id         string
+		userID     string
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "missing id, error",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Uu3ae", "Errors.IDMissing"),
+		},
+		{
+			name: "not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			id:      "notification1",
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ohc5e", "Errors.Notification.NotFound"),
+		},
+		{
+			name: "other user, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						notification.NewDeliveryFailedEvent(ctx, agg, testNotificationDelivery(1), "rejected", true),
+					),
+				),
+			),
+			id:      "notification1",
+			userID:  "user2",
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ohc5e", "Errors.Notification.NotFound"),
+		},
+		{
+			name: "still retrying, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						notification.NewDeliveryFailedEvent(ctx, agg, testNotificationDelivery(1), "timeout", false),
+					),
+				),
+			),
+			id:      "notification1",
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-aiT7e", "Errors.Notification.NotFinished"),
+		},
+		{
+			name: "without code, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						notification.NewDeliverySucceededEvent(ctx, agg, notification.Delivery{
+							UserID:           "user1",
+							MessageType:      domain.PasswordChangeMessageType,
+							NotificationType: domain.NotificationTypeEmail,
+							EventType:        user.HumanPasswordChangedType,
+							Attempt:          1,
+						}),
+					),
+				),
+			),
+			id:      "notification1",
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eis4a", "Errors.Notification.NotResendable"),
+		},
+		{
+			name: "user not found, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						notification.NewDeliveryFailedEvent(ctx, agg, testNotificationDelivery(1), "rejected", true),
+					),
+				),
+				expectFilter(),
+			),
+			id:      "notification1",
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hj9ds", "Errors.User.NotFound"),
+		},
+		{
+			name: "failed, new code requested, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						notification.NewDeliveryFailedEvent(ctx, agg, testNotificationDelivery(1), "timeout", false),
+					),
+					eventFromEventPusher(
+						notification.NewDeliveryFailedEvent(ctx, agg, testNotificationDelivery(2), "rejected", true),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanAddedEvent(ctx,
+							userAgg,
+							"username",
+							"firstname",
+							"lastname",
+							"nickname",
+							"displayname",
+							language.German,
+							domain.GenderUnspecified,
+							"email@test.ch",
+							true,
+						),
+					),
+					eventFromEventPusher(
+						user.NewHumanEmailVerifiedEvent(ctx, userAgg),
+					),
+					eventFromEventPusher(
+						user.NewHumanInitializedCheckSucceededEvent(ctx, userAgg),
+					),
+				),
+				expectPush(
+					user.NewHumanPasswordCodeAddedEvent(ctx,
+						userAgg,
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("a"),
+						},
+						time.Hour,
+						domain.NotificationTypeEmail,
+						"",
+						"",
+					),
+				),
+				expectPush(
+					notification.NewResendRequestedEvent(ctx, agg, "user1"),
+				),
+			),
+			id:     "notification1",
+			userID: "user1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := &Commands{
+				eventstore:       tt.eventstore(t),
+				newEncryptedCode: mockEncryptedCode("a", time.Hour),
+			}
+			got, err := c.ResendNotification(ctx, tt.id, "", tt.userID)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
@@ -47,6 +47,12 @@ type OIDCSession struct {
 	Reason            domain.TokenReason
 	Actor             *domain.TokenActor
 	RefreshToken      string
+	// AuthorizationDetails granted for the access token (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails
+	// Resources the access token is restricted to (RFC 8707)
+	Resources []string
+	// ACR is the acr value satisfied by the authentication
+	ACR string
 }
 
 type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel) error
@@ -54,12 +60,15 @@ type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel)
 // CreateOIDCSessionFromAuthRequest creates a new OIDC Session, creates an access token and refresh token.
 // It returns the access token id, expiration and the refresh token.
 // If the underlying [AuthRequest] is a OIDC Auth Code Flow, it will set the code as exchanged.
+// The access token can be restricted to a subset of the resources requested in the [AuthRequest],
+// if none are passed, it is issued for all of them.
 func (c *Commands) CreateOIDCSessionFromAuthRequest(
 	ctx context.Context,
 	authReqId string,
 	complianceCheck AuthRequestComplianceChecker,
 	needRefreshToken bool,
 	backChannelLogoutURI string,
+	resources []string,
 ) (session *OIDCSession, state string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -91,6 +100,9 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 	if err != nil {
 		return nil, "", err
 	}
+	if err = sessionModel.CheckSessionPolicy(cmd.loginPolicy, time.Now()); err != nil {
+		return nil, "", err
+	}
 	if authReqModel.ResponseType == domain.OIDCResponseTypeCode {
 		if err = cmd.SetAuthRequestCodeExchanged(ctx, authReqModel); err != nil {
 			return nil, "", err
@@ -99,6 +111,10 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 	if err = complianceCheck(ctx, authReqModel); err != nil {
 		return nil, "", err
 	}
+	resources, err = domain.RestrictResourceIndicators(authReqModel.Resources, resources)
+	if err != nil {
+		return nil, "", err
+	}
 
 	cmd.AddSession(ctx,
 		sessionModel.UserID,
@@ -112,11 +128,14 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 		authReqModel.Nonce,
 		sessionModel.PreferredLanguage,
 		sessionModel.UserAgent,
+		authReqModel.AuthorizationDetails,
+		authReqModel.Resources,
+		authReqModel.ACR,
 	)
 	cmd.RegisterLogout(ctx, sessionModel.AggregateID, sessionModel.UserID, authReqModel.ClientID, backChannelLogoutURI)
 
 	if authReqModel.ResponseType != domain.OIDCResponseTypeIDToken {
-		if err = cmd.AddAccessToken(ctx, authReqModel.Scope, sessionModel.UserID, sessionModel.UserResourceOwner, domain.TokenReasonAuthRequest, nil); err != nil {
+		if err = cmd.AddAccessToken(ctx, authReqModel.Scope, sessionModel.UserID, sessionModel.UserResourceOwner, domain.TokenReasonAuthRequest, nil, authReqModel.AuthorizationDetails, resources); err != nil {
 			return nil, "", err
 		}
 	}
@@ -169,10 +188,10 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 		cmd.UserImpersonated(ctx, userID, resourceOwner, clientID, actor)
 	}
 
-	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent)
+	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent, nil, nil, "")
 	cmd.RegisterLogout(ctx, sessionID, userID, clientID, backChannelLogoutURI)
 	if responseType != domain.OIDCResponseTypeIDToken {
-		if err = cmd.AddAccessToken(ctx, scope, userID, resourceOwner, reason, actor); err != nil {
+		if err = cmd.AddAccessToken(ctx, scope, userID, resourceOwner, reason, actor, nil, nil); err != nil {
 			return nil, err
 		}
 	}
@@ -196,7 +215,9 @@ type RefreshTokenComplianceChecker func(ctx context.Context, wm *OIDCSessionWrit
 
 // ExchangeOIDCSessionRefreshAndAccessToken updates an existing OIDC Session, creates a new access and refresh token.
 // It returns the access token id and expiration and the new refresh token.
-func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, refreshToken string, scope []string, reqClientID string, complianceCheck RefreshTokenComplianceChecker) (_ *OIDCSession, err error) {
+// The client can request a subset of the authorization details and resources granted for the session,
+// if none are requested, the new access token receives all of them.
+func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, refreshToken string, scope []string, authorizationDetails domain.AuthorizationDetails, resources []string, reqClientID string, complianceCheck RefreshTokenComplianceChecker) (_ *OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -208,11 +229,21 @@ func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	authorizationDetails, err = cmd.oidcSessionWriteModel.AuthorizationDetails.Restrict(authorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+	resources, err = domain.RestrictResourceIndicators(cmd.oidcSessionWriteModel.Resources, resources)
+	if err != nil {
+		return nil, err
+	}
 	err = cmd.AddAccessToken(ctx, scope,
 		cmd.oidcSessionWriteModel.UserID,
 		cmd.oidcSessionWriteModel.UserResourceOwner,
 		domain.TokenReasonRefresh,
 		cmd.oidcSessionWriteModel.AccessTokenActor,
+		authorizationDetails,
+		resources,
 	)
 	if err != nil {
 		return nil, err
@@ -305,6 +336,11 @@ func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, userID, resource
 	if err != nil {
 		return nil, err
 	}
+	loginPolicy, err := activeLoginPolicyWriteModel(ctx, c.eventstore, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime = limitTokenLifetimes(loginPolicy, accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime)
 	sessionID, err := c.idGenerator.Next()
 	if err != nil {
 		return nil, err
@@ -317,6 +353,7 @@ func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, userID, resource
 		events:                   pending,
 		oidcSessionWriteModel:    NewOIDCSessionWriteModel(sessionID, resourceOwner),
 		userStateModel:           userStateModel,
+		loginPolicy:              loginPolicy,
 		accessTokenLifetime:      accessTokenLifetime,
 		refreshTokenLifeTime:     refreshTokenLifeTime,
 		refreshTokenIdleLifetime: refreshTokenIdleLifetime,
@@ -364,11 +401,20 @@ func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken
 	if err != nil {
 		return nil, err
 	}
+	loginPolicy, err := activeLoginPolicyWriteModel(ctx, c.eventstore, sessionWriteModel.UserResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = sessionWriteModel.CheckSessionPolicy(loginPolicy, time.Now()); err != nil {
+		return nil, err
+	}
+	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime = limitTokenLifetimes(loginPolicy, accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime)
 	return &OIDCSessionEvents{
 		commands:                 c,
 		idGenerator:              c.idGenerator,
 		authAlg:                  c.authAlgorithm,
 		oidcSessionWriteModel:    sessionWriteModel,
+		loginPolicy:              loginPolicy,
 		accessTokenLifetime:      accessTokenLifetime,
 		refreshTokenLifeTime:     refreshTokenLifeTime,
 		refreshTokenIdleLifetime: refreshTokenIdleLifetime,
@@ -382,6 +428,7 @@ type OIDCSessionEvents struct {
 	events                []eventstore.Command
 	oidcSessionWriteModel *OIDCSessionWriteModel
 	userStateModel        *UserV2WriteModel
+	loginPolicy           *LoginPolicyWriteModel
 
 	accessTokenLifetime      time.Duration
 	refreshTokenLifeTime     time.Duration
@@ -408,6 +455,9 @@ func (c *OIDCSessionEvents) AddSession(
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	authorizationDetails domain.AuthorizationDetails,
+	resources []string,
+	acr string,
 ) {
 	c.events = append(c.events, oidcsession.NewAddedEvent(
 		ctx,
@@ -423,6 +473,9 @@ func (c *OIDCSessionEvents) AddSession(
 		nonce,
 		preferredLanguage,
 		userAgent,
+		authorizationDetails,
+		resources,
+		acr,
 	))
 }
 
@@ -458,13 +511,13 @@ func (c *OIDCSessionEvents) RegisterLogout(ctx context.Context, sessionID, userI
 	))
 }
 
-func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope []string, userID, resourceOwner string, reason domain.TokenReason, actor *domain.TokenActor) error {
+func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope []string, userID, resourceOwner string, reason domain.TokenReason, actor *domain.TokenActor, authorizationDetails domain.AuthorizationDetails, resources []string) error {
 	accessTokenID, err := c.idGenerator.Next()
 	if err != nil {
 		return err
 	}
 	c.accessTokenID = AccessTokenPrefix + accessTokenID
-	c.events = append(c.events, oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor))
+	c.events = append(c.events, oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor, authorizationDetails, resources))
 	return nil
 }
 
@@ -514,20 +567,23 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 		return nil, err
 	}
 	session := &OIDCSession{
-		SessionID:         c.oidcSessionWriteModel.SessionID,
-		ClientID:          c.oidcSessionWriteModel.ClientID,
-		UserID:            c.oidcSessionWriteModel.UserID,
-		Audience:          c.oidcSessionWriteModel.Audience,
-		Expiration:        c.oidcSessionWriteModel.AccessTokenExpiration,
-		Scope:             c.oidcSessionWriteModel.Scope,
-		AuthMethods:       c.oidcSessionWriteModel.AuthMethods,
-		AuthTime:          c.oidcSessionWriteModel.AuthTime,
-		Nonce:             c.oidcSessionWriteModel.Nonce,
-		PreferredLanguage: c.oidcSessionWriteModel.PreferredLanguage,
-		UserAgent:         c.oidcSessionWriteModel.UserAgent,
-		Reason:            c.oidcSessionWriteModel.AccessTokenReason,
-		Actor:             c.oidcSessionWriteModel.AccessTokenActor,
-		RefreshToken:      c.refreshToken,
+		SessionID:            c.oidcSessionWriteModel.SessionID,
+		ClientID:             c.oidcSessionWriteModel.ClientID,
+		UserID:               c.oidcSessionWriteModel.UserID,
+		Audience:             c.oidcSessionWriteModel.Audience,
+		Expiration:           c.oidcSessionWriteModel.AccessTokenExpiration,
+		Scope:                c.oidcSessionWriteModel.Scope,
+		AuthMethods:          c.oidcSessionWriteModel.AuthMethods,
+		AuthTime:             c.oidcSessionWriteModel.AuthTime,
+		Nonce:                c.oidcSessionWriteModel.Nonce,
+		PreferredLanguage:    c.oidcSessionWriteModel.PreferredLanguage,
+		UserAgent:            c.oidcSessionWriteModel.UserAgent,
+		Reason:               c.oidcSessionWriteModel.AccessTokenReason,
+		Actor:                c.oidcSessionWriteModel.AccessTokenActor,
+		RefreshToken:         c.refreshToken,
+		AuthorizationDetails: c.oidcSessionWriteModel.AccessTokenAuthorizationDetails,
+		Resources:            c.oidcSessionWriteModel.AccessTokenResources,
+		ACR:                  c.oidcSessionWriteModel.ACR,
 	}
 	if c.accessTokenID != "" {
 		// prefix the returned id with the oidcSessionID so that we can retrieve it later on
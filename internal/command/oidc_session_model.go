@@ -14,27 +14,33 @@ import (
 type OIDCSessionWriteModel struct {
 	eventstore.WriteModel
 
-	UserID                     string
-	UserResourceOwner          string
-	PreferredLanguage          *language.Tag
-	SessionID                  string
-	ClientID                   string
-	Audience                   []string
-	Scope                      []string
-	AuthMethods                []domain.UserAuthMethodType
-	AuthTime                   time.Time
-	Nonce                      string
-	UserAgent                  *domain.UserAgent
-	State                      domain.OIDCSessionState
-	AccessTokenID              string
-	AccessTokenCreation        time.Time
-	AccessTokenExpiration      time.Time
-	AccessTokenReason          domain.TokenReason
-	AccessTokenActor           *domain.TokenActor
-	RefreshTokenID             string
-	RefreshToken               string
-	RefreshTokenExpiration     time.Time
-	RefreshTokenIdleExpiration time.Time
+	UserID                          string
+	UserResourceOwner               string
+	PreferredLanguage               *language.Tag
+	SessionID                       string
+	ClientID                        string
+	Audience                        []string
+	Scope                           []string
+	AuthMethods                     []domain.UserAuthMethodType
+	AuthTime                        time.Time
+	Nonce                           string
+	UserAgent                       *domain.UserAgent
+	AuthorizationDetails            domain.AuthorizationDetails
+	Resources                       []string
+	ACR                             string
+	State                           domain.OIDCSessionState
+	AccessTokenID                   string
+	AccessTokenCreation             time.Time
+	AccessTokenExpiration           time.Time
+	AccessTokenReason               domain.TokenReason
+	AccessTokenActor                *domain.TokenActor
+	AccessTokenAuthorizationDetails domain.AuthorizationDetails
+	AccessTokenResources            []string
+	RefreshTokenID                  string
+	RefreshToken                    string
+	RefreshTokenExpiration          time.Time
+	RefreshTokenIdleExpiration      time.Time
+	CreationDate                    time.Time
 
 	aggregate *eventstore.Aggregate
 }
@@ -101,7 +107,11 @@ func (wm *OIDCSessionWriteModel) reduceAdded(e *oidcsession.AddedEvent) {
 	wm.Nonce = e.Nonce
 	wm.PreferredLanguage = e.PreferredLanguage
 	wm.UserAgent = e.UserAgent
+	wm.AuthorizationDetails = e.AuthorizationDetails
+	wm.Resources = e.Resources
+	wm.ACR = e.ACR
 	wm.State = domain.OIDCSessionStateActive
+	wm.CreationDate = e.CreationDate()
 	// the write model might be initialized without resource owner,
 	// so update the aggregate
 	if wm.ResourceOwner == "" {
@@ -114,6 +124,8 @@ func (wm *OIDCSessionWriteModel) reduceAccessTokenAdded(e *oidcsession.AccessTok
 	wm.AccessTokenExpiration = e.CreationDate().Add(e.Lifetime)
 	wm.AccessTokenReason = e.Reason
 	wm.AccessTokenActor = e.Actor
+	wm.AccessTokenAuthorizationDetails = e.AuthorizationDetails
+	wm.AccessTokenResources = e.Resources
 }
 
 func (wm *OIDCSessionWriteModel) reduceAccessTokenRevoked(e *oidcsession.AccessTokenRevokedEvent) {
@@ -154,6 +166,14 @@ func (wm *OIDCSessionWriteModel) CheckRefreshToken(refreshTokenID string) error
 	return nil
 }
 
+// CheckSessionPolicy checks that the OIDC session did not exceed the maximum session lifetime of the login policy.
+func (wm *OIDCSessionWriteModel) CheckSessionPolicy(policy *LoginPolicyWriteModel, now time.Time) error {
+	if policy.SessionMaxLifetime > 0 && !wm.CreationDate.IsZero() && now.After(wm.CreationDate.Add(policy.SessionMaxLifetime)) {
+		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-Gie4u", "Errors.OIDCSession.RefreshTokenInvalid")
+	}
+	return nil
+}
+
 func (wm *OIDCSessionWriteModel) CheckAccessToken(accessTokenID string) error {
 	if wm.State != domain.OIDCSessionStateActive {
 		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-KL2pk", "Errors.OIDCSession.Token.Invalid")
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgMailMessageTemplate overrides the mail layout of the organization for a single message type and language.
+// As for the message texts, only the support of the language is validated, not if it is allowed.
+func (c *Commands) SetOrgMailMessageTemplate(ctx context.Context, resourceOwner string, template *domain.MailMessageTemplate) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-ahL6o", "Errors.ResourceOwnerMissing")
+	}
+	if err := template.IsValid(i18n.SupportedLanguages()); err != nil {
+		return nil, err
+	}
+	existing, err := c.orgMailMessageTemplateWriteModelByID(ctx, resourceOwner, template.MessageType, template.Language)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.isChanged(template) {
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existing.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewMailMessageTemplateSetEvent(ctx, orgAgg, template.MessageType, template.Language, template.HTML, template.PlainText))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// RemoveOrgMailMessageTemplate removes the override, the instance default or the mail template of the organization is used again.
+func (c *Commands) RemoveOrgMailMessageTemplate(ctx context.Context, resourceOwner, messageType string, lang language.Tag) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Eej9u", "Errors.ResourceOwnerMissing")
+	}
+	if messageType == "" || lang == language.Und {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-ooC4a", "Errors.MailMessageTemplate.Invalid")
+	}
+	existing, err := c.orgMailMessageTemplateWriteModelByID(ctx, resourceOwner, messageType, lang)
+	if err != nil {
+		return nil, err
+	}
+	if existing.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Ahp0e", "Errors.MailMessageTemplate.NotFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&existing.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewMailMessageTemplateRemovedEvent(ctx, orgAgg, messageType, lang))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existing, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) orgMailMessageTemplateWriteModelByID(ctx context.Context, orgID, messageType string, lang language.Tag) (*OrgMailMessageTemplateWriteModel, error) {
+	writeModel := NewOrgMailMessageTemplateWriteModel(orgID, messageType, lang)
+	err := c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
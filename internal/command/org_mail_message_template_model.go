@@ -0,0 +1,52 @@
+package command
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgMailMessageTemplateWriteModel struct {
+	MailMessageTemplateWriteModel
+}
+
+func NewOrgMailMessageTemplateWriteModel(orgID, messageType string, lang language.Tag) *OrgMailMessageTemplateWriteModel {
+	return &OrgMailMessageTemplateWriteModel{
+		MailMessageTemplateWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			MessageType: messageType,
+			Language:    lang,
+		},
+	}
+}
+
+func (wm *OrgMailMessageTemplateWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.MailMessageTemplateSetEvent:
+			wm.MailMessageTemplateWriteModel.AppendEvents(&e.MailMessageTemplateSetEvent)
+		case *org.MailMessageTemplateRemovedEvent:
+			wm.MailMessageTemplateWriteModel.AppendEvents(&e.MailMessageTemplateRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgMailMessageTemplateWriteModel) Reduce() error {
+	return wm.MailMessageTemplateWriteModel.Reduce()
+}
+
+func (wm *OrgMailMessageTemplateWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.MailMessageTemplateWriteModel.AggregateID).
+		EventTypes(
+			org.MailMessageTemplateSetEventType,
+			org.MailMessageTemplateRemovedEventType).
+		Builder()
+}
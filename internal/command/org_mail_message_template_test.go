@@ -0,0 +1,202 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetOrgMailMessageTemplate(t *testing.T) {
+	type args struct {
+		resourceOwner string
+		template      *domain.MailMessageTemplate
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "no organization, error",
+			eventstore: expectEventstore(),
+			args: args{
+				template: &domain.MailMessageTemplate{},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "ORG-ahL6o", "Errors.ResourceOwnerMissing"),
+		},
+		{
+			name:       "invalid message type, error",
+			eventstore: expectEventstore(),
+			args: args{
+				resourceOwner: "org1",
+				template: &domain.MailMessageTemplate{
+					MessageType: "Template",
+					Language:    language.English,
+					HTML:        "<html></html>",
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Thie6", "Errors.MailMessageTemplate.Invalid"),
+		},
+		{
+			name: "unchanged, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						org.NewMailMessageTemplateSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							domain.InitCodeMessageType, language.English, "<html>{{.Code}}</html>", "{{.Code}}",
+						),
+					),
+				),
+			),
+			args: args{
+				resourceOwner: "org1",
+				template: &domain.MailMessageTemplate{
+					MessageType: domain.InitCodeMessageType,
+					Language:    language.English,
+					HTML:        "<html>{{.Code}}</html>",
+					PlainText:   "{{.Code}}",
+				},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "set, ok",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					org.NewMailMessageTemplateSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						domain.InitCodeMessageType, language.English, "<html>{{.Code}}</html>", "{{.Code}}",
+					),
+				),
+			),
+			args: args{
+				resourceOwner: "org1",
+				template: &domain.MailMessageTemplate{
+					MessageType: domain.InitCodeMessageType,
+					Language:    language.English,
+					HTML:        "<html>{{.Code}}</html>",
+					PlainText:   "{{.Code}}",
+				},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := r.SetOrgMailMessageTemplate(context.Background(), tt.args.resourceOwner, tt.args.template)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveOrgMailMessageTemplate(t *testing.T) {
+	type args struct {
+		resourceOwner string
+		messageType   string
+		language      language.Tag
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		args       args
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "no organization, error",
+			eventstore: expectEventstore(),
+			args: args{
+				messageType: domain.InitCodeMessageType,
+				language:    language.English,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "ORG-Eej9u", "Errors.ResourceOwnerMissing"),
+		},
+		{
+			name:       "no language, error",
+			eventstore: expectEventstore(),
+			args: args{
+				resourceOwner: "org1",
+				messageType:   domain.InitCodeMessageType,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "ORG-ooC4a", "Errors.MailMessageTemplate.Invalid"),
+		},
+		{
+			name: "not existing, not found error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						org.NewMailMessageTemplateSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							domain.InitCodeMessageType, language.German, "<html></html>", "",
+						),
+					),
+				),
+			),
+			args: args{
+				resourceOwner: "org1",
+				messageType:   domain.InitCodeMessageType,
+				language:      language.English,
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "ORG-Ahp0e", "Errors.MailMessageTemplate.NotFound"),
+		},
+		{
+			name: "remove, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						org.NewMailMessageTemplateSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+							domain.InitCodeMessageType, language.English, "<html></html>", "",
+						),
+					),
+				),
+				expectPush(
+					org.NewMailMessageTemplateRemovedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+						domain.InitCodeMessageType, language.English,
+					),
+				),
+			),
+			args: args{
+				resourceOwner: "org1",
+				messageType:   domain.InitCodeMessageType,
+				language:      language.English,
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := r.RemoveOrgMailMessageTemplate(context.Background(), tt.args.resourceOwner, tt.args.messageType, tt.args.language)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
@@ -28,7 +28,12 @@ func (c *Commands) AddOrgMemberCommand(member *AddOrgMember) preparation.Validat
 				if isMember, err := IsOrgMember(ctx, filter, member.OrgID, member.UserID); err != nil || isMember {
 					return nil, zerrors.ThrowAlreadyExists(err, "ORG-poWwe", "Errors.Org.Member.AlreadyExists")
 				}
-				return []eventstore.Command{org.NewMemberAddedEvent(ctx, &org.NewAggregate(member.OrgID).Aggregate, member.UserID, member.Roles...)}, nil
+				orgAgg := &org.NewAggregate(member.OrgID).Aggregate
+				cmds := []eventstore.Command{org.NewMemberAddedEvent(ctx, orgAgg, member.UserID, member.Roles...)}
+				if !member.Validity.IsZero() {
+					cmds = append(cmds, org.NewMemberValiditySetEvent(ctx, orgAgg, member.UserID, member.Validity.ValidFrom, member.Validity.ValidUntil))
+				}
+				return cmds, nil
 			},
 			nil
 	}
@@ -74,6 +79,8 @@ type AddOrgMember struct {
 	OrgID  string
 	UserID string
 	Roles  []string
+	// Validity limits the membership to a period of time, nil if it's not limited.
+	Validity *domain.Validity
 }
 
 func (m *AddOrgMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -83,6 +90,9 @@ func (m *AddOrgMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 	if len(domain.CheckForInvalidRoles(m.Roles, domain.OrgRolePrefix, zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(m.Roles, domain.RoleSelfManagementGlobal, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
 	}
+	if !m.Validity.IsValid() {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-Ieh5a", "Errors.Member.ValidityInvalid")
+	}
 	return nil
 }
 
@@ -116,6 +126,8 @@ type ChangeOrgMember struct {
 	OrgID  string
 	UserID string
 	Roles  []string
+	// Validity replaces the period of the membership, nil keeps the current one.
+	Validity *domain.Validity
 }
 
 func (c *ChangeOrgMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -125,6 +137,9 @@ func (c *ChangeOrgMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 	if len(domain.CheckForInvalidRoles(c.Roles, domain.OrgRolePrefix, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "INST-m9fG8", "Errors.Org.MemberInvalid")
 	}
+	if !c.Validity.IsValid() {
+		return zerrors.ThrowInvalidArgument(nil, "ORG-ahR6o", "Errors.Member.ValidityInvalid")
+	}
 
 	return nil
 }
@@ -146,17 +161,19 @@ func (c *Commands) ChangeOrgMember(ctx context.Context, member *ChangeOrgMember)
 		return nil, err
 	}
 
-	if slices.Compare(existingMember.Roles, member.Roles) == 0 {
+	orgAgg := OrgAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel)
+	cmds := make([]eventstore.Command, 0, 2)
+	if slices.Compare(existingMember.Roles, member.Roles) != 0 {
+		cmds = append(cmds, org.NewMemberChangedEvent(ctx, orgAgg, member.UserID, member.Roles...))
+	}
+	if existingMember.validityChanged(member.Validity) {
+		cmds = append(cmds, org.NewMemberValiditySetEvent(ctx, orgAgg, member.UserID, member.Validity.ValidFrom, member.Validity.ValidUntil))
+	}
+	if len(cmds) == 0 {
 		return writeModelToObjectDetails(&existingMember.WriteModel), nil
 	}
 
-	pushedEvents, err := c.eventstore.Push(ctx,
-		org.NewMemberChangedEvent(ctx,
-			OrgAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel),
-			member.UserID,
-			member.Roles...,
-		),
-	)
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
@@ -766,6 +767,122 @@ func TestCommandSide_ChangeOrgMember(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "invalid validity, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: domain.RoleOrgOwner,
+					},
+				},
+			},
+			args: args{
+				member: &ChangeOrgMember{
+					OrgID:  "org1",
+					UserID: "user1",
+					Roles:  []string{"ORG_OWNER"},
+					Validity: &domain.Validity{
+						ValidFrom:  time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC),
+						ValidUntil: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "member validity change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user1",
+								[]string{"ORG_OWNER"}...,
+							),
+						),
+					),
+					expectPush(
+						org.NewMemberValiditySetEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"user1",
+							time.Time{},
+							time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: domain.RoleOrgOwner,
+					},
+				},
+			},
+			args: args{
+				member: &ChangeOrgMember{
+					OrgID:  "org1",
+					UserID: "user1",
+					Roles:  []string{"ORG_OWNER"},
+					Validity: &domain.Validity{
+						ValidUntil: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "member validity unchanged, no change",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user1",
+								[]string{"ORG_OWNER"}...,
+							),
+						),
+						eventFromEventPusher(
+							org.NewMemberValiditySetEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"user1",
+								time.Time{},
+								time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: domain.RoleOrgOwner,
+					},
+				},
+			},
+			args: args{
+				member: &ChangeOrgMember{
+					OrgID:  "org1",
+					UserID: "user1",
+					Roles:  []string{"ORG_OWNER"},
+					Validity: &domain.Validity{
+						ValidUntil: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 		{
 			name: "member change, no permission",
 			fields: fields{
@@ -0,0 +1,174 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgParent places the organization below the parent organization.
+// The organization inherits the policies of the parent, as long as it does not override them.
+// The permission is checked on the organization and on the parent.
+func (c *Commands) SetOrgParent(ctx context.Context, organizationID, parentID string, permissionCheck OrganizationPermissionCheck) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	organizationID = strings.TrimSpace(organizationID)
+	parentID = strings.TrimSpace(parentID)
+	if organizationID == "" || parentID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Eeph5", "Errors.Org.Invalid")
+	}
+	if organizationID == parentID {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-ahC3u", "Errors.Org.Parent.Cycle")
+	}
+	if permissionCheck != nil {
+		if err := permissionCheck(ctx, organizationID); err != nil {
+			return nil, err
+		}
+		if err := permissionCheck(ctx, parentID); err != nil {
+			return nil, err
+		}
+	}
+	orgWriteModel, err := c.orgParentWriteModelByID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(orgWriteModel.State) {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Vie4a", "Errors.Org.NotFound")
+	}
+	if orgWriteModel.ParentID == parentID {
+		return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+	}
+	ancestors, err := c.orgAncestorIDs(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(ancestors, organizationID) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Chai6", "Errors.Org.Parent.Cycle")
+	}
+	if len(ancestors) >= domain.OrgHierarchyMaxDepth {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Lae1o", "Errors.Org.Parent.MaxDepthExceeded")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewOrgParentSetEvent(ctx, OrgAggregateFromWriteModel(&orgWriteModel.WriteModel), parentID))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(orgWriteModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// RemoveOrgParent makes the organization a top level organization of the instance again.
+// The permission is checked on the current parent, so admins of a child can't detach it from the parent.
+func (c *Commands) RemoveOrgParent(ctx context.Context, organizationID string, permissionCheck OrganizationPermissionCheck) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	organizationID = strings.TrimSpace(organizationID)
+	if organizationID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Gai8e", "Errors.Org.Invalid")
+	}
+	orgWriteModel, err := c.orgParentWriteModelByID(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOrgStateExists(orgWriteModel.State) {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-eeX9a", "Errors.Org.NotFound")
+	}
+	if orgWriteModel.ParentID == "" {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Quo4i", "Errors.Org.Parent.NotSet")
+	}
+	if permissionCheck != nil {
+		if err := permissionCheck(ctx, orgWriteModel.ParentID); err != nil {
+			return nil, err
+		}
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewOrgParentRemovedEvent(ctx, OrgAggregateFromWriteModel(&orgWriteModel.WriteModel)))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(orgWriteModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&orgWriteModel.WriteModel), nil
+}
+
+// orgAncestorIDs returns the id of the organization followed by the ids of its parents, the nearest first.
+// Removed parents end the hierarchy.
+func (c *Commands) orgAncestorIDs(ctx context.Context, orgID string) ([]string, error) {
+	ancestors := make([]string, 0, 1)
+	for len(ancestors) <= domain.OrgHierarchyMaxDepth {
+		wm, err := c.orgParentWriteModelByID(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if !isOrgStateExists(wm.State) {
+			if len(ancestors) == 0 {
+				return nil, zerrors.ThrowNotFound(nil, "ORG-Thoo4", "Errors.Org.Parent.NotFound")
+			}
+			return ancestors, nil
+		}
+		if slices.Contains(ancestors, orgID) {
+			return nil, zerrors.ThrowInternal(nil, "ORG-ooY7i", "Errors.Org.Parent.Cycle")
+		}
+		ancestors = append(ancestors, orgID)
+		if wm.ParentID == "" {
+			return ancestors, nil
+		}
+		orgID = wm.ParentID
+	}
+	return ancestors, nil
+}
+
+func (c *Commands) orgParentWriteModelByID(ctx context.Context, orgID string) (_ *OrgParentWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewOrgParentWriteModel(orgID)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// filterInheritedOrgPolicy returns the policy of the organization or, if it has no active policy,
+// the policy of the nearest parent with an active one.
+// It returns false if no organization of the hierarchy has an active policy, the default policy applies in this case.
+func filterInheritedOrgPolicy[T eventstore.QueryReducer](
+	ctx context.Context,
+	es *eventstore.Eventstore,
+	orgID string,
+	newPolicy func(orgID string) T,
+	isActive func(T) bool,
+) (policy T, _ bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	for depth := 0; depth <= domain.OrgHierarchyMaxDepth; depth++ {
+		policy = newPolicy(orgID)
+		wm := newInheritedOrgPolicyWriteModel(orgID, policy)
+		if err = es.FilterToQueryReducer(ctx, wm); err != nil {
+			return policy, false, err
+		}
+		// policies of removed parents are not inherited
+		if depth > 0 && wm.OrgRemoved {
+			break
+		}
+		if isActive(policy) {
+			return policy, true, nil
+		}
+		if wm.ParentID == "" {
+			break
+		}
+		orgID = wm.ParentID
+	}
+	return policy, false, nil
+}
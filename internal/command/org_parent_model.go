@@ -0,0 +1,104 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgParentWriteModel struct {
+	eventstore.WriteModel
+
+	State    domain.OrgState
+	ParentID string
+}
+
+func NewOrgParentWriteModel(orgID string) *OrgParentWriteModel {
+	return &OrgParentWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+	}
+}
+
+func (wm *OrgParentWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.OrgAddedEvent:
+			wm.State = domain.OrgStateActive
+		case *org.OrgDeactivatedEvent:
+			wm.State = domain.OrgStateInactive
+		case *org.OrgReactivatedEvent:
+			wm.State = domain.OrgStateActive
+		case *org.OrgRemovedEvent:
+			wm.State = domain.OrgStateRemoved
+			wm.ParentID = ""
+		case *org.OrgParentSetEvent:
+			wm.ParentID = e.ParentID
+		case *org.OrgParentRemovedEvent:
+			wm.ParentID = ""
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OrgParentWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.OrgAddedEventType,
+			org.OrgDeactivatedEventType,
+			org.OrgReactivatedEventType,
+			org.OrgRemovedEventType,
+			org.OrgParentSetEventType,
+			org.OrgParentRemovedEventType).
+		Builder()
+}
+
+// inheritedOrgPolicyWriteModel extends the write model of an organization policy with the parent of the organization.
+// The parent is queried together with the policy, so organizations without a parent don't need an additional query.
+type inheritedOrgPolicyWriteModel struct {
+	eventstore.QueryReducer
+
+	orgID      string
+	ParentID   string
+	OrgRemoved bool
+}
+
+func newInheritedOrgPolicyWriteModel(orgID string, policy eventstore.QueryReducer) *inheritedOrgPolicyWriteModel {
+	return &inheritedOrgPolicyWriteModel{
+		QueryReducer: policy,
+		orgID:        orgID,
+	}
+}
+
+// AppendEvents passes all events to the policy, which ignores the ones of the organization itself.
+func (wm *inheritedOrgPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	wm.QueryReducer.AppendEvents(events...)
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.OrgParentSetEvent:
+			wm.ParentID = e.ParentID
+		case *org.OrgParentRemovedEvent:
+			wm.ParentID = ""
+		case *org.OrgRemovedEvent:
+			wm.OrgRemoved = true
+		}
+	}
+}
+
+func (wm *inheritedOrgPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return wm.QueryReducer.Query().
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.orgID).
+		EventTypes(
+			org.OrgRemovedEventType,
+			org.OrgParentSetEventType,
+			org.OrgParentRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgInactivityPolicyWriteModel struct {
+	InactivityPolicyWriteModel
+}
+
+func NewOrgInactivityPolicyWriteModel(orgID string) *OrgInactivityPolicyWriteModel {
+	return &OrgInactivityPolicyWriteModel{
+		InactivityPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (wm *OrgInactivityPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.InactivityPolicyAddedEvent:
+			wm.InactivityPolicyWriteModel.AppendEvents(&e.InactivityPolicyAddedEvent)
+		case *org.InactivityPolicyChangedEvent:
+			wm.InactivityPolicyWriteModel.AppendEvents(&e.InactivityPolicyChangedEvent)
+		case *org.InactivityPolicyRemovedEvent:
+			wm.InactivityPolicyWriteModel.AppendEvents(&e.InactivityPolicyRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgInactivityPolicyWriteModel) Reduce() error {
+	return wm.InactivityPolicyWriteModel.Reduce()
+}
+
+func (wm *OrgInactivityPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateIDs(wm.InactivityPolicyWriteModel.AggregateID).
+		AggregateTypes(org.AggregateType).
+		EventTypes(org.InactivityPolicyAddedEventType,
+			org.InactivityPolicyChangedEventType,
+			org.InactivityPolicyRemovedEventType).
+		Builder()
+}
+
+func (wm *OrgInactivityPolicyWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	policy *domain.InactivityPolicy,
+) (*org.InactivityPolicyChangedEvent, bool) {
+	changes := wm.changes(policy)
+	if len(changes) == 0 {
+		return nil, false
+	}
+	changedEvent, err := org.NewInactivityPolicyChangedEvent(ctx, aggregate, changes)
+	if err != nil {
+		return nil, false
+	}
+	return changedEvent, true
+}
@@ -36,6 +36,16 @@ type AddLoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
+	TrustedDeviceLifetime      time.Duration
+	SessionIdleTimeout         time.Duration
+	SessionMaxLifetime         time.Duration
+	MaxConcurrentSessions      uint64
+	WebAuthNAttestation        domain.AttestationConveyance
+	WebAuthNUserVerification   domain.UserVerificationRequirement
+	WebAuthNResidentKey        domain.ResidentKeyRequirement
+	WebAuthNAllowedAAGUIDs     []string
+	WebAuthNDeniedAAGUIDs      []string
 }
 
 type AddLoginPolicyIDP struct {
@@ -61,6 +71,16 @@ type ChangeLoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
+	TrustedDeviceLifetime      time.Duration
+	SessionIdleTimeout         time.Duration
+	SessionMaxLifetime         time.Duration
+	MaxConcurrentSessions      uint64
+	WebAuthNAttestation        domain.AttestationConveyance
+	WebAuthNUserVerification   domain.UserVerificationRequirement
+	WebAuthNResidentKey        domain.ResidentKeyRequirement
+	WebAuthNAllowedAAGUIDs     []string
+	WebAuthNDeniedAAGUIDs      []string
 }
 
 func (c *Commands) AddLoginPolicy(ctx context.Context, resourceOwner string, policy *AddLoginPolicy) (_ *domain.ObjectDetails, err error) {
@@ -89,11 +109,13 @@ func (c *Commands) orgLoginPolicyWriteModelByID(ctx context.Context, orgID strin
 }
 
 func (c *Commands) getOrgLoginPolicy(ctx context.Context, orgID string) (*domain.LoginPolicy, error) {
-	policy, err := c.orgLoginPolicyWriteModelByID(ctx, orgID)
+	policy, ok, err := filterInheritedOrgPolicy(ctx, c.eventstore, orgID, NewOrgLoginPolicyWriteModel,
+		func(policy *OrgLoginPolicyWriteModel) bool { return policy.State == domain.PolicyStateActive },
+	)
 	if err != nil {
 		return nil, err
 	}
-	if policy.State == domain.PolicyStateActive {
+	if ok {
 		return writeModelToLoginPolicy(&policy.LoginPolicyWriteModel), nil
 	}
 	return c.getDefaultLoginPolicy(ctx)
@@ -404,6 +426,9 @@ func prepareAddLoginPolicy(a *org.Aggregate, policy *AddLoginPolicy) preparation
 		if ok := domain.ValidateDefaultRedirectURI(policy.DefaultRedirectURI); !ok {
 			return nil, zerrors.ThrowInvalidArgument(nil, "Org-WSfdq", "Errors.Org.LoginPolicy.RedirectURIInvalid")
 		}
+		if !domain.ValidateAAGUIDs(policy.WebAuthNAllowedAAGUIDs) || !domain.ValidateAAGUIDs(policy.WebAuthNDeniedAAGUIDs) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "Org-Iex5o", "Errors.Org.LoginPolicy.AAGUIDInvalid")
+		}
 		for _, factor := range policy.SecondFactors {
 			if !factor.Valid() {
 				return nil, zerrors.ThrowInvalidArgument(nil, "Org-SFeea", "Errors.Org.LoginPolicy.MFA.Unspecified")
@@ -446,6 +471,16 @@ func prepareAddLoginPolicy(a *org.Aggregate, policy *AddLoginPolicy) preparation
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
 				policy.MultiFactorCheckLifetime,
+				policy.AllowMagicLink,
+				policy.TrustedDeviceLifetime,
+				policy.SessionIdleTimeout,
+				policy.SessionMaxLifetime,
+				policy.MaxConcurrentSessions,
+				policy.WebAuthNAttestation,
+				policy.WebAuthNUserVerification,
+				policy.WebAuthNResidentKey,
+				policy.WebAuthNAllowedAAGUIDs,
+				policy.WebAuthNDeniedAAGUIDs,
 			))
 			for _, factor := range policy.SecondFactors {
 				cmds = append(cmds, org.NewLoginPolicySecondFactorAddedEvent(ctx, &a.Aggregate, factor))
@@ -466,6 +501,9 @@ func prepareChangeLoginPolicy(a *org.Aggregate, policy *ChangeLoginPolicy) prepa
 		if ok := domain.ValidateDefaultRedirectURI(policy.DefaultRedirectURI); !ok {
 			return nil, zerrors.ThrowInvalidArgument(nil, "Org-Sfd21", "Errors.Org.LoginPolicy.RedirectURIInvalid")
 		}
+		if !domain.ValidateAAGUIDs(policy.WebAuthNAllowedAAGUIDs) || !domain.ValidateAAGUIDs(policy.WebAuthNDeniedAAGUIDs) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "Org-Ahqu3", "Errors.Org.LoginPolicy.AAGUIDInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			wm := NewOrgLoginPolicyWriteModel(a.ID)
 			if err := queryAndReduce(ctx, filter, wm); err != nil {
@@ -491,7 +529,17 @@ func prepareChangeLoginPolicy(a *org.Aggregate, policy *ChangeLoginPolicy) prepa
 				policy.ExternalLoginCheckLifetime,
 				policy.MFAInitSkipLifetime,
 				policy.SecondFactorCheckLifetime,
-				policy.MultiFactorCheckLifetime)
+				policy.MultiFactorCheckLifetime,
+				policy.AllowMagicLink,
+				policy.TrustedDeviceLifetime,
+				policy.SessionIdleTimeout,
+				policy.SessionMaxLifetime,
+				policy.MaxConcurrentSessions,
+				policy.WebAuthNAttestation,
+				policy.WebAuthNUserVerification,
+				policy.WebAuthNResidentKey,
+				policy.WebAuthNAllowedAAGUIDs,
+				policy.WebAuthNDeniedAAGUIDs)
 			if !hasChanged {
 				return nil, zerrors.ThrowPreconditionFailed(nil, "Org-5M9vdd", "Errors.Org.LoginPolicy.NotChanged")
 			}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
@@ -79,6 +80,16 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
 	multiFactorCheckLifetime time.Duration,
+	allowMagicLink bool,
+	trustedDeviceLifetime,
+	sessionIdleTimeout,
+	sessionMaxLifetime time.Duration,
+	maxConcurrentSessions uint64,
+	webAuthNAttestation domain.AttestationConveyance,
+	webAuthNUserVerification domain.UserVerificationRequirement,
+	webAuthNResidentKey domain.ResidentKeyRequirement,
+	webAuthNAllowedAAGUIDs,
+	webAuthNDeniedAAGUIDs []string,
 ) (*org.LoginPolicyChangedEvent, bool) {
 
 	changes := make([]policy.LoginPolicyChanges, 0)
@@ -133,6 +144,36 @@ func (wm *OrgLoginPolicyWriteModel) NewChangedEvent(
 	if wm.DisableLoginWithPhone != disableLoginWithPhone {
 		changes = append(changes, policy.ChangeDisableLoginWithPhone(disableLoginWithPhone))
 	}
+	if wm.AllowMagicLink != allowMagicLink {
+		changes = append(changes, policy.ChangeAllowMagicLink(allowMagicLink))
+	}
+	if wm.TrustedDeviceLifetime != trustedDeviceLifetime {
+		changes = append(changes, policy.ChangeTrustedDeviceLifetime(trustedDeviceLifetime))
+	}
+	if wm.SessionIdleTimeout != sessionIdleTimeout {
+		changes = append(changes, policy.ChangeSessionIdleTimeout(sessionIdleTimeout))
+	}
+	if wm.SessionMaxLifetime != sessionMaxLifetime {
+		changes = append(changes, policy.ChangeSessionMaxLifetime(sessionMaxLifetime))
+	}
+	if wm.MaxConcurrentSessions != maxConcurrentSessions {
+		changes = append(changes, policy.ChangeMaxConcurrentSessions(maxConcurrentSessions))
+	}
+	if wm.WebAuthNAttestation != webAuthNAttestation {
+		changes = append(changes, policy.ChangeWebAuthNAttestation(webAuthNAttestation))
+	}
+	if wm.WebAuthNUserVerification != webAuthNUserVerification {
+		changes = append(changes, policy.ChangeWebAuthNUserVerification(webAuthNUserVerification))
+	}
+	if wm.WebAuthNResidentKey != webAuthNResidentKey {
+		changes = append(changes, policy.ChangeWebAuthNResidentKey(webAuthNResidentKey))
+	}
+	if !slices.Equal(wm.WebAuthNAllowedAAGUIDs, webAuthNAllowedAAGUIDs) {
+		changes = append(changes, policy.ChangeWebAuthNAllowedAAGUIDs(webAuthNAllowedAAGUIDs))
+	}
+	if !slices.Equal(wm.WebAuthNDeniedAAGUIDs, webAuthNDeniedAAGUIDs) {
+		changes = append(changes, policy.ChangeWebAuthNDeniedAAGUIDs(webAuthNDeniedAAGUIDs))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
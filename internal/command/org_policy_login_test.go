@@ -69,6 +69,9 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -124,6 +127,9 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							false,
+							0, 0, 0, 0,
+							0, 0, 0, nil, nil,
 						),
 					),
 				),
@@ -218,6 +224,9 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							false,
+							0, 0, 0, 0,
+							0, 0, 0, nil, nil,
 						),
 						org.NewLoginPolicySecondFactorAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -343,6 +352,9 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							false,
+							0, 0, 0, 0,
+							0, 0, 0, nil, nil,
 						),
 						org.NewIdentityProviderAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -425,6 +437,9 @@ func TestCommandSide_AddLoginPolicy(t *testing.T) {
 							time.Hour*3,
 							time.Hour*4,
 							time.Hour*5,
+							false,
+							0, 0, 0, 0,
+							0, 0, 0, nil, nil,
 						),
 						org.NewIdentityProviderAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
@@ -563,6 +578,9 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -621,6 +639,9 @@ func TestCommandSide_ChangeLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -770,6 +791,9 @@ func TestCommandSide_RemoveLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -910,6 +934,9 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -955,6 +982,9 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -1020,6 +1050,9 @@ func TestCommandSide_AddIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -1183,6 +1216,9 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -1228,6 +1264,9 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -1285,6 +1324,9 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -1345,6 +1387,9 @@ func TestCommandSide_RemoveIDPProviderLoginPolicy(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -10,12 +10,12 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-func (c *Commands) AddNotificationPolicy(ctx context.Context, resourceOwner string, passwordChange bool) (*domain.ObjectDetails, error) {
+func (c *Commands) AddNotificationPolicy(ctx context.Context, resourceOwner string, passwordChange, securityNotifications bool, phoneChannels []domain.PhoneChannel) (*domain.ObjectDetails, error) {
 	if resourceOwner == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "Org-x801sk2i", "Errors.ResourceOwnerMissing")
 	}
 	orgAgg := org.NewAggregate(resourceOwner)
-	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddNotificationPolicy(orgAgg, passwordChange))
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareAddNotificationPolicy(orgAgg, passwordChange, securityNotifications, phoneChannels))
 	if err != nil {
 		return nil, err
 	}
@@ -28,9 +28,14 @@ func (c *Commands) AddNotificationPolicy(ctx context.Context, resourceOwner stri
 
 func prepareAddNotificationPolicy(
 	a *org.Aggregate,
-	passwordChange bool,
+	passwordChange,
+	securityNotifications bool,
+	phoneChannels []domain.PhoneChannel,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
+		if !domain.ValidPhoneChannels(phoneChannels) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "Org-Eu8ae", "Errors.Policy.Notification.PhoneChannelsInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			writeModel := NewOrgNotificationPolicyWriteModel(a.Aggregate.ID)
 			events, err := filter(ctx, writeModel.Query())
@@ -45,18 +50,18 @@ func prepareAddNotificationPolicy(
 				return nil, zerrors.ThrowAlreadyExists(nil, "Org-xa08n2", "Errors.Org.NotificationPolicy.AlreadyExists")
 			}
 			return []eventstore.Command{
-				org.NewNotificationPolicyAddedEvent(ctx, &a.Aggregate, passwordChange),
+				org.NewNotificationPolicyAddedEvent(ctx, &a.Aggregate, passwordChange, securityNotifications, phoneChannels),
 			}, nil
 		}, nil
 	}
 }
 
-func (c *Commands) ChangeNotificationPolicy(ctx context.Context, resourceOwner string, passwordChange bool) (*domain.ObjectDetails, error) {
+func (c *Commands) ChangeNotificationPolicy(ctx context.Context, resourceOwner string, passwordChange, securityNotifications bool, phoneChannels []domain.PhoneChannel) (*domain.ObjectDetails, error) {
 	if resourceOwner == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "Org-x091n1g", "Errors.ResourceOwnerMissing")
 	}
 	orgAgg := org.NewAggregate(resourceOwner)
-	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareChangeNotificationPolicy(orgAgg, passwordChange))
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, prepareChangeNotificationPolicy(orgAgg, passwordChange, securityNotifications, phoneChannels))
 	if err != nil {
 		return nil, err
 	}
@@ -69,9 +74,14 @@ func (c *Commands) ChangeNotificationPolicy(ctx context.Context, resourceOwner s
 
 func prepareChangeNotificationPolicy(
 	a *org.Aggregate,
-	passwordChange bool,
+	passwordChange,
+	securityNotifications bool,
+	phoneChannels []domain.PhoneChannel,
 ) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
+		if !domain.ValidPhoneChannels(phoneChannels) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "Org-zoh7E", "Errors.Policy.Notification.PhoneChannelsInvalid")
+		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
 			writeModel := NewOrgNotificationPolicyWriteModel(a.Aggregate.ID)
 			events, err := filter(ctx, writeModel.Query())
@@ -86,7 +96,7 @@ func prepareChangeNotificationPolicy(
 			if writeModel.State == domain.PolicyStateUnspecified || writeModel.State == domain.PolicyStateRemoved {
 				return nil, zerrors.ThrowNotFound(nil, "ORG-x029n3", "Errors.Org.NotificationPolicy.NotFound")
 			}
-			change, hasChanged := writeModel.NewChangedEvent(ctx, &a.Aggregate, passwordChange)
+			change, hasChanged := writeModel.NewChangedEvent(ctx, &a.Aggregate, passwordChange, securityNotifications, phoneChannels)
 			if !hasChanged {
 				return nil, zerrors.ThrowPreconditionFailed(nil, "Org-ioqnxz", "Errors.Org.NotificationPolicy.NotChanged")
 			}
@@ -137,3 +147,20 @@ func prepareRemoveNotificationPolicy(
 		}, nil
 	}
 }
+
+// securityNotificationsEnabled checks if the notification policy of the organization,
+// or the default policy of the instance, enables the security notifications.
+func securityNotificationsEnabled(ctx context.Context, orgID string, queryReducer func(ctx context.Context, r eventstore.QueryReducer) error) (bool, error) {
+	orgWm := NewOrgNotificationPolicyWriteModel(orgID)
+	if err := queryReducer(ctx, orgWm); err != nil {
+		return false, err
+	}
+	if orgWm.State == domain.PolicyStateActive {
+		return orgWm.SecurityNotifications, nil
+	}
+	instanceWm := NewInstanceNotificationPolicyWriteModel(ctx)
+	if err := queryReducer(ctx, instanceWm); err != nil {
+		return false, err
+	}
+	return instanceWm.SecurityNotifications, nil
+}
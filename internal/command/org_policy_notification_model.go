@@ -2,7 +2,9 @@ package command
 
 import (
 	"context"
+	"slices"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/policy"
@@ -55,13 +57,21 @@ func (wm *OrgNotificationPolicyWriteModel) Query() *eventstore.SearchQueryBuilde
 func (wm *OrgNotificationPolicyWriteModel) NewChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
-	passwordChange bool,
+	passwordChange,
+	securityNotifications bool,
+	phoneChannels []domain.PhoneChannel,
 ) (*org.NotificationPolicyChangedEvent, bool) {
 
 	changes := make([]policy.NotificationPolicyChanges, 0)
 	if wm.PasswordChange != passwordChange {
 		changes = append(changes, policy.ChangePasswordChange(passwordChange))
 	}
+	if wm.SecurityNotifications != securityNotifications {
+		changes = append(changes, policy.ChangeSecurityNotifications(securityNotifications))
+	}
+	if !slices.Equal(wm.PhoneChannels, phoneChannels) {
+		changes = append(changes, policy.ChangePhoneChannels(phoneChannels))
+	}
 	if len(changes) == 0 {
 		return nil, false
 	}
@@ -18,9 +18,11 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 		eventstore *eventstore.Eventstore
 	}
 	type args struct {
-		ctx            context.Context
-		orgID          string
-		passwordChange bool
+		ctx                   context.Context
+		orgID                 string
+		passwordChange        bool
+		securityNotifications bool
+		phoneChannels         []domain.PhoneChannel
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -58,6 +60,8 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 							org.NewNotificationPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								true,
+								false,
+								nil,
 							),
 						),
 					),
@@ -82,6 +86,8 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 						org.NewNotificationPolicyAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
 							true,
+							false,
+							nil,
 						),
 					),
 				),
@@ -97,6 +103,34 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "add policy with security notifications, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(),
+					expectPush(
+						org.NewNotificationPolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							true,
+							true,
+							nil,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:                   context.Background(),
+				orgID:                 "org1",
+				passwordChange:        true,
+				securityNotifications: true,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 		{
 			name: "add policy empty, ok",
 			fields: fields{
@@ -107,6 +141,8 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 						org.NewNotificationPolicyAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
 							false,
+							false,
+							nil,
 						),
 					),
 				),
@@ -128,7 +164,7 @@ func TestCommandSide_AddNotificationPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddNotificationPolicy(tt.args.ctx, tt.args.orgID, tt.args.passwordChange)
+			got, err := r.AddNotificationPolicy(tt.args.ctx, tt.args.orgID, tt.args.passwordChange, tt.args.securityNotifications, tt.args.phoneChannels)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -147,9 +183,11 @@ func TestCommandSide_ChangeNotificationPolicy(t *testing.T) {
 		eventstore *eventstore.Eventstore
 	}
 	type args struct {
-		ctx            context.Context
-		orgID          string
-		passwordChange bool
+		ctx                   context.Context
+		orgID                 string
+		passwordChange        bool
+		securityNotifications bool
+		phoneChannels         []domain.PhoneChannel
 	}
 	type res struct {
 		want *domain.ObjectDetails
@@ -203,6 +241,8 @@ func TestCommandSide_ChangeNotificationPolicy(t *testing.T) {
 							org.NewNotificationPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								true,
+								false,
+								nil,
 							),
 						),
 					),
@@ -227,6 +267,8 @@ func TestCommandSide_ChangeNotificationPolicy(t *testing.T) {
 							org.NewNotificationPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								true,
+								false,
+								nil,
 							),
 						),
 					),
@@ -246,13 +288,110 @@ func TestCommandSide_ChangeNotificationPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change security notifications, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNotificationPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								false,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						func() *org.NotificationPolicyChangedEvent {
+							event, _ := org.NewNotificationPolicyChangedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								[]policy.NotificationPolicyChanges{
+									policy.ChangeSecurityNotifications(true),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx:                   context.Background(),
+				orgID:                 "org1",
+				passwordChange:        true,
+				securityNotifications: true,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "invalid phone channels, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+				),
+			},
+			args: args{
+				ctx:            context.Background(),
+				orgID:          "org1",
+				passwordChange: true,
+				phoneChannels:  []domain.PhoneChannel{domain.PhoneChannelSMS, domain.PhoneChannelSMS},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "change phone channels, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNotificationPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								false,
+								[]domain.PhoneChannel{domain.PhoneChannelSMS},
+							),
+						),
+					),
+					expectPush(
+						func() *org.NotificationPolicyChangedEvent {
+							event, _ := org.NewNotificationPolicyChangedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								[]policy.NotificationPolicyChanges{
+									policy.ChangePhoneChannels([]domain.PhoneChannel{domain.PhoneChannelWhatsApp, domain.PhoneChannelSMS}),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx:            context.Background(),
+				orgID:          "org1",
+				passwordChange: true,
+				phoneChannels:  []domain.PhoneChannel{domain.PhoneChannelWhatsApp, domain.PhoneChannelSMS},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.ChangeNotificationPolicy(tt.args.ctx, tt.args.orgID, tt.args.passwordChange)
+			got, err := r.ChangeNotificationPolicy(tt.args.ctx, tt.args.orgID, tt.args.passwordChange, tt.args.securityNotifications, tt.args.phoneChannels)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -324,6 +463,8 @@ func TestCommandSide_RemoveNotificationPolicy(t *testing.T) {
 							org.NewNotificationPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								true,
+								false,
+								nil,
 							),
 						),
 					),
@@ -13,11 +13,15 @@ func (c *Commands) getOrgPasswordComplexityPolicy(ctx context.Context, orgID str
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	policy, err := c.orgPasswordComplexityPolicyWriteModelByID(ctx, orgID)
+	policy, ok, err := filterInheritedOrgPolicy(ctx, c.eventstore, orgID, NewOrgPasswordComplexityPolicyWriteModel,
+		func(policy *OrgPasswordComplexityPolicyWriteModel) bool {
+			return policy.State == domain.PolicyStateActive
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
-	if policy.State == domain.PolicyStateActive {
+	if ok {
 		return orgWriteModelToPasswordComplexityPolicy(policy), nil
 	}
 	return c.getDefaultPasswordComplexityPolicy(ctx)
@@ -61,7 +65,8 @@ func (c *Commands) AddPasswordComplexityPolicy(ctx context.Context, resourceOwne
 			policy.HasLowercase,
 			policy.HasUppercase,
 			policy.HasNumber,
-			policy.HasSymbol))
+			policy.HasSymbol,
+			policy.CheckBreached))
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +95,7 @@ func (c *Commands) ChangePasswordComplexityPolicy(ctx context.Context, resourceO
 	}
 
 	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.PasswordComplexityPolicyWriteModel.WriteModel)
-	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MinLength, policy.HasLowercase, policy.HasUppercase, policy.HasNumber, policy.HasSymbol, policy.CheckBreached)
 	if !hasChanged {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "Org-DAs21", "Errors.Org.PasswordComplexityPolicy.NotChanged")
 	}
@@ -64,7 +64,7 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -95,7 +95,7 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 						org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 							&org.NewAggregate("org1").Aggregate,
 							8,
-							true, true, true, true,
+							true, true, true, true, false,
 						),
 					),
 				),
@@ -218,7 +218,7 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -249,7 +249,7 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -361,7 +361,7 @@ func TestCommandSide_RemovePasswordComplexityPolicy(t *testing.T) {
 							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								8,
-								true, true, true, true,
+								true, true, true, true, false,
 							),
 						),
 					),
@@ -1202,6 +1202,7 @@ func TestCommandSide_RemoveOrg(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						)),
 				),
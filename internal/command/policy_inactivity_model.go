@@ -0,0 +1,73 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type InactivityPolicyWriteModel struct {
+	eventstore.WriteModel
+
+	WarnDays           uint64
+	DeactivateDays     uint64
+	DeleteDays         uint64
+	ExemptMachineUsers bool
+	ExemptRoles        []string
+	State              domain.PolicyState
+}
+
+func (wm *InactivityPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.InactivityPolicyAddedEvent:
+			wm.WarnDays = e.WarnDays
+			wm.DeactivateDays = e.DeactivateDays
+			wm.DeleteDays = e.DeleteDays
+			wm.ExemptMachineUsers = e.ExemptMachineUsers
+			wm.ExemptRoles = e.ExemptRoles
+			wm.State = domain.PolicyStateActive
+		case *policy.InactivityPolicyChangedEvent:
+			if e.WarnDays != nil {
+				wm.WarnDays = *e.WarnDays
+			}
+			if e.DeactivateDays != nil {
+				wm.DeactivateDays = *e.DeactivateDays
+			}
+			if e.DeleteDays != nil {
+				wm.DeleteDays = *e.DeleteDays
+			}
+			if e.ExemptMachineUsers != nil {
+				wm.ExemptMachineUsers = *e.ExemptMachineUsers
+			}
+			if e.ExemptRoles != nil {
+				wm.ExemptRoles = *e.ExemptRoles
+			}
+		case *policy.InactivityPolicyRemovedEvent:
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InactivityPolicyWriteModel) changes(p *domain.InactivityPolicy) []policy.InactivityPolicyChanges {
+	changes := make([]policy.InactivityPolicyChanges, 0)
+	if wm.WarnDays != p.WarnDays {
+		changes = append(changes, policy.ChangeInactivityWarnDays(p.WarnDays))
+	}
+	if wm.DeactivateDays != p.DeactivateDays {
+		changes = append(changes, policy.ChangeInactivityDeactivateDays(p.DeactivateDays))
+	}
+	if wm.DeleteDays != p.DeleteDays {
+		changes = append(changes, policy.ChangeInactivityDeleteDays(p.DeleteDays))
+	}
+	if wm.ExemptMachineUsers != p.ExemptMachineUsers {
+		changes = append(changes, policy.ChangeInactivityExemptMachineUsers(p.ExemptMachineUsers))
+	}
+	if !slices.Equal(wm.ExemptRoles, p.ExemptRoles) {
+		changes = append(changes, policy.ChangeInactivityExemptRoles(p.ExemptRoles))
+	}
+	return changes
+}
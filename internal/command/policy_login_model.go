@@ -21,6 +21,16 @@ type LoginPolicyWriteModel struct {
 	AllowDomainDiscovery       bool
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
+	TrustedDeviceLifetime      time.Duration
+	SessionIdleTimeout         time.Duration
+	SessionMaxLifetime         time.Duration
+	MaxConcurrentSessions      uint64
+	WebAuthNAttestation        domain.AttestationConveyance
+	WebAuthNUserVerification   domain.UserVerificationRequirement
+	WebAuthNResidentKey        domain.ResidentKeyRequirement
+	WebAuthNAllowedAAGUIDs     []string
+	WebAuthNDeniedAAGUIDs      []string
 	PasswordlessType           domain.PasswordlessType
 	DefaultRedirectURI         string
 	PasswordCheckLifetime      time.Duration
@@ -46,6 +56,16 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			wm.AllowDomainDiscovery = e.AllowDomainDiscovery
 			wm.DisableLoginWithEmail = e.DisableLoginWithEmail
 			wm.DisableLoginWithPhone = e.DisableLoginWithPhone
+			wm.AllowMagicLink = e.AllowMagicLink
+			wm.TrustedDeviceLifetime = e.TrustedDeviceLifetime
+			wm.SessionIdleTimeout = e.SessionIdleTimeout
+			wm.SessionMaxLifetime = e.SessionMaxLifetime
+			wm.MaxConcurrentSessions = e.MaxConcurrentSessions
+			wm.WebAuthNAttestation = e.WebAuthNAttestation
+			wm.WebAuthNUserVerification = e.WebAuthNUserVerification
+			wm.WebAuthNResidentKey = e.WebAuthNResidentKey
+			wm.WebAuthNAllowedAAGUIDs = e.WebAuthNAllowedAAGUIDs
+			wm.WebAuthNDeniedAAGUIDs = e.WebAuthNDeniedAAGUIDs
 			wm.DefaultRedirectURI = e.DefaultRedirectURI
 			wm.PasswordCheckLifetime = e.PasswordCheckLifetime
 			wm.ExternalLoginCheckLifetime = e.ExternalLoginCheckLifetime
@@ -105,6 +125,36 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 			if e.DisableLoginWithPhone != nil {
 				wm.DisableLoginWithPhone = *e.DisableLoginWithPhone
 			}
+			if e.AllowMagicLink != nil {
+				wm.AllowMagicLink = *e.AllowMagicLink
+			}
+			if e.TrustedDeviceLifetime != nil {
+				wm.TrustedDeviceLifetime = *e.TrustedDeviceLifetime
+			}
+			if e.SessionIdleTimeout != nil {
+				wm.SessionIdleTimeout = *e.SessionIdleTimeout
+			}
+			if e.SessionMaxLifetime != nil {
+				wm.SessionMaxLifetime = *e.SessionMaxLifetime
+			}
+			if e.MaxConcurrentSessions != nil {
+				wm.MaxConcurrentSessions = *e.MaxConcurrentSessions
+			}
+			if e.WebAuthNAttestation != nil {
+				wm.WebAuthNAttestation = *e.WebAuthNAttestation
+			}
+			if e.WebAuthNUserVerification != nil {
+				wm.WebAuthNUserVerification = *e.WebAuthNUserVerification
+			}
+			if e.WebAuthNResidentKey != nil {
+				wm.WebAuthNResidentKey = *e.WebAuthNResidentKey
+			}
+			if e.WebAuthNAllowedAAGUIDs != nil {
+				wm.WebAuthNAllowedAAGUIDs = *e.WebAuthNAllowedAAGUIDs
+			}
+			if e.WebAuthNDeniedAAGUIDs != nil {
+				wm.WebAuthNDeniedAAGUIDs = *e.WebAuthNDeniedAAGUIDs
+			}
 		case *policy.LoginPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
@@ -115,3 +165,14 @@ func (wm *LoginPolicyWriteModel) Reduce() error {
 func (wm *LoginPolicyWriteModel) Exists() bool {
 	return wm.State.Exists()
 }
+
+// WebAuthNPolicy returns the requirements for the registration of passkeys and U2F.
+func (wm *LoginPolicyWriteModel) WebAuthNPolicy() *domain.WebAuthNPolicy {
+	return &domain.WebAuthNPolicy{
+		Attestation:      wm.WebAuthNAttestation,
+		UserVerification: wm.WebAuthNUserVerification,
+		ResidentKey:      wm.WebAuthNResidentKey,
+		AllowedAAGUIDs:   wm.WebAuthNAllowedAAGUIDs,
+		DeniedAAGUIDs:    wm.WebAuthNDeniedAAGUIDs,
+	}
+}
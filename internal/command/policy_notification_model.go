@@ -9,8 +9,10 @@ import (
 type NotificationPolicyWriteModel struct {
 	eventstore.WriteModel
 
-	PasswordChange bool
-	State          domain.PolicyState
+	PasswordChange        bool
+	SecurityNotifications bool
+	PhoneChannels         []domain.PhoneChannel
+	State                 domain.PolicyState
 }
 
 func (wm *NotificationPolicyWriteModel) Reduce() error {
@@ -18,11 +20,19 @@ func (wm *NotificationPolicyWriteModel) Reduce() error {
 		switch e := event.(type) {
 		case *policy.NotificationPolicyAddedEvent:
 			wm.PasswordChange = e.PasswordChange
+			wm.SecurityNotifications = e.SecurityNotifications
+			wm.PhoneChannels = e.PhoneChannels
 			wm.State = domain.PolicyStateActive
 		case *policy.NotificationPolicyChangedEvent:
 			if e.PasswordChange != nil {
 				wm.PasswordChange = *e.PasswordChange
 			}
+			if e.SecurityNotifications != nil {
+				wm.SecurityNotifications = *e.SecurityNotifications
+			}
+			if e.PhoneChannels != nil {
+				wm.PhoneChannels = *e.PhoneChannels
+			}
 		case *policy.NotificationPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
@@ -11,6 +11,7 @@ type PasswordAgePolicyWriteModel struct {
 
 	ExpireWarnDays uint64
 	MaxAgeDays     uint64
+	HistoryDepth   uint64
 	State          domain.PolicyState
 }
 
@@ -20,6 +21,7 @@ func (wm *PasswordAgePolicyWriteModel) Reduce() error {
 		case *policy.PasswordAgePolicyAddedEvent:
 			wm.ExpireWarnDays = e.ExpireWarnDays
 			wm.MaxAgeDays = e.MaxAgeDays
+			wm.HistoryDepth = e.HistoryDepth
 			wm.State = domain.PolicyStateActive
 		case *policy.PasswordAgePolicyChangedEvent:
 			if e.ExpireWarnDays != nil {
@@ -28,6 +30,9 @@ func (wm *PasswordAgePolicyWriteModel) Reduce() error {
 			if e.MaxAgeDays != nil {
 				wm.MaxAgeDays = *e.MaxAgeDays
 			}
+			if e.HistoryDepth != nil {
+				wm.HistoryDepth = *e.HistoryDepth
+			}
 		case *policy.PasswordAgePolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
@@ -27,6 +27,7 @@ type AddProject struct {
 	ProjectRoleCheck       bool
 	HasProjectCheck        bool
 	PrivateLabelingSetting domain.PrivateLabelingSetting
+	ResourceURIs           []string
 }
 
 func (p *AddProject) IsValid() error {
@@ -36,7 +37,7 @@ func (p *AddProject) IsValid() error {
 	if p.Name == "" {
 		return zerrors.ThrowInvalidArgument(nil, "PROJECT-IOVCC", "Errors.Project.Invalid")
 	}
-	return nil
+	return domain.ValidateResourceURIs(p.ResourceURIs)
 }
 
 func (c *Commands) AddProject(ctx context.Context, add *AddProject) (_ *domain.ObjectDetails, err error) {
@@ -72,7 +73,8 @@ func (c *Commands) AddProject(ctx context.Context, add *AddProject) (_ *domain.O
 			add.ProjectRoleAssertion,
 			add.ProjectRoleCheck,
 			add.HasProjectCheck,
-			add.PrivateLabelingSetting),
+			add.PrivateLabelingSetting,
+			add.ResourceURIs),
 	}
 	postCommit, err := c.projectCreatedMilestone(ctx, &events)
 	if err != nil {
@@ -117,6 +119,7 @@ func AddProjectCommand(
 					projectRoleCheck,
 					hasProjectCheck,
 					privateLabelingSetting,
+					nil,
 				),
 			}, nil
 		}, nil
@@ -195,6 +198,8 @@ type ChangeProject struct {
 	ProjectRoleCheck       *bool
 	HasProjectCheck        *bool
 	PrivateLabelingSetting *domain.PrivateLabelingSetting
+	// ResourceURIs replace the existing ones if set, an empty slice removes all.
+	ResourceURIs *[]string
 }
 
 func (p *ChangeProject) IsValid() error {
@@ -204,6 +209,9 @@ func (p *ChangeProject) IsValid() error {
 	if p.Name != nil && *p.Name == "" {
 		return zerrors.ThrowInvalidArgument(nil, "COMMAND-4m9vS", "Errors.Project.Invalid")
 	}
+	if p.ResourceURIs != nil {
+		return domain.ValidateResourceURIs(*p.ResourceURIs)
+	}
 	return nil
 }
 
@@ -230,7 +238,8 @@ func (c *Commands) ChangeProject(ctx context.Context, change *ChangeProject) (_
 		change.ProjectRoleAssertion,
 		change.ProjectRoleCheck,
 		change.HasProjectCheck,
-		change.PrivateLabelingSetting)
+		change.PrivateLabelingSetting,
+		change.ResourceURIs)
 	if changedEvent == nil {
 		return writeModelToObjectDetails(&existing.WriteModel), nil
 	}
@@ -97,6 +97,7 @@ func TestAddAPIConfig(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						}, nil
 					}).
@@ -207,7 +208,7 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 				),
@@ -236,7 +237,7 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 					expectFilter(),
@@ -291,7 +292,7 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 					expectFilter(),
@@ -346,7 +347,7 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 					expectFilter(),
@@ -42,6 +42,9 @@ type addOIDCApp struct {
 	IOSBundleID                   string
 	AndroidPackageName            string
 	AndroidSHA256CertFingerprints []string
+	AuthorizationDetailsTypes     []string
+	RequireConsent                bool
+	TokenExchangePolicy           *domain.TokenExchangePolicy
 
 	ClientID          string
 	ClientSecret      string
@@ -73,6 +76,10 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 			return nil, zerrors.ThrowInvalidArgument(nil, "V2-sLpW1", "Errors.Invalid.Argument")
 		}
 
+		if !app.TokenExchangePolicy.IsValid() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "V2-Eim3a", "Errors.Invalid.Argument")
+		}
+
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) (_ []eventstore.Command, err error) {
 			project, err := projectWriteModel(ctx, filter, app.Aggregate.ID, app.Aggregate.ResourceOwner)
 			if err != nil || !project.State.Valid() {
@@ -126,6 +133,9 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 					app.IOSBundleID,
 					app.AndroidPackageName,
 					app.AndroidSHA256CertFingerprints,
+					trimStringSliceWhiteSpaces(app.AuthorizationDetailsTypes),
+					app.RequireConsent,
+					trimTokenExchangePolicy(app.TokenExchangePolicy),
 				),
 			}, nil
 		}, nil
@@ -263,6 +273,9 @@ func (c *Commands) pushOIDCApplication(ctx context.Context, addedApplication *OI
 		strings.TrimSpace(gu.Value(oidcApp.IOSBundleID)),
 		strings.TrimSpace(gu.Value(oidcApp.AndroidPackageName)),
 		trimStringSliceWhiteSpaces(oidcApp.AndroidSHA256CertFingerprints),
+		trimStringSliceWhiteSpaces(oidcApp.AuthorizationDetailsTypes),
+		gu.Value(oidcApp.RequireConsent),
+		trimTokenExchangePolicy(oidcApp.TokenExchangePolicy),
 	))
 
 	events = append(events, extraEvents...)
@@ -400,6 +413,9 @@ func (c *Commands) oidcApplicationChangeEvent(ctx context.Context, existingOIDC
 		iosBundleID,
 		androidPackageName,
 		trimStringSliceWhiteSpaces(oidc.AndroidSHA256CertFingerprints),
+		trimStringSliceWhiteSpaces(oidc.AuthorizationDetailsTypes),
+		oidc.RequireConsent,
+		trimTokenExchangePolicy(oidc.TokenExchangePolicy),
 	)
 }
 
@@ -483,6 +499,16 @@ func trimStringSliceWhiteSpaces(slice []string) []string {
 	return slice
 }
 
+func trimTokenExchangePolicy(policy *domain.TokenExchangePolicy) *domain.TokenExchangePolicy {
+	if policy == nil {
+		return nil
+	}
+	policy.SubjectTokenTypes = trimStringSliceWhiteSpaces(policy.SubjectTokenTypes)
+	policy.Audiences = trimStringSliceWhiteSpaces(policy.Audiences)
+	policy.Scopes = trimStringSliceWhiteSpaces(policy.Scopes)
+	return policy
+}
+
 func (c *Commands) oidcUpdateSecret(ctx context.Context, agg *eventstore.Aggregate, appID, updated string) {
 	c.asyncPush(ctx, project_repo.NewOIDCConfigSecretHashUpdatedEvent(ctx, agg, appID, updated))
 }
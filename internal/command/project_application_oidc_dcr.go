@@ -4,6 +4,8 @@ import (
 	"context"
 	"strings"
 
+	"github.com/muhlemmer/gu"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
@@ -61,6 +63,7 @@ func (c *Commands) EnsureDCRProject(ctx context.Context, resourceOwner string) (
 			false,
 			false,
 			domain.PrivateLabelingSettingUnspecified,
+			nil,
 		),
 	}
 	postCommit, err := c.projectCreatedMilestone(ctx, &events)
@@ -186,6 +189,10 @@ func (c *Commands) AddDynamicOIDCClient(ctx context.Context, projectID, resource
 	// MCP clients self-register into the same project), derive a unique name from the
 	// generated application ID.
 	oidcApp.AppName = dynamicOIDCClientName(oidcApp.AppName, appID)
+	// Dynamically registered clients are third-party applications, so the user has to
+	// consent to the requested scopes. Administrators can still lift the requirement for
+	// trusted clients through the application API.
+	oidcApp.RequireConsent = gu.Ptr(true)
 
 	addedApplication := NewOIDCApplicationWriteModel(projectID, resourceOwner)
 	if err = c.eventstore.FilterToQueryReducer(ctx, addedApplication); err != nil {
@@ -32,6 +32,7 @@ func TestCommandSide_EnsureDCRProject(t *testing.T) {
 			false,
 			false,
 			domain.PrivateLabelingSettingUnspecified,
+			nil,
 		))
 	}
 
@@ -73,6 +74,7 @@ func TestCommandSide_EnsureDCRProject(t *testing.T) {
 						false,
 						false,
 						domain.PrivateLabelingSettingUnspecified,
+						nil,
 					),
 				),
 			)(t),
@@ -102,6 +104,7 @@ func TestCommandSide_EnsureDCRProject(t *testing.T) {
 						false,
 						false,
 						domain.PrivateLabelingSettingUnspecified,
+						nil,
 					),
 				),
 				expectFilter(dcrProjectAdded("winner")), // re-resolve: the racing winner
@@ -201,7 +204,7 @@ func TestCommandSide_AddDynamicOIDCClient(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 					expectFilter(),
@@ -237,7 +240,7 @@ func TestCommandSide_AddDynamicOIDCClient(t *testing.T) {
 							"",
 							"",
 							"",
-							nil),
+							nil, nil, true, nil),
 						// The registration access token (RFC 7592 §3) is persisted in the same
 						// push as the application, so a registered client is never left
 						// unmanageable.
@@ -289,6 +292,7 @@ func TestCommandSide_AddDynamicOIDCClient(t *testing.T) {
 					IDTokenUserinfoAssertion: gu.Ptr(false),
 					ClockSkew:                gu.Ptr(time.Duration(0)),
 					SkipNativeAppSuccessPage: gu.Ptr(false),
+					RequireConsent:           gu.Ptr(true),
 					BackChannelLogoutURI:     gu.Ptr(""),
 					LoginVersion:             gu.Ptr(domain.LoginVersionUnspecified),
 					LoginBaseURI:             gu.Ptr(""),
@@ -306,7 +310,7 @@ func TestCommandSide_AddDynamicOIDCClient(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 					expectFilter(),
@@ -342,7 +346,7 @@ func TestCommandSide_AddDynamicOIDCClient(t *testing.T) {
 							"",
 							"",
 							"",
-							nil),
+							nil, nil, true, nil),
 						project.NewOIDCConfigRegistrationTokenChangedEvent(context.Background(),
 							&project.NewAggregate("project1", "org1").Aggregate,
 							"app1",
@@ -390,6 +394,7 @@ func TestCommandSide_AddDynamicOIDCClient(t *testing.T) {
 					IDTokenUserinfoAssertion: gu.Ptr(false),
 					ClockSkew:                gu.Ptr(time.Duration(0)),
 					SkipNativeAppSuccessPage: gu.Ptr(false),
+					RequireConsent:           gu.Ptr(true),
 					BackChannelLogoutURI:     gu.Ptr(""),
 					LoginVersion:             gu.Ptr(domain.LoginVersionUnspecified),
 					LoginBaseURI:             gu.Ptr(""),
@@ -443,6 +448,7 @@ func Test_dcrProjectWriteModel_Reduce(t *testing.T) {
 		return project.NewProjectAddedEvent(
 			context.Background(), aggregate(projectID), name,
 			false, false, false, domain.PrivateLabelingSettingUnspecified,
+			nil,
 		)
 	}
 	renamed := func(projectID, oldName, newName string) eventstore.Event {
@@ -591,7 +597,7 @@ func TestCommandSide_UpdateDynamicOIDCClient(t *testing.T) {
 				"",
 				"",
 				"",
-				nil)),
+				nil, nil, false, nil)),
 		}
 	}
 	sameMetadata := &domain.OIDCApp{
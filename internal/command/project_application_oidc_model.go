@@ -43,6 +43,9 @@ type OIDCApplicationWriteModel struct {
 	IOSBundleID                   string
 	AndroidPackageName            string
 	AndroidSHA256CertFingerprints []string
+	AuthorizationDetailsTypes     []string
+	RequireConsent                bool
+	TokenExchangePolicy           *domain.TokenExchangePolicy
 	oidc                          bool
 }
 
@@ -148,6 +151,9 @@ func (wm *OIDCApplicationWriteModel) Reduce() error {
 			wm.IOSBundleID = ""
 			wm.AndroidPackageName = ""
 			wm.AndroidSHA256CertFingerprints = nil
+			wm.AuthorizationDetailsTypes = nil
+			wm.RequireConsent = false
+			wm.TokenExchangePolicy = nil
 			wm.oidc = false
 			wm.AppName = e.Name
 			wm.State = domain.AppStateActive
@@ -201,6 +207,9 @@ func (wm *OIDCApplicationWriteModel) Reduce() error {
 			wm.IOSBundleID = ""
 			wm.AndroidPackageName = ""
 			wm.AndroidSHA256CertFingerprints = nil
+			wm.AuthorizationDetailsTypes = nil
+			wm.RequireConsent = false
+			wm.TokenExchangePolicy = nil
 			wm.oidc = false
 			wm.State = domain.AppStateRemoved
 		}
@@ -234,6 +243,9 @@ func (wm *OIDCApplicationWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAdd
 	wm.IOSBundleID = e.IOSBundleID
 	wm.AndroidPackageName = e.AndroidPackageName
 	wm.AndroidSHA256CertFingerprints = e.AndroidSHA256CertFingerprints
+	wm.AuthorizationDetailsTypes = e.AuthorizationDetailsTypes
+	wm.RequireConsent = e.RequireConsent
+	wm.TokenExchangePolicy = e.TokenExchangePolicy
 }
 
 func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
@@ -303,6 +315,23 @@ func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfig
 	if e.AndroidSHA256CertFingerprints != nil {
 		wm.AndroidSHA256CertFingerprints = *e.AndroidSHA256CertFingerprints
 	}
+	if e.AuthorizationDetailsTypes != nil {
+		wm.AuthorizationDetailsTypes = *e.AuthorizationDetailsTypes
+	}
+	if e.RequireConsent != nil {
+		wm.RequireConsent = *e.RequireConsent
+	}
+	if e.TokenExchangePolicy != nil {
+		wm.TokenExchangePolicy = e.TokenExchangePolicy
+	}
+}
+
+// tokenExchangePolicyEqual treats a missing policy the same as one without restrictions.
+func tokenExchangePolicyEqual(a, b *domain.TokenExchangePolicy) bool {
+	if a.IsZero() || b.IsZero() {
+		return a.IsZero() && b.IsZero()
+	}
+	return a.Equal(b)
 }
 
 func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -352,6 +381,9 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	iosBundleID *string,
 	androidPackageName *string,
 	androidSHA256CertFingerprints []string,
+	authorizationDetailsTypes []string,
+	requireConsent *bool,
+	tokenExchangePolicy *domain.TokenExchangePolicy,
 ) (*project.OIDCConfigChangedEvent, bool, error) {
 	changes := make([]project.OIDCConfigChanges, 0)
 	var err error
@@ -422,6 +454,15 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	if androidSHA256CertFingerprints != nil && !slices.Equal(wm.AndroidSHA256CertFingerprints, androidSHA256CertFingerprints) {
 		changes = append(changes, project.ChangeAndroidSHA256CertFingerprints(androidSHA256CertFingerprints))
 	}
+	if authorizationDetailsTypes != nil && !slices.Equal(wm.AuthorizationDetailsTypes, authorizationDetailsTypes) {
+		changes = append(changes, project.ChangeAuthorizationDetailsTypes(authorizationDetailsTypes))
+	}
+	if requireConsent != nil && wm.RequireConsent != *requireConsent {
+		changes = append(changes, project.ChangeRequireConsent(*requireConsent))
+	}
+	if tokenExchangePolicy != nil && !tokenExchangePolicyEqual(wm.TokenExchangePolicy, tokenExchangePolicy) {
+		changes = append(changes, project.ChangeTokenExchangePolicy(tokenExchangePolicy))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
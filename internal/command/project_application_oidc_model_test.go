@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
 )
@@ -37,7 +38,7 @@ func TestOIDCApplicationWriteModel_NewChangedEvent_AppLinks(t *testing.T) {
 			context.Background(), agg, "app-id",
 			nil, nil, nil, nil, nil, nil, nil, nil,
 			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
-			nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil,
 		)
 		require.NoError(t, err)
 		assert.False(t, hasChanged)
@@ -55,6 +56,9 @@ func TestOIDCApplicationWriteModel_NewChangedEvent_AppLinks(t *testing.T) {
 			gu.Ptr("com.new.app"),
 			gu.Ptr("com.new.app"),
 			[]string{"BB:BB"},
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.True(t, hasChanged)
@@ -76,6 +80,9 @@ func TestOIDCApplicationWriteModel_NewChangedEvent_AppLinks(t *testing.T) {
 			gu.Ptr(""),
 			gu.Ptr(""),
 			[]string{},
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.True(t, hasChanged)
@@ -85,4 +92,121 @@ func TestOIDCApplicationWriteModel_NewChangedEvent_AppLinks(t *testing.T) {
 		assert.Equal(t, gu.Ptr(""), event.AndroidPackageName)
 		assert.Equal(t, &[]string{}, event.AndroidSHA256CertFingerprints)
 	})
+
+	t.Run("set authorization details types", func(t *testing.T) {
+		t.Parallel()
+		wm := base()
+		event, hasChanged, err := wm.NewChangedEvent(
+			context.Background(), agg, "app-id",
+			nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil,
+			[]string{"payment_initiation"},
+			nil,
+			nil,
+		)
+		require.NoError(t, err)
+		require.True(t, hasChanged)
+		require.NotNil(t, event)
+		assert.Equal(t, &[]string{"payment_initiation"}, event.AuthorizationDetailsTypes)
+		assert.Nil(t, event.IOSTeamID)
+	})
+
+	t.Run("set require consent", func(t *testing.T) {
+		t.Parallel()
+		wm := base()
+		event, hasChanged, err := wm.NewChangedEvent(
+			context.Background(), agg, "app-id",
+			nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil,
+			gu.Ptr(true),
+			nil,
+		)
+		require.NoError(t, err)
+		require.True(t, hasChanged)
+		require.NotNil(t, event)
+		assert.Equal(t, gu.Ptr(true), event.RequireConsent)
+		assert.Nil(t, event.AuthorizationDetailsTypes)
+	})
+
+	t.Run("unchanged require consent", func(t *testing.T) {
+		t.Parallel()
+		wm := base()
+		event, hasChanged, err := wm.NewChangedEvent(
+			context.Background(), agg, "app-id",
+			nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil,
+			gu.Ptr(false),
+			nil,
+		)
+		require.NoError(t, err)
+		assert.False(t, hasChanged)
+		assert.Nil(t, event)
+	})
+}
+
+func TestOIDCApplicationWriteModel_NewChangedEvent_TokenExchangePolicy(t *testing.T) {
+	t.Parallel()
+
+	agg := &project.NewAggregate("project-id", "org-id").Aggregate
+	base := func(policy *domain.TokenExchangePolicy) *OIDCApplicationWriteModel {
+		return &OIDCApplicationWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   "project-id",
+				ResourceOwner: "org-id",
+			},
+			AppID:               "app-id",
+			TokenExchangePolicy: policy,
+		}
+	}
+	newChangedEvent := func(wm *OIDCApplicationWriteModel, policy *domain.TokenExchangePolicy) (*project.OIDCConfigChangedEvent, bool, error) {
+		return wm.NewChangedEvent(
+			context.Background(), agg, "app-id",
+			nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil,
+			policy,
+		)
+	}
+
+	t.Run("set policy", func(t *testing.T) {
+		t.Parallel()
+		policy := &domain.TokenExchangePolicy{Audiences: []string{"api"}, DenyNestedActors: true}
+		event, hasChanged, err := newChangedEvent(base(nil), policy)
+		require.NoError(t, err)
+		require.True(t, hasChanged)
+		assert.Equal(t, policy, event.TokenExchangePolicy)
+	})
+
+	t.Run("unchanged policy", func(t *testing.T) {
+		t.Parallel()
+		event, hasChanged, err := newChangedEvent(
+			base(&domain.TokenExchangePolicy{Scopes: []string{"openid"}}),
+			&domain.TokenExchangePolicy{Scopes: []string{"openid"}},
+		)
+		require.NoError(t, err)
+		assert.False(t, hasChanged)
+		assert.Nil(t, event)
+	})
+
+	t.Run("empty policy without existing policy", func(t *testing.T) {
+		t.Parallel()
+		event, hasChanged, err := newChangedEvent(base(nil), &domain.TokenExchangePolicy{})
+		require.NoError(t, err)
+		assert.False(t, hasChanged)
+		assert.Nil(t, event)
+	})
+
+	t.Run("remove restrictions", func(t *testing.T) {
+		t.Parallel()
+		event, hasChanged, err := newChangedEvent(
+			base(&domain.TokenExchangePolicy{SubjectTokenTypes: []string{domain.TokenTypeUserID}}),
+			&domain.TokenExchangePolicy{},
+		)
+		require.NoError(t, err)
+		require.True(t, hasChanged)
+		assert.Equal(t, &domain.TokenExchangePolicy{}, event.TokenExchangePolicy)
+	})
 }
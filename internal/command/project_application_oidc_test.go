@@ -143,6 +143,7 @@ func TestAddOIDCApp(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						}, nil
 					}).
@@ -179,7 +180,7 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						"",
 						"",
-						nil),
+						nil, nil, false, nil),
 				},
 			},
 		},
@@ -214,6 +215,7 @@ func TestAddOIDCApp(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						}, nil
 					}).
@@ -250,7 +252,7 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						"",
 						"",
-						nil),
+						nil, nil, false, nil),
 				},
 			},
 		},
@@ -285,6 +287,7 @@ func TestAddOIDCApp(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						}, nil
 					}).
@@ -321,7 +324,7 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						"",
 						"",
-						nil),
+						nil, nil, false, nil),
 				},
 			},
 		},
@@ -356,6 +359,7 @@ func TestAddOIDCApp(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						}, nil
 					}).
@@ -392,7 +396,7 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						"",
 						"",
-						nil),
+						nil, nil, false, nil),
 				},
 			},
 		},
@@ -483,7 +487,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 				),
@@ -512,7 +516,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 					expectFilter(),
@@ -549,7 +553,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"",
 							"",
 							"",
-							nil),
+							nil, nil, false, nil),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
@@ -576,6 +580,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					ClockSkew:                gu.Ptr(time.Second * 1),
 					AdditionalOrigins:        []string{" https://sub.test.ch "},
 					SkipNativeAppSuccessPage: gu.Ptr(true),
+					RequireConsent:           gu.Ptr(false),
 					BackChannelLogoutURI:     gu.Ptr(" https://test.ch/backchannel "),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr(" https://login.test.ch "),
@@ -607,6 +612,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					ClockSkew:                gu.Ptr(time.Second * 1),
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: gu.Ptr(true),
+					RequireConsent:           gu.Ptr(false),
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
@@ -624,7 +630,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 					expectFilter(),
@@ -661,7 +667,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"",
 							"",
 							"",
-							nil),
+							nil, nil, false, nil),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "client1"),
@@ -689,6 +695,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					ClockSkew:                gu.Ptr(time.Second * 1),
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: gu.Ptr(true),
+					RequireConsent:           gu.Ptr(false),
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
@@ -720,6 +727,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					ClockSkew:                gu.Ptr(time.Second * 1),
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: gu.Ptr(true),
+					RequireConsent:           gu.Ptr(false),
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
@@ -737,7 +745,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 					expectFilter(),
@@ -774,7 +782,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"",
 							"",
 							"",
-							nil),
+							nil, nil, false, nil),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
@@ -801,6 +809,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					ClockSkew:                gu.Ptr(time.Second * 1),
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: gu.Ptr(true),
+					RequireConsent:           gu.Ptr(false),
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
@@ -832,6 +841,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					ClockSkew:                gu.Ptr(time.Second * 1),
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: gu.Ptr(true),
+					RequireConsent:           gu.Ptr(false),
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
@@ -849,7 +859,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 					expectFilter(),
@@ -887,7 +897,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingUnspecified),
+								domain.PrivateLabelingSettingUnspecified, nil),
 						),
 					),
 					expectFilter(),
@@ -1096,7 +1106,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, nil, false, nil),
 						),
 					),
 					expectFilter(),
@@ -1125,6 +1135,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					ClockSkew:                gu.Ptr(time.Second * 1),
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: gu.Ptr(true),
+					RequireConsent:           gu.Ptr(false),
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
@@ -1174,7 +1185,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, nil, false, nil),
 						),
 					),
 					expectFilter(),
@@ -1203,6 +1214,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					ClockSkew:                gu.Ptr(time.Second * 1),
 					AdditionalOrigins:        []string{" https://sub.test.ch "},
 					SkipNativeAppSuccessPage: gu.Ptr(true),
+					RequireConsent:           gu.Ptr(false),
 					BackChannelLogoutURI:     gu.Ptr(" https://test.ch/backchannel "),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr(" https://login.test.ch "),
@@ -1252,7 +1264,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, nil, false, nil),
 						),
 					),
 					expectFilter(),
@@ -1302,6 +1314,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					ClockSkew:                gu.Ptr(time.Second * 1),
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: gu.Ptr(true),
+					RequireConsent:           gu.Ptr(false),
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion1),
 					LoginBaseURI:             gu.Ptr(""),
@@ -1349,7 +1362,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, nil, false, nil),
 						),
 					),
 					expectFilter(),
@@ -1412,7 +1425,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, nil, false, nil),
 						),
 					),
 					expectFilter(),
@@ -1570,7 +1583,7 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, nil, false, nil),
 						),
 					),
 					expectPush(
@@ -1613,6 +1626,7 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 					ClockSkew:                gu.Ptr(time.Second * 1),
 					AdditionalOrigins:        []string{"https://sub.test.ch"},
 					SkipNativeAppSuccessPage: gu.Ptr(false),
+					RequireConsent:           gu.Ptr(false),
 					BackChannelLogoutURI:     gu.Ptr(""),
 					LoginVersion:             gu.Ptr(domain.LoginVersionUnspecified),
 					LoginBaseURI:             gu.Ptr(""),
@@ -121,7 +121,7 @@ func TestCommandSide_ChangeApplicationSecret(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, nil, false, nil),
 						),
 					),
 					expectPush(
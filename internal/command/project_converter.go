@@ -56,6 +56,9 @@ func oidcWriteModelToOIDCConfig(writeModel *OIDCApplicationWriteModel) *domain.O
 		IOSBundleID:                   emptyStringPtr(writeModel.IOSBundleID),
 		AndroidPackageName:            emptyStringPtr(writeModel.AndroidPackageName),
 		AndroidSHA256CertFingerprints: writeModel.AndroidSHA256CertFingerprints,
+		AuthorizationDetailsTypes:     writeModel.AuthorizationDetailsTypes,
+		RequireConsent:                gu.Ptr(writeModel.RequireConsent),
+		TokenExchangePolicy:           writeModel.TokenExchangePolicy,
 	}
 }
 
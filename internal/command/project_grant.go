@@ -23,6 +23,8 @@ type AddProjectGrant struct {
 	GrantID      string
 	GrantedOrgID string
 	RoleKeys     []string
+	// IncludeSubOrgs grants the project to the sub organizations of the granted organization as well
+	IncludeSubOrgs bool
 }
 
 func (p *AddProjectGrant) IsValid() error {
@@ -67,19 +69,56 @@ func (c *Commands) AddProjectGrant(ctx context.Context, grant *AddProjectGrant)
 	if projectResourceOwner != wm.ResourceOwner || wm.ResourceOwner == grant.GrantedOrgID {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "PROJECT-ckUpbvboAH", "Errors.Project.Grant.Invalid")
 	}
-	if err := c.pushAppendAndReduce(ctx,
-		wm,
+	projectAgg := ProjectAggregateFromWriteModelWithCTX(ctx, &wm.WriteModel)
+	cmds := []eventstore.Command{
 		project.NewGrantAddedEvent(ctx,
-			ProjectAggregateFromWriteModelWithCTX(ctx, &wm.WriteModel),
+			projectAgg,
 			grant.GrantID,
 			grant.GrantedOrgID,
 			grant.RoleKeys),
-	); err != nil {
+	}
+	if grant.IncludeSubOrgs {
+		cmds = append(cmds, project.NewGrantSubOrgsChangedEvent(ctx, projectAgg, grant.GrantID, true))
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, cmds...); err != nil {
 		return nil, err
 	}
 	return writeModelToObjectDetails(&wm.WriteModel), nil
 }
 
+// SetProjectGrantSubOrgs defines if the project grant applies to the sub organizations of the granted organization as well.
+func (c *Commands) SetProjectGrantSubOrgs(ctx context.Context, projectID, grantID, grantedOrgID, resourceOwner string, includeSubOrgs bool) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if (grantID == "" && grantedOrgID == "") || projectID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-aiP6e", "Errors.IDMissing")
+	}
+	existingGrant, err := c.projectGrantWriteModelByID(ctx, grantID, grantedOrgID, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGrant.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "PROJECT-Ahd3o", "Errors.Project.Grant.NotFound")
+	}
+	if err := c.checkPermissionUpdateProjectGrant(ctx, existingGrant.ResourceOwner, existingGrant.AggregateID, existingGrant.GrantID); err != nil {
+		return nil, err
+	}
+	if existingGrant.IncludeSubOrgs == includeSubOrgs {
+		return writeModelToObjectDetails(&existingGrant.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx,
+		existingGrant,
+		project.NewGrantSubOrgsChangedEvent(ctx,
+			ProjectAggregateFromWriteModelWithCTX(ctx, &existingGrant.WriteModel),
+			existingGrant.GrantID,
+			includeSubOrgs),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingGrant.WriteModel), nil
+}
+
 type ChangeProjectGrant struct {
 	es_models.ObjectRoot
 
@@ -12,10 +12,11 @@ import (
 type ProjectGrantWriteModel struct {
 	eventstore.WriteModel
 
-	GrantID      string
-	GrantedOrgID string
-	RoleKeys     []string
-	State        domain.ProjectGrantState
+	GrantID        string
+	GrantedOrgID   string
+	RoleKeys       []string
+	IncludeSubOrgs bool
+	State          domain.ProjectGrantState
 
 	FoundGrantID string
 }
@@ -56,6 +57,10 @@ func (wm *ProjectGrantWriteModel) AppendEvents(events ...eventstore.Event) {
 			if projectGrantEqual(wm.FoundGrantID, e.GrantID) {
 				wm.WriteModel.AppendEvents(e)
 			}
+		case *project.GrantSubOrgsChangedEvent:
+			if projectGrantEqual(wm.FoundGrantID, e.GrantID) {
+				wm.WriteModel.AppendEvents(e)
+			}
 		case *project.GrantRemovedEvent:
 			if projectGrantEqual(wm.FoundGrantID, e.GrantID) {
 				wm.FoundGrantID = ""
@@ -85,7 +90,10 @@ func (wm *ProjectGrantWriteModel) Reduce() error {
 			wm.GrantID = e.GrantID
 			wm.GrantedOrgID = e.GrantedOrgID
 			wm.RoleKeys = e.RoleKeys
+			wm.IncludeSubOrgs = false
 			wm.State = domain.ProjectGrantStateActive
+		case *project.GrantSubOrgsChangedEvent:
+			wm.IncludeSubOrgs = e.IncludeSubOrgs
 		case *project.GrantChangedEvent:
 			wm.RoleKeys = e.RoleKeys
 		case *project.GrantCascadeChangedEvent:
@@ -126,6 +134,7 @@ func (wm *ProjectGrantWriteModel) Query() *eventstore.SearchQueryBuilder {
 			project.GrantCascadeChangedType,
 			project.GrantDeactivatedType,
 			project.GrantReactivatedType,
+			project.GrantSubOrgsChangedType,
 			project.GrantRemovedType,
 			project.ProjectRemovedType).
 		Builder()
@@ -90,6 +90,7 @@ func TestCommandSide_AddProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "otherorg").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -135,6 +136,7 @@ func TestCommandSide_AddProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -166,6 +168,7 @@ func TestCommandSide_AddProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -204,6 +207,7 @@ func TestCommandSide_AddProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -250,6 +254,7 @@ func TestCommandSide_AddProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -306,6 +311,7 @@ func TestCommandSide_AddProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -362,6 +368,7 @@ func TestCommandSide_AddProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -540,6 +547,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "otherorg").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -585,6 +593,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -623,6 +632,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -668,6 +678,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -723,6 +734,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -793,6 +805,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -863,6 +876,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -932,6 +946,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -1004,6 +1019,7 @@ func TestCommandSide_ChangeProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -1172,6 +1188,7 @@ func TestCommandSide_DeactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -1199,6 +1216,7 @@ func TestCommandSide_DeactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -1239,6 +1257,7 @@ func TestCommandSide_DeactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -1281,6 +1300,7 @@ func TestCommandSide_DeactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -1414,6 +1434,7 @@ func TestCommandSide_ReactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -1441,6 +1462,7 @@ func TestCommandSide_ReactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -1477,6 +1499,7 @@ func TestCommandSide_ReactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -1523,6 +1546,7 @@ func TestCommandSide_ReactivateProjectGrant(t *testing.T) {
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"projectname1", true, true, true,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -17,6 +17,8 @@ type AddProjectMember struct {
 	ProjectID     string
 	UserID        string
 	Roles         []string
+	// Validity limits the membership to a period of time, nil if it's not limited.
+	Validity *domain.Validity
 }
 
 func (i *AddProjectMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -26,6 +28,9 @@ func (i *AddProjectMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 	if len(domain.CheckForInvalidRoles(i.Roles, domain.ProjectRolePrefix, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "PROJECT-3m9ds", "Errors.Project.Member.Invalid")
 	}
+	if !i.Validity.IsValid() {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Xoo4f", "Errors.Member.ValidityInvalid")
+	}
 	return nil
 }
 
@@ -63,13 +68,12 @@ func (c *Commands) AddProjectMember(ctx context.Context, member *AddProjectMembe
 		return nil, zerrors.ThrowAlreadyExists(nil, "PROJECT-PtXi1", "Errors.Project.Member.AlreadyExists")
 	}
 
-	pushedEvents, err := c.eventstore.Push(ctx,
-		project.NewProjectMemberAddedEvent(ctx,
-			ProjectAggregateFromWriteModelWithCTX(ctx, &addedMember.WriteModel),
-			member.UserID,
-			member.Roles...,
-		),
-	)
+	projectAgg := ProjectAggregateFromWriteModelWithCTX(ctx, &addedMember.WriteModel)
+	cmds := []eventstore.Command{project.NewProjectMemberAddedEvent(ctx, projectAgg, member.UserID, member.Roles...)}
+	if !member.Validity.IsZero() {
+		cmds = append(cmds, project.NewMemberValiditySetEvent(ctx, projectAgg, member.UserID, member.Validity.ValidFrom, member.Validity.ValidUntil))
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +90,8 @@ type ChangeProjectMember struct {
 	ProjectID     string
 	UserID        string
 	Roles         []string
+	// Validity replaces the period of the membership, nil keeps the current one.
+	Validity *domain.Validity
 }
 
 func (i *ChangeProjectMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -95,6 +101,9 @@ func (i *ChangeProjectMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 	if len(domain.CheckForInvalidRoles(i.Roles, domain.ProjectRolePrefix, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "PROJECT-3m9d", "Errors.Project.Member.Invalid")
 	}
+	if !i.Validity.IsValid() {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Eex1u", "Errors.Member.ValidityInvalid")
+	}
 	return nil
 }
 
@@ -114,11 +123,18 @@ func (c *Commands) ChangeProjectMember(ctx context.Context, member *ChangeProjec
 	if err := c.checkPermissionUpdateProjectMember(ctx, existingMember.ResourceOwner, existingMember.AggregateID); err != nil {
 		return nil, err
 	}
-	if slices.Compare(existingMember.Roles, member.Roles) == 0 {
+	projectAgg := ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel)
+	cmds := make([]eventstore.Command, 0, 2)
+	if slices.Compare(existingMember.Roles, member.Roles) != 0 {
+		cmds = append(cmds, project.NewProjectMemberChangedEvent(ctx, projectAgg, member.UserID, member.Roles...))
+	}
+	if existingMember.validityChanged(member.Validity) {
+		cmds = append(cmds, project.NewMemberValiditySetEvent(ctx, projectAgg, member.UserID, member.Validity.ValidFrom, member.Validity.ValidUntil))
+	}
+	if len(cmds) == 0 {
 		return writeModelToObjectDetails(&existingMember.WriteModel), nil
 	}
-	projectAgg := ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel)
-	pushedEvents, err := c.eventstore.Push(ctx, project.NewProjectMemberChangedEvent(ctx, projectAgg, member.UserID, member.Roles...))
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
 		return nil, err
 	}
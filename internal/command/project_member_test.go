@@ -123,6 +123,7 @@ func TestCommandSide_AddProjectMember(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -184,6 +185,7 @@ func TestCommandSide_AddProjectMember(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -245,6 +247,7 @@ func TestCommandSide_AddProjectMember(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
@@ -307,6 +310,7 @@ func TestCommandSide_AddProjectMember(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
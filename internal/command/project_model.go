@@ -17,6 +17,7 @@ type ProjectWriteModel struct {
 	ProjectRoleCheck       bool
 	HasProjectCheck        bool
 	PrivateLabelingSetting domain.PrivateLabelingSetting
+	ResourceURIs           []string
 	State                  domain.ProjectState
 }
 
@@ -38,6 +39,7 @@ func (wm *ProjectWriteModel) Reduce() error {
 			wm.ProjectRoleCheck = e.ProjectRoleCheck
 			wm.HasProjectCheck = e.HasProjectCheck
 			wm.PrivateLabelingSetting = e.PrivateLabelingSetting
+			wm.ResourceURIs = e.ResourceURIs
 			wm.State = domain.ProjectStateActive
 		case *project.ProjectChangeEvent:
 			if e.Name != nil {
@@ -55,6 +57,9 @@ func (wm *ProjectWriteModel) Reduce() error {
 			if e.PrivateLabelingSetting != nil {
 				wm.PrivateLabelingSetting = *e.PrivateLabelingSetting
 			}
+			if e.ResourceURIs != nil {
+				wm.ResourceURIs = *e.ResourceURIs
+			}
 		case *project.ProjectDeactivatedEvent:
 			if wm.State == domain.ProjectStateRemoved {
 				continue
@@ -71,6 +76,7 @@ func (wm *ProjectWriteModel) Reduce() error {
 			wm.ProjectRoleCheck = false
 			wm.HasProjectCheck = false
 			wm.PrivateLabelingSetting = domain.PrivateLabelingSettingUnspecified
+			wm.ResourceURIs = nil
 			wm.State = domain.ProjectStateRemoved
 		}
 	}
@@ -99,6 +105,7 @@ func (wm *ProjectWriteModel) NewChangedEvent(
 	projectRoleCheck,
 	hasProjectCheck *bool,
 	privateLabelingSetting *domain.PrivateLabelingSetting,
+	resourceURIs *[]string,
 ) *project.ProjectChangeEvent {
 	changes := make([]project.ProjectChanges, 0)
 
@@ -119,6 +126,9 @@ func (wm *ProjectWriteModel) NewChangedEvent(
 	if privateLabelingSetting != nil && wm.PrivateLabelingSetting != *privateLabelingSetting {
 		changes = append(changes, project.ChangePrivateLabelingSetting(*privateLabelingSetting))
 	}
+	if resourceURIs != nil && !slices.Equal(wm.ResourceURIs, *resourceURIs) {
+		changes = append(changes, project.ChangeResourceURIs(*resourceURIs))
+	}
 	if len(changes) == 0 {
 		return nil
 	}
@@ -106,7 +106,7 @@ func TestCommandSide_AddProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 				),
@@ -139,6 +139,7 @@ func TestCommandSide_AddProject(t *testing.T) {
 							&project.NewAggregate("project1", "org1").Aggregate,
 							"project", true, true, true,
 							domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+							nil,
 						),
 					),
 				),
@@ -162,6 +163,56 @@ func TestCommandSide_AddProject(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "project, invalid resource uri, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				project: &AddProject{
+					ObjectRoot:   models.ObjectRoot{ResourceOwner: "org1"},
+					Name:         "project",
+					ResourceURIs: []string{"/api"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project, with resource uris, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						project.NewProjectAddedEvent(
+							context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"project", false, false, false,
+							domain.PrivateLabelingSettingUnspecified,
+							[]string{"https://api.example.com"},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "project1"),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				project: &AddProject{
+					ObjectRoot:   models.ObjectRoot{ResourceOwner: "org1"},
+					Name:         "project",
+					ResourceURIs: []string{"https://api.example.com"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 		{
 			name: "project, with id, ok",
 			fields: fields{
@@ -173,6 +224,7 @@ func TestCommandSide_AddProject(t *testing.T) {
 							&project.NewAggregate("project1", "org1").Aggregate,
 							"project", true, true, true,
 							domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+							nil,
 						),
 					),
 				),
@@ -307,7 +359,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
@@ -342,7 +394,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 				),
@@ -377,7 +429,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 				),
@@ -410,7 +462,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					expectPush(
@@ -457,7 +509,7 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					expectPush(
@@ -495,6 +547,67 @@ func TestCommandSide_ChangeProject(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "project change resource uris, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+								[]string{"https://api.example.com"}),
+						),
+					),
+					expectPush(
+						project.NewProjectChangeEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"",
+							[]project.ProjectChanges{
+								project.ChangeResourceURIs([]string{"https://api.example.com", "urn:example:api"}),
+							},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: context.Background(),
+				project: &ChangeProject{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					ResourceURIs: &[]string{"https://api.example.com", "urn:example:api"},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "project change invalid resource uri, invalid error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx: context.Background(),
+				project: &ChangeProject{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					ResourceURIs: &[]string{"https://api.example.com#fragment"},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -577,7 +690,7 @@ func TestCommandSide_DeactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
@@ -607,7 +720,7 @@ func TestCommandSide_DeactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 						eventFromEventPusher(
 							project.NewProjectDeactivatedEvent(context.Background(),
@@ -635,7 +748,7 @@ func TestCommandSide_DeactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					expectPush(
@@ -665,7 +778,7 @@ func TestCommandSide_DeactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 				),
@@ -689,7 +802,7 @@ func TestCommandSide_DeactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					expectPush(
@@ -792,7 +905,7 @@ func TestCommandSide_ReactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
@@ -822,7 +935,7 @@ func TestCommandSide_ReactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 				),
@@ -846,7 +959,7 @@ func TestCommandSide_ReactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 						eventFromEventPusher(
 							project.NewProjectDeactivatedEvent(context.Background(),
@@ -880,7 +993,7 @@ func TestCommandSide_ReactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 						eventFromEventPusher(
 							project.NewProjectDeactivatedEvent(context.Background(),
@@ -908,7 +1021,7 @@ func TestCommandSide_ReactivateProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 						eventFromEventPusher(
 							project.NewProjectDeactivatedEvent(context.Background(),
@@ -1030,7 +1143,7 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
@@ -1060,7 +1173,7 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					// no saml application events
@@ -1094,7 +1207,7 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					expectFilter(
@@ -1147,7 +1260,7 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					expectFilter(
@@ -1306,7 +1419,7 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 						eventFromEventPusher(
 							project.NewProjectRemovedEvent(context.Background(),
@@ -1335,7 +1448,7 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					// no saml application events
@@ -1367,7 +1480,7 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 				),
@@ -1391,7 +1504,7 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					// no saml application events
@@ -1423,7 +1536,7 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					expectFilter(
@@ -1474,7 +1587,7 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 							project.NewProjectAddedEvent(context.Background(),
 								&project.NewAggregate("project1", "org1").Aggregate,
 								"project", true, true, true,
-								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy),
+								domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy, nil),
 						),
 					),
 					expectFilter(
@@ -1653,6 +1766,7 @@ func TestAddProject(t *testing.T) {
 						false,
 						false,
 						domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+						nil,
 					),
 				},
 			},
@@ -55,6 +55,16 @@ func (c *Commands) CreateSAMLSessionFromSAMLRequest(ctx context.Context, samlReq
 	if err = sessionModel.CheckIsActive(); err != nil {
 		return err
 	}
+	loginPolicy, err := activeLoginPolicyWriteModel(ctx, c.eventstore, sessionModel.UserResourceOwner)
+	if err != nil {
+		return err
+	}
+	if err = sessionModel.CheckSessionPolicy(loginPolicy, time.Now()); err != nil {
+		return err
+	}
+	if loginPolicy.SessionMaxLifetime > 0 {
+		samlResponseLifetime = min(samlResponseLifetime, loginPolicy.SessionMaxLifetime)
+	}
 
 	cmd, err := c.newSAMLSessionAddEvents(ctx, sessionModel.UserID, sessionModel.UserResourceOwner)
 	if err != nil {
@@ -202,6 +202,8 @@ func TestCommands_CreateSAMLSessionFromSAMLRequest(t *testing.T) {
 								testNow),
 						),
 					),
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
 					expectFilter(
 						user.NewHumanAddedEvent(
 							context.Background(),
@@ -284,6 +286,8 @@ func TestCommands_CreateSAMLSessionFromSAMLRequest(t *testing.T) {
 								testNow),
 						),
 					),
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
 					expectFilter(
 						user.NewHumanAddedEvent(
 							context.Background(),
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/muhlemmer/gu"
 	"github.com/zitadel/logging"
 	"golang.org/x/text/language"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/idpintent"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -31,6 +33,8 @@ type SessionCommands struct {
 	intentWriteModel  *IDPIntentWriteModel
 	eventstore        *eventstore.Eventstore
 	eventCommands     []eventstore.Command
+	// evictedSessions are pushed together with the eventCommands, but belong to other sessions
+	evictedSessions []eventstore.Command
 
 	hasher               *crypto.Hasher
 	intentAlg            crypto.EncryptionAlgorithm
@@ -43,6 +47,8 @@ type SessionCommands struct {
 	now                  func() time.Time
 	maxIdPIntentLifetime time.Duration
 	tarpit               func(failedAttempts uint64)
+	riskEvaluator        risk.Evaluator
+	deviceEvaluator      risk.Evaluator
 }
 
 func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWriteModel) *SessionCommands {
@@ -61,6 +67,8 @@ func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWri
 		now:                  time.Now,
 		maxIdPIntentLifetime: c.maxIdPIntentLifetime,
 		tarpit:               c.tarpit,
+		riskEvaluator:        c.RiskEvaluator,
+		deviceEvaluator:      c.DeviceEvaluator,
 	}
 }
 
@@ -158,8 +166,95 @@ func (s *SessionCommands) Exec(ctx context.Context) ([]eventstore.Command, error
 	return nil, nil
 }
 
+// EvaluateRisk evaluates the risk of the executed checks, if a risk evaluator is configured.
+// A denied check returns the evaluation event, so it can be stored together with the error.
+func (s *SessionCommands) EvaluateRisk(ctx context.Context) ([]eventstore.Command, error) {
+	if len(s.sessionCommands) == 0 || s.sessionWriteModel.UserID == "" {
+		return nil, nil
+	}
+	evaluator, err := s.evaluator(ctx)
+	if err != nil || evaluator == nil {
+		return nil, err
+	}
+	assessment, err := evaluator.Evaluate(ctx, s.riskRequest())
+	if err != nil {
+		return nil, err
+	}
+	var fingerprintID, ip string
+	if userAgent := s.sessionWriteModel.UserAgent; userAgent != nil {
+		fingerprintID = gu.Value(userAgent.FingerprintID)
+		if len(userAgent.IP) > 0 {
+			ip = userAgent.IP.String()
+		}
+	}
+	event := session.NewRiskEvaluatedEvent(ctx, s.sessionWriteModel.aggregate,
+		s.sessionWriteModel.UserID,
+		s.sessionWriteModel.UserResourceOwner,
+		fingerprintID,
+		ip,
+		assessment.Location,
+		assessment.Score,
+		assessment.Signals,
+		assessment.Outcome,
+		assessment.EvaluatedAt,
+	)
+	if assessment.Outcome == domain.RiskOutcomeDeny {
+		return []eventstore.Command{event}, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ahth4", "Errors.Session.Risk.Denied")
+	}
+	s.eventCommands = append(s.eventCommands, event)
+	return nil, nil
+}
+
+// evaluator returns the risk evaluator if the evaluation is enabled.
+// Otherwise the device evaluator is only returned if the security notifications are enabled for the user,
+// as the detected devices are only used to alert the user about sign-ins from new devices.
+func (s *SessionCommands) evaluator(ctx context.Context) (risk.Evaluator, error) {
+	if s.riskEvaluator != nil || s.deviceEvaluator == nil {
+		return s.riskEvaluator, nil
+	}
+	enabled, err := securityNotificationsEnabled(ctx, s.sessionWriteModel.UserResourceOwner, s.eventstore.FilterToQueryReducer)
+	if err != nil || !enabled {
+		return nil, err
+	}
+	return s.deviceEvaluator, nil
+}
+
+// RecordFailedCheck passes the failed checks to the risk evaluator, if one is configured.
+func (s *SessionCommands) RecordFailedCheck(ctx context.Context) {
+	if s.riskEvaluator == nil || s.sessionWriteModel.UserID == "" {
+		return
+	}
+	s.riskEvaluator.RecordFailure(ctx, s.riskRequest())
+}
+
+func (s *SessionCommands) riskRequest() *risk.Request {
+	return &risk.Request{
+		SessionID:     s.sessionWriteModel.AggregateID,
+		UserID:        s.sessionWriteModel.UserID,
+		ResourceOwner: s.sessionWriteModel.UserResourceOwner,
+		UserAgent:     s.sessionWriteModel.UserAgent,
+	}
+}
+
+// NewDeviceNotificationSent records that the user was alerted about the sign-in from a new device in the session.
+func (c *Commands) NewDeviceNotificationSent(ctx context.Context, sessionID, resourceOwner string) error {
+	sessionWriteModel := NewSessionWriteModel(sessionID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return err
+	}
+	if sessionWriteModel.State == domain.SessionStateUnspecified {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Cah4u", "Errors.Session.NotExisting")
+	}
+	return c.pushAppendAndReduce(ctx, sessionWriteModel,
+		session.NewNewDeviceNotificationSentEvent(ctx, &session.NewAggregate(sessionID, sessionWriteModel.ResourceOwner).Aggregate),
+	)
+}
+
 func (s *SessionCommands) Start(ctx context.Context, userAgent *domain.UserAgent) {
 	s.eventCommands = append(s.eventCommands, session.NewAddedEvent(ctx, s.sessionWriteModel.aggregate, userAgent))
+	// set the user agent so other checks can use it
+	s.sessionWriteModel.UserAgent = userAgent
 }
 
 func (s *SessionCommands) UserChecked(ctx context.Context, userID, resourceOwner string, checkedAt time.Time, preferredLanguage *language.Tag) error {
@@ -218,6 +313,26 @@ func (s *SessionCommands) OTPEmailChecked(ctx context.Context, checkedAt time.Ti
 	s.eventCommands = append(s.eventCommands, session.NewOTPEmailCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
 }
 
+func (s *SessionCommands) MagicLinkChallenged(ctx context.Context, code *crypto.CryptoValue, expiry time.Duration, returnCode bool, urlTmpl string) {
+	s.eventCommands = append(s.eventCommands, session.NewMagicLinkChallengedEvent(ctx, s.sessionWriteModel.aggregate, code, expiry, returnCode, urlTmpl))
+}
+
+func (s *SessionCommands) MagicLinkChecked(ctx context.Context, checkedAt time.Time) {
+	s.eventCommands = append(s.eventCommands, session.NewMagicLinkCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
+}
+
+func (s *SessionCommands) TrustedDeviceChecked(ctx context.Context, checkedAt time.Time) {
+	s.eventCommands = append(s.eventCommands, session.NewTrustedDeviceCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
+}
+
+func (s *SessionCommands) PushChallenged(ctx context.Context, challenge string, number uint32, expiry time.Duration, devices []session.PushChallengeDevice) {
+	s.eventCommands = append(s.eventCommands, session.NewPushChallengedEvent(ctx, s.sessionWriteModel.aggregate, challenge, number, expiry, devices))
+}
+
+func (s *SessionCommands) PushChecked(ctx context.Context, checkedAt time.Time, deviceID string) {
+	s.eventCommands = append(s.eventCommands, session.NewPushCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt, deviceID))
+}
+
 func (s *SessionCommands) RecoveryCodeChecked(ctx context.Context, checkedAt time.Time) {
 	s.eventCommands = append(s.eventCommands, session.NewRecoveryCodeCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
 }
@@ -278,6 +393,11 @@ func (s *SessionCommands) gethumanWriteModel(ctx context.Context) (*HumanWriteMo
 	return humanWriteModel, nil
 }
 
+// loginPolicyWriteModel returns the login policy of the organization and falls back to the default (instance) policy.
+func (s *SessionCommands) loginPolicyWriteModel(ctx context.Context, orgID string) (*LoginPolicyWriteModel, error) {
+	return activeLoginPolicyWriteModel(ctx, s.eventstore, orgID)
+}
+
 func (s *SessionCommands) commands(ctx context.Context) (string, []eventstore.Command, error) {
 	if len(s.eventCommands) == 0 {
 		return "", nil, nil
@@ -374,13 +494,26 @@ func (c *Commands) updateSession(ctx context.Context, checks *SessionCommands, m
 	if err = checks.sessionWriteModel.CheckNotInvalidated(); err != nil {
 		return nil, err
 	}
+	userAdded := checks.sessionWriteModel.UserID == ""
 	if cmds, err := checks.Exec(ctx); err != nil {
+		checks.RecordFailedCheck(ctx)
 		if len(cmds) > 0 {
 			_, pushErr := c.eventstore.Push(ctx, cmds...)
 			logging.OnError(pushErr).Error("unable to store check failures")
 		}
 		return nil, err
 	}
+	if cmds, err := checks.EvaluateRisk(ctx); err != nil {
+		if len(cmds) > 0 {
+			_, pushErr := c.eventstore.Push(ctx, cmds...)
+			logging.OnError(pushErr).Error("unable to store risk evaluation")
+		}
+		return nil, err
+	}
+	lifetime, err = checks.EnforceSessionPolicy(ctx, lifetime, userAdded && checks.sessionWriteModel.UserID != "")
+	if err != nil {
+		return nil, err
+	}
 	checks.ChangeMetadata(ctx, metadata)
 	err = checks.SetLifetime(ctx, lifetime)
 	if err != nil {
@@ -393,11 +526,11 @@ func (c *Commands) updateSession(ctx context.Context, checks *SessionCommands, m
 	if len(cmds) == 0 {
 		return sessionWriteModelToSessionChanged(checks.sessionWriteModel), nil
 	}
-	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	pushedEvents, err := c.eventstore.Push(ctx, append(cmds, checks.evictedSessions...)...)
 	if err != nil {
 		return nil, err
 	}
-	err = AppendAndReduce(checks.sessionWriteModel, pushedEvents...)
+	err = AppendAndReduce(checks.sessionWriteModel, sessionEvents(checks.sessionWriteModel.AggregateID, pushedEvents)...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,123 @@
+package command
+
+import (
+	"context"
+	"io"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CreateMagicLinkChallengeURLTemplate creates a challenge, which will be sent as link to the user's email.
+// The link is created from the urlTmpl, which must therefore point to the login UI.
+func (c *Commands) CreateMagicLinkChallengeURLTemplate(urlTmpl string) (SessionCommand, error) {
+	if urlTmpl == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ieb3O", "Errors.Session.MagicLink.URLTemplateMissing")
+	}
+	if err := domain.RenderMagicLinkURLTemplate(io.Discard, urlTmpl, "code", "userID", "loginName", "displayName", "sessionID", language.English); err != nil {
+		return nil, err
+	}
+	return c.createMagicLinkChallenge(false, urlTmpl, nil), nil
+}
+
+func (c *Commands) CreateMagicLinkChallengeReturnCode(dst *string) SessionCommand {
+	return c.createMagicLinkChallenge(true, "", dst)
+}
+
+// createMagicLinkChallenge creates a single-use code, which is sent to the verified email of the user as part of a link
+// (or returned to the caller). The login policy of the user's organization must allow magic links.
+func (c *Commands) createMagicLinkChallenge(returnCode bool, urlTmpl string, dst *string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohg7e", "Errors.User.UserIDMissing")
+		}
+		emailWriteModel := NewHumanEmailWriteModel(cmd.sessionWriteModel.UserID, "")
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, emailWriteModel); err != nil {
+			return nil, err
+		}
+		if !emailWriteModel.IsEmailVerified {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eif4a", "Errors.Session.MagicLink.EmailNotVerified")
+		}
+		policy, err := cmd.loginPolicyWriteModel(ctx, emailWriteModel.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if !policy.AllowMagicLink {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ua9ie", "Errors.Session.MagicLink.NotAllowed")
+		}
+		code, err := cmd.createCode(ctx, cmd.eventstore.Filter, domain.SecretGeneratorTypeMagicLink, cmd.otpAlg, c.defaultSecretGenerators.MagicLink) //nolint:staticcheck
+		if err != nil {
+			return nil, err
+		}
+		if returnCode {
+			*dst = code.Plain
+		}
+		cmd.MagicLinkChallenged(ctx, code.Crypted, code.Expiry, returnCode, urlTmpl)
+		return nil, nil
+	}
+}
+
+func (c *Commands) MagicLinkSent(ctx context.Context, sessionID, resourceOwner string) error {
+	sessionWriteModel := NewSessionWriteModel(sessionID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return err
+	}
+	if sessionWriteModel.MagicLinkChallenge == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Quie3", "Errors.User.Code.NotFound")
+	}
+	return c.pushAppendAndReduce(ctx, sessionWriteModel,
+		session.NewMagicLinkSentEvent(ctx, &session.NewAggregate(sessionID, sessionWriteModel.ResourceOwner).Aggregate),
+	)
+}
+
+// CheckMagicLink verifies the code of the magic link challenge.
+// The challenge is removed on success, so each link can only be used once.
+// Like OTP checks, failed checks count against the MaxOTPAttempts of the lockout policy
+// and are delayed by the tarpit.
+func CheckMagicLink(code string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		challenge := cmd.sessionWriteModel.MagicLinkChallenge
+		if challenge == nil {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ahT8o", "Errors.Session.MagicLink.NoChallenge")
+		}
+		writeModel := func(ctx context.Context, userID string, resourceOwner string) (OTPCodeWriteModel, error) {
+			magicLinkWriteModel := NewHumanMagicLinkCodeWriteModel(userID, cmd.sessionWriteModel.UserResourceOwner, challenge)
+			if err := cmd.eventstore.FilterToQueryReducer(ctx, magicLinkWriteModel); err != nil {
+				return nil, err
+			}
+			return magicLinkWriteModel, nil
+		}
+		succeededEvent := func(ctx context.Context, aggregate *eventstore.Aggregate, info *user.AuthRequestInfo) eventstore.Command {
+			return user.NewHumanMagicLinkCheckSucceededEvent(ctx, aggregate, nil)
+		}
+		failedEvent := func(ctx context.Context, aggregate *eventstore.Aggregate, info *user.AuthRequestInfo) eventstore.Command {
+			return user.NewHumanMagicLinkCheckFailedEvent(ctx, aggregate, nil)
+		}
+		commands, err := checkOTP(
+			ctx,
+			cmd.sessionWriteModel.UserID,
+			code,
+			"",
+			nil,
+			writeModel,
+			cmd.eventstore.FilterToQueryReducer,
+			cmd.otpAlg,
+			nil, // magic links are always checked locally
+			succeededEvent,
+			failedEvent,
+			cmd.tarpit,
+		)
+		if err != nil {
+			return commands, err
+		}
+		cmd.eventCommands = append(cmd.eventCommands, commands...)
+		cmd.MagicLinkChecked(ctx, cmd.now())
+		return nil, nil
+	}
+}
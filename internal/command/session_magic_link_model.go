@@ -0,0 +1,109 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// HumanMagicLinkCodeWriteModel counts the failed magic link checks of a user,
+// so they can be limited by the MaxOTPAttempts of the lockout policy.
+// The code itself is taken from the challenge of the session.
+type HumanMagicLinkCodeWriteModel struct {
+	eventstore.WriteModel
+
+	code *OTPCode
+
+	checkFailedCount uint64
+	userLocked       bool
+}
+
+func NewHumanMagicLinkCodeWriteModel(userID, resourceOwner string, code *OTPCode) *HumanMagicLinkCodeWriteModel {
+	return &HumanMagicLinkCodeWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		code: code,
+	}
+}
+
+// OTPAdded always returns true, as magic links do not need to be set up by the user.
+func (wm *HumanMagicLinkCodeWriteModel) OTPAdded() bool {
+	return true
+}
+
+func (wm *HumanMagicLinkCodeWriteModel) ResourceOwner() string {
+	return wm.WriteModel.ResourceOwner
+}
+
+func (wm *HumanMagicLinkCodeWriteModel) CodeCreationDate() time.Time {
+	if wm.code == nil {
+		return time.Time{}
+	}
+	return wm.code.CreationDate
+}
+
+func (wm *HumanMagicLinkCodeWriteModel) CodeExpiry() time.Duration {
+	if wm.code == nil {
+		return 0
+	}
+	return wm.code.Expiry
+}
+
+func (wm *HumanMagicLinkCodeWriteModel) Code() *crypto.CryptoValue {
+	if wm.code == nil {
+		return nil
+	}
+	return wm.code.Code
+}
+
+func (wm *HumanMagicLinkCodeWriteModel) CheckFailedCount() uint64 {
+	return wm.checkFailedCount
+}
+
+func (wm *HumanMagicLinkCodeWriteModel) UserLocked() bool {
+	return wm.userLocked
+}
+
+// GeneratorID is always empty, as magic link codes are always checked locally.
+func (wm *HumanMagicLinkCodeWriteModel) GeneratorID() string {
+	return ""
+}
+
+func (wm *HumanMagicLinkCodeWriteModel) ProviderVerificationID() string {
+	return ""
+}
+
+func (wm *HumanMagicLinkCodeWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *user.HumanMagicLinkCheckSucceededEvent:
+			wm.checkFailedCount = 0
+		case *user.HumanMagicLinkCheckFailedEvent:
+			wm.checkFailedCount++
+		case *user.UserLockedEvent:
+			wm.userLocked = true
+		case *user.UserUnlockedEvent:
+			wm.checkFailedCount = 0
+			wm.userLocked = false
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanMagicLinkCodeWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanMagicLinkCheckSucceededType,
+			user.HumanMagicLinkCheckFailedType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+		).
+		Builder()
+}
@@ -0,0 +1,518 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_CreateMagicLinkChallengeURLTemplate(t *testing.T) {
+	tests := []struct {
+		name    string
+		urlTmpl string
+		wantErr error
+	}{
+		{
+			name:    "missing template, invalid argument error",
+			urlTmpl: "",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-ieb3O", "Errors.Session.MagicLink.URLTemplateMissing"),
+		},
+		{
+			name:    "invalid template, invalid argument error",
+			urlTmpl: "https://example.com/magic?code={{.Code",
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-oGh5e", "Errors.User.InvalidURLTemplate"),
+		},
+		{
+			name:    "valid template",
+			urlTmpl: "https://example.com/magic?sessionID={{.SessionID}}&code={{.Code}}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := new(Commands)
+			cmd, err := c.CreateMagicLinkChallengeURLTemplate(tt.urlTmpl)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.NotNil(t, cmd)
+			}
+		})
+	}
+}
+
+func TestCommands_CreateMagicLinkChallengeReturnCode(t *testing.T) {
+	type fields struct {
+		userID     string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		createCode encryptedCodeWithDefaultFunc
+	}
+	type res struct {
+		err        error
+		returnCode string
+		commands   []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "userID missing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohg7e", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "email not verified, precondition error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanEmailChangedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, "email@test.ch"),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eif4a", "Errors.Session.MagicLink.EmailNotVerified"),
+			},
+		},
+		{
+			name: "not allowed by default policy, precondition error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanEmailChangedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, "email@test.ch"),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewLoginPolicyAddedEvent(context.Background(), &instance.NewAggregate("instanceID").Aggregate,
+								true, false, false, false, false, false, false, false, false, false,
+								domain.PasswordlessTypeNotAllowed, "",
+								time.Hour, time.Hour, time.Hour, time.Hour, time.Hour,
+								false, 0, 0, 0, 0,
+								0, 0, 0, nil, nil,
+							),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ua9ie", "Errors.Session.MagicLink.NotAllowed"),
+			},
+		},
+		{
+			name: "generate code",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanEmailChangedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, "email@test.ch"),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								true, false, false, false, false, false, false, false, false, false,
+								domain.PasswordlessTypeNotAllowed, "",
+								time.Hour, time.Hour, time.Hour, time.Hour, time.Hour,
+								true, 0, 0, 0, 0,
+								0, 0, 0, nil, nil,
+							),
+						),
+					),
+				),
+				createCode: mockEncryptedCodeWithDefault("1234567", 5*time.Minute),
+			},
+			res: res{
+				returnCode: "1234567",
+				commands: []eventstore.Command{
+					session.NewMagicLinkChallengedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("1234567"),
+						},
+						5*time.Minute,
+						true,
+						"",
+					),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				// config will not be actively used for the test (is only for default),
+				// but not providing it would result in a nil pointer
+				defaultSecretGenerators: &SecretGenerators{
+					MagicLink: emptyConfig,
+				},
+			}
+			var dst string
+			cmd := c.CreateMagicLinkChallengeReturnCode(&dst)
+
+			sessionModel := &SessionWriteModel{
+				UserID:        tt.fields.userID,
+				UserCheckedAt: testNow,
+				State:         domain.SessionStateActive,
+				aggregate:     &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			cmds := &SessionCommands{
+				sessionCommands:   []SessionCommand{cmd},
+				sessionWriteModel: sessionModel,
+				eventstore:        tt.fields.eventstore(t),
+				createCode:        tt.fields.createCode,
+				now:               time.Now,
+			}
+
+			gotCmds, err := cmd(authz.WithInstanceID(context.Background(), "instanceID"), cmds)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Empty(t, gotCmds)
+			assert.Equal(t, tt.res.returnCode, dst)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
+
+func TestCommands_MagicLinkSent(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		sessionID     string
+		resourceOwner string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "not challenged, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				sessionID:     "sessionID",
+				resourceOwner: "instanceID",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Quie3", "Errors.User.Code.NotFound"),
+		},
+		{
+			name: "challenged and sent",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewMagicLinkChallengedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("1234567"),
+								},
+								5*time.Minute,
+								false,
+								"https://example.com/magic?code={{.Code}}",
+							),
+						),
+					),
+					expectPush(
+						session.NewMagicLinkSentEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				sessionID:     "sessionID",
+				resourceOwner: "instanceID",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.MagicLinkSent(tt.args.ctx, tt.args.sessionID, tt.args.resourceOwner)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCheckMagicLink(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		challenge  *OTPCode
+		otpAlg     crypto.EncryptionAlgorithm
+		tarpit     Tarpit
+	}
+	type res struct {
+		err           error
+		commands      []eventstore.Command
+		errorCommands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		code   string
+		res    res
+	}{
+		{
+			name: "missing challenge",
+			fields: fields{
+				eventstore: expectEventstore(),
+				tarpit:     expectTarpit(0),
+			},
+			code: "code",
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-ahT8o", "Errors.Session.MagicLink.NoChallenge"),
+			},
+		},
+		{
+			name: "missing code",
+			fields: fields{
+				eventstore: expectEventstore(),
+				challenge: &OTPCode{
+					Code: &crypto.CryptoValue{
+						CryptoType: crypto.TypeEncryption,
+						Algorithm:  "enc",
+						KeyID:      "id",
+						Crypted:    []byte("code"),
+					},
+					Expiry:       5 * time.Minute,
+					CreationDate: testNow,
+				},
+				tarpit: expectTarpit(0),
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-SJl2g", "Errors.User.Code.Empty"),
+			},
+		},
+		{
+			name: "expired code",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(), // recheck
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								0, 0, false,
+							),
+						),
+					),
+				),
+				challenge: &OTPCode{
+					Code: &crypto.CryptoValue{
+						CryptoType: crypto.TypeEncryption,
+						Algorithm:  "enc",
+						KeyID:      "id",
+						Crypted:    []byte("code"),
+					},
+					Expiry:       5 * time.Minute,
+					CreationDate: testNow.Add(-10 * time.Minute),
+				},
+				otpAlg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				tarpit: expectTarpit(1),
+			},
+			code: "code",
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "CODE-QvUQ4P", "Errors.User.Code.Expired"),
+				errorCommands: []eventstore.Command{
+					user.NewHumanMagicLinkCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+				},
+			},
+		},
+		{
+			name: "invalid code, locked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanMagicLinkCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+						),
+					),
+					expectFilter(), // recheck
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								0, 2, false,
+							),
+						),
+					),
+				),
+				challenge: &OTPCode{
+					Code: &crypto.CryptoValue{
+						CryptoType: crypto.TypeEncryption,
+						Algorithm:  "enc",
+						KeyID:      "id",
+						Crypted:    []byte("code"),
+					},
+					Expiry:       5 * time.Minute,
+					CreationDate: testNow,
+				},
+				otpAlg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				tarpit: expectTarpit(2),
+			},
+			code: "wrong",
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "CODE-woT0xc", "Errors.User.Code.Invalid"),
+				errorCommands: []eventstore.Command{
+					user.NewHumanMagicLinkCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+					user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+				},
+			},
+		},
+		{
+			name: "user locked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+						),
+					),
+					expectFilter(), // recheck
+				),
+				challenge: &OTPCode{
+					Code: &crypto.CryptoValue{
+						CryptoType: crypto.TypeEncryption,
+						Algorithm:  "enc",
+						KeyID:      "id",
+						Crypted:    []byte("code"),
+					},
+					Expiry:       5 * time.Minute,
+					CreationDate: testNow,
+				},
+				otpAlg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				tarpit: expectTarpit(0),
+			},
+			code: "code",
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-S6h4R", "Errors.User.Locked"),
+			},
+		},
+		{
+			name: "check ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(), // recheck
+				),
+				challenge: &OTPCode{
+					Code: &crypto.CryptoValue{
+						CryptoType: crypto.TypeEncryption,
+						Algorithm:  "enc",
+						KeyID:      "id",
+						Crypted:    []byte("code"),
+					},
+					Expiry:       5 * time.Minute,
+					CreationDate: testNow,
+				},
+				otpAlg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				tarpit: expectTarpit(0),
+			},
+			code: "code",
+			res: res{
+				commands: []eventstore.Command{
+					user.NewHumanMagicLinkCheckSucceededEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
+					session.NewMagicLinkCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						testNow,
+					),
+				},
+			},
+		},
+		{
+			name: "check ok, locked in the meantime",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+					),
+				),
+				challenge: &OTPCode{
+					Code: &crypto.CryptoValue{
+						CryptoType: crypto.TypeEncryption,
+						Algorithm:  "enc",
+						KeyID:      "id",
+						Crypted:    []byte("code"),
+					},
+					Expiry:       5 * time.Minute,
+					CreationDate: testNow,
+				},
+				otpAlg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				tarpit: expectTarpit(0),
+			},
+			code: "code",
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-S6h4R", "Errors.User.Locked"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := CheckMagicLink(tt.code)
+
+			sessionModel := &SessionWriteModel{
+				UserID:             "userID",
+				UserResourceOwner:  "org1",
+				UserCheckedAt:      testNow,
+				State:              domain.SessionStateActive,
+				MagicLinkChallenge: tt.fields.challenge,
+				aggregate:          &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			cmds := &SessionCommands{
+				sessionCommands:   []SessionCommand{cmd},
+				sessionWriteModel: sessionModel,
+				eventstore:        tt.fields.eventstore(t),
+				otpAlg:            tt.fields.otpAlg,
+				now: func() time.Time {
+					return testNow
+				},
+				tarpit: tt.fields.tarpit.tarpit,
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.errorCommands, gotCmds)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package command
 
 import (
+	"slices"
 	"time"
 
 	"golang.org/x/text/language"
@@ -27,6 +28,24 @@ type OTPCode struct {
 	VerificationID string
 }
 
+// PushChallengeModel is the pending approval request sent to the push devices of the user.
+type PushChallengeModel struct {
+	Challenge    string
+	Number       uint32
+	Expiry       time.Duration
+	CreationDate time.Time
+	DeviceIDs    []string
+}
+
+// IsExpired reports whether the challenge can no longer be approved.
+func (p *PushChallengeModel) IsExpired(now time.Time) bool {
+	return !now.Before(p.CreationDate.Add(p.Expiry))
+}
+
+func (p *PushChallengeModel) isChallengedDevice(deviceID string) bool {
+	return slices.Contains(p.DeviceIDs, deviceID)
+}
+
 func (p *WebAuthNChallengeModel) WebAuthNLogin(human *domain.Human, credentialAssertionData []byte) *domain.WebAuthNLogin {
 	return &domain.WebAuthNLogin{
 		ObjectRoot:              human.ObjectRoot,
@@ -41,27 +60,35 @@ func (p *WebAuthNChallengeModel) WebAuthNLogin(human *domain.Human, credentialAs
 type SessionWriteModel struct {
 	eventstore.WriteModel
 
-	TokenID               string
-	UserID                string
-	UserResourceOwner     string
-	PreferredLanguage     *language.Tag
-	UserCheckedAt         time.Time
-	PasswordCheckedAt     time.Time
-	IntentCheckedAt       time.Time
-	WebAuthNCheckedAt     time.Time
-	TOTPCheckedAt         time.Time
-	OTPSMSCheckedAt       time.Time
-	OTPEmailCheckedAt     time.Time
-	RecoveryCodeCheckedAt time.Time
-	WebAuthNUserVerified  bool
-	Metadata              map[string][]byte
-	State                 domain.SessionState
-	UserAgent             *domain.UserAgent
-	Expiration            time.Time
+	TokenID                string
+	UserID                 string
+	UserResourceOwner      string
+	PreferredLanguage      *language.Tag
+	UserCheckedAt          time.Time
+	PasswordCheckedAt      time.Time
+	IntentCheckedAt        time.Time
+	WebAuthNCheckedAt      time.Time
+	TOTPCheckedAt          time.Time
+	OTPSMSCheckedAt        time.Time
+	OTPEmailCheckedAt      time.Time
+	RecoveryCodeCheckedAt  time.Time
+	MagicLinkCheckedAt     time.Time
+	TrustedDeviceCheckedAt time.Time
+	PushCheckedAt          time.Time
+	WebAuthNUserVerified   bool
+	Metadata               map[string][]byte
+	State                  domain.SessionState
+	UserAgent              *domain.UserAgent
+	Expiration             time.Time
+	CreationDate           time.Time
+	RiskScore              int
+	RiskOutcome            domain.RiskOutcome
 
 	WebAuthNChallenge     *WebAuthNChallengeModel
 	OTPSMSCodeChallenge   *OTPCode
 	OTPEmailCodeChallenge *OTPCode
+	MagicLinkChallenge    *OTPCode
+	PushChallenge         *PushChallengeModel
 	aggregate             *eventstore.Aggregate
 }
 
@@ -110,6 +137,18 @@ func (wm *SessionWriteModel) Reduce() error {
 			wm.reduceTerminate()
 		case *session.RecoveryCodeCheckedEvent:
 			wm.reduceRecoveryCodeChecked(e)
+		case *session.MagicLinkChallengedEvent:
+			wm.reduceMagicLinkChallenged(e)
+		case *session.MagicLinkCheckedEvent:
+			wm.reduceMagicLinkChecked(e)
+		case *session.TrustedDeviceCheckedEvent:
+			wm.reduceTrustedDeviceChecked(e)
+		case *session.PushChallengedEvent:
+			wm.reducePushChallenged(e)
+		case *session.PushCheckedEvent:
+			wm.reducePushChecked(e)
+		case *session.RiskEvaluatedEvent:
+			wm.reduceRiskEvaluated(e)
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -134,6 +173,12 @@ func (wm *SessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			session.OTPEmailChallengedType,
 			session.OTPEmailCheckedType,
 			session.RecoveryCodeCheckedType,
+			session.MagicLinkChallengedType,
+			session.MagicLinkCheckedType,
+			session.TrustedDeviceCheckedType,
+			session.PushChallengedType,
+			session.PushCheckedType,
+			session.RiskEvaluatedType,
 			session.TokenSetType,
 			session.MetadataSetType,
 			session.LifetimeSetType,
@@ -149,6 +194,7 @@ func (wm *SessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 
 func (wm *SessionWriteModel) reduceAdded(e *session.AddedEvent) {
 	wm.State = domain.SessionStateActive
+	wm.CreationDate = e.CreationDate()
 	wm.UserAgent = e.UserAgent
 }
 
@@ -218,6 +264,47 @@ func (wm *SessionWriteModel) reduceOTPEmailChecked(e *session.OTPEmailCheckedEve
 	wm.OTPEmailCheckedAt = e.CheckedAt
 }
 
+func (wm *SessionWriteModel) reduceMagicLinkChallenged(e *session.MagicLinkChallengedEvent) {
+	wm.MagicLinkChallenge = &OTPCode{
+		Code:         e.Code,
+		Expiry:       e.Expiry,
+		CreationDate: e.CreationDate(),
+	}
+}
+
+func (wm *SessionWriteModel) reduceMagicLinkChecked(e *session.MagicLinkCheckedEvent) {
+	wm.MagicLinkChallenge = nil
+	wm.MagicLinkCheckedAt = e.CheckedAt
+}
+
+func (wm *SessionWriteModel) reduceTrustedDeviceChecked(e *session.TrustedDeviceCheckedEvent) {
+	wm.TrustedDeviceCheckedAt = e.CheckedAt
+}
+
+func (wm *SessionWriteModel) reducePushChallenged(e *session.PushChallengedEvent) {
+	deviceIDs := make([]string, len(e.Devices))
+	for i, device := range e.Devices {
+		deviceIDs[i] = device.ID
+	}
+	wm.PushChallenge = &PushChallengeModel{
+		Challenge:    e.Challenge,
+		Number:       e.Number,
+		Expiry:       e.Expiry,
+		CreationDate: e.CreationDate(),
+		DeviceIDs:    deviceIDs,
+	}
+}
+
+func (wm *SessionWriteModel) reducePushChecked(e *session.PushCheckedEvent) {
+	wm.PushChallenge = nil
+	wm.PushCheckedAt = e.CheckedAt
+}
+
+func (wm *SessionWriteModel) reduceRiskEvaluated(e *session.RiskEvaluatedEvent) {
+	wm.RiskScore = e.Score
+	wm.RiskOutcome = e.Outcome
+}
+
 func (wm *SessionWriteModel) reduceTokenSet(e *session.TokenSetEvent) {
 	wm.TokenID = e.TokenID
 }
@@ -244,6 +331,9 @@ func (wm *SessionWriteModel) AuthenticationTime() time.Time {
 		wm.IntentCheckedAt,
 		wm.OTPSMSCheckedAt,
 		wm.OTPEmailCheckedAt,
+		wm.MagicLinkCheckedAt,
+		wm.TrustedDeviceCheckedAt,
+		wm.PushCheckedAt,
 	} {
 		if check.After(authTime) {
 			authTime = check
@@ -280,6 +370,15 @@ func (wm *SessionWriteModel) AuthMethodTypes() []domain.UserAuthMethodType {
 	if !wm.RecoveryCodeCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeRecoveryCode)
 	}
+	if !wm.MagicLinkCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeMagicLink)
+	}
+	if !wm.TrustedDeviceCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeTrustedDevice)
+	}
+	if !wm.PushCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypePush)
+	}
 	return types
 }
 
@@ -295,6 +394,36 @@ func (wm *SessionWriteModel) CheckNotInvalidated() error {
 	return nil
 }
 
+// CheckSessionPolicy checks that the session neither exceeded the maximum lifetime
+// nor was unused for longer than the idle timeout defined in the login policy.
+// Every update of the session counts as usage.
+func (wm *SessionWriteModel) CheckSessionPolicy(policy *LoginPolicyWriteModel, now time.Time) error {
+	if policy.SessionMaxLifetime > 0 && !wm.CreationDate.IsZero() && now.After(wm.CreationDate.Add(policy.SessionMaxLifetime)) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ieph1", "Errors.Session.Expired")
+	}
+	if policy.SessionIdleTimeout > 0 && !wm.ChangeDate.IsZero() && now.After(wm.ChangeDate.Add(policy.SessionIdleTimeout)) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooT6a", "Errors.Session.Expired")
+	}
+	return nil
+}
+
+// CheckRiskAccepted checks that the outcome of the latest risk evaluation allows the session to be used for an authentication.
+func (wm *SessionWriteModel) CheckRiskAccepted() error {
+	switch wm.RiskOutcome {
+	case domain.RiskOutcomeDeny:
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-eiZ4u", "Errors.Session.Risk.Denied")
+	case domain.RiskOutcomeRequireMFA:
+		if !domain.HasMFA(wm.AuthMethodTypes()) {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uu9oh", "Errors.Session.Risk.MFARequired")
+		}
+		return nil
+	case domain.RiskOutcomeUnspecified, domain.RiskOutcomeAllow:
+		fallthrough
+	default:
+		return nil
+	}
+}
+
 // CheckIsActive checks that the session was not invalidated ([CheckNotInvalidated]) and actually already exists.
 func (wm *SessionWriteModel) CheckIsActive() error {
 	if wm.State == domain.SessionStateUnspecified {
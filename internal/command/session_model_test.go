@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 func TestSessionWriteModel_AuthMethodTypes(t *testing.T) {
@@ -117,3 +119,112 @@ func TestSessionWriteModel_AuthMethodTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestSessionWriteModel_CheckRiskAccepted(t *testing.T) {
+	tests := []struct {
+		name string
+		wm   *SessionWriteModel
+		want error
+	}{
+		{
+			name: "not evaluated",
+			wm:   &SessionWriteModel{},
+		},
+		{
+			name: "allow",
+			wm:   &SessionWriteModel{RiskOutcome: domain.RiskOutcomeAllow},
+		},
+		{
+			name: "deny",
+			wm:   &SessionWriteModel{RiskOutcome: domain.RiskOutcomeDeny},
+			want: zerrors.ThrowPermissionDenied(nil, "COMMAND-eiZ4u", "Errors.Session.Risk.Denied"),
+		},
+		{
+			name: "require mfa, single factor",
+			wm: &SessionWriteModel{
+				RiskOutcome:       domain.RiskOutcomeRequireMFA,
+				PasswordCheckedAt: testNow,
+			},
+			want: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uu9oh", "Errors.Session.Risk.MFARequired"),
+		},
+		{
+			name: "require mfa, multiple factors",
+			wm: &SessionWriteModel{
+				RiskOutcome:       domain.RiskOutcomeRequireMFA,
+				PasswordCheckedAt: testNow,
+				TOTPCheckedAt:     testNow,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.wm.CheckRiskAccepted(), tt.want)
+		})
+	}
+}
+
+func TestSessionWriteModel_CheckSessionPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		wm     *SessionWriteModel
+		policy *LoginPolicyWriteModel
+		want   error
+	}{
+		{
+			name: "no limits",
+			wm: &SessionWriteModel{
+				WriteModel:   eventstore.WriteModel{ChangeDate: testNow.Add(-48 * time.Hour)},
+				CreationDate: testNow.Add(-48 * time.Hour),
+			},
+			policy: &LoginPolicyWriteModel{},
+		},
+		{
+			name: "new session",
+			wm:   &SessionWriteModel{},
+			policy: &LoginPolicyWriteModel{
+				SessionIdleTimeout: time.Hour,
+				SessionMaxLifetime: time.Hour,
+			},
+		},
+		{
+			name: "within limits",
+			wm: &SessionWriteModel{
+				WriteModel:   eventstore.WriteModel{ChangeDate: testNow.Add(-30 * time.Minute)},
+				CreationDate: testNow.Add(-2 * time.Hour),
+			},
+			policy: &LoginPolicyWriteModel{
+				SessionIdleTimeout: time.Hour,
+				SessionMaxLifetime: 24 * time.Hour,
+			},
+		},
+		{
+			name: "max lifetime exceeded",
+			wm: &SessionWriteModel{
+				WriteModel:   eventstore.WriteModel{ChangeDate: testNow.Add(-time.Minute)},
+				CreationDate: testNow.Add(-25 * time.Hour),
+			},
+			policy: &LoginPolicyWriteModel{
+				SessionIdleTimeout: time.Hour,
+				SessionMaxLifetime: 24 * time.Hour,
+			},
+			want: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ieph1", "Errors.Session.Expired"),
+		},
+		{
+			name: "idle timeout exceeded",
+			wm: &SessionWriteModel{
+				WriteModel:   eventstore.WriteModel{ChangeDate: testNow.Add(-2 * time.Hour)},
+				CreationDate: testNow.Add(-3 * time.Hour),
+			},
+			policy: &LoginPolicyWriteModel{
+				SessionIdleTimeout: time.Hour,
+				SessionMaxLifetime: 24 * time.Hour,
+			},
+			want: zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooT6a", "Errors.Session.Expired"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.wm.CheckSessionPolicy(tt.policy, testNow), tt.want)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+)
+
+// activeLoginPolicyWriteModel returns the login policy of the organization (or inherited from its parents)
+// and falls back to the default (instance) policy.
+func activeLoginPolicyWriteModel(ctx context.Context, es *eventstore.Eventstore, orgID string) (*LoginPolicyWriteModel, error) {
+	orgPolicy, ok, err := filterInheritedOrgPolicy(ctx, es, orgID, NewOrgLoginPolicyWriteModel,
+		func(policy *OrgLoginPolicyWriteModel) bool { return policy.State == domain.PolicyStateActive },
+	)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return &orgPolicy.LoginPolicyWriteModel, nil
+	}
+	instancePolicy := NewInstanceLoginPolicyWriteModel(ctx)
+	if err := es.FilterToQueryReducer(ctx, instancePolicy); err != nil {
+		return nil, err
+	}
+	return &instancePolicy.LoginPolicyWriteModel, nil
+}
+
+// EnforceSessionPolicy applies the session limits of the login policy of the user's organization:
+// It checks the idle timeout and maximum lifetime of the session and limits the requested lifetime accordingly.
+// If the user is set on the session for the first time, the oldest sessions of the user exceeding
+// the maximum number of concurrent sessions are terminated.
+func (s *SessionCommands) EnforceSessionPolicy(ctx context.Context, lifetime time.Duration, userAdded bool) (time.Duration, error) {
+	if s.sessionWriteModel.UserResourceOwner == "" {
+		return lifetime, nil
+	}
+	policy, err := s.loginPolicyWriteModel(ctx, s.sessionWriteModel.UserResourceOwner)
+	if err != nil {
+		return 0, err
+	}
+	now := s.now()
+	if err = s.sessionWriteModel.CheckSessionPolicy(policy, now); err != nil {
+		return 0, err
+	}
+	if policy.SessionMaxLifetime > 0 {
+		created := s.sessionWriteModel.CreationDate
+		if created.IsZero() {
+			created = now
+		}
+		maxExpiration := created.Add(policy.SessionMaxLifetime)
+		// keep a shorter lifetime already set on the session
+		expiration := s.sessionWriteModel.Expiration
+		if lifetime > 0 || expiration.IsZero() || expiration.After(maxExpiration) {
+			lifetime = limitLifetime(lifetime, maxExpiration.Sub(now))
+		}
+	}
+	if userAdded && policy.MaxConcurrentSessions > 0 {
+		if err = s.evictSessions(ctx, policy.MaxConcurrentSessions, policy.SessionIdleTimeout, now); err != nil {
+			return 0, err
+		}
+	}
+	return lifetime, nil
+}
+
+// evictSessions terminates the oldest active sessions of the user,
+// so that the current session does not exceed the maximum number of concurrent sessions.
+// Sessions exceeding the idle timeout are not counted, as they can no longer be used.
+func (s *SessionCommands) evictSessions(ctx context.Context, maxSessions uint64, idleTimeout time.Duration, now time.Time) error {
+	sessionIDs, err := searchUserSessionIDs(ctx, s.eventstore, s.sessionWriteModel.UserID)
+	if err != nil {
+		return err
+	}
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+	wm := NewUserSessionsWriteModel(s.sessionWriteModel.UserID, sessionIDs)
+	if err := s.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return err
+	}
+	active := make([]*UserSessionModel, 0, len(wm.Sessions))
+	for _, sessionModel := range wm.ActiveSessions(now, idleTimeout) {
+		if sessionModel.ID != s.sessionWriteModel.AggregateID {
+			active = append(active, sessionModel)
+		}
+	}
+	// the current session counts as well
+	if uint64(len(active))+1 <= maxSessions {
+		return nil
+	}
+	for _, sessionModel := range active[:uint64(len(active))+1-maxSessions] {
+		s.evictedSessions = append(s.evictedSessions, session.NewTerminateEvent(ctx, &session.NewAggregate(sessionModel.ID, sessionModel.ResourceOwner).Aggregate))
+	}
+	return nil
+}
+
+// searchUserSessionIDs returns the IDs of the (not terminated) sessions of the user
+// using the fields set by the [session.UserCheckedEvent].
+func searchUserSessionIDs(ctx context.Context, es *eventstore.Eventstore, userID string) ([]string, error) {
+	results, err := es.Search(ctx, map[eventstore.FieldType]any{
+		eventstore.FieldTypeAggregateType:  session.AggregateType,
+		eventstore.FieldTypeObjectType:     session.SessionUserSearchType,
+		eventstore.FieldTypeObjectRevision: session.SessionUserObjectRevision,
+		eventstore.FieldTypeFieldName:      session.SessionUserIDSearchField,
+		eventstore.FieldTypeValue:          userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sessionIDs := make([]string, len(results))
+	for i, result := range results {
+		sessionIDs[i] = result.Aggregate.ID
+	}
+	return sessionIDs, nil
+}
+
+// limitTokenLifetimes limits the lifetimes of the tokens by the session limits of the login policy:
+// No token can outlive the maximum session lifetime and the refresh token expires after the idle timeout.
+func limitTokenLifetimes(policy *LoginPolicyWriteModel, accessTokenLifetime, refreshTokenLifetime, refreshTokenIdleLifetime time.Duration) (time.Duration, time.Duration, time.Duration) {
+	if policy.SessionMaxLifetime > 0 {
+		accessTokenLifetime = min(accessTokenLifetime, policy.SessionMaxLifetime)
+		refreshTokenLifetime = min(refreshTokenLifetime, policy.SessionMaxLifetime)
+	}
+	if policy.SessionIdleTimeout > 0 {
+		refreshTokenIdleLifetime = min(refreshTokenIdleLifetime, policy.SessionIdleTimeout)
+	}
+	return accessTokenLifetime, refreshTokenLifetime, refreshTokenIdleLifetime
+}
+
+// sessionEvents returns the events of the session, excluding the ones of evicted sessions.
+func sessionEvents(sessionID string, events []eventstore.Event) []eventstore.Event {
+	sessionEvents := make([]eventstore.Event, 0, len(events))
+	for _, event := range events {
+		if event.Aggregate().ID == sessionID {
+			sessionEvents = append(sessionEvents, event)
+		}
+	}
+	return sessionEvents
+}
+
+// limitLifetime returns the lifetime, limited by the maximum.
+// A lifetime of 0 is unlimited.
+func limitLifetime(lifetime, maxLifetime time.Duration) time.Duration {
+	if lifetime == 0 || lifetime > maxLifetime {
+		return maxLifetime
+	}
+	return lifetime
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_limitTokenLifetimes(t *testing.T) {
+	tests := []struct {
+		name                         string
+		policy                       *LoginPolicyWriteModel
+		wantAccessTokenLifetime      time.Duration
+		wantRefreshTokenLifetime     time.Duration
+		wantRefreshTokenIdleLifetime time.Duration
+	}{
+		{
+			name:                         "no limits",
+			policy:                       &LoginPolicyWriteModel{},
+			wantAccessTokenLifetime:      12 * time.Hour,
+			wantRefreshTokenLifetime:     720 * time.Hour,
+			wantRefreshTokenIdleLifetime: 24 * time.Hour,
+		},
+		{
+			name: "limited",
+			policy: &LoginPolicyWriteModel{
+				SessionIdleTimeout: time.Hour,
+				SessionMaxLifetime: 8 * time.Hour,
+			},
+			wantAccessTokenLifetime:      8 * time.Hour,
+			wantRefreshTokenLifetime:     8 * time.Hour,
+			wantRefreshTokenIdleLifetime: time.Hour,
+		},
+		{
+			name: "longer limits",
+			policy: &LoginPolicyWriteModel{
+				SessionIdleTimeout: 48 * time.Hour,
+				SessionMaxLifetime: 1000 * time.Hour,
+			},
+			wantAccessTokenLifetime:      12 * time.Hour,
+			wantRefreshTokenLifetime:     720 * time.Hour,
+			wantRefreshTokenIdleLifetime: 24 * time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessTokenLifetime, refreshTokenLifetime, refreshTokenIdleLifetime := limitTokenLifetimes(tt.policy, 12*time.Hour, 720*time.Hour, 24*time.Hour)
+			assert.Equal(t, tt.wantAccessTokenLifetime, accessTokenLifetime)
+			assert.Equal(t, tt.wantRefreshTokenLifetime, refreshTokenLifetime)
+			assert.Equal(t, tt.wantRefreshTokenIdleLifetime, refreshTokenIdleLifetime)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CreatePushChallenge creates an approval request, which is sent to all verified push devices of the user.
+// The number, which the user has to confirm on the device, is written to dst to be displayed in the login UI.
+func (c *Commands) CreatePushChallenge(dst *uint32) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ang3o", "Errors.User.UserIDMissing")
+		}
+		devicesModel := NewHumanPushDevicesReadModel(cmd.sessionWriteModel.UserID, "")
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, devicesModel); err != nil {
+			return nil, err
+		}
+		if len(devicesModel.Devices) == 0 {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-eiF6a", "Errors.User.Push.NotReady")
+		}
+		challenge, number, err := c.pushChallengeGenerator()
+		if err != nil {
+			return nil, err
+		}
+		devices := make([]session.PushChallengeDevice, len(devicesModel.Devices))
+		for i, device := range devicesModel.Devices {
+			devices[i] = session.PushChallengeDevice{
+				ID:        device.DeviceID,
+				PushToken: device.PushToken,
+			}
+		}
+		*dst = number
+		cmd.PushChallenged(ctx, challenge, number, c.multifactors.Push.ChallengeExpiry, devices)
+		return nil, nil
+	}
+}
+
+func (c *Commands) PushChallengeSent(ctx context.Context, sessionID, resourceOwner string) error {
+	sessionWriteModel := NewSessionWriteModel(sessionID, resourceOwner)
+	err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return err
+	}
+	if sessionWriteModel.PushChallenge == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-ohB3u", "Errors.Session.Push.NoChallenge")
+	}
+	return c.pushAppendAndReduce(ctx, sessionWriteModel,
+		session.NewPushSentEvent(ctx, &session.NewAggregate(sessionID, sessionWriteModel.ResourceOwner).Aggregate),
+	)
+}
+
+// CheckPush verifies the approval of the push challenge, which was signed by one of the challenged devices.
+// The user must have approved the request and confirmed the number of the challenge.
+// The challenge is removed on success, so each approval can only be used once.
+func CheckPush(signedApproval string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		challenge := cmd.sessionWriteModel.PushChallenge
+		if challenge == nil {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Shae4", "Errors.Session.Push.NoChallenge")
+		}
+		if challenge.IsExpired(cmd.now()) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-aeS7u", "Errors.Session.Push.Expired")
+		}
+		deviceID, err := domain.PushApprovalKeyID(signedApproval)
+		if err != nil {
+			return nil, err
+		}
+		if !challenge.isChallengedDevice(deviceID) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Iej1a", "Errors.User.Push.ApprovalInvalid")
+		}
+		device := NewHumanPushDeviceWriteModel(cmd.sessionWriteModel.UserID, "", deviceID)
+		if err = cmd.eventstore.FilterToQueryReducer(ctx, device); err != nil {
+			return nil, err
+		}
+		if device.State != domain.MFAStateReady {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-gu7Oh", "Errors.User.Push.NotReady")
+		}
+		approval, err := domain.VerifyPushApproval(signedApproval, device.PublicKey, challenge.Challenge)
+		if err != nil {
+			return nil, err
+		}
+		if !approval.Approved {
+			return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ooj6e", "Errors.Session.Push.Denied")
+		}
+		if approval.Number != challenge.Number {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xoh5k", "Errors.Session.Push.NumberMismatch")
+		}
+		cmd.PushChecked(ctx, cmd.now(), deviceID)
+		return nil, nil
+	}
+}
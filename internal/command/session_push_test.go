@@ -0,0 +1,334 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_CreatePushChallenge(t *testing.T) {
+	device := newTestPushDevice(t)
+	userAgg := &user.NewAggregate("userID", "org1").Aggregate
+	type fields struct {
+		userID     string
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type res struct {
+		err      error
+		number   uint32
+		commands []eventstore.Command
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "userID missing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ang3o", "Errors.User.UserIDMissing"),
+			},
+		},
+		{
+			name: "no verified device, precondition error",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+								"device1", "phone", device.publicKey, "token", "challenge"),
+						),
+					),
+				),
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-eiF6a", "Errors.User.Push.NotReady"),
+			},
+		},
+		{
+			name: "challenge verified devices",
+			fields: fields{
+				userID: "userID",
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+								"device1", "phone", device.publicKey, "token1", "challenge"),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceVerifiedEvent(context.Background(), userAgg, "device1"),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+								"device2", "tablet", device.publicKey, "token2", "challenge"),
+						),
+					),
+				),
+			},
+			res: res{
+				number: 42,
+				commands: []eventstore.Command{
+					session.NewPushChallengedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						"challenge", 42, 2*time.Minute,
+						[]session.PushChallengeDevice{{ID: "device1", PushToken: "token1"}},
+					),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				multifactors: domain.MultifactorConfigs{
+					Push: domain.PushConfig{ChallengeExpiry: 2 * time.Minute},
+				},
+				pushChallengeGenerator: mockPushChallenge("challenge", 42),
+			}
+			var number uint32
+			cmd := c.CreatePushChallenge(&number)
+
+			cmds := &SessionCommands{
+				sessionCommands: []SessionCommand{cmd},
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        tt.fields.userID,
+					UserCheckedAt: testNow,
+					State:         domain.SessionStateActive,
+					aggregate:     &session.NewAggregate("sessionID", "instanceID").Aggregate,
+				},
+				eventstore: tt.fields.eventstore(t),
+				now:        time.Now,
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Empty(t, gotCmds)
+			assert.Equal(t, tt.res.number, number)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
+
+func TestCommands_PushChallengeSent(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			name: "not challenged, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-ohB3u", "Errors.Session.Push.NoChallenge"),
+		},
+		{
+			name: "challenged and sent",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						session.NewPushChallengedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+							"challenge", 42, 2*time.Minute,
+							[]session.PushChallengeDevice{{ID: "device1", PushToken: "token1"}},
+						),
+					),
+				),
+				expectPush(
+					session.NewPushSentEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.PushChallengeSent(context.Background(), "sessionID", "instanceID")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCheckPush(t *testing.T) {
+	device := newTestPushDevice(t)
+	userAgg := &user.NewAggregate("userID", "org1").Aggregate
+	verifiedDevice := expectFilter(
+		eventFromEventPusher(
+			user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+				"device1", "phone", device.publicKey, "token", "registration"),
+		),
+		eventFromEventPusher(
+			user.NewHumanPushDeviceVerifiedEvent(context.Background(), userAgg, "device1"),
+		),
+	)
+	challenge := &PushChallengeModel{
+		Challenge:    "challenge",
+		Number:       42,
+		Expiry:       2 * time.Minute,
+		CreationDate: testNow.Add(-time.Minute),
+		DeviceIDs:    []string{"device1"},
+	}
+	type fields struct {
+		eventstore    func(*testing.T) *eventstore.Eventstore
+		pushChallenge *PushChallengeModel
+	}
+	type res struct {
+		err      error
+		commands []eventstore.Command
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		approval string
+		res      res
+	}{
+		{
+			name: "missing challenge",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			approval: device.sign(t, "device1", &domain.PushApproval{Challenge: "challenge", Number: 42, Approved: true}),
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Shae4", "Errors.Session.Push.NoChallenge"),
+			},
+		},
+		{
+			name: "expired challenge",
+			fields: fields{
+				eventstore: expectEventstore(),
+				pushChallenge: &PushChallengeModel{
+					Challenge:    "challenge",
+					Number:       42,
+					Expiry:       2 * time.Minute,
+					CreationDate: testNow.Add(-3 * time.Minute),
+					DeviceIDs:    []string{"device1"},
+				},
+			},
+			approval: device.sign(t, "device1", &domain.PushApproval{Challenge: "challenge", Number: 42, Approved: true}),
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-aeS7u", "Errors.Session.Push.Expired"),
+			},
+		},
+		{
+			name: "device not challenged",
+			fields: fields{
+				eventstore:    expectEventstore(),
+				pushChallenge: challenge,
+			},
+			approval: device.sign(t, "device2", &domain.PushApproval{Challenge: "challenge", Number: 42, Approved: true}),
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Iej1a", "Errors.User.Push.ApprovalInvalid"),
+			},
+		},
+		{
+			name: "device removed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+								"device1", "phone", device.publicKey, "token", "registration"),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceVerifiedEvent(context.Background(), userAgg, "device1"),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceRemovedEvent(context.Background(), userAgg, "device1"),
+						),
+					),
+				),
+				pushChallenge: challenge,
+			},
+			approval: device.sign(t, "device1", &domain.PushApproval{Challenge: "challenge", Number: 42, Approved: true}),
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-gu7Oh", "Errors.User.Push.NotReady"),
+			},
+		},
+		{
+			name: "other challenge",
+			fields: fields{
+				eventstore:    expectEventstore(verifiedDevice),
+				pushChallenge: challenge,
+			},
+			approval: device.sign(t, "device1", &domain.PushApproval{Challenge: "other", Number: 42, Approved: true}),
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "DOMAIN-chai2", "Errors.User.Push.ApprovalInvalid"),
+			},
+		},
+		{
+			name: "denied",
+			fields: fields{
+				eventstore:    expectEventstore(verifiedDevice),
+				pushChallenge: challenge,
+			},
+			approval: device.sign(t, "device1", &domain.PushApproval{Challenge: "challenge", Number: 42, Approved: false}),
+			res: res{
+				err: zerrors.ThrowPermissionDenied(nil, "COMMAND-Ooj6e", "Errors.Session.Push.Denied"),
+			},
+		},
+		{
+			name: "number mismatch",
+			fields: fields{
+				eventstore:    expectEventstore(verifiedDevice),
+				pushChallenge: challenge,
+			},
+			approval: device.sign(t, "device1", &domain.PushApproval{Challenge: "challenge", Number: 24, Approved: true}),
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Xoh5k", "Errors.Session.Push.NumberMismatch"),
+			},
+		},
+		{
+			name: "approved",
+			fields: fields{
+				eventstore:    expectEventstore(verifiedDevice),
+				pushChallenge: challenge,
+			},
+			approval: device.sign(t, "device1", &domain.PushApproval{Challenge: "challenge", Number: 42, Approved: true}),
+			res: res{
+				commands: []eventstore.Command{
+					session.NewPushCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+						testNow, "device1",
+					),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := CheckPush(tt.approval)
+
+			cmds := &SessionCommands{
+				sessionCommands: []SessionCommand{cmd},
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "userID",
+					UserCheckedAt: testNow,
+					State:         domain.SessionStateActive,
+					PushChallenge: tt.fields.pushChallenge,
+					aggregate:     &session.NewAggregate("sessionID", "instanceID").Aggregate,
+				},
+				eventstore: tt.fields.eventstore(t),
+				now: func() time.Time {
+					return testNow
+				},
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Empty(t, gotCmds)
+			assert.Equal(t, tt.res.commands, cmds.eventCommands)
+		})
+	}
+}
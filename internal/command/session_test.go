@@ -22,12 +22,26 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/id/mock"
 	"github.com/zitadel/zitadel/internal/repository/idpintent"
+	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
+func sessionLimitsLoginPolicyEvent(idleTimeout, maxLifetime time.Duration, maxSessions uint64) eventstore.Event {
+	return eventFromEventPusher(
+		org.NewLoginPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+			true, false, false, false, false, false, false, false, false, false,
+			domain.PasswordlessTypeNotAllowed, "",
+			time.Hour, time.Hour, time.Hour, time.Hour, time.Hour,
+			false, 0, idleTimeout, maxLifetime, maxSessions,
+			0, 0, 0, nil, nil,
+		),
+	)
+}
+
 func TestSessionCommands_getHumanWriteModel(t *testing.T) {
 	userAggr := &user.NewAggregate("user1", "org1").Aggregate
 
@@ -420,6 +434,17 @@ func TestCommands_UpdateSession(t *testing.T) {
 	}
 }
 
+type mockRiskEvaluator struct {
+	assessment *domain.RiskAssessment
+	err        error
+}
+
+func (m *mockRiskEvaluator) Evaluate(context.Context, *risk.Request) (*domain.RiskAssessment, error) {
+	return m.assessment, m.err
+}
+
+func (m *mockRiskEvaluator) RecordFailure(context.Context, *risk.Request) {}
+
 func TestCommands_updateSession(t *testing.T) {
 	decryption := func(err error) crypto.EncryptionAlgorithm {
 		mCrypto := crypto.NewMockEncryptionAlgorithm(gomock.NewController(t))
@@ -638,6 +663,8 @@ func TestCommands_updateSession(t *testing.T) {
 						),
 					),
 					expectFilter(), // recheck
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
 					expectPush(
 						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							"userID", "org1", testNow, &language.Afrikaans,
@@ -937,6 +964,8 @@ func TestCommands_updateSession(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
 					expectPush(
 						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							"userID", "org1", testNow, &language.Afrikaans),
@@ -1020,6 +1049,8 @@ func TestCommands_updateSession(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
 					expectPush(
 						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							"userID", "org1", testNow, &language.Afrikaans),
@@ -1060,6 +1091,427 @@ func TestCommands_updateSession(t *testing.T) {
 				},
 			},
 		},
+		{
+			"risk evaluation failed",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "instance1"),
+					sessionCommands: []SessionCommand{
+						CheckUser("userID", "org1", nil),
+					},
+					riskEvaluator: &mockRiskEvaluator{err: zerrors.ThrowInternal(nil, "id", "evaluation failed")},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				err: zerrors.ThrowInternal(nil, "id", "evaluation failed"),
+			},
+		},
+		{
+			"risk denied",
+			fields{
+				eventstore: expectEventstore(
+					expectPush(
+						session.NewRiskEvaluatedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"userID", "org1", "", "", nil, 100, []domain.RiskSignal{domain.RiskSignalImpossibleTravel}, domain.RiskOutcomeDeny, testNow,
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "instance1"),
+					sessionCommands: []SessionCommand{
+						CheckUser("userID", "org1", nil),
+					},
+					riskEvaluator: &mockRiskEvaluator{
+						assessment: &domain.RiskAssessment{
+							Score:       100,
+							Signals:     []domain.RiskSignal{domain.RiskSignalImpossibleTravel},
+							Outcome:     domain.RiskOutcomeDeny,
+							EvaluatedAt: testNow,
+						},
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				err: zerrors.ThrowPermissionDenied(nil, "COMMAND-Ahth4", "Errors.Session.Risk.Denied"),
+			},
+		},
+		{
+			"risk evaluated",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
+					expectPush(
+						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"userID", "org1", testNow, nil,
+						),
+						session.NewRiskEvaluatedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"userID", "org1", "fingerprintID", "192.0.2.1", &domain.GeoLocation{Country: "CH"}, 40, []domain.RiskSignal{domain.RiskSignalNewCountry}, domain.RiskOutcomeRequireMFA, testNow,
+						),
+						session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"tokenID",
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: &SessionWriteModel{
+						WriteModel: eventstore.WriteModel{
+							AggregateID:   "sessionID",
+							ResourceOwner: "instance1",
+						},
+						UserAgent: &domain.UserAgent{
+							FingerprintID: gu.Ptr("fingerprintID"),
+							IP:            net.ParseIP("192.0.2.1"),
+						},
+						aggregate: &session.NewAggregate("sessionID", "instance1").Aggregate,
+					},
+					sessionCommands: []SessionCommand{
+						CheckUser("userID", "org1", nil),
+					},
+					riskEvaluator: &mockRiskEvaluator{
+						assessment: &domain.RiskAssessment{
+							Score:       40,
+							Signals:     []domain.RiskSignal{domain.RiskSignalNewCountry},
+							Outcome:     domain.RiskOutcomeRequireMFA,
+							Location:    &domain.GeoLocation{Country: "CH"},
+							EvaluatedAt: testNow,
+						},
+					},
+					createToken: func(sessionID string) (string, string, error) {
+						return "tokenID",
+							"token",
+							nil
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				want: &SessionChanged{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "instance1",
+					},
+					ID:       "sessionID",
+					NewToken: "token",
+				},
+			},
+		},
+		{
+			"new device detection, security notifications disabled",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(), // org notification policy
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewNotificationPolicyAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								true, false, nil,
+							),
+						),
+					),
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
+					expectPush(
+						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"userID", "org1", testNow, nil,
+						),
+						session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"tokenID",
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: &SessionWriteModel{
+						WriteModel: eventstore.WriteModel{
+							AggregateID:   "sessionID",
+							ResourceOwner: "instance1",
+						},
+						UserAgent: &domain.UserAgent{
+							FingerprintID: gu.Ptr("fingerprintID"),
+							IP:            net.ParseIP("192.0.2.1"),
+						},
+						aggregate: &session.NewAggregate("sessionID", "instance1").Aggregate,
+					},
+					sessionCommands: []SessionCommand{
+						CheckUser("userID", "org1", nil),
+					},
+					deviceEvaluator: &mockRiskEvaluator{
+						assessment: &domain.RiskAssessment{
+							Signals:     []domain.RiskSignal{domain.RiskSignalNewDevice},
+							Outcome:     domain.RiskOutcomeAllow,
+							EvaluatedAt: testNow,
+						},
+					},
+					createToken: func(sessionID string) (string, string, error) {
+						return "tokenID",
+							"token",
+							nil
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				want: &SessionChanged{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "instance1",
+					},
+					ID:       "sessionID",
+					NewToken: "token",
+				},
+			},
+		},
+		{
+			"new device detected",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewNotificationPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+								true, true, nil,
+							),
+						),
+					),
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
+					expectPush(
+						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"userID", "org1", testNow, nil,
+						),
+						session.NewRiskEvaluatedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"userID", "org1", "fingerprintID", "192.0.2.1", nil, 0, []domain.RiskSignal{domain.RiskSignalNewDevice}, domain.RiskOutcomeAllow, testNow,
+						),
+						session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"tokenID",
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: &SessionWriteModel{
+						WriteModel: eventstore.WriteModel{
+							AggregateID:   "sessionID",
+							ResourceOwner: "instance1",
+						},
+						UserAgent: &domain.UserAgent{
+							FingerprintID: gu.Ptr("fingerprintID"),
+							IP:            net.ParseIP("192.0.2.1"),
+						},
+						aggregate: &session.NewAggregate("sessionID", "instance1").Aggregate,
+					},
+					sessionCommands: []SessionCommand{
+						CheckUser("userID", "org1", nil),
+					},
+					deviceEvaluator: &mockRiskEvaluator{
+						assessment: &domain.RiskAssessment{
+							Signals:     []domain.RiskSignal{domain.RiskSignalNewDevice},
+							Outcome:     domain.RiskOutcomeAllow,
+							EvaluatedAt: testNow,
+						},
+					},
+					createToken: func(sessionID string) (string, string, error) {
+						return "tokenID",
+							"token",
+							nil
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				want: &SessionChanged{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "instance1",
+					},
+					ID:       "sessionID",
+					NewToken: "token",
+				},
+			},
+		},
+		{
+			"session idle timeout exceeded",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						sessionLimitsLoginPolicyEvent(time.Hour, 0, 0),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: &SessionWriteModel{
+						WriteModel: eventstore.WriteModel{
+							AggregateID:   "sessionID",
+							ResourceOwner: "instance1",
+							ChangeDate:    testNow.Add(-2 * time.Hour),
+						},
+						UserID:            "userID",
+						UserResourceOwner: "org1",
+						State:             domain.SessionStateActive,
+						aggregate:         &session.NewAggregate("sessionID", "instance1").Aggregate,
+					},
+					sessionCommands: []SessionCommand{},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+				metadata: map[string][]byte{"key": []byte("value")},
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooT6a", "Errors.Session.Expired"),
+			},
+		},
+		{
+			"session lifetime limited by max lifetime",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						sessionLimitsLoginPolicyEvent(0, time.Hour, 0),
+					),
+					expectPush(
+						session.NewLifetimeSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							30*time.Minute,
+						),
+						session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"tokenID",
+						),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: &SessionWriteModel{
+						WriteModel: eventstore.WriteModel{
+							AggregateID:   "sessionID",
+							ResourceOwner: "instance1",
+							ChangeDate:    testNow.Add(-time.Minute),
+						},
+						UserID:            "userID",
+						UserResourceOwner: "org1",
+						State:             domain.SessionStateActive,
+						CreationDate:      testNow.Add(-30 * time.Minute),
+						aggregate:         &session.NewAggregate("sessionID", "instance1").Aggregate,
+					},
+					sessionCommands: []SessionCommand{},
+					createToken: func(sessionID string) (string, string, error) {
+						return "tokenID",
+							"token",
+							nil
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+				lifetime: 2 * time.Hour,
+			},
+			res{
+				want: &SessionChanged{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "instance1",
+					},
+					ID:       "sessionID",
+					NewToken: "token",
+				},
+			},
+		},
+		{
+			"max concurrent sessions, oldest session terminated",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						sessionLimitsLoginPolicyEvent(0, 0, 2),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session1", "instance1").Aggregate,
+								"userID", "org1", testNow, nil),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session2", "instance1").Aggregate,
+								"userID", "org1", testNow, nil),
+						),
+					),
+					expectFilter(
+						eventFromEventPusherWithCreationDate(
+							session.NewAddedEvent(context.Background(), &session.NewAggregate("session1", "instance1").Aggregate, nil),
+							testNow.Add(-time.Hour),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session1", "instance1").Aggregate,
+								"userID", "org1", testNow, nil),
+						),
+						eventFromEventPusherWithCreationDate(
+							session.NewAddedEvent(context.Background(), &session.NewAggregate("session2", "instance1").Aggregate, nil),
+							testNow.Add(-2*time.Hour),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session2", "instance1").Aggregate,
+								"userID", "org1", testNow, nil),
+						),
+					),
+					expectPush(
+						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"userID", "org1", testNow, nil,
+						),
+						session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"tokenID",
+						),
+						session.NewTerminateEvent(context.Background(), &session.NewAggregate("session2", "instance1").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "instance1"),
+					sessionCommands: []SessionCommand{
+						CheckUser("userID", "org1", nil),
+					},
+					createToken: func(sessionID string) (string, string, error) {
+						return "tokenID",
+							"token",
+							nil
+					},
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				want: &SessionChanged{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "instance1",
+					},
+					ID:       "sessionID",
+					NewToken: "token",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1828,3 +2280,45 @@ func TestCommands_TerminateSession(t *testing.T) {
 		})
 	}
 }
+
+func TestCommands_NewDeviceNotificationSent(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		wantErr    error
+	}{
+		{
+			name: "session not existing, not found error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Cah4u", "Errors.Session.NotExisting"),
+		},
+		{
+			name: "sent",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						session.NewAddedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+							&domain.UserAgent{
+								FingerprintID: gu.Ptr("fp1"),
+							},
+						),
+					),
+				),
+				expectPush(
+					session.NewNewDeviceNotificationSentEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.NewDeviceNotificationSent(context.Background(), "sessionID", "instanceID")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// TrustDevice adds the user agent of the session to the trusted devices of the user,
+// so the multi-factor check can be skipped on later logins from the same user agent (see [CheckTrustedDevice]).
+// The session must already be authenticated with multiple factors and
+// the login policy of the user's organization must define a trusted device lifetime.
+func TrustDevice() SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		fingerprintID, policy, err := cmd.trustedDevicePreconditions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// a trusted device must not be used to extend its own trust
+		authMethods := slices.DeleteFunc(cmd.sessionWriteModel.AuthMethodTypes(), func(method domain.UserAuthMethodType) bool {
+			return method == domain.UserAuthMethodTypeTrustedDevice
+		})
+		if !domain.HasMFA(authMethods) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ieg6o", "Errors.Session.TrustedDevice.MFARequired")
+		}
+		var description string
+		if cmd.sessionWriteModel.UserAgent.Description != nil {
+			description = *cmd.sessionWriteModel.UserAgent.Description
+		}
+		cmd.eventCommands = append(cmd.eventCommands,
+			user.NewHumanTrustedDeviceAddedEvent(ctx,
+				&user.NewAggregate(cmd.sessionWriteModel.UserID, cmd.sessionWriteModel.UserResourceOwner).Aggregate,
+				fingerprintID,
+				description,
+				cmd.now().Add(policy.TrustedDeviceLifetime),
+			),
+		)
+		return nil, nil
+	}
+}
+
+// CheckTrustedDevice checks that the user trusts the user agent of the session.
+// The check fails if the trust expired or trusted devices were disabled in the login policy in the meantime.
+func CheckTrustedDevice() SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		fingerprintID, _, err := cmd.trustedDevicePreconditions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		wm := NewHumanTrustedDeviceWriteModel(cmd.sessionWriteModel.UserID, cmd.sessionWriteModel.UserResourceOwner, fingerprintID)
+		if err = cmd.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+			return nil, err
+		}
+		if !wm.IsTrusted(cmd.now()) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Fai3e", "Errors.Session.TrustedDevice.NotTrusted")
+		}
+		cmd.TrustedDeviceChecked(ctx, cmd.now())
+		return nil, nil
+	}
+}
+
+// trustedDevicePreconditions returns the fingerprint of the session's user agent
+// and the login policy of the user, if it allows trusted devices.
+func (s *SessionCommands) trustedDevicePreconditions(ctx context.Context) (string, *LoginPolicyWriteModel, error) {
+	if s.sessionWriteModel.UserID == "" {
+		return "", nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-oo4Sh", "Errors.User.UserIDMissing")
+	}
+	fingerprintID := s.sessionWriteModel.UserAgent.GetFingerprintID()
+	if fingerprintID == "" {
+		return "", nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tah8u", "Errors.Session.TrustedDevice.FingerprintMissing")
+	}
+	policy, err := s.loginPolicyWriteModel(ctx, s.sessionWriteModel.UserResourceOwner)
+	if err != nil {
+		return "", nil, err
+	}
+	if policy.TrustedDeviceLifetime <= 0 {
+		return "", nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-aiL7e", "Errors.Session.TrustedDevice.NotAllowed")
+	}
+	return fingerprintID, policy, nil
+}
@@ -0,0 +1,255 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func trustedDeviceLoginPolicyEvent(trustedDeviceLifetime time.Duration) eventstore.Event {
+	return eventFromEventPusher(
+		org.NewLoginPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
+			true, false, false, false, false, false, false, false, false, false,
+			domain.PasswordlessTypeNotAllowed, "",
+			time.Hour, time.Hour, time.Hour, time.Hour, time.Hour,
+			false, trustedDeviceLifetime, 0, 0, 0,
+			0, 0, 0, nil, nil,
+		),
+	)
+}
+
+func TestTrustDevice(t *testing.T) {
+	type fields struct {
+		eventstore   func(*testing.T) *eventstore.Eventstore
+		userID       string
+		userAgent    *domain.UserAgent
+		checkedTOTP  bool
+		checkedTrust bool
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		wantErr  error
+		commands []eventstore.Command
+	}{
+		{
+			name: "missing userID",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-oo4Sh", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "missing fingerprint",
+			fields: fields{
+				eventstore: expectEventstore(),
+				userID:     "user1",
+				userAgent:  &domain.UserAgent{},
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tah8u", "Errors.Session.TrustedDevice.FingerprintMissing"),
+		},
+		{
+			name: "disabled by default policy",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewLoginPolicyAddedEvent(context.Background(), &instance.NewAggregate("instanceID").Aggregate,
+								true, false, false, false, false, false, false, false, false, false,
+								domain.PasswordlessTypeNotAllowed, "",
+								time.Hour, time.Hour, time.Hour, time.Hour, time.Hour,
+								false, 0, 0, 0, 0,
+								0, 0, 0, nil, nil,
+							),
+						),
+					),
+				),
+				userID:    "user1",
+				userAgent: &domain.UserAgent{FingerprintID: gu.Ptr("fingerprint1")},
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-aiL7e", "Errors.Session.TrustedDevice.NotAllowed"),
+		},
+		{
+			name: "single factor, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(trustedDeviceLoginPolicyEvent(24 * time.Hour)),
+				),
+				userID:    "user1",
+				userAgent: &domain.UserAgent{FingerprintID: gu.Ptr("fingerprint1")},
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ieg6o", "Errors.Session.TrustedDevice.MFARequired"),
+		},
+		{
+			name: "trusted device does not extend itself, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(trustedDeviceLoginPolicyEvent(24 * time.Hour)),
+				),
+				userID:       "user1",
+				userAgent:    &domain.UserAgent{FingerprintID: gu.Ptr("fingerprint1")},
+				checkedTrust: true,
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ieg6o", "Errors.Session.TrustedDevice.MFARequired"),
+		},
+		{
+			name: "trusted",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(trustedDeviceLoginPolicyEvent(24 * time.Hour)),
+				),
+				userID:      "user1",
+				userAgent:   &domain.UserAgent{FingerprintID: gu.Ptr("fingerprint1"), Description: gu.Ptr("Firefox")},
+				checkedTOTP: true,
+			},
+			commands: []eventstore.Command{
+				user.NewHumanTrustedDeviceAddedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+					"fingerprint1", "Firefox", testNow.Add(24*time.Hour),
+				),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := TrustDevice()
+
+			sessionModel := &SessionWriteModel{
+				UserID:            tt.fields.userID,
+				UserResourceOwner: "org1",
+				UserCheckedAt:     testNow,
+				PasswordCheckedAt: testNow,
+				UserAgent:         tt.fields.userAgent,
+				State:             domain.SessionStateActive,
+				aggregate:         &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			if tt.fields.checkedTOTP {
+				sessionModel.TOTPCheckedAt = testNow
+			}
+			if tt.fields.checkedTrust {
+				sessionModel.TrustedDeviceCheckedAt = testNow
+			}
+			cmds := &SessionCommands{
+				sessionCommands:   []SessionCommand{cmd},
+				sessionWriteModel: sessionModel,
+				eventstore:        tt.fields.eventstore(t),
+				now: func() time.Time {
+					return testNow
+				},
+			}
+
+			gotCmds, err := cmd(authz.WithInstanceID(context.Background(), "instanceID"), cmds)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, gotCmds)
+			assert.Equal(t, tt.commands, cmds.eventCommands)
+		})
+	}
+}
+
+func TestCheckTrustedDevice(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		wantErr    error
+		commands   []eventstore.Command
+	}{
+		{
+			name: "not trusted",
+			eventstore: expectEventstore(
+				expectFilter(trustedDeviceLoginPolicyEvent(24*time.Hour)),
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Fai3e", "Errors.Session.TrustedDevice.NotTrusted"),
+		},
+		{
+			name: "trust expired",
+			eventstore: expectEventstore(
+				expectFilter(trustedDeviceLoginPolicyEvent(24*time.Hour)),
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanTrustedDeviceAddedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+							"fingerprint1", "Firefox", testNow.Add(-time.Minute),
+						),
+					),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Fai3e", "Errors.Session.TrustedDevice.NotTrusted"),
+		},
+		{
+			name: "removed",
+			eventstore: expectEventstore(
+				expectFilter(trustedDeviceLoginPolicyEvent(24*time.Hour)),
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanTrustedDeviceAddedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+							"fingerprint1", "Firefox", testNow.Add(time.Hour),
+						),
+					),
+					eventFromEventPusher(
+						user.NewHumanTrustedDeviceRemovedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+							"fingerprint1",
+						),
+					),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Fai3e", "Errors.Session.TrustedDevice.NotTrusted"),
+		},
+		{
+			name: "trusted",
+			eventstore: expectEventstore(
+				expectFilter(trustedDeviceLoginPolicyEvent(24*time.Hour)),
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanTrustedDeviceAddedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+							"fingerprint1", "Firefox", testNow.Add(time.Hour),
+						),
+					),
+				),
+			),
+			commands: []eventstore.Command{
+				session.NewTrustedDeviceCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+					testNow,
+				),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := CheckTrustedDevice()
+
+			sessionModel := &SessionWriteModel{
+				UserID:            "user1",
+				UserResourceOwner: "org1",
+				UserCheckedAt:     testNow,
+				UserAgent:         &domain.UserAgent{FingerprintID: gu.Ptr("fingerprint1")},
+				State:             domain.SessionStateActive,
+				aggregate:         &session.NewAggregate("sessionID", "instanceID").Aggregate,
+			}
+			cmds := &SessionCommands{
+				sessionCommands:   []SessionCommand{cmd},
+				sessionWriteModel: sessionModel,
+				eventstore:        tt.eventstore(t),
+				now: func() time.Time {
+					return testNow
+				},
+			}
+
+			gotCmds, err := cmd(context.Background(), cmds)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, gotCmds)
+			assert.Equal(t, tt.commands, cmds.eventCommands)
+		})
+	}
+}
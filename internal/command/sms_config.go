@@ -269,6 +269,222 @@ func (c *Commands) ChangeSMSConfigHTTP(ctx context.Context, config *ChangeSMSHTT
 	return nil
 }
 
+type AddSMSWhatsApp struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description      string
+	PhoneNumberID    string
+	AccessToken      string
+	TemplateName     string
+	TemplateLanguage string
+}
+
+func (c *Commands) AddSMSConfigWhatsApp(ctx context.Context, config *AddSMSWhatsApp) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ei3ga", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+
+	accessToken, err := crypto.Encrypt([]byte(config.AccessToken), c.smsEncryption)
+	if err != nil {
+		return err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		instance.NewSMSConfigWhatsAppAddedEvent(
+			ctx,
+			InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			config.ID,
+			config.Description,
+			config.PhoneNumberID,
+			accessToken,
+			config.TemplateName,
+			config.TemplateLanguage,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+type ChangeSMSWhatsApp struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description      *string
+	PhoneNumberID    *string
+	AccessToken      *string
+	TemplateName     *string
+	TemplateLanguage *string
+}
+
+func (c *Commands) ChangeSMSConfigWhatsApp(ctx context.Context, config *ChangeSMSWhatsApp) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ooT4a", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Mah7i", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.WhatsApp == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Zei4o", "Errors.SMSConfig.NotFound")
+	}
+
+	var accessToken *crypto.CryptoValue
+	if config.AccessToken != nil && *config.AccessToken != "" {
+		accessToken, err = crypto.Encrypt([]byte(*config.AccessToken), c.smsEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewWhatsAppChangedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+		config.ID,
+		config.Description,
+		config.PhoneNumberID,
+		config.TemplateName,
+		config.TemplateLanguage,
+		accessToken,
+	)
+	if err != nil {
+		return err
+	}
+	if !hasChanged {
+		config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+		return nil
+	}
+	err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+type AddSMSVoice struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description  string
+	SID          string
+	Token        string
+	SenderNumber string
+}
+
+func (c *Commands) AddSMSConfigVoice(ctx context.Context, config *AddSMSVoice) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ohG5u", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+
+	token, err := crypto.Encrypt([]byte(config.Token), c.smsEncryption)
+	if err != nil {
+		return err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		instance.NewSMSConfigVoiceAddedEvent(
+			ctx,
+			InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			config.ID,
+			config.Description,
+			config.SID,
+			config.SenderNumber,
+			token,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+type ChangeSMSVoice struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description  *string
+	SID          *string
+	Token        *string
+	SenderNumber *string
+}
+
+func (c *Commands) ChangeSMSConfigVoice(ctx context.Context, config *ChangeSMSVoice) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-eiS0u", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Phu7o", "Errors.IDMissing")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Voice == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Ahl9u", "Errors.SMSConfig.NotFound")
+	}
+
+	var token *crypto.CryptoValue
+	if config.Token != nil && *config.Token != "" {
+		token, err = crypto.Encrypt([]byte(*config.Token), c.smsEncryption)
+		if err != nil {
+			return err
+		}
+	}
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewVoiceChangedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+		config.ID,
+		config.Description,
+		config.SID,
+		config.SenderNumber,
+		token,
+	)
+	if err != nil {
+		return err
+	}
+	if !hasChanged {
+		config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+		return nil
+	}
+	err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
 func (c *Commands) ActivateSMSConfig(ctx context.Context, resourceOwner, id string) (*domain.ObjectDetails, error) {
 	if resourceOwner == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-EFgoOg997V", "Errors.ResourceOwnerMissing")
@@ -293,6 +509,7 @@ func (c *Commands) ActivateSMSConfig(ctx context.Context, resourceOwner, id stri
 			ctx,
 			InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
 			id,
+			smsConfigWriteModel.Channel,
 		),
 	)
 	if err != nil {
@@ -370,9 +587,9 @@ func (c *Commands) getSMSConfig(ctx context.Context, instanceID, id string) (_ *
 	return writeModel, nil
 }
 
-// getActiveSMSConfig returns the last activated SMS configuration
+// getActiveSMSConfig returns the last activated configuration of the SMS channel
 func (c *Commands) getActiveSMSConfig(ctx context.Context, instanceID string) (_ *IAMSMSConfigWriteModel, err error) {
-	writeModel := NewIAMSMSLastActivatedConfigWriteModel(instanceID)
+	writeModel := NewIAMSMSLastActivatedConfigWriteModel(instanceID, domain.PhoneChannelSMS)
 	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
 	if err != nil {
 		return nil, err
@@ -16,6 +16,9 @@ type IAMSMSConfigWriteModel struct {
 	Description string
 	Twilio      *TwilioConfig
 	HTTP        *HTTPConfig
+	WhatsApp    *WhatsAppConfig
+	Voice       *VoiceConfig
+	Channel     domain.PhoneChannel
 	State       domain.SMSConfigState
 }
 
@@ -31,6 +34,19 @@ type HTTPConfig struct {
 	SigningKey *crypto.CryptoValue
 }
 
+type WhatsAppConfig struct {
+	PhoneNumberID    string
+	AccessToken      *crypto.CryptoValue
+	TemplateName     string
+	TemplateLanguage string
+}
+
+type VoiceConfig struct {
+	SID          string
+	Token        *crypto.CryptoValue
+	SenderNumber string
+}
+
 func NewIAMSMSConfigWriteModel(instanceID, id string) *IAMSMSConfigWriteModel {
 	return &IAMSMSConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -56,6 +72,7 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				VerifyServiceSID: e.VerifyServiceSID,
 			}
 			wm.Description = e.Description
+			wm.Channel = domain.PhoneChannelSMS
 			wm.State = domain.SMSConfigStateInactive
 		case *instance.SMSConfigTwilioChangedEvent:
 			if wm.ID != e.ID {
@@ -87,6 +104,7 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 				SigningKey: e.SigningKey,
 			}
 			wm.Description = e.Description
+			wm.Channel = domain.PhoneChannelSMS
 			wm.State = domain.SMSConfigStateInactive
 		case *instance.SMSConfigHTTPChangedEvent:
 			if wm.ID != e.ID {
@@ -101,9 +119,71 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			if e.SigningKey != nil {
 				wm.HTTP.SigningKey = e.SigningKey
 			}
+		case *instance.SMSConfigWhatsAppAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.WhatsApp = &WhatsAppConfig{
+				PhoneNumberID:    e.PhoneNumberID,
+				AccessToken:      e.AccessToken,
+				TemplateName:     e.TemplateName,
+				TemplateLanguage: e.TemplateLanguage,
+			}
+			wm.Description = e.Description
+			wm.Channel = domain.PhoneChannelWhatsApp
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigWhatsAppChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.PhoneNumberID != nil {
+				wm.WhatsApp.PhoneNumberID = *e.PhoneNumberID
+			}
+			if e.AccessToken != nil {
+				wm.WhatsApp.AccessToken = e.AccessToken
+			}
+			if e.TemplateName != nil {
+				wm.WhatsApp.TemplateName = *e.TemplateName
+			}
+			if e.TemplateLanguage != nil {
+				wm.WhatsApp.TemplateLanguage = *e.TemplateLanguage
+			}
+		case *instance.SMSConfigVoiceAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Voice = &VoiceConfig{
+				SID:          e.SID,
+				Token:        e.Token,
+				SenderNumber: e.SenderNumber,
+			}
+			wm.Description = e.Description
+			wm.Channel = domain.PhoneChannelVoice
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigVoiceChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.SID != nil {
+				wm.Voice.SID = *e.SID
+			}
+			if e.Token != nil {
+				wm.Voice.Token = e.Token
+			}
+			if e.SenderNumber != nil {
+				wm.Voice.SenderNumber = *e.SenderNumber
+			}
 		case *instance.SMSConfigTwilioActivatedEvent:
 			if wm.ID != e.ID {
-				wm.State = domain.SMSConfigStateInactive
+				if wm.Channel == domain.PhoneChannelSMS {
+					wm.State = domain.SMSConfigStateInactive
+				}
 				continue
 			}
 			wm.State = domain.SMSConfigStateActive
@@ -118,10 +198,15 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			}
 			wm.Twilio = nil
 			wm.HTTP = nil
+			wm.WhatsApp = nil
+			wm.Voice = nil
 			wm.State = domain.SMSConfigStateRemoved
 		case *instance.SMSConfigActivatedEvent:
 			if wm.ID != e.ID {
-				wm.State = domain.SMSConfigStateInactive
+				// only one config per channel can be active
+				if wm.Channel == e.Channel.OrSMS() {
+					wm.State = domain.SMSConfigStateInactive
+				}
 				continue
 			}
 			wm.State = domain.SMSConfigStateActive
@@ -136,6 +221,8 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			}
 			wm.Twilio = nil
 			wm.HTTP = nil
+			wm.WhatsApp = nil
+			wm.Voice = nil
 			wm.State = domain.SMSConfigStateRemoved
 		}
 	}
@@ -154,6 +241,10 @@ func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMSConfigTwilioTokenChangedEventType,
 			instance.SMSConfigHTTPAddedEventType,
 			instance.SMSConfigHTTPChangedEventType,
+			instance.SMSConfigWhatsAppAddedEventType,
+			instance.SMSConfigWhatsAppChangedEventType,
+			instance.SMSConfigVoiceAddedEventType,
+			instance.SMSConfigVoiceChangedEventType,
 			instance.SMSConfigTwilioActivatedEventType,
 			instance.SMSConfigTwilioDeactivatedEventType,
 			instance.SMSConfigTwilioRemovedEventType,
@@ -229,19 +320,98 @@ func (wm *IAMSMSConfigWriteModel) NewHTTPChangedEvent(
 	return changeEvent, true, nil
 }
 
+func (wm *IAMSMSConfigWriteModel) NewWhatsAppChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	description, phoneNumberID, templateName, templateLanguage *string,
+	accessToken *crypto.CryptoValue,
+) (*instance.SMSConfigWhatsAppChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigWhatsAppChanges, 0)
+
+	if wm.WhatsApp == nil {
+		return nil, false, nil
+	}
+
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigWhatsAppDescription(*description))
+	}
+	if phoneNumberID != nil && wm.WhatsApp.PhoneNumberID != *phoneNumberID {
+		changes = append(changes, instance.ChangeSMSConfigWhatsAppPhoneNumberID(*phoneNumberID))
+	}
+	if templateName != nil && wm.WhatsApp.TemplateName != *templateName {
+		changes = append(changes, instance.ChangeSMSConfigWhatsAppTemplateName(*templateName))
+	}
+	if templateLanguage != nil && wm.WhatsApp.TemplateLanguage != *templateLanguage {
+		changes = append(changes, instance.ChangeSMSConfigWhatsAppTemplateLanguage(*templateLanguage))
+	}
+	// if the access token is set, update it as it is encrypted
+	if accessToken != nil {
+		changes = append(changes, instance.ChangeSMSConfigWhatsAppAccessToken(accessToken))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigWhatsAppChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewVoiceChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	description, sid, senderNumber *string,
+	token *crypto.CryptoValue,
+) (*instance.SMSConfigVoiceChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigVoiceChanges, 0)
+
+	if wm.Voice == nil {
+		return nil, false, nil
+	}
+
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigVoiceDescription(*description))
+	}
+	if sid != nil && wm.Voice.SID != *sid {
+		changes = append(changes, instance.ChangeSMSConfigVoiceSID(*sid))
+	}
+	if senderNumber != nil && wm.Voice.SenderNumber != *senderNumber {
+		changes = append(changes, instance.ChangeSMSConfigVoiceSenderNumber(*senderNumber))
+	}
+	// if the token is set, update it as it is encrypted
+	if token != nil {
+		changes = append(changes, instance.ChangeSMSConfigVoiceToken(token))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigVoiceChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
 type IAMSMSLastActivatedConfigWriteModel struct {
 	eventstore.WriteModel
 
+	channel  domain.PhoneChannel
 	activeID string
 }
 
-func NewIAMSMSLastActivatedConfigWriteModel(instanceID string) *IAMSMSLastActivatedConfigWriteModel {
+func NewIAMSMSLastActivatedConfigWriteModel(instanceID string, channel domain.PhoneChannel) *IAMSMSLastActivatedConfigWriteModel {
 	return &IAMSMSLastActivatedConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
 			AggregateID:   instanceID,
 			ResourceOwner: instanceID,
 			InstanceID:    instanceID,
 		},
+		channel: channel.OrSMS(),
 	}
 }
 
@@ -249,19 +419,24 @@ func (wm *IAMSMSLastActivatedConfigWriteModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
 		case *instance.SMSConfigActivatedEvent:
+			if e.Channel.OrSMS() != wm.channel {
+				continue
+			}
 			wm.activeID = e.ID
 		case *instance.SMSConfigTwilioActivatedEvent:
+			if wm.channel != domain.PhoneChannelSMS {
+				continue
+			}
 			wm.activeID = e.ID
 		}
 	}
 	return wm.WriteModel.Reduce()
 }
 
+// Query returns all activations, as the channel is only part of the payload.
 func (wm *IAMSMSLastActivatedConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
 		ResourceOwner(wm.ResourceOwner).
-		OrderDesc().
-		Limit(1).
 		AddQuery().
 		AggregateTypes(instance.AggregateType).
 		AggregateIDs(wm.AggregateID).
@@ -745,6 +745,7 @@ func TestCommandSide_ActivateSMSConfig(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								"providerid",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -784,6 +785,7 @@ func TestCommandSide_ActivateSMSConfig(t *testing.T) {
 							context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							"providerid",
+							domain.PhoneChannelSMS,
 						),
 					),
 				),
@@ -825,6 +827,54 @@ func TestCommandSide_ActivateSMSConfig(t *testing.T) {
 							context.Background(),
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							"providerid",
+							domain.PhoneChannelSMS,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:        context.Background(),
+				instanceID: "INSTANCE",
+				id:         "providerid",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "sms config whatsapp activate with active sms config, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigWhatsAppAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"phoneNumberID",
+								&crypto.CryptoValue{},
+								"otp_code",
+								"en",
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMSConfigActivatedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"smsproviderid",
+								domain.PhoneChannelUnspecified,
+							),
+						),
+					),
+					expectPush(
+						instance.NewSMSConfigActivatedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							domain.PhoneChannelWhatsApp,
 						),
 					),
 				),
@@ -947,6 +997,7 @@ func TestCommandSide_DeactivateSMSConfig(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								"providerid",
+								domain.PhoneChannelSMS,
 							),
 						),
 						eventFromEventPusher(
@@ -992,6 +1043,7 @@ func TestCommandSide_DeactivateSMSConfig(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								"providerid",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1040,6 +1092,7 @@ func TestCommandSide_DeactivateSMSConfig(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								"providerid",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1276,3 +1329,493 @@ func newSMSConfigHTTPChangedEvent(ctx context.Context, id, endpoint, description
 	)
 	return event
 }
+
+func TestCommandSide_AddSMSConfigWhatsApp(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		sms *AddSMSWhatsApp
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "add sms config whatsapp, missing resourceowner",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSWhatsApp{},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ei3ga", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "add sms config whatsapp, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewSMSConfigWhatsAppAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							"description",
+							"phoneNumberID",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("accessToken"),
+							},
+							"otp_code",
+							"en",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSWhatsApp{
+					ResourceOwner:    "INSTANCE",
+					Description:      "description",
+					PhoneNumberID:    "phoneNumberID",
+					AccessToken:      "accessToken",
+					TemplateName:     "otp_code",
+					TemplateLanguage: "en",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: tt.fields.alg,
+			}
+			err := r.AddSMSConfigWhatsApp(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigWhatsApp(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		sms *ChangeSMSWhatsApp
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id empty, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSWhatsApp{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Mah7i", "Errors.IDMissing"))
+				},
+			},
+		},
+		{
+			name: "sms config of other type, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigTwilioAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"sid",
+								"senderName",
+								&crypto.CryptoValue{},
+								"",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSWhatsApp{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Zei4o", "Errors.SMSConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "no changes",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigWhatsAppAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"phoneNumberID",
+								&crypto.CryptoValue{},
+								"otp_code",
+								"en",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSWhatsApp{
+					ResourceOwner:    "INSTANCE",
+					ID:               "providerid",
+					Description:      gu.Ptr("description"),
+					PhoneNumberID:    gu.Ptr("phoneNumberID"),
+					AccessToken:      gu.Ptr(""),
+					TemplateName:     gu.Ptr("otp_code"),
+					TemplateLanguage: gu.Ptr("en"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "sms config whatsapp change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigWhatsAppAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"phoneNumberID",
+								&crypto.CryptoValue{},
+								"otp_code",
+								"en",
+							),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := instance.NewSMSConfigWhatsAppChangedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								[]instance.SMSConfigWhatsAppChanges{
+									instance.ChangeSMSConfigWhatsAppTemplateName("otp_code2"),
+									instance.ChangeSMSConfigWhatsAppTemplateLanguage("de"),
+									instance.ChangeSMSConfigWhatsAppAccessToken(&crypto.CryptoValue{
+										CryptoType: crypto.TypeEncryption,
+										Algorithm:  "enc",
+										KeyID:      "id",
+										Crypted:    []byte("accessToken2"),
+									}),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSWhatsApp{
+					ResourceOwner:    "INSTANCE",
+					ID:               "providerid",
+					AccessToken:      gu.Ptr("accessToken2"),
+					TemplateName:     gu.Ptr("otp_code2"),
+					TemplateLanguage: gu.Ptr("de"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				smsEncryption: tt.fields.alg,
+			}
+			err := r.ChangeSMSConfigWhatsApp(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_AddSMSConfigVoice(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		sms *AddSMSVoice
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "add sms config voice, missing resourceowner",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSVoice{},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-ohG5u", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "add sms config voice, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewSMSConfigVoiceAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							"description",
+							"sid",
+							"+41710000000",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("token"),
+							},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSVoice{
+					ResourceOwner: "INSTANCE",
+					Description:   "description",
+					SID:           "sid",
+					Token:         "token",
+					SenderNumber:  "+41710000000",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: tt.fields.alg,
+			}
+			err := r.AddSMSConfigVoice(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigVoice(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		sms *ChangeSMSVoice
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "sms not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSVoice{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Ahl9u", "Errors.SMSConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "sms config voice change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigVoiceAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"sid",
+								"+41710000000",
+								&crypto.CryptoValue{},
+							),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							event, _ := instance.NewSMSConfigVoiceChangedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								[]instance.SMSConfigVoiceChanges{
+									instance.ChangeSMSConfigVoiceDescription("description2"),
+									instance.ChangeSMSConfigVoiceSenderNumber("+41710000001"),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSVoice{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+					Description:   gu.Ptr("description2"),
+					SID:           gu.Ptr("sid"),
+					SenderNumber:  gu.Ptr("+41710000001"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.ChangeSMSConfigVoice(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
@@ -189,7 +189,23 @@ func (c *Commands) RemoveUser(ctx context.Context, userID, resourceOwner string,
 	if !isUserStateExists(existingUser.UserState) {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-m9od", "Errors.User.NotFound")
 	}
+	events, err := c.removeUserEvents(ctx, existingUser, cascadingUserMemberships, cascadingGrantIDs, cascadingGroupIDs)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingUser, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingUser.WriteModel), nil
+}
 
+// removeUserEvents returns the events to remove the user including the cascading memberships, grants and groups.
+func (c *Commands) removeUserEvents(ctx context.Context, existingUser *UserWriteModel, cascadingUserMemberships []*CascadingMembership, cascadingGrantIDs, cascadingGroupIDs []string) ([]eventstore.Command, error) {
 	domainPolicy, err := c.domainPolicyWriteModel(ctx, existingUser.ResourceOwner)
 	if err != nil {
 		return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-3M9fs", "Errors.Org.DomainPolicy.NotExisting")
@@ -221,22 +237,13 @@ func (c *Commands) RemoveUser(ctx context.Context, userID, resourceOwner string,
 
 	// remove user from user groups
 	if len(cascadingGroupIDs) > 0 {
-		groupUserEvents, err := c.removeUserFromGroups(ctx, userID, cascadingGroupIDs, resourceOwner)
+		groupUserEvents, err := c.removeUserFromGroups(ctx, existingUser.AggregateID, cascadingGroupIDs, existingUser.ResourceOwner)
 		if err != nil {
 			return nil, err
 		}
 		events = append(events, groupUserEvents...)
 	}
-
-	pushedEvents, err := c.eventstore.Push(ctx, events...)
-	if err != nil {
-		return nil, err
-	}
-	err = AppendAndReduce(existingUser, pushedEvents...)
-	if err != nil {
-		return nil, err
-	}
-	return writeModelToObjectDetails(&existingUser.WriteModel), nil
+	return events, nil
 }
 
 func (c *Commands) RevokeAccessToken(ctx context.Context, userID, orgID, tokenID string) (*domain.ObjectDetails, error) {
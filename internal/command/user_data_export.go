@@ -0,0 +1,117 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RequestUserDataExport requests an archive of all data held about the user.
+// The archive is built by a background job, the returned id can be used to retrieve it.
+// Users are allowed to export their own data.
+func (c *Commands) RequestUserDataExport(ctx context.Context, userID string) (_ string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeth4", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, "")
+	if err != nil {
+		return "", nil, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return "", nil, zerrors.ThrowNotFound(nil, "COMMAND-Iej4o", "Errors.User.NotFound")
+	}
+	if err = c.checkPermissionOnUser(ctx, domain.PermissionUserRead, true)(existingUser.ResourceOwner, userID); err != nil {
+		return "", nil, err
+	}
+	exportID, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	writeModel := NewUserDataExportWriteModel(userID, existingUser.ResourceOwner, exportID)
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		user.NewDataExportRequestedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel), exportID),
+	); err != nil {
+		return "", nil, err
+	}
+	return exportID, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// UserDataExportSucceeded records that the archive of the export was stored.
+func (c *Commands) UserDataExportSucceeded(ctx context.Context, userID, resourceOwner, exportID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.requestedUserDataExport(ctx, userID, resourceOwner, exportID)
+	if err != nil {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx,
+		user.NewDataExportSucceededEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), exportID),
+	)
+	return err
+}
+
+// UserDataExportFailed records that the archive of the export could not be built.
+func (c *Commands) UserDataExportFailed(ctx context.Context, userID, resourceOwner, exportID string, exportErr error) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.requestedUserDataExport(ctx, userID, resourceOwner, exportID)
+	if err != nil {
+		return err
+	}
+	var reason string
+	if exportErr != nil {
+		reason = exportErr.Error()
+	}
+	_, err = c.eventstore.Push(ctx,
+		user.NewDataExportFailedEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), exportID, reason),
+	)
+	return err
+}
+
+// UserDataExportExpired records that the archive of the export was deleted after its lifetime.
+// Exports which weren't stored or whose user was removed in the meantime are ignored.
+func (c *Commands) UserDataExportExpired(ctx context.Context, userID, resourceOwner, exportID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || exportID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Eiz7o", "Errors.IDMissing")
+	}
+	writeModel := NewUserDataExportWriteModel(userID, resourceOwner, exportID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+	if writeModel.State != domain.UserDataExportStateSucceeded || writeModel.UserRemoved {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx,
+		user.NewDataExportExpiredEvent(ctx, UserAggregateFromWriteModel(&writeModel.WriteModel), exportID),
+	)
+	return err
+}
+
+// requestedUserDataExport returns the export, which must be requested and not yet finished.
+func (c *Commands) requestedUserDataExport(ctx context.Context, userID, resourceOwner, exportID string) (*UserDataExportWriteModel, error) {
+	if userID == "" || exportID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooL5u", "Errors.IDMissing")
+	}
+	writeModel := NewUserDataExportWriteModel(userID, resourceOwner, exportID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State == domain.UserDataExportStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Chei0", "Errors.User.DataExport.NotFound")
+	}
+	if writeModel.State.IsFinal() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ahgh8", "Errors.User.DataExport.AlreadyFinished")
+	}
+	return writeModel, nil
+}
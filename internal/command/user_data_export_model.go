@@ -0,0 +1,73 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type UserDataExportWriteModel struct {
+	eventstore.WriteModel
+
+	ExportID    string
+	State       domain.UserDataExportState
+	UserRemoved bool
+}
+
+func NewUserDataExportWriteModel(userID, resourceOwner, exportID string) *UserDataExportWriteModel {
+	return &UserDataExportWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		ExportID: exportID,
+	}
+}
+
+func (wm *UserDataExportWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.DataExportRequestedEvent:
+			if e.ExportID == wm.ExportID {
+				wm.State = domain.UserDataExportStateRequested
+			}
+		case *user.DataExportSucceededEvent:
+			if e.ExportID == wm.ExportID {
+				wm.State = domain.UserDataExportStateSucceeded
+			}
+		case *user.DataExportFailedEvent:
+			if e.ExportID == wm.ExportID {
+				wm.State = domain.UserDataExportStateFailed
+			}
+		case *user.DataExportExpiredEvent:
+			if e.ExportID == wm.ExportID {
+				wm.State = domain.UserDataExportStateExpired
+			}
+		case *user.UserRemovedEvent:
+			wm.UserRemoved = true
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserDataExportWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.DataExportRequestedType,
+			user.DataExportSucceededType,
+			user.DataExportFailedType,
+			user.DataExportExpiredType,
+		).
+		EventData(map[string]interface{}{
+			"exportId": wm.ExportID,
+		}).
+		Or().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.UserRemovedType).
+		Builder()
+}
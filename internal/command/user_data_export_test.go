@@ -0,0 +1,257 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func testUserDataExportHumanAdded() eventstore.Event {
+	return eventFromEventPusher(
+		user.NewHumanAddedEvent(context.Background(),
+			&user.NewAggregate("user1", "org1").Aggregate,
+			"username", "firstName",
+			"lastName",
+			"nickName",
+			"displayName",
+			language.English,
+			domain.GenderUnspecified,
+			"email",
+			false,
+		),
+	)
+}
+
+func TestCommands_RequestUserDataExport(t *testing.T) {
+	t.Parallel()
+	agg := &user.NewAggregate("user1", "org1").Aggregate
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name         string
+		fields       fields
+		userID       string
+		wantExportID string
+		wantErr      error
+	}{
+		{
+			name: "missing user id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeth4", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "user not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			userID:  "user1",
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Iej4o", "Errors.User.NotFound"),
+		},
+		{
+			name: "no permission",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(testUserDataExportHumanAdded()),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			userID:  "user1",
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "requested, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(testUserDataExportHumanAdded()),
+					expectPush(
+						user.NewDataExportRequestedEvent(context.Background(), agg, "export1"),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "export1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			userID:       "user1",
+			wantExportID: "export1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			exportID, details, err := c.RequestUserDataExport(context.Background(), tt.userID)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantExportID, exportID)
+			assert.Equal(t, "org1", details.ResourceOwner)
+		})
+	}
+}
+
+func TestCommands_UserDataExportSucceeded(t *testing.T) {
+	t.Parallel()
+	agg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		exportID   string
+		wantErr    error
+	}{
+		{
+			name:       "missing export id",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-ooL5u", "Errors.IDMissing"),
+		},
+		{
+			name: "not requested",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(user.NewDataExportRequestedEvent(context.Background(), agg, "export2")),
+				),
+			),
+			exportID: "export1",
+			wantErr:  zerrors.ThrowNotFound(nil, "COMMAND-Chei0", "Errors.User.DataExport.NotFound"),
+		},
+		{
+			name: "already finished",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(user.NewDataExportRequestedEvent(context.Background(), agg, "export1")),
+					eventFromEventPusher(user.NewDataExportFailedEvent(context.Background(), agg, "export1", "failed")),
+				),
+			),
+			exportID: "export1",
+			wantErr:  zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ahgh8", "Errors.User.DataExport.AlreadyFinished"),
+		},
+		{
+			name: "succeeded, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(user.NewDataExportRequestedEvent(context.Background(), agg, "export1")),
+				),
+				expectPush(
+					user.NewDataExportSucceededEvent(context.Background(), agg, "export1"),
+				),
+			),
+			exportID: "export1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.UserDataExportSucceeded(context.Background(), "user1", "org1", tt.exportID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_UserDataExportFailed(t *testing.T) {
+	t.Parallel()
+	agg := &user.NewAggregate("user1", "org1").Aggregate
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(user.NewDataExportRequestedEvent(context.Background(), agg, "export1")),
+			),
+			expectPush(
+				user.NewDataExportFailedEvent(context.Background(), agg, "export1", "storage unavailable"),
+			),
+		)(t),
+	}
+	err := c.UserDataExportFailed(context.Background(), "user1", "org1", "export1", errors.New("storage unavailable"))
+	assert.NoError(t, err)
+}
+
+func TestCommands_UserDataExportExpired(t *testing.T) {
+	t.Parallel()
+	agg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		exportID   string
+		wantErr    error
+	}{
+		{
+			name:       "missing export id",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Eiz7o", "Errors.IDMissing"),
+		},
+		{
+			name: "not stored, ignored",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(user.NewDataExportRequestedEvent(context.Background(), agg, "export1")),
+					eventFromEventPusher(user.NewDataExportFailedEvent(context.Background(), agg, "export1", "failed")),
+				),
+			),
+			exportID: "export1",
+		},
+		{
+			name: "already expired, ignored",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(user.NewDataExportRequestedEvent(context.Background(), agg, "export1")),
+					eventFromEventPusher(user.NewDataExportSucceededEvent(context.Background(), agg, "export1")),
+					eventFromEventPusher(user.NewDataExportExpiredEvent(context.Background(), agg, "export1")),
+				),
+			),
+			exportID: "export1",
+		},
+		{
+			name: "user removed, ignored",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(user.NewDataExportRequestedEvent(context.Background(), agg, "export1")),
+					eventFromEventPusher(user.NewDataExportSucceededEvent(context.Background(), agg, "export1")),
+					eventFromEventPusher(user.NewUserRemovedEvent(context.Background(), agg, "username", nil, true)),
+				),
+			),
+			exportID: "export1",
+		},
+		{
+			name: "expired, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(user.NewDataExportRequestedEvent(context.Background(), agg, "export1")),
+					eventFromEventPusher(user.NewDataExportSucceededEvent(context.Background(), agg, "export1")),
+				),
+				expectPush(
+					user.NewDataExportExpiredEvent(context.Background(), agg, "export1"),
+				),
+			),
+			exportID: "export1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.UserDataExportExpired(context.Background(), "user1", "org1", tt.exportID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
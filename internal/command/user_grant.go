@@ -22,11 +22,11 @@ func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	event, addedUserGrant, err := c.addUserGrant(ctx, usergrant, check)
+	cmds, addedUserGrant, err := c.addUserGrant(ctx, usergrant, check)
 	if err != nil {
 		return nil, err
 	}
-	pushedEvents, err := c.eventstore.Push(ctx, event)
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
 		return nil, err
 	}
@@ -38,10 +38,13 @@ func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant
 	return userGrantWriteModelToUserGrant(addedUserGrant), nil
 }
 
-func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant, check UserGrantPermissionCheck) (command eventstore.Command, _ *UserGrantWriteModel, err error) {
+func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant, check UserGrantPermissionCheck) (cmds []eventstore.Command, _ *UserGrantWriteModel, err error) {
 	if !userGrant.IsValid() {
 		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-kVfMa", "Errors.UserGrant.Invalid")
 	}
+	if !userGrant.Validity.IsValid() {
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Eiw7a", "Errors.UserGrant.ValidityInvalid")
+	}
 	err = c.checkUserGrantPreCondition(ctx, userGrant, check)
 	if err != nil {
 		return nil, nil, err
@@ -53,21 +56,29 @@ func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant
 
 	addedUserGrant := NewUserGrantWriteModel(userGrant.AggregateID, userGrant.ResourceOwner)
 	userGrantAgg := UserGrantAggregateFromWriteModel(&addedUserGrant.WriteModel)
-	command = usergrant.NewUserGrantAddedEvent(
-		ctx,
-		userGrantAgg,
-		userGrant.UserID,
-		userGrant.ProjectID,
-		userGrant.ProjectGrantID,
-		userGrant.RoleKeys,
-	)
-	return command, addedUserGrant, nil
+	cmds = []eventstore.Command{
+		usergrant.NewUserGrantAddedEvent(
+			ctx,
+			userGrantAgg,
+			userGrant.UserID,
+			userGrant.ProjectID,
+			userGrant.ProjectGrantID,
+			userGrant.RoleKeys,
+		),
+	}
+	if !userGrant.Validity.IsZero() {
+		cmds = append(cmds, usergrant.NewUserGrantValiditySetEvent(ctx, userGrantAgg, userGrant.Validity.ValidFrom, userGrant.Validity.ValidUntil))
+	}
+	return cmds, addedUserGrant, nil
 }
 
 func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGrant, cascade, ignoreUnchanged bool, check UserGrantPermissionCheck) (_ *domain.UserGrant, err error) {
 	if userGrant.AggregateID == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-3M0sd", "Errors.UserGrant.Invalid")
 	}
+	if !userGrant.Validity.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohx4i", "Errors.UserGrant.ValidityInvalid")
+	}
 	existingUserGrant, err := c.userGrantWriteModelByID(ctx, userGrant.AggregateID, "")
 	if err != nil {
 		return nil, err
@@ -76,8 +87,9 @@ func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGr
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-3M9sd", "Errors.UserGrant.NotFound")
 	}
 
-	grantUnchanged := slices.Equal(existingUserGrant.RoleKeys, userGrant.RoleKeys)
-	if grantUnchanged {
+	rolesUnchanged := slices.Equal(existingUserGrant.RoleKeys, userGrant.RoleKeys)
+	validityChanged := userGrant.Validity != nil && !existingUserGrant.Validity.Equal(userGrant.Validity)
+	if rolesUnchanged && !validityChanged {
 		if ignoreUnchanged {
 			return userGrantWriteModelToUserGrant(existingUserGrant), nil
 		}
@@ -96,11 +108,18 @@ func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGr
 	changedUserGrant := NewUserGrantWriteModel(userGrant.AggregateID, userGrant.ResourceOwner)
 	userGrantAgg := UserGrantAggregateFromWriteModel(&changedUserGrant.WriteModel)
 
-	var event eventstore.Command = usergrant.NewUserGrantChangedEvent(ctx, userGrantAgg, existingUserGrant.UserID, userGrant.RoleKeys)
-	if cascade {
-		event = usergrant.NewUserGrantCascadeChangedEvent(ctx, userGrantAgg, userGrant.RoleKeys)
+	cmds := make([]eventstore.Command, 0, 2)
+	if !rolesUnchanged {
+		var event eventstore.Command = usergrant.NewUserGrantChangedEvent(ctx, userGrantAgg, existingUserGrant.UserID, userGrant.RoleKeys)
+		if cascade {
+			event = usergrant.NewUserGrantCascadeChangedEvent(ctx, userGrantAgg, userGrant.RoleKeys)
+		}
+		cmds = append(cmds, event)
 	}
-	pushedEvents, err := c.eventstore.Push(ctx, event)
+	if validityChanged {
+		cmds = append(cmds, usergrant.NewUserGrantValiditySetEvent(ctx, userGrantAgg, userGrant.Validity.ValidFrom, userGrant.Validity.ValidUntil))
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
 		return nil, err
 	}
@@ -382,6 +401,8 @@ func (c *Commands) searchUserGrantPreConditionState(ctx context.Context, userGra
 		existsProject    bool
 		existsGrantedOrg bool
 		existsGrant      bool
+		grantedOrgID     string
+		includeSubOrgs   bool
 	)
 
 	for _, result := range results {
@@ -410,11 +431,15 @@ func (c *Commands) searchUserGrantPreConditionState(ctx context.Context, userGra
 		case project.ProjectGrantSearchType:
 			switch result.FieldName {
 			case project.ProjectGrantGrantedOrgIDSearchField:
-				var orgID string
-				err := result.Value.Unmarshal(&orgID)
-				if err != nil || orgID != userGrant.ResourceOwner {
+				err := result.Value.Unmarshal(&grantedOrgID)
+				if err != nil {
 					return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-3m9gg", "Errors.Org.NotFound")
 				}
+			case project.ProjectGrantSubOrgsSearchField:
+				err := result.Value.Unmarshal(&includeSubOrgs)
+				if err != nil {
+					return nil, err
+				}
 			case project.ProjectGrantStateSearchField:
 				var state domain.ProjectGrantState
 				err := result.Value.Unmarshal(&state)
@@ -448,9 +473,30 @@ func (c *Commands) searchUserGrantPreConditionState(ctx context.Context, userGra
 	if userGrant.ProjectGrantID != "" && !existsGrant {
 		return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-huvKF", "Errors.Project.Grant.NotFound")
 	}
+	if grantedOrgID != "" && grantedOrgID != userGrant.ResourceOwner {
+		if err := c.checkProjectGrantIncludesOrg(ctx, grantedOrgID, includeSubOrgs, userGrant.ResourceOwner); err != nil {
+			return nil, err
+		}
+	}
 	return existingRoleKeys, nil
 }
 
+// checkProjectGrantIncludesOrg returns an error if the organization is not a sub organization of the granted organization
+// or the project grant does not include sub organizations.
+func (c *Commands) checkProjectGrantIncludesOrg(ctx context.Context, grantedOrgID string, includeSubOrgs bool, orgID string) error {
+	if !includeSubOrgs {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-3m9gg", "Errors.Org.NotFound")
+	}
+	ancestors, err := c.orgAncestorIDs(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(ancestors, grantedOrgID) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Iek7o", "Errors.Org.NotFound")
+	}
+	return nil
+}
+
 func (c *Commands) checkUserGrantPreConditionOld(ctx context.Context, usergrant *domain.UserGrant, check UserGrantPermissionCheck) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -463,6 +509,13 @@ func (c *Commands) checkUserGrantPreConditionOld(ctx context.Context, usergrant
 	if usergrant.ResourceOwner == "" {
 		usergrant.ResourceOwner = preConditions.ProjectResourceOwner
 	}
+	if preConditions.FoundGrantID == "" && usergrant.ResourceOwner != preConditions.ProjectResourceOwner && preConditions.hasSubOrgGrants() {
+		ancestors, err := c.orgAncestorIDs(ctx, usergrant.ResourceOwner)
+		if err != nil {
+			return err
+		}
+		preConditions.useSubOrgGrant(ancestors)
+	}
 	if usergrant.ProjectGrantID == "" {
 		usergrant.ProjectGrantID = preConditions.FoundGrantID
 	}
@@ -18,6 +18,10 @@ type UserGrantWriteModel struct {
 	ProjectGrantID string
 	RoleKeys       []string
 	State          domain.UserGrantState
+	// Validity of the grant, nil if it's not limited.
+	Validity *domain.Validity
+	// Expired is set as soon as the expiry of the current validity was pushed.
+	Expired bool
 }
 
 func NewUserGrantWriteModel(userGrantID string, resourceOwner string) *UserGrantWriteModel {
@@ -43,6 +47,11 @@ func (wm *UserGrantWriteModel) Reduce() error {
 			wm.RoleKeys = e.RoleKeys
 		case *usergrant.UserGrantCascadeChangedEvent:
 			wm.RoleKeys = e.RoleKeys
+		case *usergrant.UserGrantValiditySetEvent:
+			wm.Validity = &domain.Validity{ValidFrom: e.ValidFrom, ValidUntil: e.ValidUntil}
+			wm.Expired = false
+		case *usergrant.UserGrantExpiredEvent:
+			wm.Expired = true
 		case *usergrant.UserGrantDeactivatedEvent:
 			if wm.State == domain.UserGrantStateRemoved {
 				continue
@@ -70,6 +79,8 @@ func (wm *UserGrantWriteModel) Query() *eventstore.SearchQueryBuilder {
 		EventTypes(usergrant.UserGrantAddedType,
 			usergrant.UserGrantChangedType,
 			usergrant.UserGrantCascadeChangedType,
+			usergrant.UserGrantValiditySetType,
+			usergrant.UserGrantExpiredType,
 			usergrant.UserGrantDeactivatedType,
 			usergrant.UserGrantReactivatedType,
 			usergrant.UserGrantRemovedType,
@@ -99,6 +110,14 @@ type UserGrantPreConditionReadModel struct {
 	ProjectExists           bool
 	ExistingRoleKeysProject []string
 	ExistingRoleKeysGrant   []string
+	// subOrgGrants are the project grants, which might apply to the resourceowner as sub organization of the granted organization
+	subOrgGrants map[string]*subOrgProjectGrant
+}
+
+type subOrgProjectGrant struct {
+	grantedOrgID   string
+	roleKeys       []string
+	includeSubOrgs bool
 }
 
 func NewUserGrantPreConditionReadModel(userID, projectID, projectGrantID string, resourceOwner string) *UserGrantPreConditionReadModel {
@@ -113,6 +132,7 @@ func NewUserGrantPreConditionReadModel(userID, projectID, projectGrantID string,
 		ResourceOwner:           resourceOwner,
 		ExistingRoleKeysGrant:   make([]string, 0),
 		ExistingRoleKeysProject: make([]string, 0),
+		subOrgGrants:            make(map[string]*subOrgProjectGrant),
 	}
 }
 
@@ -137,20 +157,32 @@ func (wm *UserGrantPreConditionReadModel) Reduce() error {
 			wm.ExistingRoleKeysProject = nil
 			wm.ExistingRoleKeysGrant = nil
 			wm.ProjectExists = false
+			clear(wm.subOrgGrants)
 		case *project.GrantAddedEvent:
 			if projectGrantExistsOnOrganization(wm.ProjectGrantID, wm.ResourceOwner, e.GrantID, e.GrantedOrgID) {
 				wm.ExistingRoleKeysGrant = e.RoleKeys
 				wm.FoundGrantID = e.GrantID
 			}
+			if wm.ResourceOwner != "" && (wm.ProjectGrantID == "" || wm.ProjectGrantID == e.GrantID) {
+				wm.subOrgGrants[e.GrantID] = &subOrgProjectGrant{grantedOrgID: e.GrantedOrgID, roleKeys: e.RoleKeys}
+			}
 		case *project.GrantChangedEvent:
 			if wm.FoundGrantID == e.GrantID {
 				wm.ExistingRoleKeysGrant = e.RoleKeys
 			}
+			if grant, ok := wm.subOrgGrants[e.GrantID]; ok {
+				grant.roleKeys = e.RoleKeys
+			}
+		case *project.GrantSubOrgsChangedEvent:
+			if grant, ok := wm.subOrgGrants[e.GrantID]; ok {
+				grant.includeSubOrgs = e.IncludeSubOrgs
+			}
 		case *project.GrantRemovedEvent:
 			if wm.FoundGrantID == e.GrantID {
 				wm.ExistingRoleKeysGrant = []string{}
 				wm.FoundGrantID = ""
 			}
+			delete(wm.subOrgGrants, e.GrantID)
 		case *project.RoleAddedEvent:
 			wm.ExistingRoleKeysProject = append(wm.ExistingRoleKeysProject, e.Key)
 		case *project.RoleRemovedEvent:
@@ -178,6 +210,30 @@ func projectGrantExistsOnOrganization(requiredGrantID, requiredOrganization, pro
 		(requiredOrganization == "" || requiredOrganization == grantedOrganization)
 }
 
+// hasSubOrgGrants returns true if the project is granted to any organization including its sub organizations.
+func (wm *UserGrantPreConditionReadModel) hasSubOrgGrants() bool {
+	for _, grant := range wm.subOrgGrants {
+		if grant.includeSubOrgs {
+			return true
+		}
+	}
+	return false
+}
+
+// useSubOrgGrant uses the project grant of the nearest ancestor of the resourceowner, which includes sub organizations.
+// The ancestors are expected to start with the resourceowner itself.
+func (wm *UserGrantPreConditionReadModel) useSubOrgGrant(ancestors []string) {
+	for _, ancestor := range ancestors {
+		for grantID, grant := range wm.subOrgGrants {
+			if grant.includeSubOrgs && grant.grantedOrgID == ancestor {
+				wm.FoundGrantID = grantID
+				wm.ExistingRoleKeysGrant = grant.roleKeys
+				return
+			}
+		}
+	}
+}
+
 func (wm *UserGrantPreConditionReadModel) existingRoles() []string {
 	if wm.FoundGrantID != "" {
 		return wm.ExistingRoleKeysGrant
@@ -205,6 +261,7 @@ func (wm *UserGrantPreConditionReadModel) Query() *eventstore.SearchQueryBuilder
 			project.ProjectRemovedType,
 			project.GrantAddedType,
 			project.GrantChangedType,
+			project.GrantSubOrgsChangedType,
 			project.GrantRemovedType,
 			project.RoleAddedType,
 			project.RoleRemovedType).
@@ -240,7 +240,7 @@ func (c *Commands) AddHumanCommand(human *AddHuman, orgID string, hasher *crypto
 				createCmd.AddPhoneData(human.Phone.Number)
 			}
 
-			if err := addHumanCommandPassword(ctx, filter, createCmd, human, hasher); err != nil {
+			if err := c.addHumanCommandPassword(ctx, filter, createCmd, human, hasher); err != nil {
 				return nil, err
 			}
 
@@ -357,9 +357,9 @@ func (c *Commands) addHumanCommandCheckID(ctx context.Context, filter preparatio
 	return nil
 }
 
-func addHumanCommandPassword(ctx context.Context, filter preparation.FilterToQueryReducer, createCmd humanCreationCommand, human *AddHuman, hasher *crypto.Hasher) (err error) {
+func (c *Commands) addHumanCommandPassword(ctx context.Context, filter preparation.FilterToQueryReducer, createCmd humanCreationCommand, human *AddHuman, hasher *crypto.Hasher) (err error) {
 	if human.Password != "" {
-		if err = humanValidatePassword(ctx, filter, human.Password); err != nil {
+		if err = c.humanValidatePassword(ctx, filter, human.Password); err != nil {
 			return err
 		}
 
@@ -404,13 +404,16 @@ func (c *Commands) userValidateDomain(ctx context.Context, resourceOwner string,
 	return nil
 }
 
-func humanValidatePassword(ctx context.Context, filter preparation.FilterToQueryReducer, password string) error {
+func (c *Commands) humanValidatePassword(ctx context.Context, filter preparation.FilterToQueryReducer, password string) error {
 	passwordComplexity, err := passwordComplexityPolicyWriteModel(ctx, filter)
 	if err != nil {
 		return err
 	}
 
-	return passwordComplexity.Validate(password)
+	if err := passwordComplexity.Validate(password); err != nil {
+		return err
+	}
+	return c.checkPasswordBreached(ctx, passwordComplexity.CheckBreached, password)
 }
 
 func (h *AddHuman) ensureDisplayName() {
@@ -571,6 +574,11 @@ func (c *Commands) createHuman(ctx context.Context, orgID string, human *domain.
 		if err := human.HashPasswordIfExisting(ctx, pwPolicy, c.userPasswordHasher, human.Password.ChangeRequired); err != nil {
 			return nil, nil, nil, err
 		}
+		if human.Password.SecretString != "" {
+			if err := c.checkPasswordBreached(ctx, pwPolicy.CheckBreached, human.Password.SecretString); err != nil {
+				return nil, nil, nil, err
+			}
+		}
 	}
 	if human.HashedPassword != "" {
 		if err := c.userPasswordHasher.ValidateEncodedHash(human.HashedPassword); err != nil {
@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RevokeHumanConsent removes the consent the user granted to the client.
+// The client has to ask for consent again on the next authorization.
+func (c *Commands) RevokeHumanConsent(ctx context.Context, userID, clientID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || clientID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeng0", "Errors.IDMissing")
+	}
+	wm, err := c.humanConsentWriteModel(ctx, userID, "", clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.Granted {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-ieW8o", "Errors.User.Consent.NotFound")
+	}
+	if err = c.checkPermissionUpdateUser(ctx, wm.ResourceOwner, wm.AggregateID, true); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		user.NewHumanConsentRevokedEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), clientID),
+	)
+}
+
+// authRequestConsent checks the consent of the user for auth requests of clients which require consent.
+// If the user gave the consent in the current request, the returned command persists it.
+// Without consent, or if the client explicitly asked for it (prompt=consent), a precondition error is returned,
+// so the login UI can ask the user and link the session again.
+func (c *Commands) authRequestConsent(ctx context.Context, authReq *AuthRequestWriteModel, userID, resourceOwner string, consentGranted bool) (eventstore.Command, error) {
+	if !authReq.RequireConsent {
+		return nil, nil
+	}
+	wm, err := c.humanConsentWriteModel(ctx, userID, resourceOwner, authReq.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if consentGranted {
+		return user.NewHumanConsentGrantedEvent(ctx,
+			UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel),
+			authReq.ClientID,
+			wm.mergeScopes(authReq.Scope),
+		), nil
+	}
+	if slices.Contains(authReq.Prompt, domain.PromptConsent) || !wm.Covers(authReq.Scope) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ooy5e", "Errors.AuthRequest.ConsentRequired")
+	}
+	return nil, nil
+}
+
+func (c *Commands) humanConsentWriteModel(ctx context.Context, userID, resourceOwner, clientID string) (_ *HumanConsentWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm := NewHumanConsentWriteModel(userID, resourceOwner, clientID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
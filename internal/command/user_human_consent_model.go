@@ -0,0 +1,109 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// HumanConsentWriteModel holds the consent a user granted to a single client.
+type HumanConsentWriteModel struct {
+	eventstore.WriteModel
+
+	ClientID string
+	Granted  bool
+	Scopes   []string
+}
+
+func NewHumanConsentWriteModel(userID, resourceOwner, clientID string) *HumanConsentWriteModel {
+	return &HumanConsentWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		ClientID: clientID,
+	}
+}
+
+func (wm *HumanConsentWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &wm.WriteModel
+}
+
+func (wm *HumanConsentWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.HumanConsentGrantedEvent:
+			if e.ClientID != wm.ClientID {
+				continue
+			}
+		case *user.HumanConsentRevokedEvent:
+			if e.ClientID != wm.ClientID {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *HumanConsentWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanConsentGrantedEvent:
+			wm.Granted = true
+			wm.Scopes = e.Scopes
+		case *user.HumanConsentRevokedEvent:
+			wm.Granted = false
+			wm.Scopes = nil
+		case *user.UserRemovedEvent:
+			wm.Granted = false
+			wm.Scopes = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanConsentWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanConsentGrantedType,
+			user.HumanConsentRevokedType,
+			user.UserRemovedType,
+		).
+		Builder()
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// Covers reports whether the granted consent includes all of the requested scopes.
+func (wm *HumanConsentWriteModel) Covers(scopes []string) bool {
+	if !wm.Granted {
+		return false
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(wm.Scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeScopes returns the previously granted scopes extended by the requested ones,
+// so that consenting to a narrower request does not revoke an earlier, broader consent.
+func (wm *HumanConsentWriteModel) mergeScopes(scopes []string) []string {
+	if !wm.Granted {
+		return slices.Clone(scopes)
+	}
+	merged := slices.Clone(wm.Scopes)
+	for _, scope := range scopes {
+		if !slices.Contains(merged, scope) {
+			merged = append(merged, scope)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,174 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_RevokeHumanConsent(t *testing.T) {
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx      context.Context
+		userID   string
+		clientID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr error
+	}{
+		{
+			name: "missing client id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    authz.NewMockContext("instanceID", "org1", "user1"),
+				userID: "user1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Aeng0", "Errors.IDMissing"),
+		},
+		{
+			name: "not granted",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instanceID", "org1", "user1"),
+				userID:   "user1",
+				clientID: "client1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-ieW8o", "Errors.User.Consent.NotFound"),
+		},
+		{
+			name: "already revoked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+								"client1", []string{"openid"}),
+						),
+						eventFromEventPusher(
+							user.NewHumanConsentRevokedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+								"client1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instanceID", "org1", "user1"),
+				userID:   "user1",
+				clientID: "client1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-ieW8o", "Errors.User.Consent.NotFound"),
+		},
+		{
+			name: "other user, permission denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+								"client1", []string{"openid"}),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instanceID", "org1", "user2"),
+				userID:   "user1",
+				clientID: "client1",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "own consent, revoked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+								"client1", []string{"openid"}),
+						),
+					),
+					expectPush(
+						user.NewHumanConsentRevokedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+							"client1"),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instanceID", "org1", "user1"),
+				userID:   "user1",
+				clientID: "client1",
+			},
+			want: &domain.ObjectDetails{ResourceOwner: "org1"},
+		},
+		{
+			name: "other user, revoked with permission",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanConsentGrantedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+								"client1", []string{"openid"}),
+						),
+					),
+					expectPush(
+						user.NewHumanConsentRevokedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+							"client1"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:      authz.NewMockContext("instanceID", "org1", "user2"),
+				userID:   "user1",
+				clientID: "client1",
+			},
+			want: &domain.ObjectDetails{ResourceOwner: "org1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RevokeHumanConsent(tt.args.ctx, tt.args.userID, tt.args.clientID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestHumanConsentWriteModel_Covers(t *testing.T) {
+	wm := &HumanConsentWriteModel{Granted: true, Scopes: []string{"openid", "profile"}}
+	assert.True(t, wm.Covers([]string{"openid"}))
+	assert.True(t, wm.Covers([]string{"profile", "openid"}))
+	assert.False(t, wm.Covers([]string{"openid", "email"}))
+	assert.Equal(t, []string{"openid", "profile", "email"}, wm.mergeScopes([]string{"email", "openid"}))
+
+	wm = &HumanConsentWriteModel{}
+	assert.False(t, wm.Covers([]string{"openid"}))
+	assert.Equal(t, []string{"openid"}, wm.mergeScopes([]string{"openid"}))
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// UserDevice lists the sessions and tokens issued to a single device (user agent) of a user.
+type UserDevice struct {
+	FingerprintID   string
+	SessionIDs      []string
+	OIDCSessionIDs  []string
+	SAMLSessionIDs  []string
+	RefreshTokenIDs []string
+}
+
+// RevokeUserDevice terminates all sessions and revokes all tokens of the device.
+// Sessions and tokens which are no longer active or do not belong to the user are ignored.
+// Terminating the sessions triggers the back-channel logout of the applications the user is logged into.
+func (c *Commands) RevokeUserDevice(ctx context.Context, userID string, device *UserDevice) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || device == nil || device.FingerprintID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohs4i", "Errors.IDMissing")
+	}
+	existingHuman, err := c.getHumanWriteModelByID(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(existingHuman.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Quai7", "Errors.User.NotFound")
+	}
+	if err = c.checkPermissionUpdateUser(ctx, existingHuman.ResourceOwner, userID, true); err != nil {
+		return nil, err
+	}
+
+	cmds := make([]eventstore.Command, 0, len(device.SessionIDs)+len(device.OIDCSessionIDs)+len(device.SAMLSessionIDs)+len(device.RefreshTokenIDs))
+	for _, sessionID := range device.SessionIDs {
+		wm := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+		if err = c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+			return nil, err
+		}
+		if wm.UserID != userID || wm.State != domain.SessionStateActive {
+			continue
+		}
+		cmds = append(cmds, session.NewTerminateEvent(ctx, &session.NewAggregate(wm.AggregateID, wm.ResourceOwner).Aggregate))
+	}
+	for _, oidcSessionID := range device.OIDCSessionIDs {
+		wm := NewOIDCSessionWriteModel(oidcSessionID, "")
+		if err = c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+			return nil, err
+		}
+		if wm.UserID != userID || wm.State != domain.OIDCSessionStateActive {
+			continue
+		}
+		// revoking the refresh token also revokes the access token
+		if wm.RefreshTokenID != "" {
+			cmds = append(cmds, oidcsession.NewRefreshTokenRevokedEvent(ctx, wm.aggregate))
+		} else if wm.AccessTokenID != "" {
+			cmds = append(cmds, oidcsession.NewAccessTokenRevokedEvent(ctx, wm.aggregate))
+		}
+	}
+	for _, samlSessionID := range device.SAMLSessionIDs {
+		wm := NewSAMLSessionWriteModel(samlSessionID, "")
+		if err = c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+			return nil, err
+		}
+		if wm.UserID != userID || wm.State != domain.SAMLSessionStateActive || wm.SAMLResponseID == "" {
+			continue
+		}
+		cmds = append(cmds, samlsession.NewSAMLResponseRevokedEvent(ctx, wm.aggregate))
+	}
+	for _, tokenID := range device.RefreshTokenIDs {
+		removeEvent, _, err := c.removeRefreshToken(ctx, userID, existingHuman.ResourceOwner, tokenID)
+		if zerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, removeEvent)
+	}
+	if len(cmds) == 0 {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-yoo4E", "Errors.User.Device.NotFound")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
@@ -0,0 +1,186 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_RevokeUserDevice(t *testing.T) {
+	humanAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			user.NewHumanAddedEvent(context.Background(),
+				&user.NewAggregate("user1", "org1").Aggregate,
+				"username",
+				"firstname",
+				"lastname",
+				"nickname",
+				"displayname",
+				language.German,
+				domain.GenderUnspecified,
+				"email@test.ch",
+				true,
+			),
+		)
+	}
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx    context.Context
+		userID string
+		device *UserDevice
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr error
+	}{
+		{
+			name: "missing fingerprint id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    authz.NewMockContext("instanceID", "org1", "user1"),
+				userID: "user1",
+				device: &UserDevice{},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohs4i", "Errors.IDMissing"),
+		},
+		{
+			name: "user not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:    authz.NewMockContext("instanceID", "org1", "user1"),
+				userID: "user1",
+				device: &UserDevice{FingerprintID: "fingerprint1"},
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Quai7", "Errors.User.NotFound"),
+		},
+		{
+			name: "other user, permission denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:    authz.NewMockContext("instanceID", "org1", "user2"),
+				userID: "user1",
+				device: &UserDevice{FingerprintID: "fingerprint1"},
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "session of other user, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(), &session.NewAggregate("session1", "instanceID").Aggregate, nil),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session1", "instanceID").Aggregate,
+								"user2", "org1", testNow, nil),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:    authz.NewMockContext("instanceID", "org1", "user1"),
+				userID: "user1",
+				device: &UserDevice{
+					FingerprintID: "fingerprint1",
+					SessionIDs:    []string{"session1"},
+				},
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-yoo4E", "Errors.User.Device.NotFound"),
+		},
+		{
+			name: "own device, revoked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						humanAdded(),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(), &session.NewAggregate("session1", "instanceID").Aggregate, nil),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("session1", "instanceID").Aggregate,
+								"user1", "org1", testNow, nil),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+								"user1", "org1", "session1", "clientID", []string{"audience"}, []string{"openid", "offline_access"},
+								[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, testNow, "nonce", nil, nil, nil, nil, ""),
+						),
+						eventFromEventPusher(
+							oidcsession.NewAccessTokenAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+								"at1", []string{"openid", "offline_access"}, time.Hour, domain.TokenReasonAuthRequest, nil, nil, nil),
+						),
+						eventFromEventPusher(
+							oidcsession.NewRefreshTokenAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+								"rt1", 24*time.Hour, time.Hour),
+						),
+					),
+					expectPush(
+						session.NewTerminateEvent(context.Background(), &session.NewAggregate("session1", "instanceID").Aggregate),
+						oidcsession.NewRefreshTokenRevokedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:    authz.NewMockContext("instanceID", "org1", "user1"),
+				userID: "user1",
+				device: &UserDevice{
+					FingerprintID:  "fingerprint1",
+					SessionIDs:     []string{"session1"},
+					OIDCSessionIDs: []string{"V2_oidcSessionID"},
+				},
+			},
+			want: &domain.ObjectDetails{ResourceOwner: "org1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RevokeUserDevice(tt.args.ctx, tt.args.userID, tt.args.device)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
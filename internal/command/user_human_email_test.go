@@ -721,6 +721,7 @@ func TestCommandSide_VerifyHumanEmail(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -798,6 +799,7 @@ func TestCommandSide_VerifyHumanEmail(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1512,6 +1512,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1533,6 +1534,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1582,6 +1584,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1603,6 +1606,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1647,6 +1651,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1668,6 +1673,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1717,6 +1723,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1738,6 +1745,7 @@ func TestCommandSide_HumanSendOTPSMS(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/logging"
 	"github.com/zitadel/passwap"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	commandErrors "github.com/zitadel/zitadel/internal/command/errors"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -195,7 +196,7 @@ func (c *Commands) setPassword(
 	verificationCheck setPasswordVerification,
 ) (*domain.ObjectDetails, error) {
 	agg := user.NewAggregate(wm.AggregateID, wm.ResourceOwner)
-	command, err := c.setPasswordCommand(ctx, &agg.Aggregate, wm.UserState, password, encodedPassword, userAgentID, changeRequired, verificationCheck)
+	command, err := c.setPasswordCommand(ctx, &agg.Aggregate, wm.UserState, password, encodedPassword, userAgentID, changeRequired, verificationCheck, wm.PasswordHistory)
 	if err != nil {
 		return nil, err
 	}
@@ -209,9 +210,10 @@ func (c *Commands) setPassword(
 // setPasswordCommand creates the command / intent for changing a user's password.
 // It will check the user's [domain.UserState] to be existing and not initial,
 // if the caller is allowed to change the password (permission, by code or by providing the current password),
-// and it will ensure the new password (if provided as plain) corresponds to the password complexity policy.
+// and it will ensure the new password (if provided as plain) corresponds to the password complexity policy
+// and was not used recently according to the passwordHistory and the password age policy.
 // If not already encoded, the new password will be hashed.
-func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggregate, userState domain.UserState, password, encodedPassword, userAgentID string, changeRequired bool, verificationCheck setPasswordVerification) (_ eventstore.Command, err error) {
+func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggregate, userState domain.UserState, password, encodedPassword, userAgentID string, changeRequired bool, verificationCheck setPasswordVerification, passwordHistory []string) (_ eventstore.Command, err error) {
 	if !isUserStateExists(userState) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-G8dh3", "Errors.User.Password.NotFound")
 	}
@@ -239,6 +241,9 @@ func (c *Commands) setPasswordCommand(ctx context.Context, agg *eventstore.Aggre
 		if err = c.checkPasswordComplexity(ctx, password, agg.ResourceOwner); err != nil {
 			return nil, err
 		}
+		if err = c.checkPasswordHistory(ctx, password, agg.ResourceOwner, passwordHistory); err != nil {
+			return nil, err
+		}
 	}
 
 	// In case only a plain password was passed, we need to hash it.
@@ -266,6 +271,38 @@ func (c *Commands) verifyAndUpdatePassword(ctx context.Context, encodedHash, old
 	return updated, convertPasswapErr(err)
 }
 
+// checkPasswordHistory checks that the password does not match any of the last hashes of the passwordHistory,
+// where the amount of hashes to check is defined by the history depth of the password age policy.
+func (c *Commands) checkPasswordHistory(ctx context.Context, password, resourceOwner string, passwordHistory []string) (err error) {
+	if len(passwordHistory) == 0 {
+		return nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	policy, err := c.getOrgPasswordAgePolicy(ctx, resourceOwner)
+	if err != nil {
+		return err
+	}
+	depth := int(policy.HistoryDepth)
+	if depth == 0 {
+		return nil
+	}
+	if depth < len(passwordHistory) {
+		passwordHistory = passwordHistory[len(passwordHistory)-depth:]
+	}
+	for _, encodedHash := range passwordHistory {
+		_, spanPasswap := tracing.NewNamedSpan(ctx, "passwap.Verify")
+		_, verifyErr := c.userPasswordHasher.Verify(encodedHash, password)
+		spanPasswap.EndWithError(verifyErr)
+		// mismatches as well as hashes of no longer supported algorithms are not considered a reuse
+		if verifyErr == nil {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Iew3o", "Errors.User.Password.Reused")
+		}
+	}
+	return nil
+}
+
 // checkPasswordComplexity checks uf the given password can be used to be the password of a user
 func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword string, resourceOwner string) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
@@ -279,6 +316,25 @@ func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword stri
 	if err := policy.Check(newPassword); err != nil {
 		return err
 	}
+	return c.checkPasswordBreached(ctx, policy.CheckBreached, newPassword)
+}
+
+// checkPasswordBreached checks if the password is part of a breached password corpus,
+// if the policy requires it and a corpus is configured
+func (c *Commands) checkPasswordBreached(ctx context.Context, checkBreached bool, password string) (err error) {
+	if !checkBreached || c.PasswordBreachChecker == nil || password == "" {
+		return nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	breached, err := c.PasswordBreachChecker.Breached(ctx, password)
+	if err != nil {
+		return err
+	}
+	if breached {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Oop4e", "Errors.User.PasswordComplexityPolicy.Breached")
+	}
 	return nil
 }
 
@@ -397,8 +453,40 @@ func checkPassword(ctx context.Context, userID, password string, es *eventstore.
 	if err != nil {
 		return nil, err
 	}
-	commands, _, err := verifyPasswordWithLockoutPolicy(ctx, wm, password, es, hasher.Verify, optionalAuthRequestInfo, tarpit)
-	return commands, err
+	instance := authz.GetInstance(ctx)
+	encodedHash := wm.EncodedHash
+	verify := verifyAndRehashPassword(hasher, wm.PasswordChangeDate, instance.PasswordRehashBefore())
+	commands, _, err := verifyPasswordWithLockoutPolicy(ctx, wm, password, es, verify, optionalAuthRequestInfo, tarpit)
+	if err != nil {
+		return commands, err
+	}
+	// The expiration is only checked after a successful verification, so the used algorithm is not revealed.
+	// Neither the check nor the hash update is recorded, the user has to reset the password.
+	if insecurePasswordHashExpired(encodedHash, instance.InsecurePasswordHashDeadline()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooQu4", "Errors.User.Password.InsecureHashExpired")
+	}
+	return commands, nil
+}
+
+// verifyAndRehashPassword verifies the password like [crypto.Hasher.Verify].
+// Additionally, the password is re-hashed with the current configuration,
+// if the hash was set before rehashBefore and the hasher did not already update it.
+func verifyAndRehashPassword(hasher *crypto.Hasher, passwordChangeDate, rehashBefore time.Time) func(encodedHash, password string) (string, error) {
+	return func(encodedHash, password string) (string, error) {
+		updated, err := hasher.Verify(encodedHash, password)
+		if err != nil || updated != "" || !passwordChangeDate.Before(rehashBefore) {
+			return updated, err
+		}
+		return hasher.Hash(password)
+	}
+}
+
+// insecurePasswordHashExpired returns true if the hash uses an insecure algorithm and the deadline has passed.
+func insecurePasswordHashExpired(encodedHash string, deadline time.Time) bool {
+	if deadline.IsZero() || time.Now().Before(deadline) {
+		return false
+	}
+	return crypto.ParsePasswordHashInfo(encodedHash).Insecure()
 }
 
 func verifyPasswordWithLockoutPolicy(
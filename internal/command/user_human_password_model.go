@@ -14,6 +14,10 @@ type HumanPasswordWriteModel struct {
 
 	EncodedHash          string
 	SecretChangeRequired bool
+	// PasswordChangeDate is the time the current hash was set, either by a password change or a hash update.
+	PasswordChangeDate time.Time
+	// PasswordHistory contains the encoded hashes of the previous passwords including the current one, the most recent last.
+	PasswordHistory []string
 
 	Code                     *crypto.CryptoValue
 	CodeCreationDate         time.Time
@@ -66,6 +70,8 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.VerificationID = ""
 			wm.EncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 			wm.SecretChangeRequired = e.ChangeRequired
+			wm.PasswordHistory = appendPasswordHistory(nil, wm.EncodedHash)
+			wm.PasswordChangeDate = e.CreationDate()
 			wm.UserState = domain.UserStateActive
 		case *user.HumanRegisteredEvent:
 			wm.Code = nil
@@ -76,6 +82,8 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.VerificationID = ""
 			wm.EncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 			wm.SecretChangeRequired = e.ChangeRequired
+			wm.PasswordHistory = appendPasswordHistory(nil, wm.EncodedHash)
+			wm.PasswordChangeDate = e.CreationDate()
 			wm.UserState = domain.UserStateActive
 		case *user.MachineAddedEvent:
 			wm.Code = nil
@@ -86,6 +94,8 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.VerificationID = ""
 			wm.EncodedHash = ""
 			wm.SecretChangeRequired = false
+			wm.PasswordHistory = nil
+			wm.PasswordChangeDate = time.Time{}
 			wm.UserState = domain.UserStateUnspecified
 		case *user.HumanInitialCodeAddedEvent:
 			wm.UserState = domain.UserStateInitial
@@ -94,6 +104,8 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 		case *user.HumanPasswordChangedEvent:
 			wm.EncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 			wm.SecretChangeRequired = e.ChangeRequired
+			wm.PasswordHistory = appendPasswordHistory(wm.PasswordHistory, wm.EncodedHash)
+			wm.PasswordChangeDate = e.CreationDate()
 			wm.Code = nil
 			wm.PasswordCheckFailedCount = 0
 		case *user.HumanPasswordCodeAddedEvent:
@@ -122,6 +134,8 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 		case *user.UserRemovedEvent:
 			wm.EncodedHash = ""
 			wm.SecretChangeRequired = false
+			wm.PasswordHistory = nil
+			wm.PasswordChangeDate = time.Time{}
 			wm.Code = nil
 			wm.CodeCreationDate = time.Time{}
 			wm.CodeExpiry = 0
@@ -131,6 +145,8 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			wm.UserState = domain.UserStateDeleted
 		case *user.HumanPasswordHashUpdatedEvent:
 			wm.EncodedHash = e.EncodedHash
+			wm.PasswordHistory = replaceCurrentPasswordHistory(wm.PasswordHistory, wm.EncodedHash)
+			wm.PasswordChangeDate = e.CreationDate()
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -177,3 +193,26 @@ func (wm *HumanPasswordWriteModel) Query() *eventstore.SearchQueryBuilder {
 	}
 	return query
 }
+
+// appendPasswordHistory adds the encoded hash as most recent entry to the history.
+// Only the last [domain.MaxPasswordHistoryDepth] entries are kept.
+func appendPasswordHistory(history []string, encodedHash string) []string {
+	if encodedHash == "" {
+		return history
+	}
+	history = append(history, encodedHash)
+	if len(history) > domain.MaxPasswordHistoryDepth {
+		history = history[len(history)-domain.MaxPasswordHistoryDepth:]
+	}
+	return history
+}
+
+// replaceCurrentPasswordHistory replaces the most recent entry of the history,
+// as the hash of the current password was updated (e.g. to a newer algorithm).
+func replaceCurrentPasswordHistory(history []string, encodedHash string) []string {
+	if len(history) == 0 {
+		return appendPasswordHistory(history, encodedHash)
+	}
+	history[len(history)-1] = encodedHash
+	return history
+}
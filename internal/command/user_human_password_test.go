@@ -9,9 +9,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/zitadel/passwap"
+	"github.com/zitadel/passwap/md5plain"
 	"go.uber.org/mock/gomock"
 	"golang.org/x/text/language"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/breach"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -150,6 +153,7 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -212,6 +216,7 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -463,6 +468,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -540,6 +546,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -618,6 +625,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -701,6 +709,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -757,10 +766,19 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 	}
 }
 
+type mockPasswordBreachChecker struct {
+	breached bool
+}
+
+func (m *mockPasswordBreachChecker) Breached(context.Context, string) (bool, error) {
+	return m.breached, nil
+}
+
 func TestCommandSide_ChangePassword(t *testing.T) {
 	type fields struct {
-		userPasswordHasher *crypto.Hasher
-		tarpit             Tarpit
+		userPasswordHasher    *crypto.Hasher
+		tarpit                Tarpit
+		passwordBreachChecker breach.Checker
 	}
 	type args struct {
 		ctx            context.Context
@@ -936,6 +954,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							true,
 							true,
 							true,
+							false,
 						),
 					),
 				),
@@ -944,6 +963,150 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "password breached, invalid argument error",
+			fields: fields{
+				userPasswordHasher:    mockPasswordHasher("x"),
+				tarpit:                expectTarpit(0),
+				passwordBreachChecker: &mockPasswordBreachChecker{breached: true},
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				oldPassword:   "password-old",
+				newPassword:   "password1",
+				resourceOwner: "org1",
+			},
+			expect: []expect{
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"username",
+							"firstname",
+							"lastname",
+							"nickname",
+							"displayname",
+							language.German,
+							domain.GenderUnspecified,
+							"email@test.ch",
+							true,
+						),
+					),
+					eventFromEventPusher(
+						user.NewHumanEmailVerifiedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+					eventFromEventPusher(
+						user.NewHumanPasswordChangedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password-old",
+							false,
+							"")),
+				),
+				expectFilter(), // recheck of user locking relevant events
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordComplexityPolicyAddedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							1,
+							false,
+							false,
+							false,
+							false,
+							true,
+						),
+					),
+				),
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Oop4e", "Errors.User.PasswordComplexityPolicy.Breached"))
+				},
+			},
+		},
+		{
+			name: "password reused, invalid argument error",
+			fields: fields{
+				userPasswordHasher: mockPasswordHasher("x"),
+				tarpit:             expectTarpit(0),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				oldPassword:   "password",
+				newPassword:   "password-old",
+				resourceOwner: "org1",
+			},
+			expect: []expect{
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"username",
+							"firstname",
+							"lastname",
+							"nickname",
+							"displayname",
+							language.German,
+							domain.GenderUnspecified,
+							"email@test.ch",
+							true,
+						),
+					),
+					eventFromEventPusher(
+						user.NewHumanEmailVerifiedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+					eventFromEventPusher(
+						user.NewHumanPasswordChangedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password-old",
+							false,
+							"")),
+					eventFromEventPusher(
+						user.NewHumanPasswordChangedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+							false,
+							"")),
+				),
+				expectFilter(), // recheck of user locking relevant events
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordComplexityPolicyAddedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							1,
+							false,
+							false,
+							false,
+							false,
+							false,
+						),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordAgePolicyAddedEvent(
+							context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							0,
+							0,
+							2,
+						),
+					),
+				),
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Iew3o", "Errors.User.Password.Reused"))
+				},
+			},
+		},
 		{
 			name: "password not matching, invalid argument error",
 			fields: fields{
@@ -1124,6 +1287,17 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							false,
+						),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordAgePolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							0,
+							0,
+							3,
 						),
 					),
 				),
@@ -1194,6 +1368,17 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							false,
+						),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordAgePolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							0,
+							0,
+							3,
 						),
 					),
 				),
@@ -1265,6 +1450,17 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							false,
+						),
+					),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewPasswordAgePolicyAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							0,
+							0,
+							3,
 						),
 					),
 				),
@@ -1287,9 +1483,10 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &Commands{
-				eventstore:         expectEventstore(tt.expect...)(t),
-				userPasswordHasher: tt.fields.userPasswordHasher,
-				tarpit:             tt.fields.tarpit.tarpit,
+				eventstore:            expectEventstore(tt.expect...)(t),
+				userPasswordHasher:    tt.fields.userPasswordHasher,
+				tarpit:                tt.fields.tarpit.tarpit,
+				PasswordBreachChecker: tt.fields.passwordBreachChecker,
 			}
 			got, err := r.ChangePassword(tt.args.ctx, tt.args.resourceOwner, tt.args.userID, tt.args.oldPassword, tt.args.newPassword, tt.args.userAgentID, tt.args.changeRequired)
 			if tt.res.err == nil {
@@ -1793,6 +1990,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -1834,6 +2034,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -1877,6 +2080,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -1940,6 +2146,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -1998,6 +2207,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -2088,6 +2300,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -2178,6 +2393,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -2272,6 +2490,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -2353,6 +2574,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -2414,6 +2638,176 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 			},
 			res: res{},
 		},
+		{
+			name: "check password, ok, rehash forced",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								domain.PasswordlessTypeNotAllowed,
+								"",
+								time.Hour*1,
+								time.Hour*2,
+								time.Hour*3,
+								time.Hour*4,
+								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+								"")),
+					),
+					expectFilter(),
+					expectPush(
+						user.NewHumanPasswordCheckSucceededEvent(
+							context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							&user.AuthRequestInfo{
+								ID:          "request1",
+								UserAgentID: "agent1",
+							},
+						),
+						user.NewHumanPasswordHashUpdatedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"$plain$x$password",
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+				tarpit:             expectTarpit(0),
+			},
+			args: args{
+				ctx:           authz.WithInstance(context.Background(), &mockInstance{passwordRehashBefore: time.Now()}),
+				userID:        "user1",
+				resourceOwner: "org1",
+				password:      "password",
+				authReq: &domain.AuthRequest{
+					ID:      "request1",
+					AgentID: "agent1",
+				},
+			},
+			res: res{},
+		},
+		{
+			name: "check password, insecure hash expired, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								domain.PasswordlessTypeNotAllowed,
+								"",
+								time.Hour*1,
+								time.Hour*2,
+								time.Hour*3,
+								time.Hour*4,
+								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"5f4dcc3b5aa765d61d8327deb882cf99",
+								false,
+								"")),
+					),
+					expectFilter(),
+				),
+				userPasswordHasher: &crypto.Hasher{
+					Swapper: passwap.NewSwapper(plainHasher{x: "x"}, md5plain.NewVerifier()),
+				},
+				tarpit: expectTarpit(0),
+			},
+			args: args{
+				ctx:           authz.WithInstance(context.Background(), &mockInstance{insecurePasswordHashDeadline: time.Now().Add(-time.Hour)}),
+				userID:        "user1",
+				resourceOwner: "org1",
+				password:      "password",
+				authReq: &domain.AuthRequest{
+					ID:      "request1",
+					AgentID: "agent1",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooQu4", "Errors.User.Password.InsecureHashExpired"))
+				},
+			},
+		},
 		{
 			name: "check password ok, locked in the mean time",
 			fields: fields{
@@ -2439,6 +2833,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -2519,6 +2916,9 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 								time.Hour*3,
 								time.Hour*4,
 								time.Hour*5,
+								false,
+								0, 0, 0, 0,
+								0, 0, 0, nil, nil,
 							),
 						),
 					),
@@ -226,6 +226,39 @@ func (c *Commands) RemoveHumanPhone(ctx context.Context, userID, resourceOwner s
 	return writeModelToObjectDetails(&existingPhone.WriteModel), nil
 }
 
+// SetHumanPhoneChannel sets the channel one-time codes are preferably sent to the phone of the user by.
+// [domain.PhoneChannelUnspecified] removes the preference, so the order of the notification policy is used.
+func (c *Commands) SetHumanPhoneChannel(ctx context.Context, userID, resourceOwner string, channel domain.PhoneChannel) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ahv3e", "Errors.User.UserIDMissing")
+	}
+	if channel != domain.PhoneChannelUnspecified && !channel.Valid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ua8Ae", "Errors.User.Phone.ChannelInvalid")
+	}
+
+	existingPhone, err := c.phoneWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingPhone.UserState.Exists() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohp0i", "Errors.User.NotFound")
+	}
+	if existingPhone.Channel == channel {
+		return writeModelToObjectDetails(&existingPhone.WriteModel), nil
+	}
+
+	userAgg := UserAggregateFromWriteModel(&existingPhone.WriteModel)
+	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanPhoneChannelSetEvent(ctx, userAgg, channel))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingPhone, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingPhone.WriteModel), nil
+}
+
 func (c *Commands) phoneWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanPhoneWriteModel, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
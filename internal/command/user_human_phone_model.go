@@ -15,6 +15,7 @@ type HumanPhoneWriteModel struct {
 
 	Phone           domain.PhoneNumber
 	IsPhoneVerified bool
+	Channel         domain.PhoneChannel
 
 	Code             *crypto.CryptoValue
 	CodeCreationDate time.Time
@@ -98,6 +99,8 @@ func (wm *HumanPhoneWriteModel) Reduce() error {
 		case *user.HumanPhoneCodeSentEvent:
 			wm.GeneratorID = e.GeneratorInfo.GetID()
 			wm.VerificationID = e.GeneratorInfo.GetVerificationID()
+		case *user.HumanPhoneChannelSetEvent:
+			wm.Channel = e.Channel
 		case *user.HumanPhoneRemovedEvent:
 			wm.State = domain.PhoneStateRemoved
 			wm.IsPhoneVerified = false
@@ -132,6 +135,7 @@ func (wm *HumanPhoneWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.HumanPhoneCodeAddedType,
 			user.HumanPhoneCodeSentType,
 			user.HumanPhoneRemovedType,
+			user.HumanPhoneChannelSetType,
 			user.UserRemovedType,
 			user.UserV1AddedType,
 			user.UserV1RegisteredType,
@@ -329,6 +329,7 @@ func TestCommandSide_ChangeHumanPhone(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -350,6 +351,7 @@ func TestCommandSide_ChangeHumanPhone(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -422,6 +424,7 @@ func TestCommandSide_ChangeHumanPhone(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -443,6 +446,7 @@ func TestCommandSide_ChangeHumanPhone(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -945,6 +949,7 @@ func TestCommandSide_CreateVerificationCodeHumanPhone(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -966,6 +971,7 @@ func TestCommandSide_CreateVerificationCodeHumanPhone(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1030,6 +1036,7 @@ func TestCommandSide_CreateVerificationCodeHumanPhone(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1051,6 +1058,7 @@ func TestCommandSide_CreateVerificationCodeHumanPhone(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1397,3 +1405,169 @@ func TestCommandSide_RemoveHumanPhone(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandSide_SetHumanPhoneChannel(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+		channel       domain.PhoneChannel
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				channel:       domain.PhoneChannelWhatsApp,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid channel, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				channel:       domain.PhoneChannel(42),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				channel:       domain.PhoneChannelWhatsApp,
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "channel not changed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPhoneChannelSetEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								domain.PhoneChannelWhatsApp,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				channel:       domain.PhoneChannelWhatsApp,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "set channel, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanPhoneChannelSetEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							domain.PhoneChannelVoice,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				channel:       domain.PhoneChannelVoice,
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.SetHumanPhoneChannel(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.channel)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// HumanPushDeviceWriteModel holds a single push device of a user.
+type HumanPushDeviceWriteModel struct {
+	eventstore.WriteModel
+
+	DeviceID  string
+	Name      string
+	PublicKey []byte
+	PushToken string
+	Challenge string
+	State     domain.MFAState
+}
+
+func NewHumanPushDeviceWriteModel(userID, resourceOwner, deviceID string) *HumanPushDeviceWriteModel {
+	return &HumanPushDeviceWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		DeviceID: deviceID,
+	}
+}
+
+func (wm *HumanPushDeviceWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &wm.WriteModel
+}
+
+func (wm *HumanPushDeviceWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.HumanPushDeviceAddedEvent:
+			if e.DeviceID != wm.DeviceID {
+				continue
+			}
+		case *user.HumanPushDeviceVerifiedEvent:
+			if e.DeviceID != wm.DeviceID {
+				continue
+			}
+		case *user.HumanPushDeviceRemovedEvent:
+			if e.DeviceID != wm.DeviceID {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *HumanPushDeviceWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanPushDeviceAddedEvent:
+			wm.Name = e.Name
+			wm.PublicKey = e.PublicKey
+			wm.PushToken = e.PushToken
+			wm.Challenge = e.Challenge
+			wm.State = domain.MFAStateNotReady
+		case *user.HumanPushDeviceVerifiedEvent:
+			wm.Challenge = ""
+			wm.State = domain.MFAStateReady
+		case *user.HumanPushDeviceRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+		case *user.UserRemovedEvent:
+			wm.State = domain.MFAStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanPushDeviceWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanPushDeviceAddedType,
+			user.HumanPushDeviceVerifiedType,
+			user.HumanPushDeviceRemovedType,
+			user.UserRemovedType,
+		).
+		Builder()
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// HumanPushDevicesReadModel holds all verified push devices of a user.
+type HumanPushDevicesReadModel struct {
+	eventstore.WriteModel
+
+	Devices []*HumanPushDeviceWriteModel
+}
+
+func NewHumanPushDevicesReadModel(userID, resourceOwner string) *HumanPushDevicesReadModel {
+	return &HumanPushDevicesReadModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *HumanPushDevicesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.HumanPushDeviceAddedEvent:
+			rm.Devices = append(rm.Devices, &HumanPushDeviceWriteModel{
+				WriteModel: eventstore.WriteModel{
+					AggregateID:   e.Aggregate().ID,
+					ResourceOwner: e.Aggregate().ResourceOwner,
+				},
+				DeviceID:  e.DeviceID,
+				Name:      e.Name,
+				PublicKey: e.PublicKey,
+				PushToken: e.PushToken,
+				State:     domain.MFAStateNotReady,
+			})
+		case *user.HumanPushDeviceVerifiedEvent:
+			for _, device := range rm.Devices {
+				if device.DeviceID == e.DeviceID {
+					device.State = domain.MFAStateReady
+				}
+			}
+		case *user.HumanPushDeviceRemovedEvent:
+			rm.Devices = slices.DeleteFunc(rm.Devices, func(device *HumanPushDeviceWriteModel) bool {
+				return device.DeviceID == e.DeviceID
+			})
+		case *user.UserRemovedEvent:
+			rm.Devices = nil
+		}
+	}
+	// devices, which never proved the possession of their private key, can't be used
+	rm.Devices = slices.DeleteFunc(rm.Devices, func(device *HumanPushDeviceWriteModel) bool {
+		return device.State != domain.MFAStateReady
+	})
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *HumanPushDevicesReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.HumanPushDeviceAddedType,
+			user.HumanPushDeviceVerifiedType,
+			user.HumanPushDeviceRemovedType,
+			user.UserRemovedType,
+		).
+		Builder()
+	if rm.ResourceOwner != "" {
+		query.ResourceOwner(rm.ResourceOwner)
+	}
+	return query
+}
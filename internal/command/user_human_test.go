@@ -533,6 +533,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -605,6 +606,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -680,6 +682,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -756,6 +759,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -818,6 +822,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -883,6 +888,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -948,6 +954,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1074,6 +1081,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1155,6 +1163,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1164,6 +1173,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1185,6 +1195,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1333,6 +1344,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1342,6 +1354,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1363,6 +1376,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1678,6 +1692,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1724,6 +1739,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1811,6 +1827,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1898,6 +1915,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1979,6 +1997,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2079,6 +2098,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2183,6 +2203,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2192,6 +2213,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										context.Background(),
 										&instance.NewAggregate("instanceID").Aggregate,
 										"id",
+										domain.PhoneChannelSMS,
 									),
 								),
 							),
@@ -2213,6 +2235,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										context.Background(),
 										&instance.NewAggregate("instanceID").Aggregate,
 										"id",
+										domain.PhoneChannelSMS,
 									),
 								),
 							),
@@ -2319,6 +2342,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2415,6 +2439,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2501,6 +2526,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2588,6 +2614,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2734,6 +2761,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2878,6 +2906,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -3051,6 +3080,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -3224,6 +3254,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -3404,6 +3435,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -4057,6 +4089,7 @@ func TestAddHumanCommand(t *testing.T) {
 									true,
 									true,
 									true,
+									false,
 								),
 							}, nil
 						}).
@@ -4114,6 +4147,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
@@ -4191,6 +4225,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
@@ -4266,6 +4301,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
@@ -4342,6 +4378,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
@@ -4418,6 +4455,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
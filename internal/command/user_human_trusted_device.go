@@ -0,0 +1,34 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RemoveHumanTrustedDevice removes the trust of the user in the device (user agent).
+// The user has to pass the multi-factor check again on the next login from the device.
+func (c *Commands) RemoveHumanTrustedDevice(ctx context.Context, userID, fingerprintID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || fingerprintID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Chu9a", "Errors.IDMissing")
+	}
+	wm := NewHumanTrustedDeviceWriteModel(userID, "", fingerprintID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if !wm.Trusted {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Wei3o", "Errors.User.TrustedDevice.NotFound")
+	}
+	if err = c.checkPermissionUpdateUser(ctx, wm.ResourceOwner, wm.AggregateID, true); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		user.NewHumanTrustedDeviceRemovedEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), fingerprintID),
+	)
+}
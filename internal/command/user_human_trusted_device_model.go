@@ -0,0 +1,86 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// HumanTrustedDeviceWriteModel holds the trust of a user in a single user agent.
+type HumanTrustedDeviceWriteModel struct {
+	eventstore.WriteModel
+
+	FingerprintID  string
+	Trusted        bool
+	ExpirationDate time.Time
+}
+
+func NewHumanTrustedDeviceWriteModel(userID, resourceOwner, fingerprintID string) *HumanTrustedDeviceWriteModel {
+	return &HumanTrustedDeviceWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		FingerprintID: fingerprintID,
+	}
+}
+
+func (wm *HumanTrustedDeviceWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &wm.WriteModel
+}
+
+func (wm *HumanTrustedDeviceWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.HumanTrustedDeviceAddedEvent:
+			if e.FingerprintID != wm.FingerprintID {
+				continue
+			}
+		case *user.HumanTrustedDeviceRemovedEvent:
+			if e.FingerprintID != wm.FingerprintID {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanTrustedDeviceAddedEvent:
+			wm.Trusted = true
+			wm.ExpirationDate = e.ExpirationDate
+		case *user.HumanTrustedDeviceRemovedEvent:
+			wm.Trusted = false
+			wm.ExpirationDate = time.Time{}
+		case *user.UserRemovedEvent:
+			wm.Trusted = false
+			wm.ExpirationDate = time.Time{}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanTrustedDeviceWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanTrustedDeviceAddedType,
+			user.HumanTrustedDeviceRemovedType,
+			user.UserRemovedType,
+		).
+		Builder()
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// IsTrusted reports whether the device is trusted and the trust has not expired yet.
+func (wm *HumanTrustedDeviceWriteModel) IsTrusted(now time.Time) bool {
+	return wm.Trusted && now.Before(wm.ExpirationDate)
+}
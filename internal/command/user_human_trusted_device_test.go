@@ -0,0 +1,162 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_RemoveHumanTrustedDevice(t *testing.T) {
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		fingerprintID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr error
+	}{
+		{
+			name: "missing fingerprint id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    authz.NewMockContext("instanceID", "org1", "user1"),
+				userID: "user1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Chu9a", "Errors.IDMissing"),
+		},
+		{
+			name: "not trusted",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContext("instanceID", "org1", "user1"),
+				userID:        "user1",
+				fingerprintID: "fingerprint1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Wei3o", "Errors.User.TrustedDevice.NotFound"),
+		},
+		{
+			name: "already removed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceAddedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+								"fingerprint1", "Firefox", testNow.Add(time.Hour)),
+						),
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceRemovedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+								"fingerprint1"),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContext("instanceID", "org1", "user1"),
+				userID:        "user1",
+				fingerprintID: "fingerprint1",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Wei3o", "Errors.User.TrustedDevice.NotFound"),
+		},
+		{
+			name: "other user, permission denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceAddedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+								"fingerprint1", "Firefox", testNow.Add(time.Hour)),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:           authz.NewMockContext("instanceID", "org1", "user2"),
+				userID:        "user1",
+				fingerprintID: "fingerprint1",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "own device, removed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceAddedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+								"fingerprint1", "Firefox", testNow.Add(time.Hour)),
+						),
+					),
+					expectPush(
+						user.NewHumanTrustedDeviceRemovedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+							"fingerprint1"),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:           authz.NewMockContext("instanceID", "org1", "user1"),
+				userID:        "user1",
+				fingerprintID: "fingerprint1",
+			},
+			want: &domain.ObjectDetails{ResourceOwner: "org1"},
+		},
+		{
+			name: "other user, removed with permission",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanTrustedDeviceAddedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+								"fingerprint1", "Firefox", testNow.Add(time.Hour)),
+						),
+					),
+					expectPush(
+						user.NewHumanTrustedDeviceRemovedEvent(context.Background(), &user.NewAggregate("user1", "org1").Aggregate,
+							"fingerprint1"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:           authz.NewMockContext("instanceID", "org1", "user2"),
+				userID:        "user1",
+				fingerprintID: "fingerprint1",
+			},
+			want: &domain.ObjectDetails{ResourceOwner: "org1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RemoveHumanTrustedDevice(tt.args.ctx, tt.args.userID, tt.args.fingerprintID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
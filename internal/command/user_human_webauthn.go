@@ -160,7 +160,11 @@ func (c *Commands) addHumanWebAuthN(ctx context.Context, userID, resourceowner,
 	if accountName == "" {
 		accountName = string(user.EmailAddress)
 	}
-	webAuthN, err := c.webauthnConfig.BeginRegistration(ctx, user, accountName, authenticatorPlatform, userVerification, rpID, tokens...)
+	loginPolicy, err := activeLoginPolicyWriteModel(ctx, c.eventstore, org.AggregateID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	webAuthN, err := c.webauthnConfig.BeginRegistration(ctx, user, accountName, authenticatorPlatform, userVerification, loginPolicy.WebAuthNPolicy(), rpID, tokens...)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -274,8 +278,12 @@ func (c *Commands) verifyHumanWebAuthN(ctx context.Context, userID, resourceowne
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	loginPolicy, err := activeLoginPolicyWriteModel(ctx, c.eventstore, user.ResourceOwner)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	_, token := domain.GetTokenToVerify(tokens)
-	webAuthN, err := c.webauthnConfig.FinishRegistration(ctx, user, token, tokenName, credentialData)
+	webAuthN, err := c.webauthnConfig.FinishRegistration(ctx, user, token, tokenName, credentialData, loginPolicy.WebAuthNPolicy())
 	if err != nil {
 		return nil, nil, nil, err
 	}
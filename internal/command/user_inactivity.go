@@ -0,0 +1,130 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// InactiveUser is a user which was not active since LastActivity.
+type InactiveUser struct {
+	UserID        string
+	ResourceOwner string
+	LastActivity  time.Time
+	// CheckedAt is the time the user was checked for inactivity, defaults to now
+	CheckedAt time.Time
+}
+
+// HandleInactiveUser applies the inactivity steps of the inactivity policy to the user.
+// Depending on the time since the last activity the user is warned, deactivated or removed.
+// Every step is only executed once, reactivating or unlocking the user counts as activity.
+// A user is always warned before the deactivation or removal, if the warning is late the following steps are postponed,
+// see [domain.InactivityPolicy.Since].
+// The cascading memberships, grants and groups are only removed if the user is removed.
+func (c *Commands) HandleInactiveUser(
+	ctx context.Context,
+	inactive *InactiveUser,
+	policy *domain.InactivityPolicy,
+	cascadingUserMemberships []*CascadingMembership,
+	cascadingGrantIDs, cascadingGroupIDs []string,
+) (_ domain.InactivityAction, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if inactive == nil || inactive.UserID == "" {
+		return domain.InactivityActionNone, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ooy4u", "Errors.User.UserIDMissing")
+	}
+	if policy == nil || !policy.Enabled() {
+		return domain.InactivityActionNone, nil
+	}
+	existingUser, err := c.userInactivityWriteModelByID(ctx, inactive.UserID, inactive.ResourceOwner)
+	if err != nil {
+		return domain.InactivityActionNone, err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return domain.InactivityActionNone, zerrors.ThrowNotFound(nil, "COMMAND-eiX3o", "Errors.User.NotFound")
+	}
+	if policy.ExemptMachineUsers && existingUser.UserType == domain.UserTypeMachine {
+		return domain.InactivityActionNone, nil
+	}
+	lastActivity := inactive.LastActivity
+	if existingUser.ActivatedAt.After(lastActivity) {
+		lastActivity = existingUser.ActivatedAt
+	}
+	userAgg := UserAggregateFromWriteModel(&existingUser.WriteModel)
+	now := inactive.CheckedAt
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	action := policy.Action(lastActivity, now)
+	if action == domain.InactivityActionNone {
+		return domain.InactivityActionNone, nil
+	}
+	if !existingUser.WarnedAt.After(lastActivity) {
+		warned := user.NewInactivityWarnedEvent(ctx, userAgg, lastActivity, policy.DeactivationDate(policy.Since(lastActivity, now)))
+		if _, err = c.eventstore.Push(ctx, warned); err != nil {
+			return domain.InactivityActionNone, err
+		}
+		return domain.InactivityActionWarn, nil
+	}
+
+	var events []eventstore.Command
+	action = policy.Action(policy.Since(lastActivity, existingUser.WarnedAt), now)
+	switch action {
+	case domain.InactivityActionNone, domain.InactivityActionWarn:
+		return domain.InactivityActionNone, nil
+	case domain.InactivityActionDeactivate:
+		if isUserStateInactive(existingUser.UserState) || isUserStateInitial(existingUser.UserState) {
+			return domain.InactivityActionNone, nil
+		}
+		events = append(events,
+			user.NewInactivityDeactivatedEvent(ctx, userAgg, lastActivity),
+			user.NewUserDeactivatedEvent(ctx, userAgg),
+		)
+	case domain.InactivityActionDelete:
+		removeEvents, err := c.removeUserEvents(ctx, &existingUser.UserWriteModel, cascadingUserMemberships, cascadingGrantIDs, cascadingGroupIDs)
+		if err != nil {
+			return domain.InactivityActionNone, err
+		}
+		events = append(events, user.NewInactivityRemovedEvent(ctx, userAgg, lastActivity))
+		events = append(events, removeEvents...)
+	}
+	if _, err = c.eventstore.Push(ctx, events...); err != nil {
+		return domain.InactivityActionNone, err
+	}
+	return action, nil
+}
+
+// InactivityWarningSent records that the user was notified about the upcoming deactivation.
+func (c *Commands) InactivityWarningSent(ctx context.Context, resourceOwner, userID string) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ru0ei", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Ohb0e", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx, user.NewInactivityWarningSentEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel)))
+	return err
+}
+
+func (c *Commands) userInactivityWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *userInactivityWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = newUserInactivityWriteModel(userID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type userInactivityWriteModel struct {
+	UserWriteModel
+
+	// ActivatedAt is the last time the user was reactivated or unlocked
+	ActivatedAt time.Time
+	// WarnedAt is the last time the user was warned about the inactivity
+	WarnedAt time.Time
+}
+
+func newUserInactivityWriteModel(userID, resourceOwner string) *userInactivityWriteModel {
+	return &userInactivityWriteModel{
+		UserWriteModel: *NewUserWriteModel(userID, resourceOwner),
+	}
+}
+
+func (wm *userInactivityWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.UserReactivatedEvent:
+			wm.ActivatedAt = e.CreatedAt()
+		case *user.UserUnlockedEvent:
+			wm.ActivatedAt = e.CreatedAt()
+		case *user.InactivityWarnedEvent:
+			wm.WarnedAt = e.CreatedAt()
+		}
+	}
+	return wm.UserWriteModel.Reduce()
+}
+
+func (wm *userInactivityWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return wm.UserWriteModel.Query().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.InactivityWarnedType).
+		Builder()
+}
@@ -0,0 +1,491 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_HandleInactiveUser(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		inactive *InactiveUser
+		policy   *domain.InactivityPolicy
+	}
+	type res struct {
+		want domain.InactivityAction
+		err  func(error) bool
+	}
+	now := time.Now()
+	policy := &domain.InactivityPolicy{
+		WarnDays:       30,
+		DeactivateDays: 60,
+		DeleteDays:     90,
+	}
+	humanAdded := func() *user.HumanAddedEvent {
+		return user.NewHumanAddedEvent(context.Background(),
+			&user.NewAggregate("user1", "org1").Aggregate,
+			"username",
+			"firstname",
+			"lastname",
+			"nickname",
+			"displayname",
+			language.German,
+			domain.GenderUnspecified,
+			"email@test.ch",
+			true,
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{ResourceOwner: "org1"},
+				policy:   policy,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "inactivity disabled, none",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -100), CheckedAt: now},
+				policy:   &domain.InactivityPolicy{},
+			},
+			res: res{
+				want: domain.InactivityActionNone,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -40), CheckedAt: now},
+				policy:   policy,
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "machine user exempt, none",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMachineAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"name",
+								"description",
+								true,
+								domain.OIDCTokenTypeBearer,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -100), CheckedAt: now},
+				policy: &domain.InactivityPolicy{
+					DeactivateDays:     60,
+					ExemptMachineUsers: true,
+				},
+			},
+			res: res{
+				want: domain.InactivityActionNone,
+			},
+		},
+		{
+			name: "warn user, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+					),
+					expectPush(
+						user.NewInactivityWarnedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							now.AddDate(0, 0, -30),
+							now.AddDate(0, 0, 30),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -30), CheckedAt: now},
+				policy:   policy,
+			},
+			res: res{
+				want: domain.InactivityActionWarn,
+			},
+		},
+		{
+			name: "user already warned, none",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+						eventFromEventPusherWithCreationDate(
+							user.NewInactivityWarnedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								now.AddDate(0, 0, -40),
+								now.AddDate(0, 0, 20),
+							),
+							now.AddDate(0, 0, -5),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -40), CheckedAt: now},
+				policy:   policy,
+			},
+			res: res{
+				want: domain.InactivityActionNone,
+			},
+		},
+		{
+			name: "user inactive longer than deactivation but not warned, warn",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+					),
+					expectPush(
+						user.NewInactivityWarnedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							now.AddDate(0, 0, -100),
+							now.AddDate(0, 0, 30),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -100), CheckedAt: now},
+				policy:   policy,
+			},
+			res: res{
+				want: domain.InactivityActionWarn,
+			},
+		},
+		{
+			name: "user warned late, deactivation postponed, none",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+						eventFromEventPusherWithCreationDate(
+							user.NewInactivityWarnedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								now.AddDate(0, 0, -70),
+								now.AddDate(0, 0, 20),
+							),
+							now.AddDate(0, 0, -10),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -70), CheckedAt: now},
+				policy:   policy,
+			},
+			res: res{
+				want: domain.InactivityActionNone,
+			},
+		},
+		{
+			name: "reactivated user, none",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+						eventFromEventPusherWithCreationDate(
+							user.NewUserDeactivatedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+							now.AddDate(0, 0, -10),
+						),
+						eventFromEventPusherWithCreationDate(
+							user.NewUserReactivatedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+							now.AddDate(0, 0, -5),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -70), CheckedAt: now},
+				policy:   policy,
+			},
+			res: res{
+				want: domain.InactivityActionNone,
+			},
+		},
+		{
+			name: "deactivate user, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+						eventFromEventPusherWithCreationDate(
+							user.NewInactivityWarnedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								now.AddDate(0, 0, -70),
+								now.AddDate(0, 0, -10),
+							),
+							now.AddDate(0, 0, -40),
+						),
+					),
+					expectPush(
+						user.NewInactivityDeactivatedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							now.AddDate(0, 0, -70),
+						),
+						user.NewUserDeactivatedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -70), CheckedAt: now},
+				policy:   policy,
+			},
+			res: res{
+				want: domain.InactivityActionDeactivate,
+			},
+		},
+		{
+			name: "user already inactive, none",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+						eventFromEventPusherWithCreationDate(
+							user.NewInactivityWarnedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								now.AddDate(0, 0, -80),
+								now.AddDate(0, 0, -20),
+							),
+							now.AddDate(0, 0, -50),
+						),
+						eventFromEventPusherWithCreationDate(
+							user.NewUserDeactivatedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+							now.AddDate(0, 0, -75),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -80), CheckedAt: now},
+				policy:   policy,
+			},
+			res: res{
+				want: domain.InactivityActionNone,
+			},
+		},
+		{
+			name: "remove user, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(humanAdded()),
+						eventFromEventPusherWithCreationDate(
+							user.NewInactivityWarnedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								now.AddDate(0, 0, -100),
+								now.AddDate(0, 0, -40),
+							),
+							now.AddDate(0, 0, -70),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewDomainPolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilterOrganizationSettings("org1", false, false),
+					expectPush(
+						user.NewInactivityRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							now.AddDate(0, 0, -100),
+						),
+						user.NewUserRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"username",
+							nil,
+							true,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:      context.Background(),
+				inactive: &InactiveUser{UserID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -100), CheckedAt: now},
+				policy:   policy,
+			},
+			res: res{
+				want: domain.InactivityActionDelete,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.HandleInactiveUser(tt.args.ctx, tt.args.inactive, tt.args.policy, nil, nil, nil)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_InactivityWarningSent(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx    context.Context
+		orgID  string
+		userID string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "warning sent, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewMachineAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"name",
+								"description",
+								true,
+								domain.OIDCTokenTypeBearer,
+							),
+						),
+					),
+					expectPush(
+						user.NewInactivityWarningSentEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.InactivityWarningSent(tt.args.ctx, tt.args.orgID, tt.args.userID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SecurityNotificationSent records that the user was alerted about the change of the account caused by an event of triggerType.
+func (c *Commands) SecurityNotificationSent(ctx context.Context, orgID, userID string, triggerType eventstore.EventType) (err error) {
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Uo5ee", "Errors.User.UserIDMissing")
+	}
+	existingUser, err := c.userWriteModelByID(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if !isUserStateExists(existingUser.UserState) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooP7e", "Errors.User.NotFound")
+	}
+	_, err = c.eventstore.Push(ctx,
+		user.NewSecurityNotificationSentEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel), triggerType),
+	)
+	return err
+}
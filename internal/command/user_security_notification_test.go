@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SecurityNotificationSent(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx         context.Context
+		orgID       string
+		userID      string
+		triggerType eventstore.EventType
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"missing user id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:         context.Background(),
+				triggerType: user.HumanEmailChangedType,
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Uo5ee", "Errors.User.UserIDMissing"),
+		},
+		{
+			"user does not exist",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:         context.Background(),
+				userID:      "unknown",
+				triggerType: user.HumanEmailChangedType,
+			},
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-ooP7e", "Errors.User.NotFound"),
+		},
+		{
+			"sent ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("userID", "org1").Aggregate,
+								"username", "firstName",
+								"lastName",
+								"nickName",
+								"displayName",
+								language.Afrikaans,
+								domain.GenderUnspecified,
+								"email",
+								false,
+							),
+						),
+					),
+					expectPush(
+						user.NewSecurityNotificationSentEvent(context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							user.HumanEmailChangedType,
+						),
+					),
+				),
+			},
+			args{
+				ctx:         context.Background(),
+				orgID:       "org1",
+				userID:      "userID",
+				triggerType: user.HumanEmailChangedType,
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.SecurityNotificationSent(tt.args.ctx, tt.args.orgID, tt.args.userID, tt.args.triggerType)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package command
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+)
+
+// UserSessionsWriteModel computes the state of the sessions of a user.
+// As the sessions are only linked to the user by the [session.UserCheckedEvent],
+// the IDs of the sessions have to be searched in the fields table beforehand (see [Commands.searchUserSessionIDs]).
+type UserSessionsWriteModel struct {
+	eventstore.WriteModel
+
+	UserID   string
+	Sessions map[string]*UserSessionModel
+
+	sessionIDs []string
+}
+
+type UserSessionModel struct {
+	ID            string
+	ResourceOwner string
+	UserID        string
+	State         domain.SessionState
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Expiration    time.Time
+}
+
+func NewUserSessionsWriteModel(userID string, sessionIDs []string) *UserSessionsWriteModel {
+	return &UserSessionsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: userID,
+		},
+		UserID:     userID,
+		Sessions:   make(map[string]*UserSessionModel),
+		sessionIDs: sessionIDs,
+	}
+}
+
+func (wm *UserSessionsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		sessionModel, ok := wm.Sessions[event.Aggregate().ID]
+		if !ok {
+			sessionModel = &UserSessionModel{
+				ID:            event.Aggregate().ID,
+				ResourceOwner: event.Aggregate().ResourceOwner,
+			}
+			wm.Sessions[sessionModel.ID] = sessionModel
+		}
+		// every event of the session counts as activity for the idle timeout
+		sessionModel.ChangeDate = event.CreatedAt()
+		switch e := event.(type) {
+		case *session.AddedEvent:
+			sessionModel.State = domain.SessionStateActive
+			sessionModel.CreationDate = e.CreationDate()
+		case *session.UserCheckedEvent:
+			sessionModel.UserID = e.UserID
+		case *session.LifetimeSetEvent:
+			sessionModel.Expiration = e.CreationDate().Add(e.Lifetime)
+		case *session.TerminateEvent:
+			sessionModel.State = domain.SessionStateTerminated
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserSessionsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		AggregateIDs(wm.sessionIDs...).
+		Builder()
+}
+
+// ActiveSessions returns the sessions of the user which are neither terminated, expired nor idle, the oldest first.
+// An idleTimeout of 0 disables the idle check.
+func (wm *UserSessionsWriteModel) ActiveSessions(now time.Time, idleTimeout time.Duration) []*UserSessionModel {
+	active := make([]*UserSessionModel, 0, len(wm.Sessions))
+	for _, sessionModel := range wm.Sessions {
+		if sessionModel.State != domain.SessionStateActive || sessionModel.UserID != wm.UserID {
+			continue
+		}
+		if !sessionModel.Expiration.IsZero() && sessionModel.Expiration.Before(now) {
+			continue
+		}
+		if idleTimeout > 0 && now.After(sessionModel.ChangeDate.Add(idleTimeout)) {
+			continue
+		}
+		active = append(active, sessionModel)
+	}
+	slices.SortFunc(active, func(a, b *UserSessionModel) int {
+		if c := a.CreationDate.Compare(b.CreationDate); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return active
+}
@@ -0,0 +1,68 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func TestUserSessionsWriteModel_ActiveSessions(t *testing.T) {
+	tests := []struct {
+		name        string
+		sessions    []*UserSessionModel
+		idleTimeout time.Duration
+		want        []string
+	}{
+		{
+			name: "terminated and other user, ignored",
+			sessions: []*UserSessionModel{
+				{ID: "terminated", UserID: "userID", State: domain.SessionStateTerminated, CreationDate: testNow, ChangeDate: testNow},
+				{ID: "other", UserID: "otherUserID", State: domain.SessionStateActive, CreationDate: testNow, ChangeDate: testNow},
+				{ID: "active", UserID: "userID", State: domain.SessionStateActive, CreationDate: testNow, ChangeDate: testNow},
+			},
+			want: []string{"active"},
+		},
+		{
+			name: "expired, ignored",
+			sessions: []*UserSessionModel{
+				{ID: "expired", UserID: "userID", State: domain.SessionStateActive, CreationDate: testNow.Add(-time.Hour), ChangeDate: testNow, Expiration: testNow.Add(-time.Minute)},
+				{ID: "active", UserID: "userID", State: domain.SessionStateActive, CreationDate: testNow, ChangeDate: testNow, Expiration: testNow.Add(time.Minute)},
+			},
+			want: []string{"active"},
+		},
+		{
+			name: "idle, ignored",
+			sessions: []*UserSessionModel{
+				{ID: "idle", UserID: "userID", State: domain.SessionStateActive, CreationDate: testNow.Add(-time.Hour), ChangeDate: testNow.Add(-time.Hour)},
+				{ID: "active", UserID: "userID", State: domain.SessionStateActive, CreationDate: testNow.Add(-time.Hour), ChangeDate: testNow.Add(-time.Minute)},
+			},
+			idleTimeout: 30 * time.Minute,
+			want:        []string{"active"},
+		},
+		{
+			name: "no idle timeout, oldest first",
+			sessions: []*UserSessionModel{
+				{ID: "new", UserID: "userID", State: domain.SessionStateActive, CreationDate: testNow, ChangeDate: testNow},
+				{ID: "idle", UserID: "userID", State: domain.SessionStateActive, CreationDate: testNow.Add(-time.Hour), ChangeDate: testNow.Add(-time.Hour)},
+			},
+			want: []string{"idle", "new"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wm := NewUserSessionsWriteModel("userID", nil)
+			for _, sessionModel := range tt.sessions {
+				wm.Sessions[sessionModel.ID] = sessionModel
+			}
+			got := wm.ActiveSessions(testNow, tt.idleTimeout)
+			ids := make([]string, len(got))
+			for i, sessionModel := range got {
+				ids[i] = sessionModel.ID
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
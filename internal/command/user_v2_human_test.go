@@ -498,6 +498,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -642,6 +643,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -723,6 +725,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -805,6 +808,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -876,6 +880,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -947,6 +952,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1085,6 +1091,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1172,6 +1179,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1181,6 +1189,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1202,6 +1211,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1289,6 +1299,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1298,6 +1309,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1319,6 +1331,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1474,6 +1487,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1483,6 +1497,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1504,6 +1519,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -3073,6 +3089,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -3094,6 +3111,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -3155,6 +3173,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -3176,6 +3195,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -3399,6 +3419,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -3420,6 +3441,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -3553,6 +3575,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								true,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -3663,6 +3686,17 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordAgePolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								0,
+								3,
 							),
 						),
 					),
@@ -3722,6 +3756,17 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordAgePolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								0,
+								3,
 							),
 						),
 					),
@@ -3851,6 +3896,17 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordAgePolicyAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								0,
+								0,
+								3,
 							),
 						),
 					),
@@ -3981,6 +4037,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 							),
 						),
 					),
@@ -4109,6 +4166,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
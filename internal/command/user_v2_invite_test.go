@@ -1697,6 +1697,7 @@ func TestCommands_VerifyInviteCodeSetPassword(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 							),
 						),
 					),
@@ -1782,6 +1783,7 @@ func TestCommands_VerifyInviteCodeSetPassword(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 							),
 						),
 					),
@@ -53,6 +53,8 @@ type UserV2WriteModel struct {
 	PasswordCheckFailedCount   uint64
 	PasswordCodeGeneratorID    string
 	PasswordCodeVerificationID string
+	// PasswordHistory contains the encoded hashes of the previous passwords including the current one, the most recent last.
+	PasswordHistory []string
 
 	EmailWriteModel       bool
 	Email                 domain.EmailAddress
@@ -309,6 +311,7 @@ func (wm *UserV2WriteModel) Reduce() error {
 
 		case *user.HumanPasswordHashUpdatedEvent:
 			wm.PasswordEncodedHash = e.EncodedHash
+			wm.PasswordHistory = replaceCurrentPasswordHistory(wm.PasswordHistory, wm.PasswordEncodedHash)
 		case *user.HumanPasswordCheckFailedEvent:
 			wm.PasswordCheckFailedCount += 1
 		case *user.HumanPasswordCheckSucceededEvent:
@@ -316,6 +319,7 @@ func (wm *UserV2WriteModel) Reduce() error {
 		case *user.HumanPasswordChangedEvent:
 			wm.PasswordEncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 			wm.PasswordChangeRequired = e.ChangeRequired
+			wm.PasswordHistory = appendPasswordHistory(wm.PasswordHistory, wm.PasswordEncodedHash)
 			wm.PasswordCheckFailedCount = 0
 			wm.EmptyPasswordCode()
 		case *user.HumanPasswordCodeAddedEvent:
@@ -547,6 +551,7 @@ func (wm *UserV2WriteModel) reduceHumanAddedEvent(e *user.HumanAddedEvent) {
 	wm.UserState = domain.UserStateActive
 	wm.PasswordEncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 	wm.PasswordChangeRequired = e.ChangeRequired
+	wm.PasswordHistory = appendPasswordHistory(nil, wm.PasswordEncodedHash)
 	wm.CreationDate = e.Creation
 }
 
@@ -563,6 +568,7 @@ func (wm *UserV2WriteModel) reduceHumanRegisteredEvent(e *user.HumanRegisteredEv
 	wm.UserState = domain.UserStateActive
 	wm.PasswordEncodedHash = crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)
 	wm.PasswordChangeRequired = e.ChangeRequired
+	wm.PasswordHistory = appendPasswordHistory(nil, wm.PasswordEncodedHash)
 }
 
 func (wm *UserV2WriteModel) reduceHumanProfileChangedEvent(e *user.HumanProfileChangedEvent) {
@@ -658,6 +664,7 @@ func (wm *UserV2WriteModel) reset() {
 	wm.PasswordCheckFailedCount = 0
 	wm.PasswordCodeGeneratorID = ""
 	wm.PasswordCodeVerificationID = ""
+	wm.PasswordHistory = nil
 	wm.Email = ""
 	wm.IsEmailVerified = false
 	wm.EmailCode = nil
@@ -109,6 +109,8 @@ func TestCommands_RegisterUserPasskey(t *testing.T) {
 							false, false, false,
 						),
 					)),
+					expectFilter(), // org login policy
+					expectFilter(), // instance login policy
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectError(t, io.ErrClosedPipe),
 			},
@@ -439,6 +441,8 @@ func TestCommands_pushUserPasskey(t *testing.T) {
 				false, false, false,
 			),
 		)),
+		expectFilter(), // org login policy
+		expectFilter(), // instance login policy
 		expectFilter(eventFromEventPusher(
 			user.NewHumanWebAuthNAddedEvent(eventstore.NewBaseEventForPush(
 				ctx, &org.NewAggregate("org1").Aggregate, user.HumanPasswordlessTokenAddedType,
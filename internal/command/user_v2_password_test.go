@@ -545,6 +545,7 @@ func TestCommands_requestPasswordReset(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -566,6 +567,7 @@ func TestCommands_requestPasswordReset(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -620,6 +622,7 @@ func TestCommands_requestPasswordReset(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -641,6 +644,7 @@ func TestCommands_requestPasswordReset(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -844,6 +844,7 @@ func TestCommands_changeUserPhoneWithGenerator(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -865,6 +866,7 @@ func TestCommands_changeUserPhoneWithGenerator(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1111,6 +1113,7 @@ func TestCommands_resendUserPhoneCodeWithGenerator(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1132,6 +1135,7 @@ func TestCommands_resendUserPhoneCodeWithGenerator(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1207,6 +1211,7 @@ func TestCommands_resendUserPhoneCodeWithGenerator(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -1228,6 +1233,7 @@ func TestCommands_resendUserPhoneCodeWithGenerator(t *testing.T) {
 								context.Background(),
 								&instance.NewAggregate("instanceID").Aggregate,
 								"id",
+								domain.PhoneChannelSMS,
 							),
 						),
 					),
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddUserPushDevice registers a device of the user, which approves session push challenges.
+// The publicKey (PKIX, ASN.1 DER) is used to verify the approvals signed by the device
+// and the pushToken is passed to the push channel to address the device.
+// The registration must be completed by the device signing the returned challenge (see [Commands.VerifyUserPushDevice]).
+func (c *Commands) AddUserPushDevice(ctx context.Context, userID, resourceOwner, name string, publicKey []byte, pushToken string) (_ *domain.PushDeviceRegistrationDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ahh7U", "Errors.User.UserIDMissing")
+	}
+	if pushToken == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ra5qu", "Errors.User.Push.TokenMissing")
+	}
+	if _, err = domain.ParsePushPublicKey(publicKey); err != nil {
+		return nil, err
+	}
+	resourceOwner, err = c.checkUserExists(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.checkPermissionUpdateUserCredentials(ctx, resourceOwner, userID); err != nil {
+		return nil, err
+	}
+	deviceID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	challenge, _, err := c.pushChallengeGenerator()
+	if err != nil {
+		return nil, err
+	}
+	wm := NewHumanPushDeviceWriteModel(userID, resourceOwner, deviceID)
+	if err = c.pushAppendAndReduce(ctx, wm,
+		user.NewHumanPushDeviceAddedEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), deviceID, name, publicKey, pushToken, challenge),
+	); err != nil {
+		return nil, err
+	}
+	return &domain.PushDeviceRegistrationDetails{
+		ObjectDetails: writeModelToObjectDetails(&wm.WriteModel),
+		ID:            deviceID,
+		Challenge:     challenge,
+	}, nil
+}
+
+// VerifyUserPushDevice completes the registration of a push device.
+// The signedChallenge must be a compact JWS of the registration challenge signed with the private key of the device.
+func (c *Commands) VerifyUserPushDevice(ctx context.Context, userID, resourceOwner, deviceID, signedChallenge string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.pushDeviceWriteModel(ctx, userID, resourceOwner, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if wm.State == domain.MFAStateReady {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohk5e", "Errors.User.Push.AlreadyVerified")
+	}
+	if err = c.checkPermissionUpdateUserCredentials(ctx, wm.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	if _, err = domain.VerifyPushApproval(signedChallenge, wm.PublicKey, wm.Challenge); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		user.NewHumanPushDeviceVerifiedEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), deviceID),
+	)
+}
+
+// RemoveUserPushDevice removes the push device, so it can no longer approve push challenges.
+func (c *Commands) RemoveUserPushDevice(ctx context.Context, userID, resourceOwner, deviceID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.pushDeviceWriteModel(ctx, userID, resourceOwner, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.checkPermissionUpdateUserCredentials(ctx, wm.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		user.NewHumanPushDeviceRemovedEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &wm.WriteModel), deviceID),
+	)
+}
+
+func (c *Commands) pushDeviceWriteModel(ctx context.Context, userID, resourceOwner, deviceID string) (*HumanPushDeviceWriteModel, error) {
+	if userID == "" || deviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Eech0", "Errors.IDMissing")
+	}
+	wm := NewHumanPushDeviceWriteModel(userID, resourceOwner, deviceID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if wm.State == domain.MFAStateUnspecified || wm.State == domain.MFAStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cai8o", "Errors.User.Push.NotFound")
+	}
+	return wm, nil
+}
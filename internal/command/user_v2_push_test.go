@@ -0,0 +1,364 @@
+package command
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testPushDevice struct {
+	key       *ecdsa.PrivateKey
+	publicKey []byte
+}
+
+func newTestPushDevice(t *testing.T) *testPushDevice {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return &testPushDevice{key: key, publicKey: publicKey}
+}
+
+func (d *testPushDevice) sign(t *testing.T, deviceID string, approval *domain.PushApproval) string {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: d.key}, (&jose.SignerOptions{}).WithHeader(jose.HeaderKey("kid"), deviceID))
+	require.NoError(t, err)
+	payload, err := json.Marshal(approval)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	signed, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return signed
+}
+
+func mockPushChallenge(challenge string, number uint32) func() (string, uint32, error) {
+	return func() (string, uint32, error) {
+		return challenge, number, nil
+	}
+}
+
+func TestCommands_AddUserPushDevice(t *testing.T) {
+	device := newTestPushDevice(t)
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx       context.Context
+		userID    string
+		publicKey []byte
+		pushToken string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.PushDeviceRegistrationDetails
+		wantErr error
+	}{
+		{
+			name: "missing push token, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:       authz.NewMockContext("instanceID", "org1", "user1"),
+				userID:    "user1",
+				publicKey: device.publicKey,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ra5qu", "Errors.User.Push.TokenMissing"),
+		},
+		{
+			name: "invalid public key, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:       authz.NewMockContext("instanceID", "org1", "user1"),
+				userID:    "user1",
+				publicKey: []byte("key"),
+				pushToken: "token",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ahz4o", "Errors.User.Push.PublicKeyInvalid"),
+		},
+		{
+			name: "user not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:       authz.NewMockContext("instanceID", "org1", "user1"),
+				userID:    "user1",
+				publicKey: device.publicKey,
+				pushToken: "token",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-uXHNj", "Errors.User.NotFound"),
+		},
+		{
+			name: "other user, permission denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(), userAgg,
+								"username", "firstname", "lastname", "nickname", "displayname",
+								language.German, domain.GenderUnspecified, "email@test.ch", true,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:       authz.NewMockContext("instanceID", "org1", "user2"),
+				userID:    "user1",
+				publicKey: device.publicKey,
+				pushToken: "token",
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "own device, added",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(), userAgg,
+								"username", "firstname", "lastname", "nickname", "displayname",
+								language.German, domain.GenderUnspecified, "email@test.ch", true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+							"device1", "phone", device.publicKey, "token", "challenge"),
+					),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "device1"),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:       authz.NewMockContext("instanceID", "org1", "user1"),
+				userID:    "user1",
+				publicKey: device.publicKey,
+				pushToken: "token",
+			},
+			want: &domain.PushDeviceRegistrationDetails{
+				ObjectDetails: &domain.ObjectDetails{ResourceOwner: "org1"},
+				ID:            "device1",
+				Challenge:     "challenge",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:             tt.fields.eventstore(t),
+				idGenerator:            tt.fields.idGenerator,
+				checkPermission:        tt.fields.checkPermission,
+				pushChallengeGenerator: mockPushChallenge("challenge", 42),
+			}
+			got, err := c.AddUserPushDevice(tt.args.ctx, tt.args.userID, "", "phone", tt.args.publicKey, tt.args.pushToken)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.want.ID, got.ID)
+			assert.Equal(t, tt.want.Challenge, got.Challenge)
+			assertObjectDetails(t, tt.want.ObjectDetails, got.ObjectDetails)
+		})
+	}
+}
+
+func TestCommands_VerifyUserPushDevice(t *testing.T) {
+	device := newTestPushDevice(t)
+	otherDevice := newTestPushDevice(t)
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	type args struct {
+		deviceID        string
+		signedChallenge string
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		args       args
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name: "not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			args: args{
+				deviceID:        "device1",
+				signedChallenge: device.sign(t, "device1", &domain.PushApproval{Challenge: "challenge"}),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Cai8o", "Errors.User.Push.NotFound"),
+		},
+		{
+			name: "already verified, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+							"device1", "phone", device.publicKey, "token", "challenge"),
+					),
+					eventFromEventPusher(
+						user.NewHumanPushDeviceVerifiedEvent(context.Background(), userAgg, "device1"),
+					),
+				),
+			),
+			args: args{
+				deviceID:        "device1",
+				signedChallenge: device.sign(t, "device1", &domain.PushApproval{Challenge: "challenge"}),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ohk5e", "Errors.User.Push.AlreadyVerified"),
+		},
+		{
+			name: "signed by other key, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+							"device1", "phone", device.publicKey, "token", "challenge"),
+					),
+				),
+			),
+			args: args{
+				deviceID:        "device1",
+				signedChallenge: otherDevice.sign(t, "device1", &domain.PushApproval{Challenge: "challenge"}),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-uu0Ah", "Errors.User.Push.ApprovalInvalid"),
+		},
+		{
+			name: "verified",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+							"device1", "phone", device.publicKey, "token", "challenge"),
+					),
+				),
+				expectPush(
+					user.NewHumanPushDeviceVerifiedEvent(context.Background(), userAgg, "device1"),
+				),
+			),
+			args: args{
+				deviceID:        "device1",
+				signedChallenge: device.sign(t, "device1", &domain.PushApproval{Challenge: "challenge"}),
+			},
+			want: &domain.ObjectDetails{ResourceOwner: "org1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			}
+			got, err := c.VerifyUserPushDevice(authz.NewMockContext("instanceID", "org1", "user1"), "user1", "", tt.args.deviceID, tt.args.signedChallenge)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_RemoveUserPushDevice(t *testing.T) {
+	device := newTestPushDevice(t)
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name            string
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		ctx             context.Context
+		want            *domain.ObjectDetails
+		wantErr         error
+	}{
+		{
+			name: "already removed, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+							"device1", "phone", device.publicKey, "token", "challenge"),
+					),
+					eventFromEventPusher(
+						user.NewHumanPushDeviceRemovedEvent(context.Background(), userAgg, "device1"),
+					),
+				),
+			),
+			ctx:     authz.NewMockContext("instanceID", "org1", "user1"),
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Cai8o", "Errors.User.Push.NotFound"),
+		},
+		{
+			name: "other user, permission denied",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+							"device1", "phone", device.publicKey, "token", "challenge"),
+					),
+				),
+			),
+			checkPermission: newMockPermissionCheckNotAllowed(),
+			ctx:             authz.NewMockContext("instanceID", "org1", "user2"),
+			wantErr:         zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanPushDeviceAddedEvent(context.Background(), userAgg,
+							"device1", "phone", device.publicKey, "token", "challenge"),
+					),
+					eventFromEventPusher(
+						user.NewHumanPushDeviceVerifiedEvent(context.Background(), userAgg, "device1"),
+					),
+				),
+				expectPush(
+					user.NewHumanPushDeviceRemovedEvent(context.Background(), userAgg, "device1"),
+				),
+			),
+			checkPermission: newMockPermissionCheckNotAllowed(),
+			ctx:             authz.NewMockContext("instanceID", "org1", "user1"),
+			want:            &domain.ObjectDetails{ResourceOwner: "org1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: tt.checkPermission,
+			}
+			got, err := c.RemoveUserPushDevice(tt.ctx, "user1", "", "device1")
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
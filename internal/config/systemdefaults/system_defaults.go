@@ -36,12 +36,17 @@ type SecretGenerators struct {
 type MultifactorConfig struct {
 	OTP           OTPConfig
 	RecoveryCodes RecoveryCodesConfig
+	Push          PushConfig
 }
 
 type OTPConfig struct {
 	Issuer string
 }
 
+type PushConfig struct {
+	ChallengeExpiry time.Duration
+}
+
 type RecoveryCodesConfig struct {
 	MaxCount   int
 	Format     string
@@ -0,0 +1,111 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/passwap/argon2"
+	"github.com/zitadel/passwap/bcrypt"
+	"github.com/zitadel/passwap/drupal7"
+	"github.com/zitadel/passwap/md5"
+	"github.com/zitadel/passwap/md5salted"
+	"github.com/zitadel/passwap/pbkdf2"
+	"github.com/zitadel/passwap/phpass"
+	"github.com/zitadel/passwap/scrypt"
+	"github.com/zitadel/passwap/sha2"
+)
+
+const (
+	PasswordHashAlgorithmUnknown = "unknown"
+	PasswordHashAlgorithmSha256  = "sha2-256"
+	PasswordHashAlgorithmSha512  = "sha2-512"
+)
+
+// phpassAlphabet is used by phpass and drupal7 to encode the log2 of the iteration count.
+const phpassAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// defaultSha2Rounds is used by sha2 crypt if the rounds are not part of the encoded hash.
+const defaultSha2Rounds = 5000
+
+// PasswordHashInfo describes the algorithm and cost parameters of an encoded password hash,
+// without exposing the salt or the hash itself.
+type PasswordHashInfo struct {
+	// Algorithm is the identifier of the algorithm, e.g. bcrypt, argon2id or pbkdf2-sha256.
+	Algorithm string
+	// Cost are the algorithm specific cost parameters, e.g. the bcrypt cost or the argon2 memory, time and threads.
+	Cost string
+}
+
+// ParsePasswordHashInfo returns the [PasswordHashInfo] of the encoded hash.
+// Hashes in an unknown format result in the algorithm [PasswordHashAlgorithmUnknown].
+func ParsePasswordHashInfo(encoded string) PasswordHashInfo {
+	parts := strings.Split(encoded, "$")
+	part := func(i int) string {
+		if i < len(parts) {
+			return parts[i]
+		}
+		return ""
+	}
+	switch {
+	case strings.HasPrefix(encoded, argon2.Prefix):
+		return PasswordHashInfo{Algorithm: part(1), Cost: part(3)}
+	case strings.HasPrefix(encoded, bcrypt.Prefix):
+		return PasswordHashInfo{Algorithm: string(HashNameBcrypt), Cost: part(2)}
+	case strings.HasPrefix(encoded, scrypt.Prefix):
+		return PasswordHashInfo{Algorithm: string(HashNameScrypt), Cost: part(2)}
+	case strings.HasPrefix(encoded, scrypt.Prefix_Linux):
+		return PasswordHashInfo{Algorithm: string(HashNameScrypt)}
+	case strings.HasPrefix(encoded, pbkdf2.Prefix):
+		return PasswordHashInfo{Algorithm: part(1), Cost: part(2)}
+	case strings.HasPrefix(encoded, sha2.Sha256Identifier):
+		return PasswordHashInfo{Algorithm: PasswordHashAlgorithmSha256, Cost: sha2Rounds(part(2))}
+	case strings.HasPrefix(encoded, sha2.Sha512Identifier):
+		return PasswordHashInfo{Algorithm: PasswordHashAlgorithmSha512, Cost: sha2Rounds(part(2))}
+	case strings.HasPrefix(encoded, md5.Prefix):
+		return PasswordHashInfo{Algorithm: string(HashNameMd5)}
+	case strings.HasPrefix(encoded, md5salted.Prefix):
+		return PasswordHashInfo{Algorithm: part(1)}
+	case strings.HasPrefix(encoded, phpass.IdentifierP), strings.HasPrefix(encoded, phpass.IdentifierH):
+		return PasswordHashInfo{Algorithm: string(HashNamePHPass), Cost: phpassCost(encoded, len(phpass.IdentifierP))}
+	case strings.HasPrefix(encoded, drupal7.Identifier):
+		return PasswordHashInfo{Algorithm: string(HashNameDrupal7), Cost: phpassCost(encoded, len(drupal7.Identifier))}
+	}
+	if _, err := hex.DecodeString(encoded); err == nil && encoded != "" {
+		return PasswordHashInfo{Algorithm: string(HashNameMd5Plain)}
+	}
+	return PasswordHashInfo{Algorithm: PasswordHashAlgorithmUnknown}
+}
+
+// Insecure returns true for algorithms which are only supported for verification,
+// as they are considered cryptographically broken or too fast.
+func (i PasswordHashInfo) Insecure() bool {
+	switch i.Algorithm {
+	case string(HashNameMd5),
+		string(HashNameMd5Plain),
+		md5salted.IdentifierPrefixed,
+		md5salted.IdentifierSuffixed,
+		string(HashNamePHPass),
+		string(HashNameDrupal7):
+		return true
+	}
+	return false
+}
+
+func sha2Rounds(part string) string {
+	if rounds, ok := strings.CutPrefix(part, "rounds="); ok {
+		return rounds
+	}
+	return strconv.Itoa(defaultSha2Rounds)
+}
+
+func phpassCost(encoded string, position int) string {
+	if len(encoded) <= position {
+		return ""
+	}
+	cost := strings.IndexByte(phpassAlphabet, encoded[position])
+	if cost < 0 {
+		return ""
+	}
+	return strconv.Itoa(cost)
+}
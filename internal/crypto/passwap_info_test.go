@@ -0,0 +1,89 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePasswordHashInfo(t *testing.T) {
+	tests := []struct {
+		name         string
+		encoded      string
+		want         PasswordHashInfo
+		wantInsecure bool
+	}{
+		{
+			name:    "argon2id",
+			encoded: "$argon2id$v=19$m=65536,t=3,p=4$c2FsdHNhbHQ$aGFzaGhhc2g",
+			want:    PasswordHashInfo{Algorithm: "argon2id", Cost: "m=65536,t=3,p=4"},
+		},
+		{
+			name:    "bcrypt",
+			encoded: "$2y$12$hXUrnqdq1RIIYZ2HPytIIe5lXdIvbhqrTvdPsSF7o.jFh817Z6lwm",
+			want:    PasswordHashInfo{Algorithm: "bcrypt", Cost: "12"},
+		},
+		{
+			name:    "scrypt",
+			encoded: "$scrypt$ln=16,r=8,p=1$cmFuZG9tc2FsdGlzaGFyZA$Rh+NnJNo1I6nRwaNqbDm6kmADswD1+7FTKZ7Ln9D8nQ",
+			want:    PasswordHashInfo{Algorithm: "scrypt", Cost: "ln=16,r=8,p=1"},
+		},
+		{
+			name:    "pbkdf2",
+			encoded: "$pbkdf2-sha256$12$cmFuZG9tc2FsdGlzaGFyZA$Dr0bKlaNHozl1ODwIx4flN69H/nrKSq0pS+MuKdTLxM",
+			want:    PasswordHashInfo{Algorithm: "pbkdf2-sha256", Cost: "12"},
+		},
+		{
+			name:    "sha2 with rounds",
+			encoded: "$5$rounds=10000$saltstring$3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA",
+			want:    PasswordHashInfo{Algorithm: PasswordHashAlgorithmSha256, Cost: "10000"},
+		},
+		{
+			name:    "sha2 default rounds",
+			encoded: "$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1",
+			want:    PasswordHashInfo{Algorithm: PasswordHashAlgorithmSha512, Cost: "5000"},
+		},
+		{
+			name:         "md5",
+			encoded:      "$1$kJ4QkJaQ$3EbDgdH2cYvdJj6D4Ezf/.",
+			want:         PasswordHashInfo{Algorithm: "md5"},
+			wantInsecure: true,
+		},
+		{
+			name:         "md5 salted",
+			encoded:      "$md5salted-suffix$kJ4QkJaQ$3EbDgdH2cYvdJj6D4Ezf",
+			want:         PasswordHashInfo{Algorithm: "md5salted-suffix"},
+			wantInsecure: true,
+		},
+		{
+			name:         "md5 plain",
+			encoded:      "5f4dcc3b5aa765d61d8327deb882cf99",
+			want:         PasswordHashInfo{Algorithm: "md5plain"},
+			wantInsecure: true,
+		},
+		{
+			name:         "phpass",
+			encoded:      "$P$Bvr6hmbBdyBCkxrBCRA8ZFRYhdZu6l/",
+			want:         PasswordHashInfo{Algorithm: "phpass", Cost: "13"},
+			wantInsecure: true,
+		},
+		{
+			name:         "drupal7",
+			encoded:      "$S$DxQ1qHHI2q0.2F1D5XWmXTbaGtVTEYaFJ.nyBMozX2wB0ocK4ySe",
+			want:         PasswordHashInfo{Algorithm: "drupal7", Cost: "15"},
+			wantInsecure: true,
+		},
+		{
+			name:    "unknown",
+			encoded: "$unknown$hash",
+			want:    PasswordHashInfo{Algorithm: PasswordHashAlgorithmUnknown},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParsePasswordHashInfo(tt.encoded)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantInsecure, got.Insecure())
+		})
+	}
+}
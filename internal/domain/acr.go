@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AuthenticationLevel is the assurance an authentication provides,
+// derived from the factors checked on a session.
+// Each level includes the lower ones.
+type AuthenticationLevel int32
+
+const (
+	AuthenticationLevelUnspecified AuthenticationLevel = iota
+	// AuthenticationLevelSingleFactor requires any factor to be checked.
+	AuthenticationLevelSingleFactor
+	// AuthenticationLevelMultiFactor requires at least two factors or a passkey with user verification.
+	AuthenticationLevelMultiFactor
+	// AuthenticationLevelPhishingResistant requires multiple factors, one of them being a WebAuthN credential (passkey or U2F).
+	AuthenticationLevelPhishingResistant
+
+	authenticationLevelCount
+)
+
+func (l AuthenticationLevel) Valid() bool {
+	return l > AuthenticationLevelUnspecified && l < authenticationLevelCount
+}
+
+// AuthenticationLevelOf returns the level reached by the checked auth methods.
+func AuthenticationLevelOf(methods []UserAuthMethodType) AuthenticationLevel {
+	var factors int
+	var webAuthN bool
+	for _, method := range methods {
+		switch method {
+		case UserAuthMethodTypePasswordless:
+			factors += 2
+			webAuthN = true
+		case UserAuthMethodTypeU2F:
+			factors++
+			webAuthN = true
+		case UserAuthMethodTypePassword,
+			UserAuthMethodTypeOTP,
+			UserAuthMethodTypeTOTP,
+			UserAuthMethodTypeOTPSMS,
+			UserAuthMethodTypeOTPEmail,
+			UserAuthMethodTypeRecoveryCode,
+			UserAuthMethodTypeIDP,
+			UserAuthMethodTypeMagicLink,
+			UserAuthMethodTypeTrustedDevice,
+			UserAuthMethodTypePush:
+			factors++
+		case UserAuthMethodTypeUnspecified:
+			// ignore
+		}
+	}
+	switch {
+	case factors >= 2 && webAuthN:
+		return AuthenticationLevelPhishingResistant
+	case factors >= 2:
+		return AuthenticationLevelMultiFactor
+	case factors == 1:
+		return AuthenticationLevelSingleFactor
+	default:
+		return AuthenticationLevelUnspecified
+	}
+}
+
+// ACRDefinition maps an Authentication Context Class Reference value,
+// which clients can request using the acr_values parameter, to the required authentication level.
+type ACRDefinition struct {
+	Value string              `json:"value"`
+	Level AuthenticationLevel `json:"level"`
+}
+
+type ACRDefinitions []ACRDefinition
+
+// Validate checks that all values are set and unique and map to a valid level.
+func (d ACRDefinitions) Validate() error {
+	for i, definition := range d {
+		if definition.Value == "" || !definition.Level.Valid() ||
+			slices.ContainsFunc(d[:i], func(other ACRDefinition) bool { return other.Value == definition.Value }) {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-eiQu9", "Errors.Instance.SecurityPolicy.ACRDefinitionInvalid")
+		}
+	}
+	return nil
+}
+
+// Values returns the defined acr values, e.g. to be advertised as acr_values_supported.
+func (d ACRDefinitions) Values() []string {
+	if len(d) == 0 {
+		return nil
+	}
+	values := make([]string, len(d))
+	for i, definition := range d {
+		values[i] = definition.Value
+	}
+	return values
+}
+
+// Satisfy returns the first of the requested acr values (in order of preference)
+// which is satisfied by the checked auth methods.
+// Unknown values are ignored. If none of the requested values is known, an empty acr is returned,
+// but if none of the known values is satisfied, an error is returned, so the user can be asked
+// to authenticate with further factors.
+func (d ACRDefinitions) Satisfy(requested []string, methods []UserAuthMethodType) (string, error) {
+	level := AuthenticationLevelOf(methods)
+	var known bool
+	for _, value := range requested {
+		index := slices.IndexFunc(d, func(definition ACRDefinition) bool { return definition.Value == value })
+		if index < 0 {
+			continue
+		}
+		known = true
+		if level >= d[index].Level {
+			return value, nil
+		}
+	}
+	if known {
+		return "", zerrors.ThrowPreconditionFailed(nil, "DOMAIN-Shoo4", "Errors.AuthRequest.ACRNotSatisfied")
+	}
+	return "", nil
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestAuthenticationLevelOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		methods []UserAuthMethodType
+		want    AuthenticationLevel
+	}{
+		{
+			name: "none",
+			want: AuthenticationLevelUnspecified,
+		},
+		{
+			name:    "password",
+			methods: []UserAuthMethodType{UserAuthMethodTypePassword},
+			want:    AuthenticationLevelSingleFactor,
+		},
+		{
+			name:    "password and totp",
+			methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP},
+			want:    AuthenticationLevelMultiFactor,
+		},
+		{
+			name:    "u2f only",
+			methods: []UserAuthMethodType{UserAuthMethodTypeU2F},
+			want:    AuthenticationLevelSingleFactor,
+		},
+		{
+			name:    "password and u2f",
+			methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeU2F},
+			want:    AuthenticationLevelPhishingResistant,
+		},
+		{
+			name:    "passkey",
+			methods: []UserAuthMethodType{UserAuthMethodTypePasswordless},
+			want:    AuthenticationLevelPhishingResistant,
+		},
+		{
+			name:    "password on trusted device",
+			methods: []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTrustedDevice},
+			want:    AuthenticationLevelMultiFactor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AuthenticationLevelOf(tt.methods))
+		})
+	}
+}
+
+func TestACRDefinitions_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		definitions ACRDefinitions
+		wantErr     bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "valid",
+			definitions: ACRDefinitions{
+				{Value: "urn:example:mfa", Level: AuthenticationLevelMultiFactor},
+				{Value: "phr", Level: AuthenticationLevelPhishingResistant},
+			},
+		},
+		{
+			name:        "missing value",
+			definitions: ACRDefinitions{{Level: AuthenticationLevelMultiFactor}},
+			wantErr:     true,
+		},
+		{
+			name:        "invalid level",
+			definitions: ACRDefinitions{{Value: "phr"}},
+			wantErr:     true,
+		},
+		{
+			name: "duplicate value",
+			definitions: ACRDefinitions{
+				{Value: "phr", Level: AuthenticationLevelMultiFactor},
+				{Value: "phr", Level: AuthenticationLevelPhishingResistant},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.definitions.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, zerrors.ThrowInvalidArgument(nil, "DOMAIN-eiQu9", "Errors.Instance.SecurityPolicy.ACRDefinitionInvalid"))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestACRDefinitions_Satisfy(t *testing.T) {
+	definitions := ACRDefinitions{
+		{Value: "urn:example:mfa", Level: AuthenticationLevelMultiFactor},
+		{Value: "phr", Level: AuthenticationLevelPhishingResistant},
+	}
+	tests := []struct {
+		name      string
+		requested []string
+		methods   []UserAuthMethodType
+		want      string
+		wantErr   error
+	}{
+		{
+			name:    "nothing requested",
+			methods: []UserAuthMethodType{UserAuthMethodTypePassword},
+		},
+		{
+			name:      "unknown value ignored",
+			requested: []string{"urn:unknown"},
+			methods:   []UserAuthMethodType{UserAuthMethodTypePassword},
+		},
+		{
+			name:      "first satisfied value",
+			requested: []string{"phr", "urn:example:mfa"},
+			methods:   []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP},
+			want:      "urn:example:mfa",
+		},
+		{
+			name:      "preferred value",
+			requested: []string{"phr", "urn:example:mfa"},
+			methods:   []UserAuthMethodType{UserAuthMethodTypePasswordless},
+			want:      "phr",
+		},
+		{
+			name:      "not satisfied",
+			requested: []string{"urn:unknown", "phr"},
+			methods:   []UserAuthMethodType{UserAuthMethodTypePassword, UserAuthMethodTypeTOTP},
+			wantErr:   zerrors.ThrowPreconditionFailed(nil, "DOMAIN-Shoo4", "Errors.AuthRequest.ACRNotSatisfied"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := definitions.Satisfy(tt.requested, tt.methods)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
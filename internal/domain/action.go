@@ -54,6 +54,7 @@ const (
 	ActionFunctionPreUserinfo
 	ActionFunctionPreAccessToken
 	ActionFunctionPreSAMLResponse
+	ActionFunctionPreRiskAssessment
 	actionFunctionCount
 )
 
@@ -73,6 +74,8 @@ func (s ActionFunction) LocalizationKey() string {
 		return "preaccesstoken"
 	case ActionFunctionPreSAMLResponse:
 		return "presamlresponse"
+	case ActionFunctionPreRiskAssessment:
+		return "preriskassessment"
 	case ActionFunctionUnspecified, actionFunctionCount:
 		fallthrough
 	default:
@@ -85,6 +88,7 @@ func AllActionFunctions() []string {
 		ActionFunctionPreUserinfo.LocalizationKey(),
 		ActionFunctionPreAccessToken.LocalizationKey(),
 		ActionFunctionPreSAMLResponse.LocalizationKey(),
+		ActionFunctionPreRiskAssessment.LocalizationKey(),
 	}
 }
 
@@ -59,6 +59,14 @@ type OIDCApp struct {
 	// passkey trust fields. Package name is required when fingerprints are non-empty.
 	AndroidPackageName            *string
 	AndroidSHA256CertFingerprints []string
+	// AuthorizationDetailsTypes are the types of authorization_details (RFC 9396)
+	// the client is allowed to request.
+	AuthorizationDetailsTypes []string
+	// RequireConsent forces the user to consent to the requested scopes
+	// before the client receives any tokens.
+	RequireConsent *bool
+	// TokenExchangePolicy restricts the token exchange requests of the client.
+	TokenExchangePolicy *TokenExchangePolicy
 
 	State AppState
 }
@@ -148,7 +156,7 @@ const (
 )
 
 func (a *OIDCApp) IsValid() bool {
-	if (a.ClockSkew != nil && (*a.ClockSkew > time.Second*5 || *a.ClockSkew < time.Second*0)) || !a.OriginsValid() || !a.AppLinkConfigValid() {
+	if (a.ClockSkew != nil && (*a.ClockSkew > time.Second*5 || *a.ClockSkew < time.Second*0)) || !a.OriginsValid() || !a.AppLinkConfigValid() || !a.TokenExchangePolicy.IsValid() {
 		return false
 	}
 	grantTypes := a.getRequiredGrantTypes()
@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AuthorizationDetailsParam is the name of the request parameter
+// defined by OAuth 2.0 Rich Authorization Requests (RFC 9396).
+const AuthorizationDetailsParam = "authorization_details"
+
+// AuthorizationDetail is a single object of the authorization_details parameter as specified in
+// [RFC 9396, Section 2](https://www.rfc-editor.org/rfc/rfc9396#section-2).
+// The common data fields are interpreted, all other (API specific) fields are kept in Fields
+// and passed through unchanged.
+type AuthorizationDetail struct {
+	Type       string
+	Locations  []string
+	Actions    []string
+	DataTypes  []string
+	Identifier string
+	Privileges []string
+	// Fields contains all fields besides the common data fields defined in RFC 9396.
+	Fields map[string]any
+}
+
+const (
+	authorizationDetailType       = "type"
+	authorizationDetailLocations  = "locations"
+	authorizationDetailActions    = "actions"
+	authorizationDetailDataTypes  = "datatypes"
+	authorizationDetailIdentifier = "identifier"
+	authorizationDetailPrivileges = "privileges"
+)
+
+func (d *AuthorizationDetail) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(d.Fields)+6)
+	maps.Copy(m, d.Fields)
+	m[authorizationDetailType] = d.Type
+	setIfNotEmpty(m, authorizationDetailLocations, d.Locations)
+	setIfNotEmpty(m, authorizationDetailActions, d.Actions)
+	setIfNotEmpty(m, authorizationDetailDataTypes, d.DataTypes)
+	setIfNotEmpty(m, authorizationDetailPrivileges, d.Privileges)
+	if d.Identifier != "" {
+		m[authorizationDetailIdentifier] = d.Identifier
+	}
+	return json.Marshal(m)
+}
+
+func setIfNotEmpty(m map[string]any, key string, values []string) {
+	if len(values) > 0 {
+		m[key] = values
+	}
+}
+
+func (d *AuthorizationDetail) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	common := []struct {
+		key    string
+		target any
+	}{
+		{authorizationDetailType, &d.Type},
+		{authorizationDetailLocations, &d.Locations},
+		{authorizationDetailActions, &d.Actions},
+		{authorizationDetailDataTypes, &d.DataTypes},
+		{authorizationDetailIdentifier, &d.Identifier},
+		{authorizationDetailPrivileges, &d.Privileges},
+	}
+	for _, field := range common {
+		raw, ok := fields[field.key]
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(raw, field.target); err != nil {
+			return err
+		}
+		delete(fields, field.key)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	d.Fields = make(map[string]any, len(fields))
+	for key, raw := range fields {
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		d.Fields[key] = value
+	}
+	return nil
+}
+
+// contains checks if the requested detail is covered by the (granted) detail d.
+// The type, identifier and all API specific fields must match,
+// where the common data fields of the requested detail must be a subset of the granted ones.
+func (d *AuthorizationDetail) contains(requested *AuthorizationDetail) bool {
+	if d.Type != requested.Type || d.Identifier != requested.Identifier {
+		return false
+	}
+	if !isSubset(requested.Locations, d.Locations) ||
+		!isSubset(requested.Actions, d.Actions) ||
+		!isSubset(requested.DataTypes, d.DataTypes) ||
+		!isSubset(requested.Privileges, d.Privileges) {
+		return false
+	}
+	// API specific fields can't be interpreted, so they need to be equal
+	requestedFields, err := json.Marshal(requested.Fields)
+	if err != nil {
+		return false
+	}
+	grantedFields, err := json.Marshal(d.Fields)
+	if err != nil {
+		return false
+	}
+	return string(requestedFields) == string(grantedFields)
+}
+
+func isSubset(subset, set []string) bool {
+	for _, value := range subset {
+		if !slices.Contains(set, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// AuthorizationDetails represents the list of [AuthorizationDetail] of a request.
+type AuthorizationDetails []*AuthorizationDetail
+
+// ParseAuthorizationDetails parses the JSON array passed in the authorization_details parameter.
+// An empty parameter results in nil details and no error.
+func ParseAuthorizationDetails(param string) (AuthorizationDetails, error) {
+	if strings.TrimSpace(param) == "" {
+		return nil, nil
+	}
+	var details AuthorizationDetails
+	if err := json.Unmarshal([]byte(param), &details); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Ohgh4", "Errors.AuthRequest.AuthorizationDetails.Invalid")
+	}
+	if len(details) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-ieF7a", "Errors.AuthRequest.AuthorizationDetails.Invalid")
+	}
+	for _, detail := range details {
+		if detail == nil || detail.Type == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ahw3e", "Errors.AuthRequest.AuthorizationDetails.TypeMissing")
+		}
+	}
+	return details, nil
+}
+
+// Types returns the distinct types of the details.
+func (d AuthorizationDetails) Types() []string {
+	types := make([]string, 0, len(d))
+	for _, detail := range d {
+		if !slices.Contains(types, detail.Type) {
+			types = append(types, detail.Type)
+		}
+	}
+	return types
+}
+
+// ValidateTypes checks that every detail is of one of the allowed types.
+func (d AuthorizationDetails) ValidateTypes(allowedTypes []string) error {
+	for _, detail := range d {
+		if !slices.Contains(allowedTypes, detail.Type) {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-ooP3i", "Errors.AuthRequest.AuthorizationDetails.TypeNotAllowed")
+		}
+	}
+	return nil
+}
+
+// Restrict checks that each of the requested details is covered by one of the granted details d
+// and returns the requested details.
+// If no details are requested, all granted details are returned.
+// This allows clients to request tokens with a reduced set of authorization details
+// (e.g. on a refresh token grant) as described in [RFC 9396, Section 6](https://www.rfc-editor.org/rfc/rfc9396#section-6).
+func (d AuthorizationDetails) Restrict(requested AuthorizationDetails) (AuthorizationDetails, error) {
+	if len(requested) == 0 {
+		return d, nil
+	}
+	for _, req := range requested {
+		if !slices.ContainsFunc(d, func(granted *AuthorizationDetail) bool {
+			return granted.contains(req)
+		}) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Uu4ai", "Errors.AuthRequest.AuthorizationDetails.NotGranted")
+		}
+	}
+	return requested, nil
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestParseAuthorizationDetails(t *testing.T) {
+	tests := []struct {
+		name    string
+		param   string
+		want    AuthorizationDetails
+		wantErr error
+	}{
+		{
+			name:  "empty",
+			param: "",
+			want:  nil,
+		},
+		{
+			name:    "invalid json",
+			param:   `{"type":"payment_initiation"}`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ohgh4", "Errors.AuthRequest.AuthorizationDetails.Invalid"),
+		},
+		{
+			name:    "empty array",
+			param:   `[]`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-ieF7a", "Errors.AuthRequest.AuthorizationDetails.Invalid"),
+		},
+		{
+			name:    "missing type",
+			param:   `[{"actions":["read"]}]`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ahw3e", "Errors.AuthRequest.AuthorizationDetails.TypeMissing"),
+		},
+		{
+			name:  "common and api specific fields",
+			param: `[{"type":"payment_initiation","actions":["initiate","status"],"locations":["https://example.com/payments"],"instructedAmount":{"currency":"EUR","amount":"123.50"}}]`,
+			want: AuthorizationDetails{
+				{
+					Type:      "payment_initiation",
+					Actions:   []string{"initiate", "status"},
+					Locations: []string{"https://example.com/payments"},
+					Fields: map[string]any{
+						"instructedAmount": map[string]any{
+							"currency": "EUR",
+							"amount":   "123.50",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAuthorizationDetails(tt.param)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthorizationDetail_MarshalJSON(t *testing.T) {
+	detail := &AuthorizationDetail{
+		Type:       "account_information",
+		Actions:    []string{"read"},
+		Identifier: "account-1",
+		Fields: map[string]any{
+			"type":     "overwritten",
+			"currency": "CHF",
+		},
+	}
+	got, err := json.Marshal(detail)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"account_information","actions":["read"],"identifier":"account-1","currency":"CHF"}`, string(got))
+}
+
+func TestAuthorizationDetails_ValidateTypes(t *testing.T) {
+	details := AuthorizationDetails{
+		{Type: "payment_initiation"},
+		{Type: "account_information"},
+	}
+	assert.NoError(t, details.ValidateTypes([]string{"account_information", "payment_initiation"}))
+	assert.ErrorIs(t, details.ValidateTypes([]string{"payment_initiation"}),
+		zerrors.ThrowInvalidArgument(nil, "DOMAIN-ooP3i", "Errors.AuthRequest.AuthorizationDetails.TypeNotAllowed"),
+	)
+	assert.ErrorIs(t, details.ValidateTypes(nil),
+		zerrors.ThrowInvalidArgument(nil, "DOMAIN-ooP3i", "Errors.AuthRequest.AuthorizationDetails.TypeNotAllowed"),
+	)
+}
+
+func TestAuthorizationDetails_Restrict(t *testing.T) {
+	granted := AuthorizationDetails{
+		{
+			Type:      "payment_initiation",
+			Actions:   []string{"initiate", "status", "cancel"},
+			Locations: []string{"https://example.com/payments"},
+			Fields:    map[string]any{"creditorName": "Merchant A"},
+		},
+		{
+			Type:       "account_information",
+			Actions:    []string{"read"},
+			Identifier: "account-1",
+		},
+	}
+	tests := []struct {
+		name      string
+		requested AuthorizationDetails
+		want      AuthorizationDetails
+		wantErr   error
+	}{
+		{
+			name:      "none requested, all granted",
+			requested: nil,
+			want:      granted,
+		},
+		{
+			name: "subset of actions",
+			requested: AuthorizationDetails{
+				{
+					Type:    "payment_initiation",
+					Actions: []string{"status"},
+					Fields:  map[string]any{"creditorName": "Merchant A"},
+				},
+			},
+			want: AuthorizationDetails{
+				{
+					Type:    "payment_initiation",
+					Actions: []string{"status"},
+					Fields:  map[string]any{"creditorName": "Merchant A"},
+				},
+			},
+		},
+		{
+			name: "additional action",
+			requested: AuthorizationDetails{
+				{
+					Type:    "account_information",
+					Actions: []string{"read", "write"},
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Uu4ai", "Errors.AuthRequest.AuthorizationDetails.NotGranted"),
+		},
+		{
+			name: "different identifier",
+			requested: AuthorizationDetails{
+				{
+					Type:       "account_information",
+					Identifier: "account-2",
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Uu4ai", "Errors.AuthRequest.AuthorizationDetails.NotGranted"),
+		},
+		{
+			name: "different api specific field",
+			requested: AuthorizationDetails{
+				{
+					Type:   "payment_initiation",
+					Fields: map[string]any{"creditorName": "Merchant B"},
+				},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Uu4ai", "Errors.AuthRequest.AuthorizationDetails.NotGranted"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := granted.Restrict(tt.requested)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
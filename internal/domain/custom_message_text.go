@@ -18,6 +18,15 @@ const (
 	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
 	PasswordChangeMessageType           = "PasswordChange"
 	InviteUserMessageType               = "InviteUser"
+	MagicLinkMessageType                = "MagicLink"
+	MFAAddedMessageType                 = "MFAAdded"
+	MFARemovedMessageType               = "MFARemoved"
+	EmailChangedMessageType             = "EmailChanged"
+	PhoneChangedMessageType             = "PhoneChanged"
+	NewDeviceSignInMessageType          = "NewDeviceSignIn"
+	PersonalAccessTokenAddedMessageType = "PersonalAccessTokenAdded"
+	MachineKeyAddedMessageType          = "MachineKeyAdded"
+	InactivityWarningMessageType        = "InactivityWarning"
 	MessageTitle                        = "Title"
 	MessagePreHeader                    = "PreHeader"
 	MessageSubject                      = "Subject"
@@ -63,5 +72,14 @@ func IsMessageTextType(textType string) bool {
 		textType == DomainClaimedMessageType ||
 		textType == PasswordlessRegistrationMessageType ||
 		textType == PasswordChangeMessageType ||
-		textType == InviteUserMessageType
+		textType == InviteUserMessageType ||
+		textType == MagicLinkMessageType ||
+		textType == MFAAddedMessageType ||
+		textType == MFARemovedMessageType ||
+		textType == EmailChangedMessageType ||
+		textType == PhoneChangedMessageType ||
+		textType == NewDeviceSignInMessageType ||
+		textType == PersonalAccessTokenAddedMessageType ||
+		textType == MachineKeyAddedMessageType ||
+		textType == InactivityWarningMessageType
 }
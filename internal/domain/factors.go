@@ -9,6 +9,7 @@ const (
 	SecondFactorTypeOTPEmail
 	SecondFactorTypeOTPSMS
 	SecondFactorTypeRecoveryCodes
+	SecondFactorTypePush
 
 	secondFactorCount
 )
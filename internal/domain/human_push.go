@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// PushDeviceRegistrationDetails are returned on the registration of a push device.
+// The device must sign the Challenge to prove the possession of its private key and complete the registration.
+type PushDeviceRegistrationDetails struct {
+	ObjectDetails *ObjectDetails
+	ID            string
+	Challenge     string
+}
+
+// PushApproval is the payload signed by a push device.
+// On registration it only contains the challenge of the registration,
+// on a session check it contains the challenge, the number the user confirmed and the decision of the user.
+type PushApproval struct {
+	Challenge string `json:"challenge"`
+	Number    uint32 `json:"number,omitempty"`
+	Approved  bool   `json:"approved,omitempty"`
+}
+
+// NewPushChallenge creates a random challenge, which must be signed by the push device,
+// and a two-digit number, which is displayed to the user and must be confirmed on the device.
+func NewPushChallenge() (challenge string, number uint32, err error) {
+	challengeBytes := make([]byte, 32)
+	if _, err = rand.Read(challengeBytes); err != nil {
+		return "", 0, zerrors.ThrowInternal(err, "DOMAIN-oo5Ae", "Errors.Internal")
+	}
+	n, err := rand.Int(rand.Reader, big.NewInt(90))
+	if err != nil {
+		return "", 0, zerrors.ThrowInternal(err, "DOMAIN-Ju0ie", "Errors.Internal")
+	}
+	return base64.RawURLEncoding.EncodeToString(challengeBytes), uint32(n.Int64()) + 10, nil
+}
+
+var pushSignatureAlgorithms = []jose.SignatureAlgorithm{jose.ES256, jose.EdDSA}
+
+// ParsePushPublicKey parses the PKIX, ASN.1 DER encoded public key of a push device.
+// Only ECDSA P-256 and Ed25519 keys are supported.
+func ParsePushPublicKey(der []byte) (crypto.PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Ahz4o", "Errors.User.Push.PublicKeyInvalid")
+	}
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-eeR8u", "Errors.User.Push.PublicKeyInvalid")
+		}
+		return k, nil
+	case ed25519.PublicKey:
+		return k, nil
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ooth3", "Errors.User.Push.PublicKeyInvalid")
+	}
+}
+
+// PushApprovalKeyID returns the key ID (the ID of the push device) from the header of the signed approval,
+// without verifying the signature.
+func PushApprovalKeyID(signedApproval string) (string, error) {
+	jws, err := jose.ParseSigned(signedApproval, pushSignatureAlgorithms)
+	if err != nil || len(jws.Signatures) != 1 || jws.Signatures[0].Header.KeyID == "" {
+		return "", zerrors.ThrowInvalidArgument(err, "DOMAIN-ohM4i", "Errors.User.Push.ApprovalInvalid")
+	}
+	return jws.Signatures[0].Header.KeyID, nil
+}
+
+// VerifyPushApproval verifies the signature of the approval (a compact JWS) with the public key of the push device
+// and checks that it was issued for the challenge.
+func VerifyPushApproval(signedApproval string, publicKey []byte, challenge string) (*PushApproval, error) {
+	key, err := ParsePushPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	jws, err := jose.ParseSigned(signedApproval, pushSignatureAlgorithms)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Iuv5a", "Errors.User.Push.ApprovalInvalid")
+	}
+	payload, err := jws.Verify(key)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-uu0Ah", "Errors.User.Push.ApprovalInvalid")
+	}
+	approval := new(PushApproval)
+	if err = json.Unmarshal(payload, approval); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Gai9e", "Errors.User.Push.ApprovalInvalid")
+	}
+	if subtle.ConstantTimeCompare([]byte(approval.Challenge), []byte(challenge)) != 1 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-chai2", "Errors.User.Push.ApprovalInvalid")
+	}
+	return approval, nil
+}
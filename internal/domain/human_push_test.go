@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func signPushApproval(t *testing.T, alg jose.SignatureAlgorithm, key crypto.Signer, keyID string, approval *PushApproval) string {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, (&jose.SignerOptions{}).WithHeader(jose.HeaderKey("kid"), keyID))
+	require.NoError(t, err)
+	payload, err := json.Marshal(approval)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	signed, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return signed
+}
+
+func marshalPushPublicKey(t *testing.T, key crypto.PublicKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return der
+}
+
+func TestParsePushPublicKey(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		der     []byte
+		wantErr error
+	}{
+		{
+			name:    "invalid encoding, error",
+			der:     []byte("key"),
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ahz4o", "Errors.User.Push.PublicKeyInvalid"),
+		},
+		{
+			name:    "P-384, error",
+			der:     marshalPushPublicKey(t, &p384Key.PublicKey),
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-eeR8u", "Errors.User.Push.PublicKeyInvalid"),
+		},
+		{
+			name:    "RSA, error",
+			der:     marshalPushPublicKey(t, &rsaKey.PublicKey),
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ooth3", "Errors.User.Push.PublicKeyInvalid"),
+		},
+		{
+			name: "P-256, ok",
+			der:  marshalPushPublicKey(t, &ecKey.PublicKey),
+		},
+		{
+			name: "Ed25519, ok",
+			der:  marshalPushPublicKey(t, edKey),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePushPublicKey(tt.der)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPushApprovalKeyID(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	keyID, err := PushApprovalKeyID(signPushApproval(t, jose.ES256, key, "deviceID", &PushApproval{Challenge: "challenge"}))
+	require.NoError(t, err)
+	assert.Equal(t, "deviceID", keyID)
+
+	_, err = PushApprovalKeyID(signPushApproval(t, jose.ES256, key, "", &PushApproval{Challenge: "challenge"}))
+	assert.ErrorIs(t, err, zerrors.ThrowInvalidArgument(nil, "DOMAIN-ohM4i", "Errors.User.Push.ApprovalInvalid"))
+
+	_, err = PushApprovalKeyID("approval")
+	assert.ErrorIs(t, err, zerrors.ThrowInvalidArgument(nil, "DOMAIN-ohM4i", "Errors.User.Push.ApprovalInvalid"))
+}
+
+func TestVerifyPushApproval(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPublic, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	approval := &PushApproval{Challenge: "challenge", Number: 42, Approved: true}
+
+	type args struct {
+		signedApproval string
+		publicKey      []byte
+		challenge      string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *PushApproval
+		wantErr error
+	}{
+		{
+			name: "invalid public key, error",
+			args: args{
+				signedApproval: signPushApproval(t, jose.ES256, ecKey, "deviceID", approval),
+				publicKey:      []byte("key"),
+				challenge:      "challenge",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ahz4o", "Errors.User.Push.PublicKeyInvalid"),
+		},
+		{
+			name: "malformed approval, error",
+			args: args{
+				signedApproval: "approval",
+				publicKey:      marshalPushPublicKey(t, &ecKey.PublicKey),
+				challenge:      "challenge",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Iuv5a", "Errors.User.Push.ApprovalInvalid"),
+		},
+		{
+			name: "signed by other key, error",
+			args: args{
+				signedApproval: signPushApproval(t, jose.ES256, otherKey, "deviceID", approval),
+				publicKey:      marshalPushPublicKey(t, &ecKey.PublicKey),
+				challenge:      "challenge",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-uu0Ah", "Errors.User.Push.ApprovalInvalid"),
+		},
+		{
+			name: "other challenge, error",
+			args: args{
+				signedApproval: signPushApproval(t, jose.ES256, ecKey, "deviceID", approval),
+				publicKey:      marshalPushPublicKey(t, &ecKey.PublicKey),
+				challenge:      "other",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-chai2", "Errors.User.Push.ApprovalInvalid"),
+		},
+		{
+			name: "ECDSA, ok",
+			args: args{
+				signedApproval: signPushApproval(t, jose.ES256, ecKey, "deviceID", approval),
+				publicKey:      marshalPushPublicKey(t, &ecKey.PublicKey),
+				challenge:      "challenge",
+			},
+			want: approval,
+		},
+		{
+			name: "Ed25519, ok",
+			args: args{
+				signedApproval: signPushApproval(t, jose.EdDSA, edKey, "deviceID", approval),
+				publicKey:      marshalPushPublicKey(t, edPublic),
+				challenge:      "challenge",
+			},
+			want: approval,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := VerifyPushApproval(tt.args.signedApproval, tt.args.publicKey, tt.args.challenge)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewPushChallenge(t *testing.T) {
+	challenge, number, err := NewPushChallenge()
+	require.NoError(t, err)
+	assert.Len(t, challenge, 43)
+	assert.GreaterOrEqual(t, number, uint32(10))
+	assert.LessOrEqual(t, number, uint32(99))
+}
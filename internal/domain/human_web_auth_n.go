@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+
 	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
 
@@ -45,6 +47,71 @@ const (
 	UserVerificationRequirementDiscouraged
 )
 
+type AttestationConveyance int32
+
+const (
+	AttestationConveyanceUnspecified AttestationConveyance = iota
+	AttestationConveyanceNone
+	AttestationConveyanceIndirect
+	AttestationConveyanceDirect
+	AttestationConveyanceEnterprise
+)
+
+// RequiresTrustedAttestation is true if the attestation of the authenticator must be verifiable against a trusted root.
+func (a AttestationConveyance) RequiresTrustedAttestation() bool {
+	return a == AttestationConveyanceDirect || a == AttestationConveyanceEnterprise
+}
+
+type ResidentKeyRequirement int32
+
+const (
+	ResidentKeyRequirementUnspecified ResidentKeyRequirement = iota
+	ResidentKeyRequirementDiscouraged
+	ResidentKeyRequirementPreferred
+	ResidentKeyRequirementRequired
+)
+
+// WebAuthNPolicy restricts the authenticators which can be registered for passkeys and U2F.
+// Unspecified requirements leave the defaults of the registration in place.
+type WebAuthNPolicy struct {
+	Attestation      AttestationConveyance
+	UserVerification UserVerificationRequirement
+	ResidentKey      ResidentKeyRequirement
+	AllowedAAGUIDs   []string
+	DeniedAAGUIDs    []string
+}
+
+// AAGUIDAllowed checks the AAGUID of an authenticator against the deny and allow list.
+// If the allow list is empty, all authenticators not on the deny list are allowed.
+func (p *WebAuthNPolicy) AAGUIDAllowed(aaguid uuid.UUID) bool {
+	if p == nil {
+		return true
+	}
+	if containsAAGUID(p.DeniedAAGUIDs, aaguid) {
+		return false
+	}
+	return len(p.AllowedAAGUIDs) == 0 || containsAAGUID(p.AllowedAAGUIDs, aaguid)
+}
+
+func containsAAGUID(aaguids []string, aaguid uuid.UUID) bool {
+	for _, entry := range aaguids {
+		if id, err := uuid.Parse(entry); err == nil && id == aaguid {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAAGUIDs checks that all AAGUIDs are valid UUIDs.
+func ValidateAAGUIDs(aaguids []string) bool {
+	for _, aaguid := range aaguids {
+		if _, err := uuid.Parse(aaguid); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
 type AuthenticatorAttachment int32
 
 const (
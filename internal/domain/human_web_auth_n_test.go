@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebAuthNPolicy_AAGUIDAllowed(t *testing.T) {
+	yubikey := uuid.MustParse("cb69481e-8ff7-4039-93ec-0a2729a154a8")
+	other := uuid.MustParse("08987058-cadc-4b81-b6e1-30de50dcbe96")
+	tests := []struct {
+		name   string
+		policy *WebAuthNPolicy
+		aaguid uuid.UUID
+		want   bool
+	}{
+		{
+			"no policy, true",
+			nil,
+			yubikey,
+			true,
+		},
+		{
+			"empty lists, true",
+			&WebAuthNPolicy{},
+			yubikey,
+			true,
+		},
+		{
+			"allowed, true",
+			&WebAuthNPolicy{
+				AllowedAAGUIDs: []string{"CB69481E-8FF7-4039-93EC-0A2729A154A8"},
+			},
+			yubikey,
+			true,
+		},
+		{
+			"not allowed, false",
+			&WebAuthNPolicy{
+				AllowedAAGUIDs: []string{yubikey.String()},
+			},
+			other,
+			false,
+		},
+		{
+			"denied, false",
+			&WebAuthNPolicy{
+				DeniedAAGUIDs: []string{other.String()},
+			},
+			other,
+			false,
+		},
+		{
+			"allowed and denied, false",
+			&WebAuthNPolicy{
+				AllowedAAGUIDs: []string{yubikey.String()},
+				DeniedAAGUIDs:  []string{yubikey.String()},
+			},
+			yubikey,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.AAGUIDAllowed(tt.aaguid))
+		})
+	}
+}
+
+func TestValidateAAGUIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		aaguids []string
+		want    bool
+	}{
+		{
+			"empty, true",
+			nil,
+			true,
+		},
+		{
+			"valid, true",
+			[]string{"cb69481e-8ff7-4039-93ec-0a2729a154a8", "08987058-CADC-4B81-B6E1-30DE50DCBE96"},
+			true,
+		},
+		{
+			"invalid, false",
+			[]string{"cb69481e-8ff7-4039-93ec-0a2729a154a8", "yubikey"},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidateAAGUIDs(tt.aaguids))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	htmltemplate "html/template"
+	texttemplate "text/template"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// MailMessageTemplate overrides the mail layout of a single message type and language.
+// The HTML replaces the layout of the [MailTemplate], the PlainText is sent as alternative part.
+type MailMessageTemplate struct {
+	models.ObjectRoot
+
+	State       PolicyState
+	Default     bool
+	MessageType string
+	Language    language.Tag
+	HTML        string
+	PlainText   string
+}
+
+func (m *MailMessageTemplate) IsValid(supportedLanguages []language.Tag) error {
+	if !IsMessageTextType(m.MessageType) {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Thie6", "Errors.MailMessageTemplate.Invalid")
+	}
+	if err := LanguageIsDefined(m.Language); err != nil {
+		return err
+	}
+	if err := LanguagesAreSupported(supportedLanguages, m.Language); err != nil {
+		return err
+	}
+	if m.HTML == "" && m.PlainText == "" {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-aiK3o", "Errors.MailMessageTemplate.Empty")
+	}
+	if _, err := htmltemplate.New(m.MessageType).Parse(m.HTML); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-ooX6e", "Errors.MailMessageTemplate.HTMLInvalid")
+	}
+	if _, err := texttemplate.New(m.MessageType).Parse(m.PlainText); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-Ua8ei", "Errors.MailMessageTemplate.PlainTextInvalid")
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestMailMessageTemplate_IsValid(t *testing.T) {
+	supported := []language.Tag{language.English, language.German}
+	tests := []struct {
+		name     string
+		template *MailMessageTemplate
+		wantErr  error
+	}{
+		{
+			name: "unknown message type, error",
+			template: &MailMessageTemplate{
+				MessageType: "Unknown",
+				Language:    language.English,
+				HTML:        "<html></html>",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Thie6", "Errors.MailMessageTemplate.Invalid"),
+		},
+		{
+			name: "unsupported language, error",
+			template: &MailMessageTemplate{
+				MessageType: InitCodeMessageType,
+				Language:    language.Afrikaans,
+				HTML:        "<html></html>",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "LANG-lg4DP", "Errors.Language.NotSupported"),
+		},
+		{
+			name: "empty, error",
+			template: &MailMessageTemplate{
+				MessageType: InitCodeMessageType,
+				Language:    language.English,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-aiK3o", "Errors.MailMessageTemplate.Empty"),
+		},
+		{
+			name: "invalid html, error",
+			template: &MailMessageTemplate{
+				MessageType: InitCodeMessageType,
+				Language:    language.English,
+				HTML:        "<html>{{.Code</html>",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-ooX6e", "Errors.MailMessageTemplate.HTMLInvalid"),
+		},
+		{
+			name: "invalid plain text, error",
+			template: &MailMessageTemplate{
+				MessageType: InitCodeMessageType,
+				Language:    language.English,
+				PlainText:   "{{if .Code}}",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ua8ei", "Errors.MailMessageTemplate.PlainTextInvalid"),
+		},
+		{
+			name: "html and plain text, ok",
+			template: &MailMessageTemplate{
+				MessageType: InitCodeMessageType,
+				Language:    language.German,
+				HTML:        "<html>{{.Text}} {{.Code}}</html>",
+				PlainText:   "{{.Text}} {{.Code}}",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.IsValid(supported)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
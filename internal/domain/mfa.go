@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/crypto"
 )
 
@@ -18,6 +20,12 @@ const (
 type MultifactorConfigs struct {
 	OTP           OTPConfig
 	RecoveryCodes RecoveryCodesConfig
+	Push          PushConfig
+}
+
+type PushConfig struct {
+	// ChallengeExpiry is the duration a push challenge of a session can be approved.
+	ChallengeExpiry time.Duration
 }
 
 type OTPConfig struct {
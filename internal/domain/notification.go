@@ -36,6 +36,32 @@ const (
 	notificationProviderTypeCount
 )
 
+// NotificationDeliveryState is the state of a notification after its latest delivery attempt.
+type NotificationDeliveryState int32
+
+const (
+	NotificationDeliveryStateUnspecified NotificationDeliveryState = iota
+	// NotificationDeliveryStateSent is set if the notification was handed over to the provider.
+	NotificationDeliveryStateSent
+	// NotificationDeliveryStateRetrying is set if an attempt failed and the notification will be retried.
+	NotificationDeliveryStateRetrying
+	// NotificationDeliveryStateFailed is set if the notification failed and will not be retried.
+	NotificationDeliveryStateFailed
+	// NotificationDeliveryStateResendRequested is set if a new notification was requested in place of this one.
+	NotificationDeliveryStateResendRequested
+
+	notificationDeliveryStateCount
+)
+
+func (s NotificationDeliveryState) Valid() bool {
+	return s >= 0 && s < notificationDeliveryStateCount
+}
+
+// IsFinal returns true if no further delivery attempt will be made without a resend.
+func (s NotificationDeliveryState) IsFinal() bool {
+	return s == NotificationDeliveryStateSent || s == NotificationDeliveryStateFailed
+}
+
 type NotificationArguments struct {
 	Origin          string        `json:"origin,omitempty"`
 	Domain          string        `json:"domain,omitempty"`
@@ -45,6 +71,13 @@ type NotificationArguments struct {
 	CodeID          string        `json:"codeID,omitempty"`
 	SessionID       string        `json:"sessionID,omitempty"`
 	AuthRequestID   string        `json:"authRequestID,omitempty"`
+	// IP, Country and Device describe the sign-in of a new device.
+	IP      string `json:"ip,omitempty"`
+	Country string `json:"country,omitempty"`
+	Device  string `json:"device,omitempty"`
+	// LastActivity and DeactivationDate (formatted as date) describe the inactivity of the user.
+	LastActivity     string `json:"lastActivity,omitempty"`
+	DeactivationDate string `json:"deactivationDate,omitempty"`
 }
 
 // ToMap creates a type safe map of the notification arguments.
@@ -62,5 +95,10 @@ func (n *NotificationArguments) ToMap() map[string]interface{} {
 	m["CodeID"] = n.CodeID
 	m["SessionID"] = n.SessionID
 	m["AuthRequestID"] = n.AuthRequestID
+	m["IP"] = n.IP
+	m["Country"] = n.Country
+	m["Device"] = n.Device
+	m["LastActivity"] = n.LastActivity
+	m["DeactivationDate"] = n.DeactivationDate
 	return m
 }
@@ -6,6 +6,9 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
 
+// OrgHierarchyMaxDepth is the maximum amount of parent organizations above an organization.
+const OrgHierarchyMaxDepth = 10
+
 type Org struct {
 	models.ObjectRoot
 
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// InactivityPolicy defines the steps taken on users without activity.
+type InactivityPolicy struct {
+	models.ObjectRoot
+
+	Default bool
+	// WarnDays is the amount of days without activity after which a user is notified about the upcoming deactivation.
+	WarnDays uint64
+	// DeactivateDays is the amount of days without activity after which a user is deactivated.
+	DeactivateDays uint64
+	// DeleteDays is the amount of days without activity after which a user is deleted.
+	DeleteDays uint64
+	// ExemptMachineUsers excludes machine users from the inactivity handling.
+	ExemptMachineUsers bool
+	// ExemptRoles excludes users from the inactivity handling, which are granted or member with one of the roles.
+	ExemptRoles []string
+}
+
+// IsValid checks that the inactivity steps (if set) are in order: warn < deactivate < delete
+// and that the users are warned before they are deactivated or deleted.
+func (p *InactivityPolicy) IsValid() error {
+	if p.WarnDays == 0 && (p.DeactivateDays > 0 || p.DeleteDays > 0) {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ahr7i", "Errors.Policy.Inactivity.WarningMissing")
+	}
+	steps := []uint64{p.WarnDays, p.DeactivateDays, p.DeleteDays}
+	var previous uint64
+	for _, days := range steps {
+		if days == 0 {
+			continue
+		}
+		if days <= previous {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-oong8", "Errors.Policy.Inactivity.StepsInvalid")
+		}
+		previous = days
+	}
+	return nil
+}
+
+// Enabled returns true if any action is taken on inactive users.
+func (p *InactivityPolicy) Enabled() bool {
+	return p.WarnDays > 0 || p.DeactivateDays > 0 || p.DeleteDays > 0
+}
+
+// FirstStepDays returns the amount of days without activity after which the first step is taken,
+// 0 if the inactivity handling is disabled.
+func (p *InactivityPolicy) FirstStepDays() uint64 {
+	for _, days := range []uint64{p.WarnDays, p.DeactivateDays, p.DeleteDays} {
+		if days > 0 {
+			return days
+		}
+	}
+	return 0
+}
+
+// Action returns the action to be taken for a user, who was last active at lastActivity.
+func (p *InactivityPolicy) Action(lastActivity, now time.Time) InactivityAction {
+	inactiveSince := func(days uint64) bool {
+		return days > 0 && !lastActivity.After(now.AddDate(0, 0, -int(days)))
+	}
+	switch {
+	case inactiveSince(p.DeleteDays):
+		return InactivityActionDelete
+	case inactiveSince(p.DeactivateDays):
+		return InactivityActionDeactivate
+	case inactiveSince(p.WarnDays):
+		return InactivityActionWarn
+	default:
+		return InactivityActionNone
+	}
+}
+
+// Since returns the date from which the inactivity steps of a user are counted.
+// If the user was warned later than due, e.g. because the policy was set after the user became inactive,
+// the following steps are postponed by the delay.
+// This gives every user the time between the warning and the deactivation to become active again.
+func (p *InactivityPolicy) Since(lastActivity, warnedAt time.Time) time.Time {
+	due := warnedAt.AddDate(0, 0, -int(p.WarnDays))
+	if due.After(lastActivity) {
+		return due
+	}
+	return lastActivity
+}
+
+// DeactivationDate returns the date the user will be deactivated (or deleted) if not active until then.
+// The zero time is returned if the policy does neither deactivate nor delete.
+func (p *InactivityPolicy) DeactivationDate(lastActivity time.Time) time.Time {
+	days := p.DeactivateDays
+	if days == 0 {
+		days = p.DeleteDays
+	}
+	if days == 0 {
+		return time.Time{}
+	}
+	return lastActivity.AddDate(0, 0, int(days))
+}
+
+type InactivityAction int32
+
+const (
+	InactivityActionNone InactivityAction = iota
+	InactivityActionWarn
+	InactivityActionDeactivate
+	InactivityActionDelete
+)
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestInactivityPolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *InactivityPolicy
+		wantErr bool
+	}{
+		{
+			name:   "no inactivity steps",
+			policy: &InactivityPolicy{},
+		},
+		{
+			name: "all steps in order",
+			policy: &InactivityPolicy{
+				WarnDays:       30,
+				DeactivateDays: 60,
+				DeleteDays:     90,
+			},
+		},
+		{
+			name: "warn and delete only",
+			policy: &InactivityPolicy{
+				WarnDays:   30,
+				DeleteDays: 90,
+			},
+		},
+		{
+			name: "warn after deactivate",
+			policy: &InactivityPolicy{
+				WarnDays:       60,
+				DeactivateDays: 30,
+			},
+			wantErr: true,
+		},
+		{
+			name: "deactivate without warning",
+			policy: &InactivityPolicy{
+				DeactivateDays: 60,
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete without warning",
+			policy: &InactivityPolicy{
+				DeleteDays: 90,
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete equals deactivate",
+			policy: &InactivityPolicy{
+				WarnDays:       30,
+				DeactivateDays: 60,
+				DeleteDays:     60,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.IsValid()
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestInactivityPolicy_Action(t *testing.T) {
+	now := time.Now()
+	policy := &InactivityPolicy{
+		WarnDays:       30,
+		DeactivateDays: 60,
+		DeleteDays:     90,
+	}
+	tests := []struct {
+		name         string
+		policy       *InactivityPolicy
+		lastActivity time.Time
+		want         InactivityAction
+	}{
+		{
+			name:         "disabled",
+			policy:       &InactivityPolicy{},
+			lastActivity: now.AddDate(-1, 0, 0),
+			want:         InactivityActionNone,
+		},
+		{
+			name:         "active",
+			policy:       policy,
+			lastActivity: now.AddDate(0, 0, -29),
+			want:         InactivityActionNone,
+		},
+		{
+			name:         "warn",
+			policy:       policy,
+			lastActivity: now.AddDate(0, 0, -30),
+			want:         InactivityActionWarn,
+		},
+		{
+			name:         "deactivate",
+			policy:       policy,
+			lastActivity: now.AddDate(0, 0, -60),
+			want:         InactivityActionDeactivate,
+		},
+		{
+			name:         "delete",
+			policy:       policy,
+			lastActivity: now.AddDate(0, 0, -91),
+			want:         InactivityActionDelete,
+		},
+		{
+			name: "delete without deactivate",
+			policy: &InactivityPolicy{
+				DeleteDays: 90,
+			},
+			lastActivity: now.AddDate(0, 0, -90),
+			want:         InactivityActionDelete,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.Action(tt.lastActivity, now))
+		})
+	}
+}
+
+func TestInactivityPolicy_Since(t *testing.T) {
+	policy := &InactivityPolicy{WarnDays: 30, DeactivateDays: 60}
+	lastActivity := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	// warned when due
+	assert.Equal(t, lastActivity, policy.Since(lastActivity, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)))
+	// warned 10 days late, the following steps are postponed
+	assert.Equal(t, time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC), policy.Since(lastActivity, time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestInactivityPolicy_DeactivationDate(t *testing.T) {
+	lastActivity := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), (&InactivityPolicy{WarnDays: 30, DeactivateDays: 60}).DeactivationDate(lastActivity))
+	assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), (&InactivityPolicy{WarnDays: 30, DeleteDays: 90}).DeactivationDate(lastActivity))
+	assert.True(t, (&InactivityPolicy{WarnDays: 30}).DeactivationDate(lastActivity).IsZero())
+}
+
+func TestInactivityPolicy_FirstStepDays(t *testing.T) {
+	assert.Equal(t, uint64(30), (&InactivityPolicy{WarnDays: 30, DeleteDays: 90}).FirstStepDays())
+	assert.Equal(t, uint64(60), (&InactivityPolicy{DeactivateDays: 60, DeleteDays: 90}).FirstStepDays())
+	assert.Equal(t, uint64(90), (&InactivityPolicy{DeleteDays: 90}).FirstStepDays())
+	assert.Zero(t, (&InactivityPolicy{}).FirstStepDays())
+}
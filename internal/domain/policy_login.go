@@ -31,6 +31,16 @@ type LoginPolicy struct {
 	MultiFactorCheckLifetime   time.Duration
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
+	TrustedDeviceLifetime      time.Duration
+	SessionIdleTimeout         time.Duration
+	SessionMaxLifetime         time.Duration
+	MaxConcurrentSessions      uint64
+	WebAuthNAttestation        AttestationConveyance
+	WebAuthNUserVerification   UserVerificationRequirement
+	WebAuthNResidentKey        ResidentKeyRequirement
+	WebAuthNAllowedAAGUIDs     []string
+	WebAuthNDeniedAAGUIDs      []string
 }
 
 func ValidateDefaultRedirectURI(rawURL string) bool {
@@ -2,11 +2,24 @@ package domain
 
 import (
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
+// MaxPasswordHistoryDepth is the maximum amount of previous passwords which can be prevented from reuse.
+const MaxPasswordHistoryDepth = 24
+
 type PasswordAgePolicy struct {
 	models.ObjectRoot
 
 	MaxAgeDays     uint64
 	ExpireWarnDays uint64
+	// HistoryDepth is the amount of most recent passwords (including the current one), which must not be reused.
+	HistoryDepth uint64
+}
+
+func (p *PasswordAgePolicy) IsValid() error {
+	if p.HistoryDepth > MaxPasswordHistoryDepth {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Eiph3", "Errors.User.PasswordAgePolicy.HistoryDepthNotAllowed")
+	}
+	return nil
 }
@@ -22,6 +22,8 @@ type PasswordComplexityPolicy struct {
 	HasUppercase bool
 	HasNumber    bool
 	HasSymbol    bool
+	// CheckBreached rejects passwords found in a breached password corpus.
+	CheckBreached bool
 
 	Default bool
 }
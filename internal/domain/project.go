@@ -13,6 +13,7 @@ type Project struct {
 	ProjectRoleCheck       bool
 	HasProjectCheck        bool
 	PrivateLabelingSetting PrivateLabelingSetting
+	ResourceURIs           []string
 }
 
 type ProjectState int32
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"net/url"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ResourceParam is the name of the request parameter
+// defined by Resource Indicators for OAuth 2.0 (RFC 8707).
+const ResourceParam = "resource"
+
+// ValidateResourceURIs checks that each uri is an absolute URI without a fragment component,
+// as required for resource indicators by [RFC 8707, Section 2](https://www.rfc-editor.org/rfc/rfc8707#section-2).
+func ValidateResourceURIs(uris []string) error {
+	for _, uri := range uris {
+		parsed, err := url.Parse(uri)
+		if err != nil || !parsed.IsAbs() || parsed.Fragment != "" || parsed.RawFragment != "" {
+			return zerrors.ThrowInvalidArgument(err, "DOMAIN-ahG5u", "Errors.Project.ResourceURIInvalid")
+		}
+	}
+	return nil
+}
+
+// ParseResourceIndicators validates the values of the resource parameters of a request
+// and returns them without duplicates.
+// Besides absolute URIs, the client ID of an API application is accepted as resource,
+// but values with a fragment component are always rejected.
+func ParseResourceIndicators(values []string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	resources := make([]string, 0, len(values))
+	for _, value := range values {
+		parsed, err := url.Parse(value)
+		if value == "" || err != nil || parsed.Fragment != "" || parsed.RawFragment != "" {
+			return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Eeth7", "Errors.AuthRequest.Resource.Invalid")
+		}
+		if !slices.Contains(resources, value) {
+			resources = append(resources, value)
+		}
+	}
+	return resources, nil
+}
+
+// RestrictResourceIndicators checks that each of the requested resources was granted
+// and returns the requested resources.
+// If no resources are requested, all granted resources are returned.
+// This allows clients to request access tokens for a single resource (e.g. on a refresh token grant)
+// as described in [RFC 8707, Section 2.2](https://www.rfc-editor.org/rfc/rfc8707#section-2.2).
+func RestrictResourceIndicators(granted, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return granted, nil
+	}
+	if !isSubset(requested, granted) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-ua9Oh", "Errors.AuthRequest.Resource.NotGranted")
+	}
+	return requested, nil
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestValidateResourceURIs(t *testing.T) {
+	tests := []struct {
+		name    string
+		uris    []string
+		wantErr error
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "absolute uris",
+			uris: []string{"https://api.example.com", "urn:example:api"},
+		},
+		{
+			name:    "relative uri",
+			uris:    []string{"https://api.example.com", "/api"},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-ahG5u", "Errors.Project.ResourceURIInvalid"),
+		},
+		{
+			name:    "fragment",
+			uris:    []string{"https://api.example.com#v1"},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-ahG5u", "Errors.Project.ResourceURIInvalid"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateResourceURIs(tt.uris)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParseResourceIndicators(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "empty",
+			want: nil,
+		},
+		{
+			name:   "uri and client id, duplicates removed",
+			values: []string{"https://api.example.com", "123@project", "https://api.example.com"},
+			want:   []string{"https://api.example.com", "123@project"},
+		},
+		{
+			name:    "empty value",
+			values:  []string{""},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Eeth7", "Errors.AuthRequest.Resource.Invalid"),
+		},
+		{
+			name:    "fragment",
+			values:  []string{"https://api.example.com#v1"},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Eeth7", "Errors.AuthRequest.Resource.Invalid"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseResourceIndicators(tt.values)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRestrictResourceIndicators(t *testing.T) {
+	granted := []string{"https://api.example.com", "https://other.example.com"}
+	tests := []struct {
+		name      string
+		granted   []string
+		requested []string
+		want      []string
+		wantErr   error
+	}{
+		{
+			name:    "none requested",
+			granted: granted,
+			want:    granted,
+		},
+		{
+			name:      "single resource",
+			granted:   granted,
+			requested: []string{"https://other.example.com"},
+			want:      []string{"https://other.example.com"},
+		},
+		{
+			name:      "not granted",
+			granted:   granted,
+			requested: []string{"https://unknown.example.com"},
+			wantErr:   zerrors.ThrowInvalidArgument(nil, "DOMAIN-ua9Oh", "Errors.AuthRequest.Resource.NotGranted"),
+		},
+		{
+			name:      "nothing granted",
+			requested: []string{"https://api.example.com"},
+			wantErr:   zerrors.ThrowInvalidArgument(nil, "DOMAIN-ua9Oh", "Errors.AuthRequest.Resource.NotGranted"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RestrictResourceIndicators(tt.granted, tt.requested)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// RiskOutcome is the decision taken based on the risk of a session check.
+type RiskOutcome int32
+
+const (
+	RiskOutcomeUnspecified RiskOutcome = iota
+	// RiskOutcomeAllow lets the session continue without further requirements.
+	RiskOutcomeAllow
+	// RiskOutcomeRequireMFA requires the session to be authenticated with multiple factors
+	// before it can be used for an authentication (e.g. linked to an auth request).
+	RiskOutcomeRequireMFA
+	// RiskOutcomeDeny rejects the session check.
+	RiskOutcomeDeny
+	riskOutcomeCount
+)
+
+func (o RiskOutcome) Valid() bool {
+	return o > RiskOutcomeUnspecified && o < riskOutcomeCount
+}
+
+// RiskOutcomeFromString parses the outcome as returned by an action target.
+func RiskOutcomeFromString(outcome string) RiskOutcome {
+	switch outcome {
+	case "allow":
+		return RiskOutcomeAllow
+	case "require_mfa":
+		return RiskOutcomeRequireMFA
+	case "deny":
+		return RiskOutcomeDeny
+	default:
+		return RiskOutcomeUnspecified
+	}
+}
+
+func (o RiskOutcome) String() string {
+	switch o {
+	case RiskOutcomeAllow:
+		return "allow"
+	case RiskOutcomeRequireMFA:
+		return "require_mfa"
+	case RiskOutcomeDeny:
+		return "deny"
+	case RiskOutcomeUnspecified, riskOutcomeCount:
+		fallthrough
+	default:
+		return "unspecified"
+	}
+}
+
+// RiskSignal describes an anomaly detected during the evaluation of a session check.
+// Besides the signals below, actions can add custom signals.
+type RiskSignal string
+
+const (
+	// RiskSignalNewDevice is raised if the user never logged in from the user agent (fingerprint) before.
+	RiskSignalNewDevice RiskSignal = "new_device"
+	// RiskSignalNewIP is raised if the user never logged in from the IP address before.
+	RiskSignalNewIP RiskSignal = "new_ip"
+	// RiskSignalNewASN is raised if the user never logged in from the autonomous system (network provider) before.
+	RiskSignalNewASN RiskSignal = "new_asn"
+	// RiskSignalNewCountry is raised if the user never logged in from the country before.
+	RiskSignalNewCountry RiskSignal = "new_country"
+	// RiskSignalImpossibleTravel is raised if the distance to the location of the previous login
+	// could not have been travelled in the time since.
+	RiskSignalImpossibleTravel RiskSignal = "impossible_travel"
+	// RiskSignalFailedAttempts is raised if checks of many different accounts failed from the IP address.
+	RiskSignalFailedAttempts RiskSignal = "failed_attempts"
+)
+
+// GeoLocation is the location of an IP address as resolved from a GeoIP database.
+type GeoLocation struct {
+	Country   string  `json:"country,omitempty"`
+	ASN       uint32  `json:"asn,omitempty"`
+	Latitude  float64 `json:"latitude,omitempty"`
+	Longitude float64 `json:"longitude,omitempty"`
+}
+
+// HasCoordinates reports whether the location provides coordinates to compute distances.
+func (l *GeoLocation) HasCoordinates() bool {
+	return l != nil && (l.Latitude != 0 || l.Longitude != 0)
+}
+
+const earthRadiusKM = 6371
+
+// DistanceKM returns the great-circle distance between the locations in kilometers.
+func (l *GeoLocation) DistanceKM(other *GeoLocation) float64 {
+	lat1, lat2 := l.Latitude*math.Pi/180, other.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (other.Longitude - l.Longitude) * math.Pi / 180
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKM * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// RiskAssessment is the result of the risk evaluation of a session check.
+type RiskAssessment struct {
+	Score       int
+	Signals     []RiskSignal
+	Outcome     RiskOutcome
+	Location    *GeoLocation
+	EvaluatedAt time.Time
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRiskOutcomeFromString(t *testing.T) {
+	for _, outcome := range []RiskOutcome{RiskOutcomeAllow, RiskOutcomeRequireMFA, RiskOutcomeDeny} {
+		assert.Equal(t, outcome, RiskOutcomeFromString(outcome.String()))
+	}
+	assert.Equal(t, RiskOutcomeUnspecified, RiskOutcomeFromString("block"))
+	assert.False(t, RiskOutcomeFromString("").Valid())
+}
+
+func TestGeoLocation_DistanceKM(t *testing.T) {
+	zurich := &GeoLocation{Latitude: 47.37, Longitude: 8.54}
+	newYork := &GeoLocation{Latitude: 40.71, Longitude: -74.01}
+
+	assert.InDelta(t, 0, zurich.DistanceKM(zurich), 0.001)
+	assert.InDelta(t, 6320, zurich.DistanceKM(newYork), 10)
+	assert.InDelta(t, newYork.DistanceKM(zurich), zurich.DistanceKM(newYork), 0.001)
+	assert.True(t, zurich.HasCoordinates())
+	assert.False(t, (&GeoLocation{Country: "CH"}).HasCoordinates())
+}
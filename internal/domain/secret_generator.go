@@ -16,6 +16,7 @@ const (
 	SecretGeneratorTypeOTPEmail
 	SecretGeneratorTypeInviteCode
 	SecretGeneratorTypeSigningKey
+	SecretGeneratorTypeMagicLink
 
 	secretGeneratorTypeCount
 )
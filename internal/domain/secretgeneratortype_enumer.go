@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _SecretGeneratorTypeName = "unspecifiedinit_codeverify_email_codeverify_phone_codeverify_domainpassword_reset_codepasswordless_init_codeapp_secretotpsmsotp_emailinvite_codesigning_keysecret_generator_type_count"
+const _SecretGeneratorTypeName = "unspecifiedinit_codeverify_email_codeverify_phone_codeverify_domainpassword_reset_codepasswordless_init_codeapp_secretotpsmsotp_emailinvite_codesigning_keymagic_linksecret_generator_type_count"
 
-var _SecretGeneratorTypeIndex = [...]uint8{0, 11, 20, 37, 54, 67, 86, 108, 118, 124, 133, 144, 155, 182}
+var _SecretGeneratorTypeIndex = [...]uint8{0, 11, 20, 37, 54, 67, 86, 108, 118, 124, 133, 144, 155, 165, 192}
 
-const _SecretGeneratorTypeLowerName = "unspecifiedinit_codeverify_email_codeverify_phone_codeverify_domainpassword_reset_codepasswordless_init_codeapp_secretotpsmsotp_emailinvite_codesigning_keysecret_generator_type_count"
+const _SecretGeneratorTypeLowerName = "unspecifiedinit_codeverify_email_codeverify_phone_codeverify_domainpassword_reset_codepasswordless_init_codeapp_secretotpsmsotp_emailinvite_codesigning_keymagic_linksecret_generator_type_count"
 
 func (i SecretGeneratorType) String() string {
 	if i < 0 || i >= SecretGeneratorType(len(_SecretGeneratorTypeIndex)-1) {
@@ -36,10 +36,11 @@ func _SecretGeneratorTypeNoOp() {
 	_ = x[SecretGeneratorTypeOTPEmail-(9)]
 	_ = x[SecretGeneratorTypeInviteCode-(10)]
 	_ = x[SecretGeneratorTypeSigningKey-(11)]
-	_ = x[secretGeneratorTypeCount-(12)]
+	_ = x[SecretGeneratorTypeMagicLink-(12)]
+	_ = x[secretGeneratorTypeCount-(13)]
 }
 
-var _SecretGeneratorTypeValues = []SecretGeneratorType{SecretGeneratorTypeUnspecified, SecretGeneratorTypeInitCode, SecretGeneratorTypeVerifyEmailCode, SecretGeneratorTypeVerifyPhoneCode, SecretGeneratorTypeVerifyDomain, SecretGeneratorTypePasswordResetCode, SecretGeneratorTypePasswordlessInitCode, SecretGeneratorTypeAppSecret, SecretGeneratorTypeOTPSMS, SecretGeneratorTypeOTPEmail, SecretGeneratorTypeInviteCode, SecretGeneratorTypeSigningKey, secretGeneratorTypeCount}
+var _SecretGeneratorTypeValues = []SecretGeneratorType{SecretGeneratorTypeUnspecified, SecretGeneratorTypeInitCode, SecretGeneratorTypeVerifyEmailCode, SecretGeneratorTypeVerifyPhoneCode, SecretGeneratorTypeVerifyDomain, SecretGeneratorTypePasswordResetCode, SecretGeneratorTypePasswordlessInitCode, SecretGeneratorTypeAppSecret, SecretGeneratorTypeOTPSMS, SecretGeneratorTypeOTPEmail, SecretGeneratorTypeInviteCode, SecretGeneratorTypeSigningKey, SecretGeneratorTypeMagicLink, secretGeneratorTypeCount}
 
 var _SecretGeneratorTypeNameToValueMap = map[string]SecretGeneratorType{
 	_SecretGeneratorTypeName[0:11]:         SecretGeneratorTypeUnspecified,
@@ -66,8 +67,10 @@ var _SecretGeneratorTypeNameToValueMap = map[string]SecretGeneratorType{
 	_SecretGeneratorTypeLowerName[133:144]: SecretGeneratorTypeInviteCode,
 	_SecretGeneratorTypeName[144:155]:      SecretGeneratorTypeSigningKey,
 	_SecretGeneratorTypeLowerName[144:155]: SecretGeneratorTypeSigningKey,
-	_SecretGeneratorTypeName[155:182]:      secretGeneratorTypeCount,
-	_SecretGeneratorTypeLowerName[155:182]: secretGeneratorTypeCount,
+	_SecretGeneratorTypeName[155:165]:      SecretGeneratorTypeMagicLink,
+	_SecretGeneratorTypeLowerName[155:165]: SecretGeneratorTypeMagicLink,
+	_SecretGeneratorTypeName[165:192]:      secretGeneratorTypeCount,
+	_SecretGeneratorTypeLowerName[165:192]: secretGeneratorTypeCount,
 }
 
 var _SecretGeneratorTypeNames = []string{
@@ -83,7 +86,8 @@ var _SecretGeneratorTypeNames = []string{
 	_SecretGeneratorTypeName[124:133],
 	_SecretGeneratorTypeName[133:144],
 	_SecretGeneratorTypeName[144:155],
-	_SecretGeneratorTypeName[155:182],
+	_SecretGeneratorTypeName[155:165],
+	_SecretGeneratorTypeName[165:192],
 }
 
 // SecretGeneratorTypeString retrieves an enum value from the enum constants string name.
@@ -14,6 +14,28 @@ const (
 	SessionStateTerminated
 )
 
+type MagicLinkURLData struct {
+	Code              string
+	UserID            string
+	LoginName         string
+	DisplayName       string
+	PreferredLanguage language.Tag
+	SessionID         string
+}
+
+// RenderMagicLinkURLTemplate parses and renders tmpl.
+// code, userID, (preferred) loginName, displayName and preferredLanguage are passed into the [MagicLinkURLData].
+func RenderMagicLinkURLTemplate(w io.Writer, tmpl, code, userID, loginName, displayName, sessionID string, preferredLanguage language.Tag) error {
+	return renderURLTemplate(w, tmpl, &MagicLinkURLData{
+		Code:              code,
+		UserID:            userID,
+		LoginName:         loginName,
+		DisplayName:       displayName,
+		PreferredLanguage: preferredLanguage,
+		SessionID:         sessionID,
+	})
+}
+
 type OTPEmailURLData struct {
 	Code              string
 	UserID            string
@@ -1,5 +1,9 @@
 package domain
 
+import (
+	"slices"
+)
+
 type SMSConfigState int32
 
 const (
@@ -12,3 +16,57 @@ const (
 func (s SMSConfigState) Exists() bool {
 	return s != SMSConfigStateUnspecified && s != SMSConfigStateRemoved
 }
+
+// PhoneChannel is the channel a notification is delivered by to a phone number.
+type PhoneChannel int32
+
+const (
+	PhoneChannelUnspecified PhoneChannel = iota
+	PhoneChannelSMS
+	PhoneChannelWhatsApp
+	PhoneChannelVoice
+
+	phoneChannelCount
+)
+
+func (c PhoneChannel) Valid() bool {
+	return c > PhoneChannelUnspecified && c < phoneChannelCount
+}
+
+// OrSMS returns SMS for an unspecified channel,
+// as providers and activations without a channel were all sending SMS.
+func (c PhoneChannel) OrSMS() PhoneChannel {
+	if c == PhoneChannelUnspecified {
+		return PhoneChannelSMS
+	}
+	return c
+}
+
+// ValidPhoneChannels checks that all channels are valid and listed only once.
+func ValidPhoneChannels(channels []PhoneChannel) bool {
+	for i, channel := range channels {
+		if !channel.Valid() || slices.Contains(channels[:i], channel) {
+			return false
+		}
+	}
+	return true
+}
+
+// PhoneChannelOrder returns the channels in the order they are tried to deliver a notification:
+// the preferred channel of the user followed by the fallback order of the notification policy.
+// Without a fallback order, SMS is used.
+func PhoneChannelOrder(preferred PhoneChannel, fallbacks []PhoneChannel) []PhoneChannel {
+	if len(fallbacks) == 0 {
+		fallbacks = []PhoneChannel{PhoneChannelSMS}
+	}
+	order := make([]PhoneChannel, 0, len(fallbacks)+1)
+	if preferred.Valid() {
+		order = append(order, preferred)
+	}
+	for _, channel := range fallbacks {
+		if channel.Valid() && !slices.Contains(order, channel) {
+			order = append(order, channel)
+		}
+	}
+	return order
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhoneChannelOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		preferred PhoneChannel
+		fallbacks []PhoneChannel
+		want      []PhoneChannel
+	}{
+		{
+			name: "no preference and fallbacks, sms",
+			want: []PhoneChannel{PhoneChannelSMS},
+		},
+		{
+			name:      "preference without fallbacks, preference and sms",
+			preferred: PhoneChannelVoice,
+			want:      []PhoneChannel{PhoneChannelVoice, PhoneChannelSMS},
+		},
+		{
+			name:      "fallbacks without preference, fallbacks",
+			fallbacks: []PhoneChannel{PhoneChannelWhatsApp, PhoneChannelSMS},
+			want:      []PhoneChannel{PhoneChannelWhatsApp, PhoneChannelSMS},
+		},
+		{
+			name:      "preference part of fallbacks, preference first",
+			preferred: PhoneChannelVoice,
+			fallbacks: []PhoneChannel{PhoneChannelWhatsApp, PhoneChannelVoice, PhoneChannelSMS},
+			want:      []PhoneChannel{PhoneChannelVoice, PhoneChannelWhatsApp, PhoneChannelSMS},
+		},
+		{
+			name:      "invalid channels, ignored",
+			preferred: phoneChannelCount,
+			fallbacks: []PhoneChannel{PhoneChannelUnspecified, PhoneChannelWhatsApp},
+			want:      []PhoneChannel{PhoneChannelWhatsApp},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PhoneChannelOrder(tt.preferred, tt.fallbacks))
+		})
+	}
+}
+
+func TestPhoneChannel_OrSMS(t *testing.T) {
+	assert.Equal(t, PhoneChannelSMS, PhoneChannelUnspecified.OrSMS())
+	assert.Equal(t, PhoneChannelWhatsApp, PhoneChannelWhatsApp.OrSMS())
+}
+
+func TestValidPhoneChannels(t *testing.T) {
+	assert.True(t, ValidPhoneChannels(nil))
+	assert.True(t, ValidPhoneChannels([]PhoneChannel{PhoneChannelWhatsApp, PhoneChannelSMS, PhoneChannelVoice}))
+	assert.False(t, ValidPhoneChannels([]PhoneChannel{PhoneChannelSMS, PhoneChannelUnspecified}))
+	assert.False(t, ValidPhoneChannels([]PhoneChannel{PhoneChannelSMS, PhoneChannelVoice, PhoneChannelSMS}))
+}
@@ -0,0 +1,23 @@
+package domain
+
+// SoftwareStatementIssuer is trusted to sign software statements
+// presented on dynamic client registration (RFC 7591, Section 2.3).
+type SoftwareStatementIssuer struct {
+	// Issuer must match the iss claim of the statement.
+	Issuer string `json:"issuer"`
+	// KeySet is the JSON Web Key Set (RFC 7517) with the public keys
+	// the statements of the issuer are verified with.
+	KeySet string `json:"keySet"`
+}
+
+type SoftwareStatementIssuers []SoftwareStatementIssuer
+
+// ByIssuer returns the trusted issuer matching the iss claim of a statement.
+func (issuers SoftwareStatementIssuers) ByIssuer(issuer string) *SoftwareStatementIssuer {
+	for i := range issuers {
+		if issuers[i].Issuer == issuer {
+			return &issuers[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"slices"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+)
+
+// TokenTypeUserID is the token type to exchange a user ID for a token (impersonation).
+const TokenTypeUserID = "urn:zitadel:params:oauth:token-type:user_id"
+
+// tokenExchangeSubjectTokenTypes are the token types supported as subject_token.
+var tokenExchangeSubjectTokenTypes = []string{
+	string(oidc.AccessTokenType),
+	string(oidc.IDTokenType),
+	string(oidc.JWTTokenType),
+	TokenTypeUserID,
+}
+
+// TokenExchangePolicy restricts the OAuth 2.0 Token Exchange (RFC 8693) requests of an application.
+// Empty lists do not restrict the exchange,
+// so the zero value allows everything the instance security policy allows.
+type TokenExchangePolicy struct {
+	// SubjectTokenTypes are the token types the application may pass as subject_token.
+	SubjectTokenTypes []string `json:"subjectTokenTypes,omitempty"`
+	// Audiences are the audiences the application may request for the exchanged token.
+	Audiences []string `json:"audiences,omitempty"`
+	// Scopes are the maximum scopes of the exchanged token.
+	Scopes []string `json:"scopes,omitempty"`
+	// DenyNestedActors rejects actor tokens which were obtained by delegation themselves,
+	// so the exchanged token never contains a nested act claim.
+	DenyNestedActors bool `json:"denyNestedActors,omitempty"`
+}
+
+// IsValid checks that only supported subject token types are configured and no list contains empty values.
+func (p *TokenExchangePolicy) IsValid() bool {
+	if p == nil {
+		return true
+	}
+	for _, tokenType := range p.SubjectTokenTypes {
+		if !slices.Contains(tokenExchangeSubjectTokenTypes, tokenType) {
+			return false
+		}
+	}
+	return !slices.Contains(p.Audiences, "") && !slices.Contains(p.Scopes, "")
+}
+
+func (p *TokenExchangePolicy) AllowsSubjectTokenType(tokenType string) bool {
+	return p == nil || len(p.SubjectTokenTypes) == 0 || slices.Contains(p.SubjectTokenTypes, tokenType)
+}
+
+func (p *TokenExchangePolicy) AllowsAudience(audience string) bool {
+	return p == nil || len(p.Audiences) == 0 || slices.Contains(p.Audiences, audience)
+}
+
+func (p *TokenExchangePolicy) AllowsScope(scope string) bool {
+	return p == nil || len(p.Scopes) == 0 || slices.Contains(p.Scopes, scope)
+}
+
+func (p *TokenExchangePolicy) AllowsNestedActors() bool {
+	return p == nil || !p.DenyNestedActors
+}
+
+// IsZero reports if the policy does not restrict anything.
+func (p *TokenExchangePolicy) IsZero() bool {
+	return p == nil || p.Equal(&TokenExchangePolicy{})
+}
+
+func (p *TokenExchangePolicy) Equal(o *TokenExchangePolicy) bool {
+	if p == nil || o == nil {
+		return p == o
+	}
+	return slices.Equal(p.SubjectTokenTypes, o.SubjectTokenTypes) &&
+		slices.Equal(p.Audiences, o.Audiences) &&
+		slices.Equal(p.Scopes, o.Scopes) &&
+		p.DenyNestedActors == o.DenyNestedActors
+}
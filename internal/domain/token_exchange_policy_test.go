@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenExchangePolicy_Allows(t *testing.T) {
+	tests := []struct {
+		name             string
+		policy           *TokenExchangePolicy
+		wantTokenType    bool
+		wantAudience     bool
+		wantScope        bool
+		wantNestedActors bool
+		wantIsZero       bool
+	}{
+		{
+			name:             "nil policy",
+			policy:           nil,
+			wantTokenType:    true,
+			wantAudience:     true,
+			wantScope:        true,
+			wantNestedActors: true,
+			wantIsZero:       true,
+		},
+		{
+			name:             "empty policy",
+			policy:           &TokenExchangePolicy{},
+			wantTokenType:    true,
+			wantAudience:     true,
+			wantScope:        true,
+			wantNestedActors: true,
+			wantIsZero:       true,
+		},
+		{
+			name: "allowed",
+			policy: &TokenExchangePolicy{
+				SubjectTokenTypes: []string{"urn:ietf:params:oauth:token-type:access_token"},
+				Audiences:         []string{"api"},
+				Scopes:            []string{"openid"},
+			},
+			wantTokenType:    true,
+			wantAudience:     true,
+			wantScope:        true,
+			wantNestedActors: true,
+			wantIsZero:       false,
+		},
+		{
+			name: "denied",
+			policy: &TokenExchangePolicy{
+				SubjectTokenTypes: []string{"urn:ietf:params:oauth:token-type:id_token"},
+				Audiences:         []string{"other"},
+				Scopes:            []string{"profile"},
+				DenyNestedActors:  true,
+			},
+			wantTokenType:    false,
+			wantAudience:     false,
+			wantScope:        false,
+			wantNestedActors: false,
+			wantIsZero:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantTokenType, tt.policy.AllowsSubjectTokenType("urn:ietf:params:oauth:token-type:access_token"))
+			assert.Equal(t, tt.wantAudience, tt.policy.AllowsAudience("api"))
+			assert.Equal(t, tt.wantScope, tt.policy.AllowsScope("openid"))
+			assert.Equal(t, tt.wantNestedActors, tt.policy.AllowsNestedActors())
+			assert.Equal(t, tt.wantIsZero, tt.policy.IsZero())
+		})
+	}
+}
+
+func TestTokenExchangePolicy_Equal(t *testing.T) {
+	tests := []struct {
+		name string
+		p, o *TokenExchangePolicy
+		want bool
+	}{
+		{
+			name: "both nil",
+			want: true,
+		},
+		{
+			name: "one nil",
+			p:    &TokenExchangePolicy{},
+			want: false,
+		},
+		{
+			name: "equal",
+			p:    &TokenExchangePolicy{Audiences: []string{"api"}, DenyNestedActors: true},
+			o:    &TokenExchangePolicy{Audiences: []string{"api"}, DenyNestedActors: true},
+			want: true,
+		},
+		{
+			name: "different",
+			p:    &TokenExchangePolicy{Scopes: []string{"openid"}},
+			o:    &TokenExchangePolicy{Scopes: []string{"profile"}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.p.Equal(tt.o))
+		})
+	}
+}
+
+func TestTokenExchangePolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *TokenExchangePolicy
+		want   bool
+	}{
+		{
+			name: "nil",
+			want: true,
+		},
+		{
+			name: "supported token types",
+			policy: &TokenExchangePolicy{
+				SubjectTokenTypes: []string{
+					"urn:ietf:params:oauth:token-type:access_token",
+					"urn:ietf:params:oauth:token-type:id_token",
+					"urn:ietf:params:oauth:token-type:jwt",
+					TokenTypeUserID,
+				},
+			},
+			want: true,
+		},
+		{
+			name:   "refresh token type",
+			policy: &TokenExchangePolicy{SubjectTokenTypes: []string{"urn:ietf:params:oauth:token-type:refresh_token"}},
+			want:   false,
+		},
+		{
+			name:   "empty audience",
+			policy: &TokenExchangePolicy{Audiences: []string{""}},
+			want:   false,
+		},
+		{
+			name:   "empty scope",
+			policy: &TokenExchangePolicy{Scopes: []string{"openid", ""}},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.IsValid())
+		})
+	}
+}
@@ -46,6 +46,11 @@ const (
 	UserAuthMethodTypePrivateKey
 	userAuthMethodTypeCount
 	UserAuthMethodTypeRecoveryCode
+	UserAuthMethodTypeMagicLink
+	// UserAuthMethodTypeTrustedDevice is set if the user agent was trusted by the user after a multi-factor authentication
+	UserAuthMethodTypeTrustedDevice
+	// UserAuthMethodTypePush is set if the user approved a push challenge on a registered device
+	UserAuthMethodTypePush
 )
 
 // HasMFA checks whether the user authenticated with multiple auth factors.
@@ -65,7 +70,10 @@ func HasMFA(methods []UserAuthMethodType) bool {
 			UserAuthMethodTypeIDP,
 			UserAuthMethodTypeOTP,
 			UserAuthMethodTypeRecoveryCode,
-			UserAuthMethodTypePrivateKey:
+			UserAuthMethodTypePrivateKey,
+			UserAuthMethodTypeMagicLink,
+			UserAuthMethodTypeTrustedDevice,
+			UserAuthMethodTypePush:
 			factors++
 		case UserAuthMethodTypeUnspecified,
 			userAuthMethodTypeCount:
@@ -86,13 +94,16 @@ func Has2FA(methods []UserAuthMethodType) bool {
 			UserAuthMethodTypeOTPSMS,
 			UserAuthMethodTypeOTPEmail,
 			UserAuthMethodTypeRecoveryCode,
-			UserAuthMethodTypeOTP:
+			UserAuthMethodTypeOTP,
+			UserAuthMethodTypePush:
 			factors++
 		case UserAuthMethodTypeUnspecified,
 			UserAuthMethodTypePassword,
 			UserAuthMethodTypePasswordless,
 			UserAuthMethodTypeIDP,
 			UserAuthMethodTypePrivateKey,
+			UserAuthMethodTypeMagicLink,
+			UserAuthMethodTypeTrustedDevice,
 			userAuthMethodTypeCount:
 			// ignore
 		}
@@ -121,6 +132,8 @@ func AuthMethodToSecondFactor(method UserAuthMethodType) SecondFactorType {
 		return SecondFactorTypeOTPSMS
 	case UserAuthMethodTypeOTPEmail:
 		return SecondFactorTypeOTPEmail
+	case UserAuthMethodTypePush:
+		return SecondFactorTypePush
 	case UserAuthMethodTypeOTP:
 		return SecondFactorTypeOTPSMS
 	default:
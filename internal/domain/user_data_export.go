@@ -0,0 +1,21 @@
+package domain
+
+// UserDataExportState is the state of an export of all data held about a user.
+type UserDataExportState int32
+
+const (
+	UserDataExportStateUnspecified UserDataExportState = iota
+	// UserDataExportStateRequested is set until the archive is built by the background job.
+	UserDataExportStateRequested
+	// UserDataExportStateSucceeded is set if the archive was built and can be downloaded.
+	UserDataExportStateSucceeded
+	// UserDataExportStateFailed is set if the archive could not be built.
+	UserDataExportStateFailed
+	// UserDataExportStateExpired is set if the archive was deleted after its lifetime.
+	UserDataExportStateExpired
+)
+
+// IsFinal returns true if the background job finished the export.
+func (s UserDataExportState) IsFinal() bool {
+	return s == UserDataExportStateSucceeded || s == UserDataExportStateFailed || s == UserDataExportStateExpired
+}
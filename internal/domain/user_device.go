@@ -0,0 +1,14 @@
+package domain
+
+// DeviceSessionType describes what was issued to a device (user agent) of a user.
+type DeviceSessionType int32
+
+const (
+	DeviceSessionTypeUnspecified DeviceSessionType = iota
+	// DeviceSessionTypeSession is a session of the session API.
+	DeviceSessionTypeSession
+	DeviceSessionTypeOIDC
+	DeviceSessionTypeSAML
+	// DeviceSessionTypeRefreshToken is a refresh token, which was issued without an OIDC session.
+	DeviceSessionTypeRefreshToken
+)
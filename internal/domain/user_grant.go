@@ -14,6 +14,8 @@ type UserGrant struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	// Validity limits the grant to a period of time, nil if it's not limited.
+	Validity *Validity
 }
 
 type UserGrantState int32
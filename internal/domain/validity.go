@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"time"
+)
+
+// Validity limits a user grant or a membership to a period of time.
+// A zero ValidFrom starts the period immediately, a zero ValidUntil never ends it.
+type Validity struct {
+	ValidFrom  time.Time
+	ValidUntil time.Time
+}
+
+// IsValid checks that the period doesn't end before it starts.
+func (v *Validity) IsValid() bool {
+	if v == nil || v.ValidFrom.IsZero() || v.ValidUntil.IsZero() {
+		return true
+	}
+	return v.ValidFrom.Before(v.ValidUntil)
+}
+
+// IsZero is true if the period is not limited at all.
+func (v *Validity) IsZero() bool {
+	return v == nil || (v.ValidFrom.IsZero() && v.ValidUntil.IsZero())
+}
+
+// Equal compares the periods, nil is equal to an unlimited period.
+func (v *Validity) Equal(other *Validity) bool {
+	if v.IsZero() || other.IsZero() {
+		return v.IsZero() == other.IsZero()
+	}
+	return v.ValidFrom.Equal(other.ValidFrom) && v.ValidUntil.Equal(other.ValidUntil)
+}
+
+// Contains checks if the point in time is part of the period.
+// The period includes ValidFrom and excludes ValidUntil.
+func (v *Validity) Contains(t time.Time) bool {
+	if v.IsZero() {
+		return true
+	}
+	if !v.ValidFrom.IsZero() && t.Before(v.ValidFrom) {
+		return false
+	}
+	return v.ValidUntil.IsZero() || t.Before(v.ValidUntil)
+}
+
+// IsExpired is true if the period ended before or at the point in time.
+func (v *Validity) IsExpired(t time.Time) bool {
+	return !v.IsZero() && !v.ValidUntil.IsZero() && !t.Before(v.ValidUntil)
+}
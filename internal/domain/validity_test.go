@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidity(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		validity    *Validity
+		wantValid   bool
+		wantZero    bool
+		wantContain bool
+		wantExpired bool
+	}{
+		{
+			name:        "nil validity",
+			validity:    nil,
+			wantValid:   true,
+			wantZero:    true,
+			wantContain: true,
+		},
+		{
+			name:        "empty validity",
+			validity:    &Validity{},
+			wantValid:   true,
+			wantZero:    true,
+			wantContain: true,
+		},
+		{
+			name: "not yet started",
+			validity: &Validity{
+				ValidFrom: now.Add(time.Hour),
+			},
+			wantValid: true,
+		},
+		{
+			name: "started",
+			validity: &Validity{
+				ValidFrom:  now,
+				ValidUntil: now.Add(time.Hour),
+			},
+			wantValid:   true,
+			wantContain: true,
+		},
+		{
+			name: "ends now",
+			validity: &Validity{
+				ValidUntil: now,
+			},
+			wantValid:   true,
+			wantExpired: true,
+		},
+		{
+			name: "ends before start",
+			validity: &Validity{
+				ValidFrom:  now,
+				ValidUntil: now.Add(-time.Hour),
+			},
+			wantValid:   false,
+			wantExpired: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantValid, tt.validity.IsValid())
+			assert.Equal(t, tt.wantZero, tt.validity.IsZero())
+			assert.Equal(t, tt.wantContain, tt.validity.Contains(now))
+			assert.Equal(t, tt.wantExpired, tt.validity.IsExpired(now))
+		})
+	}
+}
+
+func TestValidity_Equal(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	assert.True(t, (*Validity)(nil).Equal(&Validity{}))
+	assert.True(t, (&Validity{ValidUntil: now}).Equal(&Validity{ValidUntil: now.In(time.Local)}))
+	assert.False(t, (&Validity{ValidUntil: now}).Equal(nil))
+	assert.False(t, (&Validity{ValidFrom: now}).Equal(&Validity{ValidUntil: now}))
+}
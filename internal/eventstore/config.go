@@ -13,6 +13,13 @@ type Config struct {
 	Searcher Searcher
 	Queue    ExecutionQueue
 
+	// PersonalData decrypts the personal data of events with the key of their aggregate.
+	// If nil, encrypted personal data can't be read.
+	PersonalData PersonalDataCrypter
+	// EncryptPersonalData encrypts the personal data of new events using [PersonalData].
+	// Events pushed while it's disabled keep their personal data in plain text.
+	EncryptPersonalData bool
+
 	// Autovacuum tunes PostgreSQL's autovacuum and autoanalyze behavior of the
 	// events2 table.
 	Autovacuum AutovacuumConfig
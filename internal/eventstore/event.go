@@ -47,6 +47,9 @@ type EnforceResourceOwnerCommand interface {
 }
 
 func ShouldEnforceResourceOwner(cmd Command) bool {
+	if personalDataCmd, ok := cmd.(*personalDataCommand); ok {
+		cmd = personalDataCmd.PersonalDataCommand
+	}
 	_, ok := cmd.(EnforceResourceOwnerCommand)
 	return ok
 }
@@ -77,6 +80,8 @@ func EventData(event Command) ([]byte, error) {
 	switch data := event.Payload().(type) {
 	case nil:
 		return nil, nil
+	case json.RawMessage:
+		return data, nil
 	case []byte:
 		if json.Valid(data) {
 			return data, nil
@@ -1,6 +1,7 @@
 package eventstore
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"errors"
@@ -35,6 +36,9 @@ type Eventstore struct {
 	querier  Querier
 	searcher Searcher
 
+	personalData           PersonalDataCrypter
+	personalDataEncryption bool
+
 	logger *slog.Logger
 }
 
@@ -76,7 +80,11 @@ func NewEventstore(config *Config) *Eventstore {
 		pusher:   config.Pusher,
 		querier:  config.Querier,
 		searcher: config.Searcher,
-		logger:   logging.New(logging.StreamEventPusher),
+
+		personalData:           config.PersonalData,
+		personalDataEncryption: config.EncryptPersonalData,
+
+		logger: logging.New(logging.StreamEventPusher),
 	}
 }
 
@@ -121,6 +129,7 @@ func (es *Eventstore) PushWithNewClient(ctx context.Context, client new_db.Query
 		events  []Event
 		err     error
 		retries int
+		keys    *personalDataKeys
 	)
 	defer func() {
 		logging.OnError(ctx, err).Error("eventstore push failed", "retries", retries)
@@ -132,11 +141,22 @@ func (es *Eventstore) PushWithNewClient(ctx context.Context, client new_db.Query
 	// https://github.com/zitadel/zitadel/issues/7202
 retry:
 	for ; retries <= es.maxRetries; retries++ {
-		events, err = es.pusher.Push(ctx, client, cmds...)
+		// the keys are loaded for each attempt, as they might have changed concurrently
+		keys = es.newPersonalDataKeys()
+		var encrypted []Command
+		encrypted, err = es.encryptPersonalData(ctx, keys, cmds)
+		if err != nil {
+			break retry
+		}
+		events, err = es.pusher.Push(ctx, client, encrypted...)
 		// if there is a transaction passed the calling function needs to retry
 		if _, ok := client.(new_db.Transaction); ok {
 			break retry
 		}
+		if errors.Is(err, ErrPersonalDataKeyChanged) {
+			logging.WithError(ctx, err).Info("eventstore push retry")
+			continue
+		}
 		var pgErr *pgconn.PgError
 		if !errors.As(err, &pgErr) {
 			break retry
@@ -155,7 +175,13 @@ retry:
 		return nil, err
 	}
 
-	mappedEvents, err := es.mapEvents(events)
+	for _, cmd := range cmds {
+		if ShouldShredPersonalData(cmd) {
+			keys.forget(cmd.Aggregate())
+		}
+	}
+
+	mappedEvents, err := es.mapEvents(ctx, keys, events)
 	if err != nil {
 		return mappedEvents, err
 	}
@@ -196,11 +222,7 @@ func (es *Eventstore) Search(ctx context.Context, conditions ...map[FieldType]an
 func (es *Eventstore) Filter(ctx context.Context, searchQuery *SearchQueryBuilder) ([]Event, error) {
 	events := make([]Event, 0, searchQuery.GetLimit())
 	searchQuery.ensureInstanceID(ctx)
-	err := es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
-		event, err := es.mapEvent(event)
-		if err != nil {
-			return err
-		}
+	err := es.filter(ctx, searchQuery, func(event Event) error {
 		events = append(events, event)
 		return nil
 	})
@@ -210,10 +232,10 @@ func (es *Eventstore) Filter(ctx context.Context, searchQuery *SearchQueryBuilde
 	return events, nil
 }
 
-func (es *Eventstore) mapEvents(events []Event) (mappedEvents []Event, err error) {
+func (es *Eventstore) mapEvents(ctx context.Context, keys *personalDataKeys, events []Event) (mappedEvents []Event, err error) {
 	mappedEvents = make([]Event, len(events))
 	for i, event := range events {
-		mappedEvents[i], err = es.mapEventLocked(event)
+		mappedEvents[i], err = es.mapEvent(ctx, keys, event)
 		if err != nil {
 			return nil, err
 		}
@@ -221,7 +243,11 @@ func (es *Eventstore) mapEvents(events []Event) (mappedEvents []Event, err error
 	return mappedEvents, nil
 }
 
-func (es *Eventstore) mapEvent(event Event) (Event, error) {
+func (es *Eventstore) mapEvent(ctx context.Context, keys *personalDataKeys, event Event) (Event, error) {
+	event, err := decryptPersonalData(ctx, keys, event)
+	if err != nil {
+		return nil, err
+	}
 	return es.mapEventLocked(event)
 }
 
@@ -252,14 +278,47 @@ type reducer interface {
 // FilterToReducer filters the events based on the search query, appends all events to the reducer and calls it's reduce function
 func (es *Eventstore) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, r reducer) error {
 	searchQuery.ensureInstanceID(ctx)
-	return es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
-		event, err := es.mapEvent(event)
+	return es.filter(ctx, searchQuery, func(event Event) error {
+		r.AppendEvents(event)
+		return r.Reduce()
+	})
+}
+
+// filter calls reduce for every mapped event found by the search query.
+// As soon as an event with encrypted personal data is found, the remaining events are only mapped after all rows were read,
+// so the keys of all aggregates are loaded in a single query
+// and no additional connection is used while the rows of the filter are open.
+func (es *Eventstore) filter(ctx context.Context, searchQuery *SearchQueryBuilder, reduce Reducer) error {
+	keys := es.newPersonalDataKeys()
+	// events are buffered from the first event containing personal data
+	var events []Event
+	err := es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
+		if keys != nil && (events != nil || bytes.Contains(event.DataAsBytes(), personalDataMarker)) {
+			events = append(events, event)
+			return nil
+		}
+		event, err := es.mapEventLocked(event)
 		if err != nil {
 			return err
 		}
-		r.AppendEvents(event)
-		return r.Reduce()
+		return reduce(event)
 	})
+	if err != nil || len(events) == 0 {
+		return err
+	}
+	if err = keys.load(ctx, personalDataAggregates(events)); err != nil {
+		return err
+	}
+	for _, event := range events {
+		event, err = es.mapEvent(ctx, keys, event)
+		if err != nil {
+			return err
+		}
+		if err = reduce(event); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // LatestPosition filters the latest position for the given search query
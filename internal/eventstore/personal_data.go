@@ -0,0 +1,367 @@
+package eventstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// PersonalDataField is the field of the payload which holds the encrypted personal data of an event
+	PersonalDataField = "personalData"
+	// PersonalDataShreddedField is set in the payload of an event if its personal data was shredded
+	PersonalDataShreddedField = "personalDataShredded"
+)
+
+// ErrPersonalDataShredded is returned if the key of the aggregate
+// was destroyed or never created
+var ErrPersonalDataShredded = errors.New("personal data shredded")
+
+// PersonalDataCommand is a command which contains personal data.
+// The listed fields of the payload are encrypted with the key of the aggregate
+// before the event is stored.
+type PersonalDataCommand interface {
+	Command
+	// PersonalDataFields returns the json keys of the payload which contain personal data
+	PersonalDataFields() []string
+}
+
+// PersonalDataShredder is a command which destroys the key of the aggregate
+// in the push transaction. Afterward, the personal data of all events of the aggregate
+// can no longer be decrypted.
+type PersonalDataShredder interface {
+	Command
+	ShredPersonalData()
+}
+
+// ShouldShredPersonalData returns true if the command destroys the key of its aggregate
+func ShouldShredPersonalData(cmd Command) bool {
+	if personalDataCmd, ok := cmd.(*personalDataCommand); ok {
+		cmd = personalDataCmd.PersonalDataCommand
+	}
+	_, ok := cmd.(PersonalDataShredder)
+	return ok
+}
+
+// PersonalDataKeyCommand is a command whose personal data was encrypted.
+// The [Pusher] stores new keys and ensures the used key was not destroyed in the push transaction.
+type PersonalDataKeyCommand interface {
+	Command
+	PersonalDataKey() *PersonalDataKey
+}
+
+// ErrPersonalDataKeyChanged is returned by the [Pusher] if the key used to encrypt the personal data
+// was destroyed or another key was created concurrently. The push is retried with the current key.
+var ErrPersonalDataKeyChanged = errors.New("personal data key changed")
+
+// PlainPayloadCommand is implemented by commands whose stored payload differs from the original one,
+// e.g. because personal data is encrypted.
+type PlainPayloadCommand interface {
+	Command
+	// PlainPayload returns the payload before it was transformed
+	PlainPayload() any
+}
+
+// PersonalDataKey is the key of an aggregate used to encrypt its personal data
+type PersonalDataKey struct {
+	ID string
+	// Value is the plain key, it must never be stored or logged
+	Value string
+	// Crypted is the key encrypted by the key encryption algorithm.
+	// It's only set if the key was generated for the push and must be stored in its transaction.
+	Crypted []byte
+}
+
+// PersonalDataCrypter generates, loads and uses the keys of the aggregates.
+// The keys are stored and destroyed by the [Pusher] in the push transaction.
+type PersonalDataCrypter interface {
+	// Keys returns the stored keys of the aggregates in a single query.
+	// The keys are in the order of the aggregates, the key is nil if the aggregate has none.
+	Keys(ctx context.Context, aggregates []*Aggregate) ([]*PersonalDataKey, error)
+	// NewKey generates a new key for the aggregate, which is not stored yet
+	NewKey(ctx context.Context, aggregate *Aggregate) (*PersonalDataKey, error)
+	Encrypt(key *PersonalDataKey, data []byte) ([]byte, error)
+	Decrypt(key *PersonalDataKey, crypted []byte) ([]byte, error)
+}
+
+// personalDataKeys holds the keys used during a single push or filter.
+// The keys are not kept any longer, so a key destroyed by another process
+// can't be used afterward.
+type personalDataKeys struct {
+	crypter PersonalDataCrypter
+	// keys is nil for aggregates without a stored key
+	keys map[aggregateKey]*PersonalDataKey
+}
+
+type aggregateKey struct {
+	instanceID    string
+	aggregateType AggregateType
+	aggregateID   string
+}
+
+func toAggregateKey(aggregate *Aggregate) aggregateKey {
+	return aggregateKey{
+		instanceID:    aggregate.InstanceID,
+		aggregateType: aggregate.Type,
+		aggregateID:   aggregate.ID,
+	}
+}
+
+func (es *Eventstore) newPersonalDataKeys() *personalDataKeys {
+	if es.personalData == nil {
+		return nil
+	}
+	return &personalDataKeys{
+		crypter: es.personalData,
+		keys:    make(map[aggregateKey]*PersonalDataKey),
+	}
+}
+
+// load loads the stored keys of all aggregates which were not loaded yet in a single query
+func (k *personalDataKeys) load(ctx context.Context, aggregates []*Aggregate) error {
+	missing := make([]*Aggregate, 0, len(aggregates))
+	requested := make(map[aggregateKey]struct{}, len(aggregates))
+	for _, aggregate := range aggregates {
+		key := toAggregateKey(aggregate)
+		if _, ok := k.keys[key]; ok {
+			continue
+		}
+		if _, ok := requested[key]; ok {
+			continue
+		}
+		requested[key] = struct{}{}
+		missing = append(missing, aggregate)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	keys, err := k.crypter.Keys(ctx, missing)
+	if err != nil {
+		return err
+	}
+	for i, aggregate := range missing {
+		k.keys[toAggregateKey(aggregate)] = keys[i]
+	}
+	return nil
+}
+
+// stored returns the stored key of the aggregate or [ErrPersonalDataShredded] if it has none
+func (k *personalDataKeys) stored(ctx context.Context, aggregate *Aggregate) (*PersonalDataKey, error) {
+	if err := k.load(ctx, []*Aggregate{aggregate}); err != nil {
+		return nil, err
+	}
+	key := k.keys[toAggregateKey(aggregate)]
+	if key == nil {
+		return nil, ErrPersonalDataShredded
+	}
+	return key, nil
+}
+
+// forEncryption returns the stored key of the aggregate or generates a new one,
+// which is used for all commands of the aggregate in the push.
+func (k *personalDataKeys) forEncryption(ctx context.Context, aggregate *Aggregate) (*PersonalDataKey, error) {
+	key, err := k.stored(ctx, aggregate)
+	if !errors.Is(err, ErrPersonalDataShredded) {
+		return key, err
+	}
+	key, err = k.crypter.NewKey(ctx, aggregate)
+	if err != nil {
+		return nil, err
+	}
+	k.keys[toAggregateKey(aggregate)] = key
+	return key, nil
+}
+
+// forget removes the key of the aggregate, so it's loaded again
+func (k *personalDataKeys) forget(aggregate *Aggregate) {
+	if k == nil {
+		return
+	}
+	delete(k.keys, toAggregateKey(aggregate))
+}
+
+type personalDataEnvelope struct {
+	Fields []string `json:"fields"`
+	KeyID  string   `json:"keyId"`
+	Data   []byte   `json:"data"`
+}
+
+type personalDataCommand struct {
+	PersonalDataCommand
+	payload json.RawMessage
+	key     *PersonalDataKey
+}
+
+// Payload implements [Command]
+func (c *personalDataCommand) Payload() any {
+	return c.payload
+}
+
+// PersonalDataKey implements [PersonalDataKeyCommand]
+func (c *personalDataCommand) PersonalDataKey() *PersonalDataKey {
+	return c.key
+}
+
+// PlainPayload implements [PlainPayloadCommand]
+func (c *personalDataCommand) PlainPayload() any {
+	return c.PersonalDataCommand.Payload()
+}
+
+// encryptPersonalData encrypts the personal data of the commands with the keys of their aggregates,
+// if the encryption is enabled. All commands of an aggregate use the same key.
+func (es *Eventstore) encryptPersonalData(ctx context.Context, keys *personalDataKeys, cmds []Command) ([]Command, error) {
+	if keys == nil || !es.personalDataEncryption {
+		return cmds, nil
+	}
+	encrypted := make([]Command, len(cmds))
+	for i, cmd := range cmds {
+		personalDataCmd, ok := cmd.(PersonalDataCommand)
+		if !ok {
+			encrypted[i] = cmd
+			continue
+		}
+		payload, key, err := encryptPayload(ctx, keys, personalDataCmd)
+		if err != nil {
+			return nil, err
+		}
+		if payload == nil {
+			encrypted[i] = cmd
+			continue
+		}
+		encrypted[i] = &personalDataCommand{
+			PersonalDataCommand: personalDataCmd,
+			payload:             payload,
+			key:                 key,
+		}
+	}
+	return encrypted, nil
+}
+
+// encryptPayload moves the personal data fields of the payload into an encrypted envelope.
+// It returns nil if the payload does not contain any personal data.
+func encryptPayload(ctx context.Context, keys *personalDataKeys, cmd PersonalDataCommand) (json.RawMessage, *PersonalDataKey, error) {
+	data, err := EventData(cmd)
+	if err != nil || len(data) == 0 {
+		return nil, nil, err
+	}
+	payload := make(map[string]json.RawMessage)
+	if err = json.Unmarshal(data, &payload); err != nil {
+		return nil, nil, zerrors.ThrowInternal(err, "V2-Ohx4e", "Errors.Internal")
+	}
+	personalData := make(map[string]json.RawMessage)
+	fields := make([]string, 0, len(cmd.PersonalDataFields()))
+	for _, field := range cmd.PersonalDataFields() {
+		value, ok := payload[field]
+		if !ok {
+			continue
+		}
+		personalData[field] = value
+		fields = append(fields, field)
+		delete(payload, field)
+	}
+	if len(personalData) == 0 {
+		return nil, nil, nil
+	}
+	plain, err := json.Marshal(personalData)
+	if err != nil {
+		return nil, nil, zerrors.ThrowInternal(err, "V2-ieK9a", "Errors.Internal")
+	}
+	if cmd.Aggregate().InstanceID == "" {
+		cmd.Aggregate().InstanceID = authz.GetInstance(ctx).InstanceID()
+	}
+	key, err := keys.forEncryption(ctx, cmd.Aggregate())
+	if err != nil {
+		return nil, nil, err
+	}
+	crypted, err := keys.crypter.Encrypt(key, plain)
+	if err != nil {
+		return nil, nil, err
+	}
+	payload[PersonalDataField], err = json.Marshal(&personalDataEnvelope{
+		Fields: fields,
+		KeyID:  key.ID,
+		Data:   crypted,
+	})
+	if err != nil {
+		return nil, nil, zerrors.ThrowInternal(err, "V2-Xoo3u", "Errors.Internal")
+	}
+	encrypted, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, zerrors.ThrowInternal(err, "V2-Cae8o", "Errors.Internal")
+	}
+	return encrypted, key, nil
+}
+
+var personalDataMarker = []byte(`"` + PersonalDataField + `"`)
+
+// personalDataAggregates returns the aggregates of the events which contain encrypted personal data
+func personalDataAggregates(events []Event) []*Aggregate {
+	aggregates := make([]*Aggregate, 0, len(events))
+	for _, event := range events {
+		if bytes.Contains(event.DataAsBytes(), personalDataMarker) {
+			aggregates = append(aggregates, event.Aggregate())
+		}
+	}
+	return aggregates
+}
+
+// decryptPersonalData replaces the encrypted envelope of the event with the decrypted personal data.
+// If the key of the aggregate was destroyed the fields are omitted and [PersonalDataShreddedField] is set.
+func decryptPersonalData(ctx context.Context, keys *personalDataKeys, event Event) (Event, error) {
+	data := event.DataAsBytes()
+	if keys == nil || !bytes.Contains(data, personalDataMarker) {
+		return event, nil
+	}
+	payload := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, zerrors.ThrowInternal(err, "V2-kae0E", "Errors.Internal")
+	}
+	rawEnvelope, ok := payload[PersonalDataField]
+	if !ok {
+		return event, nil
+	}
+	envelope := new(personalDataEnvelope)
+	if err := json.Unmarshal(rawEnvelope, envelope); err != nil {
+		return nil, zerrors.ThrowInternal(err, "V2-Vah1u", "Errors.Internal")
+	}
+	delete(payload, PersonalDataField)
+
+	plain, err := decrypt(ctx, keys, event.Aggregate(), envelope)
+	switch {
+	case errors.Is(err, ErrPersonalDataShredded):
+		payload[PersonalDataShreddedField] = json.RawMessage("true")
+	case err != nil:
+		return nil, err
+	default:
+		personalData := make(map[string]json.RawMessage)
+		if err = json.Unmarshal(plain, &personalData); err != nil {
+			return nil, zerrors.ThrowInternal(err, "V2-eiM0a", "Errors.Internal")
+		}
+		maps.Copy(payload, personalData)
+	}
+
+	decrypted := BaseEventFromRepo(event)
+	decrypted.Data, err = json.Marshal(payload)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "V2-Ai3ee", "Errors.Internal")
+	}
+	return decrypted, nil
+}
+
+// decrypt returns [ErrPersonalDataShredded] if the key of the envelope was destroyed,
+// even if a new key was created for the aggregate afterward.
+func decrypt(ctx context.Context, keys *personalDataKeys, aggregate *Aggregate, envelope *personalDataEnvelope) ([]byte, error) {
+	key, err := keys.stored(ctx, aggregate)
+	if err != nil {
+		return nil, err
+	}
+	if key.ID != envelope.KeyID {
+		return nil, ErrPersonalDataShredded
+	}
+	return keys.crypter.Decrypt(key, envelope.Data)
+}
@@ -0,0 +1,373 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	new_db "github.com/zitadel/zitadel/backend/v3/storage/database"
+	"github.com/zitadel/zitadel/internal/api/authz"
+)
+
+// testCrypter implements [PersonalDataCrypter] by reversing the data.
+// The stored keys are managed by the [personalDataTestPusher].
+type testCrypter struct {
+	keys    map[string]*PersonalDataKey
+	created int
+	loaded  int
+	// beforeLoad is called before the keys are loaded, e.g. to check if rows are still open
+	beforeLoad func()
+}
+
+func (c *testCrypter) Keys(_ context.Context, aggregates []*Aggregate) ([]*PersonalDataKey, error) {
+	if c.beforeLoad != nil {
+		c.beforeLoad()
+	}
+	c.loaded++
+	keys := make([]*PersonalDataKey, len(aggregates))
+	for i, aggregate := range aggregates {
+		keys[i] = c.keys[aggregate.ID]
+	}
+	return keys, nil
+}
+
+func (c *testCrypter) NewKey(context.Context, *Aggregate) (*PersonalDataKey, error) {
+	c.created++
+	id := "key-" + strconv.Itoa(c.created)
+	return &PersonalDataKey{ID: id, Value: id, Crypted: []byte(id)}, nil
+}
+
+func (c *testCrypter) Encrypt(_ *PersonalDataKey, data []byte) ([]byte, error) {
+	crypted := slices.Clone(data)
+	slices.Reverse(crypted)
+	return crypted, nil
+}
+
+func (c *testCrypter) Decrypt(_ *PersonalDataKey, crypted []byte) ([]byte, error) {
+	data := slices.Clone(crypted)
+	slices.Reverse(data)
+	return data, nil
+}
+
+// personalDataTestPusher handles the keys like the pusher does in the push transaction
+type personalDataTestPusher struct {
+	crypter *testCrypter
+	// beforePush is called before each push, e.g. to simulate concurrent pushes
+	beforePush func()
+	pushes     int
+	// stored are the payloads of the pushed commands
+	stored [][]byte
+}
+
+func (p *personalDataTestPusher) Health(context.Context) error {
+	return nil
+}
+
+func (p *personalDataTestPusher) Push(_ context.Context, _ new_db.QueryExecutor, commands ...Command) ([]Event, error) {
+	p.pushes++
+	if p.beforePush != nil {
+		p.beforePush()
+	}
+	events := make([]Event, len(commands))
+	for i, cmd := range commands {
+		if keyCmd, ok := cmd.(PersonalDataKeyCommand); ok {
+			key := keyCmd.PersonalDataKey()
+			stored, exists := p.crypter.keys[cmd.Aggregate().ID]
+			switch {
+			case key.Crypted != nil && exists && stored.ID != key.ID,
+				key.Crypted == nil && (!exists || stored.ID != key.ID):
+				return nil, ErrPersonalDataKeyChanged
+			case key.Crypted != nil:
+				p.crypter.keys[cmd.Aggregate().ID] = &PersonalDataKey{ID: key.ID, Value: key.Value}
+			}
+		}
+		if ShouldShredPersonalData(cmd) {
+			delete(p.crypter.keys, cmd.Aggregate().ID)
+		}
+		data, err := EventData(cmd)
+		if err != nil {
+			return nil, err
+		}
+		p.stored = append(p.stored, data)
+		events[i] = &BaseEvent{
+			Agg:       cmd.Aggregate(),
+			EventType: cmd.Type(),
+			Data:      data,
+		}
+	}
+	return events, nil
+}
+
+// personalDataTestQuerier returns the events like the querier does while the rows are open
+type personalDataTestQuerier struct {
+	testQuerier
+	scanning bool
+}
+
+func (q *personalDataTestQuerier) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, reduce Reducer) error {
+	q.scanning = true
+	defer func() { q.scanning = false }()
+	return q.testQuerier.FilterToReducer(ctx, searchQuery, reduce)
+}
+
+type personalDataTestPayload struct {
+	FirstName string `json:"firstName,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Gender    int    `json:"gender,omitempty"`
+}
+
+// personalDataTestEvent implements [PersonalDataCommand]
+type personalDataTestEvent struct {
+	*testEvent
+}
+
+func (e *personalDataTestEvent) PersonalDataFields() []string {
+	return []string{"firstName", "email"}
+}
+
+func (e *personalDataTestEvent) EnforceResourceOwner() {}
+
+// shredTestEvent implements [PersonalDataShredder]
+type shredTestEvent struct {
+	*personalDataTestEvent
+}
+
+func (e *shredTestEvent) ShredPersonalData() {}
+
+func newPersonalDataTestEvent(payload *personalDataTestPayload) *personalDataTestEvent {
+	event := newTestEvent("1", "", func() interface{} { return payload }, false)
+	// other tests register interceptors for the type of the test event
+	event.EventType = "test.personal_data"
+	return &personalDataTestEvent{
+		testEvent: event,
+	}
+}
+
+func Test_personalData(t *testing.T) {
+	ctx := authz.NewMockContext("instanceID", "resourceOwner", "editorUser")
+	payload := &personalDataTestPayload{
+		FirstName: "first",
+		Email:     "mail@example.com",
+		Gender:    1,
+	}
+	added := newPersonalDataTestEvent(payload)
+	crypter := &testCrypter{keys: make(map[string]*PersonalDataKey)}
+	pusher := &personalDataTestPusher{crypter: crypter}
+	es := &Eventstore{
+		pusher:                 pusher,
+		personalData:           crypter,
+		personalDataEncryption: true,
+	}
+
+	// all commands of the aggregate use the same new key, which is stored by the pusher
+	cmds, err := es.encryptPersonalData(ctx, es.newPersonalDataKeys(), []Command{added, newPersonalDataTestEvent(payload)})
+	require.NoError(t, err)
+	require.Len(t, cmds, 2)
+	keyCmd, ok := cmds[0].(PersonalDataKeyCommand)
+	require.True(t, ok)
+	assert.Equal(t, &PersonalDataKey{ID: "key-1", Value: "key-1", Crypted: []byte("key-1")}, keyCmd.PersonalDataKey())
+	assert.Same(t, keyCmd.PersonalDataKey(), cmds[1].(PersonalDataKeyCommand).PersonalDataKey())
+	assert.True(t, ShouldEnforceResourceOwner(cmds[0]))
+	plainCmd, ok := cmds[0].(PlainPayloadCommand)
+	require.True(t, ok)
+	assert.Equal(t, payload, plainCmd.PlainPayload())
+	crypter.created = 0
+
+	pushed, err := es.Push(ctx, added)
+	require.NoError(t, err)
+	require.Len(t, pushed, 1)
+	assert.JSONEq(t, `{"firstName":"first","email":"mail@example.com","gender":1}`, string(pushed[0].DataAsBytes()))
+	require.Contains(t, crypter.keys, "1")
+
+	stored := pusher.stored[0]
+	storedPayload := make(map[string]json.RawMessage)
+	require.NoError(t, json.Unmarshal(stored, &storedPayload))
+	assert.NotContains(t, storedPayload, "firstName")
+	assert.NotContains(t, storedPayload, "email")
+	assert.Contains(t, storedPayload, PersonalDataField)
+	assert.JSONEq(t, "1", string(storedPayload["gender"]))
+
+	event := &BaseEvent{
+		Agg:       added.Aggregate(),
+		EventType: added.Type(),
+		Data:      stored,
+	}
+	keys := es.newPersonalDataKeys()
+	decrypted, err := decryptPersonalData(ctx, keys, event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"firstName":"first","email":"mail@example.com","gender":1}`, string(decrypted.DataAsBytes()))
+	// the key is loaded once per filter
+	loaded := crypter.loaded
+	_, err = decryptPersonalData(ctx, keys, event)
+	require.NoError(t, err)
+	assert.Equal(t, loaded, crypter.loaded)
+
+	removed := &shredTestEvent{
+		personalDataTestEvent: newPersonalDataTestEvent(&personalDataTestPayload{FirstName: "first"}),
+	}
+	pushed, err = es.Push(ctx, removed)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"personalDataShredded":true}`, string(pushed[0].DataAsBytes()))
+	assert.NotContains(t, crypter.keys, "1")
+
+	shredded, err := decryptPersonalData(ctx, es.newPersonalDataKeys(), event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"gender":1,"personalDataShredded":true}`, string(shredded.DataAsBytes()))
+	got := new(personalDataTestPayload)
+	require.NoError(t, shredded.Unmarshal(got))
+	assert.Equal(t, &personalDataTestPayload{Gender: 1}, got)
+
+	// a new key must not decrypt the data of the destroyed one
+	_, err = es.Push(ctx, added)
+	require.NoError(t, err)
+	assert.Equal(t, 2, crypter.created)
+	shredded, err = decryptPersonalData(ctx, es.newPersonalDataKeys(), event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"gender":1,"personalDataShredded":true}`, string(shredded.DataAsBytes()))
+}
+
+func Test_personalData_filter(t *testing.T) {
+	ctx := authz.NewMockContext("instanceID", "resourceOwner", "editorUser")
+	crypter := &testCrypter{keys: make(map[string]*PersonalDataKey)}
+	pusher := &personalDataTestPusher{crypter: crypter}
+	es := &Eventstore{
+		pusher:                 pusher,
+		personalData:           crypter,
+		personalDataEncryption: true,
+	}
+	first := newPersonalDataTestEvent(&personalDataTestPayload{FirstName: "first"})
+	second := newPersonalDataTestEvent(&personalDataTestPayload{FirstName: "second"})
+	second.Agg.ID = "2"
+	_, err := es.Push(ctx, first, second)
+	require.NoError(t, err)
+
+	querier := new(personalDataTestQuerier)
+	for i, cmd := range []Command{first, second} {
+		querier.events = append(querier.events, &BaseEvent{
+			Agg:       cmd.Aggregate(),
+			EventType: cmd.Type(),
+			Data:      pusher.stored[i],
+		})
+	}
+	es.querier = querier
+	crypter.loaded = 0
+	crypter.beforeLoad = func() {
+		assert.False(t, querier.scanning, "keys must not be loaded while the rows of the filter are open")
+	}
+
+	events, err := es.Filter(ctx, NewSearchQueryBuilder(ColumnsEvent).AddQuery().AggregateTypes("test.aggregate").Builder())
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.JSONEq(t, `{"firstName":"first"}`, string(events[0].DataAsBytes()))
+	assert.JSONEq(t, `{"firstName":"second"}`, string(events[1].DataAsBytes()))
+	// the keys of all aggregates are loaded in a single query
+	assert.Equal(t, 1, crypter.loaded)
+}
+
+func Test_personalData_keyChanged(t *testing.T) {
+	ctx := authz.NewMockContext("instanceID", "resourceOwner", "editorUser")
+	added := newPersonalDataTestEvent(&personalDataTestPayload{FirstName: "first"})
+	tests := []struct {
+		name       string
+		keys       map[string]*PersonalDataKey
+		beforePush func(crypter *testCrypter) func()
+		wantKeyID  string
+	}{
+		{
+			name: "created concurrently",
+			beforePush: func(crypter *testCrypter) func() {
+				return func() {
+					if _, ok := crypter.keys["1"]; !ok {
+						crypter.keys["1"] = &PersonalDataKey{ID: "concurrent", Value: "concurrent"}
+					}
+				}
+			},
+			wantKeyID: "concurrent",
+		},
+		{
+			name: "destroyed concurrently",
+			keys: map[string]*PersonalDataKey{"1": {ID: "destroyed", Value: "destroyed"}},
+			beforePush: func(crypter *testCrypter) func() {
+				return func() {
+					if key, ok := crypter.keys["1"]; ok && key.ID == "destroyed" {
+						delete(crypter.keys, "1")
+					}
+				}
+			},
+			wantKeyID: "key-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crypter := &testCrypter{keys: make(map[string]*PersonalDataKey)}
+			for id, key := range tt.keys {
+				crypter.keys[id] = key
+			}
+			pusher := &personalDataTestPusher{crypter: crypter, beforePush: tt.beforePush(crypter)}
+			es := &Eventstore{
+				pusher:                 pusher,
+				personalData:           crypter,
+				personalDataEncryption: true,
+				maxRetries:             1,
+			}
+
+			pushed, err := es.Push(ctx, added)
+			require.NoError(t, err)
+			assert.Equal(t, 2, pusher.pushes)
+			assert.JSONEq(t, `{"firstName":"first"}`, string(pushed[0].DataAsBytes()))
+			assert.Equal(t, tt.wantKeyID, crypter.keys["1"].ID)
+		})
+	}
+}
+
+func Test_personalData_withoutPersonalData(t *testing.T) {
+	ctx := authz.NewMockContext("instanceID", "resourceOwner", "editorUser")
+	plain := newTestEvent("1", "", func() interface{} { return &personalDataTestPayload{FirstName: "first"} }, false)
+	withoutFields := newPersonalDataTestEvent(&personalDataTestPayload{Gender: 1})
+	tests := []struct {
+		name string
+		es   *Eventstore
+		cmd  Command
+	}{
+		{
+			name: "no crypter",
+			es:   &Eventstore{},
+			cmd:  withoutFields,
+		},
+		{
+			name: "encryption disabled",
+			es: &Eventstore{
+				personalData: &testCrypter{keys: make(map[string]*PersonalDataKey)},
+			},
+			cmd: newPersonalDataTestEvent(&personalDataTestPayload{FirstName: "first"}),
+		},
+		{
+			name: "no personal data command",
+			es: &Eventstore{
+				personalData:           &testCrypter{keys: make(map[string]*PersonalDataKey)},
+				personalDataEncryption: true,
+			},
+			cmd: plain,
+		},
+		{
+			name: "no personal data in payload",
+			es: &Eventstore{
+				personalData:           &testCrypter{keys: make(map[string]*PersonalDataKey)},
+				personalDataEncryption: true,
+			},
+			cmd: withoutFields,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmds, err := tt.es.encryptPersonalData(ctx, tt.es.newPersonalDataKeys(), []Command{tt.cmd})
+			require.NoError(t, err)
+			assert.Same(t, tt.cmd, cmds[0])
+		})
+	}
+}
@@ -45,6 +45,9 @@ type event struct {
 	createdAt time.Time
 	sequence  uint64
 	position  decimal.Decimal
+	// plainPayload is set if the stored payload differs from the payload of the command,
+	// e.g. if personal data was encrypted
+	plainPayload Payload
 }
 
 // TODO: remove on v3
@@ -110,11 +113,35 @@ func commandToEvent(cmd eventstore.Command) (_ eventstore.Event, err error) {
 		EnforceOwner:  eventstore.ShouldEnforceResourceOwner(cmd),
 	}
 
+	var plainPayload Payload
+	if plainCmd, ok := cmd.(eventstore.PlainPayloadCommand); ok && plainCmd.PlainPayload() != nil {
+		plainPayload, err = json.Marshal(plainCmd.PlainPayload())
+		if err != nil {
+			logging.WithError(err).Warn("marshal plain payload failed")
+			return nil, zerrors.ThrowInternal(err, "V3-aeW4o", "Errors.Internal")
+		}
+	}
+
 	return &event{
-		command: command,
+		command:      command,
+		plainPayload: plainPayload,
 	}, nil
 }
 
+// plainEvent returns a copy of the event containing the payload before it was transformed for storage,
+// e.g. executions receive the personal data in plain text
+func plainEvent(evt eventstore.Event) eventstore.Event {
+	e, ok := evt.(*event)
+	if !ok || e.plainPayload == nil {
+		return evt
+	}
+	cmd := *e.command
+	cmd.Payload = e.plainPayload
+	plain := *e
+	plain.command = &cmd
+	return &plain
+}
+
 // CreationDate implements [eventstore.Event]
 func (e *event) CreationDate() time.Time {
 	return e.CreatedAt()
@@ -122,6 +122,33 @@ func Test_commandToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "plain payload",
+			args: args{
+				command: &plainPayloadMockCommand{
+					mockCommand: &mockCommand{
+						aggregate: mockAggregate("V3-Red9I"),
+						payload:   json.RawMessage(`{"personalData":{}}`),
+					},
+					plainPayload: payload,
+				},
+			},
+			want: want{
+				event: &event{
+					command: &command{
+						InstanceID:    "instance",
+						AggregateType: "type",
+						AggregateID:   "V3-Red9I",
+						Owner:         "ro",
+						Creator:       "creator",
+						Revision:      1,
+						CommandType:   "event.type",
+						Payload:       Payload(`{"personalData":{}}`),
+					},
+					plainPayload: payloadMarshalled,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		if tt.want.err == nil {
@@ -554,3 +581,35 @@ func assertCommand(t *testing.T, want, got *command) {
 	assert.Equal(t, want.Revision, got.Revision)
 	assert.Equal(t, want.EnforceOwner, got.EnforceOwner)
 }
+
+func Test_plainEvent(t *testing.T) {
+	stored := mockEvent(mockAggregate("V3-Red9I"), 1, Payload(`{"personalData":{}}`)).(*event)
+	stored.plainPayload = Payload(`{"firstName":"first"}`)
+
+	tests := []struct {
+		name        string
+		event       eventstore.Event
+		wantPayload []byte
+	}{
+		{
+			name:        "without plain payload",
+			event:       mockEvent(mockAggregate("V3-Red9I"), 1, Payload(`{"ID":"test"}`)),
+			wantPayload: []byte(`{"ID":"test"}`),
+		},
+		{
+			name:        "with plain payload",
+			event:       stored,
+			wantPayload: []byte(`{"firstName":"first"}`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := plainEvent(tt.event)
+			assert.Equal(t, tt.wantPayload, got.DataAsBytes())
+			assert.Equal(t, tt.event.Aggregate(), got.Aggregate())
+			assert.Equal(t, tt.event.Sequence(), got.Sequence())
+		})
+	}
+	// the stored event must not be changed
+	assert.Equal(t, Payload(`{"personalData":{}}`), stored.command.Payload)
+}
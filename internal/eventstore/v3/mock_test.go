@@ -6,6 +6,7 @@ import (
 
 var _ eventstore.Command = (*mockCommand)(nil)
 var _ eventstore.EnforceResourceOwnerCommand = (*enforcedMockCommand)(nil)
+var _ eventstore.PlainPayloadCommand = (*plainPayloadMockCommand)(nil)
 
 type mockCommand struct {
 	aggregate   *eventstore.Aggregate
@@ -19,6 +20,16 @@ type enforcedMockCommand struct {
 
 func (*enforcedMockCommand) EnforceResourceOwner() {}
 
+type plainPayloadMockCommand struct {
+	*mockCommand
+	plainPayload any
+}
+
+// PlainPayload implements [eventstore.PlainPayloadCommand]
+func (m *plainPayloadMockCommand) PlainPayload() any {
+	return m.plainPayload
+}
+
 // Aggregate implements [eventstore.Command]
 func (m *mockCommand) Aggregate() *eventstore.Aggregate {
 	return m.aggregate
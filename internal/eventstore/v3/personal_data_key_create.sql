@@ -0,0 +1,13 @@
+INSERT INTO eventstore.personal_data_keys (
+    instance_id
+    , aggregate_type
+    , aggregate_id
+    , key_id
+    , key
+) VALUES (
+    $1
+    , $2
+    , $3
+    , $4
+    , $5
+)
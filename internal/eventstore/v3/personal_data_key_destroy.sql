@@ -0,0 +1,5 @@
+DELETE FROM eventstore.personal_data_keys
+WHERE
+    instance_id = $1
+    AND aggregate_type = $2
+    AND aggregate_id = $3
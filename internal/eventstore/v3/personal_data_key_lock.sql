@@ -0,0 +1,10 @@
+-- locks the key until the end of the push transaction, so it can't be destroyed concurrently
+SELECT
+    key_id
+FROM
+    eventstore.personal_data_keys
+WHERE
+    instance_id = $1
+    AND aggregate_type = $2
+    AND aggregate_id = $3
+FOR SHARE
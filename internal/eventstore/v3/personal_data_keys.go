@@ -0,0 +1,106 @@
+package eventstore
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	//go:embed personal_data_key_create.sql
+	createPersonalDataKeyStmt string
+	//go:embed personal_data_key_lock.sql
+	lockPersonalDataKeyStmt string
+	//go:embed personal_data_key_destroy.sql
+	destroyPersonalDataKeyStmt string
+)
+
+type personalDataAggregate struct {
+	instanceID    string
+	aggregateType string
+	aggregateID   string
+}
+
+func toPersonalDataAggregate(ctx context.Context, aggregate *eventstore.Aggregate) personalDataAggregate {
+	instanceID := aggregate.InstanceID
+	if instanceID == "" {
+		instanceID = authz.GetInstance(ctx).InstanceID()
+	}
+	return personalDataAggregate{
+		instanceID:    instanceID,
+		aggregateType: string(aggregate.Type),
+		aggregateID:   aggregate.ID,
+	}
+}
+
+// handlePersonalDataKeys stores the keys created to encrypt the personal data of the commands
+// and locks the existing ones until the end of the transaction.
+// Afterward, the keys of the aggregates whose personal data is shredded are destroyed.
+// [eventstore.ErrPersonalDataKeyChanged] is returned if a key was destroyed or created concurrently.
+func handlePersonalDataKeys(ctx context.Context, tx database.Transaction, commands []eventstore.Command) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	// the keys are handled in the order of the commands, so concurrent pushes lock them in the same order
+	handled := make(map[personalDataAggregate]bool)
+	destroy := make([]personalDataAggregate, 0)
+	for _, command := range commands {
+		aggregate := toPersonalDataAggregate(ctx, command.Aggregate())
+		if eventstore.ShouldShredPersonalData(command) {
+			destroy = append(destroy, aggregate)
+		}
+		keyCmd, ok := command.(eventstore.PersonalDataKeyCommand)
+		if !ok || keyCmd.PersonalDataKey() == nil || handled[aggregate] {
+			continue
+		}
+		handled[aggregate] = true
+		if keyCmd.PersonalDataKey().Crypted != nil {
+			err = createPersonalDataKey(ctx, tx, aggregate, keyCmd.PersonalDataKey())
+		} else {
+			err = lockPersonalDataKey(ctx, tx, aggregate, keyCmd.PersonalDataKey())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, aggregate := range destroy {
+		if _, err = tx.Exec(ctx, destroyPersonalDataKeyStmt, aggregate.instanceID, aggregate.aggregateType, aggregate.aggregateID); err != nil {
+			logging.WithError(err).Warn("destroy personal data key failed")
+			return zerrors.ThrowInternal(err, "V3-Ahl3o", "Errors.Internal")
+		}
+	}
+	return nil
+}
+
+func createPersonalDataKey(ctx context.Context, tx database.Transaction, aggregate personalDataAggregate, key *eventstore.PersonalDataKey) error {
+	_, err := tx.Exec(ctx, createPersonalDataKeyStmt, aggregate.instanceID, aggregate.aggregateType, aggregate.aggregateID, key.ID, key.Crypted)
+	if errors.Is(err, new(database.UniqueError)) {
+		return zerrors.ThrowInternal(eventstore.ErrPersonalDataKeyChanged, "V3-Ohk4a", "Errors.Internal")
+	}
+	if err != nil {
+		logging.WithError(err).Warn("create personal data key failed")
+		return zerrors.ThrowInternal(err, "V3-eeL6i", "Errors.Internal")
+	}
+	return nil
+}
+
+func lockPersonalDataKey(ctx context.Context, tx database.Transaction, aggregate personalDataAggregate, key *eventstore.PersonalDataKey) error {
+	var keyID string
+	err := tx.QueryRow(ctx, lockPersonalDataKeyStmt, aggregate.instanceID, aggregate.aggregateType, aggregate.aggregateID).Scan(&keyID)
+	if errors.Is(err, new(database.NoRowFoundError)) || err == nil && keyID != key.ID {
+		return zerrors.ThrowInternal(eventstore.ErrPersonalDataKeyChanged, "V3-ooN0e", "Errors.Internal")
+	}
+	if err != nil {
+		logging.WithError(err).Warn("lock personal data key failed")
+		return zerrors.ThrowInternal(err, "V3-Quu3e", "Errors.Internal")
+	}
+	return nil
+}
@@ -57,6 +57,9 @@ func (es *Eventstore) writeCommands(ctx context.Context, client database.QueryEx
 	if err = handleUniqueConstraints(ctx, tx, commands); err != nil {
 		return nil, err
 	}
+	if err = handlePersonalDataKeys(ctx, tx, commands); err != nil {
+		return nil, err
+	}
 
 	err = es.handleFieldCommands(ctx, tx, commands)
 	if err != nil {
@@ -146,7 +149,7 @@ func eventsToJobArgs(ctx context.Context, events []eventstore.Event) ([]river.Jo
 		if !ok {
 			continue
 		}
-		req, err := exec_repo.NewRequest(event, targets)
+		req, err := exec_repo.NewRequest(plainEvent(event), targets)
 		if err != nil {
 			return nil, err
 		}
@@ -60,6 +60,9 @@ func (es *Eventstore) pushWithoutFunc(ctx context.Context, client database.Query
 	if err = handleUniqueConstraints(ctx, tx, commands); err != nil {
 		return nil, err
 	}
+	if err = handlePersonalDataKeys(ctx, tx, commands); err != nil {
+		return nil, err
+	}
 
 	err = es.handleFieldCommands(ctx, tx, commands)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/backend/v3/instrumentation/metrics"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/notification/channels/email"
 	"github.com/zitadel/zitadel/internal/notification/channels/set"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
@@ -79,8 +80,8 @@ func (c *channels) Email(ctx context.Context) (*senders.Chain, *email.Config, er
 	return chain, emailCfg, err
 }
 
-func (c *channels) SMS(ctx context.Context) (*senders.Chain, *sms.Config, error) {
-	smsCfg, err := c.q.GetActiveSMSConfig(ctx)
+func (c *channels) SMS(ctx context.Context, channel domain.PhoneChannel) (*senders.Chain, *sms.Config, error) {
+	smsCfg, err := c.q.GetActiveSMSConfig(ctx, channel)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,14 +1,20 @@
 package sms
 
 import (
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/voice"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/channels/whatsapp"
 )
 
 type Config struct {
 	ProviderConfig *Provider
+	Channel        domain.PhoneChannel
 	TwilioConfig   *twilio.Config
 	WebhookConfig  *webhook.Config
+	WhatsAppConfig *whatsapp.Config
+	VoiceConfig    *voice.Config
 }
 
 type Provider struct {
@@ -0,0 +1,91 @@
+package voice
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+
+	"github.com/twilio/twilio-go"
+	twilioClient "github.com/twilio/twilio-go/client"
+	openapi "github.com/twilio/twilio-go/rest/api/v2010"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// InitChannel reads the content of a message to the recipient in a phone call placed over Twilio.
+func InitChannel(config Config) channels.NotificationChannel {
+	client := twilio.NewRestClientWithParams(twilio.ClientParams{Username: config.SID, Password: config.Token})
+	logging.Debug("successfully initialized voice channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		msg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "VOICE-ohK3u", "message is not SMS")
+		}
+		content, err := msg.GetContent()
+		if err != nil {
+			return err
+		}
+		twiml, err := sayTwiML(spellCode(content, msg.Code), msg.Language)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "VOICE-Iesh4", "could not create call instructions")
+		}
+		params := &openapi.CreateCallParams{}
+		params.SetTo(msg.RecipientPhoneNumber)
+		params.SetFrom(msg.SenderPhoneNumber)
+		params.SetTwiml(twiml)
+		call, err := client.Api.CreateCall(params)
+
+		// the same as for sms, client errors (4xx) won't succeed on a retry
+		var twilioErr *twilioClient.TwilioRestError
+		if errors.As(err, &twilioErr) && twilioErr.Status >= 400 && twilioErr.Status < 500 {
+			logging.WithFields(
+				"error", twilioErr.Message,
+				"status", twilioErr.Status,
+				"code", twilioErr.Code,
+				"instanceID", msg.InstanceID,
+				"jobID", msg.JobID,
+				"userID", msg.UserID,
+			).Warn("twilio create call error")
+			return channels.NewCancelError(twilioErr)
+		}
+		if err != nil {
+			return zerrors.ThrowInternal(err, "VOICE-ieX7a", "could not place call")
+		}
+		logging.WithFields("call_sid", call.Sid, "status", call.Status).Debug("call placed")
+		return nil
+	})
+}
+
+// spellCode separates the characters of the code in the content,
+// so they are read one by one instead of as a number.
+func spellCode(content, code string) string {
+	if code == "" {
+		return content
+	}
+	return strings.ReplaceAll(content, code, strings.Join(strings.Split(code, ""), ", "))
+}
+
+type response struct {
+	XMLName xml.Name `xml:"Response"`
+	Says    []say
+}
+
+type say struct {
+	XMLName  xml.Name `xml:"Say"`
+	Language string   `xml:"language,attr,omitempty"`
+	Text     string   `xml:",chardata"`
+}
+
+// sayTwiML reads the text twice, giving the recipient the chance to note a code.
+func sayTwiML(text, language string) (string, error) {
+	s := say{Language: language, Text: text}
+	twiml, err := xml.Marshal(response{Says: []say{s, s}})
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(twiml), nil
+}
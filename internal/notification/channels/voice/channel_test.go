@@ -0,0 +1,44 @@
+package voice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_spellCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		code    string
+		want    string
+	}{
+		{
+			name:    "no code",
+			content: "Your account was locked.",
+			want:    "Your account was locked.",
+		},
+		{
+			name:    "code spelled",
+			content: "Your code is 123456.",
+			code:    "123456",
+			want:    "Your code is 1, 2, 3, 4, 5, 6.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, spellCode(tt.content, tt.code))
+		})
+	}
+}
+
+func Test_sayTwiML(t *testing.T) {
+	got, err := sayTwiML("Your code is 1, 2 & 3.", "de")
+	require.NoError(t, err)
+	assert.Equal(t,
+		`<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+			`<Response><Say language="de">Your code is 1, 2 &amp; 3.</Say><Say language="de">Your code is 1, 2 &amp; 3.</Say></Response>`,
+		got,
+	)
+}
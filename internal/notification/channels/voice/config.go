@@ -0,0 +1,11 @@
+package voice
+
+type Config struct {
+	SID          string
+	Token        string
+	SenderNumber string
+}
+
+func (c *Config) IsValid() bool {
+	return c.SID != "" && c.Token != "" && c.SenderNumber != ""
+}
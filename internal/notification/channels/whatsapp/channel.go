@@ -0,0 +1,139 @@
+package whatsapp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// InitChannel sends the code of a message using an approved authentication template of the WhatsApp Business Cloud API.
+// Free text can't be sent to users who didn't contact the business before, so messages without a code are not delivered.
+func InitChannel(ctx context.Context, config Config) channels.NotificationChannel {
+	client := config.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	logging.Debug("successfully initialized whatsapp channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		msg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "WHATS-Ob3ie", "message is not SMS")
+		}
+		if msg.Code == "" {
+			return channels.NewCancelError(zerrors.ThrowPreconditionFailed(nil, "WHATS-ieV4o", "Errors.Notification.WhatsApp.CodeMissing"))
+		}
+		payload, err := json.Marshal(templateMessage(msg.RecipientPhoneNumber, msg.Code, config.TemplateName, templateLanguage(config.TemplateLanguage, msg.Language)))
+		if err != nil {
+			return zerrors.ThrowInternal(err, "WHATS-Ree3a", "could not marshal message")
+		}
+
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, config.messagesURL(), bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+config.AccessToken)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "WHATS-aiP5e", "could not send message")
+		}
+		if err = resp.Body.Close(); err != nil {
+			return err
+		}
+		// client errors (e.g. the number has no WhatsApp account) won't succeed on a retry
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+			logging.WithFields(
+				"status", resp.StatusCode,
+				"instanceID", msg.InstanceID,
+				"jobID", msg.JobID,
+				"userID", msg.UserID,
+			).Warn("whatsapp send message error")
+			return channels.NewCancelError(fmt.Errorf("whatsapp api returned %s", resp.Status))
+		}
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowUnknown(fmt.Errorf("whatsapp api returned %s", resp.Status), "WHATS-Eew8u", "could not send message")
+		}
+		logging.WithFields("instanceID", msg.InstanceID, "jobID", msg.JobID).Debug("whatsapp message sent")
+		return nil
+	})
+}
+
+// templateLanguage returns the configured language the template is approved in,
+// or the language of the message if none is configured.
+func templateLanguage(configured, messageLanguage string) string {
+	if configured != "" {
+		return configured
+	}
+	if messageLanguage != "" {
+		return messageLanguage
+	}
+	return "en"
+}
+
+type message struct {
+	MessagingProduct string   `json:"messaging_product"`
+	To               string   `json:"to"`
+	Type             string   `json:"type"`
+	Template         template `json:"template"`
+}
+
+type template struct {
+	Name       string      `json:"name"`
+	Language   language    `json:"language"`
+	Components []component `json:"components"`
+}
+
+type language struct {
+	Code string `json:"code"`
+}
+
+type component struct {
+	Type       string      `json:"type"`
+	SubType    string      `json:"sub_type,omitempty"`
+	Index      string      `json:"index,omitempty"`
+	Parameters []parameter `json:"parameters"`
+}
+
+type parameter struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// templateMessage builds an authentication template message, which carries the code in the body
+// and in the copy code button.
+func templateMessage(recipient, code, templateName, templateLanguage string) *message {
+	return &message{
+		MessagingProduct: "whatsapp",
+		To:               recipient,
+		Type:             "template",
+		Template: template{
+			Name:     templateName,
+			Language: language{Code: templateLanguage},
+			Components: []component{
+				{
+					Type:       "body",
+					Parameters: []parameter{{Type: "text", Text: code}},
+				},
+				{
+					Type:       "button",
+					SubType:    "url",
+					Index:      "0",
+					Parameters: []parameter{{Type: "text", Text: code}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package whatsapp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+)
+
+func TestInitChannel(t *testing.T) {
+	tests := []struct {
+		name       string
+		code       string
+		status     int
+		wantBody   string
+		wantErr    bool
+		wantCancel bool
+	}{
+		{
+			name:       "no code, cancel",
+			wantErr:    true,
+			wantCancel: true,
+		},
+		{
+			name:     "sent",
+			code:     "123456",
+			status:   http.StatusOK,
+			wantBody: `{"messaging_product":"whatsapp","to":"+41791234567","type":"template","template":{"name":"otp","language":{"code":"de"},"components":[{"type":"body","parameters":[{"type":"text","text":"123456"}]},{"type":"button","sub_type":"url","index":"0","parameters":[{"type":"text","text":"123456"}]}]}}`,
+		},
+		{
+			name:       "client error, cancel",
+			code:       "123456",
+			status:     http.StatusBadRequest,
+			wantErr:    true,
+			wantCancel: true,
+		},
+		{
+			name:    "server error, retry",
+			code:    "123456",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/phone-id/messages", r.URL.Path)
+				assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				if tt.wantBody != "" {
+					assert.JSONEq(t, tt.wantBody, string(body))
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			channel := InitChannel(context.Background(), Config{
+				PhoneNumberID: "phone-id",
+				AccessToken:   "token",
+				TemplateName:  "otp",
+				APIURL:        server.URL,
+				Client:        server.Client(),
+			})
+			err := channel.HandleMessage(&messages.SMS{
+				RecipientPhoneNumber: "+41791234567",
+				Content:              "Your code is 123456",
+				Code:                 tt.code,
+				Language:             "de",
+			})
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			var cancelErr *channels.CancelError
+			assert.Equal(t, tt.wantCancel, errors.As(err, &cancelErr))
+		})
+	}
+}
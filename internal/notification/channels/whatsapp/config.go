@@ -0,0 +1,27 @@
+package whatsapp
+
+import "net/http"
+
+const defaultAPIURL = "https://graph.facebook.com/v20.0"
+
+type Config struct {
+	PhoneNumberID    string
+	AccessToken      string
+	TemplateName     string
+	TemplateLanguage string
+	// APIURL overrides the Meta Graph API base url, used in tests
+	APIURL string
+	Client *http.Client
+}
+
+func (c *Config) IsValid() bool {
+	return c.PhoneNumberID != "" && c.AccessToken != "" && c.TemplateName != ""
+}
+
+func (c *Config) messagesURL() string {
+	apiURL := c.APIURL
+	if apiURL == "" {
+		apiURL = defaultAPIURL
+	}
+	return apiURL + "/" + c.PhoneNumberID + "/messages"
+}
@@ -3,7 +3,9 @@ package handlers
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
 )
 
 type alreadyHandled struct {
@@ -48,3 +50,49 @@ func (n *NotificationQueries) IsAlreadyHandled(ctx context.Context, event events
 	}
 	return already.handled, nil
 }
+
+// previousNewDeviceSignIn checks if a new device was already signed in to the session before the event.
+type previousNewDeviceSignIn struct {
+	event *session.RiskEvaluatedEvent
+
+	found bool
+}
+
+func (p *previousNewDeviceSignIn) Reduce() error {
+	return nil
+}
+
+func (p *previousNewDeviceSignIn) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if e, ok := event.(*session.RiskEvaluatedEvent); ok && e.Outcome != domain.RiskOutcomeDeny {
+			p.found = true
+		}
+	}
+}
+
+func (p *previousNewDeviceSignIn) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(p.event.Aggregate().InstanceID).
+		CreationDateBefore(p.event.CreatedAt()).
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		AggregateIDs(p.event.Aggregate().ID).
+		EventTypes(session.RiskEvaluatedType).
+		EventData(map[string]interface{}{
+			"signals": []domain.RiskSignal{domain.RiskSignalNewDevice},
+		}).
+		Builder()
+}
+
+// HasPreviousNewDeviceSignIn checks if the risk of the session was already evaluated with a new device.
+// As the risk is evaluated on every check of the session, this prevents multiple alerts for the same sign-in.
+func (n *NotificationQueries) HasPreviousNewDeviceSignIn(ctx context.Context, event *session.RiskEvaluatedEvent) (bool, error) {
+	previous := &previousNewDeviceSignIn{
+		event: event,
+	}
+	err := n.es.FilterToQueryReducer(ctx, previous)
+	if err != nil {
+		return false, err
+	}
+	return previous.found, nil
+}
@@ -3,8 +3,10 @@ package handlers
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/milestone"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/repository/quota"
 )
 
@@ -17,12 +19,18 @@ type Commands interface {
 	HumanOTPEmailCodeSent(ctx context.Context, userID, resourceOwner string) error
 	OTPSMSSent(ctx context.Context, sessionID, resourceOwner string, generatorInfo *senders.CodeGeneratorInfo) error
 	OTPEmailSent(ctx context.Context, sessionID, resourceOwner string) error
+	MagicLinkSent(ctx context.Context, sessionID, resourceOwner string) error
 	UserDomainClaimedSent(ctx context.Context, orgID, userID string) error
 	HumanPasswordlessInitCodeSent(ctx context.Context, userID, resourceOwner, codeID string) error
 	PasswordChangeSent(ctx context.Context, orgID, userID string) error
 	HumanPhoneVerificationCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
 	InviteCodeSent(ctx context.Context, orgID, userID string) error
+	SecurityNotificationSent(ctx context.Context, orgID, userID string, triggerType eventstore.EventType) error
+	NewDeviceNotificationSent(ctx context.Context, sessionID, resourceOwner string) error
+	InactivityWarningSent(ctx context.Context, orgID, userID string) error
 	UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error
 	MilestonePushed(ctx context.Context, instanceID string, msType milestone.Type, endpoints []string) error
 	BackChannelLogoutSent(ctx context.Context, id, oidcSessionID, instanceID string) (err error)
+	NotificationDelivered(ctx context.Context, id, resourceOwner string, delivery notification.Delivery) error
+	NotificationDeliveryFailed(ctx context.Context, id, resourceOwner string, delivery notification.Delivery, deliveryErr error, final bool) error
 }
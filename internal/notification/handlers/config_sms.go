@@ -6,15 +6,28 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/voice"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/channels/whatsapp"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-// GetActiveSMSConfig reads the active iam sms provider config
-func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Config, error) {
-	config, err := n.SMSProviderConfigActive(ctx, authz.GetInstance(ctx).InstanceID())
+// GetPhoneChannels returns the channels a phone notification to the user is delivered over, in the order they are tried
+func (n *NotificationQueries) GetPhoneChannels(ctx context.Context, user *query.NotifyUser) ([]domain.PhoneChannel, error) {
+	policy, err := n.NotificationPolicyByOrg(ctx, true, user.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	return domain.PhoneChannelOrder(user.PhoneChannel, policy.PhoneChannels), nil
+}
+
+// GetActiveSMSConfig reads the active iam sms provider config of the channel
+func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context, channel domain.PhoneChannel) (*sms.Config, error) {
+	config, err := n.SMSProviderConfigActive(ctx, authz.GetInstance(ctx).InstanceID(), channel)
 	if err != nil {
 		return nil, err
 	}
@@ -33,6 +46,7 @@ func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Conf
 		}
 		return &sms.Config{
 			ProviderConfig: provider,
+			Channel:        domain.PhoneChannelSMS,
 			TwilioConfig: &twilio.Config{
 				SID:              config.TwilioConfig.SID,
 				Token:            token,
@@ -44,6 +58,7 @@ func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Conf
 	if config.HTTPConfig != nil {
 		return &sms.Config{
 			ProviderConfig: provider,
+			Channel:        domain.PhoneChannelSMS,
 			WebhookConfig: &webhook.Config{
 				CallURL:    config.HTTPConfig.Endpoint,
 				Method:     http.MethodPost,
@@ -53,6 +68,44 @@ func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Conf
 			},
 		}, nil
 	}
+	if config.WhatsAppConfig != nil {
+		if config.WhatsAppConfig.AccessToken == nil {
+			return nil, zerrors.ThrowNotFound(nil, "HANDLER-Oe3ai", "Errors.SMSConfig.NotFound")
+		}
+		accessToken, err := crypto.DecryptString(config.WhatsAppConfig.AccessToken, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			ProviderConfig: provider,
+			Channel:        domain.PhoneChannelWhatsApp,
+			WhatsAppConfig: &whatsapp.Config{
+				PhoneNumberID:    config.WhatsAppConfig.PhoneNumberID,
+				AccessToken:      accessToken,
+				TemplateName:     config.WhatsAppConfig.TemplateName,
+				TemplateLanguage: config.WhatsAppConfig.TemplateLanguage,
+				Client:           n.httpClient,
+			},
+		}, nil
+	}
+	if config.VoiceConfig != nil {
+		if config.VoiceConfig.Token == nil {
+			return nil, zerrors.ThrowNotFound(nil, "HANDLER-ahT4e", "Errors.SMSConfig.NotFound")
+		}
+		token, err := crypto.DecryptString(config.VoiceConfig.Token, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			ProviderConfig: provider,
+			Channel:        domain.PhoneChannelVoice,
+			VoiceConfig: &voice.Config{
+				SID:          config.VoiceConfig.SID,
+				Token:        token,
+				SenderNumber: config.VoiceConfig.SenderNumber,
+			},
+		}, nil
+	}
 
 	return nil, zerrors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMS.Twilio.NotFound")
 }
@@ -10,10 +10,12 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/channels/whatsapp"
 	"github.com/zitadel/zitadel/internal/notification/handlers/mock"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -36,6 +38,7 @@ func TestNotificationQueries_GetActiveSMSConfig(t *testing.T) {
 
 	tt := []struct {
 		name         string
+		channel      domain.PhoneChannel
 		smsConfig    *query.SMSConfig
 		smsConfigErr error
 		setupCrypto  func(m *crypto.MockEncryptionAlgorithm)
@@ -68,6 +71,7 @@ func TestNotificationQueries_GetActiveSMSConfig(t *testing.T) {
 					ID:          "twilio1",
 					Description: "twilio config with token",
 				},
+				Channel: domain.PhoneChannelSMS,
 				TwilioConfig: &twilio.Config{
 					SID:              "sid-1",
 					Token:            token,
@@ -125,6 +129,7 @@ func TestNotificationQueries_GetActiveSMSConfig(t *testing.T) {
 					ID:          "http1",
 					Description: "http webhook config",
 				},
+				Channel: domain.PhoneChannelSMS,
 				WebhookConfig: &webhook.Config{
 					CallURL:    "https://example.com/sms",
 					Method:     http.MethodPost,
@@ -133,6 +138,43 @@ func TestNotificationQueries_GetActiveSMSConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "whatsapp config with access token",
+			channel: domain.PhoneChannelWhatsApp,
+			smsConfig: &query.SMSConfig{
+				ID:          "whatsapp1",
+				Description: "whatsapp config",
+				Channel:     domain.PhoneChannelWhatsApp,
+				WhatsAppConfig: &query.WhatsApp{
+					PhoneNumberID:    "phone-number-id",
+					TemplateName:     "otp",
+					TemplateLanguage: "en",
+					AccessToken: &crypto.CryptoValue{
+						Algorithm: cryptAlg,
+						KeyID:     keyId,
+						Crypted:   encryptedToken,
+					},
+				},
+			},
+			setupCrypto: func(m *crypto.MockEncryptionAlgorithm) {
+				m.EXPECT().Algorithm().Return(cryptAlg)
+				m.EXPECT().DecryptionKeyIDs().Return([]string{keyId})
+				m.EXPECT().DecryptString(gomock.Any(), gomock.Any()).Return(token, nil)
+			},
+			expected: &sms.Config{
+				ProviderConfig: &sms.Provider{
+					ID:          "whatsapp1",
+					Description: "whatsapp config",
+				},
+				Channel: domain.PhoneChannelWhatsApp,
+				WhatsAppConfig: &whatsapp.Config{
+					PhoneNumberID:    "phone-number-id",
+					AccessToken:      token,
+					TemplateName:     "otp",
+					TemplateLanguage: "en",
+				},
+			},
+		},
 		{
 			name: "neither twilio nor http config set",
 			smsConfig: &query.SMSConfig{
@@ -160,10 +202,10 @@ func TestNotificationQueries_GetActiveSMSConfig(t *testing.T) {
 			}
 
 			queryMock := mock.NewMockQueries(ctrl)
-			queryMock.EXPECT().SMSProviderConfigActive(gomock.Any(), instId).Return(tc.smsConfig, tc.smsConfigErr)
+			queryMock.EXPECT().SMSProviderConfigActive(gomock.Any(), instId, tc.channel).Return(tc.smsConfig, tc.smsConfigErr)
 
 			notificationQueries := NewNotificationQueries(queryMock, &eventstore.Eventstore{}, "ext domain", uint16(1234), false, "filepath", nil, nil, cryptAlgMock, nil)
-			cfg, err := notificationQueries.GetActiveSMSConfig(ctx)
+			cfg, err := notificationQueries.GetActiveSMSConfig(ctx, tc.channel)
 
 			assert.ErrorIs(t, err, tc.expectedErr)
 			if tc.expectedErr != nil {
@@ -13,8 +13,10 @@ import (
 	context "context"
 	reflect "reflect"
 
+	eventstore "github.com/zitadel/zitadel/internal/eventstore"
 	senders "github.com/zitadel/zitadel/internal/notification/senders"
 	milestone "github.com/zitadel/zitadel/internal/repository/milestone"
+	notification "github.com/zitadel/zitadel/internal/repository/notification"
 	quota "github.com/zitadel/zitadel/internal/repository/quota"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -141,6 +143,20 @@ func (mr *MockCommandsMockRecorder) HumanPhoneVerificationCodeSent(ctx, orgID, u
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HumanPhoneVerificationCodeSent", reflect.TypeOf((*MockCommands)(nil).HumanPhoneVerificationCodeSent), ctx, orgID, userID, generatorInfo)
 }
 
+// InactivityWarningSent mocks base method.
+func (m *MockCommands) InactivityWarningSent(ctx context.Context, orgID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InactivityWarningSent", ctx, orgID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InactivityWarningSent indicates an expected call of InactivityWarningSent.
+func (mr *MockCommandsMockRecorder) InactivityWarningSent(ctx, orgID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InactivityWarningSent", reflect.TypeOf((*MockCommands)(nil).InactivityWarningSent), ctx, orgID, userID)
+}
+
 // InviteCodeSent mocks base method.
 func (m *MockCommands) InviteCodeSent(ctx context.Context, orgID, userID string) error {
 	m.ctrl.T.Helper()
@@ -155,6 +171,20 @@ func (mr *MockCommandsMockRecorder) InviteCodeSent(ctx, orgID, userID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteCodeSent", reflect.TypeOf((*MockCommands)(nil).InviteCodeSent), ctx, orgID, userID)
 }
 
+// MagicLinkSent mocks base method.
+func (m *MockCommands) MagicLinkSent(ctx context.Context, sessionID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MagicLinkSent", ctx, sessionID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MagicLinkSent indicates an expected call of MagicLinkSent.
+func (mr *MockCommandsMockRecorder) MagicLinkSent(ctx, sessionID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MagicLinkSent", reflect.TypeOf((*MockCommands)(nil).MagicLinkSent), ctx, sessionID, resourceOwner)
+}
+
 // MilestonePushed mocks base method.
 func (m *MockCommands) MilestonePushed(ctx context.Context, instanceID string, msType milestone.Type, endpoints []string) error {
 	m.ctrl.T.Helper()
@@ -169,6 +199,48 @@ func (mr *MockCommandsMockRecorder) MilestonePushed(ctx, instanceID, msType, end
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MilestonePushed", reflect.TypeOf((*MockCommands)(nil).MilestonePushed), ctx, instanceID, msType, endpoints)
 }
 
+// NewDeviceNotificationSent mocks base method.
+func (m *MockCommands) NewDeviceNotificationSent(ctx context.Context, sessionID, resourceOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewDeviceNotificationSent", ctx, sessionID, resourceOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NewDeviceNotificationSent indicates an expected call of NewDeviceNotificationSent.
+func (mr *MockCommandsMockRecorder) NewDeviceNotificationSent(ctx, sessionID, resourceOwner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewDeviceNotificationSent", reflect.TypeOf((*MockCommands)(nil).NewDeviceNotificationSent), ctx, sessionID, resourceOwner)
+}
+
+// NotificationDelivered mocks base method.
+func (m *MockCommands) NotificationDelivered(ctx context.Context, id, resourceOwner string, delivery notification.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationDelivered", ctx, id, resourceOwner, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationDelivered indicates an expected call of NotificationDelivered.
+func (mr *MockCommandsMockRecorder) NotificationDelivered(ctx, id, resourceOwner, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationDelivered", reflect.TypeOf((*MockCommands)(nil).NotificationDelivered), ctx, id, resourceOwner, delivery)
+}
+
+// NotificationDeliveryFailed mocks base method.
+func (m *MockCommands) NotificationDeliveryFailed(ctx context.Context, id, resourceOwner string, delivery notification.Delivery, deliveryErr error, final bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotificationDeliveryFailed", ctx, id, resourceOwner, delivery, deliveryErr, final)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotificationDeliveryFailed indicates an expected call of NotificationDeliveryFailed.
+func (mr *MockCommandsMockRecorder) NotificationDeliveryFailed(ctx, id, resourceOwner, delivery, deliveryErr, final any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationDeliveryFailed", reflect.TypeOf((*MockCommands)(nil).NotificationDeliveryFailed), ctx, id, resourceOwner, delivery, deliveryErr, final)
+}
+
 // OTPEmailSent mocks base method.
 func (m *MockCommands) OTPEmailSent(ctx context.Context, sessionID, resourceOwner string) error {
 	m.ctrl.T.Helper()
@@ -225,6 +297,20 @@ func (mr *MockCommandsMockRecorder) PasswordCodeSent(ctx, orgID, userID, generat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PasswordCodeSent", reflect.TypeOf((*MockCommands)(nil).PasswordCodeSent), ctx, orgID, userID, generatorInfo)
 }
 
+// SecurityNotificationSent mocks base method.
+func (m *MockCommands) SecurityNotificationSent(ctx context.Context, orgID, userID string, triggerType eventstore.EventType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecurityNotificationSent", ctx, orgID, userID, triggerType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SecurityNotificationSent indicates an expected call of SecurityNotificationSent.
+func (mr *MockCommandsMockRecorder) SecurityNotificationSent(ctx, orgID, userID, triggerType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecurityNotificationSent", reflect.TypeOf((*MockCommands)(nil).SecurityNotificationSent), ctx, orgID, userID, triggerType)
+}
+
 // UsageNotificationSent mocks base method.
 func (m *MockCommands) UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error {
 	m.ctrl.T.Helper()
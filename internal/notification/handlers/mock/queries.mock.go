@@ -355,6 +355,45 @@ func (c *MockQueriesInstanceByIDCall) DoAndReturn(f func(context.Context, string
 	return c
 }
 
+// MailMessageTemplateByOrg mocks base method.
+func (m *MockQueries) MailMessageTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (*query.MailMessageTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MailMessageTemplateByOrg", ctx, orgID, messageType, lang)
+	ret0, _ := ret[0].(*query.MailMessageTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MailMessageTemplateByOrg indicates an expected call of MailMessageTemplateByOrg.
+func (mr *MockQueriesMockRecorder) MailMessageTemplateByOrg(ctx, orgID, messageType, lang any) *MockQueriesMailMessageTemplateByOrgCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MailMessageTemplateByOrg", reflect.TypeOf((*MockQueries)(nil).MailMessageTemplateByOrg), ctx, orgID, messageType, lang)
+	return &MockQueriesMailMessageTemplateByOrgCall{Call: call}
+}
+
+// MockQueriesMailMessageTemplateByOrgCall wrap *gomock.Call
+type MockQueriesMailMessageTemplateByOrgCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQueriesMailMessageTemplateByOrgCall) Return(arg0 *query.MailMessageTemplate, arg1 error) *MockQueriesMailMessageTemplateByOrgCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQueriesMailMessageTemplateByOrgCall) Do(f func(context.Context, string, string, language.Tag) (*query.MailMessageTemplate, error)) *MockQueriesMailMessageTemplateByOrgCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQueriesMailMessageTemplateByOrgCall) DoAndReturn(f func(context.Context, string, string, language.Tag) (*query.MailMessageTemplate, error)) *MockQueriesMailMessageTemplateByOrgCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MailTemplateByOrg mocks base method.
 func (m *MockQueries) MailTemplateByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.MailTemplate, error) {
 	m.ctrl.T.Helper()
@@ -473,18 +512,18 @@ func (c *MockQueriesNotificationProviderByIDAndTypeCall) DoAndReturn(f func(cont
 }
 
 // SMSProviderConfigActive mocks base method.
-func (m *MockQueries) SMSProviderConfigActive(ctx context.Context, resourceOwner string) (*query.SMSConfig, error) {
+func (m *MockQueries) SMSProviderConfigActive(ctx context.Context, resourceOwner string, channel domain.PhoneChannel) (*query.SMSConfig, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SMSProviderConfigActive", ctx, resourceOwner)
+	ret := m.ctrl.Call(m, "SMSProviderConfigActive", ctx, resourceOwner, channel)
 	ret0, _ := ret[0].(*query.SMSConfig)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SMSProviderConfigActive indicates an expected call of SMSProviderConfigActive.
-func (mr *MockQueriesMockRecorder) SMSProviderConfigActive(ctx, resourceOwner any) *MockQueriesSMSProviderConfigActiveCall {
+func (mr *MockQueriesMockRecorder) SMSProviderConfigActive(ctx, resourceOwner, channel any) *MockQueriesSMSProviderConfigActiveCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMSProviderConfigActive", reflect.TypeOf((*MockQueries)(nil).SMSProviderConfigActive), ctx, resourceOwner)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SMSProviderConfigActive", reflect.TypeOf((*MockQueries)(nil).SMSProviderConfigActive), ctx, resourceOwner, channel)
 	return &MockQueriesSMSProviderConfigActiveCall{Call: call}
 }
 
@@ -500,13 +539,13 @@ func (c *MockQueriesSMSProviderConfigActiveCall) Return(config *query.SMSConfig,
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockQueriesSMSProviderConfigActiveCall) Do(f func(context.Context, string) (*query.SMSConfig, error)) *MockQueriesSMSProviderConfigActiveCall {
+func (c *MockQueriesSMSProviderConfigActiveCall) Do(f func(context.Context, string, domain.PhoneChannel) (*query.SMSConfig, error)) *MockQueriesSMSProviderConfigActiveCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockQueriesSMSProviderConfigActiveCall) DoAndReturn(f func(context.Context, string) (*query.SMSConfig, error)) *MockQueriesSMSProviderConfigActiveCall {
+func (c *MockQueriesSMSProviderConfigActiveCall) DoAndReturn(f func(context.Context, string, domain.PhoneChannel) (*query.SMSConfig, error)) *MockQueriesSMSProviderConfigActiveCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
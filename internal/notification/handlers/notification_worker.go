@@ -16,6 +16,8 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/query"
@@ -46,7 +48,13 @@ func (w *NotificationWorker) Timeout(*river.Job[*notification.Request]) time.Dur
 // Work implements [river.Worker].
 func (w *NotificationWorker) Work(ctx context.Context, job *river.Job[*notification.Request]) error {
 	ctx = ContextWithNotifier(ctx, job.Args.Aggregate)
+	notificationChannels := &deliveryChannels{ChannelChains: w.channels}
+	err := w.deliver(ctx, job, notificationChannels)
+	w.recordDelivery(ctx, job, notificationChannels.providerID, err)
+	return err
+}
 
+func (w *NotificationWorker) deliver(ctx context.Context, job *river.Job[*notification.Request], notificationChannels types.ChannelChains) error {
 	// if the notification is too old, we can directly cancel
 	if job.CreatedAt.Add(w.config.MaxTtl).Before(w.now()) {
 		return river.JobCancel(errors.New("notification is too old"))
@@ -67,7 +75,7 @@ func (w *NotificationWorker) Work(ctx context.Context, job *river.Job[*notificat
 		job.Args.Args.Domain = notifyUser.LastEmail[index+1:]
 	}
 
-	err = w.sendNotificationQueue(ctx, job.Args, strconv.Itoa(int(job.ID)), notifyUser)
+	err = w.sendNotificationQueue(ctx, notificationChannels, job.Args, strconv.Itoa(int(job.ID)), notifyUser)
 	if err == nil {
 		return nil
 	}
@@ -78,6 +86,51 @@ func (w *NotificationWorker) Work(ctx context.Context, job *river.Job[*notificat
 	return err
 }
 
+// recordDelivery stores the outcome of the attempt on the notification (the id of the job), so its delivery can be traced and resent.
+// Only the channel and the message are recorded, never the content of the request (e.g. the code).
+// Failing to record the attempt must not lead to another one, so the error is only logged.
+func (w *NotificationWorker) recordDelivery(ctx context.Context, job *river.Job[*notification.Request], providerID string, deliveryErr error) {
+	id := strconv.Itoa(int(job.ID))
+	delivery := notification.Delivery{
+		UserID:           job.Args.UserID,
+		MessageType:      job.Args.MessageType,
+		NotificationType: job.Args.NotificationType,
+		EventType:        job.Args.EventType,
+		ProviderID:       providerID,
+	}
+	var err error
+	if deliveryErr == nil {
+		err = w.commands.NotificationDelivered(ctx, id, job.Args.UserResourceOwner, delivery)
+	} else {
+		final := errors.Is(deliveryErr, new(river.JobCancelError)) || job.Attempt >= job.MaxAttempts
+		err = w.commands.NotificationDeliveryFailed(ctx, id, job.Args.UserResourceOwner, delivery, deliveryErr, final)
+	}
+	logging.WithFields("instanceID", job.Args.Aggregate.InstanceID, "notification", id).
+		OnError(err).Error("could not record notification delivery")
+}
+
+// deliveryChannels remembers the provider of the channel chain a notification is sent with.
+type deliveryChannels struct {
+	types.ChannelChains
+	providerID string
+}
+
+func (c *deliveryChannels) Email(ctx context.Context) (*senders.Chain, *email.Config, error) {
+	chain, config, err := c.ChannelChains.Email(ctx)
+	if config != nil && config.ProviderConfig != nil {
+		c.providerID = config.ProviderConfig.ID
+	}
+	return chain, config, err
+}
+
+func (c *deliveryChannels) SMS(ctx context.Context, channel domain.PhoneChannel) (*senders.Chain, *sms.Config, error) {
+	chain, config, err := c.ChannelChains.SMS(ctx, channel)
+	if config != nil && config.ProviderConfig != nil {
+		c.providerID = config.ProviderConfig.ID
+	}
+	return chain, config, err
+}
+
 type WorkerConfig struct {
 	LegacyEnabled       bool
 	Workers             uint8
@@ -124,7 +177,7 @@ func (w *NotificationWorker) Register(workers *river.Workers, queues map[string]
 	}
 }
 
-func (w *NotificationWorker) sendNotificationQueue(ctx context.Context, request *notification.Request, jobID string, notifyUser *query.NotifyUser) error {
+func (w *NotificationWorker) sendNotificationQueue(ctx context.Context, notificationChannels types.ChannelChains, request *notification.Request, jobID string, notifyUser *query.NotifyUser) error {
 	// check early that a "sent" handler exists, otherwise we can cancel early
 	sentHandler, ok := sentHandlers[request.EventType]
 	if !ok {
@@ -163,9 +216,13 @@ func (w *NotificationWorker) sendNotificationQueue(ctx context.Context, request
 		if err != nil {
 			return err
 		}
-		notify = types.SendEmail(ctx, w.channels, string(template.Template), translator, notifyUser, colors, request.EventType)
+		notify = types.SendEmail(ctx, notificationChannels, string(template.Template), w.queries, translator, notifyUser, colors, request.EventType)
 	case domain.NotificationTypeSms:
-		notify = types.SendSMS(ctx, w.channels, translator, notifyUser, colors, request.EventType, request.Aggregate.InstanceID, jobID, generatorInfo)
+		phoneChannels, err := w.queries.GetPhoneChannels(ctx, notifyUser)
+		if err != nil {
+			return err
+		}
+		notify = types.SendSMS(ctx, notificationChannels, translator, notifyUser, colors, request.EventType, request.Aggregate.InstanceID, jobID, generatorInfo, phoneChannels)
 	}
 
 	args := request.Args.ToMap()
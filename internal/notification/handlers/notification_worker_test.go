@@ -50,6 +50,7 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 			name: "too old",
 			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fieldsWorker, a argsWorker, w wantWorker) {
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
+				expectDeliveryFailed(commands, "0", "", true)
 				return fieldsWorker{
 						queries:  queries,
 						commands: commands,
@@ -109,6 +110,7 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 				commands.EXPECT().InviteCodeSent(gomock.Any(), orgID, userID).Return(nil)
+				expectDelivered(commands, "0", emailProviderID)
 				return fieldsWorker{
 						queries:  queries,
 						commands: commands,
@@ -172,6 +174,7 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					ID:             smsProviderID,
 					VerificationID: verificationID,
 				}).Return(nil)
+				expectDelivered(commands, "1", smsProviderID)
 				return fieldsWorker{
 						queries:  queries,
 						commands: commands,
@@ -228,6 +231,7 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 				}
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 				commands.EXPECT().UserDomainClaimedSent(gomock.Any(), orgID, userID).Return(nil)
+				expectDelivered(commands, "0", emailProviderID)
 				return fieldsWorker{
 						queries:  queries,
 						commands: commands,
@@ -284,6 +288,7 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					return errors.Is(err, sendError)
 				}
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
+				expectDeliveryFailed(commands, "1", emailProviderID, false)
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 				return fieldsWorker{
 						queries:  queries,
@@ -298,8 +303,10 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					argsWorker{
 						job: &river.Job[*notification.Request]{
 							JobRow: &rivertype.JobRow{
-								ID:        1,
-								CreatedAt: time.Now(),
+								ID:          1,
+								CreatedAt:   time.Now(),
+								Attempt:     1,
+								MaxAttempts: 3,
 							},
 							Args: &notification.Request{
 								Aggregate: &eventstore.Aggregate{
@@ -345,6 +352,7 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 				}
 
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
+				expectDeliveryFailed(commands, "0", emailProviderID, true)
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 				return fieldsWorker{
 						queries:  queries,
@@ -408,6 +416,26 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 	}
 }
 
+func expectDelivered(commands *mock.MockCommands, id, providerID string) {
+	commands.EXPECT().NotificationDelivered(gomock.Any(), id, orgID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, delivery notification.Delivery) error {
+			if delivery.ProviderID != providerID || delivery.UserID != userID {
+				return fmt.Errorf("unexpected delivery %+v", delivery)
+			}
+			return nil
+		})
+}
+
+func expectDeliveryFailed(commands *mock.MockCommands, id, providerID string, final bool) {
+	commands.EXPECT().NotificationDeliveryFailed(gomock.Any(), id, orgID, gomock.Any(), gomock.Not(gomock.Nil()), final).
+		DoAndReturn(func(_ context.Context, _, _ string, delivery notification.Delivery, _ error, _ bool) error {
+			if delivery.ProviderID != providerID || delivery.UserID != userID {
+				return fmt.Errorf("unexpected delivery %+v", delivery)
+			}
+			return nil
+		})
+}
+
 func newNotificationWorker(t *testing.T, ctrl *gomock.Controller, queries *mock.MockQueries, f fieldsWorker, w wantWorker) *NotificationWorker {
 	queries.EXPECT().NotificationProviderByIDAndType(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&query.DebugNotificationProvider{}, nil)
 	smtpAlg, _ := cryptoValue(t, ctrl, "smtppw")
@@ -439,7 +467,7 @@ func newNotificationWorker(t *testing.T, ctrl *gomock.Controller, queries *mock.
 			Chain: *senders.ChainChannels(channel),
 			EmailConfig: &email.Config{
 				ProviderConfig: &email.Provider{
-					ID:          "emailProviderID",
+					ID:          emailProviderID,
 					Description: "description",
 				},
 				SMTPConfig: &smtp.Config{
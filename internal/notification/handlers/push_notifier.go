@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/oidc/v3/pkg/crypto"
+
+	"github.com/zitadel/zitadel/internal/api/oidc/sign"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	_ "github.com/zitadel/zitadel/internal/notification/statik"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	PushNotificationsProjectionTable = "projections.notifications_push"
+)
+
+// PushNotifierConfig configures the push channel delivering the approval requests of session push challenges to the devices.
+// The endpoint is expected to forward the request to the push service of the device (e.g. APNs or FCM).
+type PushNotifierConfig struct {
+	// Endpoint receives an HTTP POST for each challenged device, push challenges are not delivered if it's empty.
+	Endpoint string
+	// Headers are sent with every request to the endpoint.
+	Headers http.Header
+	// SigningKey is used to sign the requests to the endpoint, see [webhook.Config].
+	SigningKey string
+}
+
+// PushApprovalRequest is sent to the device of the user as JWT signed by the instance's active web key.
+// The device presents it to the user and answers with a signed approval, containing the challenge
+// and the number the user confirmed.
+type PushApprovalRequest struct {
+	Issuer     string `json:"iss"`
+	Subject    string `json:"sub"`
+	Audience   string `json:"aud"`
+	IssuedAt   int64  `json:"iat"`
+	Expiration int64  `json:"exp"`
+	SessionID  string `json:"sid"`
+	Challenge  string `json:"challenge"`
+	LoginName  string `json:"login_name,omitempty"`
+	UserAgent  string `json:"user_agent,omitempty"`
+	IP         string `json:"ip,omitempty"`
+}
+
+// PushMessage is the payload of the request to the push channel endpoint.
+type PushMessage struct {
+	DeviceID  string `json:"deviceId"`
+	PushToken string `json:"pushToken"`
+	Request   string `json:"request"`
+}
+
+type pushNotifier struct {
+	cfg      PushNotifierConfig
+	commands *command.Commands
+	queries  *NotificationQueries
+	channels types.ChannelChains
+}
+
+func NewPushNotifier(
+	ctx context.Context,
+	pushCfg PushNotifierConfig,
+	handlerCfg handler.Config,
+	commands *command.Commands,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &handlerCfg, &pushNotifier{
+		cfg:      pushCfg,
+		commands: commands,
+		queries:  queries,
+		channels: channels,
+	})
+}
+
+func (*pushNotifier) Name() string {
+	return PushNotificationsProjectionTable
+}
+
+func (p *pushNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.PushChallengedType,
+					Reduce: p.reducePushChallenged,
+				},
+			},
+		},
+	}
+}
+
+func (p *pushNotifier) reducePushChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.PushChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Eiz4a", "reduce.wrong.event.type %s", session.PushChallengedType)
+	}
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		ctx = HandlerContext(ctx, event.Aggregate())
+		expiresAt := e.CreatedAt().Add(e.Expiry)
+		if expiresAt.Before(time.Now()) {
+			return nil
+		}
+		alreadyHandled, err := p.queries.IsAlreadyHandled(ctx, event, nil, session.PushSentType)
+		if err != nil || alreadyHandled {
+			return err
+		}
+		s, err := p.queries.SessionByID(ctx, true, e.Aggregate().ID, "", nil)
+		if err != nil {
+			return err
+		}
+		signer, _, err := sign.GetSignerOnce(p.queries.GetActiveSigningWebKey)(ctx)
+		if err != nil {
+			return err
+		}
+		request := &PushApprovalRequest{
+			Issuer:     e.TriggerOrigin(),
+			Subject:    s.UserFactor.UserID,
+			IssuedAt:   e.CreatedAt().Unix(),
+			Expiration: expiresAt.Unix(),
+			SessionID:  e.Aggregate().ID,
+			Challenge:  e.Challenge,
+			LoginName:  s.UserFactor.LoginName,
+		}
+		if s.UserAgent.Description != nil {
+			request.UserAgent = *s.UserAgent.Description
+		}
+		if len(s.UserAgent.IP) > 0 {
+			request.IP = s.UserAgent.IP.String()
+		}
+		config := webhook.Config{
+			CallURL:    p.cfg.Endpoint,
+			Method:     http.MethodPost,
+			Headers:    p.cfg.Headers,
+			SigningKey: p.cfg.SigningKey,
+			Client:     p.queries.httpClient,
+		}
+		for _, device := range e.Devices {
+			request.Audience = device.ID
+			token, err := crypto.Sign(request, signer)
+			if err != nil {
+				return err
+			}
+			message := &PushMessage{
+				DeviceID:  device.ID,
+				PushToken: device.PushToken,
+				Request:   token,
+			}
+			if err = types.SendJSON(ctx, config, p.channels, message, e.Type()).WithoutTemplate(); err != nil {
+				return err
+			}
+		}
+		return p.commands.PushChallengeSent(ctx, e.Aggregate().ID, e.Aggregate().InstanceID)
+	}), nil
+}
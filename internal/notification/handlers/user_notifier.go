@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"net/url"
+	"slices"
 	"time"
 
 	http_util "github.com/zitadel/zitadel/internal/api/http"
@@ -14,6 +15,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/queue"
 	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/repository/session"
@@ -57,6 +59,11 @@ func init() {
 			return commands.OTPEmailSent(ctx, id, orgID)
 		},
 	)
+	RegisterSentHandler(session.MagicLinkChallengedType,
+		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.MagicLinkSent(ctx, id, orgID)
+		},
+	)
 	RegisterSentHandler(user.UserDomainClaimedType,
 		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
 			return commands.UserDomainClaimedSent(ctx, orgID, id)
@@ -82,6 +89,49 @@ func init() {
 			return commands.InviteCodeSent(ctx, orgID, id)
 		},
 	)
+	for _, trigger := range securityNotificationTriggers {
+		RegisterSentHandler(trigger.eventType,
+			func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+				return commands.SecurityNotificationSent(ctx, orgID, id, trigger.eventType)
+			},
+		)
+	}
+	RegisterSentHandler(user.InactivityWarnedType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.InactivityWarningSent(ctx, orgID, id)
+		},
+	)
+	RegisterSentHandler(session.RiskEvaluatedType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.NewDeviceNotificationSent(ctx, id, orgID)
+		},
+	)
+}
+
+// securityNotificationTriggers are the events, which alert the user about a sensitive change of the account.
+var securityNotificationTriggers = []struct {
+	eventType   eventstore.EventType
+	messageType string
+}{
+	{eventType: user.HumanMFAOTPVerifiedType, messageType: domain.MFAAddedMessageType},
+	{eventType: user.HumanU2FTokenVerifiedType, messageType: domain.MFAAddedMessageType},
+	{eventType: user.HumanPasswordlessTokenVerifiedType, messageType: domain.MFAAddedMessageType},
+	{eventType: user.HumanOTPSMSAddedType, messageType: domain.MFAAddedMessageType},
+	{eventType: user.HumanOTPEmailAddedType, messageType: domain.MFAAddedMessageType},
+	{eventType: user.HumanRecoveryCodesAddedType, messageType: domain.MFAAddedMessageType},
+	{eventType: user.HumanPushDeviceVerifiedType, messageType: domain.MFAAddedMessageType},
+	{eventType: user.HumanMFAOTPRemovedType, messageType: domain.MFARemovedMessageType},
+	{eventType: user.HumanU2FTokenRemovedType, messageType: domain.MFARemovedMessageType},
+	{eventType: user.HumanPasswordlessTokenRemovedType, messageType: domain.MFARemovedMessageType},
+	{eventType: user.HumanOTPSMSRemovedType, messageType: domain.MFARemovedMessageType},
+	{eventType: user.HumanOTPEmailRemovedType, messageType: domain.MFARemovedMessageType},
+	{eventType: user.HumanRecoveryCodesRemovedType, messageType: domain.MFARemovedMessageType},
+	{eventType: user.HumanPushDeviceRemovedType, messageType: domain.MFARemovedMessageType},
+	{eventType: user.HumanEmailChangedType, messageType: domain.EmailChangedMessageType},
+	{eventType: user.HumanPhoneChangedType, messageType: domain.PhoneChangedMessageType},
+	{eventType: user.HumanPhoneRemovedType, messageType: domain.PhoneChangedMessageType},
+	{eventType: user.PersonalAccessTokenAddedType, messageType: domain.PersonalAccessTokenAddedMessageType},
+	{eventType: user.MachineKeyAddedEventType, messageType: domain.MachineKeyAddedMessageType},
 }
 
 const (
@@ -122,10 +172,17 @@ func (u *userNotifier) Name() string {
 }
 
 func (u *userNotifier) Reducers() []handler.AggregateReducer {
+	securityNotificationReducers := make([]handler.EventReducer, len(securityNotificationTriggers))
+	for i, trigger := range securityNotificationTriggers {
+		securityNotificationReducers[i] = handler.EventReducer{
+			Event:  trigger.eventType,
+			Reduce: u.reduceSecurityNotification(trigger.messageType),
+		}
+	}
 	return []handler.AggregateReducer{
 		{
 			Aggregate: user.AggregateType,
-			EventReducers: []handler.EventReducer{
+			EventReducers: append([]handler.EventReducer{
 				{
 					Event:  user.UserV1InitialCodeAddedType,
 					Reduce: u.reduceInitCodeAdded,
@@ -182,7 +239,11 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanInviteCodeAddedType,
 					Reduce: u.reduceInviteCodeAdded,
 				},
-			},
+				{
+					Event:  user.InactivityWarnedType,
+					Reduce: u.reduceInactivityWarned,
+				},
+			}, securityNotificationReducers...),
 		},
 		{
 			Aggregate: session.AggregateType,
@@ -195,6 +256,14 @@ func (u *userNotifier) Reducers() []handler.AggregateReducer {
 					Event:  session.OTPEmailChallengedType,
 					Reduce: u.reduceSessionOTPEmailChallenged,
 				},
+				{
+					Event:  session.MagicLinkChallengedType,
+					Reduce: u.reduceSessionMagicLinkChallenged,
+				},
+				{
+					Event:  session.RiskEvaluatedType,
+					Reduce: u.reduceSessionRiskEvaluated,
+				},
 			},
 		},
 	}
@@ -563,6 +632,58 @@ func (u *userNotifier) otpEmailTemplate(origin *url.URL, e *session.OTPEmailChal
 	return u.otpEmailTmpl(origin)
 }
 
+func (u *userNotifier) reduceSessionMagicLinkChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.MagicLinkChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ahs7i", "reduce.wrong.event.type %s", session.MagicLinkChallengedType)
+	}
+	if e.ReturnCode {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		ctx = HandlerContext(ctx, event.Aggregate())
+		alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil,
+			session.MagicLinkChallengedType,
+			session.MagicLinkSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "", nil)
+		if err != nil {
+			return err
+		}
+
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).OriginURL()
+
+		args := otpArgs(ctx, e.Expiry)
+		args.SessionID = e.Aggregate().ID
+		return u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:         e.Aggregate(),
+				UserID:            s.UserFactor.UserID,
+				UserResourceOwner: s.UserFactor.ResourceOwner,
+				TriggeredAtOrigin: origin.String(),
+				EventType:         e.EventType,
+				NotificationType:  domain.NotificationTypeEmail,
+				MessageType:       domain.MagicLinkMessageType,
+				Code:              e.Code,
+				CodeExpiry:        e.Expiry,
+				URLTemplate:       e.URLTmpl,
+				Args:              args,
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
+
 func otpArgs(ctx context.Context, expiry time.Duration) *domain.NotificationArguments {
 	domainCtx := http_util.DomainContext(ctx)
 	return &domain.NotificationArguments{
@@ -715,6 +836,198 @@ func (u *userNotifier) reducePasswordChanged(event eventstore.Event) (*handler.S
 	}), nil
 }
 
+// reduceSecurityNotification alerts the user about a sensitive change of the account.
+func (u *userNotifier) reduceSecurityNotification(messageType string) handler.Reduce {
+	return func(event eventstore.Event) (*handler.Statement, error) {
+		return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+			ctx = HandlerContext(ctx, event.Aggregate())
+			alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event,
+				map[string]interface{}{"triggerType": event.Type()},
+				user.SecurityNotificationSentType)
+			if err != nil {
+				return err
+			}
+			if alreadyHandled {
+				return nil
+			}
+			enabled, err := securityNotificationEnabled(ctx, u.queries, event.Aggregate().ID, event.Aggregate().ResourceOwner)
+			if err != nil || !enabled {
+				return err
+			}
+			ctx, err = u.queries.Origin(ctx, event)
+			if err != nil {
+				return err
+			}
+			origin := http_util.DomainContext(ctx).Origin()
+			return u.queue.Insert(ctx,
+				&notification.Request{
+					Aggregate:         event.Aggregate(),
+					UserID:            event.Aggregate().ID,
+					UserResourceOwner: event.Aggregate().ResourceOwner,
+					TriggeredAtOrigin: origin,
+					EventType:         event.Type(),
+					NotificationType:  domain.NotificationTypeEmail,
+					MessageType:       messageType,
+					URLTemplate:       console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+				},
+				queue.WithQueueName(notification.QueueName),
+				queue.WithMaxAttempts(u.maxAttempts),
+			)
+		}), nil
+	}
+}
+
+// reduceInactivityWarned notifies the user about the upcoming deactivation of the account.
+func (u *userNotifier) reduceInactivityWarned(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.InactivityWarnedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ieh4k", "reduce.wrong.event.type %s", user.InactivityWarnedType)
+	}
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		ctx = HandlerContext(ctx, event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.InactivityWarningSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		notify, err := hasVerifiedEmail(ctx, u.queries, e.Aggregate().ID)
+		if err != nil || !notify {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+		return u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:         e.Aggregate(),
+				UserID:            e.Aggregate().ID,
+				UserResourceOwner: e.Aggregate().ResourceOwner,
+				TriggeredAtOrigin: origin,
+				EventType:         e.EventType,
+				NotificationType:  domain.NotificationTypeEmail,
+				MessageType:       domain.InactivityWarningMessageType,
+				URLTemplate:       console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+				Args:              inactivityWarningArgs(e),
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
+
+func inactivityWarningArgs(e *user.InactivityWarnedEvent) *domain.NotificationArguments {
+	args := &domain.NotificationArguments{
+		LastActivity: e.LastActivity.Format(time.DateOnly),
+	}
+	if !e.DeactivationDate.IsZero() {
+		args.DeactivationDate = e.DeactivationDate.Format(time.DateOnly)
+	}
+	return args
+}
+
+func (u *userNotifier) reduceSessionRiskEvaluated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.RiskEvaluatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ohv3e", "reduce.wrong.event.type %s", session.RiskEvaluatedType)
+	}
+	if !isNewDeviceSignIn(e) {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		ctx = HandlerContext(ctx, event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, session.NewDeviceNotificationSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		alreadyHandled, err = u.queries.HasPreviousNewDeviceSignIn(ctx, e)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		enabled, err := securityNotificationEnabled(ctx, u.queries, e.UserID, e.UserResourceOwner)
+		if err != nil || !enabled {
+			return err
+		}
+		s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "", nil)
+		if err != nil {
+			return err
+		}
+		ctx, err = u.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		origin := http_util.DomainContext(ctx).Origin()
+		return u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:         e.Aggregate(),
+				UserID:            e.UserID,
+				UserResourceOwner: e.UserResourceOwner,
+				TriggeredAtOrigin: origin,
+				EventType:         e.EventType,
+				NotificationType:  domain.NotificationTypeEmail,
+				MessageType:       domain.NewDeviceSignInMessageType,
+				URLTemplate:       console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+				Args:              newDeviceSignInArgs(e, s),
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+	}), nil
+}
+
+// isNewDeviceSignIn returns true if the user was allowed to sign in from a device, which was never used before.
+func isNewDeviceSignIn(e *session.RiskEvaluatedEvent) bool {
+	return e.Outcome != domain.RiskOutcomeDeny && slices.Contains(e.Signals, domain.RiskSignalNewDevice)
+}
+
+func newDeviceSignInArgs(e *session.RiskEvaluatedEvent, s *query.Session) *domain.NotificationArguments {
+	args := &domain.NotificationArguments{
+		SessionID: e.Aggregate().ID,
+		IP:        e.IP,
+	}
+	if e.Location != nil {
+		args.Country = e.Location.Country
+	}
+	if s.UserAgent.Description != nil {
+		args.Device = *s.UserAgent.Description
+	}
+	return args
+}
+
+// securityNotificationEnabled checks if the organization enabled security notifications in its notification policy.
+// Users without a verified email (e.g. machine users) are not notified.
+func securityNotificationEnabled(ctx context.Context, queries *NotificationQueries, userID, resourceOwner string) (bool, error) {
+	notificationPolicy, err := queries.NotificationPolicyByOrg(ctx, true, resourceOwner, false)
+	if err != nil && !zerrors.IsNotFound(err) {
+		return false, err
+	}
+	if notificationPolicy == nil || !notificationPolicy.SecurityNotifications {
+		return false, nil
+	}
+	return hasVerifiedEmail(ctx, queries, userID)
+}
+
+// hasVerifiedEmail checks if the user can be notified by email.
+func hasVerifiedEmail(ctx context.Context, queries *NotificationQueries, userID string) (bool, error) {
+	notifyUser, err := queries.GetNotifyUserByID(ctx, true, userID)
+	if zerrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return notifyUser.VerifiedEmail != "", nil
+}
+
 func (u *userNotifier) reducePhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanPhoneCodeAddedEvent)
 	if !ok {
@@ -50,10 +50,17 @@ func (u *userNotifierLegacy) Name() string {
 }
 
 func (u *userNotifierLegacy) Reducers() []handler.AggregateReducer {
+	securityNotificationReducers := make([]handler.EventReducer, len(securityNotificationTriggers))
+	for i, trigger := range securityNotificationTriggers {
+		securityNotificationReducers[i] = handler.EventReducer{
+			Event:  trigger.eventType,
+			Reduce: u.reduceSecurityNotification(trigger.messageType),
+		}
+	}
 	return []handler.AggregateReducer{
 		{
 			Aggregate: user.AggregateType,
-			EventReducers: []handler.EventReducer{
+			EventReducers: append([]handler.EventReducer{
 				{
 					Event:  user.UserV1InitialCodeAddedType,
 					Reduce: u.reduceInitCodeAdded,
@@ -110,7 +117,11 @@ func (u *userNotifierLegacy) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanInviteCodeAddedType,
 					Reduce: u.reduceInviteCodeAdded,
 				},
-			},
+				{
+					Event:  user.InactivityWarnedType,
+					Reduce: u.reduceInactivityWarned,
+				},
+			}, securityNotificationReducers...),
 		},
 		{
 			Aggregate: session.AggregateType,
@@ -123,6 +134,14 @@ func (u *userNotifierLegacy) Reducers() []handler.AggregateReducer {
 					Event:  session.OTPEmailChallengedType,
 					Reduce: u.reduceSessionOTPEmailChallenged,
 				},
+				{
+					Event:  session.MagicLinkChallengedType,
+					Reduce: u.reduceSessionMagicLinkChallenged,
+				},
+				{
+					Event:  session.RiskEvaluatedType,
+					Reduce: u.reduceSessionRiskEvaluated,
+				},
 			},
 		},
 	}
@@ -172,7 +191,7 @@ func (u *userNotifierLegacy) reduceInitCodeAdded(event eventstore.Event) (*handl
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, e.Type()).
+		err = types.SendEmail(ctx, u.channels, string(template.Template), u.queries, translator, notifyUser, colors, e.Type()).
 			SendUserInitCode(ctx, notifyUser, code, e.AuthRequestID)
 		if err != nil {
 			if errors.Is(err, &channels.CancelError{}) {
@@ -233,7 +252,7 @@ func (u *userNotifierLegacy) reduceEmailCodeAdded(event eventstore.Event) (*hand
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type()).
+		err = types.SendEmail(ctx, u.channels, string(template.Template), u.queries, translator, notifyUser, colors, event.Type()).
 			SendEmailVerificationCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
 		if err != nil {
 			if errors.Is(err, &channels.CancelError{}) {
@@ -297,9 +316,13 @@ func (u *userNotifierLegacy) reducePasswordCodeAdded(event eventstore.Event) (*h
 			return err
 		}
 		generatorInfo := new(senders.CodeGeneratorInfo)
-		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type())
+		notify := types.SendEmail(ctx, u.channels, string(template.Template), u.queries, translator, notifyUser, colors, event.Type())
 		if e.NotificationType == domain.NotificationTypeSms {
-			notify = types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e.Type(), e.Aggregate().InstanceID, e.ID, generatorInfo)
+			phoneChannels, err := u.queries.GetPhoneChannels(ctx, notifyUser)
+			if err != nil {
+				return err
+			}
+			notify = types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e.Type(), e.Aggregate().InstanceID, e.ID, generatorInfo, phoneChannels)
 		}
 		err = notify.SendPasswordCode(ctx, notifyUser, code, e.URLTemplate, e.AuthRequestID)
 		if err != nil {
@@ -396,8 +419,12 @@ func (u *userNotifierLegacy) reduceOTPSMS(
 	if err != nil {
 		return nil, err
 	}
+	phoneChannels, err := u.queries.GetPhoneChannels(ctx, notifyUser)
+	if err != nil {
+		return nil, err
+	}
 	generatorInfo := new(senders.CodeGeneratorInfo)
-	notify := types.SendSMS(ctx, u.channels, translator, notifyUser, colors, event.Type(), event.Aggregate().InstanceID, event.Aggregate().ID, generatorInfo)
+	notify := types.SendSMS(ctx, u.channels, translator, notifyUser, colors, event.Type(), event.Aggregate().InstanceID, event.Aggregate().ID, generatorInfo, phoneChannels)
 	err = notify.SendOTPSMSCode(ctx, plainCode, expiry)
 	if err != nil {
 		if errors.Is(err, &channels.CancelError{}) {
@@ -523,7 +550,7 @@ func (u *userNotifierLegacy) reduceOTPEmail(
 	if err != nil {
 		return nil, err
 	}
-	notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type())
+	notify := types.SendEmail(ctx, u.channels, string(template.Template), u.queries, translator, notifyUser, colors, event.Type())
 	err = notify.SendOTPEmailCode(ctx, link, plainCode, expiry)
 	if err != nil {
 		if errors.Is(err, &channels.CancelError{}) {
@@ -539,6 +566,70 @@ func (u *userNotifierLegacy) reduceOTPEmail(
 	return handler.NewNoOpStatement(event), nil
 }
 
+func (u *userNotifierLegacy) reduceSessionMagicLinkChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.MagicLinkChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ahs7i", "reduce.wrong.event.type %s", session.MagicLinkChallengedType)
+	}
+	if e.ReturnCode {
+		return handler.NewNoOpStatement(e), nil
+	}
+	ctx := HandlerContext(context.Background(), event.Aggregate())
+	alreadyHandled, err := u.checkIfCodeAlreadyHandledOrExpired(ctx, event, e.Expiry, nil, session.MagicLinkChallengedType, session.MagicLinkSentType)
+	if err != nil {
+		return nil, err
+	}
+	if alreadyHandled {
+		return handler.NewNoOpStatement(event), nil
+	}
+	s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	code, err := crypto.DecryptString(e.Code, u.queries.UserDataCrypto)
+	if err != nil {
+		return nil, err
+	}
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, s.UserFactor.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	template, err := u.queries.MailTemplateByOrg(ctx, s.UserFactor.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, s.UserFactor.UserID)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, s.UserFactor.ResourceOwner, domain.MagicLinkMessageType)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err = u.queries.Origin(ctx, e)
+	if err != nil {
+		return nil, err
+	}
+	var link strings.Builder
+	if err = domain.RenderMagicLinkURLTemplate(&link, e.URLTmpl, code, notifyUser.ID, notifyUser.PreferredLoginName, notifyUser.DisplayName, e.Aggregate().ID, notifyUser.PreferredLanguage); err != nil {
+		return nil, err
+	}
+	notify := types.SendEmail(ctx, u.channels, string(template.Template), u.queries, translator, notifyUser, colors, event.Type())
+	err = notify.SendMagicLink(ctx, link.String(), code, e.Expiry)
+	if err != nil {
+		if errors.Is(err, &channels.CancelError{}) {
+			// if the notification was canceled, we don't want to return the error, so there is no retry
+			return handler.NewNoOpStatement(event), nil
+		}
+		return nil, err
+	}
+	err = u.commands.MagicLinkSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewNoOpStatement(event), nil
+}
+
 func (u *userNotifierLegacy) reduceDomainClaimed(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.DomainClaimedEvent)
 	if !ok {
@@ -577,7 +668,7 @@ func (u *userNotifierLegacy) reduceDomainClaimed(event eventstore.Event) (*handl
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type()).
+		err = types.SendEmail(ctx, u.channels, string(template.Template), u.queries, translator, notifyUser, colors, event.Type()).
 			SendDomainClaimed(ctx, notifyUser, e.UserName, e.URLTemplate)
 		if err != nil {
 			if errors.Is(err, &channels.CancelError{}) {
@@ -635,7 +726,7 @@ func (u *userNotifierLegacy) reducePasswordlessCodeRequested(event eventstore.Ev
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type()).
+		err = types.SendEmail(ctx, u.channels, string(template.Template), u.queries, translator, notifyUser, colors, event.Type()).
 			SendPasswordlessRegistrationLink(ctx, notifyUser, code, e.ID, e.URLTemplate)
 		if err != nil {
 			if errors.Is(err, &channels.CancelError{}) {
@@ -698,7 +789,7 @@ func (u *userNotifierLegacy) reducePasswordChanged(event eventstore.Event) (*han
 		if err != nil {
 			return err
 		}
-		err = types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type()).
+		err = types.SendEmail(ctx, u.channels, string(template.Template), u.queries, translator, notifyUser, colors, event.Type()).
 			SendPasswordChange(ctx, notifyUser)
 		if err != nil {
 			if errors.Is(err, &channels.CancelError{}) {
@@ -711,6 +802,137 @@ func (u *userNotifierLegacy) reducePasswordChanged(event eventstore.Event) (*han
 	}), nil
 }
 
+// reduceSecurityNotification alerts the user about a sensitive change of the account.
+func (u *userNotifierLegacy) reduceSecurityNotification(messageType string) handler.Reduce {
+	return func(event eventstore.Event) (*handler.Statement, error) {
+		return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+			ctx = HandlerContext(ctx, event.Aggregate())
+			alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event,
+				map[string]interface{}{"triggerType": event.Type()},
+				user.SecurityNotificationSentType)
+			if err != nil {
+				return err
+			}
+			if alreadyHandled {
+				return nil
+			}
+			enabled, err := securityNotificationEnabled(ctx, u.queries, event.Aggregate().ID, event.Aggregate().ResourceOwner)
+			if err != nil || !enabled {
+				return err
+			}
+			err = u.sendSecurityNotification(ctx, event, event.Aggregate().ID, event.Aggregate().ResourceOwner, messageType, nil)
+			if err != nil {
+				if errors.Is(err, &channels.CancelError{}) {
+					// if the notification was canceled, we don't want to return the error, so there is no retry
+					return nil
+				}
+				return err
+			}
+			return u.commands.SecurityNotificationSent(ctx, event.Aggregate().ResourceOwner, event.Aggregate().ID, event.Type())
+		}), nil
+	}
+}
+
+// reduceInactivityWarned notifies the user about the upcoming deactivation of the account.
+func (u *userNotifierLegacy) reduceInactivityWarned(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.InactivityWarnedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ieh4k", "reduce.wrong.event.type %s", user.InactivityWarnedType)
+	}
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		ctx = HandlerContext(ctx, event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, user.InactivityWarningSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		notify, err := hasVerifiedEmail(ctx, u.queries, e.Aggregate().ID)
+		if err != nil || !notify {
+			return err
+		}
+		err = u.sendSecurityNotification(ctx, e, e.Aggregate().ID, e.Aggregate().ResourceOwner, domain.InactivityWarningMessageType, inactivityWarningArgs(e))
+		if err != nil {
+			if errors.Is(err, &channels.CancelError{}) {
+				// if the notification was canceled, we don't want to return the error, so there is no retry
+				return nil
+			}
+			return err
+		}
+		return u.commands.InactivityWarningSent(ctx, e.Aggregate().ResourceOwner, e.Aggregate().ID)
+	}), nil
+}
+
+func (u *userNotifierLegacy) reduceSessionRiskEvaluated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.RiskEvaluatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ohv3e", "reduce.wrong.event.type %s", session.RiskEvaluatedType)
+	}
+	if !isNewDeviceSignIn(e) {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		ctx = HandlerContext(ctx, event.Aggregate())
+		alreadyHandled, err := u.queries.IsAlreadyHandled(ctx, event, nil, session.NewDeviceNotificationSentType)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		alreadyHandled, err = u.queries.HasPreviousNewDeviceSignIn(ctx, e)
+		if err != nil {
+			return err
+		}
+		if alreadyHandled {
+			return nil
+		}
+		enabled, err := securityNotificationEnabled(ctx, u.queries, e.UserID, e.UserResourceOwner)
+		if err != nil || !enabled {
+			return err
+		}
+		s, err := u.queries.SessionByID(ctx, true, e.Aggregate().ID, "", nil)
+		if err != nil {
+			return err
+		}
+		err = u.sendSecurityNotification(ctx, e, e.UserID, e.UserResourceOwner, domain.NewDeviceSignInMessageType, newDeviceSignInArgs(e, s))
+		if err != nil {
+			if errors.Is(err, &channels.CancelError{}) {
+				// if the notification was canceled, we don't want to return the error, so there is no retry
+				return nil
+			}
+			return err
+		}
+		return u.commands.NewDeviceNotificationSent(ctx, e.Aggregate().ID, e.Aggregate().ResourceOwner)
+	}), nil
+}
+
+func (u *userNotifierLegacy) sendSecurityNotification(ctx context.Context, event eventstore.Event, userID, resourceOwner, messageType string, args *domain.NotificationArguments) error {
+	colors, err := u.queries.ActiveLabelPolicyByOrg(ctx, resourceOwner, false)
+	if err != nil {
+		return err
+	}
+	template, err := u.queries.MailTemplateByOrg(ctx, resourceOwner, false)
+	if err != nil {
+		return err
+	}
+	notifyUser, err := u.queries.GetNotifyUserByID(ctx, true, userID)
+	if err != nil {
+		return err
+	}
+	translator, err := u.queries.GetTranslatorWithOrgTexts(ctx, notifyUser.ResourceOwner, messageType)
+	if err != nil {
+		return err
+	}
+	ctx, err = u.queries.Origin(ctx, event)
+	if err != nil {
+		return err
+	}
+	return types.SendEmail(ctx, u.channels, string(template.Template), u.queries, translator, notifyUser, colors, event.Type()).
+		SendSecurityNotification(ctx, notifyUser, messageType, args)
+}
+
 func (u *userNotifierLegacy) reducePhoneCodeAdded(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanPhoneCodeAddedEvent)
 	if !ok {
@@ -756,8 +978,12 @@ func (u *userNotifierLegacy) reducePhoneCodeAdded(event eventstore.Event) (*hand
 		if err != nil {
 			return err
 		}
+		phoneChannels, err := u.queries.GetPhoneChannels(ctx, notifyUser)
+		if err != nil {
+			return err
+		}
 		generatorInfo := new(senders.CodeGeneratorInfo)
-		if err = types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e.Type(), e.Aggregate().InstanceID, e.ID, generatorInfo).
+		if err = types.SendSMS(ctx, u.channels, translator, notifyUser, colors, e.Type(), e.Aggregate().InstanceID, e.ID, generatorInfo, phoneChannels).
 			SendPhoneVerificationCode(ctx, code); err != nil {
 			if errors.Is(err, &channels.CancelError{}) {
 				// if the notification was canceled, we don't want to return the error, so there is no retry
@@ -815,7 +1041,7 @@ func (u *userNotifierLegacy) reduceInviteCodeAdded(event eventstore.Event) (*han
 		if err != nil {
 			return err
 		}
-		notify := types.SendEmail(ctx, u.channels, string(template.Template), translator, notifyUser, colors, event.Type())
+		notify := types.SendEmail(ctx, u.channels, string(template.Template), u.queries, translator, notifyUser, colors, event.Type())
 		err = notify.SendInviteCode(ctx, notifyUser, code, e.ApplicationName, e.URLTemplate, e.AuthRequestID)
 		if err != nil {
 			if errors.Is(err, &channels.CancelError{}) {
@@ -872,6 +872,7 @@ func Test_userNotifierLegacy_reducePasswordCodeAdded(t *testing.T) {
 					},
 				}
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
+				queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{}, nil)
 				commands.EXPECT().PasswordCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{ID: smsProviderID, VerificationID: verificationID}).Return(nil)
 				return fields{
 						queries:  queries,
@@ -912,6 +913,7 @@ func Test_userNotifierLegacy_reducePasswordCodeAdded(t *testing.T) {
 					err: channels.NewCancelError(nil),
 				}
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
+				queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{}, nil)
 				return fields{
 						queries:  queries,
 						commands: commands,
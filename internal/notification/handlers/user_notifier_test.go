@@ -30,6 +30,7 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/repository/session"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const (
@@ -1077,6 +1078,436 @@ func Test_userNotifier_reducePasswordChanged(t *testing.T) {
 	}
 }
 
+func Test_userNotifier_reduceSecurityNotification(t *testing.T) {
+	emailChangedEvent := func() eventstore.Event {
+		return &user.HumanEmailChangedEvent{
+			BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+				InstanceID:    instanceID,
+				AggregateID:   userID,
+				ResourceOwner: sql.NullString{String: orgID},
+				CreationDate:  time.Now().UTC(),
+				Typ:           user.HumanEmailChangedType,
+			}),
+			EmailAddress: "new@email.com",
+		}
+	}
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockQueue) (fields, args, want)
+	}{
+		{
+			name: "enabled, send to verified email",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+					SecurityNotifications: true,
+				}, nil)
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), userID).Return(&query.NotifyUser{
+					ID:            userID,
+					VerifiedEmail: verifiedEmail,
+				}, nil)
+				queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+					Domains: []*query.InstanceDomain{{
+						Domain:    instancePrimaryDomain,
+						IsPrimary: true,
+					}},
+				}, nil)
+				queue.EXPECT().Insert(
+					gomock.Any(),
+					&notification.Request{
+						Aggregate: &eventstore.Aggregate{
+							ID:            userID,
+							InstanceID:    instanceID,
+							ResourceOwner: orgID,
+						},
+						UserID:            userID,
+						UserResourceOwner: orgID,
+						TriggeredAtOrigin: fmt.Sprintf("%s://%s:%d", externalProtocol, instancePrimaryDomain, externalPort),
+						URLTemplate: fmt.Sprintf("%s://%s:%d/ui/console?login_hint={{.PreferredLoginName}}",
+							externalProtocol, instancePrimaryDomain, externalPort),
+						EventType:        user.HumanEmailChangedType,
+						NotificationType: domain.NotificationTypeEmail,
+						MessageType:      domain.EmailChangedMessageType,
+					},
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil)
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+						}),
+					}, args{
+						event: emailChangedEvent(),
+					}, w
+			},
+		},
+		{
+			name: "already sent",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   userID,
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           user.SecurityNotificationSentType,
+								Data:          []byte(`{"triggerType":"user.human.email.changed"}`),
+							}).MockQuerier,
+						}),
+					}, args{
+						event: emailChangedEvent(),
+					}, w
+			},
+		},
+		{
+			name: "disabled in policy",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+					PasswordChange: true,
+				}, nil)
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+						}),
+					}, args{
+						event: emailChangedEvent(),
+					}, w
+			},
+		},
+		{
+			name: "no verified email",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+					SecurityNotifications: true,
+				}, nil)
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), userID).Return(&query.NotifyUser{
+					ID:        userID,
+					LastEmail: lastEmail,
+				}, nil)
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+						}),
+					}, args{
+						event: emailChangedEvent(),
+					}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			queue := mock.NewMockQueue(ctrl)
+			f, a, w := tt.test(ctrl, queries, queue)
+			stmt, err := newUserNotifier(t, ctrl, queries, f).reduceSecurityNotification(domain.EmailChangedMessageType)(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(t.Context(), nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceInactivityWarned(t *testing.T) {
+	inactivityWarnedEvent := func() eventstore.Event {
+		return &user.InactivityWarnedEvent{
+			BaseEvent: eventstore.BaseEventFromRepo(&repository.Event{
+				InstanceID:    instanceID,
+				AggregateID:   userID,
+				ResourceOwner: sql.NullString{String: orgID},
+				CreationDate:  time.Now().UTC(),
+				Typ:           user.InactivityWarnedType,
+			}),
+			LastActivity:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			DeactivationDate: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+		}
+	}
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockQueue) (fields, args, want)
+	}{
+		{
+			name: "send to verified email",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), userID).Return(&query.NotifyUser{
+					ID:            userID,
+					VerifiedEmail: verifiedEmail,
+				}, nil)
+				queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+					Domains: []*query.InstanceDomain{{
+						Domain:    instancePrimaryDomain,
+						IsPrimary: true,
+					}},
+				}, nil)
+				queue.EXPECT().Insert(
+					gomock.Any(),
+					&notification.Request{
+						Aggregate: &eventstore.Aggregate{
+							ID:            userID,
+							InstanceID:    instanceID,
+							ResourceOwner: orgID,
+						},
+						UserID:            userID,
+						UserResourceOwner: orgID,
+						TriggeredAtOrigin: fmt.Sprintf("%s://%s:%d", externalProtocol, instancePrimaryDomain, externalPort),
+						URLTemplate: fmt.Sprintf("%s://%s:%d/ui/console?login_hint={{.PreferredLoginName}}",
+							externalProtocol, instancePrimaryDomain, externalPort),
+						EventType:        user.InactivityWarnedType,
+						NotificationType: domain.NotificationTypeEmail,
+						MessageType:      domain.InactivityWarningMessageType,
+						Args: &domain.NotificationArguments{
+							LastActivity:     "2024-01-01",
+							DeactivationDate: "2024-03-01",
+						},
+					},
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil)
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+						}),
+					}, args{
+						event: inactivityWarnedEvent(),
+					}, w
+			},
+		},
+		{
+			name: "already sent",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents(&repository.Event{
+								InstanceID:    instanceID,
+								AggregateID:   userID,
+								ResourceOwner: sql.NullString{String: orgID},
+								CreationDate:  time.Now().UTC(),
+								Typ:           user.InactivityWarningSentType,
+							}).MockQuerier,
+						}),
+					}, args{
+						event: inactivityWarnedEvent(),
+					}, w
+			},
+		},
+		{
+			name: "no verified email",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), userID).Return(&query.NotifyUser{
+					ID:        userID,
+					LastEmail: lastEmail,
+				}, nil)
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().MockQuerier,
+						}),
+					}, args{
+						event: inactivityWarnedEvent(),
+					}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			queue := mock.NewMockQueue(ctrl)
+			f, a, w := tt.test(ctrl, queries, queue)
+			stmt, err := newUserNotifier(t, ctrl, queries, f).reduceInactivityWarned(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			err = stmt.Execute(t.Context(), nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_userNotifier_reduceSessionRiskEvaluated(t *testing.T) {
+	riskEvaluatedEvent := func(outcome domain.RiskOutcome, signals ...domain.RiskSignal) eventstore.Event {
+		return &session.RiskEvaluatedEvent{
+			BaseEvent: *eventstore.BaseEventFromRepo(&repository.Event{
+				InstanceID:    instanceID,
+				AggregateID:   sessionID,
+				ResourceOwner: sql.NullString{String: instanceID},
+				CreationDate:  time.Now().UTC(),
+				Typ:           session.RiskEvaluatedType,
+			}),
+			UserID:            userID,
+			UserResourceOwner: orgID,
+			IP:                "1.2.3.4",
+			Location:          &domain.GeoLocation{Country: "CH"},
+			Signals:           signals,
+			Outcome:           outcome,
+		}
+	}
+	tests := []struct {
+		name string
+		test func(*gomock.Controller, *mock.MockQueries, *mock.MockQueue) (fields, args, want)
+	}{
+		{
+			name: "known device",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				w.noOperation = true
+				return fields{
+						queries: queries,
+						queue:   queue,
+					}, args{
+						event: riskEvaluatedEvent(domain.RiskOutcomeAllow),
+					}, w
+			},
+		},
+		{
+			name: "denied",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				w.noOperation = true
+				return fields{
+						queries: queries,
+						queue:   queue,
+					}, args{
+						event: riskEvaluatedEvent(domain.RiskOutcomeDeny, domain.RiskSignalNewDevice),
+					}, w
+			},
+		},
+		{
+			name: "already signed in before",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).
+								ExpectFilterEvents().
+								ExpectFilterEvents(&repository.Event{
+									InstanceID:    instanceID,
+									AggregateID:   sessionID,
+									ResourceOwner: sql.NullString{String: instanceID},
+									CreationDate:  time.Now().UTC(),
+									Typ:           session.RiskEvaluatedType,
+									Data:          []byte(`{"userID":"user1","signals":["new_device"],"outcome":1}`),
+								}).MockQuerier,
+						}),
+					}, args{
+						event: riskEvaluatedEvent(domain.RiskOutcomeAllow, domain.RiskSignalNewDevice),
+					}, w
+			},
+		},
+		{
+			name: "new device",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, queue *mock.MockQueue) (f fields, a args, w want) {
+				queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{
+					SecurityNotifications: true,
+				}, nil)
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), userID).Return(&query.NotifyUser{
+					ID:            userID,
+					VerifiedEmail: verifiedEmail,
+				}, nil)
+				device := "Firefox on Linux"
+				queries.EXPECT().SessionByID(gomock.Any(), gomock.Any(), sessionID, gomock.Any(), nil).Return(&query.Session{
+					ID:            sessionID,
+					ResourceOwner: instanceID,
+					UserAgent: domain.UserAgent{
+						Description: &device,
+					},
+				}, nil)
+				queries.EXPECT().SearchInstanceDomains(gomock.Any(), gomock.Any()).Return(&query.InstanceDomains{
+					Domains: []*query.InstanceDomain{{
+						Domain:    instancePrimaryDomain,
+						IsPrimary: true,
+					}},
+				}, nil)
+				queue.EXPECT().Insert(
+					gomock.Any(),
+					&notification.Request{
+						Aggregate: &eventstore.Aggregate{
+							ID:            sessionID,
+							InstanceID:    instanceID,
+							ResourceOwner: instanceID,
+						},
+						UserID:            userID,
+						UserResourceOwner: orgID,
+						TriggeredAtOrigin: fmt.Sprintf("%s://%s:%d", externalProtocol, instancePrimaryDomain, externalPort),
+						URLTemplate: fmt.Sprintf("%s://%s:%d/ui/console?login_hint={{.PreferredLoginName}}",
+							externalProtocol, instancePrimaryDomain, externalPort),
+						EventType:        session.RiskEvaluatedType,
+						NotificationType: domain.NotificationTypeEmail,
+						MessageType:      domain.NewDeviceSignInMessageType,
+						Args: &domain.NotificationArguments{
+							SessionID: sessionID,
+							IP:        "1.2.3.4",
+							Country:   "CH",
+							Device:    device,
+						},
+					},
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil)
+				return fields{
+						queries: queries,
+						queue:   queue,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).ExpectFilterEvents().ExpectFilterEvents().MockQuerier,
+						}),
+					}, args{
+						event: riskEvaluatedEvent(domain.RiskOutcomeAllow, domain.RiskSignalNewDevice),
+					}, w
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			queries := mock.NewMockQueries(ctrl)
+			queue := mock.NewMockQueue(ctrl)
+			f, a, w := tt.test(ctrl, queries, queue)
+			stmt, err := newUserNotifier(t, ctrl, queries, f).reduceSessionRiskEvaluated(a.event)
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if w.noOperation {
+				assert.Nil(t, stmt.Execute)
+				return
+			}
+			err = stmt.Execute(t.Context(), nil, "")
+			if w.err != nil {
+				w.err(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_userNotifier_reduceOTPEmailChallenged(t *testing.T) {
 	tests := []struct {
 		name string
@@ -1956,7 +2387,7 @@ func (c *notificationChannels) Email(context.Context) (*senders.Chain, *email.Co
 	return &c.Chain, c.EmailConfig, nil
 }
 
-func (c *notificationChannels) SMS(context.Context) (*senders.Chain, *sms.Config, error) {
+func (c *notificationChannels) SMS(context.Context, domain.PhoneChannel) (*senders.Chain, *sms.Config, error) {
 	return &c.Chain, c.SMSConfig, nil
 }
 
@@ -1979,7 +2410,8 @@ func expectTemplateQueries(queries *mock.MockQueries, template string) {
 		},
 	}, nil)
 	queries.EXPECT().MailTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.MailTemplate{Template: []byte(template)}, nil)
-	queries.EXPECT().GetDefaultLanguage(gomock.Any()).Return(language.English)
+	queries.EXPECT().GetDefaultLanguage(gomock.Any()).Times(2).Return(language.English)
+	queries.EXPECT().MailMessageTemplateByOrg(gomock.Any(), gomock.Any(), gomock.Any(), language.English).Return(nil, zerrors.ThrowNotFound(nil, "", ""))
 	queries.EXPECT().CustomTextListByTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(&query.CustomTexts{}, nil)
 }
 
@@ -2017,6 +2449,7 @@ func expectTemplateWithNotifyUserQueriesSMS(queries *mock.MockQueries) {
 	}, nil)
 	queries.EXPECT().GetDefaultLanguage(gomock.Any()).Return(language.English)
 	queries.EXPECT().CustomTextListByTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(&query.CustomTexts{}, nil)
+	queries.EXPECT().NotificationPolicyByOrg(gomock.Any(), gomock.Any(), orgID, gomock.Any()).Return(&query.NotificationPolicy{}, nil)
 }
 
 func cryptoValue(t *testing.T, ctrl *gomock.Controller, value string) (crypto.EncryptionAlgorithm, *crypto.CryptoValue) {
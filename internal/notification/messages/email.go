@@ -2,7 +2,9 @@ package messages
 
 import (
 	"fmt"
+	"io"
 	"mime"
+	"mime/multipart"
 	"regexp"
 	"strings"
 	"time"
@@ -27,6 +29,7 @@ type Email struct {
 	ReplyToAddress      string
 	Subject             string
 	Content             string
+	PlainTextContent    string
 	TriggeringEventType eventstore.EventType
 }
 
@@ -50,17 +53,31 @@ func (msg *Email) GetContent() (string, error) {
 		message += fmt.Sprintf("%s: %s"+lineBreak, k, v)
 	}
 
+	subject := "Subject: " + bEncodeWord(msg.Subject) + lineBreak
+	if msg.PlainTextContent != "" && isHTML(msg.Content) {
+		return message + subject + msg.multipartAlternative(), nil
+	}
+
 	//default mime-type is html
 	mime := "MIME-Version: 1.0" + lineBreak + "Content-Type: text/html; charset=\"UTF-8\"" + lineBreak + lineBreak
 	if !isHTML(msg.Content) {
 		mime = "MIME-Version: 1.0" + lineBreak + "Content-Type: text/plain; charset=\"UTF-8\"" + lineBreak + lineBreak
 	}
-	subject := "Subject: " + bEncodeWord(msg.Subject) + lineBreak
 	message += subject + mime + lineBreak + msg.Content
 
 	return message, nil
 }
 
+// multipartAlternative returns the MIME header and body containing the plain text and the HTML content,
+// mail clients show the last part they are able to display.
+func (msg *Email) multipartAlternative() string {
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+	return "MIME-Version: 1.0" + lineBreak + "Content-Type: multipart/alternative; boundary=\"" + boundary + "\"" + lineBreak + lineBreak + lineBreak +
+		"--" + boundary + lineBreak + "Content-Type: text/plain; charset=\"UTF-8\"" + lineBreak + lineBreak + msg.PlainTextContent + lineBreak +
+		"--" + boundary + lineBreak + "Content-Type: text/html; charset=\"UTF-8\"" + lineBreak + lineBreak + msg.Content + lineBreak +
+		"--" + boundary + "--"
+}
+
 func (msg *Email) GetTriggeringEventType() eventstore.EventType {
 	return msg.TriggeringEventType
 }
@@ -0,0 +1,82 @@
+package messages
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmail_GetContent(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     *Email
+		mediaType string
+		parts     map[string]string
+	}{
+		{
+			name: "html",
+			email: &Email{
+				Content: "<html><body>html</body></html>",
+			},
+			mediaType: "text/html",
+		},
+		{
+			name: "plain text",
+			email: &Email{
+				Content: "plain",
+			},
+			mediaType: "text/plain",
+		},
+		{
+			name: "html with plain text alternative",
+			email: &Email{
+				Content:          "<html><body>html</body></html>",
+				PlainTextContent: "plain",
+			},
+			mediaType: "multipart/alternative",
+			parts: map[string]string{
+				"text/plain": "plain",
+				"text/html":  "<html><body>html</body></html>",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.email.Recipients = []string{"user@example.com"}
+			tt.email.SenderEmail = "sender@example.com"
+			tt.email.Subject = "subject"
+			content, err := tt.email.GetContent()
+			require.NoError(t, err)
+
+			msg, err := mail.ReadMessage(strings.NewReader(content))
+			require.NoError(t, err)
+			mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+			require.NoError(t, err)
+			assert.Equal(t, tt.mediaType, mediaType)
+			if tt.parts == nil {
+				return
+			}
+			reader := multipart.NewReader(msg.Body, params["boundary"])
+			parts := make(map[string]string)
+			for {
+				part, err := reader.NextPart()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				partType, _, err := mime.ParseMediaType(part.Header.Get("Content-Type"))
+				require.NoError(t, err)
+				body, err := io.ReadAll(part)
+				require.NoError(t, err)
+				parts[partType] = string(body)
+			}
+			assert.Equal(t, tt.parts, parts)
+		})
+	}
+}
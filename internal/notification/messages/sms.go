@@ -12,6 +12,10 @@ type SMS struct {
 	RecipientPhoneNumber string
 	Content              string
 	TriggeringEventType  eventstore.EventType
+	// Code is passed separately for channels which only deliver the code, e.g. WhatsApp templates
+	Code string
+	// Language of the content
+	Language string
 
 	// VerificationID is set by the sender
 	VerificationID *string
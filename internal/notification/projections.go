@@ -27,10 +27,11 @@ var (
 
 func Register(
 	ctx context.Context,
-	userHandlerCustomConfig, quotaHandlerCustomConfig, telemetryHandlerCustomConfig, backChannelLogoutHandlerCustomConfig projection.CustomConfig,
+	userHandlerCustomConfig, quotaHandlerCustomConfig, telemetryHandlerCustomConfig, backChannelLogoutHandlerCustomConfig, pushHandlerCustomConfig projection.CustomConfig,
 	notificationWorkerConfig handlers.WorkerConfig,
 	backChannelLogoutWorkerConfig *handlers.BackChannelLogoutWorkerConfig,
 	telemetryCfg handlers.TelemetryPusherConfig,
+	pushCfg handlers.PushNotifierConfig,
 	externalDomain string,
 	externalPort uint16,
 	externalSecure bool,
@@ -74,6 +75,9 @@ func Register(
 	if telemetryCfg.Enabled {
 		projections = append(projections, handlers.NewTelemetryPusher(ctx, telemetryCfg, projection.ApplyCustomConfig(telemetryHandlerCustomConfig), commands, q, c))
 	}
+	if pushCfg.Endpoint != "" {
+		projections = append(projections, handlers.NewPushNotifier(ctx, pushCfg, projection.ApplyCustomConfig(pushHandlerCustomConfig), commands, q, c))
+	}
 	if !notificationWorkerConfig.LegacyEnabled {
 		queue.AddWorkers(ctx, handlers.NewNotificationWorker(notificationWorkerConfig, commands, q, c))
 	}
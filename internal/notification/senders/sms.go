@@ -12,10 +12,16 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/channels/log"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/voice"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/channels/whatsapp"
 )
 
-const twilioSpanName = "twilio.NotificationChannel"
+const (
+	twilioSpanName   = "twilio.NotificationChannel"
+	whatsAppSpanName = "whatsapp.NotificationChannel"
+	voiceSpanName    = "voice.NotificationChannel"
+)
 
 func SMSChannels(
 	ctx context.Context,
@@ -38,6 +44,30 @@ func SMSChannels(
 			),
 		)
 	}
+	if smsConfig.WhatsAppConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				whatsapp.InitChannel(ctx, *smsConfig.WhatsAppConfig),
+				whatsAppSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
+	if smsConfig.VoiceConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				voice.InitChannel(*smsConfig.VoiceConfig),
+				voiceSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
 	if smsConfig.WebhookConfig != nil {
 		webhookChannel, err := webhook.InitChannel(ctx, *smsConfig.WebhookConfig)
 		logging.WithFields(
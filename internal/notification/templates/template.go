@@ -5,6 +5,7 @@ import (
 	"html/template"
 	"io"
 	"net/http"
+	texttemplate "text/template"
 )
 
 const (
@@ -20,6 +21,20 @@ func GetParsedTemplate(mailhtml string, contentData interface{}) (string, error)
 	return ParseTemplateText(template, contentData)
 }
 
+// GetParsedPlainTextTemplate renders the plain text alternative of a mail,
+// the content is not escaped as it's not interpreted as HTML.
+func GetParsedPlainTextTemplate(text string, contentData interface{}) (string, error) {
+	tmpl, err := texttemplate.New("plaintext").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	buf := new(bytes.Buffer)
+	if err := tmpl.Execute(buf, contentData); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 func ParseTemplateFile(mailhtml string, data interface{}) (string, error) {
 	tmpl, err := template.New("tmpl").Parse(mailhtml)
 	if err != nil {
@@ -5,6 +5,8 @@ import (
 	"html"
 	"strings"
 
+	"golang.org/x/text/language"
+
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -16,6 +18,7 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/templates"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type Notify func(
@@ -27,15 +30,22 @@ type Notify func(
 
 type ChannelChains interface {
 	Email(context.Context) (*senders.Chain, *email.Config, error)
-	SMS(context.Context) (*senders.Chain, *sms.Config, error)
+	SMS(context.Context, domain.PhoneChannel) (*senders.Chain, *sms.Config, error)
 	Webhook(context.Context, webhook.Config) (*senders.Chain, error)
 	SecurityTokenEvent(context.Context, set.Config) (*senders.Chain, error)
 }
 
+// MailMessageTemplates resolves the templates overriding the mail layout of a message type.
+type MailMessageTemplates interface {
+	GetDefaultLanguage(ctx context.Context) language.Tag
+	MailMessageTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (*query.MailMessageTemplate, error)
+}
+
 func SendEmail(
 	ctx context.Context,
 	channels ChannelChains,
 	mailhtml string,
+	messageTemplates MailMessageTemplates,
 	translator *i18n.Translator,
 	user *query.NotifyUser,
 	colors *query.LabelPolicy,
@@ -47,14 +57,11 @@ func SendEmail(
 		messageType string,
 		allowUnverifiedNotificationChannel bool,
 	) error {
-		args = mapNotifyUserToArgs(user, args)
-		sanitizeArgsForHTML(args)
-		url, err := urlFromTemplate(urlTmpl, args)
+		messageTemplate, err := mailMessageTemplate(ctx, messageTemplates, user, messageType)
 		if err != nil {
 			return err
 		}
-		data := GetTemplateData(ctx, translator, args, url, messageType, user.PreferredLanguage.String(), colors)
-		template, err := templates.GetParsedTemplate(mailhtml, data)
+		email, err := renderEmail(ctx, mailhtml, messageTemplate, translator, user, colors, urlTmpl, args, messageType)
 		if err != nil {
 			return err
 		}
@@ -62,15 +69,86 @@ func SendEmail(
 			ctx,
 			channels,
 			user,
-			template,
-			data,
-			args,
+			email,
 			allowUnverifiedNotificationChannel,
 			triggeringEventType,
 		)
 	}
 }
 
+type renderedEmail struct {
+	data      templates.TemplateData
+	args      map[string]interface{}
+	html      string
+	plainText string
+}
+
+// renderEmail renders the HTML and, if the message template defines one, the plain text content of the mail.
+// The HTML of the message template takes precedence over the mailhtml of the mail template.
+func renderEmail(
+	ctx context.Context,
+	mailhtml string,
+	messageTemplate *query.MailMessageTemplate,
+	translator *i18n.Translator,
+	user *query.NotifyUser,
+	colors *query.LabelPolicy,
+	urlTmpl string,
+	args map[string]interface{},
+	messageType string,
+) (_ *renderedEmail, err error) {
+	args = mapNotifyUserToArgs(user, args)
+	email := new(renderedEmail)
+	if messageTemplate != nil && messageTemplate.HTML != "" {
+		mailhtml = messageTemplate.HTML
+	}
+	// the plain text must be rendered before the args are sanitized for HTML
+	if messageTemplate != nil && messageTemplate.PlainText != "" {
+		url, err := urlFromTemplate(urlTmpl, args)
+		if err != nil {
+			return nil, err
+		}
+		data := GetTemplateData(ctx, translator, args, url, messageType, user.PreferredLanguage.String(), colors)
+		email.plainText, err = templates.GetParsedPlainTextTemplate(messageTemplate.PlainText, data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	sanitizeArgsForHTML(args)
+	url, err := urlFromTemplate(urlTmpl, args)
+	if err != nil {
+		return nil, err
+	}
+	email.args = args
+	email.data = GetTemplateData(ctx, translator, args, url, messageType, user.PreferredLanguage.String(), colors)
+	email.html, err = templates.GetParsedTemplate(mailhtml, email.data)
+	if err != nil {
+		return nil, err
+	}
+	return email, nil
+}
+
+// mailMessageTemplate returns the template of the message type in the preferred language of the user
+// or in the default language of the instance.
+// If neither the organization nor the instance defines one, nil is returned.
+func mailMessageTemplate(ctx context.Context, messageTemplates MailMessageTemplates, user *query.NotifyUser, messageType string) (*query.MailMessageTemplate, error) {
+	defaultLanguage := messageTemplates.GetDefaultLanguage(ctx)
+	langs := []language.Tag{defaultLanguage}
+	if !user.PreferredLanguage.IsRoot() && user.PreferredLanguage != defaultLanguage {
+		langs = []language.Tag{user.PreferredLanguage, defaultLanguage}
+	}
+	for _, lang := range langs {
+		template, err := messageTemplates.MailMessageTemplateByOrg(ctx, user.ResourceOwner, messageType, lang)
+		if zerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return template, nil
+	}
+	return nil, nil
+}
+
 func sanitizeArgsForHTML(args map[string]any) {
 	for key, arg := range args {
 		switch a := arg.(type) {
@@ -106,6 +184,7 @@ func SendSMS(
 	instanceID string,
 	jobID string,
 	generatorInfo *senders.CodeGeneratorInfo,
+	phoneChannels []domain.PhoneChannel,
 ) Notify {
 	return func(
 		urlTmpl string,
@@ -130,6 +209,7 @@ func SendSMS(
 			instanceID,
 			jobID,
 			generatorInfo,
+			phoneChannels,
 		)
 	}
 }
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type mailMessageTemplates struct {
+	defaultLanguage language.Tag
+	templates       map[language.Tag]*query.MailMessageTemplate
+	err             error
+}
+
+func (m *mailMessageTemplates) GetDefaultLanguage(context.Context) language.Tag {
+	return m.defaultLanguage
+}
+
+func (m *mailMessageTemplates) MailMessageTemplateByOrg(_ context.Context, _, _ string, lang language.Tag) (*query.MailMessageTemplate, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	template, ok := m.templates[lang]
+	if !ok {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Eiph4", "Errors.MailMessageTemplate.NotFound")
+	}
+	return template, nil
+}
+
+func Test_mailMessageTemplate(t *testing.T) {
+	english := &query.MailMessageTemplate{Language: language.English, HTML: "<html>en</html>"}
+	german := &query.MailMessageTemplate{Language: language.German, HTML: "<html>de</html>"}
+	tests := []struct {
+		name      string
+		templates *mailMessageTemplates
+		user      *query.NotifyUser
+		want      *query.MailMessageTemplate
+		wantErr   error
+	}{
+		{
+			name: "preferred language",
+			templates: &mailMessageTemplates{
+				defaultLanguage: language.English,
+				templates:       map[language.Tag]*query.MailMessageTemplate{language.English: english, language.German: german},
+			},
+			user: &query.NotifyUser{PreferredLanguage: language.German},
+			want: german,
+		},
+		{
+			name: "fallback to default language",
+			templates: &mailMessageTemplates{
+				defaultLanguage: language.English,
+				templates:       map[language.Tag]*query.MailMessageTemplate{language.English: english},
+			},
+			user: &query.NotifyUser{PreferredLanguage: language.German},
+			want: english,
+		},
+		{
+			name: "undefined preferred language",
+			templates: &mailMessageTemplates{
+				defaultLanguage: language.English,
+				templates:       map[language.Tag]*query.MailMessageTemplate{language.English: english, language.German: german},
+			},
+			user: &query.NotifyUser{},
+			want: english,
+		},
+		{
+			name: "no template",
+			templates: &mailMessageTemplates{
+				defaultLanguage: language.English,
+			},
+			user: &query.NotifyUser{PreferredLanguage: language.German},
+		},
+		{
+			name: "error",
+			templates: &mailMessageTemplates{
+				defaultLanguage: language.English,
+				err:             zerrors.ThrowInternal(nil, "QUERY-Xee8o", "Errors.Internal"),
+			},
+			user:    &query.NotifyUser{PreferredLanguage: language.German},
+			wantErr: zerrors.ThrowInternal(nil, "QUERY-Xee8o", "Errors.Internal"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mailMessageTemplate(context.Background(), tt.templates, tt.user, domain.InitCodeMessageType)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_renderEmail(t *testing.T) {
+	user := &query.NotifyUser{
+		ID:            "user&1",
+		ResourceOwner: "org1",
+	}
+	tests := []struct {
+		name            string
+		messageTemplate *query.MailMessageTemplate
+		wantHTML        string
+		wantPlainText   string
+	}{
+		{
+			name:     "mail template",
+			wantHTML: "<html>https://example.com/user&amp;amp;1</html>",
+		},
+		{
+			name: "message template html",
+			messageTemplate: &query.MailMessageTemplate{
+				HTML: "<body>{{.URL}}</body>",
+			},
+			wantHTML: "<body>https://example.com/user&amp;amp;1</body>",
+		},
+		{
+			name: "message template plain text",
+			messageTemplate: &query.MailMessageTemplate{
+				PlainText: "{{.URL}}",
+			},
+			wantHTML:      "<html>https://example.com/user&amp;amp;1</html>",
+			wantPlainText: "https://example.com/user&1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := http_utils.WithDomainContext(context.Background(), &http_utils.DomainCtx{InstanceHost: "example.com", Protocol: "https"})
+			got, err := renderEmail(ctx,
+				"<html>{{.URL}}</html>",
+				tt.messageTemplate,
+				i18n.NewNotificationTranslator(language.English, nil),
+				user,
+				&query.LabelPolicy{},
+				"https://example.com/{{.UserID}}",
+				nil,
+				domain.InitCodeMessageType,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHTML, got.html)
+			assert.Equal(t, tt.wantPlainText, got.plainText)
+		})
+	}
+}
@@ -18,6 +18,12 @@ func (notify Notify) SendOTPEmailCode(ctx context.Context, url, code string, exp
 	return notify(url, args, domain.VerifyEmailOTPMessageType, false)
 }
 
+func (notify Notify) SendMagicLink(ctx context.Context, url, code string, expiry time.Duration) error {
+	args := otpArgs(ctx, code, expiry)
+	args["Code"] = code
+	return notify(url, args, domain.MagicLinkMessageType, false)
+}
+
 func otpArgs(ctx context.Context, code string, expiry time.Duration) map[string]interface{} {
 	domainCtx := http_utils.DomainContext(ctx)
 	args := make(map[string]interface{})
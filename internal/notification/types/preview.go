@@ -0,0 +1,102 @@
+package types
+
+import (
+	"context"
+	"html"
+	"time"
+
+	"golang.org/x/text/language"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	previewCode   = "ABC123"
+	previewExpiry = time.Hour
+)
+
+type PreviewQueries interface {
+	TranslatorQueries
+	MailMessageTemplates
+	ActiveLabelPolicyByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.LabelPolicy, error)
+	MailTemplateByOrg(ctx context.Context, orgID string, withOwnerRemoved bool) (*query.MailTemplate, error)
+}
+
+// Preview is the mail of a message type as it would be sent to the user.
+type Preview struct {
+	Subject   string
+	HTML      string
+	PlainText string
+}
+
+// PreviewEmail renders the mail of the message type for the user with sample arguments (e.g. a code),
+// so the layout and texts can be reviewed without triggering the actual flow.
+// If draft is set, it's rendered instead of the message template of the organization.
+func PreviewEmail(ctx context.Context, queries PreviewQueries, user *query.NotifyUser, messageType string, draft *query.MailMessageTemplate) (*Preview, error) {
+	colors, err := queries.ActiveLabelPolicyByOrg(ctx, user.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	template, err := queries.MailTemplateByOrg(ctx, user.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+	translator, err := NewTranslatorWithOrgTexts(ctx, queries, user.ResourceOwner, messageType)
+	if err != nil {
+		return nil, err
+	}
+	messageTemplate := draft
+	if messageTemplate == nil {
+		messageTemplate, err = mailMessageTemplate(ctx, queries, user, messageType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	origin := http_utils.DomainContext(ctx).Origin()
+	args := previewArgs(origin).ToMap()
+	args["Code"] = previewCode
+	email, err := renderEmail(ctx, string(template.Template), messageTemplate, translator, user, colors, console.LoginHintLink(origin, user.PreferredLoginName), args, messageType)
+	if err != nil {
+		return nil, err
+	}
+	return &Preview{
+		Subject:   email.data.Subject,
+		HTML:      html.UnescapeString(email.html),
+		PlainText: email.plainText,
+	}, nil
+}
+
+// PreviewUser is the recipient of a preview, if no existing user is selected.
+func PreviewUser(orgID string, lang language.Tag) *query.NotifyUser {
+	return &query.NotifyUser{
+		ID:                 "preview",
+		ResourceOwner:      orgID,
+		Username:           "jane.doe",
+		LoginNames:         []string{"jane.doe@example.com"},
+		PreferredLoginName: "jane.doe@example.com",
+		FirstName:          "Jane",
+		LastName:           "Doe",
+		DisplayName:        "Jane Doe",
+		PreferredLanguage:  lang,
+		LastEmail:          "jane.doe@example.com",
+		VerifiedEmail:      "jane.doe@example.com",
+		CreationDate:       time.Now(),
+		ChangeDate:         time.Now(),
+	}
+}
+
+func previewArgs(origin string) *domain.NotificationArguments {
+	return &domain.NotificationArguments{
+		Origin:          origin,
+		Domain:          "example.com",
+		Expiry:          previewExpiry,
+		TempUsername:    "jane.doe@example.com",
+		ApplicationName: "Example App",
+		IP:              "192.0.2.1",
+		Country:         "CH",
+		Device:          "Firefox on macOS",
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"context"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// SendSecurityNotification alerts the user about a sensitive change of the account.
+// The notification is only sent to the verified email, so it reaches the user even if the email was changed.
+func (notify Notify) SendSecurityNotification(ctx context.Context, user *query.NotifyUser, messageType string, args *domain.NotificationArguments) error {
+	url := console.LoginHintLink(http_utils.DomainContext(ctx).Origin(), user.PreferredLoginName)
+	return notify(url, args.ToMap(), messageType, false)
+}
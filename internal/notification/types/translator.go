@@ -0,0 +1,50 @@
+package types
+
+import (
+	"context"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/i18n"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type TranslatorQueries interface {
+	GetInstanceRestrictions(ctx context.Context) (restrictions query.Restrictions, err error)
+	GetDefaultLanguage(ctx context.Context) language.Tag
+	CustomTextListByTemplate(ctx context.Context, aggregateID, template string, withOwnerRemoved bool) (*query.CustomTexts, error)
+}
+
+// NewTranslatorWithOrgTexts returns a translator of the notification texts,
+// overwritten by the custom texts of the instance and the organization.
+func NewTranslatorWithOrgTexts(ctx context.Context, queries TranslatorQueries, orgID, textType string) (*i18n.Translator, error) {
+	restrictions, err := queries.GetInstanceRestrictions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	translator := i18n.NewNotificationTranslator(queries.GetDefaultLanguage(ctx), restrictions.AllowedLanguages)
+
+	allCustomTexts, err := queries.CustomTextListByTemplate(ctx, authz.GetInstance(ctx).InstanceID(), textType, false)
+	if err != nil {
+		return translator, nil
+	}
+	customTexts, err := queries.CustomTextListByTemplate(ctx, orgID, textType, false)
+	if err != nil {
+		return translator, nil
+	}
+	allCustomTexts.CustomTexts = append(allCustomTexts.CustomTexts, customTexts.CustomTexts...)
+
+	for _, text := range allCustomTexts.CustomTexts {
+		msg := i18n.Message{
+			ID:   text.Template + "." + text.Key,
+			Text: text.Text,
+		}
+		err = translator.AddMessages(text.Language, msg)
+		logging.WithFields("instanceID", authz.GetInstance(ctx).InstanceID(), "orgID", orgID, "messageType", textType, "messageID", msg.ID).
+			OnError(err).
+			Warn("could not add translation message")
+	}
+	return translator, nil
+}
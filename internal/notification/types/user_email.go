@@ -10,7 +10,6 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	zchannels "github.com/zitadel/zitadel/internal/notification/channels"
 	"github.com/zitadel/zitadel/internal/notification/messages"
-	"github.com/zitadel/zitadel/internal/notification/templates"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -19,9 +18,7 @@ func generateEmail(
 	ctx context.Context,
 	channels ChannelChains,
 	user *query.NotifyUser,
-	template string,
-	data templates.TemplateData,
-	args map[string]interface{},
+	email *renderedEmail,
 	lastEmail bool,
 	triggeringEventType eventstore.EventType,
 ) error {
@@ -39,15 +36,16 @@ func generateEmail(
 	if config.SMTPConfig != nil {
 		message := &messages.Email{
 			Recipients:          []string{recipient},
-			Subject:             data.Subject,
-			Content:             html.UnescapeString(template),
+			Subject:             email.data.Subject,
+			Content:             html.UnescapeString(email.html),
+			PlainTextContent:    email.plainText,
 			TriggeringEventType: triggeringEventType,
 		}
 		return emailChannels.HandleMessage(message)
 	}
 	if config.WebhookConfig != nil {
-		caseArgs := make(map[string]interface{}, len(args))
-		for k, v := range args {
+		caseArgs := make(map[string]interface{}, len(email.args))
+		for k, v := range email.args {
 			caseArgs[strings.ToLower(string(k[0]))+k[1:]] = v
 		}
 		contextInfo := map[string]interface{}{
@@ -59,8 +57,9 @@ func generateEmail(
 		message := &messages.JSON{
 			Serializable: &serializableData{
 				ContextInfo:  contextInfo,
-				TemplateData: data,
+				TemplateData: email.data,
 				Args:         caseArgs,
+				PlainText:    email.plainText,
 			},
 			TriggeringEventType: triggeringEventType,
 		}
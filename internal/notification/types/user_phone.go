@@ -6,6 +6,7 @@ import (
 
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	zchannels "github.com/zitadel/zitadel/internal/notification/channels"
 	"github.com/zitadel/zitadel/internal/notification/messages"
@@ -19,8 +20,11 @@ type serializableData struct {
 	ContextInfo  map[string]interface{} `json:"contextInfo,omitempty"`
 	TemplateData templates.TemplateData `json:"templateData,omitempty"`
 	Args         map[string]interface{} `json:"args,omitempty"`
+	PlainText    string                 `json:"plainText,omitempty"`
 }
 
+// generateSms delivers the message over the phone channels in the given order
+// and falls back to the next channel if the delivery over a channel fails.
 func generateSms(
 	ctx context.Context,
 	channels ChannelChains,
@@ -32,8 +36,41 @@ func generateSms(
 	instanceID string,
 	jobID string,
 	generatorInfo *senders.CodeGeneratorInfo,
+	phoneChannels []domain.PhoneChannel,
+) (err error) {
+	if len(phoneChannels) == 0 {
+		phoneChannels = []domain.PhoneChannel{domain.PhoneChannelSMS}
+	}
+	for i, phoneChannel := range phoneChannels {
+		err = generatePhoneMessage(ctx, channels, phoneChannel, user, data, args, lastPhone, triggeringEventType, instanceID, jobID, generatorInfo)
+		if err == nil || i == len(phoneChannels)-1 {
+			return err
+		}
+		logging.WithFields(
+			"instanceID", instanceID,
+			"jobID", jobID,
+			"userID", user.ID,
+			"channel", phoneChannel,
+			"fallback", phoneChannels[i+1],
+		).WithError(err).Info("phone notification not delivered, trying fallback channel")
+	}
+	return err
+}
+
+func generatePhoneMessage(
+	ctx context.Context,
+	channels ChannelChains,
+	phoneChannel domain.PhoneChannel,
+	user *query.NotifyUser,
+	data templates.TemplateData,
+	args map[string]interface{},
+	lastPhone bool,
+	triggeringEventType eventstore.EventType,
+	instanceID string,
+	jobID string,
+	generatorInfo *senders.CodeGeneratorInfo,
 ) error {
-	smsChannels, config, err := channels.SMS(ctx)
+	smsChannels, config, err := channels.SMS(ctx, phoneChannel)
 	logging.OnError(err).Error("could not create sms channel")
 	if smsChannels == nil || smsChannels.Len() == 0 {
 		return zchannels.NewCancelError(
@@ -68,6 +105,24 @@ func generateSms(
 		}
 		return nil
 	}
+	if config.WhatsAppConfig != nil || config.VoiceConfig != nil {
+		number := ""
+		if config.VoiceConfig != nil {
+			number = config.VoiceConfig.SenderNumber
+		}
+		code, _ := args["Code"].(string)
+		return smsChannels.HandleMessage(&messages.SMS{
+			SenderPhoneNumber:    number,
+			RecipientPhoneNumber: recipient,
+			Content:              data.Text,
+			TriggeringEventType:  triggeringEventType,
+			Code:                 code,
+			Language:             user.PreferredLanguage.String(),
+			InstanceID:           instanceID,
+			JobID:                jobID,
+			UserID:               user.ID,
+		})
+	}
 	if config.WebhookConfig != nil {
 		caseArgs := make(map[string]interface{}, len(args))
 		for k, v := range args {
@@ -0,0 +1,172 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/set"
+	"github.com/zitadel/zitadel/internal/notification/channels/sms"
+	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/voice"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/channels/whatsapp"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/notification/templates"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+// phoneChannels delivers messages of the configured phone channels and records the channels used.
+type phoneChannels struct {
+	configs map[domain.PhoneChannel]*sms.Config
+	errs    map[domain.PhoneChannel]error
+	sent    []domain.PhoneChannel
+	message *messages.SMS
+}
+
+func (c *phoneChannels) Email(context.Context) (*senders.Chain, *email.Config, error) {
+	return nil, nil, errors.New("not implemented")
+}
+
+func (c *phoneChannels) SMS(_ context.Context, channel domain.PhoneChannel) (*senders.Chain, *sms.Config, error) {
+	config, ok := c.configs[channel]
+	if !ok {
+		return nil, nil, errors.New("not configured")
+	}
+	return senders.ChainChannels(channels.HandleMessageFunc(func(message channels.Message) error {
+		c.sent = append(c.sent, channel)
+		c.message = message.(*messages.SMS)
+		return c.errs[channel]
+	})), config, nil
+}
+
+func (c *phoneChannels) Webhook(context.Context, webhook.Config) (*senders.Chain, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *phoneChannels) SecurityTokenEvent(context.Context, set.Config) (*senders.Chain, error) {
+	return nil, errors.New("not implemented")
+}
+
+func Test_generateSms(t *testing.T) {
+	smsConfig := &sms.Config{Channel: domain.PhoneChannelSMS, TwilioConfig: &twilio.Config{SenderNumber: "+41000000000"}}
+	whatsAppConfig := &sms.Config{Channel: domain.PhoneChannelWhatsApp, WhatsAppConfig: &whatsapp.Config{}}
+	voiceConfig := &sms.Config{Channel: domain.PhoneChannelVoice, VoiceConfig: &voice.Config{SenderNumber: "+41000000001"}}
+	errSend := errors.New("send failed")
+
+	tests := []struct {
+		name        string
+		channels    *phoneChannels
+		order       []domain.PhoneChannel
+		wantSent    []domain.PhoneChannel
+		wantMessage *messages.SMS
+		wantErr     error
+	}{
+		{
+			name:     "no order, sms",
+			channels: &phoneChannels{configs: map[domain.PhoneChannel]*sms.Config{domain.PhoneChannelSMS: smsConfig}},
+			wantSent: []domain.PhoneChannel{domain.PhoneChannelSMS},
+			wantMessage: &messages.SMS{
+				SenderPhoneNumber:    "+41000000000",
+				RecipientPhoneNumber: "+41791234567",
+				Content:              "Your code is 123456",
+				UserID:               "user1",
+			},
+		},
+		{
+			name: "preferred whatsapp",
+			channels: &phoneChannels{configs: map[domain.PhoneChannel]*sms.Config{
+				domain.PhoneChannelSMS:      smsConfig,
+				domain.PhoneChannelWhatsApp: whatsAppConfig,
+			}},
+			order:    []domain.PhoneChannel{domain.PhoneChannelWhatsApp, domain.PhoneChannelSMS},
+			wantSent: []domain.PhoneChannel{domain.PhoneChannelWhatsApp},
+			wantMessage: &messages.SMS{
+				RecipientPhoneNumber: "+41791234567",
+				Content:              "Your code is 123456",
+				Code:                 "123456",
+				Language:             "de",
+				UserID:               "user1",
+			},
+		},
+		{
+			name: "whatsapp not configured, fallback to voice",
+			channels: &phoneChannels{configs: map[domain.PhoneChannel]*sms.Config{
+				domain.PhoneChannelVoice: voiceConfig,
+			}},
+			order:    []domain.PhoneChannel{domain.PhoneChannelWhatsApp, domain.PhoneChannelVoice},
+			wantSent: []domain.PhoneChannel{domain.PhoneChannelVoice},
+			wantMessage: &messages.SMS{
+				SenderPhoneNumber:    "+41000000001",
+				RecipientPhoneNumber: "+41791234567",
+				Content:              "Your code is 123456",
+				Code:                 "123456",
+				Language:             "de",
+				UserID:               "user1",
+			},
+		},
+		{
+			name: "whatsapp fails, fallback to sms",
+			channels: &phoneChannels{
+				configs: map[domain.PhoneChannel]*sms.Config{
+					domain.PhoneChannelSMS:      smsConfig,
+					domain.PhoneChannelWhatsApp: whatsAppConfig,
+				},
+				errs: map[domain.PhoneChannel]error{domain.PhoneChannelWhatsApp: channels.NewCancelError(errSend)},
+			},
+			order:    []domain.PhoneChannel{domain.PhoneChannelWhatsApp, domain.PhoneChannelSMS},
+			wantSent: []domain.PhoneChannel{domain.PhoneChannelWhatsApp, domain.PhoneChannelSMS},
+			wantMessage: &messages.SMS{
+				SenderPhoneNumber:    "+41000000000",
+				RecipientPhoneNumber: "+41791234567",
+				Content:              "Your code is 123456",
+				UserID:               "user1",
+			},
+		},
+		{
+			name: "all channels fail, last error",
+			channels: &phoneChannels{
+				configs: map[domain.PhoneChannel]*sms.Config{
+					domain.PhoneChannelSMS:      smsConfig,
+					domain.PhoneChannelWhatsApp: whatsAppConfig,
+				},
+				errs: map[domain.PhoneChannel]error{
+					domain.PhoneChannelWhatsApp: channels.NewCancelError(errors.New("other")),
+					domain.PhoneChannelSMS:      errSend,
+				},
+			},
+			order:    []domain.PhoneChannel{domain.PhoneChannelWhatsApp, domain.PhoneChannelSMS},
+			wantSent: []domain.PhoneChannel{domain.PhoneChannelWhatsApp, domain.PhoneChannelSMS},
+			wantErr:  errSend,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := generateSms(
+				context.Background(),
+				tt.channels,
+				&query.NotifyUser{ID: "user1", VerifiedPhone: "+41791234567", PreferredLanguage: language.German},
+				templates.TemplateData{Text: "Your code is 123456"},
+				map[string]interface{}{"Code": "123456"},
+				false,
+				"",
+				"",
+				"",
+				new(senders.CodeGeneratorInfo),
+				tt.order,
+			)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantSent, tt.channels.sent)
+			if tt.wantMessage != nil {
+				assert.Equal(t, tt.wantMessage, tt.channels.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Package personaldata implements the crypto-shredding of personal data stored in events.
+//
+// Each aggregate containing personal data gets its own data encryption key,
+// which is stored encrypted by the configured [crypto.EncryptionAlgorithm].
+// The keys are created and destroyed by the eventstore in the push transaction.
+// Destroying the key makes the personal data of all events of the aggregate unreadable.
+//
+// The keys are not cached beyond a single push or filter of the eventstore,
+// so destroyed keys are unusable on all instances as soon as the transaction is committed.
+package personaldata
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	//go:embed get_keys.sql
+	getKeysStmt string
+)
+
+var _ eventstore.PersonalDataCrypter = (*Crypter)(nil)
+
+// Crypter implements [eventstore.PersonalDataCrypter]
+type Crypter struct {
+	client      *database.DB
+	alg         crypto.EncryptionAlgorithm
+	idGenerator id.Generator
+}
+
+func NewCrypter(client *database.DB, alg crypto.EncryptionAlgorithm) *Crypter {
+	return &Crypter{
+		client:      client,
+		alg:         alg,
+		idGenerator: id.SonyFlakeGenerator(),
+	}
+}
+
+type storedKey struct {
+	instanceID    string
+	aggregateType string
+	aggregateID   string
+	keyID         string
+	wrapped       crypto.CryptoValue
+}
+
+// Keys implements [eventstore.PersonalDataCrypter]
+func (c *Crypter) Keys(ctx context.Context, aggregates []*eventstore.Aggregate) ([]*eventstore.PersonalDataKey, error) {
+	instanceIDs := make(database.TextArray[string], len(aggregates))
+	aggregateTypes := make(database.TextArray[string], len(aggregates))
+	aggregateIDs := make(database.TextArray[string], len(aggregates))
+	for i, aggregate := range aggregates {
+		instanceIDs[i] = aggregate.InstanceID
+		aggregateTypes[i] = string(aggregate.Type)
+		aggregateIDs[i] = aggregate.ID
+	}
+	var stored []*storedKey
+	err := c.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			key := new(storedKey)
+			if err := rows.Scan(&key.instanceID, &key.aggregateType, &key.aggregateID, &key.keyID, &key.wrapped); err != nil {
+				return err
+			}
+			stored = append(stored, key)
+		}
+		return nil
+	}, getKeysStmt, instanceIDs, aggregateTypes, aggregateIDs)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PERSO-Tho2u", "Errors.Internal")
+	}
+	keys := make([]*eventstore.PersonalDataKey, len(aggregates))
+	for _, key := range stored {
+		value, err := crypto.Decrypt(&key.wrapped, c.alg)
+		if err != nil {
+			return nil, err
+		}
+		for i, aggregate := range aggregates {
+			if aggregate.InstanceID == key.instanceID && string(aggregate.Type) == key.aggregateType && aggregate.ID == key.aggregateID {
+				keys[i] = &eventstore.PersonalDataKey{
+					ID:    key.keyID,
+					Value: string(value),
+				}
+			}
+		}
+	}
+	return keys, nil
+}
+
+// NewKey implements [eventstore.PersonalDataCrypter]
+func (c *Crypter) NewKey(context.Context, *eventstore.Aggregate) (*eventstore.PersonalDataKey, error) {
+	keyID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	newKey, err := crypto.NewKey(keyID)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PERSO-ahV5o", "Errors.Internal")
+	}
+	wrapped, err := crypto.Crypt([]byte(newKey.Value), c.alg)
+	if err != nil {
+		return nil, err
+	}
+	crypted, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PERSO-Ou3ei", "Errors.Internal")
+	}
+	return &eventstore.PersonalDataKey{
+		ID:      keyID,
+		Value:   newKey.Value,
+		Crypted: crypted,
+	}, nil
+}
+
+// Encrypt implements [eventstore.PersonalDataCrypter]
+func (c *Crypter) Encrypt(key *eventstore.PersonalDataKey, data []byte) ([]byte, error) {
+	crypted, err := crypto.EncryptAES(data, key.Value)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PERSO-Ahx7e", "Errors.Internal")
+	}
+	return crypted, nil
+}
+
+// Decrypt implements [eventstore.PersonalDataCrypter]
+func (c *Crypter) Decrypt(key *eventstore.PersonalDataKey, crypted []byte) ([]byte, error) {
+	data, err := crypto.DecryptAES(crypted, key.Value)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PERSO-uu4Oo", "Errors.Internal")
+	}
+	return data, nil
+}
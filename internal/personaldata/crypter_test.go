@@ -0,0 +1,124 @@
+package personaldata
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+)
+
+var (
+	testAggregate = &eventstore.Aggregate{
+		InstanceID: "instance",
+		Type:       "user",
+		ID:         "user1",
+	}
+	otherAggregate = &eventstore.Aggregate{
+		InstanceID: "instance",
+		Type:       "user",
+		ID:         "user2",
+	}
+	testKey = []byte("01234567890123456789012345678901")
+)
+
+func newTestCrypter(t *testing.T, idGenerator id.Generator, expectations ...func(sqlmock.Sqlmock)) *Crypter {
+	client, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+		client.Close()
+	})
+	for _, expectation := range expectations {
+		expectation(mock)
+	}
+	return &Crypter{
+		client:      &database.DB{DB: client},
+		alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+		idGenerator: idGenerator,
+	}
+}
+
+func expectGetKeys(keyIDs map[string]string) func(sqlmock.Sqlmock) {
+	return func(m sqlmock.Sqlmock) {
+		wrapped, _ := json.Marshal(&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    testKey,
+		})
+		rows := sqlmock.NewRows([]string{"instance_id", "aggregate_type", "aggregate_id", "key_id", "key"})
+		for aggregateID, keyID := range keyIDs {
+			rows.AddRow("instance", "user", aggregateID, keyID, wrapped)
+		}
+		m.ExpectQuery(getKeysStmt).
+			WithArgs(
+				database.TextArray[string]{"instance", "instance"},
+				database.TextArray[string]{"user", "user"},
+				database.TextArray[string]{"user1", "user2"},
+			).
+			WillReturnRows(rows)
+	}
+}
+
+func TestCrypter_Keys(t *testing.T) {
+	t.Run("stored", func(t *testing.T) {
+		c := newTestCrypter(t, id_mock.NewMockGenerator(gomock.NewController(t)), expectGetKeys(map[string]string{"user1": "key1", "user2": "key2"}))
+
+		keys, err := c.Keys(context.Background(), []*eventstore.Aggregate{testAggregate, otherAggregate})
+		require.NoError(t, err)
+		assert.Equal(t, []*eventstore.PersonalDataKey{
+			{ID: "key1", Value: string(testKey)},
+			{ID: "key2", Value: string(testKey)},
+		}, keys)
+	})
+	t.Run("destroyed", func(t *testing.T) {
+		c := newTestCrypter(t, id_mock.NewMockGenerator(gomock.NewController(t)), expectGetKeys(map[string]string{"user2": "key2"}))
+
+		keys, err := c.Keys(context.Background(), []*eventstore.Aggregate{testAggregate, otherAggregate})
+		require.NoError(t, err)
+		assert.Equal(t, []*eventstore.PersonalDataKey{
+			nil,
+			{ID: "key2", Value: string(testKey)},
+		}, keys)
+	})
+}
+
+func TestCrypter_NewKey(t *testing.T) {
+	// the key is stored by the eventstore in the push transaction
+	c := newTestCrypter(t, id_mock.NewIDGeneratorExpectIDs(t, "key2"))
+
+	key, err := c.NewKey(context.Background(), testAggregate)
+	require.NoError(t, err)
+	assert.Equal(t, "key2", key.ID)
+	assert.NotEmpty(t, key.Value)
+	wrapped := new(crypto.CryptoValue)
+	require.NoError(t, json.Unmarshal(key.Crypted, wrapped))
+	assert.Equal(t, key.Value, string(wrapped.Crypted))
+}
+
+func TestCrypter_EncryptDecrypt(t *testing.T) {
+	c := newTestCrypter(t, id_mock.NewMockGenerator(gomock.NewController(t)))
+	key := &eventstore.PersonalDataKey{ID: "key1", Value: string(testKey)}
+
+	crypted, err := c.Encrypt(key, []byte(`{"firstName":"first"}`))
+	require.NoError(t, err)
+	assert.NotContains(t, string(crypted), "first")
+
+	data, err := c.Decrypt(key, crypted)
+	require.NoError(t, err)
+	assert.Equal(t, `{"firstName":"first"}`, string(data))
+
+	data, err = c.Decrypt(&eventstore.PersonalDataKey{ID: "key2", Value: "98765432109876543210987654321098"}, crypted)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "first")
+}
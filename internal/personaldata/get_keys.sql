@@ -0,0 +1,11 @@
+SELECT
+    k.instance_id
+    , k.aggregate_type
+    , k.aggregate_id
+    , k.key_id
+    , k.key
+FROM
+    eventstore.personal_data_keys k
+JOIN
+    UNNEST($1::TEXT[], $2::TEXT[], $3::TEXT[]) AS a(instance_id, aggregate_type, aggregate_id)
+    USING (instance_id, aggregate_type, aggregate_id)
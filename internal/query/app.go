@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"slices"
 	"time"
@@ -68,6 +69,9 @@ type OIDCApp struct {
 	IOSBundleID                   string
 	AndroidPackageName            string
 	AndroidSHA256CertFingerprints database.TextArray[string]
+	AuthorizationDetailsTypes     database.TextArray[string]
+	RequireConsent                bool
+	TokenExchangePolicy           *domain.TokenExchangePolicy
 }
 
 type SAMLApp struct {
@@ -298,6 +302,18 @@ var (
 		name:  projection.AppOIDCConfigColumnAndroidSHA256CertFingerprints,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnAuthorizationDetailsTypes = Column{
+		name:  projection.AppOIDCConfigColumnAuthorizationDetailsTypes,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequireConsent = Column{
+		name:  projection.AppOIDCConfigColumnRequireConsent,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnTokenExchangePolicy = Column{
+		name:  projection.AppOIDCConfigColumnTokenExchangePolicy,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string) (app *App, err error) {
@@ -413,6 +429,7 @@ func (q *Queries) CheckProjectPermissionByClientID(ctx context.Context, clientID
 		domain.UserStateActive,
 		domain.ProjectGrantStateActive,
 		domain.UserGrantStateActive,
+		domain.OrgHierarchyMaxDepth,
 	)
 	return p, err
 }
@@ -437,6 +454,7 @@ func (q *Queries) CheckProjectPermissionByEntityID(ctx context.Context, entityID
 		domain.UserStateActive,
 		domain.ProjectGrantStateActive,
 		domain.UserGrantStateActive,
+		domain.OrgHierarchyMaxDepth,
 	)
 	return p, err
 }
@@ -749,6 +767,9 @@ func prepareAppQuery(activeOnly bool) (sq.SelectBuilder, func(*sql.Row) (*App, e
 		AppOIDCConfigColumnIOSBundleID.identifier(),
 		AppOIDCConfigColumnAndroidPackageName.identifier(),
 		AppOIDCConfigColumnAndroidSHA256CertFingerprints.identifier(),
+		AppOIDCConfigColumnAuthorizationDetailsTypes.identifier(),
+		AppOIDCConfigColumnRequireConsent.identifier(),
+		AppOIDCConfigColumnTokenExchangePolicy.identifier(),
 
 		AppSAMLConfigColumnAppID.identifier(),
 		AppSAMLConfigColumnEntityID.identifier(),
@@ -822,6 +843,9 @@ func scanApp(row *sql.Row) (*App, error) {
 		&oidcConfig.iosBundleID,
 		&oidcConfig.androidPackageName,
 		&oidcConfig.androidSHA256CertFingerprints,
+		&oidcConfig.authorizationDetailsTypes,
+		&oidcConfig.requireConsent,
+		&oidcConfig.tokenExchangePolicy,
 
 		&samlConfig.appID,
 		&samlConfig.entityID,
@@ -880,6 +904,9 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 			AppOIDCConfigColumnIOSBundleID.identifier(),
 			AppOIDCConfigColumnAndroidPackageName.identifier(),
 			AppOIDCConfigColumnAndroidSHA256CertFingerprints.identifier(),
+			AppOIDCConfigColumnAuthorizationDetailsTypes.identifier(),
+			AppOIDCConfigColumnRequireConsent.identifier(),
+			AppOIDCConfigColumnTokenExchangePolicy.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppOIDCConfigColumnAppID, AppColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*App, error) {
@@ -923,6 +950,9 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 				&oidcConfig.iosBundleID,
 				&oidcConfig.androidPackageName,
 				&oidcConfig.androidSHA256CertFingerprints,
+				&oidcConfig.authorizationDetailsTypes,
+				&oidcConfig.requireConsent,
+				&oidcConfig.tokenExchangePolicy,
 			)
 
 			if err != nil {
@@ -1044,6 +1074,9 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 			AppOIDCConfigColumnIOSBundleID.identifier(),
 			AppOIDCConfigColumnAndroidPackageName.identifier(),
 			AppOIDCConfigColumnAndroidSHA256CertFingerprints.identifier(),
+			AppOIDCConfigColumnAuthorizationDetailsTypes.identifier(),
+			AppOIDCConfigColumnRequireConsent.identifier(),
+			AppOIDCConfigColumnTokenExchangePolicy.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -1105,6 +1138,9 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 					&oidcConfig.iosBundleID,
 					&oidcConfig.androidPackageName,
 					&oidcConfig.androidSHA256CertFingerprints,
+					&oidcConfig.authorizationDetailsTypes,
+					&oidcConfig.requireConsent,
+					&oidcConfig.tokenExchangePolicy,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
@@ -1216,6 +1252,9 @@ type sqlOIDCConfig struct {
 	iosBundleID                   sql.NullString
 	androidPackageName            sql.NullString
 	androidSHA256CertFingerprints database.TextArray[string]
+	authorizationDetailsTypes     database.TextArray[string]
+	requireConsent                sql.NullBool
+	tokenExchangePolicy           []byte
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -1245,6 +1284,8 @@ func (c sqlOIDCConfig) set(app *App) {
 		IOSBundleID:                   c.iosBundleID.String,
 		AndroidPackageName:            c.androidPackageName.String,
 		AndroidSHA256CertFingerprints: c.androidSHA256CertFingerprints,
+		AuthorizationDetailsTypes:     c.authorizationDetailsTypes,
+		RequireConsent:                c.requireConsent.Bool,
 	}
 	if c.loginBaseURI.Valid {
 		app.OIDCConfig.LoginBaseURI = &c.loginBaseURI.String
@@ -1253,6 +1294,11 @@ func (c sqlOIDCConfig) set(app *App) {
 	app.OIDCConfig.ComplianceProblems = compliance.Problems
 
 	var err error
+	if len(c.tokenExchangePolicy) > 0 {
+		app.OIDCConfig.TokenExchangePolicy = new(domain.TokenExchangePolicy)
+		err = json.Unmarshal(c.tokenExchangePolicy, app.OIDCConfig.TokenExchangePolicy)
+		logging.LogWithFields("app", app.ID).OnError(err).Warn("unable to unmarshal token exchange policy")
+	}
 	app.OIDCConfig.AllowedOrigins, err = domain.OIDCOriginAllowList(app.OIDCConfig.RedirectURIs, app.OIDCConfig.AdditionalOrigins)
 	logging.LogWithFields("app", app.ID).OnError(err).Warn("unable to set allowed origins")
 }
@@ -1,4 +1,4 @@
-with application as (
+with recursive application as (
     SELECT a.instance_id,
            a.resource_owner,
            a.project_id,
@@ -26,17 +26,38 @@ with application as (
      WHERE u.instance_id = $1
        AND u.id = $5
        AND u.state = $6
+), user_org_hierarchy (instance_id, org_id, parent_id, depth) as (
+/* organization of the user and its parents, limited to the maximum depth of the hierarchy */
+     SELECT o.instance_id,
+            o.id,
+            o.parent_id,
+            0
+     FROM projections.orgs1 as o
+          INNER JOIN user_resourceowner as uro
+                     ON uro.instance_id = o.instance_id
+                     AND uro.resource_owner = o.id
+     UNION ALL
+     SELECT o.instance_id,
+            o.id,
+            o.parent_id,
+            h.depth + 1
+     FROM projections.orgs1 as o
+          INNER JOIN user_org_hierarchy as h
+                     ON h.instance_id = o.instance_id
+                     AND h.parent_id = o.id
+     WHERE h.depth < $9
 ), has_project_grant_check as (
 /* all projectgrants active, then filtered with the project and user resourceowner */
      SELECT pg.instance_id,
             pg.resource_owner,
             pg.project_id,
-            pg.granted_org_id
+            pg.granted_org_id,
+            pg.include_sub_orgs
      FROM projections.project_grants4 as pg
      WHERE pg.instance_id = $1
        AND pg.state = $7
 ), project_role_check as (
-/* all usergrants active and associated with the user, then filtered with the project */
+/* all usergrants active, within their validity and associated with the user, then filtered with the project */
      SELECT ug.instance_id,
             ug.resource_owner,
             ug.project_id
@@ -44,13 +65,15 @@ with application as (
      WHERE ug.instance_id = $1
        AND ug.user_id = $5
        AND ug.state = $8
+       AND (ug.valid_from IS NULL OR ug.valid_from <= now())
+       AND (ug.valid_until IS NULL OR ug.valid_until > now())
 )
 SELECT
-    /* project existence does not need to be checked, or resourceowner of user and project are equal, or resourceowner of user has project granted*/
+    /* project existence does not need to be checked, or resourceowner of user and project are equal, or resourceowner of user or one of its parents has project granted*/
        bool_and(COALESCE(
                (NOT a.has_project_check OR
                 a.resource_owner = uro.resource_owner OR
-                uro.resource_owner = hpgc.granted_org_id)
+                hpgc.granted_org_id IS NOT NULL)
            , FALSE)
        ) as project_checked,
     /* authentication existence does not need to checked, or authentication for project is existing*/
@@ -65,7 +88,8 @@ FROM application as a
          LEFT JOIN has_project_grant_check as hpgc
                    ON hpgc.instance_id = a.instance_id
                    AND hpgc.project_id = a.project_id
-                   AND hpgc.granted_org_id = uro.resource_owner
+                   AND (hpgc.granted_org_id = uro.resource_owner
+                       OR (hpgc.include_sub_orgs AND hpgc.granted_org_id IN (SELECT h.org_id FROM user_org_hierarchy as h)))
          LEFT JOIN project_role_check as prc
                    ON prc.instance_id = a.instance_id
                    AND prc.project_id = a.project_id
@@ -55,6 +55,9 @@ var (
 		` projections.apps7_oidc_configs.ios_bundle_id,` +
 		` projections.apps7_oidc_configs.android_package_name,` +
 		` projections.apps7_oidc_configs.android_sha256_cert_fingerprints,` +
+		` projections.apps7_oidc_configs.authorization_details_types,` +
+		` projections.apps7_oidc_configs.require_consent,` +
+		` projections.apps7_oidc_configs.token_exchange_policy,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
@@ -107,6 +110,9 @@ var (
 		` projections.apps7_oidc_configs.ios_bundle_id,` +
 		` projections.apps7_oidc_configs.android_package_name,` +
 		` projections.apps7_oidc_configs.android_sha256_cert_fingerprints,` +
+		` projections.apps7_oidc_configs.authorization_details_types,` +
+		` projections.apps7_oidc_configs.require_consent,` +
+		` projections.apps7_oidc_configs.token_exchange_policy,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
@@ -184,6 +190,9 @@ var (
 		"ios_bundle_id",
 		"android_package_name",
 		"android_sha256_cert_fingerprints",
+		"authorization_details_types",
+		"require_consent",
+		"token_exchange_policy",
 		//saml config
 		"app_id",
 		"entity_id",
@@ -264,6 +273,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -339,6 +351,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -417,6 +432,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -497,6 +515,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -595,6 +616,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -693,6 +717,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -791,6 +818,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -889,6 +919,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -987,6 +1020,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1085,6 +1121,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1131,6 +1170,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1177,6 +1219,9 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
@@ -1366,6 +1411,9 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
@@ -1435,6 +1483,9 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1509,6 +1560,9 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							[]byte(`{"audiences":["api"]}`),
 							// saml config
 							nil,
 							nil,
@@ -1551,6 +1605,7 @@ func Test_AppPrepare(t *testing.T) {
 					BackChannelLogoutURI:     "back.channel.logout.ch",
 					LoginVersion:             domain.LoginVersionUnspecified,
 					LoginBaseURI:             nil,
+					TokenExchangePolicy:      &domain.TokenExchangePolicy{Audiences: []string{"api"}},
 				},
 			},
 		},
@@ -1602,6 +1657,9 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1695,6 +1753,9 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -1772,6 +1833,9 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1865,6 +1929,9 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1958,6 +2025,9 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -2051,6 +2121,9 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
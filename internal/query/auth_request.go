@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -30,6 +31,12 @@ type AuthRequest struct {
 	LoginHint    *string
 	MaxAge       *time.Duration
 	HintUserID   *string
+	// AuthorizationDetails requested by the client (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails
+	// RequireConsent is set if the client requires the user to consent to the requested scopes
+	RequireConsent bool
+	// ACRValues requested by the client in order of preference
+	ACRValues []string
 }
 
 func (a *AuthRequest) checkLoginClient(ctx context.Context, permissionCheck domain.PermissionCheck) error {
@@ -57,6 +64,8 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		scope   database.TextArray[string]
 		prompt  database.NumberArray[domain.Prompt]
 		locales database.TextArray[string]
+		details []byte
+		acr     database.TextArray[string]
 	)
 
 	dst := new(AuthRequest)
@@ -65,7 +74,8 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		func(row *sql.Row) error {
 			return row.Scan(
 				&dst.ID, &dst.CreationDate, &dst.LoginClient, &dst.ClientID, &scope, &dst.RedirectURI,
-				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID,
+				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID, &details,
+				&dst.RequireConsent, &acr,
 			)
 		},
 		authRequestByIDQuery,
@@ -81,6 +91,12 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 	dst.Scope = scope
 	dst.Prompt = prompt
 	dst.UiLocales = locales
+	dst.ACRValues = acr
+	if len(details) > 0 {
+		if err = json.Unmarshal(details, &dst.AuthorizationDetails); err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-ooW4u", "Errors.Internal")
+		}
+	}
 
 	if checkLoginClient {
 		if err = dst.checkLoginClient(ctx, q.checkPermission); err != nil {
@@ -35,6 +35,9 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 		projection.AuthRequestColumnLoginHint,
 		projection.AuthRequestColumnMaxAge,
 		projection.AuthRequestColumnHintUserID,
+		projection.AuthRequestColumnAuthorizationDetails,
+		projection.AuthRequestColumnRequireConsent,
+		projection.AuthRequestColumnACRValues,
 	}
 	type args struct {
 		shouldTriggerBulk bool
@@ -68,6 +71,9 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				"me@example.com",
 				int64(time.Minute),
 				"userID",
+				[]byte(`[{"type":"payment_initiation","actions":["initiate"]}]`),
+				true,
+				database.TextArray[string]{"urn:zitadel:acr:mfa"},
 			}, "123", "instanceID"),
 			want: &AuthRequest{
 				ID:           "id",
@@ -81,6 +87,11 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				LoginHint:    gu.Ptr("me@example.com"),
 				MaxAge:       gu.Ptr(time.Minute),
 				HintUserID:   gu.Ptr("userID"),
+				AuthorizationDetails: domain.AuthorizationDetails{
+					{Type: "payment_initiation", Actions: []string{"initiate"}},
+				},
+				RequireConsent: true,
+				ACRValues:      []string{"urn:zitadel:acr:mfa"},
 			},
 		},
 		{
@@ -102,6 +113,9 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				false,
+				nil,
 			}, "123", "instanceID"),
 			want: &AuthRequest{
 				ID:           "id",
@@ -154,6 +168,9 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				false,
+				nil,
 			}, "123", "instanceID"),
 			permissionCheck: func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 				return zerrors.ThrowPermissionDenied(nil, "id", "not permitted")
@@ -179,6 +196,9 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				false,
+				nil,
 			}, "123", "instanceID"),
 			permissionCheck: func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 				return nil
@@ -0,0 +1,265 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type InactivityPolicy struct {
+	ID            string
+	Sequence      uint64
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	State         domain.PolicyState
+
+	WarnDays           uint64
+	DeactivateDays     uint64
+	DeleteDays         uint64
+	ExemptMachineUsers bool
+	ExemptRoles        database.TextArray[string]
+
+	IsDefault bool
+}
+
+// ToDomain returns the policy as [domain.InactivityPolicy]
+func (p *InactivityPolicy) ToDomain() *domain.InactivityPolicy {
+	return &domain.InactivityPolicy{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   p.ID,
+			Sequence:      p.Sequence,
+			ResourceOwner: p.ResourceOwner,
+			CreationDate:  p.CreationDate,
+			ChangeDate:    p.ChangeDate,
+		},
+		Default:            p.IsDefault,
+		WarnDays:           p.WarnDays,
+		DeactivateDays:     p.DeactivateDays,
+		DeleteDays:         p.DeleteDays,
+		ExemptMachineUsers: p.ExemptMachineUsers,
+		ExemptRoles:        p.ExemptRoles,
+	}
+}
+
+var (
+	inactivityPolicyTable = table{
+		name:          projection.InactivityPolicyProjectionTable,
+		instanceIDCol: projection.InactivityPolicyColumnInstanceID,
+	}
+	InactivityPolicyColID = Column{
+		name:  projection.InactivityPolicyColumnID,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColSequence = Column{
+		name:  projection.InactivityPolicyColumnSequence,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColCreationDate = Column{
+		name:  projection.InactivityPolicyColumnCreationDate,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColChangeDate = Column{
+		name:  projection.InactivityPolicyColumnChangeDate,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColResourceOwner = Column{
+		name:  projection.InactivityPolicyColumnResourceOwner,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColInstanceID = Column{
+		name:  projection.InactivityPolicyColumnInstanceID,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColWarnDays = Column{
+		name:  projection.InactivityPolicyColumnWarnDays,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColDeactivateDays = Column{
+		name:  projection.InactivityPolicyColumnDeactivateDays,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColDeleteDays = Column{
+		name:  projection.InactivityPolicyColumnDeleteDays,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColExemptMachineUsers = Column{
+		name:  projection.InactivityPolicyColumnExemptMachineUsers,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColExemptRoles = Column{
+		name:  projection.InactivityPolicyColumnExemptRoles,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColIsDefault = Column{
+		name:  projection.InactivityPolicyColumnIsDefault,
+		table: inactivityPolicyTable,
+	}
+	InactivityPolicyColState = Column{
+		name:  projection.InactivityPolicyColumnState,
+		table: inactivityPolicyTable,
+	}
+)
+
+// InactivityPolicyByOrg returns the custom policy of the organization or the default policy of the instance.
+func (q *Queries) InactivityPolicyByOrg(ctx context.Context, shouldTriggerBulk bool, orgID string) (policy *InactivityPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerInactivityPolicyProjection")
+		ctx, err = projection.InactivityPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	stmt, scan := prepareInactivityPolicyQuery()
+	query, args, err := stmt.Where(
+		sq.And{
+			sq.Eq{InactivityPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
+			sq.Or{
+				sq.Eq{InactivityPolicyColID.identifier(): orgID},
+				sq.Eq{InactivityPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
+			},
+		}).
+		OrderBy(InactivityPolicyColIsDefault.identifier()).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-aiP8a", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	return policy, err
+}
+
+func (q *Queries) DefaultInactivityPolicy(ctx context.Context) (policy *InactivityPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareInactivityPolicyQuery()
+	query, args, err := stmt.Where(sq.Eq{
+		InactivityPolicyColID.identifier():         authz.GetInstance(ctx).InstanceID(),
+		InactivityPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).
+		OrderBy(InactivityPolicyColIsDefault.identifier()).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Oow5e", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		policy, err = scan(row)
+		return err
+	}, query, args...)
+	return policy, err
+}
+
+// InactivityPoliciesByInstance returns the default and all custom inactivity policies of the instance.
+func (q *Queries) InactivityPoliciesByInstance(ctx context.Context) (policies []*InactivityPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareInactivityPoliciesQuery()
+	query, args, err := stmt.Where(sq.Eq{
+		InactivityPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).
+		OrderBy(InactivityPolicyColIsDefault.identifier(), InactivityPolicyColID.identifier()).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Wai3e", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		policies, err = scan(rows)
+		return err
+	}, query, args...)
+	return policies, err
+}
+
+func inactivityPolicyColumns() []string {
+	return []string{
+		InactivityPolicyColID.identifier(),
+		InactivityPolicyColSequence.identifier(),
+		InactivityPolicyColCreationDate.identifier(),
+		InactivityPolicyColChangeDate.identifier(),
+		InactivityPolicyColResourceOwner.identifier(),
+		InactivityPolicyColWarnDays.identifier(),
+		InactivityPolicyColDeactivateDays.identifier(),
+		InactivityPolicyColDeleteDays.identifier(),
+		InactivityPolicyColExemptMachineUsers.identifier(),
+		InactivityPolicyColExemptRoles.identifier(),
+		InactivityPolicyColIsDefault.identifier(),
+		InactivityPolicyColState.identifier(),
+	}
+}
+
+func scanInactivityPolicy(scan func(dest ...any) error) (*InactivityPolicy, error) {
+	policy := new(InactivityPolicy)
+	err := scan(
+		&policy.ID,
+		&policy.Sequence,
+		&policy.CreationDate,
+		&policy.ChangeDate,
+		&policy.ResourceOwner,
+		&policy.WarnDays,
+		&policy.DeactivateDays,
+		&policy.DeleteDays,
+		&policy.ExemptMachineUsers,
+		&policy.ExemptRoles,
+		&policy.IsDefault,
+		&policy.State,
+	)
+	return policy, err
+}
+
+func prepareInactivityPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*InactivityPolicy, error)) {
+	return sq.Select(inactivityPolicyColumns()...).
+			From(inactivityPolicyTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*InactivityPolicy, error) {
+			policy, err := scanInactivityPolicy(row.Scan)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Eing6", "Errors.Instance.InactivityPolicy.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-ohT2u", "Errors.Internal")
+			}
+			return policy, nil
+		}
+}
+
+func prepareInactivityPoliciesQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*InactivityPolicy, error)) {
+	return sq.Select(inactivityPolicyColumns()...).
+			From(inactivityPolicyTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*InactivityPolicy, error) {
+			policies := make([]*InactivityPolicy, 0)
+			for rows.Next() {
+				policy, err := scanInactivityPolicy(rows.Scan)
+				if err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Ahng4", "Errors.Internal")
+				}
+				policies = append(policies, policy)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-eeT5a", "Errors.Query.CloseRows")
+			}
+			return policies, nil
+		}
+}
@@ -0,0 +1,201 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareInactivityPolicyStmt = `SELECT projections.inactivity_policies.id,` +
+		` projections.inactivity_policies.sequence,` +
+		` projections.inactivity_policies.creation_date,` +
+		` projections.inactivity_policies.change_date,` +
+		` projections.inactivity_policies.resource_owner,` +
+		` projections.inactivity_policies.warn_days,` +
+		` projections.inactivity_policies.deactivate_days,` +
+		` projections.inactivity_policies.delete_days,` +
+		` projections.inactivity_policies.exempt_machine_users,` +
+		` projections.inactivity_policies.exempt_roles,` +
+		` projections.inactivity_policies.is_default,` +
+		` projections.inactivity_policies.state` +
+		` FROM projections.inactivity_policies`
+
+	prepareInactivityPolicyCols = []string{
+		"id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"warn_days",
+		"deactivate_days",
+		"delete_days",
+		"exempt_machine_users",
+		"exempt_roles",
+		"is_default",
+		"state",
+	}
+)
+
+func Test_InactivityPolicyPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareInactivityPolicyQuery no result",
+			prepare: prepareInactivityPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareInactivityPolicyStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*InactivityPolicy)(nil),
+		},
+		{
+			name:    "prepareInactivityPolicyQuery found",
+			prepare: prepareInactivityPolicyQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareInactivityPolicyStmt),
+					prepareInactivityPolicyCols,
+					[]driver.Value{
+						"pol-id",
+						uint64(20211109),
+						testNow,
+						testNow,
+						"ro",
+						30,
+						60,
+						90,
+						true,
+						database.TextArray[string]{"ORG_OWNER"},
+						true,
+						domain.PolicyStateActive,
+					},
+				),
+			},
+			object: &InactivityPolicy{
+				ID:                 "pol-id",
+				CreationDate:       testNow,
+				ChangeDate:         testNow,
+				Sequence:           20211109,
+				ResourceOwner:      "ro",
+				State:              domain.PolicyStateActive,
+				WarnDays:           30,
+				DeactivateDays:     60,
+				DeleteDays:         90,
+				ExemptMachineUsers: true,
+				ExemptRoles:        database.TextArray[string]{"ORG_OWNER"},
+				IsDefault:          true,
+			},
+		},
+		{
+			name:    "prepareInactivityPolicyQuery sql err",
+			prepare: prepareInactivityPolicyQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareInactivityPolicyStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*InactivityPolicy)(nil),
+		},
+		{
+			name:    "prepareInactivityPoliciesQuery found",
+			prepare: prepareInactivityPoliciesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareInactivityPolicyStmt),
+					prepareInactivityPolicyCols,
+					[][]driver.Value{
+						{
+							"org-id",
+							uint64(20211109),
+							testNow,
+							testNow,
+							"org-id",
+							30,
+							60,
+							90,
+							true,
+							database.TextArray[string]{"ORG_OWNER"},
+							false,
+							domain.PolicyStateActive,
+						},
+						{
+							"instance-id",
+							uint64(20211109),
+							testNow,
+							testNow,
+							"instance-id",
+							0,
+							0,
+							0,
+							false,
+							nil,
+							true,
+							domain.PolicyStateActive,
+						},
+					},
+				),
+			},
+			object: []*InactivityPolicy{
+				{
+					ID:                 "org-id",
+					CreationDate:       testNow,
+					ChangeDate:         testNow,
+					Sequence:           20211109,
+					ResourceOwner:      "org-id",
+					State:              domain.PolicyStateActive,
+					WarnDays:           30,
+					DeactivateDays:     60,
+					DeleteDays:         90,
+					ExemptMachineUsers: true,
+					ExemptRoles:        database.TextArray[string]{"ORG_OWNER"},
+				},
+				{
+					ID:            "instance-id",
+					CreationDate:  testNow,
+					ChangeDate:    testNow,
+					Sequence:      20211109,
+					ResourceOwner: "instance-id",
+					State:         domain.PolicyStateActive,
+					IsDefault:     true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
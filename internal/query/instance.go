@@ -468,6 +468,7 @@ type authzInstance struct {
 	CSP                    csp                        `json:"csp,omitempty"`
 	Impersonation          bool                       `json:"impersonation,omitempty"`
 	DCR                    dcr                        `json:"dcr,omitempty"`
+	PasswordHash           passwordHash               `json:"password_hash,omitzero"`
 	IsBlocked              *bool                      `json:"is_blocked,omitempty"`
 	LogRetention           *time.Duration             `json:"log_retention,omitempty"`
 	Feature                feature.Features           `json:"feature,omitempty"`
@@ -488,6 +489,12 @@ type dcr struct {
 	AllowUnauthenticated bool `json:"allow_unauthenticated,omitempty"`
 }
 
+// passwordHash holds the instance's settings for upgrading and expiring password hashes.
+type passwordHash struct {
+	RehashBefore           time.Time `json:"rehash_before,omitzero"`
+	InsecureHashesDeadline time.Time `json:"insecure_hashes_deadline,omitzero"`
+}
+
 func (i *authzInstance) InstanceID() string {
 	return i.ID
 }
@@ -535,6 +542,14 @@ func (i *authzInstance) AllowUnauthenticatedDynamicClientRegistration() bool {
 	return i.DCR.Enabled && i.DCR.AllowUnauthenticated
 }
 
+func (i *authzInstance) PasswordRehashBefore() time.Time {
+	return i.PasswordHash.RehashBefore
+}
+
+func (i *authzInstance) InsecurePasswordHashDeadline() time.Time {
+	return i.PasswordHash.InsecureHashesDeadline
+}
+
 func (i *authzInstance) Block() *bool {
 	return i.IsBlocked
 }
@@ -585,6 +600,8 @@ func scanAuthzInstance() (*authzInstance, func(row *sql.Row) error) {
 			enableImpersonation   sql.NullBool
 			enableDCR             sql.NullBool
 			allowUnauthDCR        sql.NullBool
+			rehashBefore          sql.NullTime
+			insecureHashDeadline  sql.NullTime
 			auditLogRetention     database.NullDuration
 			block                 sql.NullBool
 			features              []byte
@@ -603,6 +620,8 @@ func scanAuthzInstance() (*authzInstance, func(row *sql.Row) error) {
 			&enableImpersonation,
 			&enableDCR,
 			&allowUnauthDCR,
+			&rehashBefore,
+			&insecureHashDeadline,
 			&auditLogRetention,
 			&block,
 			&features,
@@ -628,6 +647,8 @@ func scanAuthzInstance() (*authzInstance, func(row *sql.Row) error) {
 		instance.Impersonation = enableImpersonation.Bool
 		instance.DCR.Enabled = enableDCR.Bool
 		instance.DCR.AllowUnauthenticated = allowUnauthDCR.Bool
+		instance.PasswordHash.RehashBefore = rehashBefore.Time
+		instance.PasswordHash.InsecureHashesDeadline = insecureHashDeadline.Time
 		if len(features) > 0 {
 			if err = json.Unmarshal(features, &instance.Feature); err != nil {
 				return zerrors.ThrowInternal(err, "QUERY-Po8ki", "Errors.Internal")
@@ -60,6 +60,8 @@ select
 	s.enable_impersonation,
 	s.enable_dynamic_client_registration,
 	s.allow_unauthenticated_dynamic_client_registration,
+	s.password_rehash_before,
+	s.insecure_password_hash_deadline,
     l.audit_log_retention,
     l.block,
 	f.features,
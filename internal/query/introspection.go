@@ -40,6 +40,7 @@ type IntrospectionClient struct {
 	ProjectID            string
 	ResourceOwner        string
 	ProjectRoleAssertion bool
+	ResourceURIs         database.TextArray[string]
 	PublicKeys           database.Map[[]byte]
 }
 
@@ -64,6 +65,7 @@ func (q *Queries) ActiveIntrospectionClientByID(ctx context.Context, clientID st
 			&client.ProjectID,
 			&client.ResourceOwner,
 			&client.ProjectRoleAssertion,
+			&client.ResourceURIs,
 			&client.PublicKeys,
 		)
 	},
@@ -19,7 +19,7 @@ keys as (
 )
 select c.app_id, c.client_id, c.client_secret, c.app_type, 
        a.project_id, a.resource_owner, p.project_role_assertion, 
-       k.public_keys
+       p.resource_uris, k.public_keys
 from config c
 join projections.apps7 a on a.id = c.app_id and a.instance_id = c.instance_id and a.state = 1
 join projections.projects4 p on p.id = a.project_id and p.instance_id = c.instance_id and p.state = 1
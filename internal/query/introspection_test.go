@@ -50,8 +50,8 @@ func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
 				getKeys:  false,
 			},
 			mock: mockQuery(expQuery,
-				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
-				[]driver.Value{"appID", "clientID", "secret", "oidc", "projectID", "orgID", true, nil},
+				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "resource_uris", "public_keys"},
+				[]driver.Value{"appID", "clientID", "secret", "oidc", "projectID", "orgID", true, database.TextArray[string]{"https://api.example.com"}, nil},
 				"instanceID", "clientID", false),
 			want: &IntrospectionClient{
 				AppID:                "appID",
@@ -61,6 +61,7 @@ func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
 				ProjectID:            "projectID",
 				ResourceOwner:        "orgID",
 				ProjectRoleAssertion: true,
+				ResourceURIs:         database.TextArray[string]{"https://api.example.com"},
 				PublicKeys:           nil,
 			},
 		},
@@ -71,8 +72,8 @@ func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
 				getKeys:  true,
 			},
 			mock: mockQuery(expQuery,
-				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
-				[]driver.Value{"appID", "clientID", "", "oidc", "projectID", "orgID", true, encPubkeys},
+				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "resource_uris", "public_keys"},
+				[]driver.Value{"appID", "clientID", "", "oidc", "projectID", "orgID", true, nil, encPubkeys},
 				"instanceID", "clientID", true),
 			want: &IntrospectionClient{
 				AppID:                "appID",
@@ -46,6 +46,7 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	ownersJoin, ownersArgs := policyOwnersClause(ctx, LabelPolicyColID, orgID)
 	stmt, scan := prepareLabelPolicyQuery()
 	eq := sq.Eq{
 		LabelPolicyColState.identifier():      domain.LabelPolicyStateActive,
@@ -54,15 +55,9 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	if !withOwnerRemoved {
 		eq[LabelPolicyOwnerRemoved.identifier()] = false
 	}
-	query, args, err := stmt.Where(
-		sq.And{
-			sq.Or{
-				sq.Eq{LabelPolicyColID.identifier(): orgID},
-				sq.Eq{LabelPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
-			eq,
-		}).
-		OrderBy(LabelPolicyColIsDefault.identifier()).
+	query, args, err := stmt.JoinClause(ownersJoin, ownersArgs...).
+		Where(eq).
+		OrderBy(policyOwnersDepth).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-V22un", "unable to create sql stmt")
@@ -79,23 +74,14 @@ func (q *Queries) PreviewLabelPolicyByOrg(ctx context.Context, orgID string) (po
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	ownersJoin, ownersArgs := policyOwnersClause(ctx, LabelPolicyColID, orgID)
 	stmt, scan := prepareLabelPolicyQuery()
-	query, args, err := stmt.Where(
-		sq.And{
-			sq.Or{
-				sq.Eq{
-					LabelPolicyColID.identifier(): orgID,
-				},
-				sq.Eq{
-					LabelPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
-			sq.Eq{
-				LabelPolicyColState.identifier():      domain.LabelPolicyStatePreview,
-				LabelPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
-			},
+	query, args, err := stmt.JoinClause(ownersJoin, ownersArgs...).
+		Where(sq.Eq{
+			LabelPolicyColState.identifier():      domain.LabelPolicyStatePreview,
+			LabelPolicyColInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 		}).
-		OrderBy(LabelPolicyColIsDefault.identifier()).
+		OrderBy(policyOwnersDepth).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-AG5eq", "unable to create sql stmt")
@@ -37,6 +37,16 @@ type LoginPolicy struct {
 	AllowDomainDiscovery       bool
 	DisableLoginWithEmail      bool
 	DisableLoginWithPhone      bool
+	AllowMagicLink             bool
+	TrustedDeviceLifetime      database.Duration
+	SessionIdleTimeout         database.Duration
+	SessionMaxLifetime         database.Duration
+	MaxConcurrentSessions      uint64
+	WebAuthNAttestation        domain.AttestationConveyance
+	WebAuthNUserVerification   domain.UserVerificationRequirement
+	WebAuthNResidentKey        domain.ResidentKeyRequirement
+	WebAuthNAllowedAAGUIDs     database.TextArray[string]
+	WebAuthNDeniedAAGUIDs      database.TextArray[string]
 	DefaultRedirectURI         string
 	PasswordCheckLifetime      database.Duration
 	ExternalLoginCheckLifetime database.Duration
@@ -161,6 +171,46 @@ var (
 		name:  projection.MultiFactorCheckLifetimeCol,
 		table: loginPolicyTable,
 	}
+	LoginPolicyColumnAllowMagicLink = Column{
+		name:  projection.AllowMagicLinkCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnTrustedDeviceLifetime = Column{
+		name:  projection.TrustedDeviceLifetimeCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnSessionIdleTimeout = Column{
+		name:  projection.SessionIdleTimeoutCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnSessionMaxLifetime = Column{
+		name:  projection.SessionMaxLifetimeCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnMaxConcurrentSessions = Column{
+		name:  projection.MaxConcurrentSessionsCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnWebAuthNAttestation = Column{
+		name:  projection.WebAuthNAttestationCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnWebAuthNUserVerification = Column{
+		name:  projection.WebAuthNUserVerificationCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnWebAuthNResidentKey = Column{
+		name:  projection.WebAuthNResidentKeyCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnWebAuthNAllowedAAGUIDs = Column{
+		name:  projection.WebAuthNAllowedAAGUIDsCol,
+		table: loginPolicyTable,
+	}
+	LoginPolicyColumnWebAuthNDeniedAAGUIDs = Column{
+		name:  projection.WebAuthNDeniedAAGUIDsCol,
+		table: loginPolicyTable,
+	}
 	LoginPolicyColumnOwnerRemoved = Column{
 		name:  projection.LoginPolicyOwnerRemovedCol,
 		table: loginPolicyTable,
@@ -182,15 +232,10 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 		eq[LoginPolicyColumnOwnerRemoved.identifier()] = false
 	}
 
+	ownersJoin, ownersArgs := policyOwnersClause(ctx, LoginPolicyColumnOrgID, orgID)
 	query, scan := prepareLoginPolicyQuery()
-	stmt, args, err := query.Where(
-		sq.And{
-			eq,
-			sq.Or{
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): orgID},
-				sq.Eq{LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
-		}).Limit(1).OrderBy(LoginPolicyColumnIsDefault.identifier()).ToSql()
+	stmt, args, err := query.JoinClause(ownersJoin, ownersArgs...).
+		Where(eq).Limit(1).OrderBy(policyOwnersDepth).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-scVHo", "Errors.Query.SQLStatement")
 	}
@@ -241,22 +286,13 @@ func (q *Queries) SecondFactorsByOrg(ctx context.Context, orgID string) (factors
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	ownersJoin, ownersArgs := policyOwnersClause(ctx, LoginPolicyColumnOrgID, orgID)
 	query, scan := prepareLoginPolicy2FAsQuery()
-	stmt, args, err := query.Where(
-		sq.And{
-			sq.Eq{
-				LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
-			},
-			sq.Or{
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): orgID,
-				},
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+	stmt, args, err := query.JoinClause(ownersJoin, ownersArgs...).
+		Where(sq.Eq{
+			LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 		}).
-		OrderBy(LoginPolicyColumnIsDefault.identifier()).
+		OrderBy(policyOwnersDepth).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-scVHo", "Errors.Query.SQLStatement")
@@ -301,22 +337,13 @@ func (q *Queries) MultiFactorsByOrg(ctx context.Context, orgID string) (factors
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	ownersJoin, ownersArgs := policyOwnersClause(ctx, LoginPolicyColumnOrgID, orgID)
 	query, scan := prepareLoginPolicyMFAsQuery()
-	stmt, args, err := query.Where(
-		sq.And{
-			sq.Eq{
-				LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
-			},
-			sq.Or{
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): orgID,
-				},
-				sq.Eq{
-					LoginPolicyColumnOrgID.identifier(): authz.GetInstance(ctx).InstanceID(),
-				},
-			},
+	stmt, args, err := query.JoinClause(ownersJoin, ownersArgs...).
+		Where(sq.Eq{
+			LoginPolicyColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
 		}).
-		OrderBy(LoginPolicyColumnIsDefault.identifier()).
+		OrderBy(policyOwnersDepth).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-B4o7h", "Errors.Query.SQLStatement")
@@ -383,6 +410,16 @@ func prepareLoginPolicyQuery() (sq.SelectBuilder, func(*sql.Rows) (*LoginPolicy,
 			LoginPolicyColumnMFAInitSkipLifetime.identifier(),
 			LoginPolicyColumnSecondFactorCheckLifetime.identifier(),
 			LoginPolicyColumnMultiFactorCheckLifetime.identifier(),
+			LoginPolicyColumnAllowMagicLink.identifier(),
+			LoginPolicyColumnTrustedDeviceLifetime.identifier(),
+			LoginPolicyColumnSessionIdleTimeout.identifier(),
+			LoginPolicyColumnSessionMaxLifetime.identifier(),
+			LoginPolicyColumnMaxConcurrentSessions.identifier(),
+			LoginPolicyColumnWebAuthNAttestation.identifier(),
+			LoginPolicyColumnWebAuthNUserVerification.identifier(),
+			LoginPolicyColumnWebAuthNResidentKey.identifier(),
+			LoginPolicyColumnWebAuthNAllowedAAGUIDs.identifier(),
+			LoginPolicyColumnWebAuthNDeniedAAGUIDs.identifier(),
 		).From(loginPolicyTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*LoginPolicy, error) {
@@ -414,6 +451,16 @@ func prepareLoginPolicyQuery() (sq.SelectBuilder, func(*sql.Rows) (*LoginPolicy,
 					&p.MFAInitSkipLifetime,
 					&p.SecondFactorCheckLifetime,
 					&p.MultiFactorCheckLifetime,
+					&p.AllowMagicLink,
+					&p.TrustedDeviceLifetime,
+					&p.SessionIdleTimeout,
+					&p.SessionMaxLifetime,
+					&p.MaxConcurrentSessions,
+					&p.WebAuthNAttestation,
+					&p.WebAuthNUserVerification,
+					&p.WebAuthNResidentKey,
+					&p.WebAuthNAllowedAAGUIDs,
+					&p.WebAuthNDeniedAAGUIDs,
 				)
 				if err != nil {
 					return nil, zerrors.ThrowInternal(err, "QUERY-YcC53", "Errors.Internal")
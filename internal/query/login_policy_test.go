@@ -38,7 +38,17 @@ var (
 		` projections.login_policies5.external_login_check_lifetime,` +
 		` projections.login_policies5.mfa_init_skip_lifetime,` +
 		` projections.login_policies5.second_factor_check_lifetime,` +
-		` projections.login_policies5.multi_factor_check_lifetime` +
+		` projections.login_policies5.multi_factor_check_lifetime,` +
+		` projections.login_policies5.allow_magic_link,` +
+		` projections.login_policies5.trusted_device_lifetime,` +
+		` projections.login_policies5.session_idle_timeout,` +
+		` projections.login_policies5.session_max_lifetime,` +
+		` projections.login_policies5.max_concurrent_sessions,` +
+		` projections.login_policies5.webauthn_attestation,` +
+		` projections.login_policies5.webauthn_user_verification,` +
+		` projections.login_policies5.webauthn_resident_key,` +
+		` projections.login_policies5.webauthn_allowed_aaguids,` +
+		` projections.login_policies5.webauthn_denied_aaguids` +
 		` FROM projections.login_policies5`
 	loginPolicyCols = []string{
 		"aggregate_id",
@@ -65,6 +75,16 @@ var (
 		"mfa_init_skip_lifetime",
 		"second_factor_check_lifetime",
 		"multi_factor_check_lifetime",
+		"allow_magic_link",
+		"trusted_device_lifetime",
+		"session_idle_timeout",
+		"session_max_lifetime",
+		"max_concurrent_sessions",
+		"webauthn_attestation",
+		"webauthn_user_verification",
+		"webauthn_resident_key",
+		"webauthn_allowed_aaguids",
+		"webauthn_denied_aaguids",
 	}
 
 	prepareLoginPolicy2FAsStmt = `SELECT projections.login_policies5.second_factors` +
@@ -142,6 +162,16 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 						&duration,
 						&duration,
 						&duration,
+						true,
+						&duration,
+						&duration,
+						&duration,
+						uint64(5),
+						domain.AttestationConveyanceDirect,
+						domain.UserVerificationRequirementRequired,
+						domain.ResidentKeyRequirementRequired,
+						database.TextArray[string]{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+						database.TextArray[string]{"08987058-cadc-4b81-b6e1-30de50dcbe96"},
 					},
 				),
 			},
@@ -170,6 +200,16 @@ func Test_LoginPolicyPrepares(t *testing.T) {
 				MFAInitSkipLifetime:        database.Duration(duration),
 				SecondFactorCheckLifetime:  database.Duration(duration),
 				MultiFactorCheckLifetime:   database.Duration(duration),
+				AllowMagicLink:             true,
+				TrustedDeviceLifetime:      database.Duration(duration),
+				SessionIdleTimeout:         database.Duration(duration),
+				SessionMaxLifetime:         database.Duration(duration),
+				MaxConcurrentSessions:      5,
+				WebAuthNAttestation:        domain.AttestationConveyanceDirect,
+				WebAuthNUserVerification:   domain.UserVerificationRequirementRequired,
+				WebAuthNResidentKey:        domain.ResidentKeyRequirementRequired,
+				WebAuthNAllowedAAGUIDs:     database.TextArray[string]{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+				WebAuthNDeniedAAGUIDs:      database.TextArray[string]{"08987058-cadc-4b81-b6e1-30de50dcbe96"},
 			},
 		},
 		{
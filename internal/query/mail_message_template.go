@@ -0,0 +1,174 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type MailMessageTemplate struct {
+	AggregateID  string
+	Sequence     uint64
+	CreationDate time.Time
+	ChangeDate   time.Time
+	IsDefault    bool
+
+	MessageType string
+	Language    language.Tag
+	HTML        string
+	PlainText   string
+}
+
+var (
+	mailMessageTemplateTable = table{
+		name:          projection.MailMessageTemplateTable,
+		instanceIDCol: projection.MailMessageTemplateInstanceIDCol,
+	}
+	MailMessageTemplateColAggregateID = Column{
+		name:  projection.MailMessageTemplateAggregateIDCol,
+		table: mailMessageTemplateTable,
+	}
+	MailMessageTemplateColInstanceID = Column{
+		name:  projection.MailMessageTemplateInstanceIDCol,
+		table: mailMessageTemplateTable,
+	}
+	MailMessageTemplateColCreationDate = Column{
+		name:  projection.MailMessageTemplateCreationDateCol,
+		table: mailMessageTemplateTable,
+	}
+	MailMessageTemplateColChangeDate = Column{
+		name:  projection.MailMessageTemplateChangeDateCol,
+		table: mailMessageTemplateTable,
+	}
+	MailMessageTemplateColSequence = Column{
+		name:  projection.MailMessageTemplateSequenceCol,
+		table: mailMessageTemplateTable,
+	}
+	MailMessageTemplateColIsDefault = Column{
+		name:  projection.MailMessageTemplateIsDefaultCol,
+		table: mailMessageTemplateTable,
+	}
+	MailMessageTemplateColType = Column{
+		name:  projection.MailMessageTemplateTypeCol,
+		table: mailMessageTemplateTable,
+	}
+	MailMessageTemplateColLanguage = Column{
+		name:  projection.MailMessageTemplateLanguageCol,
+		table: mailMessageTemplateTable,
+	}
+	MailMessageTemplateColHTML = Column{
+		name:  projection.MailMessageTemplateHTMLCol,
+		table: mailMessageTemplateTable,
+	}
+	MailMessageTemplateColPlainText = Column{
+		name:  projection.MailMessageTemplatePlainTextCol,
+		table: mailMessageTemplateTable,
+	}
+)
+
+// MailMessageTemplateByOrg returns the template of the message type and language of the organization,
+// or the one of the instance if the organization has no own.
+func (q *Queries) MailMessageTemplateByOrg(ctx context.Context, orgID, messageType string, lang language.Tag) (template *MailMessageTemplate, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	stmt, scan := prepareMailMessageTemplateQuery()
+	query, args, err := stmt.Where(
+		sq.And{
+			sq.Eq{
+				MailMessageTemplateColInstanceID.identifier(): instanceID,
+				MailMessageTemplateColType.identifier():       messageType,
+				MailMessageTemplateColLanguage.identifier():   lang.String(),
+			},
+			sq.Or{
+				sq.Eq{MailMessageTemplateColAggregateID.identifier(): orgID},
+				sq.Eq{MailMessageTemplateColAggregateID.identifier(): instanceID},
+			},
+		}).
+		OrderBy(MailMessageTemplateColIsDefault.identifier()).
+		Limit(1).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Aek3u", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		template, err = scan(row)
+		return err
+	}, query, args...)
+	return template, err
+}
+
+func (q *Queries) DefaultMailMessageTemplate(ctx context.Context, messageType string, lang language.Tag) (template *MailMessageTemplate, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareMailMessageTemplateQuery()
+	query, args, err := stmt.Where(sq.Eq{
+		MailMessageTemplateColAggregateID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		MailMessageTemplateColInstanceID.identifier():  authz.GetInstance(ctx).InstanceID(),
+		MailMessageTemplateColType.identifier():        messageType,
+		MailMessageTemplateColLanguage.identifier():    lang.String(),
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-ohT2i", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		template, err = scan(row)
+		return err
+	}, query, args...)
+	return template, err
+}
+
+func prepareMailMessageTemplateQuery() (sq.SelectBuilder, func(*sql.Row) (*MailMessageTemplate, error)) {
+	return sq.Select(
+			MailMessageTemplateColAggregateID.identifier(),
+			MailMessageTemplateColSequence.identifier(),
+			MailMessageTemplateColCreationDate.identifier(),
+			MailMessageTemplateColChangeDate.identifier(),
+			MailMessageTemplateColIsDefault.identifier(),
+			MailMessageTemplateColType.identifier(),
+			MailMessageTemplateColLanguage.identifier(),
+			MailMessageTemplateColHTML.identifier(),
+			MailMessageTemplateColPlainText.identifier(),
+		).
+			From(mailMessageTemplateTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*MailMessageTemplate, error) {
+			template := new(MailMessageTemplate)
+			lang := ""
+			html := sql.NullString{}
+			plainText := sql.NullString{}
+			err := row.Scan(
+				&template.AggregateID,
+				&template.Sequence,
+				&template.CreationDate,
+				&template.ChangeDate,
+				&template.IsDefault,
+				&template.MessageType,
+				&lang,
+				&html,
+				&plainText,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Eiph4", "Errors.MailMessageTemplate.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Xee8o", "Errors.Internal")
+			}
+			template.Language = language.Make(lang)
+			template.HTML = html.String
+			template.PlainText = plainText.String
+			return template, nil
+		}
+}
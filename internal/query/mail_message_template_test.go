@@ -0,0 +1,123 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareMailMessageTemplateStmt = `SELECT projections.mail_message_templates.aggregate_id,` +
+		` projections.mail_message_templates.sequence,` +
+		` projections.mail_message_templates.creation_date,` +
+		` projections.mail_message_templates.change_date,` +
+		` projections.mail_message_templates.is_default,` +
+		` projections.mail_message_templates.type,` +
+		` projections.mail_message_templates.language,` +
+		` projections.mail_message_templates.html,` +
+		` projections.mail_message_templates.plain_text` +
+		` FROM projections.mail_message_templates`
+	prepareMailMessageTemplateCols = []string{
+		"aggregate_id",
+		"sequence",
+		"creation_date",
+		"change_date",
+		"is_default",
+		"type",
+		"language",
+		"html",
+		"plain_text",
+	}
+)
+
+func Test_MailMessageTemplatePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareMailMessageTemplateQuery no result",
+			prepare: prepareMailMessageTemplateQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareMailMessageTemplateStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*MailMessageTemplate)(nil),
+		},
+		{
+			name:    "prepareMailMessageTemplateQuery found",
+			prepare: prepareMailMessageTemplateQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareMailMessageTemplateStmt),
+					prepareMailMessageTemplateCols,
+					[]driver.Value{
+						"agg-id",
+						uint64(20211109),
+						testNow,
+						testNow,
+						true,
+						"InitCode",
+						"en",
+						"<html>{{.Code}}</html>",
+						nil,
+					},
+				),
+			},
+			object: &MailMessageTemplate{
+				AggregateID:  "agg-id",
+				CreationDate: testNow,
+				ChangeDate:   testNow,
+				Sequence:     20211109,
+				IsDefault:    true,
+				MessageType:  "InitCode",
+				Language:     language.English,
+				HTML:         "<html>{{.Code}}</html>",
+			},
+		},
+		{
+			name:    "prepareMailMessageTemplateQuery sql err",
+			prepare: prepareMailMessageTemplateQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareMailMessageTemplateStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*MailMessageTemplate)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -33,6 +33,15 @@ type MessageTexts struct {
 	PasswordlessRegistration MessageText
 	PasswordChange           MessageText
 	InviteUser               MessageText
+	MagicLink                MessageText
+	MFAAdded                 MessageText
+	MFARemoved               MessageText
+	EmailChanged             MessageText
+	PhoneChanged             MessageText
+	NewDeviceSignIn          MessageText
+	PersonalAccessTokenAdded MessageText
+	MachineKeyAdded          MessageText
+	InactivityWarning        MessageText
 }
 
 type MessageText struct {
@@ -348,6 +357,24 @@ func (m *MessageTexts) GetMessageTextByType(msgType string) *MessageText {
 		return &m.PasswordChange
 	case domain.InviteUserMessageType:
 		return &m.InviteUser
+	case domain.MagicLinkMessageType:
+		return &m.MagicLink
+	case domain.MFAAddedMessageType:
+		return &m.MFAAdded
+	case domain.MFARemovedMessageType:
+		return &m.MFARemoved
+	case domain.EmailChangedMessageType:
+		return &m.EmailChanged
+	case domain.PhoneChangedMessageType:
+		return &m.PhoneChanged
+	case domain.NewDeviceSignInMessageType:
+		return &m.NewDeviceSignIn
+	case domain.PersonalAccessTokenAddedMessageType:
+		return &m.PersonalAccessTokenAdded
+	case domain.MachineKeyAddedMessageType:
+		return &m.MachineKeyAdded
+	case domain.InactivityWarningMessageType:
+		return &m.InactivityWarning
 	}
 	return nil
 }
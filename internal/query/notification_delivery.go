@@ -0,0 +1,226 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// NotificationDelivery is the delivery record of a single notification (e.g. a password reset mail).
+type NotificationDelivery struct {
+	ID               string
+	CreationDate     time.Time
+	ChangeDate       time.Time
+	Sequence         uint64
+	ResourceOwner    string
+	UserID           string
+	MessageType      string
+	NotificationType domain.NotificationType
+	EventType        string
+	ProviderID       string
+	Attempts         uint16
+	State            domain.NotificationDeliveryState
+	LastError        string
+}
+
+type NotificationDeliveries struct {
+	SearchResponse
+	Deliveries []*NotificationDelivery
+}
+
+type NotificationDeliverySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *NotificationDeliverySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+var (
+	notificationDeliveryTable = table{
+		name:          projection.NotificationDeliveryTable,
+		instanceIDCol: projection.NotificationDeliveryInstanceIDCol,
+	}
+	NotificationDeliveryColumnID = Column{
+		name:  projection.NotificationDeliveryIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnInstanceID = Column{
+		name:  projection.NotificationDeliveryInstanceIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnResourceOwner = Column{
+		name:  projection.NotificationDeliveryResourceOwnerCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnCreationDate = Column{
+		name:  projection.NotificationDeliveryCreationDateCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnChangeDate = Column{
+		name:  projection.NotificationDeliveryChangeDateCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnSequence = Column{
+		name:  projection.NotificationDeliverySequenceCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnUserID = Column{
+		name:  projection.NotificationDeliveryUserIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnMessageType = Column{
+		name:  projection.NotificationDeliveryMessageTypeCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnNotificationType = Column{
+		name:  projection.NotificationDeliveryNotificationTypeCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnEventType = Column{
+		name:  projection.NotificationDeliveryEventTypeCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnProviderID = Column{
+		name:  projection.NotificationDeliveryProviderIDCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnAttempts = Column{
+		name:  projection.NotificationDeliveryAttemptsCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnState = Column{
+		name:  projection.NotificationDeliveryStateCol,
+		table: notificationDeliveryTable,
+	}
+	NotificationDeliveryColumnLastError = Column{
+		name:  projection.NotificationDeliveryLastErrorCol,
+		table: notificationDeliveryTable,
+	}
+)
+
+// SearchNotificationDeliveries returns the delivery records of the instance, newest first if no sorting is requested.
+// Restrict the result to a user or an organization by the search queries.
+func (q *Queries) SearchNotificationDeliveries(ctx context.Context, queries *NotificationDeliverySearchQueries) (deliveries *NotificationDeliveries, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareNotificationDeliveriesQuery()
+	if queries.SortingColumn.isZero() {
+		query = query.OrderBy(NotificationDeliveryColumnCreationDate.identifier() + " DESC")
+	}
+	eq := sq.Eq{
+		NotificationDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Gae4o", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		deliveries, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-ieY5a", "Errors.Internal")
+	}
+	deliveries.State, err = q.latestState(ctx, notificationDeliveryTable)
+	return deliveries, err
+}
+
+func NewNotificationDeliveryUserIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnUserID, value, TextEquals)
+}
+
+func NewNotificationDeliveryResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnResourceOwner, value, TextEquals)
+}
+
+func NewNotificationDeliveryMessageTypeSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(NotificationDeliveryColumnMessageType, value, TextEquals)
+}
+
+func NewNotificationDeliveryStateSearchQuery(value domain.NotificationDeliveryState) (SearchQuery, error) {
+	return NewNumberQuery(NotificationDeliveryColumnState, value, NumberEquals)
+}
+
+func NewNotificationDeliveryNotificationTypeSearchQuery(value domain.NotificationType) (SearchQuery, error) {
+	return NewNumberQuery(NotificationDeliveryColumnNotificationType, value, NumberEquals)
+}
+
+func prepareNotificationDeliveriesQuery() (sq.SelectBuilder, func(*sql.Rows) (*NotificationDeliveries, error)) {
+	return sq.Select(
+			NotificationDeliveryColumnID.identifier(),
+			NotificationDeliveryColumnCreationDate.identifier(),
+			NotificationDeliveryColumnChangeDate.identifier(),
+			NotificationDeliveryColumnSequence.identifier(),
+			NotificationDeliveryColumnResourceOwner.identifier(),
+			NotificationDeliveryColumnUserID.identifier(),
+			NotificationDeliveryColumnMessageType.identifier(),
+			NotificationDeliveryColumnNotificationType.identifier(),
+			NotificationDeliveryColumnEventType.identifier(),
+			NotificationDeliveryColumnProviderID.identifier(),
+			NotificationDeliveryColumnAttempts.identifier(),
+			NotificationDeliveryColumnState.identifier(),
+			NotificationDeliveryColumnLastError.identifier(),
+			countColumn.identifier(),
+		).From(notificationDeliveryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*NotificationDeliveries, error) {
+			deliveries := make([]*NotificationDelivery, 0)
+			var count uint64
+			for rows.Next() {
+				var (
+					delivery   = new(NotificationDelivery)
+					providerID sql.NullString
+					lastError  sql.NullString
+				)
+				err := rows.Scan(
+					&delivery.ID,
+					&delivery.CreationDate,
+					&delivery.ChangeDate,
+					&delivery.Sequence,
+					&delivery.ResourceOwner,
+					&delivery.UserID,
+					&delivery.MessageType,
+					&delivery.NotificationType,
+					&delivery.EventType,
+					&providerID,
+					&delivery.Attempts,
+					&delivery.State,
+					&lastError,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				delivery.ProviderID = providerID.String
+				delivery.LastError = lastError.String
+				deliveries = append(deliveries, delivery)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ohx8e", "Errors.Query.CloseRows")
+			}
+
+			return &NotificationDeliveries{
+				Deliveries: deliveries,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
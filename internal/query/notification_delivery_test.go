@@ -0,0 +1,171 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareNotificationDeliveriesStmt = `SELECT projections.notification_deliveries.id,` +
+		` projections.notification_deliveries.creation_date,` +
+		` projections.notification_deliveries.change_date,` +
+		` projections.notification_deliveries.sequence,` +
+		` projections.notification_deliveries.resource_owner,` +
+		` projections.notification_deliveries.user_id,` +
+		` projections.notification_deliveries.message_type,` +
+		` projections.notification_deliveries.notification_type,` +
+		` projections.notification_deliveries.event_type,` +
+		` projections.notification_deliveries.provider_id,` +
+		` projections.notification_deliveries.attempts,` +
+		` projections.notification_deliveries.state,` +
+		` projections.notification_deliveries.last_error,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.notification_deliveries`
+	prepareNotificationDeliveriesCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"user_id",
+		"message_type",
+		"notification_type",
+		"event_type",
+		"provider_id",
+		"attempts",
+		"state",
+		"last_error",
+		"count",
+	}
+)
+
+func Test_NotificationDeliveryPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationDeliveriesQuery no result",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationDeliveriesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &NotificationDeliveries{Deliveries: []*NotificationDelivery{}},
+		},
+		{
+			name:    "prepareNotificationDeliveriesQuery multiple result",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationDeliveriesStmt),
+					prepareNotificationDeliveriesCols,
+					[][]driver.Value{
+						{
+							"id-1",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"ro",
+							"user-id",
+							"PasswordReset",
+							domain.NotificationTypeEmail,
+							"user.human.password.code.added",
+							"provider-id",
+							1,
+							domain.NotificationDeliveryStateSent,
+							nil,
+						},
+						{
+							"id-2",
+							testNow,
+							testNow,
+							uint64(20211110),
+							"ro",
+							"user-id",
+							"VerifyPhone",
+							domain.NotificationTypeSms,
+							"user.human.phone.code.added",
+							nil,
+							3,
+							domain.NotificationDeliveryStateFailed,
+							"rejected",
+						},
+					},
+				),
+			},
+			object: &NotificationDeliveries{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Deliveries: []*NotificationDelivery{
+					{
+						ID:               "id-1",
+						CreationDate:     testNow,
+						ChangeDate:       testNow,
+						Sequence:         20211109,
+						ResourceOwner:    "ro",
+						UserID:           "user-id",
+						MessageType:      "PasswordReset",
+						NotificationType: domain.NotificationTypeEmail,
+						EventType:        "user.human.password.code.added",
+						ProviderID:       "provider-id",
+						Attempts:         1,
+						State:            domain.NotificationDeliveryStateSent,
+					},
+					{
+						ID:               "id-2",
+						CreationDate:     testNow,
+						ChangeDate:       testNow,
+						Sequence:         20211110,
+						ResourceOwner:    "ro",
+						UserID:           "user-id",
+						MessageType:      "VerifyPhone",
+						NotificationType: domain.NotificationTypeSms,
+						EventType:        "user.human.phone.code.added",
+						Attempts:         3,
+						State:            domain.NotificationDeliveryStateFailed,
+						LastError:        "rejected",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareNotificationDeliveriesQuery sql err",
+			prepare: prepareNotificationDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNotificationDeliveriesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationDeliveries)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
@@ -24,7 +25,9 @@ type NotificationPolicy struct {
 	ResourceOwner string
 	State         domain.PolicyState
 
-	PasswordChange bool
+	PasswordChange        bool
+	SecurityNotifications bool
+	PhoneChannels         database.NumberArray[domain.PhoneChannel]
 
 	IsDefault bool
 }
@@ -62,6 +65,14 @@ var (
 		name:  projection.NotificationPolicyColumnPasswordChange,
 		table: notificationPolicyTable,
 	}
+	NotificationPolicyColSecurityNotifications = Column{
+		name:  projection.NotificationPolicyColumnSecurityNotifications,
+		table: notificationPolicyTable,
+	}
+	NotificationPolicyColPhoneChannels = Column{
+		name:  projection.NotificationPolicyColumnPhoneChannels,
+		table: notificationPolicyTable,
+	}
 	NotificationPolicyColIsDefault = Column{
 		name:  projection.NotificationPolicyColumnIsDefault,
 		table: notificationPolicyTable,
@@ -92,16 +103,11 @@ func (q *Queries) NotificationPolicyByOrg(ctx context.Context, shouldTriggerBulk
 	if !withOwnerRemoved {
 		eq[NotificationPolicyColOwnerRemoved.identifier()] = false
 	}
+	ownersJoin, ownersArgs := policyOwnersClause(ctx, NotificationPolicyColID, orgID)
 	stmt, scan := prepareNotificationPolicyQuery()
-	query, args, err := stmt.Where(
-		sq.And{
-			eq,
-			sq.Or{
-				sq.Eq{NotificationPolicyColID.identifier(): orgID},
-				sq.Eq{NotificationPolicyColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
-		}).
-		OrderBy(NotificationPolicyColIsDefault.identifier()).Limit(1).ToSql()
+	query, args, err := stmt.JoinClause(ownersJoin, ownersArgs...).
+		Where(eq).
+		OrderBy(policyOwnersDepth).Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-Xuoapqm", "Errors.Query.SQLStatement")
 	}
@@ -152,6 +158,8 @@ func prepareNotificationPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*Notifi
 			NotificationPolicyColChangeDate.identifier(),
 			NotificationPolicyColResourceOwner.identifier(),
 			NotificationPolicyColPasswordChange.identifier(),
+			NotificationPolicyColSecurityNotifications.identifier(),
+			NotificationPolicyColPhoneChannels.identifier(),
 			NotificationPolicyColIsDefault.identifier(),
 			NotificationPolicyColState.identifier(),
 		).
@@ -166,6 +174,8 @@ func prepareNotificationPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*Notifi
 				&policy.ChangeDate,
 				&policy.ResourceOwner,
 				&policy.PasswordChange,
+				&policy.SecurityNotifications,
+				&policy.PhoneChannels,
 				&policy.IsDefault,
 				&policy.State,
 			)
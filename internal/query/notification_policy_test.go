@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -19,6 +20,8 @@ var (
 		` projections.notification_policies.change_date,` +
 		` projections.notification_policies.resource_owner,` +
 		` projections.notification_policies.password_change,` +
+		` projections.notification_policies.security_notifications,` +
+		` projections.notification_policies.phone_channels,` +
 		` projections.notification_policies.is_default,` +
 		` projections.notification_policies.state` +
 		` FROM projections.notification_policies`)
@@ -29,6 +32,8 @@ var (
 		"change_date",
 		"resource_owner",
 		"password_change",
+		"security_notifications",
+		"phone_channels",
 		"is_default",
 		"state",
 	}
@@ -78,19 +83,23 @@ func Test_NotificationPolicyPrepares(t *testing.T) {
 						"ro",
 						true,
 						true,
+						database.NumberArray[domain.PhoneChannel]{domain.PhoneChannelWhatsApp, domain.PhoneChannelSMS},
+						true,
 						domain.PolicyStateActive,
 					},
 				),
 			},
 			object: &NotificationPolicy{
-				ID:             "pol-id",
-				CreationDate:   testNow,
-				ChangeDate:     testNow,
-				Sequence:       20211109,
-				ResourceOwner:  "ro",
-				State:          domain.PolicyStateActive,
-				PasswordChange: true,
-				IsDefault:      true,
+				ID:                    "pol-id",
+				CreationDate:          testNow,
+				ChangeDate:            testNow,
+				Sequence:              20211109,
+				ResourceOwner:         "ro",
+				State:                 domain.PolicyStateActive,
+				PasswordChange:        true,
+				SecurityNotifications: true,
+				PhoneChannels:         database.NumberArray[domain.PhoneChannel]{domain.PhoneChannelWhatsApp, domain.PhoneChannelSMS},
+				IsDefault:             true,
 			},
 		},
 		{
@@ -61,6 +61,10 @@ var (
 		name:  projection.OrgColumnDomain,
 		table: orgsTable,
 	}
+	OrgColumnParentID = Column{
+		name:  projection.OrgColumnParentID,
+		table: orgsTable,
+	}
 )
 
 type Orgs struct {
@@ -80,6 +84,8 @@ type Org struct {
 
 	Name   string
 	Domain string
+	// ParentID is the id of the parent organization, empty for top level organizations
+	ParentID string
 }
 
 func orgsCheckPermission(ctx context.Context, orgs *Orgs, permissionCheck domain_pkg.PermissionCheck) {
@@ -158,6 +164,7 @@ func (q *Queries) OrgByID(ctx context.Context, id string) (org *Org, err error)
 		instanceID:    foundOrg.InstanceID,
 		Name:          foundOrg.Name,
 		Domain:        foundOrg.PrimaryDomain.Domain,
+		ParentID:      foundOrg.ParentID,
 	}, nil
 }
 
@@ -354,6 +361,7 @@ func prepareOrgsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Orgs, error)) {
 			OrgColumnSequence.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 			countColumn.identifier()).
 			From(orgsTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -371,6 +379,7 @@ func prepareOrgsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Orgs, error)) {
 					&org.Sequence,
 					&org.Name,
 					&org.Domain,
+					&org.ParentID,
 					&count,
 				)
 				if err != nil {
@@ -403,6 +412,7 @@ func prepareOrgQuery() (sq.SelectBuilder, func(*sql.Row) (*Org, error)) {
 			OrgColumnInstanceID.identifier(),
 			OrgColumnName.identifier(),
 			OrgColumnDomain.identifier(),
+			OrgColumnParentID.identifier(),
 		).
 			From(orgsTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -418,6 +428,7 @@ func prepareOrgQuery() (sq.SelectBuilder, func(*sql.Row) (*Org, error)) {
 				&o.instanceID,
 				&o.Name,
 				&o.Domain,
+				&o.ParentID,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
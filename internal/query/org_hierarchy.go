@@ -0,0 +1,67 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	//go:embed org_hierarchy.sql
+	orgHierarchyQuery string
+)
+
+// OrgHierarchyIDs returns the id of the organization followed by the ids of its parents, the nearest first.
+func (q *Queries) OrgHierarchyIDs(ctx context.Context, orgID string) (ids []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, err := sq.Dollar.ReplacePlaceholders("SELECT id FROM (" + orgHierarchyQuery + ") hierarchy ORDER BY depth")
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ohx5i", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		return rows.Err()
+	},
+		stmt,
+		orgID,
+		authz.GetInstance(ctx).InstanceID(),
+		domain.OrgHierarchyMaxDepth,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Iej3o", "Errors.Internal")
+	}
+	return ids, nil
+}
+
+// policyOwnersClause joins the owners of the policies, which apply to the organization:
+// the organization, its parents and the instance for the default policy.
+// Order by [policyOwnersDepth] to get the nearest policy first.
+func policyOwnersClause(ctx context.Context, ownerColumn Column, orgID string) (string, []any) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	return "INNER JOIN (" + orgHierarchyQuery + "UNION ALL SELECT ?::TEXT, ?::INT) policy_owners ON policy_owners.id = " + ownerColumn.identifier(),
+		[]any{
+			orgID,
+			instanceID,
+			domain.OrgHierarchyMaxDepth,
+			instanceID,
+			domain.OrgHierarchyMaxDepth + 1,
+		}
+}
+
+const policyOwnersDepth = "policy_owners.depth"
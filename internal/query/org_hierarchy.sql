@@ -0,0 +1,15 @@
+WITH RECURSIVE hierarchy (id, depth) AS (
+    SELECT ?::TEXT
+        , 0
+    UNION ALL
+    SELECT op.parent_id
+        , h.depth + 1
+    FROM hierarchy h
+        JOIN eventstore.org_parents op
+            ON op.instance_id = ?
+                AND op.org_id = h.id
+    WHERE h.depth < ?
+)
+SELECT id
+    , depth
+FROM hierarchy
@@ -0,0 +1,40 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func Test_policyOwnersClause(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instanceID")
+
+	join, joinArgs := policyOwnersClause(ctx, LoginPolicyColumnOrgID, "orgID")
+	stmt, args, err := sq.Select(LoginPolicyColumnOrgID.identifier()).
+		From(loginPolicyTable.identifier()).
+		JoinClause(join, joinArgs...).
+		Where(sq.Eq{LoginPolicyColumnInstanceID.identifier(): "instanceID"}).
+		OrderBy(policyOwnersDepth).
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	require.NoError(t, err)
+
+	assert.Contains(t, stmt, "INNER JOIN (WITH RECURSIVE hierarchy (id, depth) AS (")
+	assert.Contains(t, stmt, "UNION ALL SELECT $4::TEXT, $5::INT) policy_owners ON policy_owners.id = "+LoginPolicyColumnOrgID.identifier()+
+		" WHERE "+LoginPolicyColumnInstanceID.identifier()+" = $6 ORDER BY policy_owners.depth LIMIT 1")
+	assert.Equal(t, []any{
+		"orgID",
+		"instanceID",
+		domain.OrgHierarchyMaxDepth,
+		"instanceID",
+		domain.OrgHierarchyMaxDepth + 1,
+		"instanceID",
+	}, args)
+}
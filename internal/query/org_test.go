@@ -30,6 +30,7 @@ var (
 		` projections.orgs1.sequence,` +
 		` projections.orgs1.name,` +
 		` projections.orgs1.primary_domain,` +
+		` projections.orgs1.parent_id,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.orgs1`
 	prepareOrgsQueryCols = []string{
@@ -41,6 +42,7 @@ var (
 		"sequence",
 		"name",
 		"primary_domain",
+		"parent_id",
 		"count",
 	}
 
@@ -52,7 +54,8 @@ var (
 		` projections.orgs1.sequence,` +
 		` projections.orgs1.instance_id,` +
 		` projections.orgs1.name,` +
-		` projections.orgs1.primary_domain` +
+		` projections.orgs1.primary_domain,` +
+		` projections.orgs1.parent_id` +
 		` FROM projections.orgs1`
 	prepareOrgQueryCols = []string{
 		"id",
@@ -64,6 +67,7 @@ var (
 		"instance_id",
 		"name",
 		"primary_domain",
+		"parent_id",
 	}
 
 	prepareOrgUniqueStmt = `SELECT COUNT(*) = 0` +
@@ -114,6 +118,7 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211109),
 							"org-name",
 							"zitadel.ch",
+							"",
 						},
 					},
 				),
@@ -153,6 +158,7 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211108),
 							"org-name-1",
 							"zitadel.ch",
+							"",
 						},
 						{
 							"id-2",
@@ -163,6 +169,7 @@ func Test_OrgPrepares(t *testing.T) {
 							uint64(20211108),
 							"org-name-2",
 							"caos.ch",
+							"id-1",
 						},
 					},
 				),
@@ -191,6 +198,7 @@ func Test_OrgPrepares(t *testing.T) {
 						Sequence:      20211108,
 						Name:          "org-name-2",
 						Domain:        "caos.ch",
+						ParentID:      "id-1",
 					},
 				},
 			},
@@ -247,6 +255,7 @@ func Test_OrgPrepares(t *testing.T) {
 						"instance-id",
 						"org-name",
 						"zitadel.ch",
+						"",
 					},
 				),
 			},
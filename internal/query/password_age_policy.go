@@ -27,6 +27,7 @@ type PasswordAgePolicy struct {
 
 	ExpireWarnDays uint64
 	MaxAgeDays     uint64
+	HistoryDepth   uint64
 
 	IsDefault bool
 }
@@ -68,6 +69,10 @@ var (
 		name:  projection.AgePolicyMaxAgeDaysCol,
 		table: passwordAgeTable,
 	}
+	PasswordAgeColHistoryDepth = Column{
+		name:  projection.AgePolicyHistoryDepthCol,
+		table: passwordAgeTable,
+	}
 	PasswordAgeColIsDefault = Column{
 		name:  projection.AgePolicyIsDefaultCol,
 		table: passwordAgeTable,
@@ -96,16 +101,11 @@ func (q *Queries) PasswordAgePolicyByOrg(ctx context.Context, shouldTriggerBulk
 	if !withOwnerRemoved {
 		eq[PasswordAgeColOwnerRemoved.identifier()] = false
 	}
+	ownersJoin, ownersArgs := policyOwnersClause(ctx, PasswordAgeColID, orgID)
 	stmt, scan := preparePasswordAgePolicyQuery()
-	query, args, err := stmt.Where(
-		sq.And{
-			eq,
-			sq.Or{
-				sq.Eq{PasswordAgeColID.identifier(): orgID},
-				sq.Eq{PasswordAgeColID.identifier(): authz.GetInstance(ctx).InstanceID()},
-			},
-		}).
-		OrderBy(PasswordAgeColIsDefault.identifier()).
+	query, args, err := stmt.JoinClause(ownersJoin, ownersArgs...).
+		Where(eq).
+		OrderBy(policyOwnersDepth).
 		Limit(1).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-SKR6X", "Errors.Query.SQLStatement")
@@ -155,6 +155,7 @@ func preparePasswordAgePolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*Passwor
 			PasswordAgeColResourceOwner.identifier(),
 			PasswordAgeColWarnDays.identifier(),
 			PasswordAgeColMaxAge.identifier(),
+			PasswordAgeColHistoryDepth.identifier(),
 			PasswordAgeColIsDefault.identifier(),
 			PasswordAgeColState.identifier(),
 		).
@@ -170,6 +171,7 @@ func preparePasswordAgePolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*Passwor
 				&policy.ResourceOwner,
 				&policy.ExpireWarnDays,
 				&policy.MaxAgeDays,
+				&policy.HistoryDepth,
 				&policy.IsDefault,
 				&policy.State,
 			)
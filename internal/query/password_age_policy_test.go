@@ -20,6 +20,7 @@ var (
 		` projections.password_age_policies2.resource_owner,` +
 		` projections.password_age_policies2.expire_warn_days,` +
 		` projections.password_age_policies2.max_age_days,` +
+		` projections.password_age_policies2.history_depth,` +
 		` projections.password_age_policies2.is_default,` +
 		` projections.password_age_policies2.state` +
 		` FROM projections.password_age_policies2`
@@ -31,6 +32,7 @@ var (
 		"resource_owner",
 		"expire_warn_days",
 		"max_age_days",
+		"history_depth",
 		"is_default",
 		"state",
 	}
@@ -80,6 +82,7 @@ func Test_PasswordAgePolicyPrepares(t *testing.T) {
 						"ro",
 						10,
 						20,
+						5,
 						true,
 						domain.PolicyStateActive,
 					},
@@ -94,6 +97,7 @@ func Test_PasswordAgePolicyPrepares(t *testing.T) {
 				State:          domain.PolicyStateActive,
 				ExpireWarnDays: 10,
 				MaxAgeDays:     20,
+				HistoryDepth:   5,
 				IsDefault:      true,
 			},
 		},
@@ -13,11 +13,11 @@ import (
 )
 
 const (
-	// eventstore.permitted_orgs(req_instance_id text, auth_user_id text, system_user_perms JSONB, perm text, filter_org text)
-	joinPermittedOrgsFunction = `INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?) permissions ON `
+	// eventstore.permitted_orgs(req_instance_id text, auth_user_id text, system_user_perms JSONB, perm text, filter_org text, max_depth int)
+	joinPermittedOrgsFunction = `INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?, ?) permissions ON `
 
-	// eventstore.permitted_projects(req_instance_id text, auth_user_id text, system_user_perms JSONB, perm text, filter_org text)
-	joinPermittedProjectsFunction = `INNER JOIN eventstore.permitted_projects(?, ?, ?, ?, ?) permissions ON `
+	// eventstore.permitted_projects(req_instance_id text, auth_user_id text, system_user_perms JSONB, perm text, filter_org text, max_depth int)
+	joinPermittedProjectsFunction = `INNER JOIN eventstore.permitted_projects(?, ?, ?, ?, ?, ?) permissions ON `
 )
 
 // permissionClauseBuilder is used to build the SQL clause for permission checks.
@@ -51,6 +51,7 @@ func (b *permissionClauseBuilder) joinFunction() (sql string, args []any) {
 		database.NewJSONArray(b.systemPermissions),
 		b.permission,
 		b.orgID,
+		domain_pkg.OrgHierarchyMaxDepth,
 	}
 }
 
@@ -50,13 +50,14 @@ func TestPermissionClause(t *testing.T) {
 				orgIDCol:   UserResourceOwnerCol,
 				permission: "permission1",
 			},
-			wantSql: "INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.users14.resource_owner = ANY(permissions.org_ids))",
+			wantSql: "INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.users14.resource_owner = ANY(permissions.org_ids))",
 			wantArgs: []any{
 				"instanceID",
 				"userID",
 				database.NewJSONArray(permissions),
 				"permission1",
 				(*string)(nil),
+				domain_pkg.OrgHierarchyMaxDepth,
 			},
 		},
 		{
@@ -69,13 +70,14 @@ func TestPermissionClause(t *testing.T) {
 					OwnedRowsPermissionOption(UserIDCol),
 				},
 			},
-			wantSql: "INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.users14.resource_owner = ANY(permissions.org_ids) OR projections.users14.id = ?)",
+			wantSql: "INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.users14.resource_owner = ANY(permissions.org_ids) OR projections.users14.id = ?)",
 			wantArgs: []any{
 				"instanceID",
 				"userID",
 				database.NewJSONArray(permissions),
 				"permission1",
 				(*string)(nil),
+				domain_pkg.OrgHierarchyMaxDepth,
 				"userID",
 			},
 		},
@@ -90,13 +92,14 @@ func TestPermissionClause(t *testing.T) {
 					ConnectionPermissionOption(UserStateCol, "bar"),
 				},
 			},
-			wantSql: "INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.users14.resource_owner = ANY(permissions.org_ids) OR projections.users14.id = ? OR projections.users14.state = ?)",
+			wantSql: "INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.users14.resource_owner = ANY(permissions.org_ids) OR projections.users14.id = ? OR projections.users14.state = ?)",
 			wantArgs: []any{
 				"instanceID",
 				"userID",
 				database.NewJSONArray(permissions),
 				"permission1",
 				(*string)(nil),
+				domain_pkg.OrgHierarchyMaxDepth,
 				"userID",
 				"bar",
 			},
@@ -114,13 +117,14 @@ func TestPermissionClause(t *testing.T) {
 					}),
 				},
 			},
-			wantSql: "INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.users14.resource_owner = ANY(permissions.org_ids))",
+			wantSql: "INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.users14.resource_owner = ANY(permissions.org_ids))",
 			wantArgs: []any{
 				"instanceID",
 				"userID",
 				database.NewJSONArray(permissions),
 				"permission1",
 				gu.Ptr("orgID"),
+				domain_pkg.OrgHierarchyMaxDepth,
 			},
 		},
 		{
@@ -133,13 +137,14 @@ func TestPermissionClause(t *testing.T) {
 					WithProjectsPermissionOption(ProjectColumnID),
 				},
 			},
-			wantSql: "INNER JOIN eventstore.permitted_projects(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.projects4.resource_owner = ANY(permissions.org_ids) OR projections.projects4.id = ANY(permissions.project_ids))",
+			wantSql: "INNER JOIN eventstore.permitted_projects(?, ?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.projects4.resource_owner = ANY(permissions.org_ids) OR projections.projects4.id = ANY(permissions.project_ids))",
 			wantArgs: []any{
 				"instanceID",
 				"userID",
 				database.NewJSONArray(permissions),
 				"permission1",
 				(*string)(nil),
+				domain_pkg.OrgHierarchyMaxDepth,
 			},
 		},
 		{
@@ -155,13 +160,14 @@ func TestPermissionClause(t *testing.T) {
 					}),
 				},
 			},
-			wantSql: "INNER JOIN eventstore.permitted_projects(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.projects4.resource_owner = ANY(permissions.org_ids) OR projections.projects4.id = ANY(permissions.project_ids))",
+			wantSql: "INNER JOIN eventstore.permitted_projects(?, ?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.projects4.resource_owner = ANY(permissions.org_ids) OR projections.projects4.id = ANY(permissions.project_ids))",
 			wantArgs: []any{
 				"instanceID",
 				"userID",
 				database.NewJSONArray(permissions),
 				"permission1",
 				gu.Ptr("orgID"),
+				domain_pkg.OrgHierarchyMaxDepth,
 			},
 		},
 	}
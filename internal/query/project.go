@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
@@ -47,6 +48,10 @@ var (
 		name:  projection.ProjectColumnPrivateLabelingSetting,
 		table: projectsTable,
 	}
+	ProjectColumnResourceURIs = Column{
+		name:  projection.ProjectColumnResourceURIs,
+		table: projectsTable,
+	}
 	ProjectColumnCreationDate = Column{
 		name:  projection.ProjectColumnCreationDate,
 		table: projectsTable,
@@ -122,6 +127,10 @@ var (
 		name:  projection.ProjectColumnPrivateLabelingSetting,
 		table: grantedProjectsAlias,
 	}
+	grantedProjectColumnResourceURIs = Column{
+		name:  projection.ProjectColumnResourceURIs,
+		table: grantedProjectsAlias,
+	}
 	grantedProjectColumnGrantResourceOwner = Column{
 		name:  "project_grant_resource_owner",
 		table: grantedProjectsAlias,
@@ -174,6 +183,7 @@ type Project struct {
 	ProjectRoleCheck       bool
 	HasProjectCheck        bool
 	PrivateLabelingSetting domain.PrivateLabelingSetting
+	ResourceURIs           database.TextArray[string]
 }
 
 type ProjectSearchQueries struct {
@@ -409,7 +419,8 @@ func prepareProjectQuery() (sq.SelectBuilder, func(*sql.Row) (*Project, error))
 			ProjectColumnProjectRoleAssertion.identifier(),
 			ProjectColumnProjectRoleCheck.identifier(),
 			ProjectColumnHasProjectCheck.identifier(),
-			ProjectColumnPrivateLabelingSetting.identifier()).
+			ProjectColumnPrivateLabelingSetting.identifier(),
+			ProjectColumnResourceURIs.identifier()).
 			From(projectsTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Project, error) {
@@ -426,6 +437,7 @@ func prepareProjectQuery() (sq.SelectBuilder, func(*sql.Row) (*Project, error))
 				&p.ProjectRoleCheck,
 				&p.HasProjectCheck,
 				&p.PrivateLabelingSetting,
+				&p.ResourceURIs,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -450,6 +462,7 @@ func prepareProjectsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Projects, error
 			ProjectColumnProjectRoleCheck.identifier(),
 			ProjectColumnHasProjectCheck.identifier(),
 			ProjectColumnPrivateLabelingSetting.identifier(),
+			ProjectColumnResourceURIs.identifier(),
 			countColumn.identifier()).
 			From(projectsTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -470,6 +483,7 @@ func prepareProjectsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Projects, error
 					&project.ProjectRoleCheck,
 					&project.HasProjectCheck,
 					&project.PrivateLabelingSetting,
+					&project.ResourceURIs,
 					&count,
 				)
 				if err != nil {
@@ -520,6 +534,7 @@ type GrantedProject struct {
 	ProjectRoleCheck       bool
 	HasProjectCheck        bool
 	PrivateLabelingSetting domain.PrivateLabelingSetting
+	ResourceURIs           database.TextArray[string]
 
 	GrantID           string
 	GrantedOrgID      string
@@ -540,6 +555,7 @@ func prepareGrantedProjectsQuery() (sq.SelectBuilder, func(*sql.Rows) (*GrantedP
 			grantedProjectColumnProjectRoleCheck.identifier(),
 			grantedProjectColumnHasProjectCheck.identifier(),
 			grantedProjectColumnPrivateLabelingSetting.identifier(),
+			grantedProjectColumnResourceURIs.identifier(),
 			grantedProjectColumnGrantID.identifier(),
 			grantedProjectColumnGrantedOrganization.identifier(),
 			grantedProjectColumnGrantedOrganizationName.identifier(),
@@ -570,6 +586,7 @@ func prepareGrantedProjectsQuery() (sq.SelectBuilder, func(*sql.Rows) (*GrantedP
 					&grantedProject.ProjectRoleCheck,
 					&grantedProject.HasProjectCheck,
 					&grantedProject.PrivateLabelingSetting,
+					&grantedProject.ResourceURIs,
 					&grantID,
 					&orgID,
 					&orgName,
@@ -628,6 +645,7 @@ func prepareProjects() string {
 		ProjectColumnProjectRoleCheck.identifier()+" AS "+grantedProjectColumnProjectRoleCheck.name,
 		ProjectColumnHasProjectCheck.identifier()+" AS "+grantedProjectColumnHasProjectCheck.name,
 		ProjectColumnPrivateLabelingSetting.identifier()+" AS "+grantedProjectColumnPrivateLabelingSetting.name,
+		ProjectColumnResourceURIs.identifier()+" AS "+grantedProjectColumnResourceURIs.name,
 		"NULL::TEXT AS "+grantedProjectColumnGrantResourceOwner.name,
 		"NULL::TEXT AS "+grantedProjectColumnGrantID.name,
 		"NULL::TEXT AS "+grantedProjectColumnGrantedOrganization.name,
@@ -656,6 +674,7 @@ func prepareGrantedProjects() string {
 		ProjectColumnProjectRoleCheck.identifier()+" AS "+grantedProjectColumnProjectRoleCheck.name,
 		ProjectColumnHasProjectCheck.identifier()+" AS "+grantedProjectColumnHasProjectCheck.name,
 		ProjectColumnPrivateLabelingSetting.identifier()+" AS "+grantedProjectColumnPrivateLabelingSetting.name,
+		ProjectColumnResourceURIs.identifier()+" AS "+grantedProjectColumnResourceURIs.name,
 		ProjectGrantColumnResourceOwner.identifier()+" AS "+grantedProjectColumnGrantResourceOwner.name,
 		ProjectGrantColumnGrantID.identifier()+" AS "+grantedProjectColumnGrantID.name,
 		ProjectGrantColumnGrantedOrgID.identifier()+" AS "+grantedProjectColumnGrantedOrganization.name,
@@ -69,6 +69,10 @@ var (
 		name:  projection.ProjectGrantColumnRoleKeys,
 		table: projectGrantsTable,
 	}
+	ProjectGrantColumnIncludeSubOrgs = Column{
+		name:  projection.ProjectGrantColumnIncludeSubOrgs,
+		table: projectGrantsTable,
+	}
 	ProjectGrantColumnGrantedOrgName = Column{
 		name:  projection.OrgColumnName,
 		table: orgsTable.setAlias(ProjectGrantGrantedOrgTableAlias),
@@ -305,6 +309,41 @@ func NewProjectGrantGrantedOrgIDSearchQuery(value string) (SearchQuery, error) {
 	return NewTextQuery(ProjectGrantColumnGrantedOrgID, value, TextEquals)
 }
 
+// NewProjectGrantIncludesOrgSearchQuery returns the project grants to the organization
+// and the project grants to its parents, which include the sub organizations.
+// The hierarchy starts with the organization itself, see [Queries.OrgHierarchyIDs].
+func NewProjectGrantIncludesOrgSearchQuery(hierarchy []string) (SearchQuery, error) {
+	if len(hierarchy) == 0 {
+		return nil, ErrEmptyValues
+	}
+	return &projectGrantIncludesOrgQuery{hierarchy: hierarchy}, nil
+}
+
+type projectGrantIncludesOrgQuery struct {
+	hierarchy []string
+}
+
+func (q *projectGrantIncludesOrgQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	return query.Where(q.comp())
+}
+
+func (q *projectGrantIncludesOrgQuery) comp() sq.Sqlizer {
+	if len(q.hierarchy) == 1 {
+		return sq.Eq{ProjectGrantColumnGrantedOrgID.identifier(): q.hierarchy[0]}
+	}
+	return sq.Or{
+		sq.Eq{ProjectGrantColumnGrantedOrgID.identifier(): q.hierarchy[0]},
+		sq.Eq{
+			ProjectGrantColumnIncludeSubOrgs.identifier(): true,
+			ProjectGrantColumnGrantedOrgID.identifier():   q.hierarchy[1:],
+		},
+	}
+}
+
+func (q *projectGrantIncludesOrgQuery) Col() Column {
+	return ProjectGrantColumnGrantedOrgID
+}
+
 func (q *ProjectGrantSearchQueries) AppendMyResourceOwnerQuery(orgID string) error {
 	query, err := NewProjectGrantResourceOwnerSearchQuery(orgID)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -25,6 +26,7 @@ var (
 		"project_role_check",
 		"has_project_check",
 		"private_labeling_setting",
+		"resource_uris",
 	}
 
 	prepareProjectsStmt = `SELECT projections.projects4.id,` +
@@ -38,6 +40,7 @@ var (
 		` projections.projects4.project_role_check,` +
 		` projections.projects4.has_project_check,` +
 		` projections.projects4.private_labeling_setting,` +
+		` projections.projects4.resource_uris,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.projects4`
 	prepareProjectsCols = []string{
@@ -52,6 +55,7 @@ var (
 		"project_role_check",
 		"has_project_check",
 		"private_labeling_setting",
+		"resource_uris",
 		"count",
 	}
 
@@ -65,7 +69,8 @@ var (
 		` projections.projects4.project_role_assertion,` +
 		` projections.projects4.project_role_check,` +
 		` projections.projects4.has_project_check,` +
-		` projections.projects4.private_labeling_setting` +
+		` projections.projects4.private_labeling_setting,` +
+		` projections.projects4.resource_uris` +
 		` FROM projections.projects4`
 	prepareProjectCols = []string{
 		"id",
@@ -79,6 +84,7 @@ var (
 		"project_role_check",
 		"has_project_check",
 		"private_labeling_setting",
+		"resource_uris",
 	}
 )
 
@@ -125,6 +131,7 @@ func Test_ProjectPrepares(t *testing.T) {
 							true,
 							true,
 							domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+							nil,
 						},
 					},
 				),
@@ -170,6 +177,7 @@ func Test_ProjectPrepares(t *testing.T) {
 							true,
 							true,
 							domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+							nil,
 						},
 						{
 							"id-2",
@@ -183,6 +191,7 @@ func Test_ProjectPrepares(t *testing.T) {
 							false,
 							false,
 							domain.PrivateLabelingSettingAllowLoginUserResourceOwnerPolicy,
+							nil,
 						},
 					},
 				),
@@ -275,6 +284,7 @@ func Test_ProjectPrepares(t *testing.T) {
 						true,
 						true,
 						domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+						database.TextArray[string]{"https://api.example.com"},
 					},
 				),
 			},
@@ -290,6 +300,7 @@ func Test_ProjectPrepares(t *testing.T) {
 				ProjectRoleCheck:       true,
 				HasProjectCheck:        true,
 				PrivateLabelingSetting: domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+				ResourceURIs:           database.TextArray[string]{"https://api.example.com"},
 			},
 		},
 		{
@@ -66,6 +66,9 @@ const (
 	AppOIDCConfigColumnAndroidPackageName            = "android_package_name"
 	AppOIDCConfigColumnAndroidSHA256CertFingerprints = "android_sha256_cert_fingerprints"
 	AppOIDCConfigColumnRegistrationToken             = "registration_token"
+	AppOIDCConfigColumnAuthorizationDetailsTypes     = "authorization_details_types"
+	AppOIDCConfigColumnRequireConsent                = "require_consent"
+	AppOIDCConfigColumnTokenExchangePolicy           = "token_exchange_policy"
 
 	appSAMLTableSuffix              = "saml_configs"
 	AppSAMLConfigColumnAppID        = "app_id"
@@ -143,6 +146,9 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppOIDCConfigColumnAndroidPackageName, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnAndroidSHA256CertFingerprints, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnRegistrationToken, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnAuthorizationDetailsTypes, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnRequireConsent, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnTokenExchangePolicy, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
 			appOIDCTableSuffix,
@@ -501,37 +507,43 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-GNHU1", "reduce.wrong.event.type %s", project.OIDCConfigAddedType)
 	}
+	cols := []handler.Column{
+		handler.NewCol(AppOIDCConfigColumnAppID, e.AppID),
+		handler.NewCol(AppOIDCConfigColumnInstanceID, e.Aggregate().InstanceID),
+		handler.NewCol(AppOIDCConfigColumnVersion, e.Version),
+		handler.NewCol(AppOIDCConfigColumnClientID, e.ClientID),
+		handler.NewCol(AppOIDCConfigColumnClientSecret, crypto.SecretOrEncodedHash(e.ClientSecret, e.HashedSecret)),
+		handler.NewCol(AppOIDCConfigColumnRedirectUris, database.TextArray[string](e.RedirectUris)),
+		handler.NewCol(AppOIDCConfigColumnResponseTypes, database.NumberArray[domain.OIDCResponseType](e.ResponseTypes)),
+		handler.NewCol(AppOIDCConfigColumnGrantTypes, database.NumberArray[domain.OIDCGrantType](e.GrantTypes)),
+		handler.NewCol(AppOIDCConfigColumnApplicationType, e.ApplicationType),
+		handler.NewCol(AppOIDCConfigColumnAuthMethodType, e.AuthMethodType),
+		handler.NewCol(AppOIDCConfigColumnPostLogoutRedirectUris, database.TextArray[string](e.PostLogoutRedirectUris)),
+		handler.NewCol(AppOIDCConfigColumnDevMode, e.DevMode),
+		handler.NewCol(AppOIDCConfigColumnAccessTokenType, e.AccessTokenType),
+		handler.NewCol(AppOIDCConfigColumnAccessTokenRoleAssertion, e.AccessTokenRoleAssertion),
+		handler.NewCol(AppOIDCConfigColumnIDTokenRoleAssertion, e.IDTokenRoleAssertion),
+		handler.NewCol(AppOIDCConfigColumnIDTokenUserinfoAssertion, e.IDTokenUserinfoAssertion),
+		handler.NewCol(AppOIDCConfigColumnClockSkew, e.ClockSkew),
+		handler.NewCol(AppOIDCConfigColumnAdditionalOrigins, database.TextArray[string](e.AdditionalOrigins)),
+		handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, e.SkipNativeAppSuccessPage),
+		handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, e.BackChannelLogoutURI),
+		handler.NewCol(AppOIDCConfigColumnLoginVersion, e.LoginVersion),
+		handler.NewCol(AppOIDCConfigColumnLoginBaseURI, e.LoginBaseURI),
+		handler.NewCol(AppOIDCConfigColumnIOSTeamID, e.IOSTeamID),
+		handler.NewCol(AppOIDCConfigColumnIOSBundleID, e.IOSBundleID),
+		handler.NewCol(AppOIDCConfigColumnAndroidPackageName, e.AndroidPackageName),
+		handler.NewCol(AppOIDCConfigColumnAndroidSHA256CertFingerprints, database.TextArray[string](e.AndroidSHA256CertFingerprints)),
+		handler.NewCol(AppOIDCConfigColumnAuthorizationDetailsTypes, database.TextArray[string](e.AuthorizationDetailsTypes)),
+		handler.NewCol(AppOIDCConfigColumnRequireConsent, e.RequireConsent),
+	}
+	if e.TokenExchangePolicy != nil {
+		cols = append(cols, handler.NewJSONCol(AppOIDCConfigColumnTokenExchangePolicy, e.TokenExchangePolicy))
+	}
 	return handler.NewMultiStatement(
 		e,
 		handler.AddCreateStatement(
-			[]handler.Column{
-				handler.NewCol(AppOIDCConfigColumnAppID, e.AppID),
-				handler.NewCol(AppOIDCConfigColumnInstanceID, e.Aggregate().InstanceID),
-				handler.NewCol(AppOIDCConfigColumnVersion, e.Version),
-				handler.NewCol(AppOIDCConfigColumnClientID, e.ClientID),
-				handler.NewCol(AppOIDCConfigColumnClientSecret, crypto.SecretOrEncodedHash(e.ClientSecret, e.HashedSecret)),
-				handler.NewCol(AppOIDCConfigColumnRedirectUris, database.TextArray[string](e.RedirectUris)),
-				handler.NewCol(AppOIDCConfigColumnResponseTypes, database.NumberArray[domain.OIDCResponseType](e.ResponseTypes)),
-				handler.NewCol(AppOIDCConfigColumnGrantTypes, database.NumberArray[domain.OIDCGrantType](e.GrantTypes)),
-				handler.NewCol(AppOIDCConfigColumnApplicationType, e.ApplicationType),
-				handler.NewCol(AppOIDCConfigColumnAuthMethodType, e.AuthMethodType),
-				handler.NewCol(AppOIDCConfigColumnPostLogoutRedirectUris, database.TextArray[string](e.PostLogoutRedirectUris)),
-				handler.NewCol(AppOIDCConfigColumnDevMode, e.DevMode),
-				handler.NewCol(AppOIDCConfigColumnAccessTokenType, e.AccessTokenType),
-				handler.NewCol(AppOIDCConfigColumnAccessTokenRoleAssertion, e.AccessTokenRoleAssertion),
-				handler.NewCol(AppOIDCConfigColumnIDTokenRoleAssertion, e.IDTokenRoleAssertion),
-				handler.NewCol(AppOIDCConfigColumnIDTokenUserinfoAssertion, e.IDTokenUserinfoAssertion),
-				handler.NewCol(AppOIDCConfigColumnClockSkew, e.ClockSkew),
-				handler.NewCol(AppOIDCConfigColumnAdditionalOrigins, database.TextArray[string](e.AdditionalOrigins)),
-				handler.NewCol(AppOIDCConfigColumnSkipNativeAppSuccessPage, e.SkipNativeAppSuccessPage),
-				handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, e.BackChannelLogoutURI),
-				handler.NewCol(AppOIDCConfigColumnLoginVersion, e.LoginVersion),
-				handler.NewCol(AppOIDCConfigColumnLoginBaseURI, e.LoginBaseURI),
-				handler.NewCol(AppOIDCConfigColumnIOSTeamID, e.IOSTeamID),
-				handler.NewCol(AppOIDCConfigColumnIOSBundleID, e.IOSBundleID),
-				handler.NewCol(AppOIDCConfigColumnAndroidPackageName, e.AndroidPackageName),
-				handler.NewCol(AppOIDCConfigColumnAndroidSHA256CertFingerprints, database.TextArray[string](e.AndroidSHA256CertFingerprints)),
-			},
+			cols,
 			handler.WithTableSuffix(appOIDCTableSuffix),
 		),
 		handler.AddUpdateStatement(
@@ -620,6 +632,15 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.AndroidSHA256CertFingerprints != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnAndroidSHA256CertFingerprints, database.TextArray[string](*e.AndroidSHA256CertFingerprints)))
 	}
+	if e.AuthorizationDetailsTypes != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnAuthorizationDetailsTypes, database.TextArray[string](*e.AuthorizationDetailsTypes)))
+	}
+	if e.RequireConsent != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequireConsent, *e.RequireConsent))
+	}
+	if e.TokenExchangePolicy != nil {
+		cols = append(cols, handler.NewJSONCol(AppOIDCConfigColumnTokenExchangePolicy, e.TokenExchangePolicy))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
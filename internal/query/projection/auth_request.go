@@ -14,21 +14,24 @@ import (
 const (
 	AuthRequestsProjectionTable = "projections.auth_requests"
 
-	AuthRequestColumnID            = "id"
-	AuthRequestColumnCreationDate  = "creation_date"
-	AuthRequestColumnChangeDate    = "change_date"
-	AuthRequestColumnSequence      = "sequence"
-	AuthRequestColumnResourceOwner = "resource_owner"
-	AuthRequestColumnInstanceID    = "instance_id"
-	AuthRequestColumnLoginClient   = "login_client"
-	AuthRequestColumnClientID      = "client_id"
-	AuthRequestColumnRedirectURI   = "redirect_uri"
-	AuthRequestColumnScope         = "scope"
-	AuthRequestColumnPrompt        = "prompt"
-	AuthRequestColumnUILocales     = "ui_locales"
-	AuthRequestColumnMaxAge        = "max_age"
-	AuthRequestColumnLoginHint     = "login_hint"
-	AuthRequestColumnHintUserID    = "hint_user_id"
+	AuthRequestColumnID                   = "id"
+	AuthRequestColumnCreationDate         = "creation_date"
+	AuthRequestColumnChangeDate           = "change_date"
+	AuthRequestColumnSequence             = "sequence"
+	AuthRequestColumnResourceOwner        = "resource_owner"
+	AuthRequestColumnInstanceID           = "instance_id"
+	AuthRequestColumnLoginClient          = "login_client"
+	AuthRequestColumnClientID             = "client_id"
+	AuthRequestColumnRedirectURI          = "redirect_uri"
+	AuthRequestColumnScope                = "scope"
+	AuthRequestColumnPrompt               = "prompt"
+	AuthRequestColumnUILocales            = "ui_locales"
+	AuthRequestColumnMaxAge               = "max_age"
+	AuthRequestColumnLoginHint            = "login_hint"
+	AuthRequestColumnHintUserID           = "hint_user_id"
+	AuthRequestColumnAuthorizationDetails = "authorization_details"
+	AuthRequestColumnRequireConsent       = "require_consent"
+	AuthRequestColumnACRValues            = "acr_values"
 )
 
 type authRequestProjection struct{}
@@ -60,6 +63,9 @@ func (*authRequestProjection) Init() *old_handler.Check {
 			handler.NewColumn(AuthRequestColumnMaxAge, handler.ColumnTypeInt64, handler.Nullable()),
 			handler.NewColumn(AuthRequestColumnLoginHint, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AuthRequestColumnHintUserID, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AuthRequestColumnAuthorizationDetails, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(AuthRequestColumnRequireConsent, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AuthRequestColumnACRValues, handler.ColumnTypeTextArray, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AuthRequestColumnInstanceID, AuthRequestColumnID),
 		),
@@ -103,26 +109,33 @@ func (p *authRequestProjection) reduceAuthRequestAdded(event eventstore.Event) (
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Sfwfa", "reduce.wrong.event.type %s", authrequest.AddedType)
 	}
 
-	return handler.NewCreateStatement(
-		e,
-		[]handler.Column{
-			handler.NewCol(AuthRequestColumnID, e.Aggregate().ID),
-			handler.NewCol(AuthRequestColumnInstanceID, e.Aggregate().InstanceID),
-			handler.NewCol(AuthRequestColumnCreationDate, e.CreationDate()),
-			handler.NewCol(AuthRequestColumnChangeDate, e.CreationDate()),
-			handler.NewCol(AuthRequestColumnResourceOwner, e.Aggregate().ResourceOwner),
-			handler.NewCol(AuthRequestColumnSequence, e.Sequence()),
-			handler.NewCol(AuthRequestColumnLoginClient, e.LoginClient),
-			handler.NewCol(AuthRequestColumnClientID, e.ClientID),
-			handler.NewCol(AuthRequestColumnRedirectURI, e.RedirectURI),
-			handler.NewCol(AuthRequestColumnScope, e.Scope),
-			handler.NewCol(AuthRequestColumnPrompt, e.Prompt),
-			handler.NewCol(AuthRequestColumnUILocales, e.UILocales),
-			handler.NewCol(AuthRequestColumnMaxAge, e.MaxAge),
-			handler.NewCol(AuthRequestColumnLoginHint, e.LoginHint),
-			handler.NewCol(AuthRequestColumnHintUserID, e.HintUserID),
-		},
-	), nil
+	cols := []handler.Column{
+		handler.NewCol(AuthRequestColumnID, e.Aggregate().ID),
+		handler.NewCol(AuthRequestColumnInstanceID, e.Aggregate().InstanceID),
+		handler.NewCol(AuthRequestColumnCreationDate, e.CreationDate()),
+		handler.NewCol(AuthRequestColumnChangeDate, e.CreationDate()),
+		handler.NewCol(AuthRequestColumnResourceOwner, e.Aggregate().ResourceOwner),
+		handler.NewCol(AuthRequestColumnSequence, e.Sequence()),
+		handler.NewCol(AuthRequestColumnLoginClient, e.LoginClient),
+		handler.NewCol(AuthRequestColumnClientID, e.ClientID),
+		handler.NewCol(AuthRequestColumnRedirectURI, e.RedirectURI),
+		handler.NewCol(AuthRequestColumnScope, e.Scope),
+		handler.NewCol(AuthRequestColumnPrompt, e.Prompt),
+		handler.NewCol(AuthRequestColumnUILocales, e.UILocales),
+		handler.NewCol(AuthRequestColumnMaxAge, e.MaxAge),
+		handler.NewCol(AuthRequestColumnLoginHint, e.LoginHint),
+		handler.NewCol(AuthRequestColumnHintUserID, e.HintUserID),
+	}
+	if len(e.AuthorizationDetails) > 0 {
+		cols = append(cols, handler.NewJSONCol(AuthRequestColumnAuthorizationDetails, e.AuthorizationDetails))
+	}
+	if e.RequireConsent {
+		cols = append(cols, handler.NewCol(AuthRequestColumnRequireConsent, e.RequireConsent))
+	}
+	if len(e.ACRValues) > 0 {
+		cols = append(cols, handler.NewCol(AuthRequestColumnACRValues, e.ACRValues))
+	}
+	return handler.NewCreateStatement(e, cols), nil
 }
 
 func (p *authRequestProjection) reduceAuthRequestEnded(event eventstore.Event) (*handler.Statement, error) {
@@ -62,6 +62,126 @@ func TestAuthRequestProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceAuthRequestAdded with authorization details",
+			args: args{
+				event: getEvent(testEvent(
+					authrequest.AddedType,
+					authrequest.AggregateType,
+					[]byte(`{"login_client": "loginClient", "client_id":"clientId","redirect_uri": "redirectURI", "scope": ["openid"], "authorization_details": [{"type":"payment_initiation","actions":["initiate"]}]}`),
+				), authrequest.AddedEventMapper),
+			},
+			reduce: (&authRequestProjection{}).reduceAuthRequestAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("auth_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.auth_requests (id, instance_id, creation_date, change_date, resource_owner, sequence, login_client, client_id, redirect_uri, scope, prompt, ui_locales, max_age, login_hint, hint_user_id, authorization_details) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								"loginClient",
+								"clientId",
+								"redirectURI",
+								[]string{"openid"},
+								[]domain.Prompt(nil),
+								[]string(nil),
+								(*time.Duration)(nil),
+								(*string)(nil),
+								(*string)(nil),
+								[]byte(`[{"actions":["initiate"],"type":"payment_initiation"}]`),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAuthRequestAdded with require consent",
+			args: args{
+				event: getEvent(testEvent(
+					authrequest.AddedType,
+					authrequest.AggregateType,
+					[]byte(`{"login_client": "loginClient", "client_id":"clientId","redirect_uri": "redirectURI", "scope": ["openid"], "require_consent": true}`),
+				), authrequest.AddedEventMapper),
+			},
+			reduce: (&authRequestProjection{}).reduceAuthRequestAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("auth_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.auth_requests (id, instance_id, creation_date, change_date, resource_owner, sequence, login_client, client_id, redirect_uri, scope, prompt, ui_locales, max_age, login_hint, hint_user_id, require_consent) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								"loginClient",
+								"clientId",
+								"redirectURI",
+								[]string{"openid"},
+								[]domain.Prompt(nil),
+								[]string(nil),
+								(*time.Duration)(nil),
+								(*string)(nil),
+								(*string)(nil),
+								true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAuthRequestAdded with acr values",
+			args: args{
+				event: getEvent(testEvent(
+					authrequest.AddedType,
+					authrequest.AggregateType,
+					[]byte(`{"login_client": "loginClient", "client_id":"clientId","redirect_uri": "redirectURI", "scope": ["openid"], "acr_values": ["urn:zitadel:acr:mfa"]}`),
+				), authrequest.AddedEventMapper),
+			},
+			reduce: (&authRequestProjection{}).reduceAuthRequestAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("auth_request"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.auth_requests (id, instance_id, creation_date, change_date, resource_owner, sequence, login_client, client_id, redirect_uri, scope, prompt, ui_locales, max_age, login_hint, hint_user_id, acr_values) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								"loginClient",
+								"clientId",
+								"redirectURI",
+								[]string{"openid"},
+								[]domain.Prompt(nil),
+								[]string(nil),
+								(*time.Duration)(nil),
+								(*string)(nil),
+								(*string)(nil),
+								[]string{"urn:zitadel:acr:mfa"},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceAuthRequestFailed",
 			args: args{
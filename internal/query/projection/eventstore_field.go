@@ -7,6 +7,7 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/permission"
 	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/session"
 )
 
 const (
@@ -15,6 +16,8 @@ const (
 	fieldsInstanceDomain    = "instance_domain_fields"
 	fieldsMemberships       = "membership_fields"
 	fieldsPermission        = "permission_fields"
+	fieldsSessionUser       = "session_user_fields"
+	fieldsOrgParent         = "org_parent_fields"
 )
 
 func newFillProjectGrantFields(config handler.Config) *handler.FieldHandler {
@@ -98,3 +101,30 @@ func newFillPermissionFields(config handler.Config) *handler.FieldHandler {
 		},
 	)
 }
+
+func newFillSessionUserFields(config handler.Config) *handler.FieldHandler {
+	return handler.NewFieldHandler(
+		&config,
+		fieldsSessionUser,
+		map[eventstore.AggregateType][]eventstore.EventType{
+			session.AggregateType: {
+				session.UserCheckedType,
+				session.TerminateType,
+			},
+		},
+	)
+}
+
+func newFillOrgParentFields(config handler.Config) *handler.FieldHandler {
+	return handler.NewFieldHandler(
+		&config,
+		fieldsOrgParent,
+		map[eventstore.AggregateType][]eventstore.EventType{
+			org.AggregateType: {
+				org.OrgParentSetEventType,
+				org.OrgParentRemovedEventType,
+				org.OrgRemovedEventType,
+			},
+		},
+	)
+}
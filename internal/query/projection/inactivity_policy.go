@@ -0,0 +1,206 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	InactivityPolicyProjectionTable = "projections.inactivity_policies"
+
+	InactivityPolicyColumnID                 = "id"
+	InactivityPolicyColumnCreationDate       = "creation_date"
+	InactivityPolicyColumnChangeDate         = "change_date"
+	InactivityPolicyColumnResourceOwner      = "resource_owner"
+	InactivityPolicyColumnInstanceID         = "instance_id"
+	InactivityPolicyColumnSequence           = "sequence"
+	InactivityPolicyColumnState              = "state"
+	InactivityPolicyColumnIsDefault          = "is_default"
+	InactivityPolicyColumnWarnDays           = "warn_days"
+	InactivityPolicyColumnDeactivateDays     = "deactivate_days"
+	InactivityPolicyColumnDeleteDays         = "delete_days"
+	InactivityPolicyColumnExemptMachineUsers = "exempt_machine_users"
+	InactivityPolicyColumnExemptRoles        = "exempt_roles"
+)
+
+type inactivityPolicyProjection struct{}
+
+func newInactivityPolicyProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(inactivityPolicyProjection))
+}
+
+func (*inactivityPolicyProjection) Name() string {
+	return InactivityPolicyProjectionTable
+}
+
+func (*inactivityPolicyProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(InactivityPolicyColumnID, handler.ColumnTypeText),
+			handler.NewColumn(InactivityPolicyColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(InactivityPolicyColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(InactivityPolicyColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(InactivityPolicyColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(InactivityPolicyColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(InactivityPolicyColumnState, handler.ColumnTypeEnum),
+			handler.NewColumn(InactivityPolicyColumnIsDefault, handler.ColumnTypeBool),
+			handler.NewColumn(InactivityPolicyColumnWarnDays, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(InactivityPolicyColumnDeactivateDays, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(InactivityPolicyColumnDeleteDays, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(InactivityPolicyColumnExemptMachineUsers, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(InactivityPolicyColumnExemptRoles, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(InactivityPolicyColumnInstanceID, InactivityPolicyColumnID),
+		),
+	)
+}
+
+func (p *inactivityPolicyProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.InactivityPolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  org.InactivityPolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  org.InactivityPolicyRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(InactivityPolicyColumnInstanceID),
+				},
+				{
+					Event:  instance.InactivityPolicyAddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  instance.InactivityPolicyChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+			},
+		},
+	}
+}
+
+func (p *inactivityPolicyProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.InactivityPolicyAddedEvent
+	var isDefault bool
+	switch e := event.(type) {
+	case *org.InactivityPolicyAddedEvent:
+		policyEvent = e.InactivityPolicyAddedEvent
+		isDefault = false
+	case *instance.InactivityPolicyAddedEvent:
+		policyEvent = e.InactivityPolicyAddedEvent
+		isDefault = true
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ahng8", "reduce.wrong.event.type %v", []eventstore.EventType{org.InactivityPolicyAddedEventType, instance.InactivityPolicyAddedEventType})
+	}
+	return handler.NewCreateStatement(
+		&policyEvent,
+		[]handler.Column{
+			handler.NewCol(InactivityPolicyColumnCreationDate, policyEvent.CreationDate()),
+			handler.NewCol(InactivityPolicyColumnChangeDate, policyEvent.CreationDate()),
+			handler.NewCol(InactivityPolicyColumnSequence, policyEvent.Sequence()),
+			handler.NewCol(InactivityPolicyColumnID, policyEvent.Aggregate().ID),
+			handler.NewCol(InactivityPolicyColumnState, domain.PolicyStateActive),
+			handler.NewCol(InactivityPolicyColumnWarnDays, policyEvent.WarnDays),
+			handler.NewCol(InactivityPolicyColumnDeactivateDays, policyEvent.DeactivateDays),
+			handler.NewCol(InactivityPolicyColumnDeleteDays, policyEvent.DeleteDays),
+			handler.NewCol(InactivityPolicyColumnExemptMachineUsers, policyEvent.ExemptMachineUsers),
+			handler.NewCol(InactivityPolicyColumnExemptRoles, database.TextArray[string](policyEvent.ExemptRoles)),
+			handler.NewCol(InactivityPolicyColumnIsDefault, isDefault),
+			handler.NewCol(InactivityPolicyColumnResourceOwner, policyEvent.Aggregate().ResourceOwner),
+			handler.NewCol(InactivityPolicyColumnInstanceID, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *inactivityPolicyProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	var policyEvent policy.InactivityPolicyChangedEvent
+	switch e := event.(type) {
+	case *org.InactivityPolicyChangedEvent:
+		policyEvent = e.InactivityPolicyChangedEvent
+	case *instance.InactivityPolicyChangedEvent:
+		policyEvent = e.InactivityPolicyChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Eeh8o", "reduce.wrong.event.type %v", []eventstore.EventType{org.InactivityPolicyChangedEventType, instance.InactivityPolicyChangedEventType})
+	}
+	cols := []handler.Column{
+		handler.NewCol(InactivityPolicyColumnChangeDate, policyEvent.CreationDate()),
+		handler.NewCol(InactivityPolicyColumnSequence, policyEvent.Sequence()),
+	}
+	if policyEvent.WarnDays != nil {
+		cols = append(cols, handler.NewCol(InactivityPolicyColumnWarnDays, *policyEvent.WarnDays))
+	}
+	if policyEvent.DeactivateDays != nil {
+		cols = append(cols, handler.NewCol(InactivityPolicyColumnDeactivateDays, *policyEvent.DeactivateDays))
+	}
+	if policyEvent.DeleteDays != nil {
+		cols = append(cols, handler.NewCol(InactivityPolicyColumnDeleteDays, *policyEvent.DeleteDays))
+	}
+	if policyEvent.ExemptMachineUsers != nil {
+		cols = append(cols, handler.NewCol(InactivityPolicyColumnExemptMachineUsers, *policyEvent.ExemptMachineUsers))
+	}
+	if policyEvent.ExemptRoles != nil {
+		cols = append(cols, handler.NewCol(InactivityPolicyColumnExemptRoles, database.TextArray[string](*policyEvent.ExemptRoles)))
+	}
+	return handler.NewUpdateStatement(
+		&policyEvent,
+		cols,
+		[]handler.Condition{
+			handler.NewCond(InactivityPolicyColumnID, policyEvent.Aggregate().ID),
+			handler.NewCond(InactivityPolicyColumnInstanceID, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *inactivityPolicyProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	policyEvent, ok := event.(*org.InactivityPolicyRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-ooL3a", "reduce.wrong.event.type %s", org.InactivityPolicyRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		policyEvent,
+		[]handler.Condition{
+			handler.NewCond(InactivityPolicyColumnID, policyEvent.Aggregate().ID),
+			handler.NewCond(InactivityPolicyColumnInstanceID, policyEvent.Aggregate().InstanceID),
+		}), nil
+}
+
+func (p *inactivityPolicyProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Xoo4i", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(InactivityPolicyColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(InactivityPolicyColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
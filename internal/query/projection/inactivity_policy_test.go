@@ -0,0 +1,270 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestInactivityPolicyProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "org reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.InactivityPolicyAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+						"warnDays": 30,
+						"deactivateDays": 60,
+						"deleteDays": 90,
+						"exemptMachineUsers": true,
+						"exemptRoles": ["ORG_OWNER"]
+}`),
+					), org.InactivityPolicyAddedEventMapper),
+			},
+			reduce: (&inactivityPolicyProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.inactivity_policies (creation_date, change_date, sequence, id, state, warn_days, deactivate_days, delete_days, exempt_machine_users, exempt_roles, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								domain.PolicyStateActive,
+								uint64(30),
+								uint64(60),
+								uint64(90),
+								true,
+								database.TextArray[string]{"ORG_OWNER"},
+								false,
+								"ro-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceChanged",
+			reduce: (&inactivityPolicyProjection{}).reduceChanged,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.InactivityPolicyChangedEventType,
+						org.AggregateType,
+						[]byte(`{
+						"deleteDays": 120,
+						"exemptRoles": ["ORG_OWNER", "PROJECT_OWNER"]
+		}`),
+					), org.InactivityPolicyChangedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.inactivity_policies SET (change_date, sequence, delete_days, exempt_roles) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								uint64(120),
+								database.TextArray[string]{"ORG_OWNER", "PROJECT_OWNER"},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceRemoved",
+			reduce: (&inactivityPolicyProjection{}).reduceRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.InactivityPolicyRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.InactivityPolicyRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.inactivity_policies WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(InactivityPolicyColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.inactivity_policies WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "instance reduceAdded",
+			reduce: (&inactivityPolicyProjection{}).reduceAdded,
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InactivityPolicyAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"exemptMachineUsers": true
+					}`),
+					), instance.InactivityPolicyAddedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.inactivity_policies (creation_date, change_date, sequence, id, state, warn_days, deactivate_days, delete_days, exempt_machine_users, exempt_roles, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								domain.PolicyStateActive,
+								uint64(0),
+								uint64(0),
+								uint64(0),
+								true,
+								database.TextArray[string](nil),
+								true,
+								"ro-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "instance reduceChanged",
+			reduce: (&inactivityPolicyProjection{}).reduceChanged,
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InactivityPolicyChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"warnDays": 30
+					}`),
+					), instance.InactivityPolicyChangedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.inactivity_policies SET (change_date, sequence, warn_days) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								uint64(30),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org.reduceOwnerRemoved",
+			reduce: (&inactivityPolicyProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.inactivity_policies WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, InactivityPolicyProjectionTable, tt.want)
+		})
+	}
+}
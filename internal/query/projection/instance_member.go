@@ -72,6 +72,14 @@ func (p *instanceMemberProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.MemberRemovedEventType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  instance.MemberValiditySetEventType,
+					Reduce: p.reduceValiditySet,
+				},
+				{
+					Event:  instance.MemberExpiredEventType,
+					Reduce: p.reduceExpired,
+				},
 				{
 					Event:  instance.InstanceRemovedEventType,
 					Reduce: reduceInstanceRemovedHelper(AppColumnInstanceID),
@@ -136,6 +144,22 @@ func (p *instanceMemberProjection) reduceRemoved(event eventstore.Event) (*handl
 	return reduceMemberRemoved(e, withMemberCond(MemberUserIDCol, e.UserID))
 }
 
+func (p *instanceMemberProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.MemberValiditySetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ieG0u", "reduce.wrong.event.type %s", instance.MemberValiditySetEventType)
+	}
+	return reduceMemberValiditySet(e.MemberValiditySetEvent)
+}
+
+func (p *instanceMemberProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.MemberExpiredEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Chee9", "reduce.wrong.event.type %s", instance.MemberExpiredEventType)
+	}
+	return reduceMemberExpired(e.MemberExpiredEvent)
+}
+
 func (p *instanceMemberProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.UserRemovedEvent)
 	if !ok {
@@ -3,6 +3,7 @@ package projection
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -40,6 +41,16 @@ const (
 	MFAInitSkipLifetimeCol              = "mfa_init_skip_lifetime"
 	SecondFactorCheckLifetimeCol        = "second_factor_check_lifetime"
 	MultiFactorCheckLifetimeCol         = "multi_factor_check_lifetime"
+	AllowMagicLinkCol                   = "allow_magic_link"
+	TrustedDeviceLifetimeCol            = "trusted_device_lifetime"
+	SessionIdleTimeoutCol               = "session_idle_timeout"
+	SessionMaxLifetimeCol               = "session_max_lifetime"
+	MaxConcurrentSessionsCol            = "max_concurrent_sessions"
+	WebAuthNAttestationCol              = "webauthn_attestation"
+	WebAuthNUserVerificationCol         = "webauthn_user_verification"
+	WebAuthNResidentKeyCol              = "webauthn_resident_key"
+	WebAuthNAllowedAAGUIDsCol           = "webauthn_allowed_aaguids"
+	WebAuthNDeniedAAGUIDsCol            = "webauthn_denied_aaguids"
 	LoginPolicyOwnerRemovedCol          = "owner_removed"
 )
 
@@ -81,6 +92,16 @@ func (*loginPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(MFAInitSkipLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(SecondFactorCheckLifetimeCol, handler.ColumnTypeInt64),
 			handler.NewColumn(MultiFactorCheckLifetimeCol, handler.ColumnTypeInt64),
+			handler.NewColumn(AllowMagicLinkCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(TrustedDeviceLifetimeCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(SessionIdleTimeoutCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(SessionMaxLifetimeCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(MaxConcurrentSessionsCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(WebAuthNAttestationCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(WebAuthNUserVerificationCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(WebAuthNResidentKeyCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(WebAuthNAllowedAAGUIDsCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(WebAuthNDeniedAAGUIDsCol, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(LoginPolicyOwnerRemovedCol, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(LoginPolicyInstanceIDCol, LoginPolicyIDCol),
@@ -202,6 +223,16 @@ func (p *loginPolicyProjection) reduceLoginPolicyAdded(event eventstore.Event) (
 		handler.NewCol(MFAInitSkipLifetimeCol, policyEvent.MFAInitSkipLifetime),
 		handler.NewCol(SecondFactorCheckLifetimeCol, policyEvent.SecondFactorCheckLifetime),
 		handler.NewCol(MultiFactorCheckLifetimeCol, policyEvent.MultiFactorCheckLifetime),
+		handler.NewCol(AllowMagicLinkCol, policyEvent.AllowMagicLink),
+		handler.NewCol(TrustedDeviceLifetimeCol, policyEvent.TrustedDeviceLifetime),
+		handler.NewCol(SessionIdleTimeoutCol, policyEvent.SessionIdleTimeout),
+		handler.NewCol(SessionMaxLifetimeCol, policyEvent.SessionMaxLifetime),
+		handler.NewCol(MaxConcurrentSessionsCol, policyEvent.MaxConcurrentSessions),
+		handler.NewCol(WebAuthNAttestationCol, policyEvent.WebAuthNAttestation),
+		handler.NewCol(WebAuthNUserVerificationCol, policyEvent.WebAuthNUserVerification),
+		handler.NewCol(WebAuthNResidentKeyCol, policyEvent.WebAuthNResidentKey),
+		handler.NewCol(WebAuthNAllowedAAGUIDsCol, database.TextArray[string](policyEvent.WebAuthNAllowedAAGUIDs)),
+		handler.NewCol(WebAuthNDeniedAAGUIDsCol, database.TextArray[string](policyEvent.WebAuthNDeniedAAGUIDs)),
 	}), nil
 }
 
@@ -271,6 +302,36 @@ func (p *loginPolicyProjection) reduceLoginPolicyChanged(event eventstore.Event)
 	if policyEvent.MultiFactorCheckLifetime != nil {
 		cols = append(cols, handler.NewCol(MultiFactorCheckLifetimeCol, *policyEvent.MultiFactorCheckLifetime))
 	}
+	if policyEvent.AllowMagicLink != nil {
+		cols = append(cols, handler.NewCol(AllowMagicLinkCol, *policyEvent.AllowMagicLink))
+	}
+	if policyEvent.TrustedDeviceLifetime != nil {
+		cols = append(cols, handler.NewCol(TrustedDeviceLifetimeCol, *policyEvent.TrustedDeviceLifetime))
+	}
+	if policyEvent.SessionIdleTimeout != nil {
+		cols = append(cols, handler.NewCol(SessionIdleTimeoutCol, *policyEvent.SessionIdleTimeout))
+	}
+	if policyEvent.SessionMaxLifetime != nil {
+		cols = append(cols, handler.NewCol(SessionMaxLifetimeCol, *policyEvent.SessionMaxLifetime))
+	}
+	if policyEvent.MaxConcurrentSessions != nil {
+		cols = append(cols, handler.NewCol(MaxConcurrentSessionsCol, *policyEvent.MaxConcurrentSessions))
+	}
+	if policyEvent.WebAuthNAttestation != nil {
+		cols = append(cols, handler.NewCol(WebAuthNAttestationCol, *policyEvent.WebAuthNAttestation))
+	}
+	if policyEvent.WebAuthNUserVerification != nil {
+		cols = append(cols, handler.NewCol(WebAuthNUserVerificationCol, *policyEvent.WebAuthNUserVerification))
+	}
+	if policyEvent.WebAuthNResidentKey != nil {
+		cols = append(cols, handler.NewCol(WebAuthNResidentKeyCol, *policyEvent.WebAuthNResidentKey))
+	}
+	if policyEvent.WebAuthNAllowedAAGUIDs != nil {
+		cols = append(cols, handler.NewCol(WebAuthNAllowedAAGUIDsCol, database.TextArray[string](*policyEvent.WebAuthNAllowedAAGUIDs)))
+	}
+	if policyEvent.WebAuthNDeniedAAGUIDs != nil {
+		cols = append(cols, handler.NewCol(WebAuthNDeniedAAGUIDsCol, database.TextArray[string](*policyEvent.WebAuthNDeniedAAGUIDs)))
+	}
 
 	return handler.NewUpdateStatement(
 		&policyEvent,
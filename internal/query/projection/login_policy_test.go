@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -45,7 +46,14 @@ func TestLoginPolicyProjection_reduces(t *testing.T) {
 						"externalLoginCheckLifetime": 10000000,
 						"mfaInitSkipLifetime": 10000000,
 						"secondFactorCheckLifetime": 10000000,
-						"multiFactorCheckLifetime": 10000000
+						"multiFactorCheckLifetime": 10000000,
+						"sessionIdleTimeout": 10000000,
+						"sessionMaxLifetime": 20000000,
+						"maxConcurrentSessions": 3,
+						"webAuthNAttestation": 3,
+						"webAuthNUserVerification": 1,
+						"webAuthNResidentKey": 3,
+						"webAuthNAllowedAAGUIDs": ["cb69481e-8ff7-4039-93ec-0a2729a154a8"]
 					}`),
 					), org.LoginPolicyAddedEventMapper),
 			},
@@ -56,7 +64,7 @@ func TestLoginPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.login_policies5 (aggregate_id, instance_id, creation_date, change_date, sequence, allow_register, allow_username_password, allow_external_idps, force_mfa, force_mfa_local_only, passwordless_type, is_default, hide_password_reset, ignore_unknown_usernames, allow_domain_discovery, disable_login_with_email, disable_login_with_phone, default_redirect_uri, password_check_lifetime, external_login_check_lifetime, mfa_init_skip_lifetime, second_factor_check_lifetime, multi_factor_check_lifetime) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)",
+							expectedStmt: "INSERT INTO projections.login_policies5 (aggregate_id, instance_id, creation_date, change_date, sequence, allow_register, allow_username_password, allow_external_idps, force_mfa, force_mfa_local_only, passwordless_type, is_default, hide_password_reset, ignore_unknown_usernames, allow_domain_discovery, disable_login_with_email, disable_login_with_phone, default_redirect_uri, password_check_lifetime, external_login_check_lifetime, mfa_init_skip_lifetime, second_factor_check_lifetime, multi_factor_check_lifetime, allow_magic_link, trusted_device_lifetime, session_idle_timeout, session_max_lifetime, max_concurrent_sessions, webauthn_attestation, webauthn_user_verification, webauthn_resident_key, webauthn_allowed_aaguids, webauthn_denied_aaguids) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -81,6 +89,16 @@ func TestLoginPolicyProjection_reduces(t *testing.T) {
 								time.Millisecond * 10,
 								time.Millisecond * 10,
 								time.Millisecond * 10,
+								false,
+								time.Duration(0),
+								time.Millisecond * 10,
+								time.Millisecond * 20,
+								uint64(3),
+								domain.AttestationConveyanceDirect,
+								domain.UserVerificationRequirementRequired,
+								domain.ResidentKeyRequirementRequired,
+								database.TextArray[string]{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+								database.TextArray[string](nil),
 							},
 						},
 					},
@@ -121,7 +139,7 @@ func TestLoginPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.login_policies5 (aggregate_id, instance_id, creation_date, change_date, sequence, allow_register, allow_username_password, allow_external_idps, force_mfa, force_mfa_local_only, passwordless_type, is_default, hide_password_reset, ignore_unknown_usernames, allow_domain_discovery, disable_login_with_email, disable_login_with_phone, default_redirect_uri, password_check_lifetime, external_login_check_lifetime, mfa_init_skip_lifetime, second_factor_check_lifetime, multi_factor_check_lifetime) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)",
+							expectedStmt: "INSERT INTO projections.login_policies5 (aggregate_id, instance_id, creation_date, change_date, sequence, allow_register, allow_username_password, allow_external_idps, force_mfa, force_mfa_local_only, passwordless_type, is_default, hide_password_reset, ignore_unknown_usernames, allow_domain_discovery, disable_login_with_email, disable_login_with_phone, default_redirect_uri, password_check_lifetime, external_login_check_lifetime, mfa_init_skip_lifetime, second_factor_check_lifetime, multi_factor_check_lifetime, allow_magic_link, trusted_device_lifetime, session_idle_timeout, session_max_lifetime, max_concurrent_sessions, webauthn_attestation, webauthn_user_verification, webauthn_resident_key, webauthn_allowed_aaguids, webauthn_denied_aaguids) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -146,6 +164,16 @@ func TestLoginPolicyProjection_reduces(t *testing.T) {
 								time.Millisecond * 10,
 								time.Millisecond * 10,
 								time.Millisecond * 10,
+								false,
+								time.Duration(0),
+								time.Duration(0),
+								time.Duration(0),
+								uint64(0),
+								domain.AttestationConveyanceUnspecified,
+								domain.UserVerificationRequirementUnspecified,
+								domain.ResidentKeyRequirementUnspecified,
+								database.TextArray[string](nil),
+								database.TextArray[string](nil),
 							},
 						},
 					},
@@ -177,7 +205,9 @@ func TestLoginPolicyProjection_reduces(t *testing.T) {
 						"externalLoginCheckLifetime": 10000000,
 						"mfaInitSkipLifetime": 10000000,
 						"secondFactorCheckLifetime": 10000000,
-						"multiFactorCheckLifetime": 10000000
+						"multiFactorCheckLifetime": 10000000,
+						"webAuthNAttestation": 3,
+						"webAuthNAllowedAAGUIDs": ["cb69481e-8ff7-4039-93ec-0a2729a154a8"]
 					}`),
 					), org.LoginPolicyChangedEventMapper),
 			},
@@ -187,7 +217,7 @@ func TestLoginPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.login_policies5 SET (change_date, sequence, allow_register, allow_username_password, allow_external_idps, force_mfa, force_mfa_local_only, passwordless_type, hide_password_reset, ignore_unknown_usernames, allow_domain_discovery, disable_login_with_email, disable_login_with_phone, default_redirect_uri, password_check_lifetime, external_login_check_lifetime, mfa_init_skip_lifetime, second_factor_check_lifetime, multi_factor_check_lifetime) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19) WHERE (aggregate_id = $20) AND (instance_id = $21)",
+							expectedStmt: "UPDATE projections.login_policies5 SET (change_date, sequence, allow_register, allow_username_password, allow_external_idps, force_mfa, force_mfa_local_only, passwordless_type, hide_password_reset, ignore_unknown_usernames, allow_domain_discovery, disable_login_with_email, disable_login_with_phone, default_redirect_uri, password_check_lifetime, external_login_check_lifetime, mfa_init_skip_lifetime, second_factor_check_lifetime, multi_factor_check_lifetime, webauthn_attestation, webauthn_allowed_aaguids) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21) WHERE (aggregate_id = $22) AND (instance_id = $23)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -208,6 +238,8 @@ func TestLoginPolicyProjection_reduces(t *testing.T) {
 								time.Millisecond * 10,
 								time.Millisecond * 10,
 								time.Millisecond * 10,
+								domain.AttestationConveyanceDirect,
+								database.TextArray[string]{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
 								"agg-id",
 								"instance-id",
 							},
@@ -406,7 +438,7 @@ func TestLoginPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.login_policies5 (aggregate_id, instance_id, creation_date, change_date, sequence, allow_register, allow_username_password, allow_external_idps, force_mfa, force_mfa_local_only, passwordless_type, is_default, hide_password_reset, ignore_unknown_usernames, allow_domain_discovery, disable_login_with_email, disable_login_with_phone, default_redirect_uri, password_check_lifetime, external_login_check_lifetime, mfa_init_skip_lifetime, second_factor_check_lifetime, multi_factor_check_lifetime) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)",
+							expectedStmt: "INSERT INTO projections.login_policies5 (aggregate_id, instance_id, creation_date, change_date, sequence, allow_register, allow_username_password, allow_external_idps, force_mfa, force_mfa_local_only, passwordless_type, is_default, hide_password_reset, ignore_unknown_usernames, allow_domain_discovery, disable_login_with_email, disable_login_with_phone, default_redirect_uri, password_check_lifetime, external_login_check_lifetime, mfa_init_skip_lifetime, second_factor_check_lifetime, multi_factor_check_lifetime, allow_magic_link, trusted_device_lifetime, session_idle_timeout, session_max_lifetime, max_concurrent_sessions, webauthn_attestation, webauthn_user_verification, webauthn_resident_key, webauthn_allowed_aaguids, webauthn_denied_aaguids) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								"instance-id",
@@ -431,6 +463,16 @@ func TestLoginPolicyProjection_reduces(t *testing.T) {
 								time.Millisecond * 10,
 								time.Millisecond * 10,
 								time.Millisecond * 10,
+								false,
+								time.Duration(0),
+								time.Duration(0),
+								time.Duration(0),
+								uint64(0),
+								domain.AttestationConveyanceUnspecified,
+								domain.UserVerificationRequirementUnspecified,
+								domain.ResidentKeyRequirementUnspecified,
+								database.TextArray[string](nil),
+								database.TextArray[string](nil),
 							},
 						},
 					},
@@ -0,0 +1,167 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	MailMessageTemplateTable = "projections.mail_message_templates"
+
+	MailMessageTemplateAggregateIDCol  = "aggregate_id"
+	MailMessageTemplateInstanceIDCol   = "instance_id"
+	MailMessageTemplateCreationDateCol = "creation_date"
+	MailMessageTemplateChangeDateCol   = "change_date"
+	MailMessageTemplateSequenceCol     = "sequence"
+	MailMessageTemplateIsDefaultCol    = "is_default"
+	MailMessageTemplateTypeCol         = "type"
+	MailMessageTemplateLanguageCol     = "language"
+	MailMessageTemplateHTMLCol         = "html"
+	MailMessageTemplatePlainTextCol    = "plain_text"
+)
+
+type mailMessageTemplateProjection struct{}
+
+func newMailMessageTemplateProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(mailMessageTemplateProjection))
+}
+
+func (*mailMessageTemplateProjection) Name() string {
+	return MailMessageTemplateTable
+}
+
+func (*mailMessageTemplateProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(MailMessageTemplateAggregateIDCol, handler.ColumnTypeText),
+			handler.NewColumn(MailMessageTemplateInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(MailMessageTemplateCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(MailMessageTemplateChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(MailMessageTemplateSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(MailMessageTemplateIsDefaultCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(MailMessageTemplateTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(MailMessageTemplateLanguageCol, handler.ColumnTypeText),
+			handler.NewColumn(MailMessageTemplateHTMLCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(MailMessageTemplatePlainTextCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(MailMessageTemplateInstanceIDCol, MailMessageTemplateAggregateIDCol, MailMessageTemplateTypeCol, MailMessageTemplateLanguageCol),
+		),
+	)
+}
+
+func (p *mailMessageTemplateProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.MailMessageTemplateSetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  org.MailMessageTemplateRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.MailMessageTemplateSetEventType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  instance.MailMessageTemplateRemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(MailMessageTemplateInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *mailMessageTemplateProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	var templateEvent policy.MailMessageTemplateSetEvent
+	var isDefault bool
+	switch e := event.(type) {
+	case *org.MailMessageTemplateSetEvent:
+		templateEvent = e.MailMessageTemplateSetEvent
+	case *instance.MailMessageTemplateSetEvent:
+		templateEvent = e.MailMessageTemplateSetEvent
+		isDefault = true
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-ieC4o", "reduce.wrong.event.type %v", []eventstore.EventType{org.MailMessageTemplateSetEventType, instance.MailMessageTemplateSetEventType})
+	}
+	return handler.NewUpsertStatement(
+		&templateEvent,
+		[]handler.Column{
+			handler.NewCol(MailMessageTemplateInstanceIDCol, nil),
+			handler.NewCol(MailMessageTemplateAggregateIDCol, nil),
+			handler.NewCol(MailMessageTemplateTypeCol, nil),
+			handler.NewCol(MailMessageTemplateLanguageCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(MailMessageTemplateAggregateIDCol, templateEvent.Aggregate().ID),
+			handler.NewCol(MailMessageTemplateInstanceIDCol, templateEvent.Aggregate().InstanceID),
+			handler.NewCol(MailMessageTemplateCreationDateCol, handler.OnlySetValueOnInsert(MailMessageTemplateTable, templateEvent.CreationDate())),
+			handler.NewCol(MailMessageTemplateChangeDateCol, templateEvent.CreationDate()),
+			handler.NewCol(MailMessageTemplateSequenceCol, templateEvent.Sequence()),
+			handler.NewCol(MailMessageTemplateIsDefaultCol, isDefault),
+			handler.NewCol(MailMessageTemplateTypeCol, templateEvent.MessageType),
+			handler.NewCol(MailMessageTemplateLanguageCol, templateEvent.Language.String()),
+			handler.NewCol(MailMessageTemplateHTMLCol, templateEvent.HTML),
+			handler.NewCol(MailMessageTemplatePlainTextCol, templateEvent.PlainText),
+		},
+	), nil
+}
+
+func (p *mailMessageTemplateProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	var templateEvent policy.MailMessageTemplateRemovedEvent
+	switch e := event.(type) {
+	case *org.MailMessageTemplateRemovedEvent:
+		templateEvent = e.MailMessageTemplateRemovedEvent
+	case *instance.MailMessageTemplateRemovedEvent:
+		templateEvent = e.MailMessageTemplateRemovedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Oocu5", "reduce.wrong.event.type %v", []eventstore.EventType{org.MailMessageTemplateRemovedEventType, instance.MailMessageTemplateRemovedEventType})
+	}
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(MailMessageTemplateAggregateIDCol, templateEvent.Aggregate().ID),
+			handler.NewCond(MailMessageTemplateTypeCol, templateEvent.MessageType),
+			handler.NewCond(MailMessageTemplateLanguageCol, templateEvent.Language.String()),
+			handler.NewCond(MailMessageTemplateInstanceIDCol, templateEvent.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *mailMessageTemplateProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Vah2o", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(MailMessageTemplateInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(MailMessageTemplateAggregateIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,201 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestMailMessageTemplateProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "org.reduceSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.MailMessageTemplateSetEventType,
+						org.AggregateType,
+						[]byte(`{
+						"messageType": "InitCode",
+						"language": "en",
+						"html": "<html>{{.Code}}</html>",
+						"plainText": "{{.Code}}"
+					}`),
+					), org.MailMessageTemplateSetEventMapper),
+			},
+			reduce: (&mailMessageTemplateProjection{}).reduceSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.mail_message_templates (aggregate_id, instance_id, creation_date, change_date, sequence, is_default, type, language, html, plain_text) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id, aggregate_id, type, language) DO UPDATE SET (creation_date, change_date, sequence, is_default, html, plain_text) = (projections.mail_message_templates.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.is_default, EXCLUDED.html, EXCLUDED.plain_text)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								false,
+								"InitCode",
+								"en",
+								"<html>{{.Code}}</html>",
+								"{{.Code}}",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org.reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.MailMessageTemplateRemovedEventType,
+						org.AggregateType,
+						[]byte(`{
+						"messageType": "InitCode",
+						"language": "en"
+					}`),
+					), org.MailMessageTemplateRemovedEventMapper),
+			},
+			reduce: (&mailMessageTemplateProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.mail_message_templates WHERE (aggregate_id = $1) AND (type = $2) AND (language = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"InitCode",
+								"en",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org.reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&mailMessageTemplateProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.mail_message_templates WHERE (instance_id = $1) AND (aggregate_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.MailMessageTemplateSetEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"messageType": "InitCode",
+						"language": "en",
+						"html": "<html>{{.Code}}</html>"
+					}`),
+					), instance.MailMessageTemplateSetEventMapper),
+			},
+			reduce: (&mailMessageTemplateProjection{}).reduceSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.mail_message_templates (aggregate_id, instance_id, creation_date, change_date, sequence, is_default, type, language, html, plain_text) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id, aggregate_id, type, language) DO UPDATE SET (creation_date, change_date, sequence, is_default, html, plain_text) = (projections.mail_message_templates.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.is_default, EXCLUDED.html, EXCLUDED.plain_text)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								true,
+								"InitCode",
+								"en",
+								"<html>{{.Code}}</html>",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance.reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(MailMessageTemplateInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.mail_message_templates WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, MailMessageTemplateTable, tt.want)
+		})
+	}
+}
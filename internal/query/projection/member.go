@@ -2,6 +2,7 @@ package projection
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
@@ -14,6 +15,9 @@ const (
 	MemberUserIDCol         = "user_id"
 	MemberRolesCol          = "roles"
 	MemberUserResourceOwner = "user_resource_owner"
+	MemberValidFrom         = "valid_from"
+	MemberValidUntil        = "valid_until"
+	MemberExpired           = "expired"
 
 	MemberCreationDate  = "creation_date"
 	MemberChangeDate    = "change_date"
@@ -32,6 +36,9 @@ var (
 		handler.NewColumn(MemberSequence, handler.ColumnTypeInt64),
 		handler.NewColumn(MemberResourceOwner, handler.ColumnTypeText),
 		handler.NewColumn(MemberInstanceID, handler.ColumnTypeText),
+		handler.NewColumn(MemberValidFrom, handler.ColumnTypeTimestamp, handler.Nullable()),
+		handler.NewColumn(MemberValidUntil, handler.ColumnTypeTimestamp, handler.Nullable()),
+		handler.NewColumn(MemberExpired, handler.ColumnTypeBool, handler.Default(false)),
 	}
 )
 
@@ -95,6 +102,46 @@ func reduceMemberChanged(e member.MemberChangedEvent, opts ...reduceMemberOpt) (
 	return handler.NewUpdateStatement(&e, config.cols, config.conds), nil
 }
 
+func reduceMemberValiditySet(e member.MemberValiditySetEvent, opts ...reduceMemberOpt) (*handler.Statement, error) {
+	config := reduceMemberConfig{
+		cols: []handler.Column{
+			handler.NewCol(MemberValidFrom, sql.NullTime{Time: e.ValidFrom, Valid: !e.ValidFrom.IsZero()}),
+			handler.NewCol(MemberValidUntil, sql.NullTime{Time: e.ValidUntil, Valid: !e.ValidUntil.IsZero()}),
+			handler.NewCol(MemberExpired, false),
+			handler.NewCol(MemberChangeDate, e.CreatedAt()),
+			handler.NewCol(MemberSequence, e.Sequence()),
+		},
+		conds: []handler.Condition{
+			handler.NewCond(MemberInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(MemberUserIDCol, e.UserID),
+		}}
+
+	for _, opt := range opts {
+		config = opt(config)
+	}
+
+	return handler.NewUpdateStatement(&e, config.cols, config.conds), nil
+}
+
+func reduceMemberExpired(e member.MemberExpiredEvent, opts ...reduceMemberOpt) (*handler.Statement, error) {
+	config := reduceMemberConfig{
+		cols: []handler.Column{
+			handler.NewCol(MemberExpired, true),
+			handler.NewCol(MemberChangeDate, e.CreatedAt()),
+			handler.NewCol(MemberSequence, e.Sequence()),
+		},
+		conds: []handler.Condition{
+			handler.NewCond(MemberInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(MemberUserIDCol, e.UserID),
+		}}
+
+	for _, opt := range opts {
+		config = opt(config)
+	}
+
+	return handler.NewUpdateStatement(&e, config.cols, config.conds), nil
+}
+
 func reduceMemberCascadeRemoved(e member.MemberCascadeRemovedEvent, opts ...reduceMemberOpt) (*handler.Statement, error) {
 	config := reduceMemberConfig{
 		conds: []handler.Condition{
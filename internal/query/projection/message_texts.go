@@ -273,7 +273,16 @@ func isMessageTemplate(template string) bool {
 		template == domain.DomainClaimedMessageType ||
 		template == domain.PasswordlessRegistrationMessageType ||
 		template == domain.PasswordChangeMessageType ||
-		template == domain.InviteUserMessageType
+		template == domain.InviteUserMessageType ||
+		template == domain.MagicLinkMessageType ||
+		template == domain.MFAAddedMessageType ||
+		template == domain.MFARemovedMessageType ||
+		template == domain.EmailChangedMessageType ||
+		template == domain.PhoneChangedMessageType ||
+		template == domain.NewDeviceSignInMessageType ||
+		template == domain.PersonalAccessTokenAddedMessageType ||
+		template == domain.MachineKeyAddedMessageType ||
+		template == domain.InactivityWarningMessageType
 }
 func isTitle(key string) bool {
 	return key == domain.MessageTitle
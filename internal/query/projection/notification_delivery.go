@@ -0,0 +1,209 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	NotificationDeliveryTable = "projections.notification_deliveries"
+
+	NotificationDeliveryIDCol               = "id"
+	NotificationDeliveryInstanceIDCol       = "instance_id"
+	NotificationDeliveryResourceOwnerCol    = "resource_owner"
+	NotificationDeliveryCreationDateCol     = "creation_date"
+	NotificationDeliveryChangeDateCol       = "change_date"
+	NotificationDeliverySequenceCol         = "sequence"
+	NotificationDeliveryUserIDCol           = "user_id"
+	NotificationDeliveryMessageTypeCol      = "message_type"
+	NotificationDeliveryNotificationTypeCol = "notification_type"
+	NotificationDeliveryEventTypeCol        = "event_type"
+	NotificationDeliveryProviderIDCol       = "provider_id"
+	NotificationDeliveryAttemptsCol         = "attempts"
+	NotificationDeliveryStateCol            = "state"
+	NotificationDeliveryLastErrorCol        = "last_error"
+)
+
+type notificationDeliveryProjection struct{}
+
+func newNotificationDeliveryProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(notificationDeliveryProjection))
+}
+
+func (*notificationDeliveryProjection) Name() string {
+	return NotificationDeliveryTable
+}
+
+func (*notificationDeliveryProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationDeliveryIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationDeliveryChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationDeliverySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationDeliveryUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryMessageTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryNotificationTypeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationDeliveryEventTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationDeliveryProviderIDCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(NotificationDeliveryAttemptsCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(NotificationDeliveryStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationDeliveryLastErrorCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(NotificationDeliveryInstanceIDCol, NotificationDeliveryIDCol),
+			handler.WithIndex(handler.NewIndex("user_id", []string{NotificationDeliveryUserIDCol})),
+		),
+	)
+}
+
+func (p *notificationDeliveryProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.DeliverySucceededType,
+					Reduce: p.reduceDeliverySucceeded,
+				},
+				{
+					Event:  notification.DeliveryFailedType,
+					Reduce: p.reduceDeliveryFailed,
+				},
+				{
+					Event:  notification.ResendRequestedType,
+					Reduce: p.reduceResendRequested,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NotificationDeliveryInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *notificationDeliveryProjection) reduceDeliverySucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeliverySucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.upsertDelivery(e, e.Delivery, domain.NotificationDeliveryStateSent), nil
+}
+
+func (p *notificationDeliveryProjection) reduceDeliveryFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.DeliveryFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	state := domain.NotificationDeliveryStateRetrying
+	if e.Final {
+		state = domain.NotificationDeliveryStateFailed
+	}
+	return p.upsertDelivery(e, e.Delivery, state, handler.NewCol(NotificationDeliveryLastErrorCol, e.Error)), nil
+}
+
+func (p *notificationDeliveryProjection) upsertDelivery(event eventstore.Event, delivery notification.Delivery, state domain.NotificationDeliveryState, cols ...handler.Column) *handler.Statement {
+	return handler.NewUpsertStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(NotificationDeliveryInstanceIDCol, nil),
+			handler.NewCol(NotificationDeliveryIDCol, nil),
+		},
+		append([]handler.Column{
+			handler.NewCol(NotificationDeliveryIDCol, event.Aggregate().ID),
+			handler.NewCol(NotificationDeliveryInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCol(NotificationDeliveryResourceOwnerCol, event.Aggregate().ResourceOwner),
+			handler.NewCol(NotificationDeliveryCreationDateCol, handler.OnlySetValueOnInsert(NotificationDeliveryTable, event.CreatedAt())),
+			handler.NewCol(NotificationDeliveryChangeDateCol, event.CreatedAt()),
+			handler.NewCol(NotificationDeliverySequenceCol, event.Sequence()),
+			handler.NewCol(NotificationDeliveryUserIDCol, delivery.UserID),
+			handler.NewCol(NotificationDeliveryMessageTypeCol, delivery.MessageType),
+			handler.NewCol(NotificationDeliveryNotificationTypeCol, delivery.NotificationType),
+			handler.NewCol(NotificationDeliveryEventTypeCol, delivery.EventType),
+			handler.NewCol(NotificationDeliveryProviderIDCol, delivery.ProviderID),
+			handler.NewCol(NotificationDeliveryAttemptsCol, delivery.Attempt),
+			handler.NewCol(NotificationDeliveryStateCol, state),
+		}, cols...),
+	)
+}
+
+func (p *notificationDeliveryProjection) reduceResendRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.ResendRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationDeliveryChangeDateCol, e.CreatedAt()),
+			handler.NewCol(NotificationDeliverySequenceCol, e.Sequence()),
+			handler.NewCol(NotificationDeliveryStateCol, domain.NotificationDeliveryStateResendRequested),
+		},
+		[]handler.Condition{
+			handler.NewCond(NotificationDeliveryIDCol, e.Aggregate().ID),
+			handler.NewCond(NotificationDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *notificationDeliveryProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Eu2ai", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationDeliveryUserIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *notificationDeliveryProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Ahx4e", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationDeliveryResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,216 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationDeliveryProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceDeliverySucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.DeliverySucceededType,
+						notification.AggregateType,
+						[]byte(`{
+						"userID": "user-id",
+						"messageType": "PasswordReset",
+						"notificationType": 0,
+						"eventType": "user.human.password.code.added",
+						"providerID": "provider-id",
+						"attempt": 1
+					}`),
+					), notification.DeliverySucceededEventMapper),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceDeliverySucceeded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_deliveries (id, instance_id, resource_owner, creation_date, change_date, sequence, user_id, message_type, notification_type, event_type, provider_id, attempts, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) ON CONFLICT (instance_id, id) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, user_id, message_type, notification_type, event_type, provider_id, attempts, state) = (EXCLUDED.resource_owner, projections.notification_deliveries.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.user_id, EXCLUDED.message_type, EXCLUDED.notification_type, EXCLUDED.event_type, EXCLUDED.provider_id, EXCLUDED.attempts, EXCLUDED.state)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"user-id",
+								"PasswordReset",
+								domain.NotificationTypeEmail,
+								eventstore.EventType("user.human.password.code.added"),
+								"provider-id",
+								uint16(1),
+								domain.NotificationDeliveryStateSent,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeliveryFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.DeliveryFailedType,
+						notification.AggregateType,
+						[]byte(`{
+						"userID": "user-id",
+						"messageType": "VerifyPhone",
+						"notificationType": 1,
+						"eventType": "user.human.phone.code.added",
+						"providerID": "provider-id",
+						"attempt": 3,
+						"error": "rejected",
+						"final": true
+					}`),
+					), notification.DeliveryFailedEventMapper),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceDeliveryFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_deliveries (id, instance_id, resource_owner, creation_date, change_date, sequence, user_id, message_type, notification_type, event_type, provider_id, attempts, state, last_error) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ON CONFLICT (instance_id, id) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, user_id, message_type, notification_type, event_type, provider_id, attempts, state, last_error) = (EXCLUDED.resource_owner, projections.notification_deliveries.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.user_id, EXCLUDED.message_type, EXCLUDED.notification_type, EXCLUDED.event_type, EXCLUDED.provider_id, EXCLUDED.attempts, EXCLUDED.state, EXCLUDED.last_error)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"user-id",
+								"VerifyPhone",
+								domain.NotificationTypeSms,
+								eventstore.EventType("user.human.phone.code.added"),
+								"provider-id",
+								uint16(3),
+								domain.NotificationDeliveryStateFailed,
+								"rejected",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceResendRequested",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.ResendRequestedType,
+						notification.AggregateType,
+						[]byte(`{"userID": "user-id"}`),
+					), notification.ResendRequestedEventMapper),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceResendRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("notification"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.notification_deliveries SET (change_date, sequence, state) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.NotificationDeliveryStateResendRequested,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_deliveries WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&notificationDeliveryProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_deliveries WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NotificationDeliveryTable, tt.want)
+		})
+	}
+}
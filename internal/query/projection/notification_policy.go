@@ -3,6 +3,7 @@ package projection
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
@@ -16,16 +17,18 @@ import (
 const (
 	NotificationPolicyProjectionTable = "projections.notification_policies"
 
-	NotificationPolicyColumnID             = "id"
-	NotificationPolicyColumnCreationDate   = "creation_date"
-	NotificationPolicyColumnChangeDate     = "change_date"
-	NotificationPolicyColumnResourceOwner  = "resource_owner"
-	NotificationPolicyColumnInstanceID     = "instance_id"
-	NotificationPolicyColumnSequence       = "sequence"
-	NotificationPolicyColumnStateCol       = "state"
-	NotificationPolicyColumnIsDefault      = "is_default"
-	NotificationPolicyColumnPasswordChange = "password_change"
-	NotificationPolicyColumnOwnerRemoved   = "owner_removed"
+	NotificationPolicyColumnID                    = "id"
+	NotificationPolicyColumnCreationDate          = "creation_date"
+	NotificationPolicyColumnChangeDate            = "change_date"
+	NotificationPolicyColumnResourceOwner         = "resource_owner"
+	NotificationPolicyColumnInstanceID            = "instance_id"
+	NotificationPolicyColumnSequence              = "sequence"
+	NotificationPolicyColumnStateCol              = "state"
+	NotificationPolicyColumnIsDefault             = "is_default"
+	NotificationPolicyColumnPasswordChange        = "password_change"
+	NotificationPolicyColumnSecurityNotifications = "security_notifications"
+	NotificationPolicyColumnPhoneChannels         = "phone_channels"
+	NotificationPolicyColumnOwnerRemoved          = "owner_removed"
 )
 
 type notificationPolicyProjection struct{}
@@ -50,6 +53,8 @@ func (*notificationPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(NotificationPolicyColumnStateCol, handler.ColumnTypeEnum),
 			handler.NewColumn(NotificationPolicyColumnIsDefault, handler.ColumnTypeBool),
 			handler.NewColumn(NotificationPolicyColumnPasswordChange, handler.ColumnTypeBool),
+			handler.NewColumn(NotificationPolicyColumnSecurityNotifications, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(NotificationPolicyColumnPhoneChannels, handler.ColumnTypeEnumArray, handler.Nullable()),
 			handler.NewColumn(NotificationPolicyColumnOwnerRemoved, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(NotificationPolicyColumnInstanceID, NotificationPolicyColumnID),
@@ -122,6 +127,8 @@ func (p *notificationPolicyProjection) reduceAdded(event eventstore.Event) (*han
 			handler.NewCol(NotificationPolicyColumnID, policyEvent.Aggregate().ID),
 			handler.NewCol(NotificationPolicyColumnStateCol, domain.PolicyStateActive),
 			handler.NewCol(NotificationPolicyColumnPasswordChange, policyEvent.PasswordChange),
+			handler.NewCol(NotificationPolicyColumnSecurityNotifications, policyEvent.SecurityNotifications),
+			handler.NewCol(NotificationPolicyColumnPhoneChannels, database.NumberArray[domain.PhoneChannel](policyEvent.PhoneChannels)),
 			handler.NewCol(NotificationPolicyColumnIsDefault, isDefault),
 			handler.NewCol(NotificationPolicyColumnResourceOwner, policyEvent.Aggregate().ResourceOwner),
 			handler.NewCol(NotificationPolicyColumnInstanceID, policyEvent.Aggregate().InstanceID),
@@ -145,6 +152,12 @@ func (p *notificationPolicyProjection) reduceChanged(event eventstore.Event) (*h
 	if policyEvent.PasswordChange != nil {
 		cols = append(cols, handler.NewCol(NotificationPolicyColumnPasswordChange, *policyEvent.PasswordChange))
 	}
+	if policyEvent.SecurityNotifications != nil {
+		cols = append(cols, handler.NewCol(NotificationPolicyColumnSecurityNotifications, *policyEvent.SecurityNotifications))
+	}
+	if policyEvent.PhoneChannels != nil {
+		cols = append(cols, handler.NewCol(NotificationPolicyColumnPhoneChannels, database.NumberArray[domain.PhoneChannel](*policyEvent.PhoneChannels)))
+	}
 	return handler.NewUpdateStatement(
 		&policyEvent,
 		cols,
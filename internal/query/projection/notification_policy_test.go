@@ -3,6 +3,7 @@ package projection
 import (
 	"testing"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -29,7 +30,9 @@ func TestNotificationPolicyProjection_reduces(t *testing.T) {
 						org.NotificationPolicyAddedEventType,
 						org.AggregateType,
 						[]byte(`{
-						"passwordChange": true
+						"passwordChange": true,
+						"securityNotifications": true,
+						"phoneChannels": [2, 1]
 }`),
 					), org.NotificationPolicyAddedEventMapper),
 			},
@@ -40,7 +43,7 @@ func TestNotificationPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.notification_policies (creation_date, change_date, sequence, id, state, password_change, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedStmt: "INSERT INTO projections.notification_policies (creation_date, change_date, sequence, id, state, password_change, security_notifications, phone_channels, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -48,6 +51,8 @@ func TestNotificationPolicyProjection_reduces(t *testing.T) {
 								"agg-id",
 								domain.PolicyStateActive,
 								true,
+								true,
+								database.NumberArray[domain.PhoneChannel]{domain.PhoneChannelWhatsApp, domain.PhoneChannelSMS},
 								false,
 								"ro-id",
 								"instance-id",
@@ -66,7 +71,9 @@ func TestNotificationPolicyProjection_reduces(t *testing.T) {
 						org.NotificationPolicyChangedEventType,
 						org.AggregateType,
 						[]byte(`{
-						"passwordChange": true
+						"passwordChange": true,
+						"securityNotifications": true,
+						"phoneChannels": [3]
 		}`),
 					), org.NotificationPolicyChangedEventMapper),
 			},
@@ -76,11 +83,13 @@ func TestNotificationPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.notification_policies SET (change_date, sequence, password_change) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedStmt: "UPDATE projections.notification_policies SET (change_date, sequence, password_change, security_notifications, phone_channels) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
 								true,
+								true,
+								database.NumberArray[domain.PhoneChannel]{domain.PhoneChannelVoice},
 								"agg-id",
 								"instance-id",
 							},
@@ -160,7 +169,7 @@ func TestNotificationPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.notification_policies (creation_date, change_date, sequence, id, state, password_change, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedStmt: "INSERT INTO projections.notification_policies (creation_date, change_date, sequence, id, state, password_change, security_notifications, phone_channels, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -168,6 +177,8 @@ func TestNotificationPolicyProjection_reduces(t *testing.T) {
 								"agg-id",
 								domain.PolicyStateActive,
 								true,
+								false,
+								database.NumberArray[domain.PhoneChannel](nil),
 								true,
 								"ro-id",
 								"instance-id",
@@ -71,6 +71,14 @@ func (p *orgMemberProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.MemberRemovedEventType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  org.MemberValiditySetEventType,
+					Reduce: p.reduceValiditySet,
+				},
+				{
+					Event:  org.MemberExpiredEventType,
+					Reduce: p.reduceExpired,
+				},
 				{
 					Event:  org.OrgRemovedEventType,
 					Reduce: p.reduceOrgRemoved,
@@ -138,6 +146,22 @@ func (p *orgMemberProjection) reduceRemoved(event eventstore.Event) (*handler.St
 	)
 }
 
+func (p *orgMemberProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.MemberValiditySetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Aeb3u", "reduce.wrong.event.type %s", org.MemberValiditySetEventType)
+	}
+	return reduceMemberValiditySet(e.MemberValiditySetEvent, withMemberCond(OrgMemberOrgIDCol, e.Aggregate().ID))
+}
+
+func (p *orgMemberProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.MemberExpiredEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-oo7Ie", "reduce.wrong.event.type %s", org.MemberExpiredEventType)
+	}
+	return reduceMemberExpired(e.MemberExpiredEvent, withMemberCond(OrgMemberOrgIDCol, e.Aggregate().ID))
+}
+
 func (p *orgMemberProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.UserRemovedEvent)
 	if !ok {
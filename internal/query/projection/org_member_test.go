@@ -2,8 +2,10 @@ package projection
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"golang.org/x/text/language"
 
@@ -258,6 +260,76 @@ func TestOrgMemberProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "org MemberValiditySetType",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.MemberValiditySetEventType,
+						org.AggregateType,
+						[]byte(`{
+					"userId": "user-id",
+					"validFrom": "2024-06-01T00:00:00Z"
+				}`),
+					), org.MemberValiditySetEventMapper),
+			},
+			reduce: (&orgMemberProjection{}).reduceValiditySet,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.org_members4 SET (valid_from, valid_until, expired, change_date, sequence) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (user_id = $7) AND (org_id = $8)",
+							expectedArgs: []interface{}{
+								sql.NullTime{Time: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+								sql.NullTime{},
+								false,
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"user-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org MemberExpiredType",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.MemberExpiredEventType,
+						org.AggregateType,
+						[]byte(`{
+					"userId": "user-id",
+					"validUntil": "2024-06-01T00:00:00Z"
+				}`),
+					), org.MemberExpiredEventMapper),
+			},
+			reduce: (&orgMemberProjection{}).reduceExpired,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.org_members4 SET (expired, change_date, sequence) = ($1, $2, $3) WHERE (instance_id = $4) AND (user_id = $5) AND (org_id = $6)",
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"user-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "user UserRemovedEventType",
 			args: args{
@@ -51,6 +51,66 @@ func TestOrgProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceParentSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgParentSetEventType,
+						org.AggregateType,
+						[]byte(`{"parentId": "parent-id"}`),
+					), org.OrgParentSetEventMapper),
+			},
+			reduce: (&orgProjection{}).reduceParentSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.orgs1 SET (change_date, sequence, parent_id) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"parent-id",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceParentRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgParentRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgParentRemovedEventMapper),
+			},
+			reduce: (&orgProjection{}).reduceParentRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.orgs1 SET (change_date, sequence, parent_id) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceOrgReactivated",
 			args: args{
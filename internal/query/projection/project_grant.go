@@ -17,16 +17,17 @@ import (
 const (
 	ProjectGrantProjectionTable = "projections.project_grants4"
 
-	ProjectGrantColumnGrantID       = "grant_id"
-	ProjectGrantColumnCreationDate  = "creation_date"
-	ProjectGrantColumnChangeDate    = "change_date"
-	ProjectGrantColumnSequence      = "sequence"
-	ProjectGrantColumnState         = "state"
-	ProjectGrantColumnResourceOwner = "resource_owner"
-	ProjectGrantColumnInstanceID    = "instance_id"
-	ProjectGrantColumnProjectID     = "project_id"
-	ProjectGrantColumnGrantedOrgID  = "granted_org_id"
-	ProjectGrantColumnRoleKeys      = "granted_role_keys"
+	ProjectGrantColumnGrantID        = "grant_id"
+	ProjectGrantColumnCreationDate   = "creation_date"
+	ProjectGrantColumnChangeDate     = "change_date"
+	ProjectGrantColumnSequence       = "sequence"
+	ProjectGrantColumnState          = "state"
+	ProjectGrantColumnResourceOwner  = "resource_owner"
+	ProjectGrantColumnInstanceID     = "instance_id"
+	ProjectGrantColumnProjectID      = "project_id"
+	ProjectGrantColumnGrantedOrgID   = "granted_org_id"
+	ProjectGrantColumnRoleKeys       = "granted_role_keys"
+	ProjectGrantColumnIncludeSubOrgs = "include_sub_orgs"
 )
 
 type projectGrantProjection struct{}
@@ -52,6 +53,7 @@ func (*projectGrantProjection) Init() *old_handler.Check {
 			handler.NewColumn(ProjectGrantColumnProjectID, handler.ColumnTypeText),
 			handler.NewColumn(ProjectGrantColumnGrantedOrgID, handler.ColumnTypeText),
 			handler.NewColumn(ProjectGrantColumnRoleKeys, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(ProjectGrantColumnIncludeSubOrgs, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(ProjectGrantColumnInstanceID, ProjectGrantColumnGrantID),
 			handler.WithIndex(handler.NewIndex("resource_owner", []string{ProjectGrantColumnResourceOwner})),
@@ -77,6 +79,10 @@ func (p *projectGrantProjection) Reducers() []handler.AggregateReducer {
 					Event:  project.GrantCascadeChangedType,
 					Reduce: p.reduceProjectGrantCascadeChanged,
 				},
+				{
+					Event:  project.GrantSubOrgsChangedType,
+					Reduce: p.reduceProjectGrantSubOrgsChanged,
+				},
 				{
 					Event:  project.GrantDeactivatedType,
 					Reduce: p.reduceProjectGrantDeactivated,
@@ -182,6 +188,26 @@ func (p *projectGrantProjection) reduceProjectGrantCascadeChanged(event eventsto
 	), nil
 }
 
+func (p *projectGrantProjection) reduceProjectGrantSubOrgsChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.GrantSubOrgsChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Eiph4", "reduce.wrong.event.type %s", project.GrantSubOrgsChangedType)
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProjectGrantColumnChangeDate, e.CreationDate()),
+			handler.NewCol(ProjectGrantColumnSequence, e.Sequence()),
+			handler.NewCol(ProjectGrantColumnIncludeSubOrgs, e.IncludeSubOrgs),
+		},
+		[]handler.Condition{
+			handler.NewCond(ProjectGrantColumnGrantID, e.GrantID),
+			handler.NewCond(ProjectGrantColumnProjectID, e.Aggregate().ID),
+			handler.NewCond(ProjectGrantColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *projectGrantProjection) reduceProjectGrantDeactivated(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*project.GrantDeactivateEvent)
 	if !ok {
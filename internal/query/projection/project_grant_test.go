@@ -228,6 +228,37 @@ func TestProjectGrantProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceProjectGrantSubOrgsChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.GrantSubOrgsChangedType,
+						project.AggregateType,
+						[]byte(`{"grantId": "grant-id", "includeSubOrgs": true}`),
+					), project.GrantSubOrgsChangedEventMapper),
+			},
+			reduce: (&projectGrantProjection{}).reduceProjectGrantSubOrgsChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.project_grants4 SET (change_date, sequence, include_sub_orgs) = ($1, $2, $3) WHERE (grant_id = $4) AND (project_id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								true,
+								"grant-id",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceProjectGrantAdded",
 			args: args{
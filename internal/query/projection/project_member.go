@@ -75,6 +75,14 @@ func (p *projectMemberProjection) Reducers() []handler.AggregateReducer {
 					Event:  project.MemberRemovedEventType,
 					Reduce: p.reduceRemoved,
 				},
+				{
+					Event:  project.MemberValiditySetEventType,
+					Reduce: p.reduceValiditySet,
+				},
+				{
+					Event:  project.MemberExpiredEventType,
+					Reduce: p.reduceExpired,
+				},
 				{
 					Event:  project.ProjectRemovedType,
 					Reduce: p.reduceProjectRemoved,
@@ -161,6 +169,22 @@ func (p *projectMemberProjection) reduceRemoved(event eventstore.Event) (*handle
 	)
 }
 
+func (p *projectMemberProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.MemberValiditySetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Nai4o", "reduce.wrong.event.type %s", project.MemberValiditySetEventType)
+	}
+	return reduceMemberValiditySet(e.MemberValiditySetEvent, withMemberCond(ProjectMemberProjectIDCol, e.Aggregate().ID))
+}
+
+func (p *projectMemberProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*project.MemberExpiredEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Vae1e", "reduce.wrong.event.type %s", project.MemberExpiredEventType)
+	}
+	return reduceMemberExpired(e.MemberExpiredEvent, withMemberCond(ProjectMemberProjectIDCol, e.Aggregate().ID))
+}
+
 func (p *projectMemberProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.UserRemovedEvent)
 	if !ok {
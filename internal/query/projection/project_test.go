@@ -3,6 +3,7 @@ package projection
 import (
 	"testing"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -142,7 +143,7 @@ func TestProjectProjection_reduces(t *testing.T) {
 					testEvent(
 						project.ProjectChangedType,
 						project.AggregateType,
-						[]byte(`{"name": "new name", "projectRoleAssertion": true, "projectRoleCheck": true, "hasProjectCheck": true, "privateLabelingSetting": 1}`),
+						[]byte(`{"name": "new name", "projectRoleAssertion": true, "projectRoleCheck": true, "hasProjectCheck": true, "privateLabelingSetting": 1, "resourceURIs": ["https://api.example.com"]}`),
 					), project.ProjectChangeEventMapper),
 			},
 			reduce: (&projectProjection{}).reduceProjectChanged,
@@ -152,7 +153,7 @@ func TestProjectProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.projects4 SET (change_date, sequence, name, project_role_assertion, project_role_check, has_project_check, private_labeling_setting) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8) AND (instance_id = $9)",
+							expectedStmt: "UPDATE projections.projects4 SET (change_date, sequence, name, project_role_assertion, project_role_check, has_project_check, private_labeling_setting, resource_uris) = ($1, $2, $3, $4, $5, $6, $7, $8) WHERE (id = $9) AND (instance_id = $10)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -161,6 +162,7 @@ func TestProjectProjection_reduces(t *testing.T) {
 								true,
 								true,
 								domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+								database.TextArray[string]{"https://api.example.com"},
 								"agg-id",
 								"instance-id",
 							},
@@ -193,7 +195,7 @@ func TestProjectProjection_reduces(t *testing.T) {
 					testEvent(
 						project.ProjectAddedType,
 						project.AggregateType,
-						[]byte(`{"name": "name", "projectRoleAssertion": true, "projectRoleCheck": true, "hasProjectCheck": true, "privateLabelingSetting": 1}`),
+						[]byte(`{"name": "name", "projectRoleAssertion": true, "projectRoleCheck": true, "hasProjectCheck": true, "privateLabelingSetting": 1, "resourceURIs": ["https://api.example.com"]}`),
 					), project.ProjectAddedEventMapper),
 			},
 			reduce: (&projectProjection{}).reduceProjectAdded,
@@ -203,7 +205,7 @@ func TestProjectProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.projects4 (id, creation_date, change_date, resource_owner, instance_id, sequence, name, project_role_assertion, project_role_check, has_project_check, private_labeling_setting, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+							expectedStmt: "INSERT INTO projections.projects4 (id, creation_date, change_date, resource_owner, instance_id, sequence, name, project_role_assertion, project_role_check, has_project_check, private_labeling_setting, resource_uris, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								anyArg{},
@@ -216,6 +218,7 @@ func TestProjectProjection_reduces(t *testing.T) {
 								true,
 								true,
 								domain.PrivateLabelingSettingEnforceProjectResourceOwnerPolicy,
+								database.TextArray[string]{"https://api.example.com"},
 								domain.ProjectStateActive,
 							},
 						},
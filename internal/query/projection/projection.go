@@ -44,6 +44,8 @@ var (
 	IDPLoginPolicyLinkProjection        *handler.Handler
 	IDPTemplateProjection               *handler.Handler
 	MailTemplateProjection              *handler.Handler
+	MailMessageTemplateProjection       *handler.Handler
+	NotificationDeliveryProjection      *handler.Handler
 	MessageTextProjection               *handler.Handler
 	CustomTextProjection                *handler.Handler
 	UserProjection                      *handler.Handler
@@ -58,6 +60,11 @@ var (
 	PersonalAccessTokenProjection       *handler.Handler
 	UserGrantProjection                 *handler.Handler
 	UserMetadataProjection              *handler.Handler
+	UserConsentProjection               *handler.Handler
+	UserTrustedDeviceProjection         *handler.Handler
+	UserPasswordHashProjection          *handler.Handler
+	UserDeviceSessionProjection         *handler.Handler
+	UserLoginHistoryProjection          *handler.Handler
 	UserAuthMethodProjection            *handler.Handler
 	InstanceProjection                  *handler.Handler
 	SecretGeneratorProjection           *handler.Handler
@@ -68,6 +75,7 @@ var (
 	KeyProjection                       *handler.Handler
 	SecurityPolicyProjection            *handler.Handler
 	NotificationPolicyProjection        *handler.Handler
+	InactivityPolicyProjection          *handler.Handler
 	NotificationsProjection             interface{}
 	NotificationsQuotaProjection        interface{}
 	TelemetryPusherProjection           interface{}
@@ -96,6 +104,8 @@ var (
 	InstanceDomainFields    *handler.FieldHandler
 	MembershipFields        *handler.FieldHandler
 	PermissionFields        *handler.FieldHandler
+	SessionUserFields       *handler.FieldHandler
+	OrgParentFields         *handler.FieldHandler
 
 	GroupProjection      *handler.Handler
 	GroupUsersProjection *handler.Handler
@@ -155,6 +165,8 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	IDPLoginPolicyLinkProjection = newIDPLoginPolicyLinkProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idp_login_policy_links"]))
 	IDPTemplateProjection = newIDPTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["idp_templates"]))
 	MailTemplateProjection = newMailTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["mail_templates"]))
+	MailMessageTemplateProjection = newMailMessageTemplateProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["mail_message_templates"]))
+	NotificationDeliveryProjection = newNotificationDeliveryProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_deliveries"]))
 	MessageTextProjection = newMessageTextProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["message_texts"]))
 	CustomTextProjection = newCustomTextProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["custom_texts"]))
 	UserProjection = newUserProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["users"]))
@@ -169,6 +181,11 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	PersonalAccessTokenProjection = newPersonalAccessTokenProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["personal_access_tokens"]))
 	UserGrantProjection = newUserGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_grants"]))
 	UserMetadataProjection = newUserMetadataProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_metadata"]))
+	UserConsentProjection = newUserConsentProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_consents"]))
+	UserTrustedDeviceProjection = newUserTrustedDeviceProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_trusted_devices"]))
+	UserPasswordHashProjection = newUserPasswordHashProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_password_hashes"]))
+	UserDeviceSessionProjection = newUserDeviceSessionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_device_sessions"]))
+	UserLoginHistoryProjection = newUserLoginHistoryProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_login_history"]))
 	UserAuthMethodProjection = newUserAuthMethodProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_auth_method"]))
 	InstanceProjection = newInstanceProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instances"]))
 	SecretGeneratorProjection = newSecretGeneratorProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["secret_generators"]))
@@ -179,6 +196,7 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	KeyProjection = newKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["keys"]), keyEncryptionAlgorithm, certEncryptionAlgorithm)
 	SecurityPolicyProjection = newSecurityPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["security_policies"]))
 	NotificationPolicyProjection = newNotificationPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_policies"]))
+	InactivityPolicyProjection = newInactivityPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["inactivity_policies"]))
 	DeviceAuthProjection = newDeviceAuthProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["device_auth"]))
 	SessionProjection = newSessionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["sessions"]))
 	AuthRequestProjection = newAuthRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["auth_requests"]))
@@ -202,6 +220,8 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	InstanceDomainFields = newFillInstanceDomainFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsInstanceDomain]))
 	MembershipFields = newFillMembershipFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsMemberships]))
 	PermissionFields = newFillPermissionFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsPermission]))
+	SessionUserFields = newFillSessionUserFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsSessionUser]))
+	OrgParentFields = newFillOrgParentFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsOrgParent]))
 	// Don't forget to add the new field handler to [ProjectInstanceFields]
 
 	GroupProjection = newGroupProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["groups"]))
@@ -318,6 +338,8 @@ func newFieldsList() {
 		InstanceDomainFields,
 		MembershipFields,
 		PermissionFields,
+		SessionUserFields,
+		OrgParentFields,
 	}
 }
 
@@ -350,6 +372,8 @@ func newProjectionsList() {
 		IDPUserLinkProjection,
 		IDPLoginPolicyLinkProjection,
 		MailTemplateProjection,
+		MailMessageTemplateProjection,
+		NotificationDeliveryProjection,
 		MessageTextProjection,
 		CustomTextProjection,
 		UserProjection,
@@ -364,6 +388,11 @@ func newProjectionsList() {
 		PersonalAccessTokenProjection,
 		UserGrantProjection,
 		UserMetadataProjection,
+		UserConsentProjection,
+		UserTrustedDeviceProjection,
+		UserPasswordHashProjection,
+		UserDeviceSessionProjection,
+		UserLoginHistoryProjection,
 		UserAuthMethodProjection,
 		InstanceProjection,
 		SecretGeneratorProjection,
@@ -374,6 +403,7 @@ func newProjectionsList() {
 		KeyProjection,
 		SecurityPolicyProjection,
 		NotificationPolicyProjection,
+		InactivityPolicyProjection,
 		DeviceAuthProjection,
 		SessionProjection,
 		AuthRequestProjection,
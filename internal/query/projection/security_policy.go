@@ -22,6 +22,11 @@ const (
 
 	SecurityPolicyColumnEnableDynamicClientRegistration               = "enable_dynamic_client_registration"
 	SecurityPolicyColumnAllowUnauthenticatedDynamicClientRegistration = "allow_unauthenticated_dynamic_client_registration"
+	SecurityPolicyColumnRequireSoftwareStatement                      = "require_software_statement"
+	SecurityPolicyColumnSoftwareStatementIssuers                      = "software_statement_issuers"
+	SecurityPolicyColumnACRDefinitions                                = "acr_definitions"
+	SecurityPolicyColumnPasswordRehashBefore                          = "password_rehash_before"
+	SecurityPolicyColumnInsecurePasswordHashDeadline                  = "insecure_password_hash_deadline"
 )
 
 type securityPolicyProjection struct{}
@@ -46,6 +51,11 @@ func (*securityPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(SecurityPolicyColumnEnableImpersonation, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(SecurityPolicyColumnEnableDynamicClientRegistration, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(SecurityPolicyColumnAllowUnauthenticatedDynamicClientRegistration, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SecurityPolicyColumnRequireSoftwareStatement, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SecurityPolicyColumnSoftwareStatementIssuers, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SecurityPolicyColumnACRDefinitions, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SecurityPolicyColumnPasswordRehashBefore, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SecurityPolicyColumnInsecurePasswordHashDeadline, handler.ColumnTypeTimestamp, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(SecurityPolicyColumnInstanceID),
 		),
@@ -98,6 +108,21 @@ func (p *securityPolicyProjection) reduceSecurityPolicySet(event eventstore.Even
 	if e.AllowUnauthenticatedDynamicClientRegistration != nil {
 		changes = append(changes, handler.NewCol(SecurityPolicyColumnAllowUnauthenticatedDynamicClientRegistration, e.AllowUnauthenticatedDynamicClientRegistration))
 	}
+	if e.RequireSoftwareStatement != nil {
+		changes = append(changes, handler.NewCol(SecurityPolicyColumnRequireSoftwareStatement, *e.RequireSoftwareStatement))
+	}
+	if e.SoftwareStatementIssuers != nil {
+		changes = append(changes, handler.NewJSONCol(SecurityPolicyColumnSoftwareStatementIssuers, *e.SoftwareStatementIssuers))
+	}
+	if e.ACRDefinitions != nil {
+		changes = append(changes, handler.NewJSONCol(SecurityPolicyColumnACRDefinitions, *e.ACRDefinitions))
+	}
+	if e.PasswordRehashBefore != nil {
+		changes = append(changes, handler.NewCol(SecurityPolicyColumnPasswordRehashBefore, *e.PasswordRehashBefore))
+	}
+	if e.InsecurePasswordHashDeadline != nil {
+		changes = append(changes, handler.NewCol(SecurityPolicyColumnInsecurePasswordHashDeadline, *e.InsecurePasswordHashDeadline))
+	}
 	return handler.NewUpsertStatement(
 		e,
 		[]handler.Column{
@@ -3,6 +3,7 @@ package projection
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
@@ -35,6 +36,9 @@ const (
 	SessionColumnOTPSMSCheckedAt        = "otp_sms_checked_at"
 	SessionColumnOTPEmailCheckedAt      = "otp_email_checked_at"
 	SessionColumnRecoveryCodeCheckedAt  = "mfa_recovery_code_checked_at"
+	SessionColumnMagicLinkCheckedAt     = "magic_link_checked_at"
+	SessionColumnTrustedDeviceCheckedAt = "trusted_device_checked_at"
+	SessionColumnPushCheckedAt          = "push_checked_at"
 	SessionColumnMetadata               = "metadata"
 	SessionColumnTokenID                = "token_id"
 	SessionColumnUserAgentFingerprintID = "user_agent_fingerprint_id"
@@ -42,6 +46,11 @@ const (
 	SessionColumnUserAgentDescription   = "user_agent_description"
 	SessionColumnUserAgentHeader        = "user_agent_header"
 	SessionColumnExpiration             = "expiration"
+	SessionColumnRiskScore              = "risk_score"
+	SessionColumnRiskSignals            = "risk_signals"
+	SessionColumnRiskOutcome            = "risk_outcome"
+	SessionColumnRiskLocation           = "risk_location"
+	SessionColumnRiskEvaluatedAt        = "risk_evaluated_at"
 )
 
 type sessionProjection struct{}
@@ -76,6 +85,9 @@ func (*sessionProjection) Init() *old_handler.Check {
 			handler.NewColumn(SessionColumnOTPSMSCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
 			handler.NewColumn(SessionColumnOTPEmailCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
 			handler.NewColumn(SessionColumnRecoveryCodeCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnMagicLinkCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnTrustedDeviceCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnPushCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
 			handler.NewColumn(SessionColumnMetadata, handler.ColumnTypeJSONB, handler.Nullable()),
 			handler.NewColumn(SessionColumnTokenID, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(SessionColumnUserAgentFingerprintID, handler.ColumnTypeText, handler.Nullable()),
@@ -83,6 +95,11 @@ func (*sessionProjection) Init() *old_handler.Check {
 			handler.NewColumn(SessionColumnUserAgentDescription, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(SessionColumnUserAgentHeader, handler.ColumnTypeJSONB, handler.Nullable()),
 			handler.NewColumn(SessionColumnExpiration, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnRiskScore, handler.ColumnTypeInt64, handler.Nullable()),
+			handler.NewColumn(SessionColumnRiskSignals, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(SessionColumnRiskOutcome, handler.ColumnTypeEnum, handler.Nullable()),
+			handler.NewColumn(SessionColumnRiskLocation, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SessionColumnRiskEvaluatedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(SessionColumnInstanceID, SessionColumnID),
 			handler.WithIndex(handler.NewIndex(
@@ -135,6 +152,22 @@ func (p *sessionProjection) Reducers() []handler.AggregateReducer {
 					Event:  session.RecoveryCodeCheckedType,
 					Reduce: p.reduceRecoveryCodeChecked,
 				},
+				{
+					Event:  session.MagicLinkCheckedType,
+					Reduce: p.reduceMagicLinkChecked,
+				},
+				{
+					Event:  session.TrustedDeviceCheckedType,
+					Reduce: p.reduceTrustedDeviceChecked,
+				},
+				{
+					Event:  session.PushCheckedType,
+					Reduce: p.reducePushChecked,
+				},
+				{
+					Event:  session.RiskEvaluatedType,
+					Reduce: p.reduceRiskEvaluated,
+				},
 				{
 					Event:  session.TokenSetType,
 					Reduce: p.reduceTokenSet,
@@ -384,6 +417,90 @@ func (p *sessionProjection) reduceRecoveryCodeChecked(event eventstore.Event) (*
 	), nil
 }
 
+func (p *sessionProjection) reduceMagicLinkChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.MagicLinkCheckedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnMagicLinkCheckedAt, e.CheckedAt),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *sessionProjection) reduceTrustedDeviceChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.TrustedDeviceCheckedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnTrustedDeviceCheckedAt, e.CheckedAt),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *sessionProjection) reducePushChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.PushCheckedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnPushCheckedAt, e.CheckedAt),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *sessionProjection) reduceRiskEvaluated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.RiskEvaluatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnRiskScore, e.Score),
+			handler.NewCol(SessionColumnRiskSignals, database.TextArray[domain.RiskSignal](e.Signals)),
+			handler.NewCol(SessionColumnRiskOutcome, e.Outcome),
+			handler.NewJSONCol(SessionColumnRiskLocation, e.Location),
+			handler.NewCol(SessionColumnRiskEvaluatedAt, e.EvaluatedAt),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *sessionProjection) reduceTokenSet(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*session.TokenSetEvent)
 	if !ok {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/muhlemmer/gu"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -232,6 +233,47 @@ func TestSessionProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instance reduceRiskEvaluated",
+			args: args{
+				event: getEvent(testEvent(
+					session.RiskEvaluatedType,
+					session.AggregateType,
+					[]byte(`{
+						"userID": "user-id",
+						"userResourceOwner": "org-id",
+						"location": {"country": "CH", "asn": 65536},
+						"score": 40,
+						"signals": ["new_country"],
+						"outcome": 2,
+						"evaluatedAt": "2023-05-04T00:00:00Z"
+					}`),
+				), eventstore.GenericEventMapper[session.RiskEvaluatedEvent]),
+			},
+			reduce: (&sessionProjection{}).reduceRiskEvaluated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("session"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sessions8 SET (change_date, sequence, risk_score, risk_signals, risk_outcome, risk_location, risk_evaluated_at) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8) AND (instance_id = $9)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								40,
+								database.TextArray[domain.RiskSignal]{domain.RiskSignalNewCountry},
+								domain.RiskOutcomeRequireMFA,
+								[]byte(`{"country":"CH","asn":65536}`),
+								time.Date(2023, time.May, 4, 0, 0, 0, 0, time.UTC),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceTokenSet",
 			args: args{
@@ -1591,6 +1591,10 @@ func setSecretGeneratorSettingsAttrs(generatorType legacy_domain.SecretGenerator
 		secretGeneratorSettingsAttrs.InviteCode = &domain.InviteCodeAttributes{
 			SecretGeneratorAttrsWithExpiry: attrsWithExpiry,
 		}
+	case legacy_domain.SecretGeneratorTypeMagicLink:
+		secretGeneratorSettingsAttrs.MagicLink = &domain.MagicLinkAttributes{
+			SecretGeneratorAttrsWithExpiry: attrsWithExpiry,
+		}
 	case legacy_domain.SecretGeneratorTypeSigningKey:
 		// do nothing as this secret generator is not persisted in the settings
 	case legacy_domain.SecretGeneratorTypeUnspecified:
@@ -11,9 +11,11 @@ import (
 )
 
 const (
-	SMSConfigProjectionTable = "projections.sms_configs3"
+	SMSConfigProjectionTable = "projections.sms_configs4"
 	SMSTwilioTable           = SMSConfigProjectionTable + "_" + smsTwilioTableSuffix
 	SMSHTTPTable             = SMSConfigProjectionTable + "_" + smsHTTPTableSuffix
+	SMSWhatsAppTable         = SMSConfigProjectionTable + "_" + smsWhatsAppTableSuffix
+	SMSVoiceTable            = SMSConfigProjectionTable + "_" + smsVoiceTableSuffix
 
 	SMSColumnID            = "id"
 	SMSColumnAggregateID   = "aggregate_id"
@@ -24,6 +26,7 @@ const (
 	SMSColumnResourceOwner = "resource_owner"
 	SMSColumnInstanceID    = "instance_id"
 	SMSColumnDescription   = "description"
+	SMSColumnChannel       = "channel"
 
 	smsTwilioTableSuffix            = "twilio"
 	SMSTwilioColumnSMSID            = "sms_id"
@@ -38,6 +41,21 @@ const (
 	SMSHTTPColumnInstanceID = "instance_id"
 	SMSHTTPColumnEndpoint   = "endpoint"
 	SMSHTTPColumnSigningKey = "signing_key"
+
+	smsWhatsAppTableSuffix            = "whatsapp"
+	SMSWhatsAppColumnSMSID            = "sms_id"
+	SMSWhatsAppColumnInstanceID       = "instance_id"
+	SMSWhatsAppColumnPhoneNumberID    = "phone_number_id"
+	SMSWhatsAppColumnAccessToken      = "access_token"
+	SMSWhatsAppColumnTemplateName     = "template_name"
+	SMSWhatsAppColumnTemplateLanguage = "template_language"
+
+	smsVoiceTableSuffix        = "voice"
+	SMSVoiceColumnSMSID        = "sms_id"
+	SMSVoiceColumnInstanceID   = "instance_id"
+	SMSVoiceColumnSID          = "sid"
+	SMSVoiceColumnToken        = "token"
+	SMSVoiceColumnSenderNumber = "sender_number"
 )
 
 type smsConfigProjection struct{}
@@ -62,6 +80,7 @@ func (*smsConfigProjection) Init() *old_handler.Check {
 			handler.NewColumn(SMSColumnResourceOwner, handler.ColumnTypeText),
 			handler.NewColumn(SMSColumnInstanceID, handler.ColumnTypeText),
 			handler.NewColumn(SMSColumnDescription, handler.ColumnTypeText),
+			handler.NewColumn(SMSColumnChannel, handler.ColumnTypeEnum),
 		},
 			handler.NewPrimaryKey(SMSColumnInstanceID, SMSColumnID),
 		),
@@ -87,6 +106,29 @@ func (*smsConfigProjection) Init() *old_handler.Check {
 			smsHTTPTableSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSWhatsAppColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSWhatsAppColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSWhatsAppColumnPhoneNumberID, handler.ColumnTypeText),
+			handler.NewColumn(SMSWhatsAppColumnAccessToken, handler.ColumnTypeJSONB),
+			handler.NewColumn(SMSWhatsAppColumnTemplateName, handler.ColumnTypeText),
+			handler.NewColumn(SMSWhatsAppColumnTemplateLanguage, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSWhatsAppColumnInstanceID, SMSWhatsAppColumnSMSID),
+			smsWhatsAppTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSVoiceColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVoiceColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVoiceColumnSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVoiceColumnToken, handler.ColumnTypeJSONB),
+			handler.NewColumn(SMSVoiceColumnSenderNumber, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSVoiceColumnInstanceID, SMSVoiceColumnSMSID),
+			smsVoiceTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -115,6 +157,22 @@ func (p *smsConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMSConfigHTTPChangedEventType,
 					Reduce: p.reduceSMSConfigHTTPChanged,
 				},
+				{
+					Event:  instance.SMSConfigWhatsAppAddedEventType,
+					Reduce: p.reduceSMSConfigWhatsAppAdded,
+				},
+				{
+					Event:  instance.SMSConfigWhatsAppChangedEventType,
+					Reduce: p.reduceSMSConfigWhatsAppChanged,
+				},
+				{
+					Event:  instance.SMSConfigVoiceAddedEventType,
+					Reduce: p.reduceSMSConfigVoiceAdded,
+				},
+				{
+					Event:  instance.SMSConfigVoiceChangedEventType,
+					Reduce: p.reduceSMSConfigVoiceChanged,
+				},
 				{
 					Event:  instance.SMSConfigTwilioActivatedEventType,
 					Reduce: p.reduceSMSConfigTwilioActivated,
@@ -167,6 +225,7 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioAdded(event eventstore.Event)
 				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
 				handler.NewCol(SMSColumnSequence, e.Sequence()),
 				handler.NewCol(SMSColumnDescription, e.Description),
+				handler.NewCol(SMSColumnChannel, domain.PhoneChannelSMS),
 			},
 		),
 		handler.AddCreateStatement(
@@ -281,6 +340,7 @@ func (p *smsConfigProjection) reduceSMSConfigHTTPAdded(event eventstore.Event) (
 				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
 				handler.NewCol(SMSColumnSequence, e.Sequence()),
 				handler.NewCol(SMSColumnDescription, e.Description),
+				handler.NewCol(SMSColumnChannel, domain.PhoneChannelSMS),
 			},
 		),
 		handler.AddCreateStatement(
@@ -338,6 +398,172 @@ func (p *smsConfigProjection) reduceSMSConfigHTTPChanged(event eventstore.Event)
 	return handler.NewMultiStatement(e, stmts...), nil
 }
 
+func (p *smsConfigProjection) reduceSMSConfigWhatsAppAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigWhatsAppAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+				handler.NewCol(SMSColumnDescription, e.Description),
+				handler.NewCol(SMSColumnChannel, domain.PhoneChannelWhatsApp),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSWhatsAppColumnSMSID, e.ID),
+				handler.NewCol(SMSWhatsAppColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSWhatsAppColumnPhoneNumberID, e.PhoneNumberID),
+				handler.NewCol(SMSWhatsAppColumnAccessToken, e.AccessToken),
+				handler.NewCol(SMSWhatsAppColumnTemplateName, e.TemplateName),
+				handler.NewCol(SMSWhatsAppColumnTemplateLanguage, e.TemplateLanguage),
+			},
+			handler.WithTableSuffix(smsWhatsAppTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigWhatsAppChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigWhatsAppChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	columns := []handler.Column{
+		handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMSColumnSequence, e.Sequence()),
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMSColumnDescription, *e.Description))
+	}
+	stmts = append(stmts, handler.AddUpdateStatement(
+		columns,
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, e.ID),
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	))
+
+	whatsAppColumns := make([]handler.Column, 0, 4)
+	if e.PhoneNumberID != nil {
+		whatsAppColumns = append(whatsAppColumns, handler.NewCol(SMSWhatsAppColumnPhoneNumberID, *e.PhoneNumberID))
+	}
+	if e.AccessToken != nil {
+		whatsAppColumns = append(whatsAppColumns, handler.NewCol(SMSWhatsAppColumnAccessToken, e.AccessToken))
+	}
+	if e.TemplateName != nil {
+		whatsAppColumns = append(whatsAppColumns, handler.NewCol(SMSWhatsAppColumnTemplateName, *e.TemplateName))
+	}
+	if e.TemplateLanguage != nil {
+		whatsAppColumns = append(whatsAppColumns, handler.NewCol(SMSWhatsAppColumnTemplateLanguage, *e.TemplateLanguage))
+	}
+	if len(whatsAppColumns) > 0 {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			whatsAppColumns,
+			[]handler.Condition{
+				handler.NewCond(SMSWhatsAppColumnSMSID, e.ID),
+				handler.NewCond(SMSWhatsAppColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsWhatsAppTableSuffix),
+		))
+	}
+
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVoiceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigVoiceAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+				handler.NewCol(SMSColumnDescription, e.Description),
+				handler.NewCol(SMSColumnChannel, domain.PhoneChannelVoice),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSVoiceColumnSMSID, e.ID),
+				handler.NewCol(SMSVoiceColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSVoiceColumnSID, e.SID),
+				handler.NewCol(SMSVoiceColumnToken, e.Token),
+				handler.NewCol(SMSVoiceColumnSenderNumber, e.SenderNumber),
+			},
+			handler.WithTableSuffix(smsVoiceTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVoiceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigVoiceChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	columns := []handler.Column{
+		handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMSColumnSequence, e.Sequence()),
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMSColumnDescription, *e.Description))
+	}
+	stmts = append(stmts, handler.AddUpdateStatement(
+		columns,
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, e.ID),
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	))
+
+	voiceColumns := make([]handler.Column, 0, 3)
+	if e.SID != nil {
+		voiceColumns = append(voiceColumns, handler.NewCol(SMSVoiceColumnSID, *e.SID))
+	}
+	if e.Token != nil {
+		voiceColumns = append(voiceColumns, handler.NewCol(SMSVoiceColumnToken, e.Token))
+	}
+	if e.SenderNumber != nil {
+		voiceColumns = append(voiceColumns, handler.NewCol(SMSVoiceColumnSenderNumber, *e.SenderNumber))
+	}
+	if len(voiceColumns) > 0 {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			voiceColumns,
+			[]handler.Condition{
+				handler.NewCond(SMSVoiceColumnSMSID, e.ID),
+				handler.NewCond(SMSVoiceColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsVoiceTableSuffix),
+		))
+	}
+
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
 func (p *smsConfigProjection) reduceSMSConfigTwilioActivated(event eventstore.Event) (*handler.Statement, error) {
 	e, err := assertEvent[*instance.SMSConfigTwilioActivatedEvent](event)
 	if err != nil {
@@ -355,6 +581,7 @@ func (p *smsConfigProjection) reduceSMSConfigTwilioActivated(event eventstore.Ev
 			[]handler.Condition{
 				handler.Not(handler.NewCond(SMSColumnID, e.ID)),
 				handler.NewCond(SMSColumnState, domain.SMSConfigStateActive),
+				handler.NewCond(SMSColumnChannel, domain.PhoneChannelSMS),
 				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
 			},
 		),
@@ -424,6 +651,7 @@ func (p *smsConfigProjection) reduceSMSConfigActivated(event eventstore.Event) (
 			[]handler.Condition{
 				handler.Not(handler.NewCond(SMSColumnID, e.ID)),
 				handler.NewCond(SMSColumnState, domain.SMSConfigStateActive),
+				handler.NewCond(SMSColumnChannel, e.Channel.OrSMS()),
 				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
 			},
 		),
@@ -54,6 +54,7 @@ const (
 	// phone
 	HumanPhoneCol           = "phone"
 	HumanIsPhoneVerifiedCol = "is_phone_verified"
+	HumanPhoneChannelCol    = "phone_channel"
 
 	// machine
 	UserMachineSuffix         = "machines"
@@ -117,6 +118,7 @@ func (*userProjection) Init() *old_handler.Check {
 			handler.NewColumn(HumanIsEmailVerifiedCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(HumanPhoneCol, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(HumanIsPhoneVerifiedCol, handler.ColumnTypeBool, handler.Nullable()),
+			handler.NewColumn(HumanPhoneChannelCol, handler.ColumnTypeEnum, handler.Default(0)),
 			handler.NewColumn(HumanPasswordChangeRequired, handler.ColumnTypeBool),
 			handler.NewColumn(HumanPasswordChanged, handler.ColumnTypeTimestamp, handler.Nullable()),
 			handler.NewColumn(HumanMFAInitSkipped, handler.ColumnTypeTimestamp, handler.Nullable()),
@@ -251,6 +253,10 @@ func (p *userProjection) Reducers() []handler.AggregateReducer {
 					Event:  user.UserV1PhoneVerifiedType,
 					Reduce: p.reduceHumanPhoneVerified,
 				},
+				{
+					Event:  user.HumanPhoneChannelSetType,
+					Reduce: p.reduceHumanPhoneChannelSet,
+				},
 				{
 					Event:  user.HumanEmailChangedType,
 					Reduce: p.reduceHumanEmailChanged,
@@ -804,6 +810,37 @@ func (p *userProjection) reduceHumanPhoneVerified(event eventstore.Event) (*hand
 	), nil
 }
 
+func (p *userProjection) reduceHumanPhoneChannelSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPhoneChannelSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ieph4", "reduce.wrong.event.type %s", user.HumanPhoneChannelSetType)
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(UserChangeDateCol, e.CreationDate()),
+				handler.NewCol(UserSequenceCol, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(UserIDCol, e.Aggregate().ID),
+				handler.NewCond(UserInstanceIDCol, e.Aggregate().InstanceID),
+			},
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(HumanPhoneChannelCol, e.Channel),
+			},
+			[]handler.Condition{
+				handler.NewCond(HumanUserIDCol, e.Aggregate().ID),
+				handler.NewCond(HumanUserInstanceIDCol, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(UserHumanSuffix),
+		),
+	), nil
+}
+
 func (p *userProjection) reduceHumanEmailChanged(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*user.HumanEmailChangedEvent)
 	if !ok {
@@ -0,0 +1,391 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserDeviceSessionProjectionTable = "projections.user_device_sessions"
+
+	UserDeviceSessionColumnID                     = "id"
+	UserDeviceSessionColumnType                   = "type"
+	UserDeviceSessionColumnUserID                 = "user_id"
+	UserDeviceSessionColumnResourceOwner          = "resource_owner"
+	UserDeviceSessionColumnInstanceID             = "instance_id"
+	UserDeviceSessionColumnSessionID              = "session_id"
+	UserDeviceSessionColumnClientID               = "client_id"
+	UserDeviceSessionColumnFingerprintID          = "fingerprint_id"
+	UserDeviceSessionColumnIP                     = "ip"
+	UserDeviceSessionColumnDescription            = "description"
+	UserDeviceSessionColumnCreationDate           = "creation_date"
+	UserDeviceSessionColumnChangeDate             = "change_date"
+	UserDeviceSessionColumnSequence               = "sequence"
+	UserDeviceSessionColumnAccessTokenExpiration  = "access_token_expiration"
+	UserDeviceSessionColumnRefreshTokenExpiration = "refresh_token_expiration"
+)
+
+// userDeviceSessionProjection keeps the OIDC sessions, SAML sessions and refresh tokens
+// issued to a user agent, so users can see and revoke them per device.
+// Sessions of the session API are read from the [sessionProjection].
+type userDeviceSessionProjection struct{}
+
+func newUserDeviceSessionProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userDeviceSessionProjection))
+}
+
+func (*userDeviceSessionProjection) Name() string {
+	return UserDeviceSessionProjectionTable
+}
+
+func (*userDeviceSessionProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserDeviceSessionColumnID, handler.ColumnTypeText),
+			handler.NewColumn(UserDeviceSessionColumnType, handler.ColumnTypeEnum),
+			handler.NewColumn(UserDeviceSessionColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(UserDeviceSessionColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(UserDeviceSessionColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserDeviceSessionColumnSessionID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(UserDeviceSessionColumnClientID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(UserDeviceSessionColumnFingerprintID, handler.ColumnTypeText),
+			handler.NewColumn(UserDeviceSessionColumnIP, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(UserDeviceSessionColumnDescription, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(UserDeviceSessionColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserDeviceSessionColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserDeviceSessionColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserDeviceSessionColumnAccessTokenExpiration, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(UserDeviceSessionColumnRefreshTokenExpiration, handler.ColumnTypeTimestamp, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(UserDeviceSessionColumnInstanceID, UserDeviceSessionColumnID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{UserDeviceSessionColumnUserID})),
+			handler.WithIndex(handler.NewIndex("session_id", []string{UserDeviceSessionColumnSessionID})),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserDeviceSessionColumnResourceOwner})),
+		),
+	)
+}
+
+func (p *userDeviceSessionProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: oidcsession.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  oidcsession.AddedType,
+					Reduce: p.reduceOIDCSessionAdded,
+				},
+				{
+					Event:  oidcsession.AccessTokenAddedType,
+					Reduce: p.reduceOIDCAccessTokenAdded,
+				},
+				{
+					Event:  oidcsession.AccessTokenRevokedType,
+					Reduce: p.reduceOIDCAccessTokenRevoked,
+				},
+				{
+					Event:  oidcsession.RefreshTokenAddedType,
+					Reduce: p.reduceOIDCRefreshTokenAdded,
+				},
+				{
+					Event:  oidcsession.RefreshTokenRenewedType,
+					Reduce: p.reduceOIDCRefreshTokenRenewed,
+				},
+				{
+					Event:  oidcsession.RefreshTokenRevokedType,
+					Reduce: p.reduceOIDCRefreshTokenRevoked,
+				},
+			},
+		},
+		{
+			Aggregate: samlsession.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  samlsession.AddedType,
+					Reduce: p.reduceSAMLSessionAdded,
+				},
+				{
+					Event:  samlsession.SAMLResponseAddedType,
+					Reduce: p.reduceSAMLResponseAdded,
+				},
+				{
+					Event:  samlsession.SAMLResponseRevokedType,
+					Reduce: p.reduceSAMLResponseRevoked,
+				},
+			},
+		},
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.TerminateType,
+					Reduce: p.reduceSessionTerminated,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanRefreshTokenAddedType,
+					Reduce: p.reduceRefreshTokenAdded,
+				},
+				{
+					Event:  user.HumanRefreshTokenRenewedType,
+					Reduce: p.reduceRefreshTokenRenewed,
+				},
+				{
+					Event:  user.HumanRefreshTokenRemovedType,
+					Reduce: p.reduceRefreshTokenRemoved,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserDeviceSessionColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userDeviceSessionProjection) reduceOIDCSessionAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*oidcsession.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ahW3e", "reduce.wrong.event.type %s", oidcsession.AddedType)
+	}
+	return p.addDeviceSession(e, domain.DeviceSessionTypeOIDC, e.UserID, e.UserResourceOwner, e.SessionID, e.ClientID, e.UserAgent), nil
+}
+
+func (p *userDeviceSessionProjection) reduceOIDCAccessTokenAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*oidcsession.AccessTokenAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ieb7o", "reduce.wrong.event.type %s", oidcsession.AccessTokenAddedType)
+	}
+	return p.updateDeviceSession(e, e.Aggregate().ID,
+		handler.NewCol(UserDeviceSessionColumnAccessTokenExpiration, e.CreatedAt().Add(e.Lifetime)),
+	), nil
+}
+
+func (p *userDeviceSessionProjection) reduceOIDCAccessTokenRevoked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*oidcsession.AccessTokenRevokedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Oosh4", "reduce.wrong.event.type %s", oidcsession.AccessTokenRevokedType)
+	}
+	return p.updateDeviceSession(e, e.Aggregate().ID,
+		handler.NewCol(UserDeviceSessionColumnAccessTokenExpiration, nil),
+	), nil
+}
+
+func (p *userDeviceSessionProjection) reduceOIDCRefreshTokenAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*oidcsession.RefreshTokenAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Eeng1", "reduce.wrong.event.type %s", oidcsession.RefreshTokenAddedType)
+	}
+	return p.updateDeviceSession(e, e.Aggregate().ID,
+		handler.NewCol(UserDeviceSessionColumnRefreshTokenExpiration, e.CreatedAt().Add(e.Lifetime)),
+	), nil
+}
+
+func (p *userDeviceSessionProjection) reduceOIDCRefreshTokenRenewed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*oidcsession.RefreshTokenRenewedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-aiC8u", "reduce.wrong.event.type %s", oidcsession.RefreshTokenRenewedType)
+	}
+	return p.updateDeviceSession(e, e.Aggregate().ID), nil
+}
+
+func (p *userDeviceSessionProjection) reduceOIDCRefreshTokenRevoked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*oidcsession.RefreshTokenRevokedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ug5oh", "reduce.wrong.event.type %s", oidcsession.RefreshTokenRevokedType)
+	}
+	return p.updateDeviceSession(e, e.Aggregate().ID,
+		handler.NewCol(UserDeviceSessionColumnRefreshTokenExpiration, nil),
+	), nil
+}
+
+func (p *userDeviceSessionProjection) reduceSAMLSessionAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*samlsession.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-xoo9W", "reduce.wrong.event.type %s", samlsession.AddedType)
+	}
+	return p.addDeviceSession(e, domain.DeviceSessionTypeSAML, e.UserID, e.UserResourceOwner, e.SessionID, e.EntityID, e.UserAgent), nil
+}
+
+func (p *userDeviceSessionProjection) reduceSAMLResponseAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*samlsession.SAMLResponseAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Kai0d", "reduce.wrong.event.type %s", samlsession.SAMLResponseAddedType)
+	}
+	return p.updateDeviceSession(e, e.Aggregate().ID), nil
+}
+
+func (p *userDeviceSessionProjection) reduceSAMLResponseRevoked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*samlsession.SAMLResponseRevokedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-mee5U", "reduce.wrong.event.type %s", samlsession.SAMLResponseRevokedType)
+	}
+	return p.deleteDeviceSession(e, e.Aggregate().ID), nil
+}
+
+func (p *userDeviceSessionProjection) reduceSessionTerminated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.TerminateEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Cie6a", "reduce.wrong.event.type %s", session.TerminateType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserDeviceSessionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserDeviceSessionColumnSessionID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userDeviceSessionProjection) reduceRefreshTokenAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRefreshTokenAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Thu5e", "reduce.wrong.event.type %s", user.HumanRefreshTokenAddedType)
+	}
+	if e.UserAgentID == "" {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserDeviceSessionColumnID, e.TokenID),
+			handler.NewCol(UserDeviceSessionColumnType, domain.DeviceSessionTypeRefreshToken),
+			handler.NewCol(UserDeviceSessionColumnUserID, e.Aggregate().ID),
+			handler.NewCol(UserDeviceSessionColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserDeviceSessionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserDeviceSessionColumnClientID, e.ClientID),
+			handler.NewCol(UserDeviceSessionColumnFingerprintID, e.UserAgentID),
+			handler.NewCol(UserDeviceSessionColumnCreationDate, e.CreatedAt()),
+			handler.NewCol(UserDeviceSessionColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(UserDeviceSessionColumnSequence, e.Sequence()),
+			handler.NewCol(UserDeviceSessionColumnRefreshTokenExpiration, e.CreatedAt().Add(e.Expiration)),
+		},
+	), nil
+}
+
+func (p *userDeviceSessionProjection) reduceRefreshTokenRenewed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRefreshTokenRenewedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Phei2", "reduce.wrong.event.type %s", user.HumanRefreshTokenRenewedType)
+	}
+	return p.updateDeviceSession(e, e.TokenID), nil
+}
+
+func (p *userDeviceSessionProjection) reduceRefreshTokenRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRefreshTokenRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Wah8o", "reduce.wrong.event.type %s", user.HumanRefreshTokenRemovedType)
+	}
+	return p.deleteDeviceSession(e, e.TokenID), nil
+}
+
+func (p *userDeviceSessionProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-eiM1k", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserDeviceSessionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserDeviceSessionColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userDeviceSessionProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ahp3i", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserDeviceSessionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserDeviceSessionColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
+
+// addDeviceSession creates the device session, if the user agent provided a fingerprint.
+// Without it, the session cannot be attributed to a device.
+func (p *userDeviceSessionProjection) addDeviceSession(e eventstore.Event, sessionType domain.DeviceSessionType, userID, resourceOwner, sessionID, clientID string, userAgent *domain.UserAgent) *handler.Statement {
+	if userAgent == nil || userAgent.FingerprintID == nil || *userAgent.FingerprintID == "" {
+		return handler.NewNoOpStatement(e)
+	}
+	cols := []handler.Column{
+		handler.NewCol(UserDeviceSessionColumnID, e.Aggregate().ID),
+		handler.NewCol(UserDeviceSessionColumnType, sessionType),
+		handler.NewCol(UserDeviceSessionColumnUserID, userID),
+		handler.NewCol(UserDeviceSessionColumnResourceOwner, resourceOwner),
+		handler.NewCol(UserDeviceSessionColumnInstanceID, e.Aggregate().InstanceID),
+		handler.NewCol(UserDeviceSessionColumnSessionID, sessionID),
+		handler.NewCol(UserDeviceSessionColumnClientID, clientID),
+		handler.NewCol(UserDeviceSessionColumnFingerprintID, *userAgent.FingerprintID),
+		handler.NewCol(UserDeviceSessionColumnDescription, userAgent.Description),
+		handler.NewCol(UserDeviceSessionColumnCreationDate, e.CreatedAt()),
+		handler.NewCol(UserDeviceSessionColumnChangeDate, e.CreatedAt()),
+		handler.NewCol(UserDeviceSessionColumnSequence, e.Sequence()),
+	}
+	if userAgent.IP != nil {
+		cols = append(cols, handler.NewCol(UserDeviceSessionColumnIP, userAgent.IP.String()))
+	}
+	return handler.NewCreateStatement(e, cols)
+}
+
+// updateDeviceSession sets the change date as the last use of the device session and the additional columns.
+func (p *userDeviceSessionProjection) updateDeviceSession(e eventstore.Event, id string, cols ...handler.Column) *handler.Statement {
+	return handler.NewUpdateStatement(
+		e,
+		append([]handler.Column{
+			handler.NewCol(UserDeviceSessionColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(UserDeviceSessionColumnSequence, e.Sequence()),
+		}, cols...),
+		[]handler.Condition{
+			handler.NewCond(UserDeviceSessionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserDeviceSessionColumnID, id),
+		},
+	)
+}
+
+func (p *userDeviceSessionProjection) deleteDeviceSession(e eventstore.Event, id string) *handler.Statement {
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserDeviceSessionColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserDeviceSessionColumnID, id),
+		},
+	)
+}
@@ -0,0 +1,477 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestUserDeviceSessionProjection_reduces(t *testing.T) {
+	creationDate := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceOIDCSessionAdded",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						oidcsession.AddedType,
+						oidcsession.AggregateType,
+						[]byte(`{
+							"userID": "user-id",
+							"userResourceOwner": "org-id",
+							"sessionID": "session-id",
+							"clientID": "client-id",
+							"userAgent": {
+								"fingerprint_id": "fingerprint-id",
+								"ip": "127.0.0.1",
+								"description": "firefox"
+							}
+						}`),
+						creationDate,
+					), eventstore.GenericEventMapper[oidcsession.AddedEvent]),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceOIDCSessionAdded,
+			want: wantReduce{
+				aggregateType: oidcsession.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_device_sessions (id, type, user_id, resource_owner, instance_id, session_id, client_id, fingerprint_id, description, creation_date, change_date, sequence, ip) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								domain.DeviceSessionTypeOIDC,
+								"user-id",
+								"org-id",
+								"instance-id",
+								"session-id",
+								"client-id",
+								"fingerprint-id",
+								gu.Ptr("firefox"),
+								creationDate,
+								creationDate,
+								uint64(15),
+								"127.0.0.1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOIDCSessionAdded, no fingerprint",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						oidcsession.AddedType,
+						oidcsession.AggregateType,
+						[]byte(`{
+							"userID": "user-id",
+							"userResourceOwner": "org-id",
+							"sessionID": "session-id",
+							"clientID": "client-id"
+						}`),
+						creationDate,
+					), eventstore.GenericEventMapper[oidcsession.AddedEvent]),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceOIDCSessionAdded,
+			want: wantReduce{
+				aggregateType: oidcsession.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "reduceOIDCAccessTokenAdded",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						oidcsession.AccessTokenAddedType,
+						oidcsession.AggregateType,
+						[]byte(`{
+							"id": "at-id",
+							"lifetime": 3600000000000
+						}`),
+						creationDate,
+					), eventstore.GenericEventMapper[oidcsession.AccessTokenAddedEvent]),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceOIDCAccessTokenAdded,
+			want: wantReduce{
+				aggregateType: oidcsession.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_device_sessions SET (change_date, sequence, access_token_expiration) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								creationDate,
+								uint64(15),
+								creationDate.Add(time.Hour),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOIDCRefreshTokenRenewed",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						oidcsession.RefreshTokenRenewedType,
+						oidcsession.AggregateType,
+						[]byte(`{
+							"id": "rt-id"
+						}`),
+						creationDate,
+					), eventstore.GenericEventMapper[oidcsession.RefreshTokenRenewedEvent]),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceOIDCRefreshTokenRenewed,
+			want: wantReduce{
+				aggregateType: oidcsession.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_device_sessions SET (change_date, sequence) = ($1, $2) WHERE (instance_id = $3) AND (id = $4)",
+							expectedArgs: []interface{}{
+								creationDate,
+								uint64(15),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOIDCRefreshTokenRevoked",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						oidcsession.RefreshTokenRevokedType,
+						oidcsession.AggregateType,
+						nil,
+						creationDate,
+					), eventstore.GenericEventMapper[oidcsession.RefreshTokenRevokedEvent]),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceOIDCRefreshTokenRevoked,
+			want: wantReduce{
+				aggregateType: oidcsession.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_device_sessions SET (change_date, sequence, refresh_token_expiration) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								creationDate,
+								uint64(15),
+								nil,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSAMLSessionAdded",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						samlsession.AddedType,
+						samlsession.AggregateType,
+						[]byte(`{
+							"userID": "user-id",
+							"userResourceOwner": "org-id",
+							"sessionID": "session-id",
+							"entityID": "client-id",
+							"userAgent": {
+								"fingerprint_id": "fingerprint-id",
+								"ip": "127.0.0.1",
+								"description": "firefox"
+							}
+						}`),
+						creationDate,
+					), eventstore.GenericEventMapper[samlsession.AddedEvent]),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceSAMLSessionAdded,
+			want: wantReduce{
+				aggregateType: samlsession.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_device_sessions (id, type, user_id, resource_owner, instance_id, session_id, client_id, fingerprint_id, description, creation_date, change_date, sequence, ip) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								domain.DeviceSessionTypeSAML,
+								"user-id",
+								"org-id",
+								"instance-id",
+								"session-id",
+								"client-id",
+								"fingerprint-id",
+								gu.Ptr("firefox"),
+								creationDate,
+								creationDate,
+								uint64(15),
+								"127.0.0.1",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSAMLResponseRevoked",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						samlsession.SAMLResponseRevokedType,
+						samlsession.AggregateType,
+						nil,
+						creationDate,
+					), eventstore.GenericEventMapper[samlsession.SAMLResponseRevokedEvent]),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceSAMLResponseRevoked,
+			want: wantReduce{
+				aggregateType: samlsession.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_device_sessions WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSessionTerminated",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						session.TerminateType,
+						session.AggregateType,
+						nil,
+						creationDate,
+					), session.TerminateEventMapper),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceSessionTerminated,
+			want: wantReduce{
+				aggregateType: session.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_device_sessions WHERE (instance_id = $1) AND (session_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRefreshTokenAdded",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						user.HumanRefreshTokenAddedType,
+						user.AggregateType,
+						[]byte(`{
+							"tokenId": "token-id",
+							"clientId": "client-id",
+							"userAgentId": "fingerprint-id",
+							"expiration": 86400000000000
+						}`),
+						creationDate,
+					), user.HumanRefreshTokenAddedEventMapper),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceRefreshTokenAdded,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_device_sessions (id, type, user_id, resource_owner, instance_id, client_id, fingerprint_id, creation_date, change_date, sequence, refresh_token_expiration) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"token-id",
+								domain.DeviceSessionTypeRefreshToken,
+								"agg-id",
+								"ro-id",
+								"instance-id",
+								"client-id",
+								"fingerprint-id",
+								creationDate,
+								creationDate,
+								uint64(15),
+								creationDate.Add(24 * time.Hour),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRefreshTokenRemoved",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						user.HumanRefreshTokenRemovedType,
+						user.AggregateType,
+						[]byte(`{
+							"tokenId": "token-id"
+						}`),
+						creationDate,
+					), user.HumanRefreshTokenRemovedEventEventMapper),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceRefreshTokenRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_device_sessions WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"token-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+						creationDate,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_device_sessions WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+						creationDate,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&userDeviceSessionProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_device_sessions WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+						creationDate,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(UserDeviceSessionColumnInstanceID),
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_device_sessions WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserDeviceSessionProjectionTable, tt.want)
+		})
+	}
+}
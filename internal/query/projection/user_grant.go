@@ -2,6 +2,7 @@ package projection
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
@@ -34,6 +35,9 @@ const (
 	UserGrantGrantID              = "grant_id"
 	UserGrantGrantedOrg           = "granted_org"
 	UserGrantRoles                = "roles"
+	UserGrantValidFrom            = "valid_from"
+	UserGrantValidUntil           = "valid_until"
+	UserGrantExpired              = "expired"
 )
 
 type userGrantProjection struct {
@@ -65,6 +69,9 @@ func (*userGrantProjection) Init() *old_handler.Check {
 			handler.NewColumn(UserGrantGrantID, handler.ColumnTypeText),
 			handler.NewColumn(UserGrantGrantedOrg, handler.ColumnTypeText),
 			handler.NewColumn(UserGrantRoles, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(UserGrantValidFrom, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(UserGrantValidUntil, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(UserGrantExpired, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(UserGrantInstanceID, UserGrantID),
 			handler.WithIndex(handler.NewIndex("user_id", []string{UserGrantUserID})),
@@ -106,6 +113,14 @@ func (p *userGrantProjection) Reducers() []handler.AggregateReducer {
 					Event:  usergrant.UserGrantReactivatedType,
 					Reduce: p.reduceReactivated,
 				},
+				{
+					Event:  usergrant.UserGrantValiditySetType,
+					Reduce: p.reduceValiditySet,
+				},
+				{
+					Event:  usergrant.UserGrantExpiredType,
+					Reduce: p.reduceExpired,
+				},
 			},
 		},
 		{
@@ -277,6 +292,47 @@ func (p *userGrantProjection) reduceReactivated(event eventstore.Event) (*handle
 	), nil
 }
 
+func (p *userGrantProjection) reduceValiditySet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantValiditySetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Oa5ch", "reduce.wrong.event.type %s", usergrant.UserGrantValiditySetType)
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserGrantChangeDate, e.CreatedAt()),
+			handler.NewCol(UserGrantValidFrom, sql.NullTime{Time: e.ValidFrom, Valid: !e.ValidFrom.IsZero()}),
+			handler.NewCol(UserGrantValidUntil, sql.NullTime{Time: e.ValidUntil, Valid: !e.ValidUntil.IsZero()}),
+			handler.NewCol(UserGrantExpired, false),
+			handler.NewCol(UserGrantSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantID, e.Aggregate().ID),
+			handler.NewCond(UserGrantInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userGrantProjection) reduceExpired(event eventstore.Event) (*handler.Statement, error) {
+	if _, ok := event.(*usergrant.UserGrantExpiredEvent); !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-yee3U", "reduce.wrong.event.type %s", usergrant.UserGrantExpiredType)
+	}
+
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewCol(UserGrantChangeDate, event.CreatedAt()),
+			handler.NewCol(UserGrantExpired, true),
+			handler.NewCol(UserGrantSequence, event.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(UserGrantID, event.Aggregate().ID),
+			handler.NewCond(UserGrantInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *userGrantProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
 	if _, ok := event.(*user.UserRemovedEvent); !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Bner2a", "reduce.wrong.event.type %s", user.UserRemovedType)
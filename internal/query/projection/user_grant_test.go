@@ -2,7 +2,9 @@ package projection
 
 import (
 	"context"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"golang.org/x/text/language"
@@ -76,6 +78,7 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 						false,
 						false,
 						domain.PrivateLabelingSettingUnspecified,
+						nil,
 					),
 				}),
 		}).reduceAdded,
@@ -143,6 +146,7 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 							false,
 							false,
 							domain.PrivateLabelingSettingUnspecified,
+							nil,
 						),
 					}),
 			}).reduceAdded,
@@ -208,6 +212,7 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 							&project.NewAggregate("project-id", "org2").Aggregate,
 							"project", true, true, true,
 							domain.PrivateLabelingSettingUnspecified,
+							nil,
 						),
 						project.NewGrantAddedEvent(context.Background(),
 							&project.NewAggregate("project-id", "org2").Aggregate,
@@ -449,6 +454,68 @@ func TestUserGrantProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceValiditySet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						usergrant.UserGrantValiditySetType,
+						usergrant.AggregateType,
+						[]byte(`{"validUntil": "2024-06-01T00:00:00Z"}`),
+					), usergrant.UserGrantValiditySetEventMapper),
+			},
+			reduce: (&userGrantProjection{}).reduceValiditySet,
+			want: wantReduce{
+				aggregateType: usergrant.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_grants5 SET (change_date, valid_from, valid_until, expired, sequence) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								sql.NullTime{},
+								sql.NullTime{Time: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+								false,
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceExpired",
+			args: args{
+				event: getEvent(
+					testEvent(
+						usergrant.UserGrantExpiredType,
+						usergrant.AggregateType,
+						[]byte(`{"userId": "user-id", "projectId": "project-id", "validUntil": "2024-06-01T00:00:00Z"}`),
+					), usergrant.UserGrantExpiredEventMapper),
+			},
+			reduce: (&userGrantProjection{}).reduceExpired,
+			want: wantReduce{
+				aggregateType: usergrant.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_grants5 SET (change_date, expired, sequence) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								true,
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceUserRemoved",
 			args: args{
@@ -810,6 +877,7 @@ func Test_getResourceOwners(t *testing.T) {
 								false,
 								false,
 								domain.PrivateLabelingSettingUnspecified,
+								nil,
 							),
 						),
 					),
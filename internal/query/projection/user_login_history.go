@@ -0,0 +1,163 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserLoginHistoryProjectionTable = "projections.user_login_history"
+
+	UserLoginHistoryColumnSessionID     = "session_id"
+	UserLoginHistoryColumnUserID        = "user_id"
+	UserLoginHistoryColumnCreationDate  = "creation_date"
+	UserLoginHistoryColumnChangeDate    = "change_date"
+	UserLoginHistoryColumnSequence      = "sequence"
+	UserLoginHistoryColumnResourceOwner = "resource_owner"
+	UserLoginHistoryColumnInstanceID    = "instance_id"
+	UserLoginHistoryColumnFingerprintID = "fingerprint_id"
+	UserLoginHistoryColumnIP            = "ip"
+	UserLoginHistoryColumnLocation      = "location"
+)
+
+// userLoginHistoryProjection keeps the accepted risk evaluations of sessions, which are used
+// as baseline for the evaluation of further logins of the user.
+// In contrast to the sessions projection, the entries are kept after the session is terminated.
+type userLoginHistoryProjection struct{}
+
+func newUserLoginHistoryProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userLoginHistoryProjection))
+}
+
+func (*userLoginHistoryProjection) Name() string {
+	return UserLoginHistoryProjectionTable
+}
+
+func (*userLoginHistoryProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserLoginHistoryColumnSessionID, handler.ColumnTypeText),
+			handler.NewColumn(UserLoginHistoryColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(UserLoginHistoryColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserLoginHistoryColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserLoginHistoryColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserLoginHistoryColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(UserLoginHistoryColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserLoginHistoryColumnFingerprintID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(UserLoginHistoryColumnIP, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(UserLoginHistoryColumnLocation, handler.ColumnTypeJSONB, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(UserLoginHistoryColumnInstanceID, UserLoginHistoryColumnSessionID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{UserLoginHistoryColumnUserID})),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserLoginHistoryColumnResourceOwner})),
+		),
+	)
+}
+
+func (p *userLoginHistoryProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.RiskEvaluatedType,
+					Reduce: p.reduceRiskEvaluated,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserLoginHistoryColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userLoginHistoryProjection) reduceRiskEvaluated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.RiskEvaluatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Iep0u", "reduce.wrong.event.type %s", session.RiskEvaluatedType)
+	}
+	// denied logins must not become part of the baseline
+	if e.Outcome == domain.RiskOutcomeDeny {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserLoginHistoryColumnInstanceID, nil),
+			handler.NewCol(UserLoginHistoryColumnSessionID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserLoginHistoryColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserLoginHistoryColumnSessionID, e.Aggregate().ID),
+			handler.NewCol(UserLoginHistoryColumnUserID, e.UserID),
+			handler.NewCol(UserLoginHistoryColumnResourceOwner, e.UserResourceOwner),
+			handler.NewCol(UserLoginHistoryColumnCreationDate, handler.OnlySetValueOnInsert(UserLoginHistoryProjectionTable, e.CreationDate())),
+			handler.NewCol(UserLoginHistoryColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserLoginHistoryColumnSequence, e.Sequence()),
+			handler.NewCol(UserLoginHistoryColumnFingerprintID, e.FingerprintID),
+			handler.NewCol(UserLoginHistoryColumnIP, e.IP),
+			handler.NewJSONCol(UserLoginHistoryColumnLocation, e.Location),
+		},
+	), nil
+}
+
+func (p *userLoginHistoryProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Wae5h", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserLoginHistoryColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserLoginHistoryColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userLoginHistoryProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Kai3e", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserLoginHistoryColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserLoginHistoryColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
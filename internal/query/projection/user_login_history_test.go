@@ -0,0 +1,188 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestUserLoginHistoryProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceRiskEvaluated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						session.RiskEvaluatedType,
+						session.AggregateType,
+						[]byte(`{
+						"userID": "user-id",
+						"userResourceOwner": "org-id",
+						"fingerprintID": "fingerprint-id",
+						"ip": "192.0.2.1",
+						"location": {"country": "CH", "asn": 65536, "latitude": 47.37, "longitude": 8.54},
+						"score": 0,
+						"outcome": 1,
+						"evaluatedAt": "2023-05-04T00:00:00Z"
+					}`),
+					), eventstore.GenericEventMapper[session.RiskEvaluatedEvent]),
+			},
+			reduce: (&userLoginHistoryProjection{}).reduceRiskEvaluated,
+			want: wantReduce{
+				aggregateType: session.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_login_history (instance_id, session_id, user_id, resource_owner, creation_date, change_date, sequence, fingerprint_id, ip, location) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id, session_id) DO UPDATE SET (user_id, resource_owner, creation_date, change_date, sequence, fingerprint_id, ip, location) = (EXCLUDED.user_id, EXCLUDED.resource_owner, projections.user_login_history.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.fingerprint_id, EXCLUDED.ip, EXCLUDED.location)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+								"org-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"fingerprint-id",
+								"192.0.2.1",
+								[]byte(`{"country":"CH","asn":65536,"latitude":47.37,"longitude":8.54}`),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRiskEvaluated denied",
+			args: args{
+				event: getEvent(
+					testEvent(
+						session.RiskEvaluatedType,
+						session.AggregateType,
+						[]byte(`{
+						"userID": "user-id",
+						"userResourceOwner": "org-id",
+						"score": 100,
+						"outcome": 3,
+						"evaluatedAt": "2023-05-04T00:00:00Z"
+					}`),
+					), eventstore.GenericEventMapper[session.RiskEvaluatedEvent]),
+			},
+			reduce: (&userLoginHistoryProjection{}).reduceRiskEvaluated,
+			want: wantReduce{
+				aggregateType: session.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&userLoginHistoryProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_login_history WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceOwnerRemoved",
+			reduce: (&userLoginHistoryProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_login_history WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(UserLoginHistoryColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_login_history WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserLoginHistoryProjectionTable, tt.want)
+		})
+	}
+}
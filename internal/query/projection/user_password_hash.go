@@ -0,0 +1,198 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserPasswordHashProjectionTable = "projections.user_password_hashes"
+
+	UserPasswordHashColumnUserID        = "user_id"
+	UserPasswordHashColumnChangeDate    = "change_date"
+	UserPasswordHashColumnSequence      = "sequence"
+	UserPasswordHashColumnResourceOwner = "resource_owner"
+	UserPasswordHashColumnInstanceID    = "instance_id"
+	UserPasswordHashColumnAlgorithm     = "algorithm"
+	UserPasswordHashColumnCost          = "cost"
+)
+
+// userPasswordHashProjection keeps the algorithm and cost of the current password hash of each user.
+// The hash itself is not stored.
+type userPasswordHashProjection struct{}
+
+func newUserPasswordHashProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userPasswordHashProjection))
+}
+
+func (*userPasswordHashProjection) Name() string {
+	return UserPasswordHashProjectionTable
+}
+
+func (*userPasswordHashProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserPasswordHashColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(UserPasswordHashColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserPasswordHashColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserPasswordHashColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(UserPasswordHashColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserPasswordHashColumnAlgorithm, handler.ColumnTypeText),
+			handler.NewColumn(UserPasswordHashColumnCost, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(UserPasswordHashColumnInstanceID, UserPasswordHashColumnUserID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserPasswordHashColumnResourceOwner})),
+		),
+	)
+}
+
+func (p *userPasswordHashProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserV1AddedType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.HumanAddedType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.UserV1RegisteredType,
+					Reduce: p.reduceHumanRegistered,
+				},
+				{
+					Event:  user.HumanRegisteredType,
+					Reduce: p.reduceHumanRegistered,
+				},
+				{
+					Event:  user.UserV1PasswordChangedType,
+					Reduce: p.reducePasswordChanged,
+				},
+				{
+					Event:  user.HumanPasswordChangedType,
+					Reduce: p.reducePasswordChanged,
+				},
+				{
+					Event:  user.HumanPasswordHashUpdatedType,
+					Reduce: p.reducePasswordHashUpdated,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserPasswordHashColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userPasswordHashProjection) reduceHumanAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-oofe7", "reduce.wrong.event.type %s", user.HumanAddedType)
+	}
+	return p.upsertPasswordHash(e, crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)), nil
+}
+
+func (p *userPasswordHashProjection) reduceHumanRegistered(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRegisteredEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Aeb5u", "reduce.wrong.event.type %s", user.HumanRegisteredType)
+	}
+	return p.upsertPasswordHash(e, crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)), nil
+}
+
+func (p *userPasswordHashProjection) reducePasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ieT6i", "reduce.wrong.event.type %s", user.HumanPasswordChangedType)
+	}
+	return p.upsertPasswordHash(e, crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)), nil
+}
+
+func (p *userPasswordHashProjection) reducePasswordHashUpdated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordHashUpdatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Xoo4d", "reduce.wrong.event.type %s", user.HumanPasswordHashUpdatedType)
+	}
+	return p.upsertPasswordHash(e, e.EncodedHash), nil
+}
+
+func (p *userPasswordHashProjection) upsertPasswordHash(e eventstore.Event, encodedHash string) *handler.Statement {
+	if encodedHash == "" {
+		return handler.NewNoOpStatement(e)
+	}
+	info := crypto.ParsePasswordHashInfo(encodedHash)
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserPasswordHashColumnInstanceID, nil),
+			handler.NewCol(UserPasswordHashColumnUserID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserPasswordHashColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserPasswordHashColumnUserID, e.Aggregate().ID),
+			handler.NewCol(UserPasswordHashColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserPasswordHashColumnChangeDate, e.CreatedAt()),
+			handler.NewCol(UserPasswordHashColumnSequence, e.Sequence()),
+			handler.NewCol(UserPasswordHashColumnAlgorithm, info.Algorithm),
+			handler.NewCol(UserPasswordHashColumnCost, info.Cost),
+		},
+	)
+}
+
+func (p *userPasswordHashProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ahsh9", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserPasswordHashColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserPasswordHashColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userPasswordHashProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Eiph6", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserPasswordHashColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserPasswordHashColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,242 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestUserPasswordHashProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceHumanAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanAddedType,
+						user.AggregateType,
+						[]byte(`{
+						"userName": "username",
+						"encodedHash": "$2y$12$hXUrnqdq1RIIYZ2HPytIIe5lXdIvbhqrTvdPsSF7o.jFh817Z6lwm"
+					}`),
+					), user.HumanAddedEventMapper),
+			},
+			reduce: (&userPasswordHashProjection{}).reduceHumanAdded,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_password_hashes (instance_id, user_id, resource_owner, change_date, sequence, algorithm, cost) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (instance_id, user_id) DO UPDATE SET (resource_owner, change_date, sequence, algorithm, cost) = (EXCLUDED.resource_owner, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.algorithm, EXCLUDED.cost)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								uint64(15),
+								"bcrypt",
+								"12",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceHumanAdded, no password",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanAddedType,
+						user.AggregateType,
+						[]byte(`{
+						"userName": "username"
+					}`),
+					), user.HumanAddedEventMapper),
+			},
+			reduce: (&userPasswordHashProjection{}).reduceHumanAdded,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "reducePasswordChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanPasswordChangedType,
+						user.AggregateType,
+						[]byte(`{
+						"encodedHash": "5f4dcc3b5aa765d61d8327deb882cf99"
+					}`),
+					), user.HumanPasswordChangedEventMapper),
+			},
+			reduce: (&userPasswordHashProjection{}).reducePasswordChanged,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_password_hashes (instance_id, user_id, resource_owner, change_date, sequence, algorithm, cost) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (instance_id, user_id) DO UPDATE SET (resource_owner, change_date, sequence, algorithm, cost) = (EXCLUDED.resource_owner, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.algorithm, EXCLUDED.cost)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								uint64(15),
+								"md5plain",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reducePasswordHashUpdated",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanPasswordHashUpdatedType,
+						user.AggregateType,
+						[]byte(`{
+						"encodedHash": "$argon2id$v=19$m=65536,t=3,p=4$c2FsdHNhbHQ$aGFzaGhhc2g"
+					}`),
+					), eventstore.GenericEventMapper[user.HumanPasswordHashUpdatedEvent]),
+			},
+			reduce: (&userPasswordHashProjection{}).reducePasswordHashUpdated,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_password_hashes (instance_id, user_id, resource_owner, change_date, sequence, algorithm, cost) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (instance_id, user_id) DO UPDATE SET (resource_owner, change_date, sequence, algorithm, cost) = (EXCLUDED.resource_owner, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.algorithm, EXCLUDED.cost)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								uint64(15),
+								"argon2id",
+								"m=65536,t=3,p=4",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&userPasswordHashProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_password_hashes WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceOwnerRemoved",
+			reduce: (&userPasswordHashProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_password_hashes WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(UserPasswordHashColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_password_hashes WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserPasswordHashProjectionTable, tt.want)
+		})
+	}
+}
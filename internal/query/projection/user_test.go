@@ -1013,6 +1013,45 @@ func TestUserProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceHumanPhoneChannelSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanPhoneChannelSetType,
+						user.AggregateType,
+						[]byte(`{
+							"channel": 2
+						}`),
+					), eventstore.GenericEventMapper[user.HumanPhoneChannelSetEvent]),
+			},
+			reduce: (&userProjection{}).reduceHumanPhoneChannelSet,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.users14 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.users14_humans SET phone_channel = $1 WHERE (user_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								domain.PhoneChannelWhatsApp,
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceUserV1PhoneRemoved",
 			args: args{
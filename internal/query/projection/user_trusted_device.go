@@ -0,0 +1,165 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserTrustedDeviceProjectionTable = "projections.user_trusted_devices"
+
+	UserTrustedDeviceColumnUserID         = "user_id"
+	UserTrustedDeviceColumnFingerprintID  = "fingerprint_id"
+	UserTrustedDeviceColumnCreationDate   = "creation_date"
+	UserTrustedDeviceColumnChangeDate     = "change_date"
+	UserTrustedDeviceColumnSequence       = "sequence"
+	UserTrustedDeviceColumnResourceOwner  = "resource_owner"
+	UserTrustedDeviceColumnInstanceID     = "instance_id"
+	UserTrustedDeviceColumnDescription    = "description"
+	UserTrustedDeviceColumnExpirationDate = "expiration_date"
+)
+
+type userTrustedDeviceProjection struct{}
+
+func newUserTrustedDeviceProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userTrustedDeviceProjection))
+}
+
+func (*userTrustedDeviceProjection) Name() string {
+	return UserTrustedDeviceProjectionTable
+}
+
+func (*userTrustedDeviceProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserTrustedDeviceColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(UserTrustedDeviceColumnFingerprintID, handler.ColumnTypeText),
+			handler.NewColumn(UserTrustedDeviceColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserTrustedDeviceColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserTrustedDeviceColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserTrustedDeviceColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(UserTrustedDeviceColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserTrustedDeviceColumnDescription, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(UserTrustedDeviceColumnExpirationDate, handler.ColumnTypeTimestamp),
+		},
+			handler.NewPrimaryKey(UserTrustedDeviceColumnInstanceID, UserTrustedDeviceColumnUserID, UserTrustedDeviceColumnFingerprintID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserTrustedDeviceColumnResourceOwner})),
+		),
+	)
+}
+
+func (p *userTrustedDeviceProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanTrustedDeviceAddedType,
+					Reduce: p.reduceTrustedDeviceAdded,
+				},
+				{
+					Event:  user.HumanTrustedDeviceRemovedType,
+					Reduce: p.reduceTrustedDeviceRemoved,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserTrustedDeviceColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userTrustedDeviceProjection) reduceTrustedDeviceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanTrustedDeviceAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ohm8e", "reduce.wrong.event.type %s", user.HumanTrustedDeviceAddedType)
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserTrustedDeviceColumnInstanceID, nil),
+			handler.NewCol(UserTrustedDeviceColumnUserID, nil),
+			handler.NewCol(UserTrustedDeviceColumnFingerprintID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserTrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCol(UserTrustedDeviceColumnFingerprintID, e.FingerprintID),
+			handler.NewCol(UserTrustedDeviceColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserTrustedDeviceColumnCreationDate, handler.OnlySetValueOnInsert(UserTrustedDeviceProjectionTable, e.CreationDate())),
+			handler.NewCol(UserTrustedDeviceColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserTrustedDeviceColumnSequence, e.Sequence()),
+			handler.NewCol(UserTrustedDeviceColumnDescription, e.Description),
+			handler.NewCol(UserTrustedDeviceColumnExpirationDate, e.ExpirationDate),
+		},
+	), nil
+}
+
+func (p *userTrustedDeviceProjection) reduceTrustedDeviceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanTrustedDeviceRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ree4j", "reduce.wrong.event.type %s", user.HumanTrustedDeviceRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserTrustedDeviceColumnUserID, e.Aggregate().ID),
+			handler.NewCond(UserTrustedDeviceColumnFingerprintID, e.FingerprintID),
+		},
+	), nil
+}
+
+func (p *userTrustedDeviceProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ujo2a", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserTrustedDeviceColumnUserID, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *userTrustedDeviceProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Gai0x", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserTrustedDeviceColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserTrustedDeviceColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,187 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestUserTrustedDeviceProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceTrustedDeviceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanTrustedDeviceAddedType,
+						user.AggregateType,
+						[]byte(`{
+						"fingerprintId": "fingerprint-id",
+						"description": "firefox",
+						"expirationDate": "2026-10-18T00:00:00Z"
+					}`),
+					), eventstore.GenericEventMapper[user.HumanTrustedDeviceAddedEvent]),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceTrustedDeviceAdded,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_trusted_devices (instance_id, user_id, fingerprint_id, resource_owner, creation_date, change_date, sequence, description, expiration_date) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (instance_id, user_id, fingerprint_id) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, description, expiration_date) = (EXCLUDED.resource_owner, projections.user_trusted_devices.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.description, EXCLUDED.expiration_date)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"fingerprint-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"firefox",
+								time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTrustedDeviceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanTrustedDeviceRemovedType,
+						user.AggregateType,
+						[]byte(`{
+						"fingerprintId": "fingerprint-id"
+					}`),
+					), eventstore.GenericEventMapper[user.HumanTrustedDeviceRemovedEvent]),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceTrustedDeviceRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_trusted_devices WHERE (instance_id = $1) AND (user_id = $2) AND (fingerprint_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"fingerprint-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&userTrustedDeviceProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_trusted_devices WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceOwnerRemoved",
+			reduce: (&userTrustedDeviceProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_trusted_devices WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(UserTrustedDeviceColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_trusted_devices WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserTrustedDeviceProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+//go:embed resource_indicators.sql
+var resourceIndicatorsQuery string
+
+// KnownResourceIndicators returns the passed resources (RFC 8707) which are known to the instance.
+// A resource is known if it is a resource URI of an active project
+// or the client ID of an active API application.
+func (q *Queries) KnownResourceIndicators(ctx context.Context, resources []string) (known []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(resources) == 0 {
+		return nil, nil
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var resource string
+			if err := rows.Scan(&resource); err != nil {
+				return err
+			}
+			known = append(known, resource)
+		}
+		return rows.Err()
+	},
+		resourceIndicatorsQuery,
+		authz.GetInstance(ctx).InstanceID(), database.TextArray[string](resources),
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-ooR4e", "Errors.Internal")
+	}
+	return known, nil
+}
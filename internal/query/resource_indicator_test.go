@@ -0,0 +1,66 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_KnownResourceIndicators(t *testing.T) {
+	ctx := authz.NewMockContextWithPermissions("instance1", "org1", "user1", nil)
+	expQuery := regexp.QuoteMeta(resourceIndicatorsQuery)
+	resources := []string{"https://api.example.com", "123@project", "https://unknown.example.com"}
+	queryArgs := []driver.Value{"instance1", database.TextArray[string](resources)}
+	cols := []string{"resource"}
+
+	tests := []struct {
+		name      string
+		resources []string
+		mock      sqlExpectation
+		want      []string
+		wantErr   error
+	}{
+		{
+			name: "no resources",
+			mock: func(m sqlmock.Sqlmock) sqlmock.Sqlmock { return m },
+		},
+		{
+			name:      "internal error",
+			resources: resources,
+			mock:      mockQueryErr(expQuery, sql.ErrConnDone, queryArgs...),
+			wantErr:   zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-ooR4e", "Errors.Internal"),
+		},
+		{
+			name:      "ok",
+			resources: resources,
+			mock: mockQueries(expQuery, cols, [][]driver.Value{
+				{"https://api.example.com"},
+				{"123@project"},
+			}, queryArgs...),
+			want: []string{"https://api.example.com", "123@project"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.KnownResourceIndicators(ctx, tt.resources)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
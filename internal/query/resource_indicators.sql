@@ -0,0 +1,17 @@
+select r.resource
+from unnest($2::text[]) as r(resource)
+where exists (
+		select 1
+		from projections.projects4 p
+		where p.instance_id = $1
+			and p.state = 1
+			and r.resource = any(p.resource_uris)
+	)
+	or exists (
+		select 1
+		from projections.apps7_api_configs c
+		join projections.apps7 a on a.id = c.app_id and a.instance_id = c.instance_id and a.state = 1
+		join projections.projects4 p on p.id = a.project_id and p.instance_id = a.instance_id and p.state = 1
+		where c.instance_id = $1
+			and c.client_id = r.resource
+	);
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -55,6 +57,26 @@ var (
 		name:  projection.SecurityPolicyColumnAllowUnauthenticatedDynamicClientRegistration,
 		table: securityPolicyTable,
 	}
+	SecurityPolicyColumnRequireSoftwareStatement = Column{
+		name:  projection.SecurityPolicyColumnRequireSoftwareStatement,
+		table: securityPolicyTable,
+	}
+	SecurityPolicyColumnSoftwareStatementIssuers = Column{
+		name:  projection.SecurityPolicyColumnSoftwareStatementIssuers,
+		table: securityPolicyTable,
+	}
+	SecurityPolicyColumnACRDefinitions = Column{
+		name:  projection.SecurityPolicyColumnACRDefinitions,
+		table: securityPolicyTable,
+	}
+	SecurityPolicyColumnPasswordRehashBefore = Column{
+		name:  projection.SecurityPolicyColumnPasswordRehashBefore,
+		table: securityPolicyTable,
+	}
+	SecurityPolicyColumnInsecurePasswordHashDeadline = Column{
+		name:  projection.SecurityPolicyColumnInsecurePasswordHashDeadline,
+		table: securityPolicyTable,
+	}
 )
 
 type SecurityPolicy struct {
@@ -70,6 +92,13 @@ type SecurityPolicy struct {
 
 	EnableDynamicClientRegistration               bool
 	AllowUnauthenticatedDynamicClientRegistration bool
+	RequireSoftwareStatement                      bool
+	SoftwareStatementIssuers                      domain.SoftwareStatementIssuers
+
+	ACRDefinitions domain.ACRDefinitions
+
+	PasswordRehashBefore         time.Time
+	InsecurePasswordHashDeadline time.Time
 }
 
 func (q *Queries) SecurityPolicy(ctx context.Context) (policy *SecurityPolicy, err error) {
@@ -99,11 +128,20 @@ func prepareSecurityPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*SecurityPo
 			SecurityPolicyColumnAllowedOrigins.identifier(),
 			SecurityPolicyColumnEnableImpersonation.identifier(),
 			SecurityPolicyColumnEnableDynamicClientRegistration.identifier(),
-			SecurityPolicyColumnAllowUnauthenticatedDynamicClientRegistration.identifier()).
+			SecurityPolicyColumnAllowUnauthenticatedDynamicClientRegistration.identifier(),
+			SecurityPolicyColumnRequireSoftwareStatement.identifier(),
+			SecurityPolicyColumnSoftwareStatementIssuers.identifier(),
+			SecurityPolicyColumnACRDefinitions.identifier(),
+			SecurityPolicyColumnPasswordRehashBefore.identifier(),
+			SecurityPolicyColumnInsecurePasswordHashDeadline.identifier()).
 			From(securityPolicyTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*SecurityPolicy, error) {
 			securityPolicy := new(SecurityPolicy)
+			var (
+				softwareStatementIssuers, acrDefinitions           []byte
+				passwordRehashBefore, insecurePasswordHashDeadline sql.NullTime
+			)
 			err := row.Scan(
 				&securityPolicy.AggregateID,
 				&securityPolicy.CreationDate,
@@ -115,10 +153,27 @@ func prepareSecurityPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*SecurityPo
 				&securityPolicy.EnableImpersonation,
 				&securityPolicy.EnableDynamicClientRegistration,
 				&securityPolicy.AllowUnauthenticatedDynamicClientRegistration,
+				&securityPolicy.RequireSoftwareStatement,
+				&softwareStatementIssuers,
+				&acrDefinitions,
+				&passwordRehashBefore,
+				&insecurePasswordHashDeadline,
 			)
 			if err != nil && !errors.Is(err, sql.ErrNoRows) { // ignore not found errors
 				return nil, zerrors.ThrowInternal(err, "QUERY-Dfrt2", "Errors.Internal")
 			}
+			if len(softwareStatementIssuers) > 0 {
+				if err = json.Unmarshal(softwareStatementIssuers, &securityPolicy.SoftwareStatementIssuers); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-ua7Ee", "Errors.Internal")
+				}
+			}
+			if len(acrDefinitions) > 0 {
+				if err = json.Unmarshal(acrDefinitions, &securityPolicy.ACRDefinitions); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Eiph3", "Errors.Internal")
+				}
+			}
+			securityPolicy.PasswordRehashBefore = passwordRehashBefore.Time
+			securityPolicy.InsecurePasswordHashDeadline = insecurePasswordHashDeadline.Time
 			return securityPolicy, nil
 		}
 }
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"net"
 	"net/http"
@@ -27,24 +28,28 @@ type Sessions struct {
 }
 
 type Session struct {
-	ID                 string
-	CreationDate       time.Time
-	ChangeDate         time.Time
-	Sequence           uint64
-	State              domain.SessionState
-	ResourceOwner      string
-	Creator            string
-	UserFactor         SessionUserFactor
-	PasswordFactor     SessionPasswordFactor
-	IntentFactor       SessionIntentFactor
-	WebAuthNFactor     SessionWebAuthNFactor
-	TOTPFactor         SessionTOTPFactor
-	OTPSMSFactor       SessionOTPFactor
-	OTPEmailFactor     SessionOTPFactor
-	RecoveryCodeFactor SessionRecoveryCodeFactor
-	Metadata           map[string][]byte
-	UserAgent          domain.UserAgent
-	Expiration         time.Time
+	ID                  string
+	CreationDate        time.Time
+	ChangeDate          time.Time
+	Sequence            uint64
+	State               domain.SessionState
+	ResourceOwner       string
+	Creator             string
+	UserFactor          SessionUserFactor
+	PasswordFactor      SessionPasswordFactor
+	IntentFactor        SessionIntentFactor
+	WebAuthNFactor      SessionWebAuthNFactor
+	TOTPFactor          SessionTOTPFactor
+	OTPSMSFactor        SessionOTPFactor
+	OTPEmailFactor      SessionOTPFactor
+	RecoveryCodeFactor  SessionRecoveryCodeFactor
+	MagicLinkFactor     SessionMagicLinkFactor
+	TrustedDeviceFactor SessionTrustedDeviceFactor
+	PushFactor          SessionPushFactor
+	Metadata            map[string][]byte
+	UserAgent           domain.UserAgent
+	Expiration          time.Time
+	Risk                SessionRisk
 }
 
 type SessionUserFactor struct {
@@ -80,6 +85,27 @@ type SessionRecoveryCodeFactor struct {
 	RecoveryCodeCheckedAt time.Time
 }
 
+type SessionMagicLinkFactor struct {
+	MagicLinkCheckedAt time.Time
+}
+
+type SessionTrustedDeviceFactor struct {
+	TrustedDeviceCheckedAt time.Time
+}
+
+type SessionPushFactor struct {
+	PushCheckedAt time.Time
+}
+
+// SessionRisk is the result of the latest risk evaluation of the session checks.
+type SessionRisk struct {
+	Score       int
+	Signals     []domain.RiskSignal
+	Outcome     domain.RiskOutcome
+	Location    *domain.GeoLocation
+	EvaluatedAt time.Time
+}
+
 type SessionsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -225,6 +251,18 @@ var (
 		name:  projection.SessionColumnRecoveryCodeCheckedAt,
 		table: sessionsTable,
 	}
+	SessionColumnMagicLinkCheckedAt = Column{
+		name:  projection.SessionColumnMagicLinkCheckedAt,
+		table: sessionsTable,
+	}
+	SessionColumnTrustedDeviceCheckedAt = Column{
+		name:  projection.SessionColumnTrustedDeviceCheckedAt,
+		table: sessionsTable,
+	}
+	SessionColumnPushCheckedAt = Column{
+		name:  projection.SessionColumnPushCheckedAt,
+		table: sessionsTable,
+	}
 	SessionColumnMetadata = Column{
 		name:  projection.SessionColumnMetadata,
 		table: sessionsTable,
@@ -253,6 +291,26 @@ var (
 		name:  projection.SessionColumnExpiration,
 		table: sessionsTable,
 	}
+	SessionColumnRiskScore = Column{
+		name:  projection.SessionColumnRiskScore,
+		table: sessionsTable,
+	}
+	SessionColumnRiskSignals = Column{
+		name:  projection.SessionColumnRiskSignals,
+		table: sessionsTable,
+	}
+	SessionColumnRiskOutcome = Column{
+		name:  projection.SessionColumnRiskOutcome,
+		table: sessionsTable,
+	}
+	SessionColumnRiskLocation = Column{
+		name:  projection.SessionColumnRiskLocation,
+		table: sessionsTable,
+	}
+	SessionColumnRiskEvaluatedAt = Column{
+		name:  projection.SessionColumnRiskEvaluatedAt,
+		table: sessionsTable,
+	}
 )
 
 func (q *Queries) SessionByID(ctx context.Context, shouldTriggerBulk bool, id, sessionToken string, permissionCheck domain.PermissionCheck) (session *Session, err error) {
@@ -397,6 +455,9 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
 			SessionColumnRecoveryCodeCheckedAt.identifier(),
+			SessionColumnMagicLinkCheckedAt.identifier(),
+			SessionColumnTrustedDeviceCheckedAt.identifier(),
+			SessionColumnPushCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnToken.identifier(),
 			SessionColumnUserAgentFingerprintID.identifier(),
@@ -404,6 +465,11 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			SessionColumnUserAgentDescription.identifier(),
 			SessionColumnUserAgentHeader.identifier(),
 			SessionColumnExpiration.identifier(),
+			SessionColumnRiskScore.identifier(),
+			SessionColumnRiskSignals.identifier(),
+			SessionColumnRiskOutcome.identifier(),
+			SessionColumnRiskLocation.identifier(),
+			SessionColumnRiskEvaluatedAt.identifier(),
 		).From(sessionsTable.identifier()).
 			LeftJoin(join(LoginNameUserIDCol, SessionColumnUserID)).
 			LeftJoin(join(HumanUserIDCol, SessionColumnUserID)).
@@ -425,11 +491,19 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 				otpSMSCheckedAt        sql.NullTime
 				otpEmailCheckedAt      sql.NullTime
 				recoveryCodesCheckedAt sql.NullTime
+				magicLinkCheckedAt     sql.NullTime
+				trustedDeviceCheckedAt sql.NullTime
+				pushCheckedAt          sql.NullTime
 				metadata               database.Map[[]byte]
 				token                  sql.NullString
 				userAgentIP            sql.NullString
 				userAgentHeader        database.Map[[]string]
 				expiration             sql.NullTime
+				riskScore              sql.NullInt64
+				riskSignals            database.TextArray[domain.RiskSignal]
+				riskOutcome            sql.NullInt32
+				riskLocation           []byte
+				riskEvaluatedAt        sql.NullTime
 			)
 
 			err := row.Scan(
@@ -453,6 +527,9 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 				&otpSMSCheckedAt,
 				&otpEmailCheckedAt,
 				&recoveryCodesCheckedAt,
+				&magicLinkCheckedAt,
+				&trustedDeviceCheckedAt,
+				&pushCheckedAt,
 				&metadata,
 				&token,
 				&session.UserAgent.FingerprintID,
@@ -460,6 +537,11 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 				&session.UserAgent.Description,
 				&userAgentHeader,
 				&expiration,
+				&riskScore,
+				&riskSignals,
+				&riskOutcome,
+				&riskLocation,
+				&riskEvaluatedAt,
 			)
 
 			if err != nil {
@@ -482,12 +564,19 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 			session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
 			session.RecoveryCodeFactor.RecoveryCodeCheckedAt = recoveryCodesCheckedAt.Time
+			session.MagicLinkFactor.MagicLinkCheckedAt = magicLinkCheckedAt.Time
+			session.TrustedDeviceFactor.TrustedDeviceCheckedAt = trustedDeviceCheckedAt.Time
+			session.PushFactor.PushCheckedAt = pushCheckedAt.Time
 			session.Metadata = metadata
 			session.UserAgent.Header = http.Header(userAgentHeader)
 			if userAgentIP.Valid {
 				session.UserAgent.IP = net.ParseIP(userAgentIP.String)
 			}
 			session.Expiration = expiration.Time
+			session.Risk, err = sessionRiskFromColumns(riskScore, riskSignals, riskOutcome, riskLocation, riskEvaluatedAt)
+			if err != nil {
+				return nil, "", err
+			}
 			return session, token.String, nil
 		}
 }
@@ -514,12 +603,20 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
 			SessionColumnRecoveryCodeCheckedAt.identifier(),
+			SessionColumnMagicLinkCheckedAt.identifier(),
+			SessionColumnTrustedDeviceCheckedAt.identifier(),
+			SessionColumnPushCheckedAt.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnUserAgentFingerprintID.identifier(),
 			SessionColumnUserAgentIP.identifier(),
 			SessionColumnUserAgentDescription.identifier(),
 			SessionColumnUserAgentHeader.identifier(),
 			SessionColumnExpiration.identifier(),
+			SessionColumnRiskScore.identifier(),
+			SessionColumnRiskSignals.identifier(),
+			SessionColumnRiskOutcome.identifier(),
+			SessionColumnRiskLocation.identifier(),
+			SessionColumnRiskEvaluatedAt.identifier(),
 			countColumn.identifier(),
 		).From(sessionsTable.identifier()).
 			LeftJoin(join(LoginNameUserIDCol, SessionColumnUserID)).
@@ -545,10 +642,18 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 					otpSMSCheckedAt        sql.NullTime
 					otpEmailCheckedAt      sql.NullTime
 					recoveryCodesCheckedAt sql.NullTime
+					magicLinkCheckedAt     sql.NullTime
+					trustedDeviceCheckedAt sql.NullTime
+					pushCheckedAt          sql.NullTime
 					metadata               database.Map[[]byte]
 					userAgentIP            sql.NullString
 					userAgentHeader        database.Map[[]string]
 					expiration             sql.NullTime
+					riskScore              sql.NullInt64
+					riskSignals            database.TextArray[domain.RiskSignal]
+					riskOutcome            sql.NullInt32
+					riskLocation           []byte
+					riskEvaluatedAt        sql.NullTime
 				)
 
 				err := rows.Scan(
@@ -572,12 +677,20 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 					&otpSMSCheckedAt,
 					&otpEmailCheckedAt,
 					&recoveryCodesCheckedAt,
+					&magicLinkCheckedAt,
+					&trustedDeviceCheckedAt,
+					&pushCheckedAt,
 					&metadata,
 					&session.UserAgent.FingerprintID,
 					&userAgentIP,
 					&session.UserAgent.Description,
 					&userAgentHeader,
 					&expiration,
+					&riskScore,
+					&riskSignals,
+					&riskOutcome,
+					&riskLocation,
+					&riskEvaluatedAt,
 					&sessions.Count,
 				)
 
@@ -597,12 +710,19 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 				session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 				session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
 				session.RecoveryCodeFactor.RecoveryCodeCheckedAt = recoveryCodesCheckedAt.Time
+				session.MagicLinkFactor.MagicLinkCheckedAt = magicLinkCheckedAt.Time
+				session.TrustedDeviceFactor.TrustedDeviceCheckedAt = trustedDeviceCheckedAt.Time
+				session.PushFactor.PushCheckedAt = pushCheckedAt.Time
 				session.Metadata = metadata
 				session.UserAgent.Header = http.Header(userAgentHeader)
 				if userAgentIP.Valid {
 					session.UserAgent.IP = net.ParseIP(userAgentIP.String)
 				}
 				session.Expiration = expiration.Time
+				session.Risk, err = sessionRiskFromColumns(riskScore, riskSignals, riskOutcome, riskLocation, riskEvaluatedAt)
+				if err != nil {
+					return nil, err
+				}
 
 				sessions.Sessions = append(sessions.Sessions, session)
 			}
@@ -610,3 +730,18 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 			return sessions, nil
 		}
 }
+
+func sessionRiskFromColumns(score sql.NullInt64, signals database.TextArray[domain.RiskSignal], outcome sql.NullInt32, location []byte, evaluatedAt sql.NullTime) (SessionRisk, error) {
+	sessionRisk := SessionRisk{
+		Score:       int(score.Int64),
+		Signals:     signals,
+		Outcome:     domain.RiskOutcome(outcome.Int32),
+		EvaluatedAt: evaluatedAt.Time,
+	}
+	if len(location) > 0 {
+		if err := json.Unmarshal(location, &sessionRisk.Location); err != nil {
+			return SessionRisk{}, zerrors.ThrowInternal(err, "QUERY-Ohf3u", "Errors.Internal")
+		}
+	}
+	return sessionRisk, nil
+}
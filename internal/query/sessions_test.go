@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -41,13 +42,21 @@ var (
 		` projections.sessions8.otp_sms_checked_at,` +
 		` projections.sessions8.otp_email_checked_at,` +
 		` projections.sessions8.mfa_recovery_code_checked_at,` +
+		` projections.sessions8.magic_link_checked_at,` +
+		` projections.sessions8.trusted_device_checked_at,` +
+		` projections.sessions8.push_checked_at,` +
 		` projections.sessions8.metadata,` +
 		` projections.sessions8.token_id,` +
 		` projections.sessions8.user_agent_fingerprint_id,` +
 		` projections.sessions8.user_agent_ip,` +
 		` projections.sessions8.user_agent_description,` +
 		` projections.sessions8.user_agent_header,` +
-		` projections.sessions8.expiration` +
+		` projections.sessions8.expiration,` +
+		` projections.sessions8.risk_score,` +
+		` projections.sessions8.risk_signals,` +
+		` projections.sessions8.risk_outcome,` +
+		` projections.sessions8.risk_location,` +
+		` projections.sessions8.risk_evaluated_at` +
 		` FROM projections.sessions8` +
 		` LEFT JOIN projections.login_names3 ON projections.sessions8.user_id = projections.login_names3.user_id AND projections.sessions8.instance_id = projections.login_names3.instance_id` +
 		` LEFT JOIN projections.users14_humans ON projections.sessions8.user_id = projections.users14_humans.user_id AND projections.sessions8.instance_id = projections.users14_humans.instance_id` +
@@ -72,12 +81,20 @@ var (
 		` projections.sessions8.otp_sms_checked_at,` +
 		` projections.sessions8.otp_email_checked_at,` +
 		` projections.sessions8.mfa_recovery_code_checked_at,` +
+		` projections.sessions8.magic_link_checked_at,` +
+		` projections.sessions8.trusted_device_checked_at,` +
+		` projections.sessions8.push_checked_at,` +
 		` projections.sessions8.metadata,` +
 		` projections.sessions8.user_agent_fingerprint_id,` +
 		` projections.sessions8.user_agent_ip,` +
 		` projections.sessions8.user_agent_description,` +
 		` projections.sessions8.user_agent_header,` +
 		` projections.sessions8.expiration,` +
+		` projections.sessions8.risk_score,` +
+		` projections.sessions8.risk_signals,` +
+		` projections.sessions8.risk_outcome,` +
+		` projections.sessions8.risk_location,` +
+		` projections.sessions8.risk_evaluated_at,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.sessions8` +
 		` LEFT JOIN projections.login_names3 ON projections.sessions8.user_id = projections.login_names3.user_id AND projections.sessions8.instance_id = projections.login_names3.instance_id` +
@@ -105,6 +122,9 @@ var (
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
 		"mfa_recovery_code_checked_at",
+		"magic_link_checked_at",
+		"trusted_device_checked_at",
+		"push_checked_at",
 		"metadata",
 		"token",
 		"user_agent_fingerprint_id",
@@ -112,6 +132,11 @@ var (
 		"user_agent_description",
 		"user_agent_header",
 		"expiration",
+		"risk_score",
+		"risk_signals",
+		"risk_outcome",
+		"risk_location",
+		"risk_evaluated_at",
 	}
 
 	sessionsCols = []string{
@@ -135,12 +160,20 @@ var (
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
 		"mfa_recovery_code_checked_at",
+		"magic_link_checked_at",
+		"trusted_device_checked_at",
+		"push_checked_at",
 		"metadata",
 		"user_agent_fingerprint_id",
 		"user_agent_ip",
 		"user_agent_description",
 		"user_agent_header",
 		"expiration",
+		"risk_score",
+		"risk_signals",
+		"risk_outcome",
+		"risk_location",
+		"risk_evaluated_at",
 		"count",
 	}
 )
@@ -197,12 +230,20 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							"fingerPrintID",
 							"1.2.3.4",
 							"agentDescription",
 							[]byte(`{"foo":["foo","bar"]}`),
 							testNow,
+							int64(40),
+							database.TextArray[domain.RiskSignal]{domain.RiskSignalNewCountry},
+							domain.RiskOutcomeRequireMFA,
+							[]byte(`{"country":"CH","asn":65536}`),
+							testNow,
 						},
 					},
 				),
@@ -249,6 +290,15 @@ func Test_SessionsPrepare(t *testing.T) {
 						RecoveryCodeFactor: SessionRecoveryCodeFactor{
 							RecoveryCodeCheckedAt: testNow,
 						},
+						MagicLinkFactor: SessionMagicLinkFactor{
+							MagicLinkCheckedAt: testNow,
+						},
+						TrustedDeviceFactor: SessionTrustedDeviceFactor{
+							TrustedDeviceCheckedAt: testNow,
+						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -259,6 +309,13 @@ func Test_SessionsPrepare(t *testing.T) {
 							Header:        http.Header{"foo": []string{"foo", "bar"}},
 						},
 						Expiration: testNow,
+						Risk: SessionRisk{
+							Score:       40,
+							Signals:     []domain.RiskSignal{domain.RiskSignalNewCountry},
+							Outcome:     domain.RiskOutcomeRequireMFA,
+							Location:    &domain.GeoLocation{Country: "CH", ASN: 65536},
+							EvaluatedAt: testNow,
+						},
 					},
 				},
 			},
@@ -292,12 +349,20 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							"fingerPrintID",
 							"1.2.3.4",
 							"agentDescription",
 							[]byte(`{"foo":["foo","bar"]}`),
 							testNow,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"session-id2",
@@ -320,12 +385,20 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
+							testNow,
 							[]byte(`{"key": "dmFsdWU="}`),
 							"fingerPrintID",
 							"1.2.3.4",
 							"agentDescription",
 							[]byte(`{"foo":["foo","bar"]}`),
 							testNow,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -372,6 +445,15 @@ func Test_SessionsPrepare(t *testing.T) {
 						RecoveryCodeFactor: SessionRecoveryCodeFactor{
 							RecoveryCodeCheckedAt: testNow,
 						},
+						MagicLinkFactor: SessionMagicLinkFactor{
+							MagicLinkCheckedAt: testNow,
+						},
+						TrustedDeviceFactor: SessionTrustedDeviceFactor{
+							TrustedDeviceCheckedAt: testNow,
+						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -420,6 +502,15 @@ func Test_SessionsPrepare(t *testing.T) {
 						RecoveryCodeFactor: SessionRecoveryCodeFactor{
 							RecoveryCodeCheckedAt: testNow,
 						},
+						MagicLinkFactor: SessionMagicLinkFactor{
+							MagicLinkCheckedAt: testNow,
+						},
+						TrustedDeviceFactor: SessionTrustedDeviceFactor{
+							TrustedDeviceCheckedAt: testNow,
+						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -516,6 +607,9 @@ func Test_SessionPrepare(t *testing.T) {
 						testNow,
 						testNow,
 						testNow,
+						testNow,
+						testNow,
+						testNow,
 						[]byte(`{"key": "dmFsdWU="}`),
 						"tokenID",
 						"fingerPrintID",
@@ -523,6 +617,11 @@ func Test_SessionPrepare(t *testing.T) {
 						"agentDescription",
 						[]byte(`{"foo":["foo","bar"]}`),
 						testNow,
+						int64(40),
+						database.TextArray[domain.RiskSignal]{domain.RiskSignalNewCountry},
+						domain.RiskOutcomeRequireMFA,
+						[]byte(`{"country":"CH","asn":65536}`),
+						testNow,
 					},
 				),
 			},
@@ -563,6 +662,15 @@ func Test_SessionPrepare(t *testing.T) {
 				RecoveryCodeFactor: SessionRecoveryCodeFactor{
 					RecoveryCodeCheckedAt: testNow,
 				},
+				MagicLinkFactor: SessionMagicLinkFactor{
+					MagicLinkCheckedAt: testNow,
+				},
+				TrustedDeviceFactor: SessionTrustedDeviceFactor{
+					TrustedDeviceCheckedAt: testNow,
+				},
+				PushFactor: SessionPushFactor{
+					PushCheckedAt: testNow,
+				},
 				Metadata: map[string][]byte{
 					"key": []byte("value"),
 				},
@@ -573,6 +681,13 @@ func Test_SessionPrepare(t *testing.T) {
 					Header:        http.Header{"foo": []string{"foo", "bar"}},
 				},
 				Expiration: testNow,
+				Risk: SessionRisk{
+					Score:       40,
+					Signals:     []domain.RiskSignal{domain.RiskSignalNewCountry},
+					Outcome:     domain.RiskOutcomeRequireMFA,
+					Location:    &domain.GeoLocation{Country: "CH", ASN: 65536},
+					EvaluatedAt: testNow,
+				},
 			},
 		},
 		{
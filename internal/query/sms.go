@@ -30,9 +30,12 @@ type SMSConfig struct {
 	State         domain.SMSConfigState
 	Sequence      uint64
 	Description   string
+	Channel       domain.PhoneChannel
 
-	TwilioConfig *Twilio
-	HTTPConfig   *HTTP
+	TwilioConfig   *Twilio
+	HTTPConfig     *HTTP
+	WhatsAppConfig *WhatsApp
+	VoiceConfig    *Voice
 }
 
 func (h *SMSConfig) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
@@ -49,6 +52,19 @@ type Twilio struct {
 	VerifyServiceSID string
 }
 
+type WhatsApp struct {
+	PhoneNumberID    string
+	AccessToken      *crypto.CryptoValue
+	TemplateName     string
+	TemplateLanguage string
+}
+
+type Voice struct {
+	SID          string
+	Token        *crypto.CryptoValue
+	SenderNumber string
+}
+
 type HTTP struct {
 	Endpoint   string
 	signingKey *crypto.CryptoValue
@@ -121,6 +137,10 @@ var (
 		name:  projection.SMSColumnDescription,
 		table: smsConfigsTable,
 	}
+	SMSColumnChannel = Column{
+		name:  projection.SMSColumnChannel,
+		table: smsConfigsTable,
+	}
 )
 
 var (
@@ -169,6 +189,56 @@ var (
 	}
 )
 
+var (
+	smsWhatsAppTable = table{
+		name:          projection.SMSWhatsAppTable,
+		instanceIDCol: projection.SMSWhatsAppColumnInstanceID,
+	}
+	SMSWhatsAppColumnSMSID = Column{
+		name:  projection.SMSWhatsAppColumnSMSID,
+		table: smsWhatsAppTable,
+	}
+	SMSWhatsAppColumnPhoneNumberID = Column{
+		name:  projection.SMSWhatsAppColumnPhoneNumberID,
+		table: smsWhatsAppTable,
+	}
+	SMSWhatsAppColumnAccessToken = Column{
+		name:  projection.SMSWhatsAppColumnAccessToken,
+		table: smsWhatsAppTable,
+	}
+	SMSWhatsAppColumnTemplateName = Column{
+		name:  projection.SMSWhatsAppColumnTemplateName,
+		table: smsWhatsAppTable,
+	}
+	SMSWhatsAppColumnTemplateLanguage = Column{
+		name:  projection.SMSWhatsAppColumnTemplateLanguage,
+		table: smsWhatsAppTable,
+	}
+)
+
+var (
+	smsVoiceTable = table{
+		name:          projection.SMSVoiceTable,
+		instanceIDCol: projection.SMSVoiceColumnInstanceID,
+	}
+	SMSVoiceColumnSMSID = Column{
+		name:  projection.SMSVoiceColumnSMSID,
+		table: smsVoiceTable,
+	}
+	SMSVoiceColumnSID = Column{
+		name:  projection.SMSVoiceColumnSID,
+		table: smsVoiceTable,
+	}
+	SMSVoiceColumnToken = Column{
+		name:  projection.SMSVoiceColumnToken,
+		table: smsVoiceTable,
+	}
+	SMSVoiceColumnSenderNumber = Column{
+		name:  projection.SMSVoiceColumnSenderNumber,
+		table: smsVoiceTable,
+	}
+)
+
 func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -197,7 +267,8 @@ func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config
 	return config, nil
 }
 
-func (q *Queries) SMSProviderConfigActive(ctx context.Context, instanceID string) (config *SMSConfig, err error) {
+// SMSProviderConfigActive returns the active provider config of the instance delivering messages over the given channel.
+func (q *Queries) SMSProviderConfigActive(ctx context.Context, instanceID string, channel domain.PhoneChannel) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -206,6 +277,7 @@ func (q *Queries) SMSProviderConfigActive(ctx context.Context, instanceID string
 		sq.Eq{
 			SMSColumnInstanceID.identifier(): instanceID,
 			SMSColumnState.identifier():      domain.SMSConfigStateActive,
+			SMSColumnChannel.identifier():    channel.OrSMS(),
 		},
 	).ToSql()
 	if err != nil {
@@ -268,6 +340,7 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 			SMSColumnState.identifier(),
 			SMSColumnSequence.identifier(),
 			SMSColumnDescription.identifier(),
+			SMSColumnChannel.identifier(),
 
 			SMSTwilioColumnSMSID.identifier(),
 			SMSTwilioColumnSID.identifier(),
@@ -278,15 +351,31 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 			SMSHTTPColumnSMSID.identifier(),
 			SMSHTTPColumnEndpoint.identifier(),
 			SMSHTTPColumnSigningKey.identifier(),
+
+			SMSWhatsAppColumnSMSID.identifier(),
+			SMSWhatsAppColumnPhoneNumberID.identifier(),
+			SMSWhatsAppColumnAccessToken.identifier(),
+			SMSWhatsAppColumnTemplateName.identifier(),
+			SMSWhatsAppColumnTemplateLanguage.identifier(),
+
+			SMSVoiceColumnSMSID.identifier(),
+			SMSVoiceColumnSID.identifier(),
+			SMSVoiceColumnToken.identifier(),
+			SMSVoiceColumnSenderNumber.identifier(),
 		).From(smsConfigsTable.identifier()).
 			LeftJoin(join(SMSTwilioColumnSMSID, SMSColumnID)).
 			LeftJoin(join(SMSHTTPColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSWhatsAppColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSVoiceColumnSMSID, SMSColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*SMSConfig, error) {
 			config := new(SMSConfig)
 
 			var (
-				twilioConfig = sqlTwilioConfig{}
-				httpConfig   = sqlHTTPConfig{}
+				channel        sql.NullInt32
+				twilioConfig   = sqlTwilioConfig{}
+				httpConfig     = sqlHTTPConfig{}
+				whatsAppConfig = sqlWhatsAppConfig{}
+				voiceConfig    = sqlVoiceConfig{}
 			)
 
 			err := row.Scan(
@@ -298,6 +387,7 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 				&config.State,
 				&config.Sequence,
 				&config.Description,
+				&channel,
 
 				&twilioConfig.smsID,
 				&twilioConfig.sid,
@@ -308,6 +398,17 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 				&httpConfig.id,
 				&httpConfig.endpoint,
 				&httpConfig.signingKey,
+
+				&whatsAppConfig.smsID,
+				&whatsAppConfig.phoneNumberID,
+				&whatsAppConfig.accessToken,
+				&whatsAppConfig.templateName,
+				&whatsAppConfig.templateLanguage,
+
+				&voiceConfig.smsID,
+				&voiceConfig.sid,
+				&voiceConfig.token,
+				&voiceConfig.senderNumber,
 			)
 
 			if err != nil {
@@ -317,8 +418,11 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 				return nil, zerrors.ThrowInternal(err, "QUERY-3n9Js", "Errors.Internal")
 			}
 
+			config.Channel = domain.PhoneChannel(channel.Int32)
 			twilioConfig.set(config)
 			httpConfig.setSMS(config)
+			whatsAppConfig.set(config)
+			voiceConfig.set(config)
 
 			return config, nil
 		}
@@ -334,6 +438,7 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 			SMSColumnState.identifier(),
 			SMSColumnSequence.identifier(),
 			SMSColumnDescription.identifier(),
+			SMSColumnChannel.identifier(),
 
 			SMSTwilioColumnSMSID.identifier(),
 			SMSTwilioColumnSID.identifier(),
@@ -345,18 +450,34 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 			SMSHTTPColumnEndpoint.identifier(),
 			SMSHTTPColumnSigningKey.identifier(),
 
+			SMSWhatsAppColumnSMSID.identifier(),
+			SMSWhatsAppColumnPhoneNumberID.identifier(),
+			SMSWhatsAppColumnAccessToken.identifier(),
+			SMSWhatsAppColumnTemplateName.identifier(),
+			SMSWhatsAppColumnTemplateLanguage.identifier(),
+
+			SMSVoiceColumnSMSID.identifier(),
+			SMSVoiceColumnSID.identifier(),
+			SMSVoiceColumnToken.identifier(),
+			SMSVoiceColumnSenderNumber.identifier(),
+
 			countColumn.identifier(),
 		).From(smsConfigsTable.identifier()).
 			LeftJoin(join(SMSTwilioColumnSMSID, SMSColumnID)).
 			LeftJoin(join(SMSHTTPColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSWhatsAppColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSVoiceColumnSMSID, SMSColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Rows) (*SMSConfigs, error) {
 			configs := &SMSConfigs{Configs: []*SMSConfig{}}
 
 			for row.Next() {
 				config := new(SMSConfig)
 				var (
-					twilioConfig = sqlTwilioConfig{}
-					httpConfig   = sqlHTTPConfig{}
+					channel        sql.NullInt32
+					twilioConfig   = sqlTwilioConfig{}
+					httpConfig     = sqlHTTPConfig{}
+					whatsAppConfig = sqlWhatsAppConfig{}
+					voiceConfig    = sqlVoiceConfig{}
 				)
 
 				err := row.Scan(
@@ -368,6 +489,7 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 					&config.State,
 					&config.Sequence,
 					&config.Description,
+					&channel,
 
 					&twilioConfig.smsID,
 					&twilioConfig.sid,
@@ -379,6 +501,28 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 					&httpConfig.endpoint,
 					&httpConfig.signingKey,
 
+					&whatsAppConfig.smsID,
+					&whatsAppConfig.phoneNumberID,
+					&whatsAppConfig.accessToken,
+					&whatsAppConfig.templateName,
+					&whatsAppConfig.templateLanguage,
+
+					&voiceConfig.smsID,
+					&voiceConfig.sid,
+					&voiceConfig.token,
+					&voiceConfig.senderNumber,
+
+					&whatsAppConfig.smsID,
+					&whatsAppConfig.phoneNumberID,
+					&whatsAppConfig.accessToken,
+					&whatsAppConfig.templateName,
+					&whatsAppConfig.templateLanguage,
+
+					&voiceConfig.smsID,
+					&voiceConfig.sid,
+					&voiceConfig.token,
+					&voiceConfig.senderNumber,
+
 					&configs.Count,
 				)
 
@@ -386,8 +530,11 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 					return nil, zerrors.ThrowInternal(err, "QUERY-d9jJd", "Errors.Internal")
 				}
 
+				config.Channel = domain.PhoneChannel(channel.Int32)
 				twilioConfig.set(config)
 				httpConfig.setSMS(config)
+				whatsAppConfig.set(config)
+				voiceConfig.set(config)
 
 				configs.Configs = append(configs.Configs, config)
 			}
@@ -431,3 +578,41 @@ func (c sqlHTTPConfig) setSMS(smsConfig *SMSConfig) {
 		signingKey: c.signingKey,
 	}
 }
+
+type sqlWhatsAppConfig struct {
+	smsID            sql.NullString
+	phoneNumberID    sql.NullString
+	accessToken      *crypto.CryptoValue
+	templateName     sql.NullString
+	templateLanguage sql.NullString
+}
+
+func (c sqlWhatsAppConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.WhatsAppConfig = &WhatsApp{
+		PhoneNumberID:    c.phoneNumberID.String,
+		AccessToken:      c.accessToken,
+		TemplateName:     c.templateName.String,
+		TemplateLanguage: c.templateLanguage.String,
+	}
+}
+
+type sqlVoiceConfig struct {
+	smsID        sql.NullString
+	sid          sql.NullString
+	token        *crypto.CryptoValue
+	senderNumber sql.NullString
+}
+
+func (c sqlVoiceConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.VoiceConfig = &Voice{
+		SID:          c.sid.String,
+		Token:        c.token,
+		SenderNumber: c.senderNumber.String,
+	}
+}
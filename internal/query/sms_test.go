@@ -14,53 +14,85 @@ import (
 )
 
 var (
-	expectedSMSConfigQuery = regexp.QuoteMeta(`SELECT projections.sms_configs3.id,` +
-		` projections.sms_configs3.aggregate_id,` +
-		` projections.sms_configs3.creation_date,` +
-		` projections.sms_configs3.change_date,` +
-		` projections.sms_configs3.resource_owner,` +
-		` projections.sms_configs3.state,` +
-		` projections.sms_configs3.sequence,` +
-		` projections.sms_configs3.description,` +
+	expectedSMSConfigQuery = regexp.QuoteMeta(`SELECT projections.sms_configs4.id,` +
+		` projections.sms_configs4.aggregate_id,` +
+		` projections.sms_configs4.creation_date,` +
+		` projections.sms_configs4.change_date,` +
+		` projections.sms_configs4.resource_owner,` +
+		` projections.sms_configs4.state,` +
+		` projections.sms_configs4.sequence,` +
+		` projections.sms_configs4.description,` +
+		` projections.sms_configs4.channel,` +
 
 		// twilio config
-		` projections.sms_configs3_twilio.sms_id,` +
-		` projections.sms_configs3_twilio.sid,` +
-		` projections.sms_configs3_twilio.token,` +
-		` projections.sms_configs3_twilio.sender_number,` +
-		` projections.sms_configs3_twilio.verify_service_sid,` +
+		` projections.sms_configs4_twilio.sms_id,` +
+		` projections.sms_configs4_twilio.sid,` +
+		` projections.sms_configs4_twilio.token,` +
+		` projections.sms_configs4_twilio.sender_number,` +
+		` projections.sms_configs4_twilio.verify_service_sid,` +
 
 		// http config
-		` projections.sms_configs3_http.sms_id,` +
-		` projections.sms_configs3_http.endpoint,` +
-		` projections.sms_configs3_http.signing_key` +
-		` FROM projections.sms_configs3` +
-		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
-		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id`)
-	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs3.id,` +
-		` projections.sms_configs3.aggregate_id,` +
-		` projections.sms_configs3.creation_date,` +
-		` projections.sms_configs3.change_date,` +
-		` projections.sms_configs3.resource_owner,` +
-		` projections.sms_configs3.state,` +
-		` projections.sms_configs3.sequence,` +
-		` projections.sms_configs3.description,` +
+		` projections.sms_configs4_http.sms_id,` +
+		` projections.sms_configs4_http.endpoint,` +
+		` projections.sms_configs4_http.signing_key,` +
+
+		// whatsapp config
+		` projections.sms_configs4_whatsapp.sms_id,` +
+		` projections.sms_configs4_whatsapp.phone_number_id,` +
+		` projections.sms_configs4_whatsapp.access_token,` +
+		` projections.sms_configs4_whatsapp.template_name,` +
+		` projections.sms_configs4_whatsapp.template_language,` +
+
+		// voice config
+		` projections.sms_configs4_voice.sms_id,` +
+		` projections.sms_configs4_voice.sid,` +
+		` projections.sms_configs4_voice.token,` +
+		` projections.sms_configs4_voice.sender_number` +
+		` FROM projections.sms_configs4` +
+		` LEFT JOIN projections.sms_configs4_twilio ON projections.sms_configs4.id = projections.sms_configs4_twilio.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_twilio.instance_id` +
+		` LEFT JOIN projections.sms_configs4_http ON projections.sms_configs4.id = projections.sms_configs4_http.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_http.instance_id` +
+		` LEFT JOIN projections.sms_configs4_whatsapp ON projections.sms_configs4.id = projections.sms_configs4_whatsapp.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_whatsapp.instance_id` +
+		` LEFT JOIN projections.sms_configs4_voice ON projections.sms_configs4.id = projections.sms_configs4_voice.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_voice.instance_id`)
+	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs4.id,` +
+		` projections.sms_configs4.aggregate_id,` +
+		` projections.sms_configs4.creation_date,` +
+		` projections.sms_configs4.change_date,` +
+		` projections.sms_configs4.resource_owner,` +
+		` projections.sms_configs4.state,` +
+		` projections.sms_configs4.sequence,` +
+		` projections.sms_configs4.description,` +
+		` projections.sms_configs4.channel,` +
 
 		// twilio config
-		` projections.sms_configs3_twilio.sms_id,` +
-		` projections.sms_configs3_twilio.sid,` +
-		` projections.sms_configs3_twilio.token,` +
-		` projections.sms_configs3_twilio.sender_number,` +
-		` projections.sms_configs3_twilio.verify_service_sid,` +
+		` projections.sms_configs4_twilio.sms_id,` +
+		` projections.sms_configs4_twilio.sid,` +
+		` projections.sms_configs4_twilio.token,` +
+		` projections.sms_configs4_twilio.sender_number,` +
+		` projections.sms_configs4_twilio.verify_service_sid,` +
 
 		// http config
-		` projections.sms_configs3_http.sms_id,` +
-		` projections.sms_configs3_http.endpoint,` +
-		` projections.sms_configs3_http.signing_key,` +
+		` projections.sms_configs4_http.sms_id,` +
+		` projections.sms_configs4_http.endpoint,` +
+		` projections.sms_configs4_http.signing_key,` +
+
+		// whatsapp config
+		` projections.sms_configs4_whatsapp.sms_id,` +
+		` projections.sms_configs4_whatsapp.phone_number_id,` +
+		` projections.sms_configs4_whatsapp.access_token,` +
+		` projections.sms_configs4_whatsapp.template_name,` +
+		` projections.sms_configs4_whatsapp.template_language,` +
+
+		// voice config
+		` projections.sms_configs4_voice.sms_id,` +
+		` projections.sms_configs4_voice.sid,` +
+		` projections.sms_configs4_voice.token,` +
+		` projections.sms_configs4_voice.sender_number,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.sms_configs3` +
-		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
-		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id`)
+		` FROM projections.sms_configs4` +
+		` LEFT JOIN projections.sms_configs4_twilio ON projections.sms_configs4.id = projections.sms_configs4_twilio.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_twilio.instance_id` +
+		` LEFT JOIN projections.sms_configs4_http ON projections.sms_configs4.id = projections.sms_configs4_http.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_http.instance_id` +
+		` LEFT JOIN projections.sms_configs4_whatsapp ON projections.sms_configs4.id = projections.sms_configs4_whatsapp.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_whatsapp.instance_id` +
+		` LEFT JOIN projections.sms_configs4_voice ON projections.sms_configs4.id = projections.sms_configs4_voice.sms_id AND projections.sms_configs4.instance_id = projections.sms_configs4_voice.instance_id`)
 
 	smsConfigCols = []string{
 		"id",
@@ -71,6 +103,7 @@ var (
 		"state",
 		"sequence",
 		"description",
+		"channel",
 		// twilio config
 		"sms_id",
 		"sid",
@@ -81,6 +114,17 @@ var (
 		"sms_id",
 		"endpoint",
 		"signing_key",
+		// whatsapp config
+		"sms_id",
+		"phone_number_id",
+		"access_token",
+		"template_name",
+		"template_language",
+		// voice config
+		"sms_id",
+		"sid",
+		"token",
+		"sender_number",
 	}
 	smsConfigsCols = append(smsConfigCols, "count")
 )
@@ -125,6 +169,7 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							domain.SMSConfigStateInactive,
 							uint64(20211109),
 							"description",
+							domain.PhoneChannelSMS,
 							// twilio config
 							"sms-id",
 							"sid",
@@ -135,6 +180,17 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// whatsapp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// voice config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -153,6 +209,7 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 						State:         domain.SMSConfigStateInactive,
 						Sequence:      20211109,
 						Description:   "description",
+						Channel:       domain.PhoneChannelSMS,
 						TwilioConfig: &Twilio{
 							SID:              "sid",
 							Token:            &crypto.CryptoValue{},
@@ -180,6 +237,7 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							domain.SMSConfigStateInactive,
 							uint64(20211109),
 							"description",
+							domain.PhoneChannelSMS,
 							// twilio config
 							nil,
 							nil,
@@ -195,6 +253,17 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							// whatsapp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// voice config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -213,6 +282,7 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 						State:         domain.SMSConfigStateInactive,
 						Sequence:      20211109,
 						Description:   "description",
+						Channel:       domain.PhoneChannelSMS,
 						HTTPConfig: &HTTP{
 							Endpoint: "endpoint",
 							signingKey: &crypto.CryptoValue{
@@ -243,6 +313,7 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							domain.SMSConfigStateActive,
 							uint64(20211109),
 							"description",
+							domain.PhoneChannelSMS,
 							// twilio config
 							"sms-id",
 							"sid",
@@ -253,6 +324,17 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// whatsapp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// voice config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id2",
@@ -263,6 +345,7 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							domain.SMSConfigStateInactive,
 							uint64(20211109),
 							"description",
+							domain.PhoneChannelSMS,
 							// twilio config
 							"sms-id2",
 							"sid2",
@@ -273,6 +356,17 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// whatsapp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// voice config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id3",
@@ -283,6 +377,7 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							domain.SMSConfigStateInactive,
 							uint64(20211109),
 							"description",
+							domain.PhoneChannelSMS,
 							// twilio config
 							nil,
 							nil,
@@ -298,6 +393,17 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							// whatsapp config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// voice config
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -316,6 +422,7 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 						State:         domain.SMSConfigStateActive,
 						Sequence:      20211109,
 						Description:   "description",
+						Channel:       domain.PhoneChannelSMS,
 						TwilioConfig: &Twilio{
 							SID:              "sid",
 							Token:            &crypto.CryptoValue{},
@@ -332,6 +439,7 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 						State:         domain.SMSConfigStateInactive,
 						Sequence:      20211109,
 						Description:   "description",
+						Channel:       domain.PhoneChannelSMS,
 						TwilioConfig: &Twilio{
 							SID:              "sid2",
 							Token:            &crypto.CryptoValue{},
@@ -348,6 +456,7 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 						State:         domain.SMSConfigStateInactive,
 						Sequence:      20211109,
 						Description:   "description",
+						Channel:       domain.PhoneChannelSMS,
 						HTTPConfig: &HTTP{
 							Endpoint: "endpoint3",
 							signingKey: &crypto.CryptoValue{
@@ -431,6 +540,7 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						domain.SMSConfigStateActive,
 						uint64(20211109),
 						"description",
+						domain.PhoneChannelSMS,
 						// twilio config
 						"sms-id",
 						"sid",
@@ -441,6 +551,17 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// whatsapp config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// voice config
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -453,6 +574,7 @@ func Test_SMSConfigPrepare(t *testing.T) {
 				State:         domain.SMSConfigStateActive,
 				Sequence:      20211109,
 				Description:   "description",
+				Channel:       domain.PhoneChannelSMS,
 				TwilioConfig: &Twilio{
 					SID:              "sid",
 					SenderNumber:     "sender-number",
@@ -461,6 +583,65 @@ func Test_SMSConfigPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareSMSConfigQuery, whatsapp, found",
+			prepare: prepareSMSConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedSMSConfigQuery,
+					smsConfigCols,
+					[]driver.Value{
+						"sms-id",
+						"agg-id",
+						testNow,
+						testNow,
+						"ro",
+						domain.SMSConfigStateActive,
+						uint64(20211109),
+						"description",
+						domain.PhoneChannelWhatsApp,
+						// twilio config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// http config
+						nil,
+						nil,
+						nil,
+						// whatsapp config
+						"sms-id",
+						"phone-number-id",
+						&crypto.CryptoValue{},
+						"template-name",
+						"en",
+						// voice config
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &SMSConfig{
+				ID:            "sms-id",
+				AggregateID:   "agg-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				State:         domain.SMSConfigStateActive,
+				Sequence:      20211109,
+				Description:   "description",
+				Channel:       domain.PhoneChannelWhatsApp,
+				WhatsAppConfig: &WhatsApp{
+					PhoneNumberID:    "phone-number-id",
+					AccessToken:      &crypto.CryptoValue{},
+					TemplateName:     "template-name",
+					TemplateLanguage: "en",
+				},
+			},
+		},
 		{
 			name:    "prepareSMSConfigQuery, http, found",
 			prepare: prepareSMSConfigQuery,
@@ -477,6 +658,7 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						domain.SMSConfigStateInactive,
 						uint64(20211109),
 						"description",
+						domain.PhoneChannelSMS,
 						// twilio config
 						nil,
 						nil,
@@ -492,6 +674,17 @@ func Test_SMSConfigPrepare(t *testing.T) {
 							KeyID:      "encKey",
 							Crypted:    []byte("crypted"),
 						},
+						// whatsapp config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// voice config
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -504,6 +697,7 @@ func Test_SMSConfigPrepare(t *testing.T) {
 				State:         domain.SMSConfigStateInactive,
 				Sequence:      20211109,
 				Description:   "description",
+				Channel:       domain.PhoneChannelSMS,
 				HTTPConfig: &HTTP{
 					Endpoint: "endpoint",
 					signingKey: &crypto.CryptoValue{
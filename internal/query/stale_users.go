@@ -0,0 +1,112 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	//go:embed stale_users.sql
+	staleUsersQuery string
+)
+
+// StaleUser is a user without activity since LastActivity.
+// If the user was never active, LastActivity is the creation date of the user.
+type StaleUser struct {
+	ID            string
+	ResourceOwner string
+	Username      string
+	Type          domain.UserType
+	State         domain.UserState
+	CreationDate  time.Time
+	LastActivity  time.Time
+}
+
+type StaleUsers struct {
+	SearchResponse
+	Users []*StaleUser
+}
+
+type StaleUsersSearchQueries struct {
+	Offset uint64
+	Limit  uint64
+
+	// InactiveSince only returns users without activity since
+	InactiveSince time.Time
+	// ResourceOwners only returns users of the organizations, all organizations if empty
+	ResourceOwners []string
+	// ExcludedResourceOwners excludes the users of the organizations
+	ExcludedResourceOwners []string
+	// ExemptMachineUsers excludes machine users
+	ExemptMachineUsers bool
+	// ExemptRoles excludes users which are granted or member with any of the roles
+	ExemptRoles []string
+}
+
+// SearchStaleUsers returns the users of the instance without activity since [StaleUsersSearchQueries.InactiveSince],
+// ordered by the last activity (oldest first).
+func (q *Queries) SearchStaleUsers(ctx context.Context, queries *StaleUsersSearchQueries, shouldTriggerBulk bool) (users *StaleUsers, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserProjection")
+		ctx, err = projection.UserProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	limit := sql.NullInt64{Int64: int64(queries.Limit), Valid: queries.Limit > 0}
+	users = &StaleUsers{Users: []*StaleUser{}}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			user := new(StaleUser)
+			var lastActivity sql.NullTime
+			err := rows.Scan(
+				&user.ID,
+				&user.ResourceOwner,
+				&user.Username,
+				&user.Type,
+				&user.State,
+				&user.CreationDate,
+				&lastActivity,
+				&users.Count,
+			)
+			if err != nil {
+				return err
+			}
+			user.LastActivity = user.CreationDate
+			if lastActivity.Valid {
+				user.LastActivity = lastActivity.Time
+			}
+			users.Users = append(users.Users, user)
+		}
+		return rows.Err()
+	}, staleUsersQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		queries.InactiveSince,
+		database.TextArray[string](queries.ResourceOwners),
+		database.TextArray[string](queries.ExcludedResourceOwners),
+		queries.ExemptMachineUsers,
+		domain.UserTypeMachine,
+		database.TextArray[string](queries.ExemptRoles),
+		limit,
+		queries.Offset,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Eix4o", "Errors.Internal")
+	}
+	return users, nil
+}
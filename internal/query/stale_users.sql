@@ -0,0 +1,36 @@
+SELECT u.id
+    , u.resource_owner
+    , u.username
+    , u.type
+    , u.state
+    , u.creation_date
+    , a.last_activity
+    , COUNT(*) OVER ()
+FROM projections.users14 u
+    LEFT JOIN auth.user_activities a
+        ON a.instance_id = u.instance_id
+            AND a.user_id = u.id
+WHERE u.instance_id = $1
+    AND COALESCE(a.last_activity, u.creation_date) < $2
+    AND ($3::TEXT[] IS NULL OR u.resource_owner = ANY($3::TEXT[]))
+    AND ($4::TEXT[] IS NULL OR u.resource_owner <> ALL($4::TEXT[]))
+    AND ($5::BOOLEAN IS FALSE OR u.type <> $6)
+    AND ($7::TEXT[] IS NULL OR NOT EXISTS (
+        SELECT 1 FROM projections.user_grants5 g
+        WHERE g.instance_id = u.instance_id AND g.user_id = u.id AND g.roles && $7::TEXT[]
+        UNION ALL
+        SELECT 1 FROM projections.instance_members4 m
+        WHERE m.instance_id = u.instance_id AND m.user_id = u.id AND m.roles && $7::TEXT[]
+        UNION ALL
+        SELECT 1 FROM projections.org_members4 m
+        WHERE m.instance_id = u.instance_id AND m.user_id = u.id AND m.roles && $7::TEXT[]
+        UNION ALL
+        SELECT 1 FROM projections.project_members4 m
+        WHERE m.instance_id = u.instance_id AND m.user_id = u.id AND m.roles && $7::TEXT[]
+        UNION ALL
+        SELECT 1 FROM projections.project_grant_members4 m
+        WHERE m.instance_id = u.instance_id AND m.user_id = u.id AND m.roles && $7::TEXT[]
+    ))
+ORDER BY COALESCE(a.last_activity, u.creation_date), u.id
+LIMIT $8
+OFFSET $9;
@@ -0,0 +1,123 @@
+package query
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func TestQueries_SearchStaleUsers(t *testing.T) {
+	columns := []string{"id", "resource_owner", "username", "type", "state", "creation_date", "last_activity", "count"}
+	inactiveSince := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		queries    *StaleUsersSearchQueries
+		expects    func(sqlmock.Sqlmock)
+		wantResult *StaleUsers
+		wantErr    bool
+	}{
+		{
+			name: "query error",
+			queries: &StaleUsersSearchQueries{
+				InactiveSince: inactiveSince,
+			},
+			expects: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(staleUsersQuery)).
+					WithArgs("instanceID", inactiveSince, nil, nil, false, domain.UserTypeMachine, nil, sql.NullInt64{}, uint64(0)).
+					WillReturnError(assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name: "success",
+			queries: &StaleUsersSearchQueries{
+				Offset:                 10,
+				Limit:                  5,
+				InactiveSince:          inactiveSince,
+				ResourceOwners:         []string{"org1"},
+				ExcludedResourceOwners: []string{"org2"},
+				ExemptMachineUsers:     true,
+				ExemptRoles:            []string{"IAM_OWNER"},
+			},
+			expects: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(staleUsersQuery)).
+					WithArgs(
+						"instanceID",
+						inactiveSince,
+						database.TextArray[string]{"org1"},
+						database.TextArray[string]{"org2"},
+						true,
+						domain.UserTypeMachine,
+						database.TextArray[string]{"IAM_OWNER"},
+						sql.NullInt64{Int64: 5, Valid: true},
+						uint64(10),
+					).
+					WillReturnRows(
+						sqlmock.NewRows(columns).
+							AddRow("user1", "org1", "username1", domain.UserTypeHuman, domain.UserStateActive, time.Unix(1, 0), time.Unix(2, 0), 2).
+							AddRow("user2", "org1", "username2", domain.UserTypeHuman, domain.UserStateInactive, time.Unix(3, 0), nil, 2),
+					)
+			},
+			wantResult: &StaleUsers{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Users: []*StaleUser{
+					{
+						ID:            "user1",
+						ResourceOwner: "org1",
+						Username:      "username1",
+						Type:          domain.UserTypeHuman,
+						State:         domain.UserStateActive,
+						CreationDate:  time.Unix(1, 0),
+						LastActivity:  time.Unix(2, 0),
+					},
+					{
+						ID:            "user2",
+						ResourceOwner: "org1",
+						Username:      "username2",
+						Type:          domain.UserTypeHuman,
+						State:         domain.UserStateInactive,
+						CreationDate:  time.Unix(3, 0),
+						LastActivity:  time.Unix(3, 0),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer func() {
+				err := mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			}()
+			defer db.Close()
+			tt.expects(mock)
+			mock.ExpectClose()
+			q := &Queries{
+				client: &database.DB{
+					DB: db,
+				},
+			}
+
+			gotResult, err := q.SearchStaleUsers(authz.NewMockContext("instanceID", "org1", "user1"), tt.queries, false)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, gotResult)
+		})
+	}
+}
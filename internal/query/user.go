@@ -90,6 +90,7 @@ type Phone struct {
 	Sequence      uint64
 	Phone         string
 	IsVerified    bool
+	Channel       domain.PhoneChannel
 }
 
 type Machine struct {
@@ -117,6 +118,7 @@ type NotifyUser struct {
 	AvatarKey          string
 	PreferredLanguage  language.Tag
 	Gender             domain.Gender
+	PhoneChannel       domain.PhoneChannel
 	LastEmail          string
 	VerifiedEmail      string
 	LastPhone          string
@@ -266,6 +268,10 @@ var (
 		name:  projection.HumanIsPhoneVerifiedCol,
 		table: humanTable,
 	}
+	HumanPhoneChannelCol = Column{
+		name:  projection.HumanPhoneChannelCol,
+		table: humanTable,
+	}
 
 	HumanPasswordChangeRequiredCol = Column{
 		name:  projection.HumanPasswordChangeRequired,
@@ -1198,7 +1204,8 @@ func preparePhoneQuery() (sq.SelectBuilder, func(*sql.Row) (*Phone, error)) {
 			UserSequenceCol.identifier(),
 			HumanUserIDCol.identifier(),
 			HumanPhoneCol.identifier(),
-			HumanIsPhoneVerifiedCol.identifier()).
+			HumanIsPhoneVerifiedCol.identifier(),
+			HumanPhoneChannelCol.identifier()).
 			From(userTable.identifier()).
 			LeftJoin(join(HumanUserIDCol, UserIDCol)).
 			PlaceholderFormat(sq.Dollar),
@@ -1208,6 +1215,7 @@ func preparePhoneQuery() (sq.SelectBuilder, func(*sql.Row) (*Phone, error)) {
 			humanID := sql.NullString{}
 			phone := sql.NullString{}
 			isPhoneVerified := sql.NullBool{}
+			channel := sql.NullInt32{}
 
 			err := row.Scan(
 				&e.ID,
@@ -1218,6 +1226,7 @@ func preparePhoneQuery() (sq.SelectBuilder, func(*sql.Row) (*Phone, error)) {
 				&humanID,
 				&phone,
 				&isPhoneVerified,
+				&channel,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -1231,6 +1240,7 @@ func preparePhoneQuery() (sq.SelectBuilder, func(*sql.Row) (*Phone, error)) {
 
 			e.Phone = phone.String
 			e.IsVerified = isPhoneVerified.Bool
+			e.Channel = domain.PhoneChannel(channel.Int32)
 
 			return e, nil
 		}
@@ -1256,6 +1266,7 @@ func prepareNotifyUserQuery() (sq.SelectBuilder, func(*sql.Row) (*NotifyUser, er
 			HumanPreferredLanguageCol.identifier(),
 			HumanGenderCol.identifier(),
 			HumanAvatarURLCol.identifier(),
+			HumanPhoneChannelCol.identifier(),
 			NotifyUserIDCol.identifier(),
 			NotifyEmailCol.identifier(),
 			NotifyVerifiedEmailCol.identifier(),
@@ -1286,6 +1297,7 @@ func scanNotifyUser(row *sql.Row) (*NotifyUser, error) {
 	preferredLanguage := sql.NullString{}
 	gender := sql.NullInt32{}
 	avatarKey := sql.NullString{}
+	phoneChannel := sql.NullInt32{}
 
 	notifyUserID := sql.NullString{}
 	notifyEmail := sql.NullString{}
@@ -1313,6 +1325,7 @@ func scanNotifyUser(row *sql.Row) (*NotifyUser, error) {
 		&preferredLanguage,
 		&gender,
 		&avatarKey,
+		&phoneChannel,
 		&notifyUserID,
 		&notifyEmail,
 		&notifyVerifiedEmail,
@@ -1345,6 +1358,7 @@ func scanNotifyUser(row *sql.Row) (*NotifyUser, error) {
 		u.AvatarKey = avatarKey.String
 		u.PreferredLanguage = language.Make(preferredLanguage.String)
 		u.Gender = domain.Gender(gender.Int32)
+		u.PhoneChannel = domain.PhoneChannel(phoneChannel.Int32)
 	}
 	u.LastEmail = notifyEmail.String
 	u.VerifiedEmail = notifyVerifiedEmail.String
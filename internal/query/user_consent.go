@@ -0,0 +1,159 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type UserConsents struct {
+	SearchResponse
+	Consents []*UserConsent
+}
+
+// UserConsent is the set of scopes a user granted to a client.
+type UserConsent struct {
+	UserID        string
+	ClientID      string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+	ResourceOwner string
+	Scopes        database.TextArray[string]
+}
+
+var (
+	userConsentTable = table{
+		name:          projection.UserConsentProjectionTable,
+		instanceIDCol: projection.UserConsentColumnInstanceID,
+	}
+	UserConsentUserIDCol = Column{
+		name:  projection.UserConsentColumnUserID,
+		table: userConsentTable,
+	}
+	UserConsentClientIDCol = Column{
+		name:  projection.UserConsentColumnClientID,
+		table: userConsentTable,
+	}
+	UserConsentCreationDateCol = Column{
+		name:  projection.UserConsentColumnCreationDate,
+		table: userConsentTable,
+	}
+	UserConsentChangeDateCol = Column{
+		name:  projection.UserConsentColumnChangeDate,
+		table: userConsentTable,
+	}
+	UserConsentSequenceCol = Column{
+		name:  projection.UserConsentColumnSequence,
+		table: userConsentTable,
+	}
+	UserConsentResourceOwnerCol = Column{
+		name:  projection.UserConsentColumnResourceOwner,
+		table: userConsentTable,
+	}
+	UserConsentInstanceIDCol = Column{
+		name:  projection.UserConsentColumnInstanceID,
+		table: userConsentTable,
+	}
+	UserConsentScopesCol = Column{
+		name:  projection.UserConsentColumnScopes,
+		table: userConsentTable,
+	}
+)
+
+// UserConsentsByUserID returns all consents the user has granted to clients.
+// Consents the caller is not allowed to see are filtered out by the permissionCheck.
+func (q *Queries) UserConsentsByUserID(ctx context.Context, shouldTriggerBulk bool, userID string, permissionCheck domain.PermissionCheck) (consents *UserConsents, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserConsentProjection")
+		ctx, err = projection.UserConsentProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	query, scan := prepareUserConsentsQuery()
+	stmt, args, err := query.Where(sq.Eq{
+		UserConsentUserIDCol.identifier():     userID,
+		UserConsentInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).OrderBy(UserConsentClientIDCol.identifier()).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ahng2", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		consents, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	if permissionCheck != nil {
+		consents.Consents = slices.DeleteFunc(consents.Consents, func(consent *UserConsent) bool {
+			return userCheckPermission(ctx, consent.ResourceOwner, consent.UserID, permissionCheck) != nil
+		})
+	}
+	consents.State, err = q.latestState(ctx, userConsentTable)
+	return consents, err
+}
+
+func prepareUserConsentsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserConsents, error)) {
+	return sq.Select(
+			UserConsentUserIDCol.identifier(),
+			UserConsentClientIDCol.identifier(),
+			UserConsentCreationDateCol.identifier(),
+			UserConsentChangeDateCol.identifier(),
+			UserConsentSequenceCol.identifier(),
+			UserConsentResourceOwnerCol.identifier(),
+			UserConsentScopesCol.identifier(),
+			countColumn.identifier(),
+		).
+			From(userConsentTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserConsents, error) {
+			consents := make([]*UserConsent, 0)
+			var count uint64
+			for rows.Next() {
+				consent := new(UserConsent)
+				err := rows.Scan(
+					&consent.UserID,
+					&consent.ClientID,
+					&consent.CreationDate,
+					&consent.ChangeDate,
+					&consent.Sequence,
+					&consent.ResourceOwner,
+					&consent.Scopes,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				consents = append(consents, consent)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Xoh7u", "Errors.Query.CloseRows")
+			}
+
+			return &UserConsents{
+				Consents: consents,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,119 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+var (
+	userConsentsQuery = `SELECT projections.user_consents.user_id,` +
+		` projections.user_consents.client_id,` +
+		` projections.user_consents.creation_date,` +
+		` projections.user_consents.change_date,` +
+		` projections.user_consents.sequence,` +
+		` projections.user_consents.resource_owner,` +
+		` projections.user_consents.scopes,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.user_consents`
+	userConsentsCols = []string{
+		"user_id",
+		"client_id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"scopes",
+		"count",
+	}
+)
+
+func Test_UserConsentPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserConsentsQuery no result",
+			prepare: prepareUserConsentsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userConsentsQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &UserConsents{Consents: []*UserConsent{}},
+		},
+		{
+			name:    "prepareUserConsentsQuery one result",
+			prepare: prepareUserConsentsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userConsentsQuery),
+					userConsentsCols,
+					[][]driver.Value{
+						{
+							"user-id",
+							"client-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							"resource_owner",
+							database.TextArray[string]{"openid", "profile"},
+						},
+					},
+				),
+			},
+			object: &UserConsents{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Consents: []*UserConsent{
+					{
+						UserID:        "user-id",
+						ClientID:      "client-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						Sequence:      20211108,
+						ResourceOwner: "resource_owner",
+						Scopes:        database.TextArray[string]{"openid", "profile"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserConsentsQuery sql err",
+			prepare: prepareUserConsentsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(userConsentsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserConsents)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
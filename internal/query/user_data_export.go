@@ -0,0 +1,129 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// UserDataExport is an export of all data held about a user.
+type UserDataExport struct {
+	ID            string
+	UserID        string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	State         domain.UserDataExportState
+	FailureReason string
+}
+
+// UserDataExportByID returns the export of the user's data.
+// Users are allowed to retrieve their own exports.
+func (q *Queries) UserDataExportByID(ctx context.Context, userID, exportID string, permissionCheck domain.PermissionCheck) (_ *UserDataExport, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || exportID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-eeG4u", "Errors.IDMissing")
+	}
+	readModel := newUserDataExportReadModel(userID, exportID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	if readModel.State == domain.UserDataExportStateUnspecified {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Oob3i", "Errors.User.DataExport.NotFound")
+	}
+	if err = userCheckPermission(ctx, readModel.ResourceOwner, userID, permissionCheck); err != nil {
+		return nil, err
+	}
+	return &UserDataExport{
+		ID:            exportID,
+		UserID:        userID,
+		ResourceOwner: readModel.ResourceOwner,
+		CreationDate:  readModel.CreationDate,
+		ChangeDate:    readModel.ChangeDate,
+		State:         readModel.State,
+		FailureReason: readModel.FailureReason,
+	}, nil
+}
+
+type userDataExportReadModel struct {
+	eventstore.ReadModel
+
+	ExportID      string
+	State         domain.UserDataExportState
+	FailureReason string
+}
+
+func newUserDataExportReadModel(userID, exportID string) *userDataExportReadModel {
+	return &userDataExportReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID: userID,
+		},
+		ExportID: exportID,
+	}
+}
+
+func (rm *userDataExportReadModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *user.DataExportRequestedEvent:
+			if e.ExportID != rm.ExportID {
+				continue
+			}
+		case *user.DataExportSucceededEvent:
+			if e.ExportID != rm.ExportID {
+				continue
+			}
+		case *user.DataExportFailedEvent:
+			if e.ExportID != rm.ExportID {
+				continue
+			}
+		case *user.DataExportExpiredEvent:
+			if e.ExportID != rm.ExportID {
+				continue
+			}
+		}
+		rm.ReadModel.AppendEvents(event)
+	}
+}
+
+func (rm *userDataExportReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.DataExportRequestedEvent:
+			rm.State = domain.UserDataExportStateRequested
+		case *user.DataExportSucceededEvent:
+			rm.State = domain.UserDataExportStateSucceeded
+		case *user.DataExportFailedEvent:
+			rm.State = domain.UserDataExportStateFailed
+			rm.FailureReason = e.Reason
+		case *user.DataExportExpiredEvent:
+			rm.State = domain.UserDataExportStateExpired
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *userDataExportReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.DataExportRequestedType,
+			user.DataExportSucceededType,
+			user.DataExportFailedType,
+			user.DataExportExpiredType,
+		).
+		EventData(map[string]interface{}{
+			"exportId": rm.ExportID,
+		}).
+		Builder()
+}
@@ -0,0 +1,330 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type UserDevices struct {
+	SearchResponse
+	Devices []*UserDevice
+}
+
+// UserDevice groups the sessions and tokens issued to a single user agent of the user,
+// identified by the fingerprint of the user agent.
+type UserDevice struct {
+	FingerprintID string
+	// Description and IP are taken from the most recently used session of the device.
+	Description string
+	IP          string
+	LastUsed    time.Time
+	Sessions    []*DeviceSession
+}
+
+// DeviceSession is a session of the session API, an OIDC or SAML session or a refresh token
+// issued to a device of the user.
+type DeviceSession struct {
+	ID            string
+	Type          domain.DeviceSessionType
+	ResourceOwner string
+	// SessionID is the session the OIDC or SAML session was created from.
+	SessionID     string
+	ClientID      string
+	FingerprintID string
+	IP            string
+	Description   string
+	CreationDate  time.Time
+	LastUsed      time.Time
+	// Expiration is zero if the session does not expire.
+	Expiration time.Time
+}
+
+var (
+	userDeviceSessionTable = table{
+		name:          projection.UserDeviceSessionProjectionTable,
+		instanceIDCol: projection.UserDeviceSessionColumnInstanceID,
+	}
+	UserDeviceSessionIDCol = Column{
+		name:  projection.UserDeviceSessionColumnID,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionTypeCol = Column{
+		name:  projection.UserDeviceSessionColumnType,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionUserIDCol = Column{
+		name:  projection.UserDeviceSessionColumnUserID,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionResourceOwnerCol = Column{
+		name:  projection.UserDeviceSessionColumnResourceOwner,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionInstanceIDCol = Column{
+		name:  projection.UserDeviceSessionColumnInstanceID,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionSessionIDCol = Column{
+		name:  projection.UserDeviceSessionColumnSessionID,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionClientIDCol = Column{
+		name:  projection.UserDeviceSessionColumnClientID,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionFingerprintIDCol = Column{
+		name:  projection.UserDeviceSessionColumnFingerprintID,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionIPCol = Column{
+		name:  projection.UserDeviceSessionColumnIP,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionDescriptionCol = Column{
+		name:  projection.UserDeviceSessionColumnDescription,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionCreationDateCol = Column{
+		name:  projection.UserDeviceSessionColumnCreationDate,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionChangeDateCol = Column{
+		name:  projection.UserDeviceSessionColumnChangeDate,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionSequenceCol = Column{
+		name:  projection.UserDeviceSessionColumnSequence,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionAccessTokenExpirationCol = Column{
+		name:  projection.UserDeviceSessionColumnAccessTokenExpiration,
+		table: userDeviceSessionTable,
+	}
+	UserDeviceSessionRefreshTokenExpirationCol = Column{
+		name:  projection.UserDeviceSessionColumnRefreshTokenExpiration,
+		table: userDeviceSessionTable,
+	}
+)
+
+// UserDevicesByUserID returns the devices (user agents) of the user with all active sessions and tokens issued to them.
+// Sessions the caller is not allowed to see are filtered out by the permissionCheck.
+func (q *Queries) UserDevicesByUserID(ctx context.Context, shouldTriggerBulk bool, userID string, permissionCheck domain.PermissionCheck) (devices *UserDevices, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserDeviceSessionProjection")
+		ctx, err = projection.UserDeviceSessionProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	now := time.Now()
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	sessionsQuery, sessionsScan := prepareUserDeviceSessionsFromSessionsQuery()
+	stmt, args, err := sessionsQuery.Where(sq.And{
+		sq.Eq{
+			SessionColumnUserID.identifier():     userID,
+			SessionColumnInstanceID.identifier(): instanceID,
+		},
+		sq.NotEq{SessionColumnUserAgentFingerprintID.identifier(): nil},
+		sq.NotEq{SessionColumnUserAgentFingerprintID.identifier(): ""},
+		sq.Or{
+			sq.Eq{SessionColumnExpiration.identifier(): nil},
+			sq.Gt{SessionColumnExpiration.identifier(): now},
+		},
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Aiy4u", "Errors.Query.SQLStatement")
+	}
+	var sessions []*DeviceSession
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		sessions, err = sessionsScan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceSessionsQuery, deviceSessionsScan := prepareUserDeviceSessionsQuery()
+	stmt, args, err = deviceSessionsQuery.Where(sq.And{
+		sq.Eq{
+			UserDeviceSessionUserIDCol.identifier():     userID,
+			UserDeviceSessionInstanceIDCol.identifier(): instanceID,
+		},
+		sq.Or{
+			sq.Eq{UserDeviceSessionTypeCol.identifier(): domain.DeviceSessionTypeSAML},
+			sq.Gt{UserDeviceSessionAccessTokenExpirationCol.identifier(): now},
+			sq.Gt{UserDeviceSessionRefreshTokenExpirationCol.identifier(): now},
+		},
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-ohX9e", "Errors.Query.SQLStatement")
+	}
+	var deviceSessions []*DeviceSession
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		deviceSessions, err = deviceSessionsScan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions = append(sessions, deviceSessions...)
+	if permissionCheck != nil {
+		sessions = slices.DeleteFunc(sessions, func(session *DeviceSession) bool {
+			return userCheckPermission(ctx, session.ResourceOwner, userID, permissionCheck) != nil
+		})
+	}
+	devices = &UserDevices{Devices: devicesFromSessions(sessions)}
+	devices.Count = uint64(len(devices.Devices))
+	devices.State, err = q.latestState(ctx, userDeviceSessionTable)
+	return devices, err
+}
+
+// devicesFromSessions groups the sessions by the fingerprint of their user agent.
+// Devices as well as their sessions are ordered by the last use, the most recent first.
+func devicesFromSessions(sessions []*DeviceSession) []*UserDevice {
+	slices.SortStableFunc(sessions, func(a, b *DeviceSession) int {
+		return b.LastUsed.Compare(a.LastUsed)
+	})
+	devices := make([]*UserDevice, 0)
+	byFingerprint := make(map[string]*UserDevice)
+	for _, session := range sessions {
+		device, ok := byFingerprint[session.FingerprintID]
+		if !ok {
+			device = &UserDevice{
+				FingerprintID: session.FingerprintID,
+				Description:   session.Description,
+				IP:            session.IP,
+				LastUsed:      session.LastUsed,
+			}
+			byFingerprint[session.FingerprintID] = device
+			devices = append(devices, device)
+		}
+		device.Sessions = append(device.Sessions, session)
+	}
+	return devices
+}
+
+func prepareUserDeviceSessionsFromSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*DeviceSession, error)) {
+	return sq.Select(
+			SessionColumnID.identifier(),
+			SessionColumnUserResourceOwner.identifier(),
+			SessionColumnUserAgentFingerprintID.identifier(),
+			SessionColumnUserAgentIP.identifier(),
+			SessionColumnUserAgentDescription.identifier(),
+			SessionColumnCreationDate.identifier(),
+			SessionColumnChangeDate.identifier(),
+			SessionColumnExpiration.identifier(),
+		).
+			From(sessionsTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*DeviceSession, error) {
+			sessions := make([]*DeviceSession, 0)
+			for rows.Next() {
+				session := &DeviceSession{Type: domain.DeviceSessionTypeSession}
+				var (
+					ip          sql.NullString
+					description sql.NullString
+					expiration  sql.NullTime
+				)
+				err := rows.Scan(
+					&session.ID,
+					&session.ResourceOwner,
+					&session.FingerprintID,
+					&ip,
+					&description,
+					&session.CreationDate,
+					&session.LastUsed,
+					&expiration,
+				)
+				if err != nil {
+					return nil, err
+				}
+				session.IP = ip.String
+				session.Description = description.String
+				session.Expiration = expiration.Time
+				sessions = append(sessions, session)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-eiT8a", "Errors.Query.CloseRows")
+			}
+			return sessions, nil
+		}
+}
+
+func prepareUserDeviceSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*DeviceSession, error)) {
+	return sq.Select(
+			UserDeviceSessionIDCol.identifier(),
+			UserDeviceSessionTypeCol.identifier(),
+			UserDeviceSessionResourceOwnerCol.identifier(),
+			UserDeviceSessionSessionIDCol.identifier(),
+			UserDeviceSessionClientIDCol.identifier(),
+			UserDeviceSessionFingerprintIDCol.identifier(),
+			UserDeviceSessionIPCol.identifier(),
+			UserDeviceSessionDescriptionCol.identifier(),
+			UserDeviceSessionCreationDateCol.identifier(),
+			UserDeviceSessionChangeDateCol.identifier(),
+			UserDeviceSessionAccessTokenExpirationCol.identifier(),
+			UserDeviceSessionRefreshTokenExpirationCol.identifier(),
+		).
+			From(userDeviceSessionTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*DeviceSession, error) {
+			sessions := make([]*DeviceSession, 0)
+			for rows.Next() {
+				session := new(DeviceSession)
+				var (
+					ip                     sql.NullString
+					description            sql.NullString
+					accessTokenExpiration  sql.NullTime
+					refreshTokenExpiration sql.NullTime
+				)
+				err := rows.Scan(
+					&session.ID,
+					&session.Type,
+					&session.ResourceOwner,
+					&session.SessionID,
+					&session.ClientID,
+					&session.FingerprintID,
+					&ip,
+					&description,
+					&session.CreationDate,
+					&session.LastUsed,
+					&accessTokenExpiration,
+					&refreshTokenExpiration,
+				)
+				if err != nil {
+					return nil, err
+				}
+				session.IP = ip.String
+				session.Description = description.String
+				// the session is usable as long as any of its tokens
+				if accessTokenExpiration.Time.After(refreshTokenExpiration.Time) {
+					session.Expiration = accessTokenExpiration.Time
+				} else {
+					session.Expiration = refreshTokenExpiration.Time
+				}
+				sessions = append(sessions, session)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ree2k", "Errors.Query.CloseRows")
+			}
+			return sessions, nil
+		}
+}
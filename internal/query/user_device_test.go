@@ -0,0 +1,265 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	userDeviceSessionsFromSessionsQuery = `SELECT projections.sessions8.id,` +
+		` projections.sessions8.user_resource_owner,` +
+		` projections.sessions8.user_agent_fingerprint_id,` +
+		` projections.sessions8.user_agent_ip,` +
+		` projections.sessions8.user_agent_description,` +
+		` projections.sessions8.creation_date,` +
+		` projections.sessions8.change_date,` +
+		` projections.sessions8.expiration` +
+		` FROM projections.sessions8`
+	userDeviceSessionsFromSessionsCols = []string{
+		"id",
+		"user_resource_owner",
+		"user_agent_fingerprint_id",
+		"user_agent_ip",
+		"user_agent_description",
+		"creation_date",
+		"change_date",
+		"expiration",
+	}
+	userDeviceSessionsQuery = `SELECT projections.user_device_sessions.id,` +
+		` projections.user_device_sessions.type,` +
+		` projections.user_device_sessions.resource_owner,` +
+		` projections.user_device_sessions.session_id,` +
+		` projections.user_device_sessions.client_id,` +
+		` projections.user_device_sessions.fingerprint_id,` +
+		` projections.user_device_sessions.ip,` +
+		` projections.user_device_sessions.description,` +
+		` projections.user_device_sessions.creation_date,` +
+		` projections.user_device_sessions.change_date,` +
+		` projections.user_device_sessions.access_token_expiration,` +
+		` projections.user_device_sessions.refresh_token_expiration` +
+		` FROM projections.user_device_sessions`
+	userDeviceSessionsCols = []string{
+		"id",
+		"type",
+		"resource_owner",
+		"session_id",
+		"client_id",
+		"fingerprint_id",
+		"ip",
+		"description",
+		"creation_date",
+		"change_date",
+		"access_token_expiration",
+		"refresh_token_expiration",
+	}
+)
+
+func Test_UserDeviceSessionsPrepares(t *testing.T) {
+	testNow := time.Now()
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserDeviceSessionsFromSessionsQuery no result",
+			prepare: prepareUserDeviceSessionsFromSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userDeviceSessionsFromSessionsQuery),
+					nil,
+					nil,
+				),
+			},
+			object: []*DeviceSession{},
+		},
+		{
+			name:    "prepareUserDeviceSessionsFromSessionsQuery one result",
+			prepare: prepareUserDeviceSessionsFromSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userDeviceSessionsFromSessionsQuery),
+					userDeviceSessionsFromSessionsCols,
+					[][]driver.Value{
+						{
+							"session-id",
+							"org-id",
+							"fingerprint-id",
+							"1.2.3.4",
+							"firefox",
+							testNow,
+							testNow,
+							nil,
+						},
+					},
+				),
+			},
+			object: []*DeviceSession{
+				{
+					ID:            "session-id",
+					Type:          domain.DeviceSessionTypeSession,
+					ResourceOwner: "org-id",
+					FingerprintID: "fingerprint-id",
+					IP:            "1.2.3.4",
+					Description:   "firefox",
+					CreationDate:  testNow,
+					LastUsed:      testNow,
+				},
+			},
+		},
+		{
+			name:    "prepareUserDeviceSessionsFromSessionsQuery sql err",
+			prepare: prepareUserDeviceSessionsFromSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(userDeviceSessionsFromSessionsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]*DeviceSession)(nil),
+		},
+		{
+			name:    "prepareUserDeviceSessionsQuery no result",
+			prepare: prepareUserDeviceSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userDeviceSessionsQuery),
+					nil,
+					nil,
+				),
+			},
+			object: []*DeviceSession{},
+		},
+		{
+			name:    "prepareUserDeviceSessionsQuery multiple results",
+			prepare: prepareUserDeviceSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userDeviceSessionsQuery),
+					userDeviceSessionsCols,
+					[][]driver.Value{
+						{
+							"oidc-session-id",
+							domain.DeviceSessionTypeOIDC,
+							"org-id",
+							"session-id",
+							"client-id",
+							"fingerprint-id",
+							"1.2.3.4",
+							"firefox",
+							testNow,
+							testNow,
+							testNow.Add(time.Hour),
+							testNow.Add(24 * time.Hour),
+						},
+						{
+							"saml-session-id",
+							domain.DeviceSessionTypeSAML,
+							"org-id",
+							"session-id",
+							"entity-id",
+							"fingerprint-id",
+							nil,
+							nil,
+							testNow,
+							testNow,
+							nil,
+							nil,
+						},
+					},
+				),
+			},
+			object: []*DeviceSession{
+				{
+					ID:            "oidc-session-id",
+					Type:          domain.DeviceSessionTypeOIDC,
+					ResourceOwner: "org-id",
+					SessionID:     "session-id",
+					ClientID:      "client-id",
+					FingerprintID: "fingerprint-id",
+					IP:            "1.2.3.4",
+					Description:   "firefox",
+					CreationDate:  testNow,
+					LastUsed:      testNow,
+					Expiration:    testNow.Add(24 * time.Hour),
+				},
+				{
+					ID:            "saml-session-id",
+					Type:          domain.DeviceSessionTypeSAML,
+					ResourceOwner: "org-id",
+					SessionID:     "session-id",
+					ClientID:      "entity-id",
+					FingerprintID: "fingerprint-id",
+					CreationDate:  testNow,
+					LastUsed:      testNow,
+				},
+			},
+		},
+		{
+			name:    "prepareUserDeviceSessionsQuery sql err",
+			prepare: prepareUserDeviceSessionsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(userDeviceSessionsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]*DeviceSession)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
+func Test_devicesFromSessions(t *testing.T) {
+	now := time.Now()
+	older := &DeviceSession{ID: "1", FingerprintID: "fp1", Description: "old", LastUsed: now.Add(-time.Hour)}
+	newer := &DeviceSession{ID: "2", FingerprintID: "fp1", Description: "new", IP: "1.2.3.4", LastUsed: now}
+	other := &DeviceSession{ID: "3", FingerprintID: "fp2", Description: "other", LastUsed: now.Add(-time.Minute)}
+
+	got := devicesFromSessions([]*DeviceSession{older, other, newer})
+	assert.Equal(t, []*UserDevice{
+		{
+			FingerprintID: "fp1",
+			Description:   "new",
+			IP:            "1.2.3.4",
+			LastUsed:      now,
+			Sessions:      []*DeviceSession{newer, older},
+		},
+		{
+			FingerprintID: "fp2",
+			Description:   "other",
+			LastUsed:      now.Add(-time.Minute),
+			Sessions:      []*DeviceSession{other},
+		},
+	}, got)
+}
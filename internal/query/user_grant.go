@@ -207,6 +207,17 @@ func NewUserGrantStateQuery(value domain.UserGrantState) (SearchQuery, error) {
 	return NewNumberQuery(UserGrantState, value, NumberEquals)
 }
 
+// NewUserGrantValidAtQuery only returns the user grants which are valid at the given time.
+func NewUserGrantValidAtQuery(t time.Time) (SearchQuery, error) {
+	return newValidAtQuery(UserGrantValidFrom, UserGrantValidUntil, t)
+}
+
+// NewUserGrantExpiryPendingQuery only returns the user grants whose validity ended before the given time,
+// but which weren't expired yet.
+func NewUserGrantExpiryPendingQuery(t time.Time) (SearchQuery, error) {
+	return newExpiryPendingQuery(UserGrantValidUntil, UserGrantExpired, t)
+}
+
 func NewUserGrantWithGrantedQuery(owner string) (SearchQuery, error) {
 	orgQuery, err := NewUserGrantResourceOwnerSearchQuery(owner)
 	if err != nil {
@@ -276,6 +287,18 @@ var (
 		name:  projection.UserGrantState,
 		table: userGrantTable,
 	}
+	UserGrantValidFrom = Column{
+		name:  projection.UserGrantValidFrom,
+		table: userGrantTable,
+	}
+	UserGrantValidUntil = Column{
+		name:  projection.UserGrantValidUntil,
+		table: userGrantTable,
+	}
+	UserGrantExpired = Column{
+		name:  projection.UserGrantExpired,
+		table: userGrantTable,
+	}
 
 	UserOrgsTable = table{
 		name:          projection.OrgProjectionTable,
@@ -0,0 +1,128 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// loginHistoryLimit is the maximum number of previous logins used as baseline for the risk evaluation.
+const loginHistoryLimit = 100
+
+var (
+	userLoginHistoryTable = table{
+		name:          projection.UserLoginHistoryProjectionTable,
+		instanceIDCol: projection.UserLoginHistoryColumnInstanceID,
+	}
+	UserLoginHistorySessionIDCol = Column{
+		name:  projection.UserLoginHistoryColumnSessionID,
+		table: userLoginHistoryTable,
+	}
+	UserLoginHistoryUserIDCol = Column{
+		name:  projection.UserLoginHistoryColumnUserID,
+		table: userLoginHistoryTable,
+	}
+	UserLoginHistoryChangeDateCol = Column{
+		name:  projection.UserLoginHistoryColumnChangeDate,
+		table: userLoginHistoryTable,
+	}
+	UserLoginHistoryInstanceIDCol = Column{
+		name:  projection.UserLoginHistoryColumnInstanceID,
+		table: userLoginHistoryTable,
+	}
+	UserLoginHistoryFingerprintIDCol = Column{
+		name:  projection.UserLoginHistoryColumnFingerprintID,
+		table: userLoginHistoryTable,
+	}
+	UserLoginHistoryIPCol = Column{
+		name:  projection.UserLoginHistoryColumnIP,
+		table: userLoginHistoryTable,
+	}
+	UserLoginHistoryLocationCol = Column{
+		name:  projection.UserLoginHistoryColumnLocation,
+		table: userLoginHistoryTable,
+	}
+)
+
+// LoginHistory returns the most recent logins of the user, used by the risk evaluation of session checks.
+// The session currently evaluated is excluded.
+func (q *Queries) LoginHistory(ctx context.Context, userID, excludeSessionID string) (logins []*risk.Login, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareLoginHistoryQuery()
+	stmt, args, err := query.Where(sq.And{
+		sq.Eq{
+			UserLoginHistoryUserIDCol.identifier():     userID,
+			UserLoginHistoryInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+		},
+		sq.NotEq{UserLoginHistorySessionIDCol.identifier(): excludeSessionID},
+	}).OrderBy(UserLoginHistoryChangeDateCol.identifier() + " DESC").
+		Limit(loginHistoryLimit).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Eeh5a", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		logins, err = scan(rows)
+		return err
+	}, stmt, args...)
+	return logins, err
+}
+
+func prepareLoginHistoryQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*risk.Login, error)) {
+	return sq.Select(
+			UserLoginHistoryFingerprintIDCol.identifier(),
+			UserLoginHistoryIPCol.identifier(),
+			UserLoginHistoryLocationCol.identifier(),
+			UserLoginHistoryChangeDateCol.identifier(),
+		).
+			From(userLoginHistoryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*risk.Login, error) {
+			logins := make([]*risk.Login, 0)
+			for rows.Next() {
+				var (
+					login    = new(risk.Login)
+					location []byte
+				)
+				err := rows.Scan(
+					&login.FingerprintID,
+					&login.IP,
+					&location,
+					&login.Time,
+				)
+				if err != nil {
+					return nil, err
+				}
+				if len(location) > 0 {
+					var geoLocation *domain.GeoLocation
+					if err := json.Unmarshal(location, &geoLocation); err != nil {
+						return nil, zerrors.ThrowInternal(err, "QUERY-ieW4a", "Errors.Internal")
+					}
+					if geoLocation != nil {
+						login.Country = geoLocation.Country
+						login.ASN = geoLocation.ASN
+						login.Latitude = geoLocation.Latitude
+						login.Longitude = geoLocation.Longitude
+					}
+				}
+				logins = append(logins, login)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-aiG4e", "Errors.Query.CloseRows")
+			}
+			return logins, nil
+		}
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/risk"
+)
+
+var (
+	loginHistoryQuery = `SELECT projections.user_login_history.fingerprint_id,` +
+		` projections.user_login_history.ip,` +
+		` projections.user_login_history.location,` +
+		` projections.user_login_history.change_date` +
+		` FROM projections.user_login_history`
+	loginHistoryCols = []string{
+		"fingerprint_id",
+		"ip",
+		"location",
+		"change_date",
+	}
+)
+
+func Test_LoginHistoryPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareLoginHistoryQuery no result",
+			prepare: prepareLoginHistoryQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(loginHistoryQuery),
+					nil,
+					nil,
+				),
+			},
+			object: []*risk.Login{},
+		},
+		{
+			name:    "prepareLoginHistoryQuery multiple results",
+			prepare: prepareLoginHistoryQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(loginHistoryQuery),
+					loginHistoryCols,
+					[][]driver.Value{
+						{
+							"fingerprint-id",
+							"192.0.2.1",
+							[]byte(`{"country":"CH","asn":65536,"latitude":47.37,"longitude":8.54}`),
+							testNow,
+						},
+						{
+							"fingerprint-id",
+							"192.0.2.2",
+							[]byte(`null`),
+							testNow,
+						},
+					},
+				),
+			},
+			object: []*risk.Login{
+				{
+					FingerprintID: "fingerprint-id",
+					IP:            "192.0.2.1",
+					Country:       "CH",
+					ASN:           65536,
+					Latitude:      47.37,
+					Longitude:     8.54,
+					Time:          testNow,
+				},
+				{
+					FingerprintID: "fingerprint-id",
+					IP:            "192.0.2.2",
+					Time:          testNow,
+				},
+			},
+		},
+		{
+			name:    "prepareLoginHistoryQuery sql err",
+			prepare: prepareLoginHistoryQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(loginHistoryQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]*risk.Login)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -28,6 +28,9 @@ type Membership struct {
 	ChangeDate    time.Time
 	Sequence      uint64
 	ResourceOwner string
+	// ValidFrom and ValidUntil are zero if the membership isn't limited on that side.
+	ValidFrom  time.Time
+	ValidUntil time.Time
 
 	Org          *OrgMembership
 	IAM          *IAMMembership
@@ -86,6 +89,15 @@ func NewMembershipResourceOwnersSearchQuery(ids ...string) (SearchQuery, error)
 	return NewListQuery(membershipResourceOwner, list, ListIn)
 }
 
+// NewMembershipOrgIDsSearchQuery only returns the memberships of the organizations, e.g. of the parents of an organization.
+func NewMembershipOrgIDsSearchQuery(ids ...string) (SearchQuery, error) {
+	list := make([]interface{}, len(ids))
+	for i, value := range ids {
+		list[i] = value
+	}
+	return NewListQuery(membershipOrgID, list, ListIn)
+}
+
 func NewMembershipGrantedOrgIDSearchQuery(id string) (SearchQuery, error) {
 	return NewTextQuery(ProjectGrantColumnGrantedOrgID, id, TextEquals)
 }
@@ -110,6 +122,17 @@ func NewMembershipRoleQuery(role string) (SearchQuery, error) {
 	return NewTextQuery(membershipRoles, role, TextListContains)
 }
 
+// NewMembershipValidAtQuery only returns the memberships which are valid at the given time.
+func NewMembershipValidAtQuery(t time.Time) (SearchQuery, error) {
+	return newValidAtQuery(membershipValidFrom, membershipValidUntil, t)
+}
+
+// NewMembershipExpiryPendingQuery only returns the memberships whose validity ended before the given time,
+// but which weren't expired yet.
+func NewMembershipExpiryPendingQuery(t time.Time) (SearchQuery, error) {
+	return newExpiryPendingQuery(membershipValidUntil, membershipExpired, t)
+}
+
 func (q *MembershipSearchQuery) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
 	query = q.SearchRequest.toQuery(query)
 	for _, q := range q.Queries {
@@ -226,6 +249,18 @@ var (
 		name:  projection.ProjectGrantMemberGrantIDCol,
 		table: membershipAlias,
 	}
+	membershipValidFrom = Column{
+		name:  projection.MemberValidFrom,
+		table: membershipAlias,
+	}
+	membershipValidUntil = Column{
+		name:  projection.MemberValidUntil,
+		table: membershipAlias,
+	}
+	membershipExpired = Column{
+		name:  projection.MemberExpired,
+		table: membershipAlias,
+	}
 	membershipGrantGrantedOrgID = Column{
 		name:  projection.ProjectGrantColumnGrantedOrgID,
 		table: membershipAlias,
@@ -265,6 +300,8 @@ func prepareMembershipsQuery(ctx context.Context, queries *MembershipSearchQuery
 			membershipIAMID.identifier(),
 			membershipProjectID.identifier(),
 			membershipGrantID.identifier(),
+			membershipValidFrom.identifier(),
+			membershipValidUntil.identifier(),
 			ProjectGrantColumnGrantedOrgID.identifier(),
 			ProjectColumnName.identifier(),
 			OrgColumnName.identifier(),
@@ -288,6 +325,8 @@ func prepareMembershipsQuery(ctx context.Context, queries *MembershipSearchQuery
 					instanceID   = sql.NullString{}
 					projectID    = sql.NullString{}
 					grantID      = sql.NullString{}
+					validFrom    = sql.NullTime{}
+					validUntil   = sql.NullTime{}
 					grantedOrgID = sql.NullString{}
 					projectName  = sql.NullString{}
 					orgName      = sql.NullString{}
@@ -305,6 +344,8 @@ func prepareMembershipsQuery(ctx context.Context, queries *MembershipSearchQuery
 					&instanceID,
 					&projectID,
 					&grantID,
+					&validFrom,
+					&validUntil,
 					&grantedOrgID,
 					&projectName,
 					&orgName,
@@ -316,6 +357,8 @@ func prepareMembershipsQuery(ctx context.Context, queries *MembershipSearchQuery
 					return nil, err
 				}
 
+				membership.ValidFrom = validFrom.Time
+				membership.ValidUntil = validUntil.Time
 				if orgID.Valid {
 					membership.Org = &OrgMembership{
 						OrgID: orgID.String,
@@ -369,6 +412,9 @@ func prepareOrgMember(ctx context.Context, query *MembershipSearchQuery, permiss
 		"NULL::TEXT AS "+membershipIAMID.name,
 		"NULL::TEXT AS "+membershipProjectID.name,
 		"NULL::TEXT AS "+membershipGrantID.name,
+		membershipValidFrom.identifier(),
+		membershipValidUntil.identifier(),
+		membershipExpired.identifier(),
 	).From(orgMemberTable.identifier())
 	builder = administratorOrgPermissionCheckV2(ctx, builder, permissionV2)
 
@@ -393,6 +439,9 @@ func prepareIAMMember(ctx context.Context, query *MembershipSearchQuery, permiss
 		InstanceMemberIAMID.identifier(),
 		"NULL::TEXT AS "+membershipProjectID.name,
 		"NULL::TEXT AS "+membershipGrantID.name,
+		membershipValidFrom.identifier(),
+		membershipValidUntil.identifier(),
+		membershipExpired.identifier(),
 	).From(instanceMemberTable.identifier())
 	builder = administratorInstancePermissionCheckV2(ctx, builder, permissionV2)
 
@@ -417,6 +466,9 @@ func prepareProjectMember(ctx context.Context, query *MembershipSearchQuery, per
 		"NULL::TEXT AS "+membershipIAMID.name,
 		ProjectMemberProjectID.identifier(),
 		"NULL::TEXT AS "+membershipGrantID.name,
+		membershipValidFrom.identifier(),
+		membershipValidUntil.identifier(),
+		membershipExpired.identifier(),
 	).From(projectMemberTable.identifier())
 	builder = administratorProjectPermissionCheckV2(ctx, builder, permissionV2)
 
@@ -442,6 +494,9 @@ func prepareProjectGrantMember(ctx context.Context, query *MembershipSearchQuery
 		"NULL::TEXT AS "+membershipIAMID.name,
 		ProjectGrantMemberProjectID.identifier(),
 		ProjectGrantMemberGrantID.identifier(),
+		membershipValidFrom.identifier(),
+		membershipValidUntil.identifier(),
+		membershipExpired.identifier(),
 	).From(projectGrantMemberTable.identifier())
 	builder = administratorProjectGrantPermissionCheckV2(ctx, builder, permissionV2)
 
@@ -26,6 +26,8 @@ var (
 			", members.id" +
 			", members.project_id" +
 			", members.grant_id" +
+			", members.valid_from" +
+			", members.valid_until" +
 			", projections.project_grants4.granted_org_id" +
 			", projections.projects4.name" +
 			", projections.orgs1.name" +
@@ -43,6 +45,9 @@ var (
 			", NULL::TEXT AS id" +
 			", NULL::TEXT AS project_id" +
 			", NULL::TEXT AS grant_id" +
+			", members.valid_from" +
+			", members.valid_until" +
+			", members.expired" +
 			" FROM projections.org_members4 AS members" +
 			" UNION ALL " +
 			"SELECT members.user_id" +
@@ -56,6 +61,9 @@ var (
 			", members.id" +
 			", NULL::TEXT AS project_id" +
 			", NULL::TEXT AS grant_id" +
+			", members.valid_from" +
+			", members.valid_until" +
+			", members.expired" +
 			" FROM projections.instance_members4 AS members" +
 			" UNION ALL " +
 			"SELECT members.user_id" +
@@ -69,6 +77,9 @@ var (
 			", NULL::TEXT AS id" +
 			", members.project_id" +
 			", NULL::TEXT AS grant_id" +
+			", members.valid_from" +
+			", members.valid_until" +
+			", members.expired" +
 			" FROM projections.project_members4 AS members" +
 			" UNION ALL " +
 			"SELECT members.user_id" +
@@ -82,6 +93,9 @@ var (
 			", NULL::TEXT AS id" +
 			", members.project_id" +
 			", members.grant_id" +
+			", members.valid_from" +
+			", members.valid_until" +
+			", members.expired" +
 			" FROM projections.project_grant_members4 AS members" +
 			") AS members" +
 			" LEFT JOIN projections.projects4 ON members.project_id = projections.projects4.id AND members.instance_id = projections.projects4.instance_id" +
@@ -99,6 +113,8 @@ var (
 		"instance_id",
 		"project_id",
 		"grant_id",
+		"valid_from",
+		"valid_until",
 		"granted_org_id",
 		"name", //project name
 		"name", //org name
@@ -151,6 +167,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							"org-name",
 							nil,
 						},
@@ -196,6 +214,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							"instance",
 						},
 					},
@@ -238,6 +258,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							"project-id",
 							nil,
 							nil,
+							nil,
+							nil,
 							"project-name",
 							nil,
 							nil,
@@ -281,6 +303,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							nil,
 							"project-id",
 							"grant-id",
+							nil,
+							nil,
 							"granted-org-id",
 							"project-name",
 							nil,
@@ -332,6 +356,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							"org-name",
 							nil,
 						},
@@ -349,6 +375,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							"instance",
 						},
 						{
@@ -363,6 +391,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							"project-id",
 							nil,
 							nil,
+							nil,
+							nil,
 							"project-name",
 							nil,
 							nil,
@@ -378,6 +408,8 @@ func Test_MembershipPrepares(t *testing.T) {
 							nil,
 							"project-id",
 							"grant-id",
+							nil,
+							nil,
 							"granted-org-id",
 							"project-name",
 							nil,
@@ -0,0 +1,134 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type PasswordHashStatistics struct {
+	Statistics []*PasswordHashStatistic
+}
+
+// PasswordHashStatistic is the amount of users of an organization,
+// whose current password hash uses the algorithm with the cost parameters.
+type PasswordHashStatistic struct {
+	ResourceOwner string
+	Algorithm     string
+	Cost          string
+	UserCount     uint64
+	// Insecure is set for algorithms which are only supported for verification,
+	// see [crypto.PasswordHashInfo.Insecure].
+	Insecure bool
+}
+
+var (
+	userPasswordHashTable = table{
+		name:          projection.UserPasswordHashProjectionTable,
+		instanceIDCol: projection.UserPasswordHashColumnInstanceID,
+	}
+	UserPasswordHashUserIDCol = Column{
+		name:  projection.UserPasswordHashColumnUserID,
+		table: userPasswordHashTable,
+	}
+	UserPasswordHashChangeDateCol = Column{
+		name:  projection.UserPasswordHashColumnChangeDate,
+		table: userPasswordHashTable,
+	}
+	UserPasswordHashSequenceCol = Column{
+		name:  projection.UserPasswordHashColumnSequence,
+		table: userPasswordHashTable,
+	}
+	UserPasswordHashResourceOwnerCol = Column{
+		name:  projection.UserPasswordHashColumnResourceOwner,
+		table: userPasswordHashTable,
+	}
+	UserPasswordHashInstanceIDCol = Column{
+		name:  projection.UserPasswordHashColumnInstanceID,
+		table: userPasswordHashTable,
+	}
+	UserPasswordHashAlgorithmCol = Column{
+		name:  projection.UserPasswordHashColumnAlgorithm,
+		table: userPasswordHashTable,
+	}
+	UserPasswordHashCostCol = Column{
+		name:  projection.UserPasswordHashColumnCost,
+		table: userPasswordHashTable,
+	}
+)
+
+// PasswordHashStatisticsByOrg returns the amount of users per organization, hash algorithm and cost parameters.
+// If orgID is empty, all organizations of the instance are returned.
+func (q *Queries) PasswordHashStatisticsByOrg(ctx context.Context, orgID string) (statistics *PasswordHashStatistics, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{UserPasswordHashInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID()}
+	if orgID != "" {
+		eq[UserPasswordHashResourceOwnerCol.identifier()] = orgID
+	}
+	query, scan := preparePasswordHashStatisticsQuery()
+	stmt, args, err := query.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ohc5a", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		statistics, err = scan(rows)
+		return err
+	}, stmt, args...)
+	return statistics, err
+}
+
+func preparePasswordHashStatisticsQuery() (sq.SelectBuilder, func(*sql.Rows) (*PasswordHashStatistics, error)) {
+	return sq.Select(
+			UserPasswordHashResourceOwnerCol.identifier(),
+			UserPasswordHashAlgorithmCol.identifier(),
+			UserPasswordHashCostCol.identifier(),
+			"COUNT("+UserPasswordHashUserIDCol.identifier()+")",
+		).
+			From(userPasswordHashTable.identifier()).
+			GroupBy(
+				UserPasswordHashResourceOwnerCol.identifier(),
+				UserPasswordHashAlgorithmCol.identifier(),
+				UserPasswordHashCostCol.identifier(),
+			).
+			OrderBy(
+				UserPasswordHashResourceOwnerCol.identifier(),
+				UserPasswordHashAlgorithmCol.identifier(),
+				UserPasswordHashCostCol.identifier(),
+			).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*PasswordHashStatistics, error) {
+			statistics := make([]*PasswordHashStatistic, 0)
+			for rows.Next() {
+				statistic := new(PasswordHashStatistic)
+				err := rows.Scan(
+					&statistic.ResourceOwner,
+					&statistic.Algorithm,
+					&statistic.Cost,
+					&statistic.UserCount,
+				)
+				if err != nil {
+					return nil, err
+				}
+				statistic.Insecure = crypto.PasswordHashInfo{Algorithm: statistic.Algorithm, Cost: statistic.Cost}.Insecure()
+				statistics = append(statistics, statistic)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-ieG0u", "Errors.Query.CloseRows")
+			}
+
+			return &PasswordHashStatistics{
+				Statistics: statistics,
+			}, nil
+		}
+}
@@ -0,0 +1,114 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	passwordHashStatisticsQuery = `SELECT projections.user_password_hashes.resource_owner,` +
+		` projections.user_password_hashes.algorithm,` +
+		` projections.user_password_hashes.cost,` +
+		` COUNT(projections.user_password_hashes.user_id)` +
+		` FROM projections.user_password_hashes` +
+		` GROUP BY projections.user_password_hashes.resource_owner, projections.user_password_hashes.algorithm, projections.user_password_hashes.cost` +
+		` ORDER BY projections.user_password_hashes.resource_owner, projections.user_password_hashes.algorithm, projections.user_password_hashes.cost`
+	passwordHashStatisticsCols = []string{
+		"resource_owner",
+		"algorithm",
+		"cost",
+		"count",
+	}
+)
+
+func Test_PasswordHashStatisticsPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "preparePasswordHashStatisticsQuery no result",
+			prepare: preparePasswordHashStatisticsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(passwordHashStatisticsQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &PasswordHashStatistics{Statistics: []*PasswordHashStatistic{}},
+		},
+		{
+			name:    "preparePasswordHashStatisticsQuery multiple results",
+			prepare: preparePasswordHashStatisticsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(passwordHashStatisticsQuery),
+					passwordHashStatisticsCols,
+					[][]driver.Value{
+						{
+							"org-id",
+							"bcrypt",
+							"12",
+							uint64(10),
+						},
+						{
+							"org-id",
+							"md5plain",
+							"",
+							uint64(2),
+						},
+					},
+				),
+			},
+			object: &PasswordHashStatistics{
+				Statistics: []*PasswordHashStatistic{
+					{
+						ResourceOwner: "org-id",
+						Algorithm:     "bcrypt",
+						Cost:          "12",
+						UserCount:     10,
+					},
+					{
+						ResourceOwner: "org-id",
+						Algorithm:     "md5plain",
+						UserCount:     2,
+						Insecure:      true,
+					},
+				},
+			},
+		},
+		{
+			name:    "preparePasswordHashStatisticsQuery sql err",
+			prepare: preparePasswordHashStatisticsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(passwordHashStatisticsQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*PasswordHashStatistics)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
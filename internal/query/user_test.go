@@ -156,7 +156,8 @@ var (
 		` projections.users14.sequence,` +
 		` projections.users14_humans.user_id,` +
 		` projections.users14_humans.phone,` +
-		` projections.users14_humans.is_phone_verified` +
+		` projections.users14_humans.is_phone_verified,` +
+		` projections.users14_humans.phone_channel` +
 		` FROM projections.users14` +
 		` LEFT JOIN projections.users14_humans ON projections.users14.id = projections.users14_humans.user_id AND projections.users14.instance_id = projections.users14_humans.instance_id`
 	phoneCols = []string{
@@ -168,6 +169,7 @@ var (
 		"user_id",
 		"phone",
 		"is_phone_verified",
+		"phone_channel",
 	}
 	userUniqueQuery = `SELECT projections.users14.id,` +
 		` projections.users14.state,` +
@@ -203,6 +205,7 @@ var (
 		` projections.users14_humans.preferred_language,` +
 		` projections.users14_humans.gender,` +
 		` projections.users14_humans.avatar_key,` +
+		` projections.users14_humans.phone_channel,` +
 		` projections.users14_notifications.user_id,` +
 		` projections.users14_notifications.last_email,` +
 		` projections.users14_notifications.verified_email,` +
@@ -234,6 +237,7 @@ var (
 		"preferred_language",
 		"gender",
 		"avatar_key",
+		"phone_channel",
 		// service account
 		"user_id",
 		"last_email",
@@ -567,6 +571,7 @@ func Test_UserPrepares(t *testing.T) {
 						"id",
 						"phone",
 						true,
+						domain.PhoneChannelWhatsApp,
 					},
 				),
 			},
@@ -579,6 +584,7 @@ func Test_UserPrepares(t *testing.T) {
 				//State:              domain.UserStateActive,
 				Phone:      "phone",
 				IsVerified: true,
+				Channel:    domain.PhoneChannelWhatsApp,
 			},
 		},
 		{
@@ -598,6 +604,7 @@ func Test_UserPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 					},
 				),
 				err: func(err error) (error, bool) {
@@ -725,6 +732,7 @@ func Test_UserPrepares(t *testing.T) {
 						"de",
 						domain.GenderUnspecified,
 						"avatar_key",
+						domain.PhoneChannelUnspecified,
 						//notify
 						"id",
 						"lastEmail",
@@ -788,6 +796,7 @@ func Test_UserPrepares(t *testing.T) {
 						"de",
 						domain.GenderUnspecified,
 						"avatar_key",
+						domain.PhoneChannelUnspecified,
 						nil,
 						nil,
 						nil,
@@ -0,0 +1,168 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type UserTrustedDevices struct {
+	SearchResponse
+	TrustedDevices []*UserTrustedDevice
+}
+
+// UserTrustedDevice is a user agent on which the user may skip the multi-factor check until the expiration date.
+type UserTrustedDevice struct {
+	UserID         string
+	FingerprintID  string
+	CreationDate   time.Time
+	ChangeDate     time.Time
+	Sequence       uint64
+	ResourceOwner  string
+	Description    string
+	ExpirationDate time.Time
+}
+
+var (
+	userTrustedDeviceTable = table{
+		name:          projection.UserTrustedDeviceProjectionTable,
+		instanceIDCol: projection.UserTrustedDeviceColumnInstanceID,
+	}
+	UserTrustedDeviceUserIDCol = Column{
+		name:  projection.UserTrustedDeviceColumnUserID,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceFingerprintIDCol = Column{
+		name:  projection.UserTrustedDeviceColumnFingerprintID,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceCreationDateCol = Column{
+		name:  projection.UserTrustedDeviceColumnCreationDate,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceChangeDateCol = Column{
+		name:  projection.UserTrustedDeviceColumnChangeDate,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceSequenceCol = Column{
+		name:  projection.UserTrustedDeviceColumnSequence,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceResourceOwnerCol = Column{
+		name:  projection.UserTrustedDeviceColumnResourceOwner,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceInstanceIDCol = Column{
+		name:  projection.UserTrustedDeviceColumnInstanceID,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceDescriptionCol = Column{
+		name:  projection.UserTrustedDeviceColumnDescription,
+		table: userTrustedDeviceTable,
+	}
+	UserTrustedDeviceExpirationDateCol = Column{
+		name:  projection.UserTrustedDeviceColumnExpirationDate,
+		table: userTrustedDeviceTable,
+	}
+)
+
+// UserTrustedDevicesByUserID returns all devices the user currently trusts.
+// Devices with an expired trust are omitted and devices the caller is not allowed to see are filtered out by the permissionCheck.
+func (q *Queries) UserTrustedDevicesByUserID(ctx context.Context, shouldTriggerBulk bool, userID string, permissionCheck domain.PermissionCheck) (devices *UserTrustedDevices, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerUserTrustedDeviceProjection")
+		ctx, err = projection.UserTrustedDeviceProjection.Trigger(ctx, handler.WithAwaitRunning())
+		logging.OnError(err).Debug("trigger failed")
+		traceSpan.EndWithError(err)
+	}
+
+	query, scan := prepareUserTrustedDevicesQuery()
+	stmt, args, err := query.Where(sq.And{
+		sq.Eq{
+			UserTrustedDeviceUserIDCol.identifier():     userID,
+			UserTrustedDeviceInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+		},
+		sq.Gt{UserTrustedDeviceExpirationDateCol.identifier(): time.Now()},
+	}).OrderBy(UserTrustedDeviceFingerprintIDCol.identifier()).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Aeb7u", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		devices, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	if permissionCheck != nil {
+		devices.TrustedDevices = slices.DeleteFunc(devices.TrustedDevices, func(device *UserTrustedDevice) bool {
+			return userCheckPermission(ctx, device.ResourceOwner, device.UserID, permissionCheck) != nil
+		})
+	}
+	devices.State, err = q.latestState(ctx, userTrustedDeviceTable)
+	return devices, err
+}
+
+func prepareUserTrustedDevicesQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserTrustedDevices, error)) {
+	return sq.Select(
+			UserTrustedDeviceUserIDCol.identifier(),
+			UserTrustedDeviceFingerprintIDCol.identifier(),
+			UserTrustedDeviceCreationDateCol.identifier(),
+			UserTrustedDeviceChangeDateCol.identifier(),
+			UserTrustedDeviceSequenceCol.identifier(),
+			UserTrustedDeviceResourceOwnerCol.identifier(),
+			UserTrustedDeviceDescriptionCol.identifier(),
+			UserTrustedDeviceExpirationDateCol.identifier(),
+			countColumn.identifier(),
+		).
+			From(userTrustedDeviceTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*UserTrustedDevices, error) {
+			devices := make([]*UserTrustedDevice, 0)
+			var count uint64
+			for rows.Next() {
+				device := new(UserTrustedDevice)
+				err := rows.Scan(
+					&device.UserID,
+					&device.FingerprintID,
+					&device.CreationDate,
+					&device.ChangeDate,
+					&device.Sequence,
+					&device.ResourceOwner,
+					&device.Description,
+					&device.ExpirationDate,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				devices = append(devices, device)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Voo3e", "Errors.Query.CloseRows")
+			}
+
+			return &UserTrustedDevices{
+				TrustedDevices: devices,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
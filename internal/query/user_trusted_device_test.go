@@ -0,0 +1,121 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+var (
+	userTrustedDevicesQuery = `SELECT projections.user_trusted_devices.user_id,` +
+		` projections.user_trusted_devices.fingerprint_id,` +
+		` projections.user_trusted_devices.creation_date,` +
+		` projections.user_trusted_devices.change_date,` +
+		` projections.user_trusted_devices.sequence,` +
+		` projections.user_trusted_devices.resource_owner,` +
+		` projections.user_trusted_devices.description,` +
+		` projections.user_trusted_devices.expiration_date,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.user_trusted_devices`
+	userTrustedDevicesCols = []string{
+		"user_id",
+		"fingerprint_id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"description",
+		"expiration_date",
+		"count",
+	}
+)
+
+func Test_UserTrustedDevicePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareUserTrustedDevicesQuery no result",
+			prepare: prepareUserTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userTrustedDevicesQuery),
+					nil,
+					nil,
+				),
+			},
+			object: &UserTrustedDevices{TrustedDevices: []*UserTrustedDevice{}},
+		},
+		{
+			name:    "prepareUserTrustedDevicesQuery one result",
+			prepare: prepareUserTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(userTrustedDevicesQuery),
+					userTrustedDevicesCols,
+					[][]driver.Value{
+						{
+							"user-id",
+							"fingerprint-id",
+							testNow,
+							testNow,
+							uint64(20211108),
+							"resource_owner",
+							"firefox",
+							testNow,
+						},
+					},
+				),
+			},
+			object: &UserTrustedDevices{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				TrustedDevices: []*UserTrustedDevice{
+					{
+						UserID:         "user-id",
+						FingerprintID:  "fingerprint-id",
+						CreationDate:   testNow,
+						ChangeDate:     testNow,
+						Sequence:       20211108,
+						ResourceOwner:  "resource_owner",
+						Description:    "firefox",
+						ExpirationDate: testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareUserTrustedDevicesQuery sql err",
+			prepare: prepareUserTrustedDevicesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(userTrustedDevicesQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*UserTrustedDevices)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
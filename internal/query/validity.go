@@ -0,0 +1,47 @@
+package query
+
+import "time"
+
+// newValidAtQuery returns the query for the entries which are valid at the given time.
+// Columns without value don't limit the validity.
+func newValidAtQuery(validFrom, validUntil Column, t time.Time) (SearchQuery, error) {
+	noValidFrom, err := NewIsNullQuery(validFrom)
+	if err != nil {
+		return nil, err
+	}
+	started, err := NewTimestampQuery(validFrom, t, TimestampLessOrEquals)
+	if err != nil {
+		return nil, err
+	}
+	noValidUntil, err := NewIsNullQuery(validUntil)
+	if err != nil {
+		return nil, err
+	}
+	notEnded, err := NewTimestampQuery(validUntil, t, TimestampGreater)
+	if err != nil {
+		return nil, err
+	}
+	startedQuery, err := NewOrQuery(noValidFrom, started)
+	if err != nil {
+		return nil, err
+	}
+	notEndedQuery, err := NewOrQuery(noValidUntil, notEnded)
+	if err != nil {
+		return nil, err
+	}
+	return NewAndQuery(startedQuery, notEndedQuery)
+}
+
+// newExpiryPendingQuery returns the query for the entries whose validity ended before the given time,
+// but the expiry wasn't pushed yet.
+func newExpiryPendingQuery(validUntil, expired Column, t time.Time) (SearchQuery, error) {
+	ended, err := NewTimestampQuery(validUntil, t, TimestampLessOrEquals)
+	if err != nil {
+		return nil, err
+	}
+	notExpired, err := NewBoolQuery(expired, false)
+	if err != nil {
+		return nil, err
+	}
+	return NewAndQuery(ended, notExpired)
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -16,7 +17,12 @@ func (q *Queries) MyZitadelPermissions(ctx context.Context, orgID, userID string
 	if err != nil {
 		return nil, err
 	}
-	orgIDsQuery, err := NewMembershipResourceOwnersSearchQuery(orgID, authz.GetInstance(ctx).InstanceID())
+	// the members of the parent organizations manage the organization as well
+	orgIDs, err := q.OrgHierarchyIDs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	orgIDsQuery, err := NewMembershipResourceOwnersSearchQuery(append(orgIDs, authz.GetInstance(ctx).InstanceID())...)
 	if err != nil {
 		return nil, err
 	}
@@ -24,8 +30,12 @@ func (q *Queries) MyZitadelPermissions(ctx context.Context, orgID, userID string
 	if err != nil {
 		return nil, err
 	}
+	validQuery, err := NewMembershipValidAtQuery(time.Now())
+	if err != nil {
+		return nil, err
+	}
 	memberships, err := q.Memberships(ctx, &MembershipSearchQuery{
-		Queries: []SearchQuery{userIDQuery, Or(orgIDsQuery, grantedOrgIDQuery)},
+		Queries: []SearchQuery{userIDQuery, Or(orgIDsQuery, grantedOrgIDQuery), validQuery},
 	}, false)
 	if err != nil {
 		return nil, err
@@ -17,6 +17,13 @@ const (
 	SessionLinkedType      = authRequestEventPrefix + "session.linked"
 	CodeExchangedType      = authRequestEventPrefix + "code.exchanged"
 	SucceededType          = authRequestEventPrefix + "succeeded"
+	PushedType             = authRequestEventPrefix + "pushed"
+	PushedUsedType         = authRequestEventPrefix + "pushed.used"
+
+	// UniquePushedUsed ensures a pushed authorization request can only be used once,
+	// even if the request_uri is redeemed concurrently.
+	UniquePushedUsed    = "pushed_auth_request_used"
+	DuplicatePushedUsed = "Errors.AuthRequest.Pushed.AlreadyUsed"
 )
 
 type AddedEvent struct {
@@ -40,6 +47,14 @@ type AddedEvent struct {
 	NeedRefreshToken bool                      `json:"need_refresh_token,omitempty"`
 	Issuer           string                    `json:"issuer,omitempty"`
 	OrganizationID   string                    `json:"organization_id,omitempty"`
+	// AuthorizationDetails requested by the client (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
+	// RequireConsent is set if the client requires the user to consent to the requested scopes
+	RequireConsent bool `json:"require_consent,omitempty"`
+	// Resources requested by the client (RFC 8707)
+	Resources []string `json:"resources,omitempty"`
+	// ACRValues requested by the client in order of preference
+	ACRValues []string `json:"acr_values,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -70,6 +85,10 @@ func NewAddedEvent(ctx context.Context,
 	needRefreshToken bool,
 	issuer,
 	organizationID string,
+	authorizationDetails domain.AuthorizationDetails,
+	requireConsent bool,
+	resources []string,
+	acrValues []string,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -77,24 +96,28 @@ func NewAddedEvent(ctx context.Context,
 			aggregate,
 			AddedType,
 		),
-		LoginClient:      loginClient,
-		ClientID:         clientID,
-		RedirectURI:      redirectURI,
-		State:            state,
-		Nonce:            nonce,
-		Scope:            scope,
-		Audience:         audience,
-		ResponseType:     responseType,
-		ResponseMode:     responseMode,
-		CodeChallenge:    codeChallenge,
-		Prompt:           prompt,
-		UILocales:        uiLocales,
-		MaxAge:           maxAge,
-		LoginHint:        loginHint,
-		HintUserID:       hintUserID,
-		NeedRefreshToken: needRefreshToken,
-		Issuer:           issuer,
-		OrganizationID:   organizationID,
+		LoginClient:          loginClient,
+		ClientID:             clientID,
+		RedirectURI:          redirectURI,
+		State:                state,
+		Nonce:                nonce,
+		Scope:                scope,
+		Audience:             audience,
+		ResponseType:         responseType,
+		ResponseMode:         responseMode,
+		CodeChallenge:        codeChallenge,
+		Prompt:               prompt,
+		UILocales:            uiLocales,
+		MaxAge:               maxAge,
+		LoginHint:            loginHint,
+		HintUserID:           hintUserID,
+		NeedRefreshToken:     needRefreshToken,
+		Issuer:               issuer,
+		OrganizationID:       organizationID,
+		AuthorizationDetails: authorizationDetails,
+		RequireConsent:       requireConsent,
+		Resources:            resources,
+		ACRValues:            acrValues,
 	}
 }
 
@@ -117,6 +140,8 @@ type SessionLinkedEvent struct {
 	UserID      string                      `json:"user_id"`
 	AuthTime    time.Time                   `json:"auth_time"`
 	AuthMethods []domain.UserAuthMethodType `json:"auth_methods"`
+	// ACR is the requested acr value satisfied by the session
+	ACR string `json:"acr,omitempty"`
 }
 
 func (e *SessionLinkedEvent) Payload() interface{} {
@@ -133,6 +158,7 @@ func NewSessionLinkedEvent(ctx context.Context,
 	userID string,
 	authTime time.Time,
 	authMethods []domain.UserAuthMethodType,
+	acr string,
 ) *SessionLinkedEvent {
 	return &SessionLinkedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -144,6 +170,7 @@ func NewSessionLinkedEvent(ctx context.Context,
 		UserID:      userID,
 		AuthTime:    authTime,
 		AuthMethods: authMethods,
+		ACR:         acr,
 	}
 }
 
@@ -295,3 +322,83 @@ func SucceededEventMapper(event eventstore.Event) (eventstore.Event, error) {
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+// PushedEvent stores the parameters of a pushed authorization request (RFC 9126),
+// until they are used on the authorization endpoint through the issued request_uri.
+type PushedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID   string              `json:"client_id"`
+	Parameters map[string][]string `json:"parameters,omitempty"`
+	Expiration time.Time           `json:"expiration"`
+}
+
+func (e *PushedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPushedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	parameters map[string][]string,
+	expiration time.Time,
+) *PushedEvent {
+	return &PushedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedType,
+		),
+		ClientID:   clientID,
+		Parameters: parameters,
+		Expiration: expiration,
+	}
+}
+
+func PushedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	added := &PushedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(added)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "AUTHR-ooL4a", "unable to unmarshal pushed auth request")
+	}
+
+	return added, nil
+}
+
+type PushedUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PushedUsedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *PushedUsedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{
+		eventstore.NewAddEventUniqueConstraint(UniquePushedUsed, e.Aggregate().ID, DuplicatePushedUsed),
+	}
+}
+
+func NewPushedUsedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PushedUsedEvent {
+	return &PushedUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedUsedType,
+		),
+	}
+}
+
+func PushedUsedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &PushedUsedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -2,6 +2,7 @@ package instance
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/member"
@@ -12,6 +13,8 @@ const (
 	MemberChangedEventType        = instanceEventTypePrefix + member.ChangedEventType
 	MemberRemovedEventType        = instanceEventTypePrefix + member.RemovedEventType
 	MemberCascadeRemovedEventType = instanceEventTypePrefix + member.CascadeRemovedEventType
+	MemberValiditySetEventType    = instanceEventTypePrefix + member.ValiditySetEventType
+	MemberExpiredEventType        = instanceEventTypePrefix + member.ExpiredEventType
 )
 
 const (
@@ -158,3 +161,73 @@ func MemberCascadeRemovedEventMapper(event eventstore.Event) (eventstore.Event,
 
 	return &MemberCascadeRemovedEvent{MemberCascadeRemovedEvent: *e.(*member.MemberCascadeRemovedEvent)}, nil
 }
+
+type MemberValiditySetEvent struct {
+	member.MemberValiditySetEvent
+}
+
+func (e *MemberValiditySetEvent) Fields() []*eventstore.FieldOperation {
+	return e.FieldOperations(fieldPrefix)
+}
+
+func NewMemberValiditySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	validFrom,
+	validUntil time.Time,
+) *MemberValiditySetEvent {
+	return &MemberValiditySetEvent{
+		MemberValiditySetEvent: *member.NewMemberValiditySetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				MemberValiditySetEventType,
+			),
+			userID,
+			validFrom,
+			validUntil,
+		),
+	}
+}
+
+func MemberValiditySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := member.ValiditySetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemberValiditySetEvent{MemberValiditySetEvent: *e.(*member.MemberValiditySetEvent)}, nil
+}
+
+type MemberExpiredEvent struct {
+	member.MemberExpiredEvent
+}
+
+func NewMemberExpiredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	validUntil time.Time,
+) *MemberExpiredEvent {
+	return &MemberExpiredEvent{
+		MemberExpiredEvent: *member.NewMemberExpiredEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				MemberExpiredEventType,
+			),
+			userID,
+			validUntil,
+		),
+	}
+}
+
+func MemberExpiredEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := member.ExpiredEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemberExpiredEvent{MemberExpiredEvent: *e.(*member.MemberExpiredEvent)}, nil
+}
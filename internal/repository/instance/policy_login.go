@@ -38,6 +38,16 @@ func NewLoginPolicyAddedEvent(
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
 	multiFactorCheckLifetime time.Duration,
+	allowMagicLink bool,
+	trustedDeviceLifetime,
+	sessionIdleTimeout,
+	sessionMaxLifetime time.Duration,
+	maxConcurrentSessions uint64,
+	webAuthNAttestation domain.AttestationConveyance,
+	webAuthNUserVerification domain.UserVerificationRequirement,
+	webAuthNResidentKey domain.ResidentKeyRequirement,
+	webAuthNAllowedAAGUIDs,
+	webAuthNDeniedAAGUIDs []string,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		LoginPolicyAddedEvent: *policy.NewLoginPolicyAddedEvent(
@@ -61,7 +71,17 @@ func NewLoginPolicyAddedEvent(
 			externalLoginCheckLifetime,
 			mfaInitSkipLifetime,
 			secondFactorCheckLifetime,
-			multiFactorCheckLifetime),
+			multiFactorCheckLifetime,
+			allowMagicLink,
+			trustedDeviceLifetime,
+			sessionIdleTimeout,
+			sessionMaxLifetime,
+			maxConcurrentSessions,
+			webAuthNAttestation,
+			webAuthNUserVerification,
+			webAuthNResidentKey,
+			webAuthNAllowedAAGUIDs,
+			webAuthNDeniedAAGUIDs),
 	}
 }
 
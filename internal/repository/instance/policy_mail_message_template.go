@@ -0,0 +1,75 @@
+package instance
+
+import (
+	"context"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	MailMessageTemplateSetEventType     = instanceEventTypePrefix + policy.MailMessageTemplateSetEventType
+	MailMessageTemplateRemovedEventType = instanceEventTypePrefix + policy.MailMessageTemplateRemovedEventType
+)
+
+type MailMessageTemplateSetEvent struct {
+	policy.MailMessageTemplateSetEvent
+}
+
+func NewMailMessageTemplateSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	messageType string,
+	language language.Tag,
+	html,
+	plainText string,
+) *MailMessageTemplateSetEvent {
+	return &MailMessageTemplateSetEvent{
+		MailMessageTemplateSetEvent: *policy.NewMailMessageTemplateSetEvent(
+			eventstore.NewBaseEventForPush(ctx, aggregate, MailMessageTemplateSetEventType),
+			messageType,
+			language,
+			html,
+			plainText,
+		),
+	}
+}
+
+func MailMessageTemplateSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.MailMessageTemplateSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MailMessageTemplateSetEvent{MailMessageTemplateSetEvent: *e.(*policy.MailMessageTemplateSetEvent)}, nil
+}
+
+type MailMessageTemplateRemovedEvent struct {
+	policy.MailMessageTemplateRemovedEvent
+}
+
+func NewMailMessageTemplateRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	messageType string,
+	language language.Tag,
+) *MailMessageTemplateRemovedEvent {
+	return &MailMessageTemplateRemovedEvent{
+		MailMessageTemplateRemovedEvent: *policy.NewMailMessageTemplateRemovedEvent(
+			eventstore.NewBaseEventForPush(ctx, aggregate, MailMessageTemplateRemovedEventType),
+			messageType,
+			language,
+		),
+	}
+}
+
+func MailMessageTemplateRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.MailMessageTemplateRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MailMessageTemplateRemovedEvent{MailMessageTemplateRemovedEvent: *e.(*policy.MailMessageTemplateRemovedEvent)}, nil
+}
@@ -3,6 +3,7 @@ package instance
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/policy"
 )
@@ -19,7 +20,9 @@ type NotificationPolicyAddedEvent struct {
 func NewNotificationPolicyAddedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
-	passwordChange bool,
+	passwordChange,
+	securityNotifications bool,
+	phoneChannels []domain.PhoneChannel,
 ) *NotificationPolicyAddedEvent {
 	return &NotificationPolicyAddedEvent{
 		NotificationPolicyAddedEvent: *policy.NewNotificationPolicyAddedEvent(
@@ -27,7 +30,9 @@ func NewNotificationPolicyAddedEvent(
 				ctx,
 				aggregate,
 				NotificationPolicyAddedEventType),
-			passwordChange),
+			passwordChange,
+			securityNotifications,
+			phoneChannels),
 	}
 }
 
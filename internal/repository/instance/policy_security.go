@@ -2,7 +2,9 @@ package instance
 
 import (
 	"context"
+	"time"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -28,6 +30,19 @@ type SecurityPolicySetEvent struct {
 	// AllowUnauthenticatedDynamicClientRegistration additionally allows registration
 	// without an access token. It only has an effect if EnableDynamicClientRegistration.
 	AllowUnauthenticatedDynamicClientRegistration *bool `json:"allow_unauthenticated_dynamic_client_registration,omitempty"`
+	// RequireSoftwareStatement only allows registrations with a software statement
+	// signed by one of the SoftwareStatementIssuers.
+	RequireSoftwareStatement *bool                            `json:"require_software_statement,omitempty"`
+	SoftwareStatementIssuers *domain.SoftwareStatementIssuers `json:"software_statement_issuers,omitempty"`
+
+	// ACRDefinitions map the acr values clients can request to the required authentication level.
+	ACRDefinitions *domain.ACRDefinitions `json:"acr_definitions,omitempty"`
+
+	// PasswordRehashBefore forces password hashes set before this time
+	// to be re-hashed on the next successful password check.
+	PasswordRehashBefore *time.Time `json:"password_rehash_before,omitempty"`
+	// InsecurePasswordHashDeadline expires passwords still hashed with an insecure algorithm after this time.
+	InsecurePasswordHashDeadline *time.Time `json:"insecure_password_hash_deadline,omitempty"`
 }
 
 func NewSecurityPolicySetEvent(
@@ -86,6 +101,42 @@ func ChangeSecurityPolicyAllowUnauthenticatedDynamicClientRegistration(allow boo
 	}
 }
 
+func ChangeSecurityPolicyRequireSoftwareStatement(require bool) func(event *SecurityPolicySetEvent) {
+	return func(e *SecurityPolicySetEvent) {
+		e.RequireSoftwareStatement = &require
+	}
+}
+
+func ChangeSecurityPolicySoftwareStatementIssuers(issuers domain.SoftwareStatementIssuers) func(event *SecurityPolicySetEvent) {
+	return func(e *SecurityPolicySetEvent) {
+		if len(issuers) == 0 {
+			issuers = domain.SoftwareStatementIssuers{}
+		}
+		e.SoftwareStatementIssuers = &issuers
+	}
+}
+
+func ChangeSecurityPolicyACRDefinitions(definitions domain.ACRDefinitions) func(event *SecurityPolicySetEvent) {
+	return func(e *SecurityPolicySetEvent) {
+		if len(definitions) == 0 {
+			definitions = domain.ACRDefinitions{}
+		}
+		e.ACRDefinitions = &definitions
+	}
+}
+
+func ChangeSecurityPolicyPasswordRehashBefore(rehashBefore time.Time) func(event *SecurityPolicySetEvent) {
+	return func(e *SecurityPolicySetEvent) {
+		e.PasswordRehashBefore = &rehashBefore
+	}
+}
+
+func ChangeSecurityPolicyInsecurePasswordHashDeadline(deadline time.Time) func(event *SecurityPolicySetEvent) {
+	return func(e *SecurityPolicySetEvent) {
+		e.InsecurePasswordHashDeadline = &deadline
+	}
+}
+
 func (e *SecurityPolicySetEvent) Payload() interface{} {
 	return e
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -12,10 +13,16 @@ const (
 	smsConfigPrefix                      = "sms.config"
 	smsConfigTwilioPrefix                = "twilio."
 	smsConfigHTTPPrefix                  = "http."
+	smsConfigWhatsAppPrefix              = "whatsapp."
+	smsConfigVoicePrefix                 = "voice."
 	SMSConfigTwilioAddedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
 	SMSConfigTwilioChangedEventType      = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
 	SMSConfigHTTPAddedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
 	SMSConfigHTTPChangedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
+	SMSConfigWhatsAppAddedEventType      = instanceEventTypePrefix + smsConfigPrefix + smsConfigWhatsAppPrefix + "added"
+	SMSConfigWhatsAppChangedEventType    = instanceEventTypePrefix + smsConfigPrefix + smsConfigWhatsAppPrefix + "changed"
+	SMSConfigVoiceAddedEventType         = instanceEventTypePrefix + smsConfigPrefix + smsConfigVoicePrefix + "added"
+	SMSConfigVoiceChangedEventType       = instanceEventTypePrefix + smsConfigPrefix + smsConfigVoicePrefix + "changed"
 	SMSConfigTwilioTokenChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
 	SMSConfigTwilioActivatedEventType    = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
 	SMSConfigTwilioDeactivatedEventType  = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
@@ -304,12 +311,16 @@ func (e *SMSConfigTwilioActivatedEvent) UniqueConstraints() []*eventstore.Unique
 type SMSConfigActivatedEvent struct {
 	*eventstore.BaseEvent `json:"-"`
 	ID                    string `json:"id,omitempty"`
+	// Channel of the activated config, other configs of the same channel are deactivated.
+	// Activations without a channel are of the SMS channel.
+	Channel domain.PhoneChannel `json:"channel,omitempty"`
 }
 
 func NewSMSConfigActivatedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
 	id string,
+	channel domain.PhoneChannel,
 ) *SMSConfigActivatedEvent {
 	return &SMSConfigActivatedEvent{
 		BaseEvent: eventstore.NewBaseEventForPush(
@@ -317,7 +328,8 @@ func NewSMSConfigActivatedEvent(
 			aggregate,
 			SMSConfigActivatedEventType,
 		),
-		ID: id,
+		ID:      id,
+		Channel: channel,
 	}
 }
 
@@ -430,3 +442,245 @@ func (e *SMSConfigRemovedEvent) Payload() interface{} {
 func (e *SMSConfigRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
+
+type SMSConfigWhatsAppAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID               string              `json:"id,omitempty"`
+	Description      string              `json:"description,omitempty"`
+	PhoneNumberID    string              `json:"phoneNumberId,omitempty"`
+	AccessToken      *crypto.CryptoValue `json:"accessToken,omitempty"`
+	TemplateName     string              `json:"templateName,omitempty"`
+	TemplateLanguage string              `json:"templateLanguage,omitempty"`
+}
+
+func NewSMSConfigWhatsAppAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description,
+	phoneNumberID string,
+	accessToken *crypto.CryptoValue,
+	templateName,
+	templateLanguage string,
+) *SMSConfigWhatsAppAddedEvent {
+	return &SMSConfigWhatsAppAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigWhatsAppAddedEventType,
+		),
+		ID:               id,
+		Description:      description,
+		PhoneNumberID:    phoneNumberID,
+		AccessToken:      accessToken,
+		TemplateName:     templateName,
+		TemplateLanguage: templateLanguage,
+	}
+}
+
+func (e *SMSConfigWhatsAppAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigWhatsAppAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigWhatsAppAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigWhatsAppChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID               string              `json:"id,omitempty"`
+	Description      *string             `json:"description,omitempty"`
+	PhoneNumberID    *string             `json:"phoneNumberId,omitempty"`
+	AccessToken      *crypto.CryptoValue `json:"accessToken,omitempty"`
+	TemplateName     *string             `json:"templateName,omitempty"`
+	TemplateLanguage *string             `json:"templateLanguage,omitempty"`
+}
+
+func NewSMSConfigWhatsAppChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigWhatsAppChanges,
+) (*SMSConfigWhatsAppChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INST-ieQu6", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigWhatsAppChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigWhatsAppChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigWhatsAppChanges func(event *SMSConfigWhatsAppChangedEvent)
+
+func ChangeSMSConfigWhatsAppDescription(description string) func(event *SMSConfigWhatsAppChangedEvent) {
+	return func(e *SMSConfigWhatsAppChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMSConfigWhatsAppPhoneNumberID(phoneNumberID string) func(event *SMSConfigWhatsAppChangedEvent) {
+	return func(e *SMSConfigWhatsAppChangedEvent) {
+		e.PhoneNumberID = &phoneNumberID
+	}
+}
+
+func ChangeSMSConfigWhatsAppAccessToken(accessToken *crypto.CryptoValue) func(event *SMSConfigWhatsAppChangedEvent) {
+	return func(e *SMSConfigWhatsAppChangedEvent) {
+		e.AccessToken = accessToken
+	}
+}
+
+func ChangeSMSConfigWhatsAppTemplateName(templateName string) func(event *SMSConfigWhatsAppChangedEvent) {
+	return func(e *SMSConfigWhatsAppChangedEvent) {
+		e.TemplateName = &templateName
+	}
+}
+
+func ChangeSMSConfigWhatsAppTemplateLanguage(templateLanguage string) func(event *SMSConfigWhatsAppChangedEvent) {
+	return func(e *SMSConfigWhatsAppChangedEvent) {
+		e.TemplateLanguage = &templateLanguage
+	}
+}
+
+func (e *SMSConfigWhatsAppChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigWhatsAppChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigWhatsAppChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigVoiceAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id,omitempty"`
+	Description  string              `json:"description,omitempty"`
+	SID          string              `json:"sid,omitempty"`
+	Token        *crypto.CryptoValue `json:"token,omitempty"`
+	SenderNumber string              `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigVoiceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description,
+	sid,
+	senderNumber string,
+	token *crypto.CryptoValue,
+) *SMSConfigVoiceAddedEvent {
+	return &SMSConfigVoiceAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVoiceAddedEventType,
+		),
+		ID:           id,
+		Description:  description,
+		SID:          sid,
+		Token:        token,
+		SenderNumber: senderNumber,
+	}
+}
+
+func (e *SMSConfigVoiceAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigVoiceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVoiceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigVoiceChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	SID          *string             `json:"sid,omitempty"`
+	Token        *crypto.CryptoValue `json:"token,omitempty"`
+	SenderNumber *string             `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigVoiceChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigVoiceChanges,
+) (*SMSConfigVoiceChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INST-Thoh4", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigVoiceChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVoiceChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigVoiceChanges func(event *SMSConfigVoiceChangedEvent)
+
+func ChangeSMSConfigVoiceDescription(description string) func(event *SMSConfigVoiceChangedEvent) {
+	return func(e *SMSConfigVoiceChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMSConfigVoiceSID(sid string) func(event *SMSConfigVoiceChangedEvent) {
+	return func(e *SMSConfigVoiceChangedEvent) {
+		e.SID = &sid
+	}
+}
+
+func ChangeSMSConfigVoiceToken(token *crypto.CryptoValue) func(event *SMSConfigVoiceChangedEvent) {
+	return func(e *SMSConfigVoiceChangedEvent) {
+		e.Token = token
+	}
+}
+
+func ChangeSMSConfigVoiceSenderNumber(senderNumber string) func(event *SMSConfigVoiceChangedEvent) {
+	return func(e *SMSConfigVoiceChangedEvent) {
+		e.SenderNumber = &senderNumber
+	}
+}
+
+func (e *SMSConfigVoiceChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigVoiceChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVoiceChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
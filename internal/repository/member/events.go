@@ -196,6 +196,10 @@ func (e *MemberRemovedEvent) FieldOperations(prefix string) []*eventstore.FieldO
 			e.Aggregate(),
 			memberSearchObject(prefix, e.UserID),
 		),
+		eventstore.RemoveSearchFieldsByAggregateAndObject(
+			e.Aggregate(),
+			memberValiditySearchObject(prefix, e.UserID),
+		),
 	}
 }
 
@@ -243,6 +247,10 @@ func (e *MemberCascadeRemovedEvent) FieldOperations(prefix string) []*eventstore
 			e.Aggregate(),
 			memberSearchObject(prefix, e.UserID),
 		),
+		eventstore.RemoveSearchFieldsByAggregateAndObject(
+			e.Aggregate(),
+			memberValiditySearchObject(prefix, e.UserID),
+		),
 	}
 }
 
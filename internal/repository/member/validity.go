@@ -0,0 +1,156 @@
+package member
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Event types
+const (
+	ValiditySetEventType = "member.validity.set"
+	ExpiredEventType     = "member.expired"
+)
+
+// Field table types
+const (
+	memberValidityTypeSuffix    string = "_member_validity"
+	MemberValidityRevision      uint8  = 1
+	validFromSearchFieldSuffix  string = "_valid_from"
+	validUntilSearchFieldSuffix string = "_valid_until"
+)
+
+// MemberValiditySetEvent limits the membership to a period of time.
+// Zero times remove the limit on that side of the period.
+type MemberValiditySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID     string    `json:"userId"`
+	ValidFrom  time.Time `json:"validFrom,omitempty"`
+	ValidUntil time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *MemberValiditySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MemberValiditySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+// FieldOperations stores the period next to the roles of the membership,
+// so the permission checks based on the fields table can ignore memberships outside of it.
+func (e *MemberValiditySetEvent) FieldOperations(prefix string) []*eventstore.FieldOperation {
+	ops := []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObject(
+			e.Aggregate(),
+			memberValiditySearchObject(prefix, e.UserID),
+		),
+	}
+	if !e.ValidFrom.IsZero() {
+		ops = append(ops, memberValiditySetField(e.Aggregate(), prefix, e.UserID, prefix+validFromSearchFieldSuffix, e.ValidFrom))
+	}
+	if !e.ValidUntil.IsZero() {
+		ops = append(ops, memberValiditySetField(e.Aggregate(), prefix, e.UserID, prefix+validUntilSearchFieldSuffix, e.ValidUntil))
+	}
+	return ops
+}
+
+func NewMemberValiditySetEvent(
+	base *eventstore.BaseEvent,
+	userID string,
+	validFrom,
+	validUntil time.Time,
+) *MemberValiditySetEvent {
+	return &MemberValiditySetEvent{
+		BaseEvent:  *base,
+		UserID:     userID,
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+}
+
+func ValiditySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MemberValiditySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "MEMBER-Ohc3a", "unable to unmarshal member validity")
+	}
+
+	return e, nil
+}
+
+// MemberExpiredEvent is pushed by the scheduled expiry check as soon as the validity of the membership ended.
+// The membership itself is kept, so it can be extended by setting a new validity.
+type MemberExpiredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID     string    `json:"userId"`
+	ValidUntil time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *MemberExpiredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MemberExpiredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMemberExpiredEvent(
+	base *eventstore.BaseEvent,
+	userID string,
+	validUntil time.Time,
+) *MemberExpiredEvent {
+	return &MemberExpiredEvent{
+		BaseEvent:  *base,
+		UserID:     userID,
+		ValidUntil: validUntil,
+	}
+}
+
+func ExpiredEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MemberExpiredEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "MEMBER-ieX8o", "unable to unmarshal member expiry")
+	}
+
+	return e, nil
+}
+
+func memberValiditySetField(aggregate *eventstore.Aggregate, prefix, userID, fieldName string, value time.Time) *eventstore.FieldOperation {
+	return eventstore.SetField(
+		aggregate,
+		memberValiditySearchObject(prefix, userID),
+		fieldName,
+		&eventstore.Value{
+			Value:        value,
+			MustBeUnique: false,
+			ShouldIndex:  true,
+		},
+
+		eventstore.FieldTypeInstanceID,
+		eventstore.FieldTypeResourceOwner,
+		eventstore.FieldTypeAggregateType,
+		eventstore.FieldTypeAggregateID,
+		eventstore.FieldTypeObjectType,
+		eventstore.FieldTypeObjectID,
+		eventstore.FieldTypeFieldName,
+	)
+}
+
+func memberValiditySearchObject(prefix, userID string) eventstore.Object {
+	return eventstore.Object{
+		Type:     prefix + memberValidityTypeSuffix,
+		ID:       userID,
+		Revision: MemberValidityRevision,
+	}
+}
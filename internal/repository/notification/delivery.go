@@ -0,0 +1,155 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	notificationEventPrefix = "notification."
+	DeliverySucceededType   = notificationEventPrefix + "delivery.succeeded"
+	DeliveryFailedType      = notificationEventPrefix + "delivery.failed"
+	ResendRequestedType     = notificationEventPrefix + "resend.requested"
+)
+
+// Delivery describes a single delivery attempt of a notification.
+// It only identifies the channel and the message, the content (e.g. a code) is never stored.
+type Delivery struct {
+	UserID           string                  `json:"userID,omitempty"`
+	MessageType      string                  `json:"messageType,omitempty"`
+	NotificationType domain.NotificationType `json:"notificationType"`
+	EventType        eventstore.EventType    `json:"eventType,omitempty"`
+	ProviderID       string                  `json:"providerID,omitempty"`
+	// Attempt is the total number of delivery attempts of the notification, including the ones of resends.
+	Attempt uint16 `json:"attempt,omitempty"`
+}
+
+type DeliverySucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	Delivery
+}
+
+func (e *DeliverySucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeliverySucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliverySucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	delivery Delivery,
+) *DeliverySucceededEvent {
+	return &DeliverySucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeliverySucceededType,
+		),
+		Delivery: delivery,
+	}
+}
+
+func DeliverySucceededEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &DeliverySucceededEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "NOTIF-ahC4i", "unable to unmarshal notification delivery")
+	}
+	return e, nil
+}
+
+type DeliveryFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	Delivery
+
+	Error string `json:"error,omitempty"`
+	// Final is set if the notification will not be retried.
+	Final bool `json:"final,omitempty"`
+}
+
+func (e *DeliveryFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeliveryFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliveryFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	delivery Delivery,
+	deliveryErr string,
+	final bool,
+) *DeliveryFailedEvent {
+	return &DeliveryFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DeliveryFailedType,
+		),
+		Delivery: delivery,
+		Error:    deliveryErr,
+		Final:    final,
+	}
+}
+
+func DeliveryFailedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &DeliveryFailedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "NOTIF-ooR5e", "unable to unmarshal notification delivery failure")
+	}
+	return e, nil
+}
+
+// ResendRequestedEvent records that a new notification was requested in place of this one.
+type ResendRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userID,omitempty"`
+}
+
+func (e *ResendRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ResendRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewResendRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+) *ResendRequestedEvent {
+	return &ResendRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ResendRequestedType,
+		),
+		UserID: userID,
+	}
+}
+
+func ResendRequestedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ResendRequestedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "NOTIF-Ieth7", "unable to unmarshal notification resend")
+	}
+	return e, nil
+}
@@ -0,0 +1,9 @@
+package notification
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliverySucceededType, DeliverySucceededEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliveryFailedType, DeliveryFailedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ResendRequestedType, ResendRequestedEventMapper)
+}
@@ -34,6 +34,12 @@ type AddedEvent struct {
 	Nonce             string                      `json:"nonce,omitempty"`
 	PreferredLanguage *language.Tag               `json:"preferredLanguage,omitempty"`
 	UserAgent         *domain.UserAgent           `json:"userAgent,omitempty"`
+	// AuthorizationDetails granted for the session (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
+	// Resources granted for the session (RFC 8707)
+	Resources []string `json:"resources,omitempty"`
+	// ACR is the acr value satisfied by the authentication
+	ACR string `json:"acr,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -61,6 +67,9 @@ func NewAddedEvent(ctx context.Context,
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	authorizationDetails domain.AuthorizationDetails,
+	resources []string,
+	acr string,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -68,17 +77,20 @@ func NewAddedEvent(ctx context.Context,
 			aggregate,
 			AddedType,
 		),
-		UserID:            userID,
-		UserResourceOwner: userResourceOwner,
-		SessionID:         sessionID,
-		ClientID:          clientID,
-		Audience:          audience,
-		Scope:             scope,
-		AuthMethods:       authMethods,
-		AuthTime:          authTime,
-		Nonce:             nonce,
-		PreferredLanguage: preferredLanguage,
-		UserAgent:         userAgent,
+		UserID:               userID,
+		UserResourceOwner:    userResourceOwner,
+		SessionID:            sessionID,
+		ClientID:             clientID,
+		Audience:             audience,
+		Scope:                scope,
+		AuthMethods:          authMethods,
+		AuthTime:             authTime,
+		Nonce:                nonce,
+		PreferredLanguage:    preferredLanguage,
+		UserAgent:            userAgent,
+		AuthorizationDetails: authorizationDetails,
+		Resources:            resources,
+		ACR:                  acr,
 	}
 }
 
@@ -90,6 +102,11 @@ type AccessTokenAddedEvent struct {
 	Lifetime time.Duration      `json:"lifetime,omitempty"`
 	Reason   domain.TokenReason `json:"reason,omitempty"`
 	Actor    *domain.TokenActor `json:"actor,omitempty"`
+	// AuthorizationDetails of the token, which might be a subset of the session's (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
+	// Resources the token is restricted to, which might be a subset of the session's (RFC 8707).
+	// If set, they are used as audience of the token.
+	Resources []string `json:"resources,omitempty"`
 }
 
 func (e *AccessTokenAddedEvent) Payload() interface{} {
@@ -112,6 +129,8 @@ func NewAccessTokenAddedEvent(
 	lifetime time.Duration,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
+	authorizationDetails domain.AuthorizationDetails,
+	resources []string,
 ) *AccessTokenAddedEvent {
 	return &AccessTokenAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -119,11 +138,13 @@ func NewAccessTokenAddedEvent(
 			aggregate,
 			AccessTokenAddedType,
 		),
-		ID:       id,
-		Scope:    scope,
-		Lifetime: lifetime,
-		Reason:   reason,
-		Actor:    actor,
+		ID:                   id,
+		Scope:                scope,
+		Lifetime:             lifetime,
+		Reason:               reason,
+		Actor:                actor,
+		AuthorizationDetails: authorizationDetails,
+		Resources:            resources,
 	}
 }
 
@@ -0,0 +1,114 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	OrgParentSetEventType     = orgEventTypePrefix + "parent.set"
+	OrgParentRemovedEventType = orgEventTypePrefix + "parent.removed"
+
+	OrgParentSearchField = "parent_id"
+)
+
+// OrgParentSetEvent places the organization below the parent organization.
+// The organization inherits the policies of the parent, as long as it does not override them.
+type OrgParentSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ParentID string `json:"parentId"`
+}
+
+func (e *OrgParentSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OrgParentSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *OrgParentSetEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.SetField(
+			e.Aggregate(),
+			orgSearchObject(e.Aggregate().ID),
+			OrgParentSearchField,
+			&eventstore.Value{
+				Value:       e.ParentID,
+				ShouldIndex: true,
+			},
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		),
+	}
+}
+
+func NewOrgParentSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, parentID string) *OrgParentSetEvent {
+	return &OrgParentSetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentSetEventType,
+		),
+		ParentID: parentID,
+	}
+}
+
+func OrgParentSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	parentSet := &OrgParentSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(parentSet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ORG-ooN2e", "unable to unmarshal org parent set")
+	}
+
+	return parentSet, nil
+}
+
+// OrgParentRemovedEvent makes the organization a top level organization of the instance again.
+type OrgParentRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *OrgParentRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *OrgParentRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *OrgParentRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(
+			e.Aggregate(),
+			orgSearchObject(e.Aggregate().ID),
+			OrgParentSearchField,
+		),
+	}
+}
+
+func NewOrgParentRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *OrgParentRemovedEvent {
+	return &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OrgParentRemovedEventType,
+		),
+	}
+}
+
+func OrgParentRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &OrgParentRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -0,0 +1,109 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	InactivityPolicyAddedEventType   = orgEventTypePrefix + policy.InactivityPolicyAddedEventType
+	InactivityPolicyChangedEventType = orgEventTypePrefix + policy.InactivityPolicyChangedEventType
+	InactivityPolicyRemovedEventType = orgEventTypePrefix + policy.InactivityPolicyRemovedEventType
+)
+
+type InactivityPolicyAddedEvent struct {
+	policy.InactivityPolicyAddedEvent
+}
+
+func NewInactivityPolicyAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	warnDays,
+	deactivateDays,
+	deleteDays uint64,
+	exemptMachineUsers bool,
+	exemptRoles []string,
+) *InactivityPolicyAddedEvent {
+	return &InactivityPolicyAddedEvent{
+		InactivityPolicyAddedEvent: *policy.NewInactivityPolicyAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				InactivityPolicyAddedEventType),
+			warnDays,
+			deactivateDays,
+			deleteDays,
+			exemptMachineUsers,
+			exemptRoles,
+		),
+	}
+}
+
+func InactivityPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.InactivityPolicyAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InactivityPolicyAddedEvent{InactivityPolicyAddedEvent: *e.(*policy.InactivityPolicyAddedEvent)}, nil
+}
+
+type InactivityPolicyChangedEvent struct {
+	policy.InactivityPolicyChangedEvent
+}
+
+func NewInactivityPolicyChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []policy.InactivityPolicyChanges,
+) (*InactivityPolicyChangedEvent, error) {
+	changedEvent, err := policy.NewInactivityPolicyChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InactivityPolicyChangedEventType),
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &InactivityPolicyChangedEvent{InactivityPolicyChangedEvent: *changedEvent}, nil
+}
+
+func InactivityPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.InactivityPolicyChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InactivityPolicyChangedEvent{InactivityPolicyChangedEvent: *e.(*policy.InactivityPolicyChangedEvent)}, nil
+}
+
+type InactivityPolicyRemovedEvent struct {
+	policy.InactivityPolicyRemovedEvent
+}
+
+func NewInactivityPolicyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *InactivityPolicyRemovedEvent {
+	return &InactivityPolicyRemovedEvent{
+		InactivityPolicyRemovedEvent: *policy.NewInactivityPolicyRemovedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				InactivityPolicyRemovedEventType),
+		),
+	}
+}
+
+func InactivityPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.InactivityPolicyRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InactivityPolicyRemovedEvent{InactivityPolicyRemovedEvent: *e.(*policy.InactivityPolicyRemovedEvent)}, nil
+}
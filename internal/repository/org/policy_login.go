@@ -39,6 +39,16 @@ func NewLoginPolicyAddedEvent(
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
 	multiFactorCheckLifetime time.Duration,
+	allowMagicLink bool,
+	trustedDeviceLifetime,
+	sessionIdleTimeout,
+	sessionMaxLifetime time.Duration,
+	maxConcurrentSessions uint64,
+	webAuthNAttestation domain.AttestationConveyance,
+	webAuthNUserVerification domain.UserVerificationRequirement,
+	webAuthNResidentKey domain.ResidentKeyRequirement,
+	webAuthNAllowedAAGUIDs,
+	webAuthNDeniedAAGUIDs []string,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		LoginPolicyAddedEvent: *policy.NewLoginPolicyAddedEvent(
@@ -63,6 +73,16 @@ func NewLoginPolicyAddedEvent(
 			mfaInitSkipLifetime,
 			secondFactorCheckLifetime,
 			multiFactorCheckLifetime,
+			allowMagicLink,
+			trustedDeviceLifetime,
+			sessionIdleTimeout,
+			sessionMaxLifetime,
+			maxConcurrentSessions,
+			webAuthNAttestation,
+			webAuthNUserVerification,
+			webAuthNResidentKey,
+			webAuthNAllowedAAGUIDs,
+			webAuthNDeniedAAGUIDs,
 		),
 	}
 }
@@ -18,23 +18,33 @@ const (
 type LoginPolicyAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AllowUserNamePassword      bool                    `json:"allowUsernamePassword,omitempty"`
-	AllowRegister              bool                    `json:"allowRegister,omitempty"`
-	AllowExternalIDP           bool                    `json:"allowExternalIdp,omitempty"`
-	ForceMFA                   bool                    `json:"forceMFA,omitempty"`
-	ForceMFALocalOnly          bool                    `json:"forceMFALocalOnly,omitempty"`
-	HidePasswordReset          bool                    `json:"hidePasswordReset,omitempty"`
-	IgnoreUnknownUsernames     bool                    `json:"ignoreUnknownUsernames,omitempty"`
-	AllowDomainDiscovery       bool                    `json:"allowDomainDiscovery,omitempty"`
-	DisableLoginWithEmail      bool                    `json:"disableLoginWithEmail,omitempty"`
-	DisableLoginWithPhone      bool                    `json:"disableLoginWithPhone,omitempty"`
-	PasswordlessType           domain.PasswordlessType `json:"passwordlessType,omitempty"`
-	DefaultRedirectURI         string                  `json:"defaultRedirectURI,omitempty"`
-	PasswordCheckLifetime      time.Duration           `json:"passwordCheckLifetime,omitempty"`
-	ExternalLoginCheckLifetime time.Duration           `json:"externalLoginCheckLifetime,omitempty"`
-	MFAInitSkipLifetime        time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
-	SecondFactorCheckLifetime  time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
-	MultiFactorCheckLifetime   time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	AllowUserNamePassword      bool                               `json:"allowUsernamePassword,omitempty"`
+	AllowRegister              bool                               `json:"allowRegister,omitempty"`
+	AllowExternalIDP           bool                               `json:"allowExternalIdp,omitempty"`
+	ForceMFA                   bool                               `json:"forceMFA,omitempty"`
+	ForceMFALocalOnly          bool                               `json:"forceMFALocalOnly,omitempty"`
+	HidePasswordReset          bool                               `json:"hidePasswordReset,omitempty"`
+	IgnoreUnknownUsernames     bool                               `json:"ignoreUnknownUsernames,omitempty"`
+	AllowDomainDiscovery       bool                               `json:"allowDomainDiscovery,omitempty"`
+	DisableLoginWithEmail      bool                               `json:"disableLoginWithEmail,omitempty"`
+	DisableLoginWithPhone      bool                               `json:"disableLoginWithPhone,omitempty"`
+	PasswordlessType           domain.PasswordlessType            `json:"passwordlessType,omitempty"`
+	DefaultRedirectURI         string                             `json:"defaultRedirectURI,omitempty"`
+	PasswordCheckLifetime      time.Duration                      `json:"passwordCheckLifetime,omitempty"`
+	ExternalLoginCheckLifetime time.Duration                      `json:"externalLoginCheckLifetime,omitempty"`
+	MFAInitSkipLifetime        time.Duration                      `json:"mfaInitSkipLifetime,omitempty"`
+	SecondFactorCheckLifetime  time.Duration                      `json:"secondFactorCheckLifetime,omitempty"`
+	MultiFactorCheckLifetime   time.Duration                      `json:"multiFactorCheckLifetime,omitempty"`
+	AllowMagicLink             bool                               `json:"allowMagicLink,omitempty"`
+	TrustedDeviceLifetime      time.Duration                      `json:"trustedDeviceLifetime,omitempty"`
+	SessionIdleTimeout         time.Duration                      `json:"sessionIdleTimeout,omitempty"`
+	SessionMaxLifetime         time.Duration                      `json:"sessionMaxLifetime,omitempty"`
+	MaxConcurrentSessions      uint64                             `json:"maxConcurrentSessions,omitempty"`
+	WebAuthNAttestation        domain.AttestationConveyance       `json:"webAuthNAttestation,omitempty"`
+	WebAuthNUserVerification   domain.UserVerificationRequirement `json:"webAuthNUserVerification,omitempty"`
+	WebAuthNResidentKey        domain.ResidentKeyRequirement      `json:"webAuthNResidentKey,omitempty"`
+	WebAuthNAllowedAAGUIDs     []string                           `json:"webAuthNAllowedAAGUIDs,omitempty"`
+	WebAuthNDeniedAAGUIDs      []string                           `json:"webAuthNDeniedAAGUIDs,omitempty"`
 }
 
 func (e *LoginPolicyAddedEvent) Payload() interface{} {
@@ -64,6 +74,16 @@ func NewLoginPolicyAddedEvent(
 	mfaInitSkipLifetime,
 	secondFactorCheckLifetime,
 	multiFactorCheckLifetime time.Duration,
+	allowMagicLink bool,
+	trustedDeviceLifetime,
+	sessionIdleTimeout,
+	sessionMaxLifetime time.Duration,
+	maxConcurrentSessions uint64,
+	webAuthNAttestation domain.AttestationConveyance,
+	webAuthNUserVerification domain.UserVerificationRequirement,
+	webAuthNResidentKey domain.ResidentKeyRequirement,
+	webAuthNAllowedAAGUIDs,
+	webAuthNDeniedAAGUIDs []string,
 ) *LoginPolicyAddedEvent {
 	return &LoginPolicyAddedEvent{
 		BaseEvent:                  *base,
@@ -84,6 +104,16 @@ func NewLoginPolicyAddedEvent(
 		MultiFactorCheckLifetime:   multiFactorCheckLifetime,
 		DisableLoginWithEmail:      disableLoginWithEmail,
 		DisableLoginWithPhone:      disableLoginWithPhone,
+		AllowMagicLink:             allowMagicLink,
+		TrustedDeviceLifetime:      trustedDeviceLifetime,
+		SessionIdleTimeout:         sessionIdleTimeout,
+		SessionMaxLifetime:         sessionMaxLifetime,
+		MaxConcurrentSessions:      maxConcurrentSessions,
+		WebAuthNAttestation:        webAuthNAttestation,
+		WebAuthNUserVerification:   webAuthNUserVerification,
+		WebAuthNResidentKey:        webAuthNResidentKey,
+		WebAuthNAllowedAAGUIDs:     webAuthNAllowedAAGUIDs,
+		WebAuthNDeniedAAGUIDs:      webAuthNDeniedAAGUIDs,
 	}
 }
 
@@ -103,23 +133,33 @@ func LoginPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, erro
 type LoginPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AllowUserNamePassword      *bool                    `json:"allowUsernamePassword,omitempty"`
-	AllowRegister              *bool                    `json:"allowRegister,omitempty"`
-	AllowExternalIDP           *bool                    `json:"allowExternalIdp,omitempty"`
-	ForceMFA                   *bool                    `json:"forceMFA,omitempty"`
-	ForceMFALocalOnly          *bool                    `json:"forceMFALocalOnly,omitempty"`
-	HidePasswordReset          *bool                    `json:"hidePasswordReset,omitempty"`
-	IgnoreUnknownUsernames     *bool                    `json:"ignoreUnknownUsernames,omitempty"`
-	AllowDomainDiscovery       *bool                    `json:"allowDomainDiscovery,omitempty"`
-	DisableLoginWithEmail      *bool                    `json:"disableLoginWithEmail,omitempty"`
-	DisableLoginWithPhone      *bool                    `json:"disableLoginWithPhone,omitempty"`
-	PasswordlessType           *domain.PasswordlessType `json:"passwordlessType,omitempty"`
-	DefaultRedirectURI         *string                  `json:"defaultRedirectURI,omitempty"`
-	PasswordCheckLifetime      *time.Duration           `json:"passwordCheckLifetime,omitempty"`
-	ExternalLoginCheckLifetime *time.Duration           `json:"externalLoginCheckLifetime,omitempty"`
-	MFAInitSkipLifetime        *time.Duration           `json:"mfaInitSkipLifetime,omitempty"`
-	SecondFactorCheckLifetime  *time.Duration           `json:"secondFactorCheckLifetime,omitempty"`
-	MultiFactorCheckLifetime   *time.Duration           `json:"multiFactorCheckLifetime,omitempty"`
+	AllowUserNamePassword      *bool                               `json:"allowUsernamePassword,omitempty"`
+	AllowRegister              *bool                               `json:"allowRegister,omitempty"`
+	AllowExternalIDP           *bool                               `json:"allowExternalIdp,omitempty"`
+	ForceMFA                   *bool                               `json:"forceMFA,omitempty"`
+	ForceMFALocalOnly          *bool                               `json:"forceMFALocalOnly,omitempty"`
+	HidePasswordReset          *bool                               `json:"hidePasswordReset,omitempty"`
+	IgnoreUnknownUsernames     *bool                               `json:"ignoreUnknownUsernames,omitempty"`
+	AllowDomainDiscovery       *bool                               `json:"allowDomainDiscovery,omitempty"`
+	DisableLoginWithEmail      *bool                               `json:"disableLoginWithEmail,omitempty"`
+	DisableLoginWithPhone      *bool                               `json:"disableLoginWithPhone,omitempty"`
+	AllowMagicLink             *bool                               `json:"allowMagicLink,omitempty"`
+	PasswordlessType           *domain.PasswordlessType            `json:"passwordlessType,omitempty"`
+	DefaultRedirectURI         *string                             `json:"defaultRedirectURI,omitempty"`
+	PasswordCheckLifetime      *time.Duration                      `json:"passwordCheckLifetime,omitempty"`
+	ExternalLoginCheckLifetime *time.Duration                      `json:"externalLoginCheckLifetime,omitempty"`
+	MFAInitSkipLifetime        *time.Duration                      `json:"mfaInitSkipLifetime,omitempty"`
+	SecondFactorCheckLifetime  *time.Duration                      `json:"secondFactorCheckLifetime,omitempty"`
+	MultiFactorCheckLifetime   *time.Duration                      `json:"multiFactorCheckLifetime,omitempty"`
+	TrustedDeviceLifetime      *time.Duration                      `json:"trustedDeviceLifetime,omitempty"`
+	SessionIdleTimeout         *time.Duration                      `json:"sessionIdleTimeout,omitempty"`
+	SessionMaxLifetime         *time.Duration                      `json:"sessionMaxLifetime,omitempty"`
+	MaxConcurrentSessions      *uint64                             `json:"maxConcurrentSessions,omitempty"`
+	WebAuthNAttestation        *domain.AttestationConveyance       `json:"webAuthNAttestation,omitempty"`
+	WebAuthNUserVerification   *domain.UserVerificationRequirement `json:"webAuthNUserVerification,omitempty"`
+	WebAuthNResidentKey        *domain.ResidentKeyRequirement      `json:"webAuthNResidentKey,omitempty"`
+	WebAuthNAllowedAAGUIDs     *[]string                           `json:"webAuthNAllowedAAGUIDs,omitempty"`
+	WebAuthNDeniedAAGUIDs      *[]string                           `json:"webAuthNDeniedAAGUIDs,omitempty"`
 }
 
 func (e *LoginPolicyChangedEvent) Payload() interface{} {
@@ -250,6 +290,66 @@ func ChangeDisableLoginWithPhone(DisableLoginWithPhone bool) func(*LoginPolicyCh
 	}
 }
 
+func ChangeAllowMagicLink(allowMagicLink bool) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.AllowMagicLink = &allowMagicLink
+	}
+}
+
+func ChangeTrustedDeviceLifetime(trustedDeviceLifetime time.Duration) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.TrustedDeviceLifetime = &trustedDeviceLifetime
+	}
+}
+
+func ChangeSessionIdleTimeout(sessionIdleTimeout time.Duration) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.SessionIdleTimeout = &sessionIdleTimeout
+	}
+}
+
+func ChangeSessionMaxLifetime(sessionMaxLifetime time.Duration) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.SessionMaxLifetime = &sessionMaxLifetime
+	}
+}
+
+func ChangeMaxConcurrentSessions(maxConcurrentSessions uint64) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.MaxConcurrentSessions = &maxConcurrentSessions
+	}
+}
+
+func ChangeWebAuthNAttestation(webAuthNAttestation domain.AttestationConveyance) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.WebAuthNAttestation = &webAuthNAttestation
+	}
+}
+
+func ChangeWebAuthNUserVerification(webAuthNUserVerification domain.UserVerificationRequirement) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.WebAuthNUserVerification = &webAuthNUserVerification
+	}
+}
+
+func ChangeWebAuthNResidentKey(webAuthNResidentKey domain.ResidentKeyRequirement) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.WebAuthNResidentKey = &webAuthNResidentKey
+	}
+}
+
+func ChangeWebAuthNAllowedAAGUIDs(webAuthNAllowedAAGUIDs []string) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.WebAuthNAllowedAAGUIDs = &webAuthNAllowedAAGUIDs
+	}
+}
+
+func ChangeWebAuthNDeniedAAGUIDs(webAuthNDeniedAAGUIDs []string) func(*LoginPolicyChangedEvent) {
+	return func(e *LoginPolicyChangedEvent) {
+		e.WebAuthNDeniedAAGUIDs = &webAuthNDeniedAAGUIDs
+	}
+}
+
 func LoginPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &LoginPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -0,0 +1,100 @@
+package policy
+
+import (
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	mailMessageTemplatePrefix           = mailTemplatePolicyPrefix + "message."
+	MailMessageTemplateSetEventType     = mailMessageTemplatePrefix + "set"
+	MailMessageTemplateRemovedEventType = mailMessageTemplatePrefix + "removed"
+)
+
+type MailMessageTemplateSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	MessageType string       `json:"messageType,omitempty"`
+	Language    language.Tag `json:"language,omitempty"`
+	HTML        string       `json:"html,omitempty"`
+	PlainText   string       `json:"plainText,omitempty"`
+}
+
+func (e *MailMessageTemplateSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MailMessageTemplateSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMailMessageTemplateSetEvent(
+	base *eventstore.BaseEvent,
+	messageType string,
+	language language.Tag,
+	html,
+	plainText string,
+) *MailMessageTemplateSetEvent {
+	return &MailMessageTemplateSetEvent{
+		BaseEvent:   *base,
+		MessageType: messageType,
+		Language:    language,
+		HTML:        html,
+		PlainText:   plainText,
+	}
+}
+
+func MailMessageTemplateSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MailMessageTemplateSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Aeb1u", "unable to unmarshal mail message template")
+	}
+
+	return e, nil
+}
+
+type MailMessageTemplateRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	MessageType string       `json:"messageType,omitempty"`
+	Language    language.Tag `json:"language,omitempty"`
+}
+
+func (e *MailMessageTemplateRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MailMessageTemplateRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewMailMessageTemplateRemovedEvent(
+	base *eventstore.BaseEvent,
+	messageType string,
+	language language.Tag,
+) *MailMessageTemplateRemovedEvent {
+	return &MailMessageTemplateRemovedEvent{
+		BaseEvent:   *base,
+		MessageType: messageType,
+		Language:    language,
+	}
+}
+
+func MailMessageTemplateRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &MailMessageTemplateRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Quo2e", "unable to unmarshal mail message template")
+	}
+
+	return e, nil
+}
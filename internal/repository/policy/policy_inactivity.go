@@ -0,0 +1,164 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	InactivityPolicyAddedEventType   = "policy.inactivity.added"
+	InactivityPolicyChangedEventType = "policy.inactivity.changed"
+	InactivityPolicyRemovedEventType = "policy.inactivity.removed"
+)
+
+type InactivityPolicyAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	WarnDays           uint64   `json:"warnDays,omitempty"`
+	DeactivateDays     uint64   `json:"deactivateDays,omitempty"`
+	DeleteDays         uint64   `json:"deleteDays,omitempty"`
+	ExemptMachineUsers bool     `json:"exemptMachineUsers,omitempty"`
+	ExemptRoles        []string `json:"exemptRoles,omitempty"`
+}
+
+func (e *InactivityPolicyAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InactivityPolicyAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityPolicyAddedEvent(
+	base *eventstore.BaseEvent,
+	warnDays,
+	deactivateDays,
+	deleteDays uint64,
+	exemptMachineUsers bool,
+	exemptRoles []string,
+) *InactivityPolicyAddedEvent {
+	return &InactivityPolicyAddedEvent{
+		BaseEvent:          *base,
+		WarnDays:           warnDays,
+		DeactivateDays:     deactivateDays,
+		DeleteDays:         deleteDays,
+		ExemptMachineUsers: exemptMachineUsers,
+		ExemptRoles:        exemptRoles,
+	}
+}
+
+func InactivityPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &InactivityPolicyAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Quai0", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type InactivityPolicyChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	WarnDays           *uint64   `json:"warnDays,omitempty"`
+	DeactivateDays     *uint64   `json:"deactivateDays,omitempty"`
+	DeleteDays         *uint64   `json:"deleteDays,omitempty"`
+	ExemptMachineUsers *bool     `json:"exemptMachineUsers,omitempty"`
+	ExemptRoles        *[]string `json:"exemptRoles,omitempty"`
+}
+
+func (e *InactivityPolicyChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InactivityPolicyChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityPolicyChangedEvent(
+	base *eventstore.BaseEvent,
+	changes []InactivityPolicyChanges,
+) (*InactivityPolicyChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "POLICY-ieZ9e", "Errors.NoChangesFound")
+	}
+	changeEvent := &InactivityPolicyChangedEvent{
+		BaseEvent: *base,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type InactivityPolicyChanges func(*InactivityPolicyChangedEvent)
+
+func ChangeInactivityWarnDays(days uint64) func(*InactivityPolicyChangedEvent) {
+	return func(e *InactivityPolicyChangedEvent) {
+		e.WarnDays = &days
+	}
+}
+
+func ChangeInactivityDeactivateDays(days uint64) func(*InactivityPolicyChangedEvent) {
+	return func(e *InactivityPolicyChangedEvent) {
+		e.DeactivateDays = &days
+	}
+}
+
+func ChangeInactivityDeleteDays(days uint64) func(*InactivityPolicyChangedEvent) {
+	return func(e *InactivityPolicyChangedEvent) {
+		e.DeleteDays = &days
+	}
+}
+
+func ChangeInactivityExemptMachineUsers(exempt bool) func(*InactivityPolicyChangedEvent) {
+	return func(e *InactivityPolicyChangedEvent) {
+		e.ExemptMachineUsers = &exempt
+	}
+}
+
+func ChangeInactivityExemptRoles(roles []string) func(*InactivityPolicyChangedEvent) {
+	return func(e *InactivityPolicyChangedEvent) {
+		e.ExemptRoles = &roles
+	}
+}
+
+func InactivityPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &InactivityPolicyChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Oe0ah", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type InactivityPolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *InactivityPolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *InactivityPolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityPolicyRemovedEvent(base *eventstore.BaseEvent) *InactivityPolicyRemovedEvent {
+	return &InactivityPolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func InactivityPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &InactivityPolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -14,7 +15,10 @@ const (
 type NotificationPolicyAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	PasswordChange bool `json:"passwordChange,omitempty"`
+	PasswordChange        bool `json:"passwordChange,omitempty"`
+	SecurityNotifications bool `json:"securityNotifications,omitempty"`
+	// PhoneChannels is the order in which the channels are tried to deliver notifications to a phone number.
+	PhoneChannels []domain.PhoneChannel `json:"phoneChannels,omitempty"`
 }
 
 func (e *NotificationPolicyAddedEvent) Payload() interface{} {
@@ -27,11 +31,15 @@ func (e *NotificationPolicyAddedEvent) UniqueConstraints() []*eventstore.UniqueC
 
 func NewNotificationPolicyAddedEvent(
 	base *eventstore.BaseEvent,
-	passwordChange bool,
+	passwordChange,
+	securityNotifications bool,
+	phoneChannels []domain.PhoneChannel,
 ) *NotificationPolicyAddedEvent {
 	return &NotificationPolicyAddedEvent{
-		BaseEvent:      *base,
-		PasswordChange: passwordChange,
+		BaseEvent:             *base,
+		PasswordChange:        passwordChange,
+		SecurityNotifications: securityNotifications,
+		PhoneChannels:         phoneChannels,
 	}
 }
 
@@ -51,7 +59,9 @@ func NotificationPolicyAddedEventMapper(event eventstore.Event) (eventstore.Even
 type NotificationPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	PasswordChange *bool `json:"passwordChange,omitempty"`
+	PasswordChange        *bool                  `json:"passwordChange,omitempty"`
+	SecurityNotifications *bool                  `json:"securityNotifications,omitempty"`
+	PhoneChannels         *[]domain.PhoneChannel `json:"phoneChannels,omitempty"`
 }
 
 func (e *NotificationPolicyChangedEvent) Payload() interface{} {
@@ -86,6 +96,18 @@ func ChangePasswordChange(passwordChange bool) func(*NotificationPolicyChangedEv
 	}
 }
 
+func ChangeSecurityNotifications(securityNotifications bool) func(*NotificationPolicyChangedEvent) {
+	return func(e *NotificationPolicyChangedEvent) {
+		e.SecurityNotifications = &securityNotifications
+	}
+}
+
+func ChangePhoneChannels(phoneChannels []domain.PhoneChannel) func(*NotificationPolicyChangedEvent) {
+	return func(e *NotificationPolicyChangedEvent) {
+		e.PhoneChannels = &phoneChannels
+	}
+}
+
 func NotificationPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &NotificationPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
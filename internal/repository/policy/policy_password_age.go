@@ -16,6 +16,7 @@ type PasswordAgePolicyAddedEvent struct {
 
 	ExpireWarnDays uint64 `json:"expireWarnDays,omitempty"`
 	MaxAgeDays     uint64 `json:"maxAgeDays,omitempty"`
+	HistoryDepth   uint64 `json:"historyDepth,omitempty"`
 }
 
 func (e *PasswordAgePolicyAddedEvent) Payload() interface{} {
@@ -29,13 +30,15 @@ func (e *PasswordAgePolicyAddedEvent) UniqueConstraints() []*eventstore.UniqueCo
 func NewPasswordAgePolicyAddedEvent(
 	base *eventstore.BaseEvent,
 	expireWarnDays,
-	maxAgeDays uint64,
+	maxAgeDays,
+	historyDepth uint64,
 ) *PasswordAgePolicyAddedEvent {
 
 	return &PasswordAgePolicyAddedEvent{
 		BaseEvent:      *base,
 		ExpireWarnDays: expireWarnDays,
 		MaxAgeDays:     maxAgeDays,
+		HistoryDepth:   historyDepth,
 	}
 }
 
@@ -57,6 +60,7 @@ type PasswordAgePolicyChangedEvent struct {
 
 	ExpireWarnDays *uint64 `json:"expireWarnDays,omitempty"`
 	MaxAgeDays     *uint64 `json:"maxAgeDays,omitempty"`
+	HistoryDepth   *uint64 `json:"historyDepth,omitempty"`
 }
 
 func (e *PasswordAgePolicyChangedEvent) Payload() interface{} {
@@ -97,6 +101,12 @@ func ChangeMaxAgeDays(maxAgeDays uint64) func(*PasswordAgePolicyChangedEvent) {
 	}
 }
 
+func ChangeHistoryDepth(historyDepth uint64) func(*PasswordAgePolicyChangedEvent) {
+	return func(e *PasswordAgePolicyChangedEvent) {
+		e.HistoryDepth = &historyDepth
+	}
+}
+
 func PasswordAgePolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &PasswordAgePolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -18,12 +18,14 @@ var (
 	GrantDeactivatedType    = grantEventTypePrefix + "deactivated"
 	GrantReactivatedType    = grantEventTypePrefix + "reactivated"
 	GrantRemovedType        = grantEventTypePrefix + "removed"
+	GrantSubOrgsChangedType = grantEventTypePrefix + "suborgs.changed"
 
 	ProjectGrantSearchType              = "project_grant"
 	ProjectGrantGrantIDSearchField      = "grant_id"
 	ProjectGrantGrantedOrgIDSearchField = "granted_org_id"
 	ProjectGrantStateSearchField        = "state"
 	ProjectGrantRoleKeySearchField      = "role_key"
+	ProjectGrantSubOrgsSearchField      = "include_sub_orgs"
 	ProjectGrantObjectRevision          = uint8(1)
 )
 
@@ -446,6 +448,73 @@ func GrantReactivatedEventMapper(event eventstore.Event) (eventstore.Event, erro
 	return e, nil
 }
 
+// GrantSubOrgsChangedEvent defines if the project is also granted to the sub organizations of the granted organization.
+type GrantSubOrgsChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GrantID        string `json:"grantId,omitempty"`
+	IncludeSubOrgs bool   `json:"includeSubOrgs"`
+}
+
+func (e *GrantSubOrgsChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GrantSubOrgsChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *GrantSubOrgsChangedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.SetField(
+			e.Aggregate(),
+			grantSearchObject(e.GrantID),
+			ProjectGrantSubOrgsSearchField,
+			&eventstore.Value{
+				Value:       e.IncludeSubOrgs,
+				ShouldIndex: false,
+			},
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		),
+	}
+}
+
+func NewGrantSubOrgsChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	grantID string,
+	includeSubOrgs bool,
+) *GrantSubOrgsChangedEvent {
+	return &GrantSubOrgsChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GrantSubOrgsChangedType,
+		),
+		GrantID:        grantID,
+		IncludeSubOrgs: includeSubOrgs,
+	}
+}
+
+func GrantSubOrgsChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &GrantSubOrgsChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "PROJECT-Ohph3", "unable to unmarshal project grant sub orgs changed")
+	}
+
+	return e, nil
+}
+
 type GrantRemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
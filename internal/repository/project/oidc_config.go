@@ -32,27 +32,30 @@ type OIDCConfigAddedEvent struct {
 	ClientSecret *crypto.CryptoValue `json:"clientSecret,omitempty"`
 	HashedSecret string              `json:"hashedSecret,omitempty"`
 
-	RedirectUris                  []string                   `json:"redirectUris,omitempty"`
-	ResponseTypes                 []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes                    []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType               domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType                domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris        []string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                       bool                       `json:"devMode,omitempty"`
-	AccessTokenType               domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion      bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion          bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion      bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                     time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins             []string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage      bool                       `json:"skipNativeAppSuccessPage,omitempty"`
-	BackChannelLogoutURI          string                     `json:"backChannelLogoutURI,omitempty"`
-	LoginVersion                  domain.LoginVersion        `json:"loginVersion,omitempty"`
-	LoginBaseURI                  string                     `json:"loginBaseURI,omitempty"`
-	IOSTeamID                     string                     `json:"iosTeamId,omitempty"`
-	IOSBundleID                   string                     `json:"iosBundleId,omitempty"`
-	AndroidPackageName            string                     `json:"androidPackageName,omitempty"`
-	AndroidSHA256CertFingerprints []string                   `json:"androidSha256CertFingerprints,omitempty"`
+	RedirectUris                  []string                    `json:"redirectUris,omitempty"`
+	ResponseTypes                 []domain.OIDCResponseType   `json:"responseTypes,omitempty"`
+	GrantTypes                    []domain.OIDCGrantType      `json:"grantTypes,omitempty"`
+	ApplicationType               domain.OIDCApplicationType  `json:"applicationType,omitempty"`
+	AuthMethodType                domain.OIDCAuthMethodType   `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris        []string                    `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                       bool                        `json:"devMode,omitempty"`
+	AccessTokenType               domain.OIDCTokenType        `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion      bool                        `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion          bool                        `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion      bool                        `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                     time.Duration               `json:"clockSkew,omitempty"`
+	AdditionalOrigins             []string                    `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage      bool                        `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI          string                      `json:"backChannelLogoutURI,omitempty"`
+	LoginVersion                  domain.LoginVersion         `json:"loginVersion,omitempty"`
+	LoginBaseURI                  string                      `json:"loginBaseURI,omitempty"`
+	IOSTeamID                     string                      `json:"iosTeamId,omitempty"`
+	IOSBundleID                   string                      `json:"iosBundleId,omitempty"`
+	AndroidPackageName            string                      `json:"androidPackageName,omitempty"`
+	AndroidSHA256CertFingerprints []string                    `json:"androidSha256CertFingerprints,omitempty"`
+	AuthorizationDetailsTypes     []string                    `json:"authorizationDetailsTypes,omitempty"`
+	RequireConsent                bool                        `json:"requireConsent,omitempty"`
+	TokenExchangePolicy           *domain.TokenExchangePolicy `json:"tokenExchangePolicy,omitempty"`
 }
 
 func (e *OIDCConfigAddedEvent) Payload() interface{} {
@@ -91,6 +94,9 @@ func NewOIDCConfigAddedEvent(
 	iosBundleID string,
 	androidPackageName string,
 	androidSHA256CertFingerprints []string,
+	authorizationDetailsTypes []string,
+	requireConsent bool,
+	tokenExchangePolicy *domain.TokenExchangePolicy,
 ) *OIDCConfigAddedEvent {
 	return &OIDCConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -123,6 +129,9 @@ func NewOIDCConfigAddedEvent(
 		IOSBundleID:                   iosBundleID,
 		AndroidPackageName:            androidPackageName,
 		AndroidSHA256CertFingerprints: androidSHA256CertFingerprints,
+		AuthorizationDetailsTypes:     authorizationDetailsTypes,
+		RequireConsent:                requireConsent,
+		TokenExchangePolicy:           tokenExchangePolicy,
 	}
 }
 
@@ -229,7 +238,16 @@ func (e *OIDCConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 	if e.AndroidPackageName != c.AndroidPackageName {
 		return false
 	}
-	return slices.Equal(e.AndroidSHA256CertFingerprints, c.AndroidSHA256CertFingerprints)
+	if !slices.Equal(e.AndroidSHA256CertFingerprints, c.AndroidSHA256CertFingerprints) {
+		return false
+	}
+	if !slices.Equal(e.AuthorizationDetailsTypes, c.AuthorizationDetailsTypes) {
+		return false
+	}
+	if e.RequireConsent != c.RequireConsent {
+		return false
+	}
+	return e.TokenExchangePolicy.Equal(c.TokenExchangePolicy)
 }
 
 func OIDCConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
@@ -271,6 +289,9 @@ type OIDCConfigChangedEvent struct {
 	IOSBundleID                   *string                     `json:"iosBundleId,omitempty"`
 	AndroidPackageName            *string                     `json:"androidPackageName,omitempty"`
 	AndroidSHA256CertFingerprints *[]string                   `json:"androidSha256CertFingerprints,omitempty"`
+	AuthorizationDetailsTypes     *[]string                   `json:"authorizationDetailsTypes,omitempty"`
+	RequireConsent                *bool                       `json:"requireConsent,omitempty"`
+	TokenExchangePolicy           *domain.TokenExchangePolicy `json:"tokenExchangePolicy,omitempty"`
 }
 
 func (e *OIDCConfigChangedEvent) Payload() interface{} {
@@ -455,6 +476,32 @@ func ChangeAndroidSHA256CertFingerprints(fingerprints []string) func(event *OIDC
 	}
 }
 
+func ChangeAuthorizationDetailsTypes(types []string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		if types == nil {
+			// explicitly set them to empty so we can differentiate "not set" in the event in case of no changes
+			types = make([]string, 0)
+		}
+		e.AuthorizationDetailsTypes = &types
+	}
+}
+
+func ChangeRequireConsent(requireConsent bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequireConsent = &requireConsent
+	}
+}
+
+func ChangeTokenExchangePolicy(policy *domain.TokenExchangePolicy) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		if policy == nil {
+			// explicitly set an empty policy so we can differentiate "not set" in the event in case of no changes
+			policy = new(domain.TokenExchangePolicy)
+		}
+		e.TokenExchangePolicy = policy
+	}
+}
+
 func OIDCConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &OIDCConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
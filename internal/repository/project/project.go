@@ -45,6 +45,8 @@ type ProjectAddedEvent struct {
 	ProjectRoleCheck       bool                          `json:"projectRoleCheck,omitempty"`
 	HasProjectCheck        bool                          `json:"hasProjectCheck,omitempty"`
 	PrivateLabelingSetting domain.PrivateLabelingSetting `json:"privateLabelingSetting,omitempty"`
+	// ResourceURIs the project's APIs can be requested by (RFC 8707)
+	ResourceURIs []string `json:"resourceURIs,omitempty"`
 }
 
 func (*ProjectAddedEvent) EnforceResourceOwner() {}
@@ -104,6 +106,7 @@ func NewProjectAddedEvent(
 	projectRoleCheck,
 	hasProjectCheck bool,
 	privateLabelingSetting domain.PrivateLabelingSetting,
+	resourceURIs []string,
 ) *ProjectAddedEvent {
 	return &ProjectAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -116,6 +119,7 @@ func NewProjectAddedEvent(
 		ProjectRoleCheck:       projectRoleCheck,
 		HasProjectCheck:        hasProjectCheck,
 		PrivateLabelingSetting: privateLabelingSetting,
+		ResourceURIs:           resourceURIs,
 	}
 }
 
@@ -140,6 +144,7 @@ type ProjectChangeEvent struct {
 	ProjectRoleCheck       *bool                          `json:"projectRoleCheck,omitempty"`
 	HasProjectCheck        *bool                          `json:"hasProjectCheck,omitempty"`
 	PrivateLabelingSetting *domain.PrivateLabelingSetting `json:"privateLabelingSetting,omitempty"`
+	ResourceURIs           *[]string                      `json:"resourceURIs,omitempty"`
 	oldName                string
 }
 
@@ -233,6 +238,12 @@ func ChangePrivateLabelingSetting(ChangePrivateLabelingSetting domain.PrivateLab
 	}
 }
 
+func ChangeResourceURIs(resourceURIs []string) func(event *ProjectChangeEvent) {
+	return func(e *ProjectChangeEvent) {
+		e.ResourceURIs = &resourceURIs
+	}
+}
+
 func ProjectChangeEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &ProjectChangeEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
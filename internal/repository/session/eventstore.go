@@ -17,6 +17,15 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailSentType, eventstore.GenericEventMapper[OTPEmailSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailCheckedType, eventstore.GenericEventMapper[OTPEmailCheckedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RecoveryCodeCheckedType, eventstore.GenericEventMapper[RecoveryCodeCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MagicLinkChallengedType, eventstore.GenericEventMapper[MagicLinkChallengedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MagicLinkSentType, eventstore.GenericEventMapper[MagicLinkSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, MagicLinkCheckedType, eventstore.GenericEventMapper[MagicLinkCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, TrustedDeviceCheckedType, eventstore.GenericEventMapper[TrustedDeviceCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushChallengedType, eventstore.GenericEventMapper[PushChallengedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushSentType, eventstore.GenericEventMapper[PushSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushCheckedType, eventstore.GenericEventMapper[PushCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RiskEvaluatedType, eventstore.GenericEventMapper[RiskEvaluatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, NewDeviceNotificationSentType, eventstore.GenericEventMapper[NewDeviceNotificationSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, TokenSetType, TokenSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MetadataSetType, MetadataSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LifetimeSetType, eventstore.GenericEventMapper[LifetimeSetEvent])
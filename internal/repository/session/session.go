@@ -15,25 +15,38 @@ import (
 )
 
 const (
-	sessionEventPrefix      = "session."
-	AddedType               = sessionEventPrefix + "added"
-	UserCheckedType         = sessionEventPrefix + "user.checked"
-	PasswordCheckedType     = sessionEventPrefix + "password.checked"
-	IntentCheckedType       = sessionEventPrefix + "intent.checked"
-	WebAuthNChallengedType  = sessionEventPrefix + "webAuthN.challenged"
-	WebAuthNCheckedType     = sessionEventPrefix + "webAuthN.checked"
-	TOTPCheckedType         = sessionEventPrefix + "totp.checked"
-	OTPSMSChallengedType    = sessionEventPrefix + "otp.sms.challenged"
-	OTPSMSSentType          = sessionEventPrefix + "otp.sms.sent"
-	OTPSMSCheckedType       = sessionEventPrefix + "otp.sms.checked"
-	OTPEmailChallengedType  = sessionEventPrefix + "otp.email.challenged"
-	OTPEmailSentType        = sessionEventPrefix + "otp.email.sent"
-	OTPEmailCheckedType     = sessionEventPrefix + "otp.email.checked"
-	RecoveryCodeCheckedType = sessionEventPrefix + "recoveryCode.checked"
-	TokenSetType            = sessionEventPrefix + "token.set"
-	MetadataSetType         = sessionEventPrefix + "metadata.set"
-	LifetimeSetType         = sessionEventPrefix + "lifetime.set"
-	TerminateType           = sessionEventPrefix + "terminated"
+	sessionEventPrefix            = "session."
+	AddedType                     = sessionEventPrefix + "added"
+	UserCheckedType               = sessionEventPrefix + "user.checked"
+	PasswordCheckedType           = sessionEventPrefix + "password.checked"
+	IntentCheckedType             = sessionEventPrefix + "intent.checked"
+	WebAuthNChallengedType        = sessionEventPrefix + "webAuthN.challenged"
+	WebAuthNCheckedType           = sessionEventPrefix + "webAuthN.checked"
+	TOTPCheckedType               = sessionEventPrefix + "totp.checked"
+	OTPSMSChallengedType          = sessionEventPrefix + "otp.sms.challenged"
+	OTPSMSSentType                = sessionEventPrefix + "otp.sms.sent"
+	OTPSMSCheckedType             = sessionEventPrefix + "otp.sms.checked"
+	OTPEmailChallengedType        = sessionEventPrefix + "otp.email.challenged"
+	OTPEmailSentType              = sessionEventPrefix + "otp.email.sent"
+	OTPEmailCheckedType           = sessionEventPrefix + "otp.email.checked"
+	RecoveryCodeCheckedType       = sessionEventPrefix + "recoveryCode.checked"
+	MagicLinkChallengedType       = sessionEventPrefix + "magicLink.challenged"
+	MagicLinkSentType             = sessionEventPrefix + "magicLink.sent"
+	MagicLinkCheckedType          = sessionEventPrefix + "magicLink.checked"
+	TrustedDeviceCheckedType      = sessionEventPrefix + "trustedDevice.checked"
+	PushChallengedType            = sessionEventPrefix + "push.challenged"
+	PushSentType                  = sessionEventPrefix + "push.sent"
+	PushCheckedType               = sessionEventPrefix + "push.checked"
+	RiskEvaluatedType             = sessionEventPrefix + "risk.evaluated"
+	NewDeviceNotificationSentType = sessionEventPrefix + "newDevice.notification.sent"
+	TokenSetType                  = sessionEventPrefix + "token.set"
+	MetadataSetType               = sessionEventPrefix + "metadata.set"
+	LifetimeSetType               = sessionEventPrefix + "lifetime.set"
+	TerminateType                 = sessionEventPrefix + "terminated"
+
+	SessionUserSearchType     = "session_user"
+	SessionUserIDSearchField  = "user_id"
+	SessionUserObjectRevision = uint8(1)
 )
 
 type AddedEvent struct {
@@ -93,6 +106,30 @@ func (e *UserCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
 
+// Fields indexes the user of the session, so the sessions of a user can be searched
+// without scanning the payload of all [UserCheckedEvent]s.
+func (e *UserCheckedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.SetField(
+			e.Aggregate(),
+			sessionUserSearchObject(e.Aggregate().ID),
+			SessionUserIDSearchField,
+			&eventstore.Value{
+				Value:       e.UserID,
+				ShouldIndex: true,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		),
+	}
+}
+
 func NewUserCheckedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -543,6 +580,357 @@ func NewOTPEmailCheckedEvent(
 	}
 }
 
+type MagicLinkChallengedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Code              *crypto.CryptoValue `json:"code"`
+	Expiry            time.Duration       `json:"expiry"`
+	ReturnCode        bool                `json:"returnCode,omitempty"`
+	URLTmpl           string              `json:"urlTmpl,omitempty"`
+	TriggeredAtOrigin string              `json:"triggerOrigin,omitempty"`
+}
+
+func (e *MagicLinkChallengedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MagicLinkChallengedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MagicLinkChallengedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func (e *MagicLinkChallengedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewMagicLinkChallengedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+	returnCode bool,
+	urlTmpl string,
+) *MagicLinkChallengedEvent {
+	return &MagicLinkChallengedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkChallengedType,
+		),
+		Code:              code,
+		Expiry:            expiry,
+		ReturnCode:        returnCode,
+		URLTmpl:           urlTmpl,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+type MagicLinkSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *MagicLinkSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MagicLinkSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MagicLinkSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewMagicLinkSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *MagicLinkSentEvent {
+	return &MagicLinkSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkSentType,
+		),
+	}
+}
+
+type MagicLinkCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+func (e *MagicLinkCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *MagicLinkCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *MagicLinkCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewMagicLinkCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+) *MagicLinkCheckedEvent {
+	return &MagicLinkCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			MagicLinkCheckedType,
+		),
+		CheckedAt: checkedAt,
+	}
+}
+
+type TrustedDeviceCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+func (e *TrustedDeviceCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *TrustedDeviceCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *TrustedDeviceCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewTrustedDeviceCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+) *TrustedDeviceCheckedEvent {
+	return &TrustedDeviceCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			TrustedDeviceCheckedType,
+		),
+		CheckedAt: checkedAt,
+	}
+}
+
+// PushChallengeDevice is a device of the user the push challenge is sent to.
+type PushChallengeDevice struct {
+	ID        string `json:"id"`
+	PushToken string `json:"pushToken"`
+}
+
+// PushChallengedEvent requests the approval of the session on a push device of the user.
+// The user must confirm the number, which is displayed in the login UI, on the device.
+type PushChallengedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Challenge         string                `json:"challenge"`
+	Number            uint32                `json:"number"`
+	Expiry            time.Duration         `json:"expiry"`
+	Devices           []PushChallengeDevice `json:"devices"`
+	TriggeredAtOrigin string                `json:"triggerOrigin,omitempty"`
+}
+
+func (e *PushChallengedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushChallengedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushChallengedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func (e *PushChallengedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewPushChallengedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	challenge string,
+	number uint32,
+	expiry time.Duration,
+	devices []PushChallengeDevice,
+) *PushChallengedEvent {
+	return &PushChallengedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushChallengedType,
+		),
+		Challenge:         challenge,
+		Number:            number,
+		Expiry:            expiry,
+		Devices:           devices,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+type PushSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PushSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PushSentEvent {
+	return &PushSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushSentType,
+		),
+	}
+}
+
+type PushCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+	DeviceID  string    `json:"deviceId"`
+}
+
+func (e *PushCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+	deviceID string,
+) *PushCheckedEvent {
+	return &PushCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushCheckedType,
+		),
+		CheckedAt: checkedAt,
+		DeviceID:  deviceID,
+	}
+}
+
+type RiskEvaluatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID            string              `json:"userID"`
+	UserResourceOwner string              `json:"userResourceOwner"`
+	FingerprintID     string              `json:"fingerprintID,omitempty"`
+	IP                string              `json:"ip,omitempty"`
+	Location          *domain.GeoLocation `json:"location,omitempty"`
+	Score             int                 `json:"score"`
+	Signals           []domain.RiskSignal `json:"signals,omitempty"`
+	Outcome           domain.RiskOutcome  `json:"outcome"`
+	EvaluatedAt       time.Time           `json:"evaluatedAt"`
+}
+
+func (e *RiskEvaluatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RiskEvaluatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RiskEvaluatedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewRiskEvaluatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	userResourceOwner,
+	fingerprintID,
+	ip string,
+	location *domain.GeoLocation,
+	score int,
+	signals []domain.RiskSignal,
+	outcome domain.RiskOutcome,
+	evaluatedAt time.Time,
+) *RiskEvaluatedEvent {
+	return &RiskEvaluatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RiskEvaluatedType,
+		),
+		UserID:            userID,
+		UserResourceOwner: userResourceOwner,
+		FingerprintID:     fingerprintID,
+		IP:                ip,
+		Location:          location,
+		Score:             score,
+		Signals:           signals,
+		Outcome:           outcome,
+		EvaluatedAt:       evaluatedAt,
+	}
+}
+
+// NewDeviceNotificationSentEvent records that the user was alerted about the sign-in from a new device.
+type NewDeviceNotificationSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *NewDeviceNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *NewDeviceNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *NewDeviceNotificationSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewNewDeviceNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *NewDeviceNotificationSentEvent {
+	return &NewDeviceNotificationSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			NewDeviceNotificationSentType,
+		),
+	}
+}
+
 type TokenSetEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -672,6 +1060,12 @@ func (e *TerminateEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
 
+func (e *TerminateEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(e.Aggregate()),
+	}
+}
+
 func (e *TerminateEvent) TriggerOrigin() string {
 	return e.TriggeredAtOrigin
 }
@@ -728,3 +1122,11 @@ func NewRecoveryCodeCheckedEvent(
 		CheckedAt: checkedAt,
 	}
 }
+
+func sessionUserSearchObject(sessionID string) eventstore.Object {
+	return eventstore.Object{
+		Type:     SessionUserSearchType,
+		ID:       sessionID,
+		Revision: SessionUserObjectRevision,
+	}
+}
@@ -9,6 +9,12 @@ type AuthRequestInfo struct {
 	*BrowserInfo
 }
 
+// PersonalDataFields implements [eventstore.PersonalDataCommand] for all events of the user
+// which contain the information of the browser.
+func (*AuthRequestInfo) PersonalDataFields() []string {
+	return []string{"userAgent", "acceptLanguage", "remoteIP"}
+}
+
 type BrowserInfo struct {
 	UserAgent      string `json:"userAgent,omitempty"`
 	AcceptLanguage string `json:"acceptLanguage,omitempty"`
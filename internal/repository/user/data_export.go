@@ -0,0 +1,138 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	dataExportEventPrefix   = userEventTypePrefix + "data.export."
+	DataExportRequestedType = dataExportEventPrefix + "requested"
+	DataExportSucceededType = dataExportEventPrefix + "succeeded"
+	DataExportFailedType    = dataExportEventPrefix + "failed"
+	DataExportExpiredType   = dataExportEventPrefix + "expired"
+)
+
+// DataExportRequestedEvent records that an archive of all data held about the user was requested.
+// The archive is built asynchronously and identified by the ExportID.
+type DataExportRequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ExportID string `json:"exportId"`
+}
+
+func (e *DataExportRequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DataExportRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DataExportRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDataExportRequestedEvent(ctx context.Context, aggregate *eventstore.Aggregate, exportID string) *DataExportRequestedEvent {
+	return &DataExportRequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DataExportRequestedType,
+		),
+		ExportID: exportID,
+	}
+}
+
+// DataExportSucceededEvent records that the archive of the export was built and stored.
+type DataExportSucceededEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ExportID string `json:"exportId"`
+}
+
+func (e *DataExportSucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DataExportSucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DataExportSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDataExportSucceededEvent(ctx context.Context, aggregate *eventstore.Aggregate, exportID string) *DataExportSucceededEvent {
+	return &DataExportSucceededEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DataExportSucceededType,
+		),
+		ExportID: exportID,
+	}
+}
+
+// DataExportFailedEvent records that the archive of the export could not be built.
+type DataExportFailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ExportID string `json:"exportId"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+func (e *DataExportFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DataExportFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DataExportFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDataExportFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate, exportID, reason string) *DataExportFailedEvent {
+	return &DataExportFailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DataExportFailedType,
+		),
+		ExportID: exportID,
+		Reason:   reason,
+	}
+}
+
+// DataExportExpiredEvent records that the archive of the export was deleted after its lifetime.
+type DataExportExpiredEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ExportID string `json:"exportId"`
+}
+
+func (e *DataExportExpiredEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DataExportExpiredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DataExportExpiredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDataExportExpiredEvent(ctx context.Context, aggregate *eventstore.Aggregate, exportID string) *DataExportExpiredEvent {
+	return &DataExportExpiredEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DataExportExpiredType,
+		),
+		ExportID: exportID,
+	}
+}
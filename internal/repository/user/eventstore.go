@@ -81,6 +81,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPhoneVerificationFailedType, HumanPhoneVerificationFailedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPhoneCodeAddedType, HumanPhoneCodeAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPhoneCodeSentType, eventstore.GenericEventMapper[HumanPhoneCodeSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPhoneChannelSetType, eventstore.GenericEventMapper[HumanPhoneChannelSetEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanProfileChangedType, HumanProfileChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanAvatarAddedType, HumanAvatarAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanAvatarRemovedType, HumanAvatarRemovedEventMapper)
@@ -103,6 +104,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanOTPEmailCodeSentType, eventstore.GenericEventMapper[HumanOTPEmailCodeSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanOTPEmailCheckSucceededType, eventstore.GenericEventMapper[HumanOTPEmailCheckSucceededEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanOTPEmailCheckFailedType, eventstore.GenericEventMapper[HumanOTPEmailCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkCheckSucceededType, eventstore.GenericEventMapper[HumanMagicLinkCheckSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanMagicLinkCheckFailedType, eventstore.GenericEventMapper[HumanMagicLinkCheckFailedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryCodesAddedType, eventstore.GenericEventMapper[HumanRecoveryCodesAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryCodesRemovedType, eventstore.GenericEventMapper[HumanRecoveryCodesRemovedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryCodeCheckSucceededType, eventstore.GenericEventMapper[HumanRecoveryCodeCheckSucceededEvent])
@@ -142,4 +145,20 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCodeSentType, eventstore.GenericEventMapper[HumanInviteCodeSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCheckSucceededType, eventstore.GenericEventMapper[HumanInviteCheckSucceededEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCheckFailedType, eventstore.GenericEventMapper[HumanInviteCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanConsentGrantedType, eventstore.GenericEventMapper[HumanConsentGrantedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanConsentRevokedType, eventstore.GenericEventMapper[HumanConsentRevokedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceAddedType, eventstore.GenericEventMapper[HumanTrustedDeviceAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceRemovedType, eventstore.GenericEventMapper[HumanTrustedDeviceRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceAddedType, eventstore.GenericEventMapper[HumanPushDeviceAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceVerifiedType, eventstore.GenericEventMapper[HumanPushDeviceVerifiedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceRemovedType, eventstore.GenericEventMapper[HumanPushDeviceRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SecurityNotificationSentType, eventstore.GenericEventMapper[SecurityNotificationSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DataExportRequestedType, eventstore.GenericEventMapper[DataExportRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DataExportSucceededType, eventstore.GenericEventMapper[DataExportSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DataExportFailedType, eventstore.GenericEventMapper[DataExportFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DataExportExpiredType, eventstore.GenericEventMapper[DataExportExpiredEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, InactivityWarnedType, eventstore.GenericEventMapper[InactivityWarnedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, InactivityWarningSentType, eventstore.GenericEventMapper[InactivityWarningSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, InactivityDeactivatedType, eventstore.GenericEventMapper[InactivityDeactivatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, InactivityRemovedType, eventstore.GenericEventMapper[InactivityRemovedEvent])
 }
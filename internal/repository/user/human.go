@@ -28,6 +28,24 @@ const (
 	HumanSignedOutType                 = humanEventPrefix + "signed.out"
 )
 
+// humanPersonalDataFields are encrypted with the key of the user,
+// so they become unreadable after the user is removed
+var humanPersonalDataFields = []string{
+	"userName",
+	"firstName",
+	"lastName",
+	"nickName",
+	"displayName",
+	"gender",
+	"email",
+	"phone",
+	"country",
+	"locality",
+	"postalCode",
+	"region",
+	"streetAddress",
+}
+
 type HumanAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -60,6 +78,10 @@ type HumanAddedEvent struct {
 
 func (*HumanAddedEvent) EnforceResourceOwner() {}
 
+func (*HumanAddedEvent) PersonalDataFields() []string {
+	return humanPersonalDataFields
+}
+
 func (e *HumanAddedEvent) Payload() interface{} {
 	return e
 }
@@ -171,6 +193,10 @@ type HumanRegisteredEvent struct {
 
 func (*HumanRegisteredEvent) EnforceResourceOwner() {}
 
+func (*HumanRegisteredEvent) PersonalDataFields() []string {
+	return humanPersonalDataFields
+}
+
 func (e *HumanRegisteredEvent) Payload() interface{} {
 	return e
 }
@@ -22,6 +22,10 @@ type HumanAddressChangedEvent struct {
 	StreetAddress *string `json:"streetAddress,omitempty"`
 }
 
+func (*HumanAddressChangedEvent) PersonalDataFields() []string {
+	return []string{"country", "locality", "postalCode", "region", "streetAddress"}
+}
+
 func (e *HumanAddressChangedEvent) Payload() interface{} {
 	return e
 }
@@ -0,0 +1,84 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	humanConsentEventPrefix = humanEventPrefix + "consent."
+	HumanConsentGrantedType = humanConsentEventPrefix + "granted"
+	HumanConsentRevokedType = humanConsentEventPrefix + "revoked"
+)
+
+// HumanConsentGrantedEvent records that the user consented to the client
+// receiving the listed scopes. A new grant for the same client replaces the previous one.
+type HumanConsentGrantedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ClientID string   `json:"clientId"`
+	Scopes   []string `json:"scopes,omitempty"`
+}
+
+func (e *HumanConsentGrantedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanConsentGrantedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanConsentGrantedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanConsentGrantedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	scopes []string,
+) *HumanConsentGrantedEvent {
+	return &HumanConsentGrantedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanConsentGrantedType,
+		),
+		ClientID: clientID,
+		Scopes:   scopes,
+	}
+}
+
+type HumanConsentRevokedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ClientID string `json:"clientId"`
+}
+
+func (e *HumanConsentRevokedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanConsentRevokedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanConsentRevokedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanConsentRevokedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+) *HumanConsentRevokedEvent {
+	return &HumanConsentRevokedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanConsentRevokedType,
+		),
+		ClientID: clientID,
+	}
+}
@@ -26,6 +26,10 @@ type HumanEmailChangedEvent struct {
 	EmailAddress domain.EmailAddress `json:"email,omitempty"`
 }
 
+func (*HumanEmailChangedEvent) PersonalDataFields() []string {
+	return []string{"email"}
+}
+
 func (e *HumanEmailChangedEvent) Payload() interface{} {
 	return e
 }
@@ -42,6 +42,10 @@ type UserIDPLinkAddedEvent struct {
 	DisplayName    string `json:"displayName,omitempty"`
 }
 
+func (*UserIDPLinkAddedEvent) PersonalDataFields() []string {
+	return []string{"userId", "displayName"}
+}
+
 func (e *UserIDPLinkAddedEvent) Payload() interface{} {
 	return e
 }
@@ -89,6 +93,10 @@ type UserIDPLinkRemovedEvent struct {
 	ExternalUserID string `json:"userId,omitempty"`
 }
 
+func (*UserIDPLinkRemovedEvent) PersonalDataFields() []string {
+	return []string{"userId"}
+}
+
 func (e *UserIDPLinkRemovedEvent) Payload() interface{} {
 	return e
 }
@@ -134,6 +142,10 @@ type UserIDPLinkCascadeRemovedEvent struct {
 	ExternalUserID string `json:"userId,omitempty"`
 }
 
+func (*UserIDPLinkCascadeRemovedEvent) PersonalDataFields() []string {
+	return []string{"userId"}
+}
+
 func (e *UserIDPLinkCascadeRemovedEvent) Payload() interface{} {
 	return e
 }
@@ -219,6 +231,10 @@ type UserIDPExternalIDMigratedEvent struct {
 	NewID                string `json:"newId"`
 }
 
+func (*UserIDPExternalIDMigratedEvent) PersonalDataFields() []string {
+	return []string{"previousId", "newId"}
+}
+
 func (e *UserIDPExternalIDMigratedEvent) Payload() interface{} {
 	return e
 }
@@ -257,6 +273,10 @@ type UserIDPExternalUsernameEvent struct {
 	ExternalUsername     string `json:"username"`
 }
 
+func (*UserIDPExternalUsernameEvent) PersonalDataFields() []string {
+	return []string{"userId", "username"}
+}
+
 func (e *UserIDPExternalUsernameEvent) Payload() interface{} {
 	return e
 }
@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	magicLinkEventPrefix             = humanEventPrefix + "magiclink."
+	HumanMagicLinkCheckSucceededType = magicLinkEventPrefix + "check.succeeded"
+	HumanMagicLinkCheckFailedType    = magicLinkEventPrefix + "check.failed"
+)
+
+type HumanMagicLinkCheckSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+}
+
+func (e *HumanMagicLinkCheckSucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanMagicLinkCheckSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanMagicLinkCheckSucceededEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanMagicLinkCheckSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info *AuthRequestInfo,
+) *HumanMagicLinkCheckSucceededEvent {
+	return &HumanMagicLinkCheckSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMagicLinkCheckSucceededType,
+		),
+		AuthRequestInfo: info,
+	}
+}
+
+type HumanMagicLinkCheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+}
+
+func (e *HumanMagicLinkCheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanMagicLinkCheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanMagicLinkCheckFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanMagicLinkCheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info *AuthRequestInfo,
+) *HumanMagicLinkCheckFailedEvent {
+	return &HumanMagicLinkCheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanMagicLinkCheckFailedType,
+		),
+		AuthRequestInfo: info,
+	}
+}
@@ -20,6 +20,7 @@ const (
 	HumanPhoneVerificationFailedType = phoneEventPrefix + "verification.failed"
 	HumanPhoneCodeAddedType          = phoneEventPrefix + "code.added"
 	HumanPhoneCodeSentType           = phoneEventPrefix + "code.sent"
+	HumanPhoneChannelSetType         = phoneEventPrefix + "channel.set"
 )
 
 type HumanPhoneChangedEvent struct {
@@ -28,6 +29,10 @@ type HumanPhoneChangedEvent struct {
 	PhoneNumber domain.PhoneNumber `json:"phone,omitempty"`
 }
 
+func (*HumanPhoneChangedEvent) PersonalDataFields() []string {
+	return []string{"phone"}
+}
+
 func (e *HumanPhoneChangedEvent) Payload() interface{} {
 	return e
 }
@@ -240,3 +245,33 @@ func NewHumanPhoneCodeSentEvent(ctx context.Context, aggregate *eventstore.Aggre
 		GeneratorInfo: generatorInfo,
 	}
 }
+
+// HumanPhoneChannelSetEvent sets the channel notifications to the phone of the user are preferably delivered by.
+type HumanPhoneChannelSetEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Channel domain.PhoneChannel `json:"channel,omitempty"`
+}
+
+func (e *HumanPhoneChannelSetEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *HumanPhoneChannelSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPhoneChannelSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPhoneChannelSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, channel domain.PhoneChannel) *HumanPhoneChannelSetEvent {
+	return &HumanPhoneChannelSetEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPhoneChannelSetType,
+		),
+		Channel: channel,
+	}
+}
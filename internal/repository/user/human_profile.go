@@ -26,6 +26,10 @@ type HumanProfileChangedEvent struct {
 	Gender            *domain.Gender `json:"gender,omitempty"`
 }
 
+func (*HumanProfileChangedEvent) PersonalDataFields() []string {
+	return []string{"firstName", "lastName", "nickName", "displayName", "gender"}
+}
+
 func (e *HumanProfileChangedEvent) Payload() interface{} {
 	return e
 }
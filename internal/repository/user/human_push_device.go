@@ -0,0 +1,131 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	humanPushDeviceEventPrefix  = humanEventPrefix + "push_device."
+	HumanPushDeviceAddedType    = humanPushDeviceEventPrefix + "added"
+	HumanPushDeviceVerifiedType = humanPushDeviceEventPrefix + "verified"
+	HumanPushDeviceRemovedType  = humanPushDeviceEventPrefix + "removed"
+)
+
+// HumanPushDeviceAddedEvent registers a device, which approves push challenges by signing them with its private key.
+// The device is only used after it proved the possession of the private key by signing the challenge.
+type HumanPushDeviceAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DeviceID  string `json:"deviceId"`
+	Name      string `json:"name,omitempty"`
+	PublicKey []byte `json:"publicKey"`
+	PushToken string `json:"pushToken"`
+	Challenge string `json:"challenge"`
+}
+
+func (e *HumanPushDeviceAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (*HumanPushDeviceAddedEvent) PersonalDataFields() []string {
+	return []string{"name", "pushToken"}
+}
+
+func (e *HumanPushDeviceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPushDeviceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID,
+	name string,
+	publicKey []byte,
+	pushToken,
+	challenge string,
+) *HumanPushDeviceAddedEvent {
+	return &HumanPushDeviceAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceAddedType,
+		),
+		DeviceID:  deviceID,
+		Name:      name,
+		PublicKey: publicKey,
+		PushToken: pushToken,
+		Challenge: challenge,
+	}
+}
+
+type HumanPushDeviceVerifiedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanPushDeviceVerifiedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanPushDeviceVerifiedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceVerifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPushDeviceVerifiedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanPushDeviceVerifiedEvent {
+	return &HumanPushDeviceVerifiedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceVerifiedType,
+		),
+		DeviceID: deviceID,
+	}
+}
+
+type HumanPushDeviceRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanPushDeviceRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanPushDeviceRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanPushDeviceRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanPushDeviceRemovedEvent {
+	return &HumanPushDeviceRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceRemovedType,
+		),
+		DeviceID: deviceID,
+	}
+}
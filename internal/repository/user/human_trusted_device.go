@@ -0,0 +1,92 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	humanTrustedDeviceEventPrefix = humanEventPrefix + "trusted_device."
+	HumanTrustedDeviceAddedType   = humanTrustedDeviceEventPrefix + "added"
+	HumanTrustedDeviceRemovedType = humanTrustedDeviceEventPrefix + "removed"
+)
+
+// HumanTrustedDeviceAddedEvent records that the user trusts the user agent identified by the fingerprint.
+// Adding the same fingerprint again extends the trust.
+type HumanTrustedDeviceAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	FingerprintID  string    `json:"fingerprintId"`
+	Description    string    `json:"description,omitempty"`
+	ExpirationDate time.Time `json:"expirationDate"`
+}
+
+func (e *HumanTrustedDeviceAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (*HumanTrustedDeviceAddedEvent) PersonalDataFields() []string {
+	return []string{"description"}
+}
+
+func (e *HumanTrustedDeviceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTrustedDeviceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	fingerprintID,
+	description string,
+	expirationDate time.Time,
+) *HumanTrustedDeviceAddedEvent {
+	return &HumanTrustedDeviceAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceAddedType,
+		),
+		FingerprintID:  fingerprintID,
+		Description:    description,
+		ExpirationDate: expirationDate,
+	}
+}
+
+type HumanTrustedDeviceRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	FingerprintID string `json:"fingerprintId"`
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanTrustedDeviceRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	fingerprintID string,
+) *HumanTrustedDeviceRemovedEvent {
+	return &HumanTrustedDeviceRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceRemovedType,
+		),
+		FingerprintID: fingerprintID,
+	}
+}
@@ -0,0 +1,151 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	inactivityEventTypePrefix = userEventTypePrefix + "inactivity."
+
+	InactivityWarnedType      = inactivityEventTypePrefix + "warned"
+	InactivityWarningSentType = inactivityEventTypePrefix + "warning.sent"
+	InactivityDeactivatedType = inactivityEventTypePrefix + "deactivated"
+	InactivityRemovedType     = inactivityEventTypePrefix + "removed"
+)
+
+// InactivityWarnedEvent is pushed if the user was not active since LastActivity
+// and will be deactivated (or removed) at DeactivationDate if there's no further activity.
+type InactivityWarnedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	LastActivity      time.Time `json:"lastActivity"`
+	DeactivationDate  time.Time `json:"deactivationDate,omitempty"`
+	TriggeredAtOrigin string    `json:"triggerOrigin,omitempty"`
+}
+
+func (e *InactivityWarnedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *InactivityWarnedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InactivityWarnedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *InactivityWarnedEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func NewInactivityWarnedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	lastActivity,
+	deactivationDate time.Time,
+) *InactivityWarnedEvent {
+	return &InactivityWarnedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InactivityWarnedType,
+		),
+		LastActivity:      lastActivity,
+		DeactivationDate:  deactivationDate,
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
+
+// InactivityWarningSentEvent records that the user was notified about the [InactivityWarnedEvent].
+type InactivityWarningSentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *InactivityWarningSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *InactivityWarningSentEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *InactivityWarningSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityWarningSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *InactivityWarningSentEvent {
+	return &InactivityWarningSentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InactivityWarningSentType,
+		),
+	}
+}
+
+// InactivityDeactivatedEvent is pushed together with the [UserDeactivatedEvent]
+// if the user was deactivated because there was no activity since LastActivity.
+type InactivityDeactivatedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	LastActivity time.Time `json:"lastActivity"`
+}
+
+func (e *InactivityDeactivatedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *InactivityDeactivatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InactivityDeactivatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityDeactivatedEvent(ctx context.Context, aggregate *eventstore.Aggregate, lastActivity time.Time) *InactivityDeactivatedEvent {
+	return &InactivityDeactivatedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InactivityDeactivatedType,
+		),
+		LastActivity: lastActivity,
+	}
+}
+
+// InactivityRemovedEvent is pushed together with the [UserRemovedEvent]
+// if the user was removed because there was no activity since LastActivity.
+type InactivityRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	LastActivity time.Time `json:"lastActivity"`
+}
+
+func (e *InactivityRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *InactivityRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InactivityRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewInactivityRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, lastActivity time.Time) *InactivityRemovedEvent {
+	return &InactivityRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InactivityRemovedType,
+		),
+		LastActivity: lastActivity,
+	}
+}
@@ -17,6 +17,10 @@ type MetadataSetEvent struct {
 	metadata.SetEvent
 }
 
+func (*MetadataSetEvent) PersonalDataFields() []string {
+	return []string{"value"}
+}
+
 func NewMetadataSetEvent(ctx context.Context, aggregate *eventstore.Aggregate, key string, value []byte) *MetadataSetEvent {
 	return &MetadataSetEvent{
 		SetEvent: *metadata.NewSetEvent(
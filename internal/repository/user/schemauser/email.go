@@ -29,6 +29,10 @@ func (e *EmailUpdatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
 	e.BaseEvent = event
 }
 
+func (*EmailUpdatedEvent) PersonalDataFields() []string {
+	return []string{"email"}
+}
+
 func (e *EmailUpdatedEvent) Payload() interface{} {
 	return e
 }
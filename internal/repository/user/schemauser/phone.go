@@ -30,6 +30,10 @@ func (e *PhoneUpdatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
 	e.BaseEvent = event
 }
 
+func (*PhoneUpdatedEvent) PersonalDataFields() []string {
+	return []string{"phone"}
+}
+
 func (e *PhoneUpdatedEvent) Payload() interface{} {
 	return e
 }
@@ -32,6 +32,10 @@ func (e *CreatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
 	e.BaseEvent = event
 }
 
+func (*CreatedEvent) PersonalDataFields() []string {
+	return []string{"user"}
+}
+
 func (e *CreatedEvent) Payload() interface{} {
 	return e
 }
@@ -72,6 +76,10 @@ func (e *UpdatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
 	e.BaseEvent = event
 }
 
+func (*UpdatedEvent) PersonalDataFields() []string {
+	return []string{"schema"}
+}
+
 func (e *UpdatedEvent) Payload() interface{} {
 	return e
 }
@@ -124,6 +132,9 @@ func (e *DeletedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
 	e.BaseEvent = event
 }
 
+// ShredPersonalData destroys the key of the user, so the personal data of all its events becomes unreadable
+func (*DeletedEvent) ShredPersonalData() {}
+
 func (e *DeletedEvent) Payload() interface{} {
 	return e
 }
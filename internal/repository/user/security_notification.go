@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	SecurityNotificationSentType = userEventTypePrefix + "security.notification.sent"
+)
+
+// SecurityNotificationSentEvent records that the user was alerted about a sensitive change of the account.
+// TriggerType is the type of the event, which caused the notification.
+type SecurityNotificationSentEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	TriggerType eventstore.EventType `json:"triggerType"`
+}
+
+func (e *SecurityNotificationSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *SecurityNotificationSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SecurityNotificationSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSecurityNotificationSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	triggerType eventstore.EventType,
+) *SecurityNotificationSentEvent {
+	return &SecurityNotificationSentEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SecurityNotificationSentType,
+		),
+		TriggerType: triggerType,
+	}
+}
@@ -179,6 +179,9 @@ type UserRemovedEvent struct {
 	orgScopedUsername bool
 }
 
+// ShredPersonalData destroys the key of the user, so the personal data of all its events becomes unreadable
+func (*UserRemovedEvent) ShredPersonalData() {}
+
 func (e *UserRemovedEvent) Payload() interface{} {
 	return nil
 }
@@ -468,6 +471,10 @@ type UsernameChangedEvent struct {
 	organizationScopedUsernames bool
 }
 
+func (*UsernameChangedEvent) PersonalDataFields() []string {
+	return []string{"userName"}
+}
+
 func (e *UsernameChangedEvent) Payload() interface{} {
 	return e
 }
@@ -0,0 +1,114 @@
+package usergrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserGrantValiditySetType = userGrantEventTypePrefix + "validity.set"
+	UserGrantExpiredType     = userGrantEventTypePrefix + "expired"
+)
+
+// UserGrantValiditySetEvent limits the grant to a period of time.
+// Zero times remove the limit on that side of the period.
+type UserGrantValiditySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ValidFrom  time.Time `json:"validFrom,omitempty"`
+	ValidUntil time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *UserGrantValiditySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantValiditySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserGrantValiditySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	validFrom,
+	validUntil time.Time,
+) *UserGrantValiditySetEvent {
+	return &UserGrantValiditySetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantValiditySetType,
+		),
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	}
+}
+
+func UserGrantValiditySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &UserGrantValiditySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "UGRANT-ooK4e", "unable to unmarshal user grant validity")
+	}
+
+	return e, nil
+}
+
+// UserGrantExpiredEvent is pushed by the scheduled expiry check as soon as the validity of the grant ended.
+// The grant itself is kept, so it can be extended by setting a new validity.
+type UserGrantExpiredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID         string    `json:"userId,omitempty"`
+	ProjectID      string    `json:"projectId,omitempty"`
+	ProjectGrantID string    `json:"grantId,omitempty"`
+	ValidUntil     time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *UserGrantExpiredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserGrantExpiredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewUserGrantExpiredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	projectID,
+	projectGrantID string,
+	validUntil time.Time,
+) *UserGrantExpiredEvent {
+	return &UserGrantExpiredEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserGrantExpiredType,
+		),
+		UserID:         userID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		ValidUntil:     validUntil,
+	}
+}
+
+func UserGrantExpiredEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &UserGrantExpiredEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "UGRANT-Aih7a", "unable to unmarshal user grant expiry")
+	}
+
+	return e, nil
+}
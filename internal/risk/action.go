@@ -0,0 +1,78 @@
+package risk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/execution"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ContextInfo is sent to the targets of the preriskassessment function.
+// The targets can add signals and override the outcome of the evaluation.
+type ContextInfo struct {
+	Function  string               `json:"function,omitempty"`
+	SessionID string               `json:"session_id,omitempty"`
+	UserID    string               `json:"user_id,omitempty"`
+	OrgID     string               `json:"org_id,omitempty"`
+	UserAgent *domain.UserAgent    `json:"user_agent,omitempty"`
+	Location  *domain.GeoLocation  `json:"location,omitempty"`
+	Signals   []domain.RiskSignal  `json:"signals,omitempty"`
+	Score     int                  `json:"score"`
+	Outcome   string               `json:"outcome,omitempty"`
+	Response  *ContextInfoResponse `json:"response,omitempty"`
+}
+
+type ContextInfoResponse struct {
+	// Outcome overrides the evaluated outcome if set: `allow`, `require_mfa` or `deny`.
+	Outcome string `json:"outcome,omitempty"`
+	// AppendSignals are added to the signals of the evaluation.
+	AppendSignals []domain.RiskSignal `json:"append_signals,omitempty"`
+	// AddScore is added to the evaluated score.
+	AddScore int `json:"add_score,omitempty"`
+}
+
+func (c *ContextInfo) GetHTTPRequestBody() []byte {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func (c *ContextInfo) SetHTTPResponseBody(resp []byte) error {
+	if !json.Valid(resp) {
+		return zerrors.ThrowPreconditionFailed(nil, "RISK-Ied3a", "Errors.Execution.ResponseIsNotValidJSON")
+	}
+	if c.Response == nil {
+		c.Response = &ContextInfoResponse{}
+	}
+	return json.Unmarshal(resp, c.Response)
+}
+
+func (c *ContextInfo) GetContent() interface{} {
+	return c.Response
+}
+
+// actionCaller calls the targets of the preriskassessment function.
+type actionCaller func(ctx context.Context, info *ContextInfo) (*ContextInfoResponse, error)
+
+func callTargets(alg crypto.EncryptionAlgorithm, activeSigningKey execution.GetActiveSigningWebKey, client *http.Client) actionCaller {
+	return func(ctx context.Context, info *ContextInfo) (*ContextInfoResponse, error) {
+		info.Function = exec_repo.ID(domain.ExecutionTypeFunction, domain.ActionFunctionPreRiskAssessment.LocalizationKey())
+		targets := execution.QueryExecutionTargetsForFunction(ctx, info.Function)
+		if len(targets) == 0 {
+			return nil, nil
+		}
+		resp, err := execution.CallTargets(ctx, targets, info, alg, activeSigningKey, client)
+		if err != nil {
+			return nil, err
+		}
+		response, _ := resp.(*ContextInfoResponse)
+		return response, nil
+	}
+}
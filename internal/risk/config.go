@@ -0,0 +1,46 @@
+package risk
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+type Config struct {
+	// Enabled activates the risk evaluation of session checks.
+	Enabled bool
+	// GeoIPDatabase is the path to an offline GeoIP database file in CSV format.
+	// Each line describes a network: `network,country,asn,latitude,longitude`, e.g. `192.0.2.0/24,CH,65536,47.37,8.54`.
+	// Lines starting with `#` are ignored. Without a database, no location based signals are raised.
+	GeoIPDatabase string
+	// ImpossibleTravelSpeed is the maximum speed (in km/h) a user can travel between two logins.
+	ImpossibleTravelSpeed float64
+	// FailedAttempts raises a signal if checks of multiple accounts failed from the same IP address.
+	FailedAttempts FailedAttemptsConfig
+	// Scores defines the score each signal adds to the risk of a session check.
+	// Custom signals of actions, which are not listed, add no score.
+	Scores map[domain.RiskSignal]int
+	// RequireMFAScore is the score from which the session must be authenticated with multiple factors.
+	RequireMFAScore int
+	// DenyScore is the score from which the session check is denied.
+	DenyScore int
+}
+
+type FailedAttemptsConfig struct {
+	// Accounts is the number of distinct accounts with failed checks from an IP address, from which the signal is raised.
+	Accounts int
+	// Window is the duration in which the failed checks are counted.
+	Window time.Duration
+}
+
+// outcome returns the outcome for the score based on the configured thresholds.
+func (c *Config) outcome(score int) domain.RiskOutcome {
+	switch {
+	case c.DenyScore > 0 && score >= c.DenyScore:
+		return domain.RiskOutcomeDeny
+	case c.RequireMFAScore > 0 && score >= c.RequireMFAScore:
+		return domain.RiskOutcomeRequireMFA
+	default:
+		return domain.RiskOutcomeAllow
+	}
+}
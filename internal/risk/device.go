@@ -0,0 +1,57 @@
+package risk
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// deviceEvaluator is used if the risk evaluation is disabled.
+// It only records the device of the session checks and raises the new_device signal,
+// so users can still be alerted about sign-ins from new devices (see SecurityNotifications of the notification policy).
+// It never requires additional factors or denies a check.
+type deviceEvaluator struct {
+	history History
+	now     func() time.Time
+}
+
+// NewDeviceEvaluator returns an evaluator which only detects sign-ins from new devices.
+func NewDeviceEvaluator(history History) Evaluator {
+	return newDeviceEvaluator(history)
+}
+
+func newDeviceEvaluator(history History) *deviceEvaluator {
+	return &deviceEvaluator{
+		history: history,
+		now:     time.Now,
+	}
+}
+
+func (e *deviceEvaluator) Evaluate(ctx context.Context, req *Request) (_ *domain.RiskAssessment, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	assessment := &domain.RiskAssessment{
+		Outcome:     domain.RiskOutcomeAllow,
+		EvaluatedAt: e.now(),
+	}
+	fingerprintID := req.fingerprintID()
+	if fingerprintID == "" {
+		return assessment, nil
+	}
+	logins, err := e.history.LoginHistory(ctx, req.UserID, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	// without any previous login there's no baseline and therefore no signal is raised
+	if len(logins) > 0 && !slices.ContainsFunc(logins, func(login *Login) bool { return login.FingerprintID == fingerprintID }) {
+		assessment.Signals = []domain.RiskSignal{domain.RiskSignalNewDevice}
+	}
+	return assessment, nil
+}
+
+// RecordFailure is a no-op, as failed checks are only relevant for the risk score.
+func (*deviceEvaluator) RecordFailure(context.Context, *Request) {}
@@ -0,0 +1,82 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func TestDeviceEvaluator_Evaluate(t *testing.T) {
+	now := time.Now()
+	knownLogin := &Login{
+		FingerprintID: "fingerprint",
+		IP:            "192.0.2.1",
+		Time:          now.Add(-time.Hour),
+	}
+	tests := []struct {
+		name    string
+		history *mockHistory
+		req     *Request
+		want    *domain.RiskAssessment
+		wantErr error
+	}{
+		{
+			name:    "no fingerprint, no signal",
+			history: &mockHistory{logins: []*Login{knownLogin}},
+			req:     &Request{UserID: "user1"},
+			want: &domain.RiskAssessment{
+				Outcome:     domain.RiskOutcomeAllow,
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name:    "no history, no signal",
+			history: &mockHistory{},
+			req:     &Request{UserID: "user1", UserAgent: &domain.UserAgent{FingerprintID: gu.Ptr("other")}},
+			want: &domain.RiskAssessment{
+				Outcome:     domain.RiskOutcomeAllow,
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name:    "known device, no signal",
+			history: &mockHistory{logins: []*Login{knownLogin}},
+			req:     &Request{UserID: "user1", UserAgent: &domain.UserAgent{FingerprintID: gu.Ptr("fingerprint")}},
+			want: &domain.RiskAssessment{
+				Outcome:     domain.RiskOutcomeAllow,
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name:    "new device, allowed",
+			history: &mockHistory{logins: []*Login{knownLogin}},
+			req:     &Request{UserID: "user1", UserAgent: &domain.UserAgent{FingerprintID: gu.Ptr("other")}},
+			want: &domain.RiskAssessment{
+				Signals:     []domain.RiskSignal{domain.RiskSignalNewDevice},
+				Outcome:     domain.RiskOutcomeAllow,
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name:    "history error",
+			history: &mockHistory{err: errors.New("history error")},
+			req:     &Request{UserID: "user1", UserAgent: &domain.UserAgent{FingerprintID: gu.Ptr("other")}},
+			wantErr: errors.New("history error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newDeviceEvaluator(tt.history)
+			e.now = func() time.Time { return now }
+			got, err := e.Evaluate(context.Background(), tt.req)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package risk
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// minTravelDistanceKM is the distance below which no impossible travel is detected,
+// as the accuracy of GeoIP databases is limited.
+const minTravelDistanceKM = 100
+
+// Evaluator evaluates the risk of session checks.
+type Evaluator interface {
+	// Evaluate returns the risk assessment of a successful session check.
+	Evaluate(ctx context.Context, req *Request) (*domain.RiskAssessment, error)
+	// RecordFailure records a failed session check, which is used to detect attacks against many accounts.
+	RecordFailure(ctx context.Context, req *Request)
+}
+
+// Request describes the session check to evaluate.
+type Request struct {
+	SessionID     string
+	UserID        string
+	ResourceOwner string
+	UserAgent     *domain.UserAgent
+}
+
+func (r *Request) ip() string {
+	if r.UserAgent == nil || len(r.UserAgent.IP) == 0 {
+		return ""
+	}
+	return r.UserAgent.IP.String()
+}
+
+func (r *Request) fingerprintID() string {
+	if r.UserAgent == nil || r.UserAgent.FingerprintID == nil {
+		return ""
+	}
+	return *r.UserAgent.FingerprintID
+}
+
+type Engine struct {
+	config     *Config
+	geoIP      *GeoIPDatabase
+	failures   *failedAttempts
+	history    History
+	callAction actionCaller
+	now        func() time.Time
+}
+
+// NewEvaluator returns the risk engine based on the config.
+// If the evaluation is disabled, nil is returned.
+func NewEvaluator(
+	config *Config,
+	history History,
+	targetEncryptionAlgorithm crypto.EncryptionAlgorithm,
+	activeSigningKey execution.GetActiveSigningWebKey,
+	client *http.Client,
+) (Evaluator, error) {
+	if config == nil || !config.Enabled {
+		return nil, nil
+	}
+	var geoIP *GeoIPDatabase
+	if config.GeoIPDatabase != "" {
+		var err error
+		if geoIP, err = LoadGeoIPDatabase(config.GeoIPDatabase); err != nil {
+			return nil, err
+		}
+	}
+	return newEngine(config, geoIP, history, callTargets(targetEncryptionAlgorithm, activeSigningKey, client)), nil
+}
+
+func newEngine(config *Config, geoIP *GeoIPDatabase, history History, callAction actionCaller) *Engine {
+	return &Engine{
+		config:     config,
+		geoIP:      geoIP,
+		failures:   newFailedAttempts(config.FailedAttempts.Window),
+		history:    history,
+		callAction: callAction,
+		now:        time.Now,
+	}
+}
+
+func (e *Engine) Evaluate(ctx context.Context, req *Request) (_ *domain.RiskAssessment, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	assessment := &domain.RiskAssessment{
+		Location:    e.geoIP.lookup(req.UserAgent),
+		EvaluatedAt: e.now(),
+	}
+	logins, err := e.history.LoginHistory(ctx, req.UserID, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	assessment.Signals = append(assessment.Signals, e.historySignals(req, assessment.Location, logins)...)
+	if e.config.FailedAttempts.Accounts > 0 &&
+		e.failures.accounts(authz.GetInstance(ctx).InstanceID(), req.ip()) >= e.config.FailedAttempts.Accounts {
+		assessment.Signals = append(assessment.Signals, domain.RiskSignalFailedAttempts)
+	}
+	for _, signal := range assessment.Signals {
+		assessment.Score += e.config.Scores[signal]
+	}
+	assessment.Outcome = e.config.outcome(assessment.Score)
+
+	if e.callAction == nil {
+		return assessment, nil
+	}
+	resp, err := e.callAction(ctx, &ContextInfo{
+		SessionID: req.SessionID,
+		UserID:    req.UserID,
+		OrgID:     req.ResourceOwner,
+		UserAgent: req.UserAgent,
+		Location:  assessment.Location,
+		Signals:   assessment.Signals,
+		Score:     assessment.Score,
+		Outcome:   assessment.Outcome.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	e.applyActionResponse(assessment, resp)
+	return assessment, nil
+}
+
+// historySignals compares the session check to the previous logins of the user.
+// Without any previous login there's no baseline and therefore no signal is raised.
+func (e *Engine) historySignals(req *Request, location *domain.GeoLocation, logins []*Login) []domain.RiskSignal {
+	if len(logins) == 0 {
+		return nil
+	}
+	signals := make([]domain.RiskSignal, 0, 5)
+	if fingerprintID := req.fingerprintID(); fingerprintID != "" &&
+		!slices.ContainsFunc(logins, func(login *Login) bool { return login.FingerprintID == fingerprintID }) {
+		signals = append(signals, domain.RiskSignalNewDevice)
+	}
+	if ip := req.ip(); ip != "" &&
+		!slices.ContainsFunc(logins, func(login *Login) bool { return login.IP == ip }) {
+		signals = append(signals, domain.RiskSignalNewIP)
+	}
+	if location == nil {
+		return signals
+	}
+	if location.ASN != 0 &&
+		!slices.ContainsFunc(logins, func(login *Login) bool { return login.ASN == location.ASN }) {
+		signals = append(signals, domain.RiskSignalNewASN)
+	}
+	if location.Country != "" &&
+		!slices.ContainsFunc(logins, func(login *Login) bool { return login.Country == location.Country }) {
+		signals = append(signals, domain.RiskSignalNewCountry)
+	}
+	if e.impossibleTravel(location, logins) {
+		signals = append(signals, domain.RiskSignalImpossibleTravel)
+	}
+	return signals
+}
+
+// impossibleTravel checks the speed needed to travel from the location of the most recent login with coordinates.
+func (e *Engine) impossibleTravel(location *domain.GeoLocation, logins []*Login) bool {
+	if e.config.ImpossibleTravelSpeed <= 0 || !location.HasCoordinates() {
+		return false
+	}
+	for _, login := range logins {
+		previous := &domain.GeoLocation{Latitude: login.Latitude, Longitude: login.Longitude}
+		if !previous.HasCoordinates() {
+			continue
+		}
+		distance := location.DistanceKM(previous)
+		if distance < minTravelDistanceKM {
+			return false
+		}
+		hours := e.now().Sub(login.Time).Hours()
+		return hours <= 0 || distance/hours > e.config.ImpossibleTravelSpeed
+	}
+	return false
+}
+
+func (e *Engine) applyActionResponse(assessment *domain.RiskAssessment, resp *ContextInfoResponse) {
+	if resp == nil {
+		return
+	}
+	for _, signal := range resp.AppendSignals {
+		if signal == "" || slices.Contains(assessment.Signals, signal) {
+			continue
+		}
+		assessment.Signals = append(assessment.Signals, signal)
+		assessment.Score += e.config.Scores[signal]
+	}
+	assessment.Score += resp.AddScore
+	assessment.Outcome = e.config.outcome(assessment.Score)
+	if outcome := domain.RiskOutcomeFromString(resp.Outcome); outcome.Valid() {
+		assessment.Outcome = outcome
+	}
+}
+
+func (e *Engine) RecordFailure(ctx context.Context, req *Request) {
+	if e.config.FailedAttempts.Accounts <= 0 {
+		return
+	}
+	e.failures.add(authz.GetInstance(ctx).InstanceID(), req.ip(), req.UserID)
+}
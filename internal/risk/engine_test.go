@@ -0,0 +1,265 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+type mockHistory struct {
+	logins []*Login
+	err    error
+}
+
+func (h *mockHistory) LoginHistory(context.Context, string, string) ([]*Login, error) {
+	return h.logins, h.err
+}
+
+func testConfig() *Config {
+	return &Config{
+		Enabled:               true,
+		ImpossibleTravelSpeed: 1000,
+		FailedAttempts: FailedAttemptsConfig{
+			Accounts: 2,
+			Window:   time.Minute,
+		},
+		Scores: map[domain.RiskSignal]int{
+			domain.RiskSignalNewDevice:        20,
+			domain.RiskSignalNewIP:            10,
+			domain.RiskSignalNewASN:           20,
+			domain.RiskSignalNewCountry:       40,
+			domain.RiskSignalImpossibleTravel: 80,
+			domain.RiskSignalFailedAttempts:   60,
+		},
+		RequireMFAScore: 40,
+		DenyScore:       100,
+	}
+}
+
+func TestEngine_Evaluate(t *testing.T) {
+	now := time.Now()
+	geoIP, err := ParseGeoIPDatabase(strings.NewReader(testGeoIPDatabase))
+	require.NoError(t, err)
+	knownLogin := &Login{
+		FingerprintID: "fingerprint",
+		IP:            "192.0.2.1",
+		Country:       "CH",
+		ASN:           65536,
+		Latitude:      47.37,
+		Longitude:     8.54,
+		Time:          now.Add(-time.Hour),
+	}
+	userAgent := func(fingerprintID, ip string) *domain.UserAgent {
+		return &domain.UserAgent{
+			FingerprintID: gu.Ptr(fingerprintID),
+			IP:            net.ParseIP(ip),
+		}
+	}
+
+	type fields struct {
+		history    History
+		callAction actionCaller
+		failures   []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		req     *Request
+		want    *domain.RiskAssessment
+		wantErr error
+	}{
+		{
+			name: "history error",
+			fields: fields{
+				history: &mockHistory{err: errors.New("history error")},
+			},
+			req:     &Request{UserID: "user1", UserAgent: userAgent("fingerprint", "192.0.2.1")},
+			wantErr: errors.New("history error"),
+		},
+		{
+			name: "no history, allow",
+			fields: fields{
+				history: &mockHistory{},
+			},
+			req: &Request{UserID: "user1", UserAgent: userAgent("other", "198.51.100.1")},
+			want: &domain.RiskAssessment{
+				Outcome:     domain.RiskOutcomeAllow,
+				Location:    &domain.GeoLocation{Country: "US", ASN: 65538, Latitude: 40.71, Longitude: -74.01},
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name: "known login, allow",
+			fields: fields{
+				history: &mockHistory{logins: []*Login{knownLogin}},
+			},
+			req: &Request{UserID: "user1", UserAgent: userAgent("fingerprint", "192.0.2.1")},
+			want: &domain.RiskAssessment{
+				Outcome:     domain.RiskOutcomeAllow,
+				Location:    &domain.GeoLocation{Country: "CH", ASN: 65536, Latitude: 47.37, Longitude: 8.54},
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name: "new device and ip in same network, allow",
+			fields: fields{
+				history: &mockHistory{logins: []*Login{knownLogin}},
+			},
+			req: &Request{UserID: "user1", UserAgent: userAgent("other", "192.0.2.2")},
+			want: &domain.RiskAssessment{
+				Score:       30,
+				Signals:     []domain.RiskSignal{domain.RiskSignalNewDevice, domain.RiskSignalNewIP},
+				Outcome:     domain.RiskOutcomeAllow,
+				Location:    &domain.GeoLocation{Country: "CH", ASN: 65536, Latitude: 47.37, Longitude: 8.54},
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name: "new asn in same country, allow",
+			fields: fields{
+				history: &mockHistory{logins: []*Login{knownLogin}},
+			},
+			req: &Request{UserID: "user1", UserAgent: userAgent("fingerprint", "192.0.2.200")},
+			want: &domain.RiskAssessment{
+				Score:       30,
+				Signals:     []domain.RiskSignal{domain.RiskSignalNewIP, domain.RiskSignalNewASN},
+				Outcome:     domain.RiskOutcomeAllow,
+				Location:    &domain.GeoLocation{Country: "CH", ASN: 65537, Latitude: 46.95, Longitude: 7.45},
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name: "impossible travel, deny",
+			fields: fields{
+				history: &mockHistory{logins: []*Login{knownLogin}},
+			},
+			req: &Request{UserID: "user1", UserAgent: userAgent("fingerprint", "198.51.100.1")},
+			want: &domain.RiskAssessment{
+				Score:       150,
+				Signals:     []domain.RiskSignal{domain.RiskSignalNewIP, domain.RiskSignalNewASN, domain.RiskSignalNewCountry, domain.RiskSignalImpossibleTravel},
+				Outcome:     domain.RiskOutcomeDeny,
+				Location:    &domain.GeoLocation{Country: "US", ASN: 65538, Latitude: 40.71, Longitude: -74.01},
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name: "possible travel, require mfa",
+			fields: fields{
+				history: &mockHistory{logins: []*Login{
+					{
+						FingerprintID: "fingerprint",
+						IP:            "192.0.2.1",
+						Country:       "CH",
+						ASN:           65536,
+						Latitude:      47.37,
+						Longitude:     8.54,
+						Time:          now.Add(-24 * time.Hour),
+					},
+				}},
+			},
+			req: &Request{UserID: "user1", UserAgent: userAgent("fingerprint", "198.51.100.1")},
+			want: &domain.RiskAssessment{
+				Score:       70,
+				Signals:     []domain.RiskSignal{domain.RiskSignalNewIP, domain.RiskSignalNewASN, domain.RiskSignalNewCountry},
+				Outcome:     domain.RiskOutcomeRequireMFA,
+				Location:    &domain.GeoLocation{Country: "US", ASN: 65538, Latitude: 40.71, Longitude: -74.01},
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name: "failed attempts, require mfa",
+			fields: fields{
+				history:  &mockHistory{},
+				failures: []string{"user2", "user3"},
+			},
+			req: &Request{UserID: "user1", UserAgent: userAgent("fingerprint", "192.0.2.1")},
+			want: &domain.RiskAssessment{
+				Score:       60,
+				Signals:     []domain.RiskSignal{domain.RiskSignalFailedAttempts},
+				Outcome:     domain.RiskOutcomeRequireMFA,
+				Location:    &domain.GeoLocation{Country: "CH", ASN: 65536, Latitude: 47.37, Longitude: 8.54},
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name: "action error",
+			fields: fields{
+				history: &mockHistory{},
+				callAction: func(context.Context, *ContextInfo) (*ContextInfoResponse, error) {
+					return nil, errors.New("action error")
+				},
+			},
+			req:     &Request{UserID: "user1", UserAgent: userAgent("fingerprint", "192.0.2.1")},
+			wantErr: errors.New("action error"),
+		},
+		{
+			name: "action signals, require mfa",
+			fields: fields{
+				history: &mockHistory{},
+				callAction: func(_ context.Context, info *ContextInfo) (*ContextInfoResponse, error) {
+					assert.Equal(t, "user1", info.UserID)
+					assert.Equal(t, "allow", info.Outcome)
+					return &ContextInfoResponse{
+						AppendSignals: []domain.RiskSignal{domain.RiskSignalNewCountry, "tor_exit_node"},
+						AddScore:      5,
+					}, nil
+				},
+			},
+			req: &Request{UserID: "user1", UserAgent: userAgent("fingerprint", "192.0.2.1")},
+			want: &domain.RiskAssessment{
+				Score:       45,
+				Signals:     []domain.RiskSignal{domain.RiskSignalNewCountry, "tor_exit_node"},
+				Outcome:     domain.RiskOutcomeRequireMFA,
+				Location:    &domain.GeoLocation{Country: "CH", ASN: 65536, Latitude: 47.37, Longitude: 8.54},
+				EvaluatedAt: now,
+			},
+		},
+		{
+			name: "action outcome override, deny",
+			fields: fields{
+				history: &mockHistory{},
+				callAction: func(context.Context, *ContextInfo) (*ContextInfoResponse, error) {
+					return &ContextInfoResponse{Outcome: "deny"}, nil
+				},
+			},
+			req: &Request{UserID: "user1", UserAgent: userAgent("fingerprint", "192.0.2.1")},
+			want: &domain.RiskAssessment{
+				Outcome:     domain.RiskOutcomeDeny,
+				Location:    &domain.GeoLocation{Country: "CH", ASN: 65536, Latitude: 47.37, Longitude: 8.54},
+				EvaluatedAt: now,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := authz.NewMockContext("instance1", "org1", "user1")
+			e := newEngine(testConfig(), geoIP, tt.fields.history, tt.fields.callAction)
+			e.now = func() time.Time { return now }
+			for _, userID := range tt.fields.failures {
+				e.RecordFailure(ctx, &Request{UserID: userID, UserAgent: tt.req.UserAgent})
+			}
+			got, err := e.Evaluate(ctx, tt.req)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewEvaluator(t *testing.T) {
+	got, err := NewEvaluator(&Config{Enabled: false}, &mockHistory{}, nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = NewEvaluator(&Config{Enabled: true, GeoIPDatabase: "/does/not/exist.csv"}, &mockHistory{}, nil, nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,87 @@
+package risk
+
+import (
+	"sync"
+	"time"
+)
+
+// failedAttempts counts the distinct accounts with failed checks per instance and IP address
+// in a sliding window.
+// The attempts are kept in memory and are therefore only counted per ZITADEL process.
+type failedAttempts struct {
+	mu       sync.Mutex
+	window   time.Duration
+	attempts map[failedAttemptsKey]map[string]time.Time
+	cleaned  time.Time
+	now      func() time.Time
+}
+
+type failedAttemptsKey struct {
+	instanceID string
+	ip         string
+}
+
+func newFailedAttempts(window time.Duration) *failedAttempts {
+	return &failedAttempts{
+		window:   window,
+		attempts: make(map[failedAttemptsKey]map[string]time.Time),
+		now:      time.Now,
+	}
+}
+
+// add records a failed check of the user from the ip.
+func (f *failedAttempts) add(instanceID, ip, userID string) {
+	if ip == "" || userID == "" {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := failedAttemptsKey{instanceID: instanceID, ip: ip}
+	users, ok := f.attempts[key]
+	if !ok {
+		users = make(map[string]time.Time)
+		f.attempts[key] = users
+	}
+	users[userID] = f.now()
+	f.cleanup()
+}
+
+// accounts returns the number of distinct accounts with failed checks from the ip in the window.
+func (f *failedAttempts) accounts(instanceID, ip string) int {
+	if ip == "" {
+		return 0
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	since := f.now().Add(-f.window)
+	var count int
+	for _, failedAt := range f.attempts[failedAttemptsKey{instanceID: instanceID, ip: ip}] {
+		if failedAt.After(since) {
+			count++
+		}
+	}
+	return count
+}
+
+// cleanup removes all attempts outside the window at most once per window,
+// the caller must hold the lock.
+func (f *failedAttempts) cleanup() {
+	now := f.now()
+	if now.Sub(f.cleaned) < f.window {
+		return
+	}
+	f.cleaned = now
+	since := now.Add(-f.window)
+	for key, users := range f.attempts {
+		for userID, failedAt := range users {
+			if !failedAt.After(since) {
+				delete(users, userID)
+			}
+		}
+		if len(users) == 0 {
+			delete(f.attempts, key)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package risk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_failedAttempts(t *testing.T) {
+	now := time.Now()
+	f := newFailedAttempts(time.Minute)
+	f.now = func() time.Time { return now }
+
+	f.add("instance1", "192.0.2.1", "user1")
+	f.add("instance1", "192.0.2.1", "user1")
+	f.add("instance1", "192.0.2.1", "user2")
+	f.add("instance1", "192.0.2.2", "user3")
+	f.add("instance2", "192.0.2.1", "user4")
+	f.add("instance1", "", "user5")
+
+	assert.Equal(t, 2, f.accounts("instance1", "192.0.2.1"))
+	assert.Equal(t, 1, f.accounts("instance1", "192.0.2.2"))
+	assert.Equal(t, 1, f.accounts("instance2", "192.0.2.1"))
+	assert.Equal(t, 0, f.accounts("instance1", ""))
+
+	now = now.Add(30 * time.Second)
+	f.add("instance1", "192.0.2.1", "user3")
+	assert.Equal(t, 3, f.accounts("instance1", "192.0.2.1"))
+
+	now = now.Add(45 * time.Second)
+	assert.Equal(t, 1, f.accounts("instance1", "192.0.2.1"))
+
+	now = now.Add(time.Minute)
+	f.add("instance1", "192.0.2.3", "user1")
+	assert.Len(t, f.attempts, 1)
+}
@@ -0,0 +1,128 @@
+package risk
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GeoIPDatabase resolves the location of IP addresses from an offline database.
+// The most specific network containing the address is used.
+type GeoIPDatabase struct {
+	networks map[netip.Prefix]*domain.GeoLocation
+	// prefixLengths contains the distinct prefix lengths of the networks, longest first
+	prefixLengths []int
+}
+
+// LoadGeoIPDatabase reads the CSV database file, see [Config.GeoIPDatabase] for the format.
+func LoadGeoIPDatabase(path string) (*GeoIPDatabase, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "RISK-Ohl4e", "Errors.Internal")
+	}
+	defer file.Close()
+	return ParseGeoIPDatabase(file)
+}
+
+// ParseGeoIPDatabase reads a database in CSV format, see [Config.GeoIPDatabase].
+func ParseGeoIPDatabase(r io.Reader) (*GeoIPDatabase, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	db := &GeoIPDatabase{
+		networks: make(map[netip.Prefix]*domain.GeoLocation),
+	}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "RISK-Dee5o", "invalid geoip database")
+		}
+		prefix, location, err := parseGeoIPRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		db.networks[prefix.Masked()] = location
+		if !slices.Contains(db.prefixLengths, prefix.Bits()) {
+			db.prefixLengths = append(db.prefixLengths, prefix.Bits())
+		}
+	}
+	slices.Sort(db.prefixLengths)
+	slices.Reverse(db.prefixLengths)
+	return db, nil
+}
+
+func parseGeoIPRecord(record []string) (netip.Prefix, *domain.GeoLocation, error) {
+	if len(record) < 2 {
+		return netip.Prefix{}, nil, zerrors.ThrowInvalidArgumentf(nil, "RISK-Zai4u", "invalid geoip record %v", record)
+	}
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(record[0]))
+	if err != nil {
+		return netip.Prefix{}, nil, zerrors.ThrowInvalidArgumentf(err, "RISK-ooT3a", "invalid network %q", record[0])
+	}
+	location := &domain.GeoLocation{
+		Country: strings.ToUpper(strings.TrimSpace(record[1])),
+	}
+	if len(record) > 2 && record[2] != "" {
+		asn, err := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(record[2]), "AS"), 10, 32)
+		if err != nil {
+			return netip.Prefix{}, nil, zerrors.ThrowInvalidArgumentf(err, "RISK-Eiv9o", "invalid asn %q", record[2])
+		}
+		location.ASN = uint32(asn)
+	}
+	if len(record) > 4 && record[3] != "" && record[4] != "" {
+		if location.Latitude, err = strconv.ParseFloat(record[3], 64); err != nil {
+			return netip.Prefix{}, nil, zerrors.ThrowInvalidArgumentf(err, "RISK-Nae2i", "invalid latitude %q", record[3])
+		}
+		if location.Longitude, err = strconv.ParseFloat(record[4], 64); err != nil {
+			return netip.Prefix{}, nil, zerrors.ThrowInvalidArgumentf(err, "RISK-aeX6i", "invalid longitude %q", record[4])
+		}
+	}
+	return prefix, location, nil
+}
+
+// Lookup returns the location of the most specific network containing the ip
+// or nil if the ip is not part of the database.
+func (db *GeoIPDatabase) Lookup(ip net.IP) *domain.GeoLocation {
+	if db == nil || len(ip) == 0 {
+		return nil
+	}
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return nil
+	}
+	addr = addr.Unmap()
+	for _, bits := range db.prefixLengths {
+		if bits > addr.BitLen() {
+			continue
+		}
+		prefix, err := addr.Prefix(bits)
+		if err != nil {
+			continue
+		}
+		if location, ok := db.networks[prefix]; ok {
+			return location
+		}
+	}
+	return nil
+}
+
+func (db *GeoIPDatabase) lookup(userAgent *domain.UserAgent) *domain.GeoLocation {
+	if userAgent == nil {
+		return nil
+	}
+	return db.Lookup(userAgent.IP)
+}
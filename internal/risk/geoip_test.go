@@ -0,0 +1,106 @@
+package risk
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const testGeoIPDatabase = `# network,country,asn,latitude,longitude
+192.0.2.0/24,ch,AS65536,47.37,8.54
+192.0.2.128/25,CH,65537,46.95,7.45
+198.51.100.0/24,US,65538,40.71,-74.01
+2001:db8::/32,DE,,,
+`
+
+func TestParseGeoIPDatabase(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: testGeoIPDatabase,
+		},
+		{
+			name:    "invalid network",
+			data:    "192.0.2.0,CH,65536,47.37,8.54",
+			wantErr: true,
+		},
+		{
+			name:    "invalid asn",
+			data:    "192.0.2.0/24,CH,asn,47.37,8.54",
+			wantErr: true,
+		},
+		{
+			name:    "invalid latitude",
+			data:    "192.0.2.0/24,CH,65536,north,8.54",
+			wantErr: true,
+		},
+		{
+			name:    "missing country",
+			data:    "192.0.2.0/24",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseGeoIPDatabase(strings.NewReader(tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGeoIPDatabase_Lookup(t *testing.T) {
+	db, err := ParseGeoIPDatabase(strings.NewReader(testGeoIPDatabase))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		ip   net.IP
+		want *domain.GeoLocation
+	}{
+		{
+			name: "no ip",
+		},
+		{
+			name: "unknown network",
+			ip:   net.ParseIP("203.0.113.1"),
+		},
+		{
+			name: "network",
+			ip:   net.ParseIP("192.0.2.1"),
+			want: &domain.GeoLocation{Country: "CH", ASN: 65536, Latitude: 47.37, Longitude: 8.54},
+		},
+		{
+			name: "most specific network",
+			ip:   net.ParseIP("192.0.2.200"),
+			want: &domain.GeoLocation{Country: "CH", ASN: 65537, Latitude: 46.95, Longitude: 7.45},
+		},
+		{
+			name: "ipv4 as 16 byte representation",
+			ip:   net.ParseIP("198.51.100.1").To16(),
+			want: &domain.GeoLocation{Country: "US", ASN: 65538, Latitude: 40.71, Longitude: -74.01},
+		},
+		{
+			name: "ipv6 without asn and coordinates",
+			ip:   net.ParseIP("2001:db8::1"),
+			want: &domain.GeoLocation{Country: "DE"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, db.Lookup(tt.ip))
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package risk
+
+import (
+	"context"
+	"time"
+)
+
+// Login is a previous login of a user, used as baseline for the evaluation.
+type Login struct {
+	FingerprintID string
+	IP            string
+	Country       string
+	ASN           uint32
+	Latitude      float64
+	Longitude     float64
+	Time          time.Time
+}
+
+// History provides the previous logins of a user, most recent first.
+type History interface {
+	LoginHistory(ctx context.Context, userID, excludeSessionID string) ([]*Login, error)
+}
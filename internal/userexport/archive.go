@@ -0,0 +1,214 @@
+package userexport
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// Archive contains all data held about a user.
+type Archive struct {
+	ExportID    string                `json:"exportId"`
+	UserID      string                `json:"userId"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	User        *query.User           `json:"user"`
+	Metadata    []*query.UserMetadata `json:"metadata"`
+	Grants      []*query.UserGrant    `json:"grants"`
+	Memberships []*query.Membership   `json:"memberships"`
+	IDPLinks    []*query.IDPUserLink  `json:"idpLinks"`
+	Sessions    []*query.Session      `json:"sessions"`
+	AuthMethods []*query.AuthMethod   `json:"authMethods"`
+	Events      []*Event              `json:"events"`
+}
+
+// Event is an event of the user aggregate.
+// Secrets, hashes and codes of the payload are redacted.
+type Event struct {
+	Sequence     uint64          `json:"sequence"`
+	CreationDate time.Time       `json:"creationDate"`
+	Type         string          `json:"type"`
+	Creator      string          `json:"creator"`
+	Payload      json.RawMessage `json:"payload,omitempty"`
+}
+
+type Queries interface {
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	SearchUserMetadata(ctx context.Context, shouldTriggerBulk bool, userID string, queries *query.UserMetadataSearchQueries, permissionCheck domain.PermissionCheck) (*query.UserMetadataList, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool, permissionCheck domain.PermissionCheck) (*query.UserGrants, error)
+	Memberships(ctx context.Context, queries *query.MembershipSearchQuery, shouldTrigger bool) (*query.Memberships, error)
+	IDPUserLinks(ctx context.Context, queries *query.IDPUserLinksSearchQuery, permissionCheck domain.PermissionCheck) (*query.IDPUserLinks, error)
+	SearchSessions(ctx context.Context, queries *query.SessionsSearchQueries, permissionCheck domain.PermissionCheck) (*query.Sessions, error)
+	SearchUserAuthMethods(ctx context.Context, queries *query.UserAuthMethodSearchQueries, permissionCheck domain.PermissionCheck) (*query.AuthMethods, error)
+}
+
+type Eventstore interface {
+	FilterToQueryReducer(ctx context.Context, reducer eventstore.QueryReducer) error
+}
+
+// BuildArchive collects the data held about the user from the projections and the full event history of the user aggregate.
+// The audit log retention is not applied, as the archive must be complete.
+func BuildArchive(ctx context.Context, queries Queries, es Eventstore, userID, exportID string, now time.Time) (_ *Archive, err error) {
+	archive := &Archive{
+		ExportID:  exportID,
+		UserID:    userID,
+		CreatedAt: now,
+	}
+	if archive.User, err = queries.GetUserByID(ctx, true, userID); err != nil {
+		return nil, err
+	}
+	metadata, err := queries.SearchUserMetadata(ctx, true, userID, &query.UserMetadataSearchQueries{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	archive.Metadata = metadata.Metadata
+
+	grantUserQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{grantUserQuery}}, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	archive.Grants = grants.UserGrants
+
+	membershipUserQuery, err := query.NewMembershipUserIDQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := queries.Memberships(ctx, &query.MembershipSearchQuery{Queries: []query.SearchQuery{membershipUserQuery}}, true)
+	if err != nil {
+		return nil, err
+	}
+	archive.Memberships = memberships.Memberships
+
+	linkUserQuery, err := query.NewIDPUserLinksUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := queries.IDPUserLinks(ctx, &query.IDPUserLinksSearchQuery{Queries: []query.SearchQuery{linkUserQuery}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	archive.IDPLinks = links.Links
+
+	sessionUserQuery, err := query.NewUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := queries.SearchSessions(ctx, &query.SessionsSearchQueries{Queries: []query.SearchQuery{sessionUserQuery}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	archive.Sessions = sessions.Sessions
+
+	authMethodUserQuery, err := query.NewUserAuthMethodUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	authMethods, err := queries.SearchUserAuthMethods(ctx, &query.UserAuthMethodSearchQueries{Queries: []query.SearchQuery{authMethodUserQuery}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	archive.AuthMethods = authMethods.AuthMethods
+
+	events := &eventsArchive{userID: userID}
+	if err = es.FilterToQueryReducer(ctx, events); err != nil {
+		return nil, err
+	}
+	archive.Events = events.events
+	return archive, nil
+}
+
+// eventsArchive converts the events of the user aggregate while they are filtered,
+// so large histories are not kept in memory twice.
+type eventsArchive struct {
+	userID string
+	events []*Event
+	err    error
+}
+
+func (a *eventsArchive) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		payload, err := redactPayload(event.DataAsBytes())
+		if err != nil {
+			a.err = err
+			return
+		}
+		a.events = append(a.events, &Event{
+			Sequence:     event.Sequence(),
+			CreationDate: event.CreatedAt(),
+			Type:         string(event.Type()),
+			Creator:      event.Creator(),
+			Payload:      payload,
+		})
+	}
+}
+
+func (a *eventsArchive) Reduce() error {
+	return a.err
+}
+
+func (a *eventsArchive) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(a.userID).
+		Builder()
+}
+
+const redacted = "[REDACTED]"
+
+// redactedKeys are the payload fields of the user events containing secrets, hashes or codes.
+var redactedKeys = map[string]bool{
+	"code":         true,
+	"codes":        true,
+	"secret":       true,
+	"otpSecret":    true,
+	"encodedHash":  true,
+	"hashedSecret": true,
+	"clientSecret": true,
+	"refreshToken": true,
+	"pushToken":    true,
+}
+
+func redactPayload(payload []byte) (json.RawMessage, error) {
+	if len(payload) == 0 {
+		return nil, nil
+	}
+	var data any
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, err
+	}
+	return json.Marshal(redactValue(data))
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		// encrypted values (crypto.CryptoValue) are redacted regardless of their key
+		if _, ok := v["crypted"]; ok {
+			return redacted
+		}
+		for key, field := range v {
+			if redactedKeys[key] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(field)
+		}
+		return v
+	case []any:
+		for i, field := range v {
+			v[i] = redactValue(field)
+		}
+		return v
+	default:
+		return value
+	}
+}
@@ -0,0 +1,156 @@
+package userexport
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testQueries struct {
+	err error
+}
+
+func (q *testQueries) GetUserByID(_ context.Context, _ bool, userID string) (*query.User, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &query.User{ID: userID, ResourceOwner: "org1", Username: "username"}, nil
+}
+
+func (q *testQueries) SearchUserMetadata(context.Context, bool, string, *query.UserMetadataSearchQueries, domain.PermissionCheck) (*query.UserMetadataList, error) {
+	return &query.UserMetadataList{Metadata: []*query.UserMetadata{{Key: "key", Value: []byte("value")}}}, nil
+}
+
+func (q *testQueries) UserGrants(context.Context, *query.UserGrantsQueries, bool, domain.PermissionCheck) (*query.UserGrants, error) {
+	return &query.UserGrants{UserGrants: []*query.UserGrant{{ID: "grant1", Roles: []string{"role"}}}}, nil
+}
+
+func (q *testQueries) Memberships(context.Context, *query.MembershipSearchQuery, bool) (*query.Memberships, error) {
+	return &query.Memberships{}, nil
+}
+
+func (q *testQueries) IDPUserLinks(context.Context, *query.IDPUserLinksSearchQuery, domain.PermissionCheck) (*query.IDPUserLinks, error) {
+	return &query.IDPUserLinks{Links: []*query.IDPUserLink{{IDPID: "idp1", ProvidedUserID: "external1"}}}, nil
+}
+
+func (q *testQueries) SearchSessions(context.Context, *query.SessionsSearchQueries, domain.PermissionCheck) (*query.Sessions, error) {
+	return &query.Sessions{Sessions: []*query.Session{{ID: "session1"}}}, nil
+}
+
+func (q *testQueries) SearchUserAuthMethods(context.Context, *query.UserAuthMethodSearchQueries, domain.PermissionCheck) (*query.AuthMethods, error) {
+	return &query.AuthMethods{}, nil
+}
+
+type testEventstore struct {
+	events []eventstore.Event
+}
+
+func (es *testEventstore) FilterToQueryReducer(_ context.Context, reducer eventstore.QueryReducer) error {
+	for _, event := range es.events {
+		reducer.AppendEvents(event)
+		if err := reducer.Reduce(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func testEvent(seq uint64, eventType eventstore.EventType, data string) eventstore.Event {
+	return &eventstore.BaseEvent{
+		EventType: eventType,
+		Agg:       &user.NewAggregate("user1", "org1").Aggregate,
+		Seq:       seq,
+		Creation:  time.Date(2024, 1, 1, 0, 0, int(seq), 0, time.UTC),
+		User:      "admin1",
+		Data:      []byte(data),
+	}
+}
+
+func TestBuildArchive(t *testing.T) {
+	now := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	t.Run("query error", func(t *testing.T) {
+		_, err := BuildArchive(context.Background(), &testQueries{err: zerrors.ThrowNotFound(nil, "QUERY-id", "Errors.User.NotFound")}, &testEventstore{}, "user1", "export1", now)
+		assert.ErrorIs(t, err, zerrors.ThrowNotFound(nil, "QUERY-id", "Errors.User.NotFound"))
+	})
+	t.Run("archive built", func(t *testing.T) {
+		es := &testEventstore{events: []eventstore.Event{
+			testEvent(1, user.HumanAddedType, `{"userName":"username"}`),
+			testEvent(2, user.HumanPasswordChangedType, `{"encodedHash":"$2a$14$hash"}`),
+		}}
+		archive, err := BuildArchive(context.Background(), &testQueries{}, es, "user1", "export1", now)
+		require.NoError(t, err)
+		assert.Equal(t, "export1", archive.ExportID)
+		assert.Equal(t, now, archive.CreatedAt)
+		assert.Equal(t, "username", archive.User.Username)
+		assert.Len(t, archive.Metadata, 1)
+		assert.Len(t, archive.Grants, 1)
+		assert.Len(t, archive.IDPLinks, 1)
+		assert.Len(t, archive.Sessions, 1)
+		require.Len(t, archive.Events, 2)
+		assert.Equal(t, &Event{
+			Sequence:     2,
+			CreationDate: time.Date(2024, 1, 1, 0, 0, 2, 0, time.UTC),
+			Type:         string(user.HumanPasswordChangedType),
+			Creator:      "admin1",
+			Payload:      json.RawMessage(`{"encodedHash":"[REDACTED]"}`),
+		}, archive.Events[1])
+	})
+}
+
+func Test_redactPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:    "invalid",
+			payload: `{`,
+			wantErr: true,
+		},
+		{
+			name:    "nothing to redact",
+			payload: `{"email":"test@example.com","phone":"+41791234567"}`,
+			want:    `{"email":"test@example.com","phone":"+41791234567"}`,
+		},
+		{
+			name:    "secrets redacted",
+			payload: `{"secret":"otp","encodedHash":"hash","codes":["code1","code2"],"userAgentID":"agent"}`,
+			want:    `{"codes":"[REDACTED]","encodedHash":"[REDACTED]","secret":"[REDACTED]","userAgentID":"agent"}`,
+		},
+		{
+			name:    "encrypted value redacted",
+			payload: `{"expiry":300000000000,"otp":{"cryptoType":0,"algorithm":"aes","keyId":"key","crypted":"c2VjcmV0"},"nested":[{"code":"1234"}]}`,
+			want:    `{"expiry":300000000000,"nested":[{"code":"[REDACTED]"}],"otp":"[REDACTED]"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := redactPayload([]byte(tt.payload))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want == "" {
+				assert.Nil(t, got)
+				return
+			}
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
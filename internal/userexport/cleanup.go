@@ -0,0 +1,130 @@
+package userexport
+
+import (
+	"context"
+
+	"github.com/riverqueue/river"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/static"
+)
+
+var (
+	_ river.Worker[*Expiry]  = (*ExpiryWorker)(nil)
+	_ river.Worker[*Removal] = (*RemovalWorker)(nil)
+)
+
+// ExpiryWorker deletes the archive of an export after its lifetime.
+type ExpiryWorker struct {
+	river.WorkerDefaults[*Expiry]
+
+	commands Commands
+	storage  static.Storage
+}
+
+func NewExpiryWorker(commands Commands, storage static.Storage) *ExpiryWorker {
+	return &ExpiryWorker{
+		commands: commands,
+		storage:  storage,
+	}
+}
+
+// Register implements the [queue.Worker] interface.
+// The jobs are processed by the queue of the [Worker].
+func (w *ExpiryWorker) Register(workers *river.Workers, _ map[string]river.QueueConfig) {
+	river.AddWorker[*Expiry](workers, w)
+}
+
+// Work implements [river.Worker].
+// The archive is deleted before the expiry is pushed, so a failed deletion is retried.
+func (w *ExpiryWorker) Work(ctx context.Context, job *river.Job[*Expiry]) error {
+	aggregate := job.Args.Aggregate
+	ctx = authz.WithInstanceID(authz.SetCtxData(ctx, authz.CtxData{UserID: ExportUserID, OrgID: aggregate.ResourceOwner}), aggregate.InstanceID)
+
+	if err := w.storage.RemoveObject(ctx, aggregate.InstanceID, aggregate.ResourceOwner, ObjectName(aggregate.ID, job.Args.ExportID)); err != nil {
+		return err
+	}
+	return w.commands.UserDataExportExpired(ctx, aggregate.ID, aggregate.ResourceOwner, job.Args.ExportID)
+}
+
+// RemovalWorker deletes the archives of a removed user or of all users of a removed organization.
+type RemovalWorker struct {
+	river.WorkerDefaults[*Removal]
+
+	eventstore Eventstore
+	storage    static.Storage
+}
+
+func NewRemovalWorker(eventstore Eventstore, storage static.Storage) *RemovalWorker {
+	return &RemovalWorker{
+		eventstore: eventstore,
+		storage:    storage,
+	}
+}
+
+// Register implements the [queue.Worker] interface.
+// The jobs are processed by the queue of the [Worker].
+func (w *RemovalWorker) Register(workers *river.Workers, _ map[string]river.QueueConfig) {
+	river.AddWorker[*Removal](workers, w)
+}
+
+// Work implements [river.Worker].
+func (w *RemovalWorker) Work(ctx context.Context, job *river.Job[*Removal]) error {
+	aggregate := job.Args.Aggregate
+	ctx = authz.WithInstanceID(authz.SetCtxData(ctx, authz.CtxData{UserID: ExportUserID, OrgID: aggregate.ResourceOwner}), aggregate.InstanceID)
+
+	if aggregate.Type == org.AggregateType {
+		return w.storage.RemoveObjects(ctx, aggregate.InstanceID, aggregate.ID, static.ObjectTypeUserDataExport)
+	}
+	exports := &storedExports{userID: aggregate.ID}
+	if err := w.eventstore.FilterToQueryReducer(ctx, exports); err != nil {
+		return err
+	}
+	for _, exportID := range exports.exportIDs {
+		if err := w.storage.RemoveObject(ctx, aggregate.InstanceID, aggregate.ResourceOwner, ObjectName(aggregate.ID, exportID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storedExports collects the exports of the user whose archive was stored and not yet deleted.
+type storedExports struct {
+	userID    string
+	exportIDs []string
+}
+
+func (e *storedExports) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch event := event.(type) {
+		case *user.DataExportSucceededEvent:
+			e.exportIDs = append(e.exportIDs, event.ExportID)
+		case *user.DataExportExpiredEvent:
+			for i, exportID := range e.exportIDs {
+				if exportID == event.ExportID {
+					e.exportIDs = append(e.exportIDs[:i], e.exportIDs[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+}
+
+func (e *storedExports) Reduce() error {
+	return nil
+}
+
+func (e *storedExports) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(e.userID).
+		EventTypes(
+			user.DataExportSucceededType,
+			user.DataExportExpiredType,
+		).
+		Builder()
+}
@@ -0,0 +1,114 @@
+package userexport
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/riverqueue/river"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/static/mock"
+)
+
+func TestExpiryWorker_Work(t *testing.T) {
+	job := &river.Job[*Expiry]{
+		Args: &Expiry{
+			Aggregate: &user.NewAggregate("user1", "org1").Aggregate,
+			ExportID:  "export1",
+		},
+	}
+	t.Run("storage error, not expired", func(t *testing.T) {
+		commands := new(testCommands)
+		w := NewExpiryWorker(commands, mock.NewStorage(t).ExpectRemoveObjectError())
+		err := w.Work(context.Background(), job)
+		assert.Error(t, err)
+		assert.Empty(t, commands.expired)
+	})
+	t.Run("command error", func(t *testing.T) {
+		commands := &testCommands{err: errors.New("push failed")}
+		w := NewExpiryWorker(commands, mock.NewStorage(t).ExpectRemoveObjectNoError())
+		err := w.Work(context.Background(), job)
+		assert.Error(t, err)
+	})
+	t.Run("archive deleted, expired", func(t *testing.T) {
+		commands := new(testCommands)
+		storage := mock.NewStorage(t)
+		storage.EXPECT().RemoveObject(gomock.Any(), "", "org1", "user_data_export/user1/export1.json").Return(nil)
+		w := NewExpiryWorker(commands, storage)
+		err := w.Work(context.Background(), job)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"export1"}, commands.expired)
+	})
+}
+
+func TestRemovalWorker_Work(t *testing.T) {
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name      string
+		aggregate *eventstore.Aggregate
+		events    []eventstore.Event
+		storage   func(*testing.T) static.Storage
+		wantErr   bool
+	}{
+		{
+			name:      "no exports",
+			aggregate: userAgg,
+			storage: func(t *testing.T) static.Storage {
+				return mock.NewStorage(t)
+			},
+		},
+		{
+			name:      "stored exports deleted",
+			aggregate: userAgg,
+			events: []eventstore.Event{
+				user.NewDataExportSucceededEvent(context.Background(), userAgg, "export1"),
+				user.NewDataExportSucceededEvent(context.Background(), userAgg, "export2"),
+				user.NewDataExportExpiredEvent(context.Background(), userAgg, "export1"),
+				user.NewDataExportSucceededEvent(context.Background(), userAgg, "export3"),
+			},
+			storage: func(t *testing.T) static.Storage {
+				storage := mock.NewStorage(t)
+				storage.EXPECT().RemoveObject(gomock.Any(), "", "org1", "user_data_export/user1/export2.json").Return(nil)
+				storage.EXPECT().RemoveObject(gomock.Any(), "", "org1", "user_data_export/user1/export3.json").Return(nil)
+				return storage
+			},
+		},
+		{
+			name:      "storage error",
+			aggregate: userAgg,
+			events: []eventstore.Event{
+				user.NewDataExportSucceededEvent(context.Background(), userAgg, "export1"),
+			},
+			storage: func(t *testing.T) static.Storage {
+				return mock.NewStorage(t).ExpectRemoveObjectError()
+			},
+			wantErr: true,
+		},
+		{
+			name:      "organization removed, all exports deleted",
+			aggregate: &org.NewAggregate("org1").Aggregate,
+			storage: func(t *testing.T) static.Storage {
+				storage := mock.NewStorage(t)
+				storage.EXPECT().RemoveObjects(gomock.Any(), "", "org1", static.ObjectTypeUserDataExport).Return(nil)
+				return storage
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewRemovalWorker(&testEventstore{events: tt.events}, tt.storage(t))
+			err := w.Work(context.Background(), &river.Job[*Removal]{Args: &Removal{Aggregate: tt.aggregate}})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package userexport
+
+import (
+	"context"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserDataExportsProjectionTable = "projections.user_data_exports"
+)
+
+type Queue interface {
+	Insert(ctx context.Context, args river.JobArgs, opts ...queue.InsertOpt) error
+}
+
+// requestHandler hands the requested exports over to the queue,
+// so large event histories don't block the projection.
+// It also schedules the deletion of the stored archives after their lifetime
+// and as soon as the user or organization is removed.
+type requestHandler struct {
+	queue       Queue
+	maxAttempts uint8
+	lifetime    time.Duration
+}
+
+func NewRequestHandler(
+	ctx context.Context,
+	config handler.Config,
+	queue Queue,
+	maxAttempts uint8,
+	lifetime time.Duration,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &requestHandler{
+		queue:       queue,
+		maxAttempts: maxAttempts,
+		lifetime:    lifetime,
+	})
+}
+
+func (*requestHandler) Name() string {
+	return UserDataExportsProjectionTable
+}
+
+func (h *requestHandler) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.DataExportRequestedType,
+					Reduce: h.reduceDataExportRequested,
+				},
+				{
+					Event:  user.DataExportSucceededType,
+					Reduce: h.reduceDataExportSucceeded,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: h.reduceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: h.reduceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (h *requestHandler) reduceDataExportRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.DataExportRequestedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ooN2a", "reduce.wrong.event.type %s", user.DataExportRequestedType)
+	}
+
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		return h.queue.Insert(ctx,
+			&Request{
+				Aggregate: e.Aggregate(),
+				ExportID:  e.ExportID,
+			},
+			queue.WithQueueName(QueueName),
+			queue.WithMaxAttempts(h.maxAttempts),
+		)
+	}), nil
+}
+
+func (h *requestHandler) reduceDataExportSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.DataExportSucceededEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Shoo6", "reduce.wrong.event.type %s", user.DataExportSucceededType)
+	}
+
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		return h.queue.Insert(ctx,
+			&Expiry{
+				Aggregate: e.Aggregate(),
+				ExportID:  e.ExportID,
+			},
+			queue.WithQueueName(QueueName),
+			queue.WithMaxAttempts(h.maxAttempts),
+			queue.WithScheduledAt(e.CreatedAt().Add(h.lifetime)),
+		)
+	}), nil
+}
+
+// reduceRemoved deletes the archives of the removed user or of all users of the removed organization.
+func (h *requestHandler) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	switch event.Type() {
+	case user.UserRemovedType, org.OrgRemovedEventType:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ieb3a", "reduce.wrong.event.type %v", []eventstore.EventType{user.UserRemovedType, org.OrgRemovedEventType})
+	}
+
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		return h.queue.Insert(ctx,
+			&Removal{
+				Aggregate: event.Aggregate(),
+			},
+			queue.WithQueueName(QueueName),
+			queue.WithMaxAttempts(h.maxAttempts),
+		)
+	}), nil
+}
@@ -0,0 +1,46 @@
+package userexport
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/static"
+)
+
+var (
+	projections []*handler.Handler
+)
+
+func Register(
+	ctx context.Context,
+	handlerCustomConfig projection.CustomConfig,
+	workerConfig WorkerConfig,
+	commands *command.Commands,
+	queries *query.Queries,
+	es *eventstore.Eventstore,
+	storage static.Storage,
+	queue *queue.Queue,
+) {
+	queue.ShouldStart()
+
+	// make sure the slice does not contain old values
+	projections = nil
+
+	projections = append(projections, NewRequestHandler(ctx, projection.ApplyCustomConfig(handlerCustomConfig), queue, workerConfig.MaxAttempts, workerConfig.Lifetime))
+	queue.AddWorkers(ctx,
+		NewWorker(workerConfig, commands, queries, es, storage),
+		NewExpiryWorker(commands, storage),
+		NewRemovalWorker(es, storage),
+	)
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+}
@@ -0,0 +1,45 @@
+package userexport
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	QueueName = "user_data_export"
+
+	// ObjectNamePrefix prefixes the names of the stored archives.
+	// Objects with this prefix contain personal data and must never be served publicly.
+	ObjectNamePrefix = "user_data_export/"
+)
+
+// Request is the job building the archive of a requested user data export.
+type Request struct {
+	Aggregate *eventstore.Aggregate
+	ExportID  string
+}
+
+func (r *Request) Kind() string {
+	return "user_data_export_request"
+}
+
+// ObjectName returns the name the archive of the export is stored by.
+func ObjectName(userID, exportID string) string {
+	return ObjectNamePrefix + userID + "/" + exportID + ".json"
+}
+
+// Expiry is the job deleting the archive of an export after its lifetime.
+type Expiry struct {
+	Aggregate *eventstore.Aggregate
+	ExportID  string
+}
+
+func (e *Expiry) Kind() string {
+	return "user_data_export_expiry"
+}
+
+// Removal is the job deleting the archives of a removed user or organization.
+type Removal struct {
+	Aggregate *eventstore.Aggregate
+}
+
+func (r *Removal) Kind() string {
+	return "user_data_export_removal"
+}
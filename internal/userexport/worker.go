@@ -0,0 +1,114 @@
+package userexport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/static"
+)
+
+// ExportUserID is the creator of the events pushed by the worker.
+const ExportUserID = "USER_DATA_EXPORT"
+
+var _ river.Worker[*Request] = (*Worker)(nil)
+
+type Worker struct {
+	river.WorkerDefaults[*Request]
+
+	config     WorkerConfig
+	commands   Commands
+	queries    Queries
+	eventstore Eventstore
+	storage    static.Storage
+	now        func() time.Time
+}
+
+type Commands interface {
+	UserDataExportSucceeded(ctx context.Context, userID, resourceOwner, exportID string) error
+	UserDataExportFailed(ctx context.Context, userID, resourceOwner, exportID string, exportErr error) error
+	UserDataExportExpired(ctx context.Context, userID, resourceOwner, exportID string) error
+}
+
+type WorkerConfig struct {
+	Workers             uint8
+	TransactionDuration time.Duration
+	MaxAttempts         uint8
+	Lifetime            time.Duration
+}
+
+func NewWorker(
+	config WorkerConfig,
+	commands Commands,
+	queries Queries,
+	eventstore Eventstore,
+	storage static.Storage,
+) *Worker {
+	return &Worker{
+		config:     config,
+		commands:   commands,
+		queries:    queries,
+		eventstore: eventstore,
+		storage:    storage,
+		now:        time.Now,
+	}
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker[*Request](workers, w)
+	queues[QueueName] = river.QueueConfig{
+		MaxWorkers: int(w.config.Workers),
+	}
+}
+
+// Timeout implements the Timeout-function of [river.Worker].
+// Maximum time a job can run before the context gets canceled.
+func (w *Worker) Timeout(*river.Job[*Request]) time.Duration {
+	return w.config.TransactionDuration
+}
+
+// Work implements [river.Worker].
+// The export is marked as failed after the last attempt.
+func (w *Worker) Work(ctx context.Context, job *river.Job[*Request]) error {
+	aggregate := job.Args.Aggregate
+	ctx = authz.WithInstanceID(authz.SetCtxData(ctx, authz.CtxData{UserID: ExportUserID, OrgID: aggregate.ResourceOwner}), aggregate.InstanceID)
+
+	err := w.export(ctx, job.Args)
+	if err == nil {
+		return w.commands.UserDataExportSucceeded(ctx, aggregate.ID, aggregate.ResourceOwner, job.Args.ExportID)
+	}
+	if job.Attempt < job.MaxAttempts {
+		return err
+	}
+	if failedErr := w.commands.UserDataExportFailed(ctx, aggregate.ID, aggregate.ResourceOwner, job.Args.ExportID, err); failedErr != nil {
+		return failedErr
+	}
+	return river.JobCancel(err)
+}
+
+func (w *Worker) export(ctx context.Context, request *Request) error {
+	archive, err := BuildArchive(ctx, w.queries, w.eventstore, request.Aggregate.ID, request.ExportID, w.now())
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(archive)
+	if err != nil {
+		return err
+	}
+	_, err = w.storage.PutObject(ctx,
+		request.Aggregate.InstanceID,
+		"",
+		request.Aggregate.ResourceOwner,
+		ObjectName(request.Aggregate.ID, request.ExportID),
+		"application/json",
+		static.ObjectTypeUserDataExport,
+		bytes.NewReader(data),
+		int64(len(data)),
+	)
+	return err
+}
@@ -0,0 +1,114 @@
+package userexport
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/static/mock"
+)
+
+type testCommands struct {
+	succeeded []string
+	failed    []string
+	expired   []string
+	err       error
+}
+
+func (c *testCommands) UserDataExportSucceeded(_ context.Context, _, _, exportID string) error {
+	c.succeeded = append(c.succeeded, exportID)
+	return nil
+}
+
+func (c *testCommands) UserDataExportFailed(_ context.Context, _, _, exportID string, _ error) error {
+	c.failed = append(c.failed, exportID)
+	return nil
+}
+
+func (c *testCommands) UserDataExportExpired(_ context.Context, _, _, exportID string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.expired = append(c.expired, exportID)
+	return nil
+}
+
+func testJob(attempt, maxAttempts int) *river.Job[*Request] {
+	return &river.Job[*Request]{
+		JobRow: &rivertype.JobRow{
+			Attempt:     attempt,
+			MaxAttempts: maxAttempts,
+		},
+		Args: &Request{
+			Aggregate: &user.NewAggregate("user1", "org1").Aggregate,
+			ExportID:  "export1",
+		},
+	}
+}
+
+func TestWorker_Work(t *testing.T) {
+	errQuery := errors.New("query failed")
+	tests := []struct {
+		name          string
+		queries       Queries
+		storage       func(*testing.T) static.Storage
+		job           *river.Job[*Request]
+		wantSucceeded []string
+		wantFailed    []string
+		wantErr       bool
+		wantCancel    bool
+	}{
+		{
+			name:    "archive stored, succeeded",
+			queries: &testQueries{},
+			storage: func(t *testing.T) static.Storage {
+				return mock.NewStorage(t).ExpectPutObject()
+			},
+			job:           testJob(1, 3),
+			wantSucceeded: []string{"export1"},
+		},
+		{
+			name:    "storage error, retry",
+			queries: &testQueries{},
+			storage: func(t *testing.T) static.Storage {
+				return mock.NewStorage(t).ExpectPutObjectError()
+			},
+			job:     testJob(1, 3),
+			wantErr: true,
+		},
+		{
+			name:    "query error on last attempt, failed",
+			queries: &testQueries{err: errQuery},
+			storage: func(t *testing.T) static.Storage {
+				return mock.NewStorage(t)
+			},
+			job:        testJob(3, 3),
+			wantFailed: []string{"export1"},
+			wantErr:    true,
+			wantCancel: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := new(testCommands)
+			w := NewWorker(WorkerConfig{Workers: 1, TransactionDuration: time.Minute, MaxAttempts: 3}, commands, tt.queries, &testEventstore{}, tt.storage(t))
+			err := w.Work(context.Background(), tt.job)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+			var cancelErr *river.JobCancelError
+			assert.Equal(t, tt.wantCancel, errors.As(err, &cancelErr))
+			assert.Equal(t, tt.wantSucceeded, commands.succeeded)
+			assert.Equal(t, tt.wantFailed, commands.failed)
+		})
+	}
+}
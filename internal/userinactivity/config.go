@@ -0,0 +1,12 @@
+package userinactivity
+
+type Config struct {
+	// Enabled starts the periodic check of inactive users
+	Enabled bool
+	// Interval of the check as cron expression
+	Interval string
+	// MaxAttempts of each check
+	MaxAttempts uint8
+	// BulkSize is the amount of inactive users loaded at once
+	BulkSize uint64
+}
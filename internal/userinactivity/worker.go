@@ -0,0 +1,279 @@
+// Package userinactivity periodically applies the steps of the inactivity policies
+// (warn, deactivate, delete) to the users without activity.
+package userinactivity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	QueueName = "user_inactivity"
+	// InactivityUserID is the creator of the events pushed by the worker.
+	InactivityUserID = "USER_INACTIVITY"
+)
+
+var _ river.Worker[*Check] = (*Worker)(nil)
+
+// Check is the periodic job checking the users of all instances.
+type Check struct{}
+
+func (*Check) Kind() string {
+	return "user_inactivity_check"
+}
+
+type Worker struct {
+	river.WorkerDefaults[*Check]
+
+	config   *Config
+	commands Commands
+	queries  Queries
+	now      func() time.Time
+}
+
+type Commands interface {
+	HandleInactiveUser(ctx context.Context, inactive *command.InactiveUser, policy *domain.InactivityPolicy, cascadingUserMemberships []*command.CascadingMembership, cascadingGrantIDs, cascadingGroupIDs []string) (domain.InactivityAction, error)
+}
+
+type Queries interface {
+	SearchInstances(ctx context.Context, queries *query.InstanceSearchQueries) (*query.Instances, error)
+	InactivityPoliciesByInstance(ctx context.Context) ([]*query.InactivityPolicy, error)
+	SearchStaleUsers(ctx context.Context, queries *query.StaleUsersSearchQueries, shouldTriggerBulk bool) (*query.StaleUsers, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool, permissionCheck domain.PermissionCheck) (*query.UserGrants, error)
+	Memberships(ctx context.Context, queries *query.MembershipSearchQuery, shouldTrigger bool) (*query.Memberships, error)
+	SearchGroupUsers(ctx context.Context, queries *query.GroupUsersSearchQuery, permissionCheck domain.PermissionCheck) (*query.GroupUsers, error)
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker[*Check](workers, w)
+	queues[QueueName] = river.QueueConfig{
+		MaxWorkers: 1,
+	}
+}
+
+// Work implements the [river.Worker] interface.
+func (w *Worker) Work(ctx context.Context, _ *river.Job[*Check]) error {
+	instances, err := w.queries.SearchInstances(ctx, &query.InstanceSearchQueries{})
+	if err != nil {
+		return err
+	}
+	errs := make([]error, 0)
+	for _, instance := range instances.Instances {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		instanceCtx := authz.WithInstanceID(authz.SetCtxData(ctx, authz.CtxData{UserID: InactivityUserID}), instance.ID)
+		if err := w.checkInstance(instanceCtx); err != nil {
+			logging.WithFields("instance", instance.ID).WithError(err).Warn("unable to check inactive users")
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkInstance applies the custom policies to the users of their organization
+// and the default policy to the users of all other organizations.
+func (w *Worker) checkInstance(ctx context.Context) error {
+	policies, err := w.queries.InactivityPoliciesByInstance(ctx)
+	if err != nil {
+		return err
+	}
+	customOrgIDs := make([]string, 0, len(policies))
+	for _, policy := range policies {
+		if !policy.IsDefault {
+			customOrgIDs = append(customOrgIDs, policy.ID)
+		}
+	}
+	errs := make([]error, 0)
+	for _, policy := range policies {
+		if policy.IsDefault {
+			errs = append(errs, w.checkUsers(ctx, policy.ToDomain(), nil, customOrgIDs))
+			continue
+		}
+		errs = append(errs, w.checkUsers(ctx, policy.ToDomain(), []string{policy.ID}, nil))
+	}
+	return errors.Join(errs...)
+}
+
+// checkUsers handles the stale users in bulks.
+// Warned and deactivated users are still stale and returned by the next search,
+// as are the users which could not be handled.
+// Therefore, the offset is only increased for those, so no user is skipped.
+func (w *Worker) checkUsers(ctx context.Context, policy *domain.InactivityPolicy, resourceOwners, excludedResourceOwners []string) error {
+	if !policy.Enabled() {
+		return nil
+	}
+	now := w.now()
+	search := &query.StaleUsersSearchQueries{
+		Limit:                  w.config.BulkSize,
+		InactiveSince:          now.AddDate(0, 0, -int(policy.FirstStepDays())),
+		ResourceOwners:         resourceOwners,
+		ExcludedResourceOwners: excludedResourceOwners,
+		ExemptMachineUsers:     policy.ExemptMachineUsers,
+		ExemptRoles:            policy.ExemptRoles,
+	}
+	for {
+		users, err := w.queries.SearchStaleUsers(ctx, search, true)
+		if err != nil {
+			return err
+		}
+		for _, user := range users.Users {
+			if !w.checkUser(ctx, policy, user, now) {
+				search.Offset++
+			}
+		}
+		if search.Limit == 0 || uint64(len(users.Users)) < search.Limit {
+			return nil
+		}
+	}
+}
+
+// checkUser returns true if the user was removed.
+func (w *Worker) checkUser(ctx context.Context, policy *domain.InactivityPolicy, user *query.StaleUser, now time.Time) bool {
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: InactivityUserID, OrgID: user.ResourceOwner})
+	var (
+		memberships []*command.CascadingMembership
+		grantIDs    []string
+		groupIDs    []string
+		err         error
+	)
+	if policy.Action(user.LastActivity, now) == domain.InactivityActionDelete {
+		memberships, grantIDs, groupIDs, err = w.userDependencies(ctx, user.ID)
+		if err != nil {
+			logging.WithFields("user", user.ID).WithError(err).Warn("unable to load dependencies of inactive user")
+			return false
+		}
+	}
+	action, err := w.commands.HandleInactiveUser(ctx,
+		&command.InactiveUser{
+			UserID:        user.ID,
+			ResourceOwner: user.ResourceOwner,
+			LastActivity:  user.LastActivity,
+			CheckedAt:     now,
+		},
+		policy,
+		memberships,
+		grantIDs,
+		groupIDs,
+	)
+	if err != nil {
+		logging.WithFields("user", user.ID).WithError(err).Warn("unable to handle inactive user")
+		return false
+	}
+	logging.WithFields("user", user.ID, "action", action).Debug("inactive user handled")
+	return action == domain.InactivityActionDelete
+}
+
+func (w *Worker) userDependencies(ctx context.Context, userID string) ([]*command.CascadingMembership, []string, []string, error) {
+	userGrantUserQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	grants, err := w.queries.UserGrants(ctx, &query.UserGrantsQueries{
+		Queries: []query.SearchQuery{userGrantUserQuery},
+	}, true, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	membershipsUserQuery, err := query.NewMembershipUserIDQuery(userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	memberships, err := w.queries.Memberships(ctx, &query.MembershipSearchQuery{
+		Queries: []query.SearchQuery{membershipsUserQuery},
+	}, false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	groupUserQuery, err := query.NewGroupUsersUserIDsSearchQuery([]string{userID})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	groupUsers, err := w.queries.SearchGroupUsers(ctx, &query.GroupUsersSearchQuery{
+		Queries: []query.SearchQuery{groupUserQuery},
+	}, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	grantIDs := make([]string, len(grants.UserGrants))
+	for i, grant := range grants.UserGrants {
+		grantIDs[i] = grant.ID
+	}
+	groupIDs := make([]string, len(groupUsers.GroupUsers))
+	for i, groupUser := range groupUsers.GroupUsers {
+		groupIDs[i] = groupUser.GroupID
+	}
+	return cascadingMemberships(memberships.Memberships), grantIDs, groupIDs, nil
+}
+
+func cascadingMemberships(memberships []*query.Membership) []*command.CascadingMembership {
+	cascades := make([]*command.CascadingMembership, len(memberships))
+	for i, membership := range memberships {
+		cascades[i] = &command.CascadingMembership{
+			UserID:        membership.UserID,
+			ResourceOwner: membership.ResourceOwner,
+		}
+		if membership.IAM != nil {
+			cascades[i].IAM = &command.CascadingIAMMembership{IAMID: membership.IAM.IAMID}
+		}
+		if membership.Org != nil {
+			cascades[i].Org = &command.CascadingOrgMembership{OrgID: membership.Org.OrgID}
+		}
+		if membership.Project != nil {
+			cascades[i].Project = &command.CascadingProjectMembership{ProjectID: membership.Project.ProjectID}
+		}
+		if membership.ProjectGrant != nil {
+			cascades[i].ProjectGrant = &command.CascadingProjectGrantMembership{ProjectID: membership.ProjectGrant.ProjectID, GrantID: membership.ProjectGrant.GrantID}
+		}
+	}
+	return cascades
+}
+
+func Register(
+	ctx context.Context,
+	q *queue.Queue,
+	commands *command.Commands,
+	queries *query.Queries,
+	config *Config,
+) {
+	if !config.Enabled {
+		return
+	}
+	q.AddWorkers(ctx, &Worker{
+		config:   config,
+		commands: commands,
+		queries:  queries,
+		now:      time.Now,
+	})
+}
+
+func Start(ctx context.Context, config *Config, q *queue.Queue) error {
+	if !config.Enabled {
+		return nil
+	}
+	schedule, err := cron.ParseStandard(config.Interval)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "USRIN-ahR5e", "invalid interval")
+	}
+	q.AddPeriodicJob(
+		ctx,
+		schedule,
+		&Check{},
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(config.MaxAttempts),
+	)
+	return nil
+}
@@ -0,0 +1,239 @@
+package userinactivity
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type fakeQueries struct {
+	Queries
+
+	policies []*query.InactivityPolicy
+	users    map[string][]*query.StaleUser
+	searches []*query.StaleUsersSearchQueries
+}
+
+func (q *fakeQueries) SearchInstances(context.Context, *query.InstanceSearchQueries) (*query.Instances, error) {
+	return &query.Instances{Instances: []*query.Instance{{ID: "instance1"}}}, nil
+}
+
+func (q *fakeQueries) InactivityPoliciesByInstance(context.Context) ([]*query.InactivityPolicy, error) {
+	return q.policies, nil
+}
+
+func (q *fakeQueries) SearchStaleUsers(_ context.Context, search *query.StaleUsersSearchQueries, _ bool) (*query.StaleUsers, error) {
+	copied := *search
+	q.searches = append(q.searches, &copied)
+	key := "default"
+	if len(search.ResourceOwners) > 0 {
+		key = search.ResourceOwners[0]
+	}
+	users := q.users[key]
+	if search.Offset >= uint64(len(users)) {
+		return &query.StaleUsers{}, nil
+	}
+	users = users[search.Offset:]
+	if search.Limit > 0 && search.Limit < uint64(len(users)) {
+		users = users[:search.Limit]
+	}
+	return &query.StaleUsers{Users: users}, nil
+}
+
+// removeUser removes the user from the stale users, like the removal does
+func (q *fakeQueries) removeUser(userID string) {
+	for key, users := range q.users {
+		q.users[key] = slices.DeleteFunc(slices.Clone(users), func(user *query.StaleUser) bool {
+			return user.ID == userID
+		})
+	}
+}
+
+func (q *fakeQueries) UserGrants(context.Context, *query.UserGrantsQueries, bool, domain.PermissionCheck) (*query.UserGrants, error) {
+	return &query.UserGrants{UserGrants: []*query.UserGrant{{ID: "grant1"}}}, nil
+}
+
+func (q *fakeQueries) Memberships(context.Context, *query.MembershipSearchQuery, bool) (*query.Memberships, error) {
+	return &query.Memberships{Memberships: []*query.Membership{{UserID: "user2", ResourceOwner: "org2", Org: &query.OrgMembership{OrgID: "org2"}}}}, nil
+}
+
+func (q *fakeQueries) SearchGroupUsers(context.Context, *query.GroupUsersSearchQuery, domain.PermissionCheck) (*query.GroupUsers, error) {
+	return &query.GroupUsers{}, nil
+}
+
+type handledUser struct {
+	userID      string
+	instanceID  string
+	policyID    string
+	grantIDs    []string
+	memberships []*command.CascadingMembership
+}
+
+type fakeCommands struct {
+	handled []handledUser
+	// actions are the results of the users, none if not set
+	actions map[string]domain.InactivityAction
+	errs    map[string]error
+	queries *fakeQueries
+}
+
+func (c *fakeCommands) HandleInactiveUser(ctx context.Context, inactive *command.InactiveUser, policy *domain.InactivityPolicy, cascadingUserMemberships []*command.CascadingMembership, cascadingGrantIDs, _ []string) (domain.InactivityAction, error) {
+	c.handled = append(c.handled, handledUser{
+		userID:      inactive.UserID,
+		instanceID:  authz.GetInstance(ctx).InstanceID(),
+		policyID:    policy.AggregateID,
+		grantIDs:    cascadingGrantIDs,
+		memberships: cascadingUserMemberships,
+	})
+	if err := c.errs[inactive.UserID]; err != nil {
+		return domain.InactivityActionNone, err
+	}
+	action := c.actions[inactive.UserID]
+	if action == domain.InactivityActionDelete {
+		c.queries.removeUser(inactive.UserID)
+	}
+	return action, nil
+}
+
+func TestWorker_Work(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	queries := &fakeQueries{
+		policies: []*query.InactivityPolicy{
+			{
+				ID:             "org1",
+				WarnDays:       30,
+				DeactivateDays: 60,
+			},
+			{
+				ID: "org3",
+			},
+			{
+				ID:                 "instance1",
+				IsDefault:          true,
+				WarnDays:           60,
+				DeleteDays:         90,
+				ExemptMachineUsers: true,
+				ExemptRoles:        []string{"IAM_OWNER"},
+			},
+		},
+		users: map[string][]*query.StaleUser{
+			"org1": {
+				{ID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -40)},
+			},
+			"default": {
+				{ID: "user2", ResourceOwner: "org2", LastActivity: now.AddDate(0, 0, -100)},
+			},
+		},
+	}
+	commands := new(fakeCommands)
+	w := &Worker{
+		config:   &Config{BulkSize: 10},
+		commands: commands,
+		queries:  queries,
+		now:      func() time.Time { return now },
+	}
+
+	err := w.Work(context.Background(), &river.Job[*Check]{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []*query.StaleUsersSearchQueries{
+		{
+			Limit:          10,
+			InactiveSince:  now.AddDate(0, 0, -30),
+			ResourceOwners: []string{"org1"},
+		},
+		{
+			Limit:                  10,
+			InactiveSince:          now.AddDate(0, 0, -60),
+			ExcludedResourceOwners: []string{"org1", "org3"},
+			ExemptMachineUsers:     true,
+			ExemptRoles:            []string{"IAM_OWNER"},
+		},
+	}, queries.searches)
+	assert.Equal(t, []handledUser{
+		{
+			userID:     "user1",
+			instanceID: "instance1",
+			policyID:   "org1",
+		},
+		{
+			userID:     "user2",
+			instanceID: "instance1",
+			policyID:   "instance1",
+			grantIDs:   []string{"grant1"},
+			memberships: []*command.CascadingMembership{
+				{
+					UserID:        "user2",
+					ResourceOwner: "org2",
+					Org:           &command.CascadingOrgMembership{OrgID: "org2"},
+				},
+			},
+		},
+	}, commands.handled)
+}
+
+func TestWorker_Work_bulks(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	queries := &fakeQueries{
+		policies: []*query.InactivityPolicy{
+			{
+				ID:             "instance1",
+				IsDefault:      true,
+				WarnDays:       30,
+				DeactivateDays: 60,
+			},
+		},
+		users: map[string][]*query.StaleUser{
+			"default": {
+				{ID: "user1", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -100)},
+				{ID: "user2", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -90)},
+				{ID: "user3", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -80)},
+				{ID: "user4", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -70)},
+				{ID: "user5", ResourceOwner: "org1", LastActivity: now.AddDate(0, 0, -40)},
+			},
+		},
+	}
+	commands := &fakeCommands{
+		actions: map[string]domain.InactivityAction{
+			"user1": domain.InactivityActionDelete,
+			"user3": domain.InactivityActionWarn,
+			"user4": domain.InactivityActionDelete,
+		},
+		errs: map[string]error{
+			"user2": errors.New("push failed"),
+		},
+		queries: queries,
+	}
+	w := &Worker{
+		config:   &Config{BulkSize: 2},
+		commands: commands,
+		queries:  queries,
+		now:      func() time.Time { return now },
+	}
+
+	err := w.Work(context.Background(), &river.Job[*Check]{})
+	require.NoError(t, err)
+
+	// only the removed users are no longer stale, the others are skipped on the next bulk
+	offsets := make([]uint64, len(queries.searches))
+	for i, search := range queries.searches {
+		offsets[i] = search.Offset
+	}
+	assert.Equal(t, []uint64{0, 1, 2}, offsets)
+	handled := make([]string, len(commands.handled))
+	for i, user := range commands.handled {
+		handled[i] = user.userID
+	}
+	assert.Equal(t, []string{"user1", "user2", "user3", "user4", "user5"}, handled)
+}
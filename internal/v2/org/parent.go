@@ -0,0 +1,59 @@
+package org
+
+import (
+	"github.com/zitadel/zitadel/internal/v2/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	ParentSetType     = eventTypePrefix + "parent.set"
+	ParentRemovedType = eventTypePrefix + "parent.removed"
+)
+
+type parentSetPayload struct {
+	ParentID string `json:"parentId"`
+}
+
+type ParentSetEvent eventstore.Event[parentSetPayload]
+
+var _ eventstore.TypeChecker = (*ParentSetEvent)(nil)
+
+// ActionType implements eventstore.Typer.
+func (c *ParentSetEvent) ActionType() string {
+	return ParentSetType
+}
+
+func ParentSetEventFromStorage(event *eventstore.StorageEvent) (e *ParentSetEvent, _ error) {
+	if event.Type != e.ActionType() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Uch0a", "Errors.Invalid.Event.Type")
+	}
+
+	payload, err := eventstore.UnmarshalPayload[parentSetPayload](event.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ParentSetEvent{
+		StorageEvent: event,
+		Payload:      payload,
+	}, nil
+}
+
+type ParentRemovedEvent eventstore.Event[eventstore.EmptyPayload]
+
+var _ eventstore.TypeChecker = (*ParentRemovedEvent)(nil)
+
+// ActionType implements eventstore.Typer.
+func (c *ParentRemovedEvent) ActionType() string {
+	return ParentRemovedType
+}
+
+func ParentRemovedEventFromStorage(event *eventstore.StorageEvent) (e *ParentRemovedEvent, _ error) {
+	if event.Type != e.ActionType() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Ku5ei", "Errors.Invalid.Event.Type")
+	}
+
+	return &ParentRemovedEvent{
+		StorageEvent: event,
+	}, nil
+}
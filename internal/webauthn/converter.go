@@ -88,3 +88,29 @@ func AuthenticatorAttachmentFromDomain(authType domain.AuthenticatorAttachment)
 		return ""
 	}
 }
+
+func AttestationConveyanceFromDomain(attestation domain.AttestationConveyance) protocol.ConveyancePreference {
+	switch attestation {
+	case domain.AttestationConveyanceIndirect:
+		return protocol.PreferIndirectAttestation
+	case domain.AttestationConveyanceDirect:
+		return protocol.PreferDirectAttestation
+	case domain.AttestationConveyanceEnterprise:
+		return protocol.PreferEnterpriseAttestation
+	default:
+		return protocol.PreferNoAttestation
+	}
+}
+
+func ResidentKeyRequirementFromDomain(residentKey domain.ResidentKeyRequirement) protocol.ResidentKeyRequirement {
+	switch residentKey {
+	case domain.ResidentKeyRequirementDiscouraged:
+		return protocol.ResidentKeyRequirementDiscouraged
+	case domain.ResidentKeyRequirementPreferred:
+		return protocol.ResidentKeyRequirementPreferred
+	case domain.ResidentKeyRequirementRequired:
+		return protocol.ResidentKeyRequirementRequired
+	default:
+		return ""
+	}
+}
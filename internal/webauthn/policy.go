@@ -0,0 +1,55 @@
+package webauthn
+
+import (
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const extensionCredProps = "credProps"
+
+// checkRegistrationPolicy checks the created credential against the trusted metadata and the policy of the organization:
+// the authenticator must not be reported with an undesired status, must be allowed by the AAGUID lists,
+// must provide a trusted attestation if direct or enterprise attestation is required,
+// and must have verified the user and created a discoverable credential if required.
+func (w *Config) checkRegistrationPolicy(policy *domain.WebAuthNPolicy, credentialData *protocol.ParsedCredentialCreationData, credential *webauthn.Credential) error {
+	aaguid, err := uuid.FromBytes(credential.Authenticator.AAGUID)
+	if err != nil {
+		return zerrors.ThrowPreconditionFailed(err, "WEBAU-ohB2u", "Errors.User.WebAuthN.AuthenticatorNotAllowed")
+	}
+	if err = w.Trust.checkStatus(aaguid); err != nil {
+		return err
+	}
+	if policy == nil {
+		return nil
+	}
+	if !policy.AAGUIDAllowed(aaguid) {
+		return zerrors.ThrowPreconditionFailed(nil, "WEBAU-Thoo3", "Errors.User.WebAuthN.AuthenticatorNotAllowed")
+	}
+	if policy.Attestation.RequiresTrustedAttestation() {
+		if err = w.Trust.verifyAttestation(aaguid, credentialData.Response.AttestationObject); err != nil {
+			return err
+		}
+	}
+	if policy.UserVerification == domain.UserVerificationRequirementRequired && !credential.Flags.UserVerified {
+		return zerrors.ThrowPreconditionFailed(nil, "WEBAU-ieGh5", "Errors.User.WebAuthN.UserVerificationRequired")
+	}
+	if policy.ResidentKey == domain.ResidentKeyRequirementRequired && !residentKeyCreated(credentialData.ClientExtensionResults) {
+		return zerrors.ThrowPreconditionFailed(nil, "WEBAU-Aiy5e", "Errors.User.WebAuthN.ResidentKeyRequired")
+	}
+	return nil
+}
+
+// residentKeyCreated returns the rk property of the credProps extension.
+// If the client does not report it, the credential is not considered discoverable.
+func residentKeyCreated(results protocol.AuthenticationExtensionsClientOutputs) bool {
+	credProps, ok := results[extensionCredProps].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	rk, _ := credProps["rk"].(bool)
+	return rk
+}
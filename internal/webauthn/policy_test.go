@@ -0,0 +1,149 @@
+package webauthn
+
+import (
+	"testing"
+
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestConfig_checkRegistrationPolicy(t *testing.T) {
+	trust := &TrustStore{
+		metadata: map[uuid.UUID]metadata.MetadataBLOBPayloadEntry{
+			revokedAAGUID: {
+				StatusReports: []metadata.StatusReport{{Status: metadata.Revoked}},
+			},
+		},
+	}
+	credential := func(aaguid uuid.UUID, userVerified bool) *webauthn.Credential {
+		return &webauthn.Credential{
+			Flags:         webauthn.CredentialFlags{UserVerified: userVerified},
+			Authenticator: webauthn.Authenticator{AAGUID: aaguid[:]},
+		}
+	}
+	credentialData := func(extensions protocol.AuthenticationExtensionsClientOutputs) *protocol.ParsedCredentialCreationData {
+		return &protocol.ParsedCredentialCreationData{
+			ParsedPublicKeyCredential: protocol.ParsedPublicKeyCredential{
+				ClientExtensionResults: extensions,
+			},
+			Response: protocol.ParsedAttestationResponse{
+				AttestationObject: protocol.AttestationObject{Format: "none"},
+			},
+		}
+	}
+	type args struct {
+		policy         *domain.WebAuthNPolicy
+		credentialData *protocol.ParsedCredentialCreationData
+		credential     *webauthn.Credential
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "no policy, ok",
+			args: args{
+				credentialData: credentialData(nil),
+				credential:     credential(testAAGUID, false),
+			},
+		},
+		{
+			name: "revoked authenticator, error",
+			args: args{
+				credentialData: credentialData(nil),
+				credential:     credential(revokedAAGUID, true),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-Aed9i", "Errors.User.WebAuthN.AuthenticatorNotAllowed"),
+		},
+		{
+			name: "authenticator not allowed, error",
+			args: args{
+				policy: &domain.WebAuthNPolicy{
+					AllowedAAGUIDs: []string{uuid.NewString()},
+				},
+				credentialData: credentialData(nil),
+				credential:     credential(testAAGUID, true),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-Thoo3", "Errors.User.WebAuthN.AuthenticatorNotAllowed"),
+		},
+		{
+			name: "trusted attestation missing, error",
+			args: args{
+				policy: &domain.WebAuthNPolicy{
+					Attestation: domain.AttestationConveyanceDirect,
+				},
+				credentialData: credentialData(nil),
+				credential:     credential(testAAGUID, true),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-Oox8a", "Errors.User.WebAuthN.AttestationNotTrusted"),
+		},
+		{
+			name: "user not verified, error",
+			args: args{
+				policy: &domain.WebAuthNPolicy{
+					UserVerification: domain.UserVerificationRequirementRequired,
+				},
+				credentialData: credentialData(nil),
+				credential:     credential(testAAGUID, false),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-ieGh5", "Errors.User.WebAuthN.UserVerificationRequired"),
+		},
+		{
+			name: "resident key not reported, error",
+			args: args{
+				policy: &domain.WebAuthNPolicy{
+					ResidentKey: domain.ResidentKeyRequirementRequired,
+				},
+				credentialData: credentialData(nil),
+				credential:     credential(testAAGUID, true),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-Aiy5e", "Errors.User.WebAuthN.ResidentKeyRequired"),
+		},
+		{
+			name: "resident key not created, error",
+			args: args{
+				policy: &domain.WebAuthNPolicy{
+					ResidentKey: domain.ResidentKeyRequirementRequired,
+				},
+				credentialData: credentialData(protocol.AuthenticationExtensionsClientOutputs{
+					extensionCredProps: map[string]interface{}{"rk": false},
+				}),
+				credential: credential(testAAGUID, true),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-Aiy5e", "Errors.User.WebAuthN.ResidentKeyRequired"),
+		},
+		{
+			name: "all requirements met, ok",
+			args: args{
+				policy: &domain.WebAuthNPolicy{
+					UserVerification: domain.UserVerificationRequirementRequired,
+					ResidentKey:      domain.ResidentKeyRequirementRequired,
+					AllowedAAGUIDs:   []string{testAAGUID.String()},
+					DeniedAAGUIDs:    []string{revokedAAGUID.String()},
+				},
+				credentialData: credentialData(protocol.AuthenticationExtensionsClientOutputs{
+					extensionCredProps: map[string]interface{}{"rk": true},
+				}),
+				credential: credential(testAAGUID, true),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Config{Trust: trust}
+			err := w.checkRegistrationPolicy(tt.args.policy, tt.args.credentialData, tt.args.credential)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
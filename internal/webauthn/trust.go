@@ -0,0 +1,199 @@
+package webauthn
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/google/uuid"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// TrustConfig configures the trust anchors used to verify the attestation of authenticators.
+type TrustConfig struct {
+	// MetadataBLOB is the path to a FIDO Metadata Service (MDS3) BLOB, as downloaded from https://mds3.fidoalliance.org/.
+	MetadataBLOB string
+	// RootCertificates is the path to a PEM file containing additional trusted attestation root certificates.
+	RootCertificates string
+}
+
+// TrustStore contains the metadata and attestation root certificates of trusted authenticators.
+type TrustStore struct {
+	metadata map[uuid.UUID]metadata.MetadataBLOBPayloadEntry
+	roots    []*x509.Certificate
+}
+
+var metadataBLOBAlgorithms = []jose.SignatureAlgorithm{jose.RS256, jose.ES256}
+
+// NewTrustStore loads the configured metadata BLOB and root certificates.
+// If neither is configured, nil is returned.
+func NewTrustStore(config *TrustConfig) (*TrustStore, error) {
+	if config == nil || (config.MetadataBLOB == "" && config.RootCertificates == "") {
+		return nil, nil
+	}
+	store := &TrustStore{
+		metadata: make(map[uuid.UUID]metadata.MetadataBLOBPayloadEntry),
+	}
+	if config.MetadataBLOB != "" {
+		root, err := parseBase64Certificate(metadata.ProductionMDSRoot)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "WEBAU-eiP4u", "Errors.Internal")
+		}
+		if err = store.loadMetadataBLOB(config.MetadataBLOB, root); err != nil {
+			return nil, err
+		}
+	}
+	if config.RootCertificates != "" {
+		if err := store.loadRootCertificates(config.RootCertificates); err != nil {
+			return nil, err
+		}
+	}
+	return store, nil
+}
+
+// loadMetadataBLOB verifies the signature of the BLOB, which must be signed by a certificate chaining up to the root,
+// and stores the entries by AAGUID.
+func (t *TrustStore) loadMetadataBLOB(path string, root *x509.Certificate) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "WEBAU-Ahm6o", "Errors.Internal")
+	}
+	jws, err := jose.ParseSigned(string(data), metadataBLOBAlgorithms)
+	if err != nil || len(jws.Signatures) != 1 {
+		return zerrors.ThrowInternal(err, "WEBAU-ieX3a", "Errors.Internal")
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	chains, err := jws.Signatures[0].Protected.Certificates(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return zerrors.ThrowInternal(err, "WEBAU-Ohp8e", "Errors.Internal")
+	}
+	payload, err := jws.Verify(chains[0][0].PublicKey)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "WEBAU-lah7E", "Errors.Internal")
+	}
+	var blob metadata.MetadataBLOBPayload
+	if err = json.Unmarshal(payload, &blob); err != nil {
+		return zerrors.ThrowInternal(err, "WEBAU-Eo3ja", "Errors.Internal")
+	}
+	if nextUpdate, err := time.Parse(time.DateOnly, blob.NextUpdate); err == nil && nextUpdate.Before(time.Now()) {
+		logging.WithFields("nextUpdate", blob.NextUpdate, "number", blob.Number).Warn("webauthn metadata BLOB is outdated")
+	}
+	for _, entry := range blob.Entries {
+		aaguid, err := uuid.Parse(entry.AaGUID)
+		if err != nil {
+			// UAF and U2F authenticators are identified by AAID or key identifiers
+			continue
+		}
+		t.metadata[aaguid] = entry
+	}
+	return nil
+}
+
+func (t *TrustStore) loadRootCertificates(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "WEBAU-Thai4", "Errors.Internal")
+	}
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "WEBAU-Kie9o", "Errors.Internal")
+		}
+		t.roots = append(t.roots, cert)
+	}
+	if len(t.roots) == 0 {
+		return zerrors.ThrowInternal(nil, "WEBAU-ooD3e", "Errors.Internal")
+	}
+	return nil
+}
+
+// checkStatus returns an error if the metadata of the authenticator reports an undesired status,
+// e.g. because it was revoked or its attestation key was compromised.
+func (t *TrustStore) checkStatus(aaguid uuid.UUID) error {
+	if t == nil {
+		return nil
+	}
+	entry, ok := t.metadata[aaguid]
+	if !ok {
+		return nil
+	}
+	for _, report := range entry.StatusReports {
+		if metadata.IsUndesiredAuthenticatorStatus(report.Status) {
+			return zerrors.ThrowPreconditionFailed(nil, "WEBAU-Aed9i", "Errors.User.WebAuthN.AuthenticatorNotAllowed")
+		}
+	}
+	return nil
+}
+
+// verifyAttestation verifies that the attestation certificate chain (x5c) of the authenticator
+// chains up to either a root certificate of the authenticator's metadata or a configured root certificate.
+// The signature of the attestation statement itself is already verified during the creation of the credential.
+func (t *TrustStore) verifyAttestation(aaguid uuid.UUID, attestation protocol.AttestationObject) error {
+	if t == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "WEBAU-Ub2ai", "Errors.User.WebAuthN.AttestationNotTrusted")
+	}
+	x5c, ok := attestation.AttStatement["x5c"].([]interface{})
+	if !ok || len(x5c) == 0 {
+		return zerrors.ThrowPreconditionFailed(nil, "WEBAU-Oox8a", "Errors.User.WebAuthN.AttestationNotTrusted")
+	}
+	certs := make([]*x509.Certificate, len(x5c))
+	for i, raw := range x5c {
+		der, ok := raw.([]byte)
+		if !ok {
+			return zerrors.ThrowPreconditionFailed(nil, "WEBAU-iew7U", "Errors.User.WebAuthN.AttestationNotTrusted")
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return zerrors.ThrowPreconditionFailed(err, "WEBAU-Zae2o", "Errors.User.WebAuthN.AttestationNotTrusted")
+		}
+		certs[i] = cert
+	}
+	roots := x509.NewCertPool()
+	for _, root := range t.roots {
+		roots.AddCert(root)
+	}
+	if entry, ok := t.metadata[aaguid]; ok {
+		for _, encoded := range entry.MetadataStatement.AttestationRootCertificates {
+			root, err := parseBase64Certificate(encoded)
+			if err != nil {
+				continue
+			}
+			roots.AddCert(root)
+		}
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return zerrors.ThrowPreconditionFailed(err, "WEBAU-Ahch0", "Errors.User.WebAuthN.AttestationNotTrusted")
+	}
+	return nil
+}
+
+func parseBase64Certificate(encoded string) (*x509.Certificate, error) {
+	der, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
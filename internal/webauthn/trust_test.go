@@ -0,0 +1,262 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	testAAGUID    = uuid.MustParse("cb69481e-8ff7-4039-93ec-0a2729a154a8")
+	revokedAAGUID = uuid.MustParse("08987058-cadc-4b81-b6e1-30de50dcbe96")
+)
+
+type testCertificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCertificate(t *testing.T, commonName string, parent *testCertificate) *testCertificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	issuer, signer := template, key
+	if parent != nil {
+		issuer, signer = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCertificate{cert: cert, key: key}
+}
+
+func (c *testCertificate) base64() string {
+	return base64.StdEncoding.EncodeToString(c.cert.Raw)
+}
+
+func writeMetadataBLOB(t *testing.T, signer *testCertificate, chain []*testCertificate, payload *metadata.MetadataBLOBPayload) string {
+	t.Helper()
+	x5c := make([]string, len(chain))
+	for i, cert := range chain {
+		x5c[i] = cert.base64()
+	}
+	jwsSigner, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: signer.key},
+		(&jose.SignerOptions{}).WithHeader("x5c", x5c),
+	)
+	require.NoError(t, err)
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	jws, err := jwsSigner.Sign(data)
+	require.NoError(t, err)
+	blob, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "blob.jwt")
+	require.NoError(t, os.WriteFile(path, []byte(blob), 0o600))
+	return path
+}
+
+func TestTrustStore_loadMetadataBLOB(t *testing.T) {
+	mdsRoot := newTestCertificate(t, "mds root", nil)
+	mdsSigner := newTestCertificate(t, "mds signer", mdsRoot)
+	otherRoot := newTestCertificate(t, "other root", nil)
+	payload := &metadata.MetadataBLOBPayload{
+		Number:     1,
+		NextUpdate: time.Now().Add(24 * time.Hour).Format(time.DateOnly),
+		Entries: []metadata.MetadataBLOBPayloadEntry{
+			{
+				AaGUID: testAAGUID.String(),
+			},
+			{
+				AttestationCertificateKeyIdentifiers: []string{"u2f"},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		path    string
+		root    *x509.Certificate
+		wantErr bool
+	}{
+		{
+			name:    "missing file, error",
+			path:    filepath.Join(t.TempDir(), "missing"),
+			root:    mdsRoot.cert,
+			wantErr: true,
+		},
+		{
+			name:    "untrusted signer, error",
+			path:    writeMetadataBLOB(t, mdsSigner, []*testCertificate{mdsSigner, mdsRoot}, payload),
+			root:    otherRoot.cert,
+			wantErr: true,
+		},
+		{
+			name:    "wrong signature, error",
+			path:    writeMetadataBLOB(t, otherRoot, []*testCertificate{mdsSigner, mdsRoot}, payload),
+			root:    mdsRoot.cert,
+			wantErr: true,
+		},
+		{
+			name: "trusted signer, ok",
+			path: writeMetadataBLOB(t, mdsSigner, []*testCertificate{mdsSigner, mdsRoot}, payload),
+			root: mdsRoot.cert,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &TrustStore{metadata: make(map[uuid.UUID]metadata.MetadataBLOBPayloadEntry)}
+			err := store.loadMetadataBLOB(tt.path, tt.root)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsInternal(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, store.metadata, 1)
+			assert.Contains(t, store.metadata, testAAGUID)
+		})
+	}
+}
+
+func TestTrustStore_loadRootCertificates(t *testing.T) {
+	root := newTestCertificate(t, "root", nil)
+	dir := t.TempDir()
+	certificates := filepath.Join(dir, "roots.pem")
+	require.NoError(t, os.WriteFile(certificates, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.cert.Raw}), 0o600))
+	empty := filepath.Join(dir, "empty.pem")
+	require.NoError(t, os.WriteFile(empty, []byte("no certificates"), 0o600))
+
+	store := new(TrustStore)
+	require.NoError(t, store.loadRootCertificates(certificates))
+	assert.Len(t, store.roots, 1)
+	assert.True(t, zerrors.IsInternal(new(TrustStore).loadRootCertificates(empty)))
+}
+
+func TestTrustStore_checkStatus(t *testing.T) {
+	store := &TrustStore{
+		metadata: map[uuid.UUID]metadata.MetadataBLOBPayloadEntry{
+			testAAGUID: {
+				StatusReports: []metadata.StatusReport{{Status: metadata.FidoCertified}},
+			},
+			revokedAAGUID: {
+				StatusReports: []metadata.StatusReport{{Status: metadata.Revoked}},
+			},
+		},
+	}
+	var noStore *TrustStore
+	assert.NoError(t, noStore.checkStatus(revokedAAGUID))
+	assert.NoError(t, store.checkStatus(testAAGUID))
+	assert.NoError(t, store.checkStatus(uuid.New()))
+	assert.True(t, zerrors.IsPreconditionFailed(store.checkStatus(revokedAAGUID)))
+}
+
+func TestTrustStore_verifyAttestation(t *testing.T) {
+	metadataRoot := newTestCertificate(t, "metadata root", nil)
+	configuredRoot := newTestCertificate(t, "configured root", nil)
+	otherRoot := newTestCertificate(t, "other root", nil)
+	metadataStore := &TrustStore{
+		metadata: map[uuid.UUID]metadata.MetadataBLOBPayloadEntry{
+			testAAGUID: {
+				MetadataStatement: metadata.MetadataStatement{
+					AttestationRootCertificates: []string{metadataRoot.base64()},
+				},
+			},
+		},
+	}
+	configuredStore := &TrustStore{roots: []*x509.Certificate{configuredRoot.cert}}
+	attestation := func(chain ...*testCertificate) protocol.AttestationObject {
+		x5c := make([]interface{}, len(chain))
+		for i, cert := range chain {
+			x5c[i] = cert.cert.Raw
+		}
+		return protocol.AttestationObject{
+			Format:       "packed",
+			AttStatement: map[string]interface{}{"x5c": x5c},
+		}
+	}
+	tests := []struct {
+		name        string
+		store       *TrustStore
+		aaguid      uuid.UUID
+		attestation protocol.AttestationObject
+		wantErr     bool
+	}{
+		{
+			name:        "no trust store, error",
+			aaguid:      testAAGUID,
+			attestation: attestation(newTestCertificate(t, "attestation", metadataRoot)),
+			wantErr:     true,
+		},
+		{
+			name:        "no attestation, error",
+			store:       metadataStore,
+			aaguid:      testAAGUID,
+			attestation: protocol.AttestationObject{Format: "none"},
+			wantErr:     true,
+		},
+		{
+			name:        "untrusted root, error",
+			store:       metadataStore,
+			aaguid:      testAAGUID,
+			attestation: attestation(newTestCertificate(t, "attestation", otherRoot)),
+			wantErr:     true,
+		},
+		{
+			name:        "root of other authenticator, error",
+			store:       metadataStore,
+			aaguid:      revokedAAGUID,
+			attestation: attestation(newTestCertificate(t, "attestation", metadataRoot)),
+			wantErr:     true,
+		},
+		{
+			name:        "metadata root, ok",
+			store:       metadataStore,
+			aaguid:      testAAGUID,
+			attestation: attestation(newTestCertificate(t, "attestation", metadataRoot)),
+		},
+		{
+			name:        "configured root, ok",
+			store:       configuredStore,
+			aaguid:      uuid.New(),
+			attestation: attestation(newTestCertificate(t, "attestation", configuredRoot)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.store.verifyAttestation(tt.aaguid, tt.attestation)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsPreconditionFailed(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
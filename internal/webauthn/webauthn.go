@@ -18,6 +18,8 @@ import (
 type Config struct {
 	DisplayName    string
 	ExternalSecure bool
+	// Trust contains the trust anchors to verify the attestation of authenticators, if configured.
+	Trust *TrustStore
 }
 
 type webUser struct {
@@ -52,7 +54,10 @@ func (u *webUser) WebAuthnCredentials() []webauthn.Credential {
 	return u.credentials
 }
 
-func (w *Config) BeginRegistration(ctx context.Context, user *domain.Human, accountName string, authType domain.AuthenticatorAttachment, userVerification domain.UserVerificationRequirement, rpID string, webAuthNs ...*domain.WebAuthNToken) (*domain.WebAuthNToken, error) {
+// BeginRegistration creates the options for the registration of a new credential.
+// The policy of the organization overrides the requested user verification
+// and defines the attestation conveyance and resident key requirement.
+func (w *Config) BeginRegistration(ctx context.Context, user *domain.Human, accountName string, authType domain.AuthenticatorAttachment, userVerification domain.UserVerificationRequirement, policy *domain.WebAuthNPolicy, rpID string, webAuthNs ...*domain.WebAuthNToken) (*domain.WebAuthNToken, error) {
 	webAuthNServer, err := w.serverFromContext(ctx, rpID, "")
 	if err != nil {
 		return nil, err
@@ -65,18 +70,37 @@ func (w *Config) BeginRegistration(ctx context.Context, user *domain.Human, acco
 			CredentialID: cred.ID,
 		}
 	}
+	selection := protocol.AuthenticatorSelection{
+		UserVerification:        UserVerificationFromDomain(userVerification),
+		AuthenticatorAttachment: AuthenticatorAttachmentFromDomain(authType),
+	}
+	conveyance := protocol.PreferNoAttestation
+	var extensions protocol.AuthenticationExtensions
+	if policy != nil {
+		if policy.UserVerification != domain.UserVerificationRequirementUnspecified {
+			selection.UserVerification = UserVerificationFromDomain(policy.UserVerification)
+		}
+		if policy.ResidentKey != domain.ResidentKeyRequirementUnspecified {
+			selection.ResidentKey = ResidentKeyRequirementFromDomain(policy.ResidentKey)
+			selection.RequireResidentKey = protocol.ResidentKeyNotRequired()
+			if policy.ResidentKey == domain.ResidentKeyRequirementRequired {
+				selection.RequireResidentKey = protocol.ResidentKeyRequired()
+			}
+			// credProps reports whether a discoverable credential was created
+			extensions = protocol.AuthenticationExtensions{extensionCredProps: true}
+		}
+		conveyance = AttestationConveyanceFromDomain(policy.Attestation)
+	}
 	credentialOptions, sessionData, err := webAuthNServer.BeginRegistration(
 		&webUser{
 			Human:       user,
 			accountName: accountName,
 			credentials: creds,
 		},
-		webauthn.WithAuthenticatorSelection(protocol.AuthenticatorSelection{
-			UserVerification:        UserVerificationFromDomain(userVerification),
-			AuthenticatorAttachment: AuthenticatorAttachmentFromDomain(authType),
-		}),
-		webauthn.WithConveyancePreference(protocol.PreferNoAttestation),
+		webauthn.WithAuthenticatorSelection(selection),
+		webauthn.WithConveyancePreference(conveyance),
 		webauthn.WithExclusions(existing),
+		webauthn.WithExtensions(extensions),
 	)
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "WEBAU-bM8sd", "Errors.User.WebAuthN.BeginRegisterFailed")
@@ -94,7 +118,8 @@ func (w *Config) BeginRegistration(ctx context.Context, user *domain.Human, acco
 	}, nil
 }
 
-func (w *Config) FinishRegistration(ctx context.Context, user *domain.Human, webAuthN *domain.WebAuthNToken, tokenName string, credData []byte) (*domain.WebAuthNToken, error) {
+// FinishRegistration verifies the created credential and checks it against the policy of the organization.
+func (w *Config) FinishRegistration(ctx context.Context, user *domain.Human, webAuthN *domain.WebAuthNToken, tokenName string, credData []byte, policy *domain.WebAuthNPolicy) (*domain.WebAuthNToken, error) {
 	if webAuthN == nil {
 		return nil, zerrors.ThrowInternal(nil, "WEBAU-5M9so", "Errors.User.WebAuthN.NotFound")
 	}
@@ -118,6 +143,9 @@ func (w *Config) FinishRegistration(ctx context.Context, user *domain.Human, web
 		logging.WithFields("error", tryExtractProtocolErrMsg(err), "err_id", "WEBAU-3Vb9s").Debug("webauthn credential could not be created")
 		return nil, zerrors.ThrowInternal(err, "WEBAU-3Vb9s", "Errors.User.WebAuthN.CreateCredentialFailed")
 	}
+	if err = w.checkRegistrationPolicy(policy, credentialData, credential); err != nil {
+		return nil, err
+	}
 
 	webAuthN.KeyID = credential.ID
 	webAuthN.PublicKey = credential.PublicKey
@@ -2,13 +2,17 @@ package webauthn
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
+	"github.com/go-webauthn/webauthn/protocol"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -77,3 +81,61 @@ func TestConfig_serverFromContext(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_BeginRegistration(t *testing.T) {
+	ctx := http_util.WithDomainContext(context.Background(), &http_util.DomainCtx{InstanceHost: "example.com", Protocol: "https"})
+	user := &domain.Human{
+		ObjectRoot: models.ObjectRoot{AggregateID: "user1"},
+		Username:   "username",
+		Profile:    &domain.Profile{DisplayName: "display name"},
+	}
+	type want struct {
+		userVerification protocol.UserVerificationRequirement
+		attestation      protocol.ConveyancePreference
+		residentKey      protocol.ResidentKeyRequirement
+		extensions       protocol.AuthenticationExtensions
+	}
+	tests := []struct {
+		name   string
+		policy *domain.WebAuthNPolicy
+		want   want
+	}{
+		{
+			name: "no policy",
+			want: want{
+				userVerification: protocol.VerificationRequired,
+				attestation:      protocol.PreferNoAttestation,
+			},
+		},
+		{
+			name: "policy",
+			policy: &domain.WebAuthNPolicy{
+				Attestation:      domain.AttestationConveyanceDirect,
+				UserVerification: domain.UserVerificationRequirementPreferred,
+				ResidentKey:      domain.ResidentKeyRequirementRequired,
+			},
+			want: want{
+				userVerification: protocol.VerificationPreferred,
+				attestation:      protocol.PreferDirectAttestation,
+				residentKey:      protocol.ResidentKeyRequirementRequired,
+				extensions:       protocol.AuthenticationExtensions{extensionCredProps: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Config{
+				DisplayName:    "DisplayName",
+				ExternalSecure: true,
+			}
+			got, err := w.BeginRegistration(ctx, user, "username", domain.AuthenticatorAttachmentUnspecified, domain.UserVerificationRequirementRequired, tt.policy, "")
+			require.NoError(t, err)
+			var options protocol.CredentialCreation
+			require.NoError(t, json.Unmarshal(got.CredentialCreationData, &options))
+			assert.Equal(t, tt.want.userVerification, options.Response.AuthenticatorSelection.UserVerification)
+			assert.Equal(t, tt.want.attestation, options.Response.Attestation)
+			assert.Equal(t, tt.want.residentKey, options.Response.AuthenticatorSelection.ResidentKey)
+			assert.Equal(t, tt.want.extensions, options.Response.Extensions)
+		})
+	}
+}